@@ -0,0 +1,132 @@
+// Package anomaly flags interval buckets that deviate from a seasonal
+// baseline, built from the same bucket in previous seasons such as the same
+// weekday and hour in previous weeks.
+//
+// Buckets are scored with a robust z-score: the distance of the value from
+// the median of the baseline divided by the scaled median absolute deviation.
+// The median and MAD are not skewed by a single unusual season, such as a week
+// with a broken tracker, unlike the mean and standard deviation.
+package anomaly
+
+import (
+	"math"
+	"slices"
+
+	"github.com/medama-io/medama/model"
+)
+
+const (
+	// DefaultThreshold is the absolute score a metric is flagged at.
+	DefaultThreshold = 3.0
+	// MinSeasons is the number of baseline buckets needed to score a metric.
+	MinSeasons = 2
+
+	// madScale makes the median absolute deviation comparable to the
+	// standard deviation of normally distributed values.
+	madScale = 1.4826
+	// minCountDeviation is the smallest deviation of visitors and pageviews,
+	// so a few visitors more than a usually empty bucket are not flagged.
+	minCountDeviation = 3.0
+	// minBounceDeviation is the smallest deviation of the bounce rate.
+	minBounceDeviation = 0.05
+	// minDurationDeviation is the smallest deviation of the duration in
+	// milliseconds.
+	minDurationDeviation = 1000.0
+	// minDurationRatio is the smallest deviation of the duration relative to
+	// the baseline.
+	minDurationRatio = 0.1
+)
+
+// Detect returns the metrics of the bucket whose absolute score reaches the
+// threshold, compared with the same bucket in previous seasons. Missing
+// seasons are nil. The bounce rate and duration are only scored against
+// seasons with a bounce rate or duration, as they are zero without traffic.
+func Detect(current *model.StatsIntervals, baseline []*model.StatsIntervals, threshold float64) []model.StatsAnomaly {
+	anomalies := []model.StatsAnomaly{}
+
+	var visitors, pageviews, bounces, durations []float64
+
+	for _, season := range baseline {
+		if season == nil {
+			continue
+		}
+
+		visitors = append(visitors, float64(season.Visitors))
+		pageviews = append(pageviews, float64(season.Pageviews))
+
+		if season.BounceRate > 0 {
+			bounces = append(bounces, float64(season.BounceRate))
+		}
+
+		if season.Duration > 0 {
+			durations = append(durations, float64(season.Duration))
+		}
+	}
+
+	add := func(metric model.AnomalyMetric, value float64, seasons []float64, minDeviation func(float64) float64) {
+		if len(seasons) < MinSeasons {
+			return
+		}
+
+		expected, deviation := medianDeviation(seasons)
+		deviation = max(deviation, minDeviation(expected))
+
+		score := (value - expected) / deviation
+		if math.Abs(score) >= threshold {
+			anomalies = append(anomalies, model.StatsAnomaly{
+				Metric:   metric,
+				Value:    value,
+				Expected: expected,
+				Score:    math.Round(score*100) / 100,
+			})
+		}
+	}
+
+	add(model.AnomalyMetricVisitors, float64(current.Visitors), visitors, countDeviation)
+	add(model.AnomalyMetricPageviews, float64(current.Pageviews), pageviews, countDeviation)
+
+	if current.BounceRate > 0 {
+		add(model.AnomalyMetricBounceRate, float64(current.BounceRate), bounces, func(float64) float64 {
+			return minBounceDeviation
+		})
+	}
+
+	if current.Duration > 0 {
+		add(model.AnomalyMetricDuration, float64(current.Duration), durations, func(expected float64) float64 {
+			return max(minDurationDeviation, expected*minDurationRatio)
+		})
+	}
+
+	return anomalies
+}
+
+// countDeviation returns the smallest deviation of counts, which at least
+// follows the Poisson noise of the expected count.
+func countDeviation(expected float64) float64 {
+	return max(minCountDeviation, math.Sqrt(expected))
+}
+
+// medianDeviation returns the median of the values and their median absolute
+// deviation scaled to a standard deviation.
+func medianDeviation(values []float64) (float64, float64) {
+	m := median(values)
+
+	deviations := make([]float64, len(values))
+	for i, v := range values {
+		deviations[i] = math.Abs(v - m)
+	}
+
+	return m, median(deviations) * madScale
+}
+
+func median(values []float64) float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
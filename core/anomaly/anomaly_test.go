@@ -0,0 +1,87 @@
+package anomaly_test
+
+import (
+	"testing"
+
+	"github.com/medama-io/medama/anomaly"
+	"github.com/medama-io/medama/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func bucket(visitors int, pageviews int, bounceRate float32, duration int) *model.StatsIntervals {
+	return &model.StatsIntervals{
+		Visitors:   visitors,
+		Pageviews:  pageviews,
+		BounceRate: bounceRate,
+		Duration:   duration,
+	}
+}
+
+func TestDetect(t *testing.T) {
+	baseline := []*model.StatsIntervals{
+		bucket(100, 200, 0.4, 30000),
+		bucket(110, 220, 0.42, 31000),
+		bucket(95, 190, 0.38, 29000),
+		// A past outage does not skew the baseline.
+		bucket(0, 0, 0, 0),
+	}
+
+	testCases := []struct {
+		name     string
+		current  *model.StatsIntervals
+		expected []model.AnomalyMetric
+	}{
+		{"usual", bucket(105, 205, 0.41, 30500), nil},
+		{"broken tracker", bucket(0, 0, 0, 0), []model.AnomalyMetric{
+			model.AnomalyMetricVisitors,
+			model.AnomalyMetricPageviews,
+		}},
+		{"spike", bucket(400, 800, 0.4, 30000), []model.AnomalyMetric{
+			model.AnomalyMetricVisitors,
+			model.AnomalyMetricPageviews,
+		}},
+		{"bounces", bucket(100, 200, 0.9, 5000), []model.AnomalyMetric{
+			model.AnomalyMetricBounceRate,
+			model.AnomalyMetricDuration,
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			anomalies := anomaly.Detect(tc.current, baseline, anomaly.DefaultThreshold)
+
+			var metrics []model.AnomalyMetric
+			for _, a := range anomalies {
+				metrics = append(metrics, a.Metric)
+			}
+
+			assert.Equal(t, tc.expected, metrics)
+		})
+	}
+}
+
+func TestDetectScore(t *testing.T) {
+	anomalies := anomaly.Detect(bucket(0, 0, 0, 0), []*model.StatsIntervals{
+		bucket(100, 100, 0, 0),
+		bucket(120, 120, 0, 0),
+		bucket(80, 80, 0, 0),
+	}, anomaly.DefaultThreshold)
+
+	assert.Len(t, anomalies, 2)
+	assert.Equal(t, model.StatsAnomaly{
+		Metric:   model.AnomalyMetricVisitors,
+		Value:    0,
+		Expected: 100,
+		Score:    -3.37,
+	}, anomalies[0])
+}
+
+func TestDetectQuiet(t *testing.T) {
+	// A few visitors in a usually empty bucket are noise.
+	baseline := []*model.StatsIntervals{bucket(0, 0, 0, 0), bucket(1, 1, 0, 0), bucket(0, 0, 0, 0)}
+	assert.Empty(t, anomaly.Detect(bucket(5, 6, 0, 0), baseline, anomaly.DefaultThreshold))
+	assert.Len(t, anomaly.Detect(bucket(20, 20, 0, 0), baseline, anomaly.DefaultThreshold), 2)
+
+	// Metrics need enough seasons to be scored.
+	assert.Empty(t, anomaly.Detect(bucket(500, 500, 0, 0), []*model.StatsIntervals{bucket(1, 1, 0, 0), nil}, anomaly.DefaultThreshold))
+}
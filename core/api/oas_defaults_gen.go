@@ -42,6 +42,14 @@ func (s *NotFoundErrorError) setDefaults() {
 	}
 }
 
+// setDefaults set default value of fields.
+func (s *TooManyRequestsErrorError) setDefaults() {
+	{
+		val := int32(429)
+		s.Code = val
+	}
+}
+
 // setDefaults set default value of fields.
 func (s *UnauthorisedErrorError) setDefaults() {
 	{
@@ -57,3 +65,15 @@ func (s *UserSettings) setDefaults() {
 		s.Language.SetTo(val)
 	}
 }
+
+// setDefaults set default value of fields.
+func (s *WebsitePatch) setDefaults() {
+	{
+		val := bool(false)
+		s.Merge.SetTo(val)
+	}
+	{
+		val := bool(false)
+		s.MergeAccess.SetTo(val)
+	}
+}
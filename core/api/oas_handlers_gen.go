@@ -28,12 +28,12 @@ func (c *codeRecorder) Unwrap() http.ResponseWriter {
 
 func recordError(string, error) {}
 
-// handleDeleteUserRequest handles delete-user operation.
+// handleDeleteAPIKeysIDRequest handles delete-api-keys-id operation.
 //
-// Delete a user account.
+// Revoke an API key. Requests using the key are rejected immediately.
 //
-// DELETE /user
-func (s *Server) handleDeleteUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /api-keys/{keyId}
+func (s *Server) handleDeleteAPIKeysIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -41,15 +41,15 @@ func (s *Server) handleDeleteUserRequest(args [0]string, argsEscaped bool, w htt
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteUserOperation,
-			ID:   "delete-user",
+			Name: DeleteAPIKeysIDOperation,
+			ID:   "delete-api-keys-id",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, DeleteUserOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, DeleteAPIKeysIDOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -89,7 +89,7 @@ func (s *Server) handleDeleteUserRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeDeleteUserParams(args, argsEscaped, r)
+	params, err := decodeDeleteAPIKeysIDParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -102,13 +102,13 @@ func (s *Server) handleDeleteUserRequest(args [0]string, argsEscaped bool, w htt
 
 	var rawBody []byte
 
-	var response DeleteUserRes
+	var response DeleteAPIKeysIDRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteUserOperation,
-			OperationSummary: "Delete User",
-			OperationID:      "delete-user",
+			OperationName:    DeleteAPIKeysIDOperation,
+			OperationSummary: "Revoke API Key",
+			OperationID:      "delete-api-keys-id",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -116,14 +116,18 @@ func (s *Server) handleDeleteUserRequest(args [0]string, argsEscaped bool, w htt
 					Name: "_me_sess",
 					In:   "cookie",
 				}: params.MeSess,
+				{
+					Name: "keyId",
+					In:   "path",
+				}: params.KeyId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DeleteUserParams
-			Response = DeleteUserRes
+			Params   = DeleteAPIKeysIDParams
+			Response = DeleteAPIKeysIDRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -132,14 +136,14 @@ func (s *Server) handleDeleteUserRequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackDeleteUserParams,
+			unpackDeleteAPIKeysIDParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteUser(ctx, params)
+				response, err = s.h.DeleteAPIKeysID(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteUser(ctx, params)
+		response, err = s.h.DeleteAPIKeysID(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -147,7 +151,7 @@ func (s *Server) handleDeleteUserRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeDeleteUserResponse(response, w); err != nil {
+	if err := encodeDeleteAPIKeysIDResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -156,12 +160,13 @@ func (s *Server) handleDeleteUserRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleDeleteWebsitesIDRequest handles delete-websites-id operation.
+// handleDeleteAuthLockoutsRequest handles delete-auth-lockouts operation.
 //
-// Delete a website.
+// Clear the lockout and failed logins of a username or IP address, e.g. for a user locked out of
+// their account. Only owners and admins can clear lockouts.
 //
-// DELETE /websites/{hostname}
-func (s *Server) handleDeleteWebsitesIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /auth/lockouts
+func (s *Server) handleDeleteAuthLockoutsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -169,15 +174,15 @@ func (s *Server) handleDeleteWebsitesIDRequest(args [1]string, argsEscaped bool,
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteWebsitesIDOperation,
-			ID:   "delete-websites-id",
+			Name: DeleteAuthLockoutsOperation,
+			ID:   "delete-auth-lockouts",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, DeleteWebsitesIDOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, DeleteAuthLockoutsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -217,7 +222,7 @@ func (s *Server) handleDeleteWebsitesIDRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeDeleteWebsitesIDParams(args, argsEscaped, r)
+	params, err := decodeDeleteAuthLockoutsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -230,13 +235,13 @@ func (s *Server) handleDeleteWebsitesIDRequest(args [1]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response DeleteWebsitesIDRes
+	var response DeleteAuthLockoutsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteWebsitesIDOperation,
-			OperationSummary: "Delete Website",
-			OperationID:      "delete-websites-id",
+			OperationName:    DeleteAuthLockoutsOperation,
+			OperationSummary: "Clear Login Lockout",
+			OperationID:      "delete-auth-lockouts",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -245,17 +250,21 @@ func (s *Server) handleDeleteWebsitesIDRequest(args [1]string, argsEscaped bool,
 					In:   "cookie",
 				}: params.MeSess,
 				{
-					Name: "hostname",
-					In:   "path",
-				}: params.Hostname,
+					Name: "username",
+					In:   "query",
+				}: params.Username,
+				{
+					Name: "ip",
+					In:   "query",
+				}: params.IP,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DeleteWebsitesIDParams
-			Response = DeleteWebsitesIDRes
+			Params   = DeleteAuthLockoutsParams
+			Response = DeleteAuthLockoutsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -264,14 +273,14 @@ func (s *Server) handleDeleteWebsitesIDRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackDeleteWebsitesIDParams,
+			unpackDeleteAuthLockoutsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteWebsitesID(ctx, params)
+				response, err = s.h.DeleteAuthLockouts(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteWebsitesID(ctx, params)
+		response, err = s.h.DeleteAuthLockouts(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -279,7 +288,7 @@ func (s *Server) handleDeleteWebsitesIDRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeDeleteWebsitesIDResponse(response, w); err != nil {
+	if err := encodeDeleteAuthLockoutsResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -288,12 +297,12 @@ func (s *Server) handleDeleteWebsitesIDRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetEventPingRequest handles get-event-ping operation.
+// handleDeleteReportsIDRequest handles delete-reports-id operation.
 //
-// Ping endpoint to determine if the user is unique or not.
+// Unsubscribe from an email report.
 //
-// GET /event/ping
-func (s *Server) handleGetEventPingRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /reports/{reportId}
+func (s *Server) handleDeleteReportsIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -301,11 +310,55 @@ func (s *Server) handleGetEventPingRequest(args [0]string, argsEscaped bool, w h
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetEventPingOperation,
-			ID:   "get-event-ping",
+			Name: DeleteReportsIDOperation,
+			ID:   "delete-reports-id",
 		}
 	)
-	params, err := decodeGetEventPingParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, DeleteReportsIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteReportsIDParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -318,32 +371,32 @@ func (s *Server) handleGetEventPingRequest(args [0]string, argsEscaped bool, w h
 
 	var rawBody []byte
 
-	var response GetEventPingRes
+	var response DeleteReportsIDRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetEventPingOperation,
-			OperationSummary: "Ping",
-			OperationID:      "get-event-ping",
+			OperationName:    DeleteReportsIDOperation,
+			OperationSummary: "Delete Email Report",
+			OperationID:      "delete-reports-id",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "If-Modified-Since",
-					In:   "header",
-				}: params.IfModifiedSince,
+					Name: "_me_sess",
+					In:   "cookie",
+				}: params.MeSess,
 				{
-					Name: "u",
-					In:   "query",
-				}: params.U,
+					Name: "reportId",
+					In:   "path",
+				}: params.ReportId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetEventPingParams
-			Response = GetEventPingRes
+			Params   = DeleteReportsIDParams
+			Response = DeleteReportsIDRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -352,14 +405,14 @@ func (s *Server) handleGetEventPingRequest(args [0]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackGetEventPingParams,
+			unpackDeleteReportsIDParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetEventPing(ctx, params)
+				response, err = s.h.DeleteReportsID(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetEventPing(ctx, params)
+		response, err = s.h.DeleteReportsID(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -367,7 +420,7 @@ func (s *Server) handleGetEventPingRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeGetEventPingResponse(response, w); err != nil {
+	if err := encodeDeleteReportsIDResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -376,12 +429,12 @@ func (s *Server) handleGetEventPingRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handleGetTenantSettingsRequest handles get-tenant-settings operation.
+// handleDeleteUserRequest handles delete-user operation.
 //
-// Get a list of all tenant settings.
+// Delete a user account.
 //
-// GET /tenant/settings
-func (s *Server) handleGetTenantSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /user
+func (s *Server) handleDeleteUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -389,15 +442,15 @@ func (s *Server) handleGetTenantSettingsRequest(args [0]string, argsEscaped bool
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetTenantSettingsOperation,
-			ID:   "get-tenant-settings",
+			Name: DeleteUserOperation,
+			ID:   "delete-user",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetTenantSettingsOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, DeleteUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -437,7 +490,7 @@ func (s *Server) handleGetTenantSettingsRequest(args [0]string, argsEscaped bool
 			return
 		}
 	}
-	params, err := decodeGetTenantSettingsParams(args, argsEscaped, r)
+	params, err := decodeDeleteUserParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -450,13 +503,13 @@ func (s *Server) handleGetTenantSettingsRequest(args [0]string, argsEscaped bool
 
 	var rawBody []byte
 
-	var response GetTenantSettingsRes
+	var response DeleteUserRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetTenantSettingsOperation,
-			OperationSummary: "List Tenant Settings",
-			OperationID:      "get-tenant-settings",
+			OperationName:    DeleteUserOperation,
+			OperationSummary: "Delete User",
+			OperationID:      "delete-user",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -470,8 +523,8 @@ func (s *Server) handleGetTenantSettingsRequest(args [0]string, argsEscaped bool
 
 		type (
 			Request  = struct{}
-			Params   = GetTenantSettingsParams
-			Response = GetTenantSettingsRes
+			Params   = DeleteUserParams
+			Response = DeleteUserRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -480,14 +533,14 @@ func (s *Server) handleGetTenantSettingsRequest(args [0]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackGetTenantSettingsParams,
+			unpackDeleteUserParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTenantSettings(ctx, params)
+				response, err = s.h.DeleteUser(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetTenantSettings(ctx, params)
+		response, err = s.h.DeleteUser(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -495,7 +548,7 @@ func (s *Server) handleGetTenantSettingsRequest(args [0]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeGetTenantSettingsResponse(response, w); err != nil {
+	if err := encodeDeleteUserResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -504,12 +557,12 @@ func (s *Server) handleGetTenantSettingsRequest(args [0]string, argsEscaped bool
 	}
 }
 
-// handleGetUserRequest handles get-user operation.
+// handleDeleteUserSessionsRequest handles delete-user-sessions operation.
 //
-// Retrieve the information of the user with the matching user ID.
+// Revoke all sessions of the user, including the current session.
 //
-// GET /user
-func (s *Server) handleGetUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /user/sessions
+func (s *Server) handleDeleteUserSessionsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -517,15 +570,15 @@ func (s *Server) handleGetUserRequest(args [0]string, argsEscaped bool, w http.R
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetUserOperation,
-			ID:   "get-user",
+			Name: DeleteUserSessionsOperation,
+			ID:   "delete-user-sessions",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetUserOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, DeleteUserSessionsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -565,7 +618,7 @@ func (s *Server) handleGetUserRequest(args [0]string, argsEscaped bool, w http.R
 			return
 		}
 	}
-	params, err := decodeGetUserParams(args, argsEscaped, r)
+	params, err := decodeDeleteUserSessionsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -578,13 +631,13 @@ func (s *Server) handleGetUserRequest(args [0]string, argsEscaped bool, w http.R
 
 	var rawBody []byte
 
-	var response GetUserRes
+	var response DeleteUserSessionsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUserOperation,
-			OperationSummary: "Get User Info",
-			OperationID:      "get-user",
+			OperationName:    DeleteUserSessionsOperation,
+			OperationSummary: "Sign Out All Sessions",
+			OperationID:      "delete-user-sessions",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -598,8 +651,8 @@ func (s *Server) handleGetUserRequest(args [0]string, argsEscaped bool, w http.R
 
 		type (
 			Request  = struct{}
-			Params   = GetUserParams
-			Response = GetUserRes
+			Params   = DeleteUserSessionsParams
+			Response = DeleteUserSessionsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -608,14 +661,14 @@ func (s *Server) handleGetUserRequest(args [0]string, argsEscaped bool, w http.R
 		](
 			m,
 			mreq,
-			unpackGetUserParams,
+			unpackDeleteUserSessionsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUser(ctx, params)
+				response, err = s.h.DeleteUserSessions(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUser(ctx, params)
+		response, err = s.h.DeleteUserSessions(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -623,7 +676,7 @@ func (s *Server) handleGetUserRequest(args [0]string, argsEscaped bool, w http.R
 		return
 	}
 
-	if err := encodeGetUserResponse(response, w); err != nil {
+	if err := encodeDeleteUserSessionsResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -632,12 +685,13 @@ func (s *Server) handleGetUserRequest(args [0]string, argsEscaped bool, w http.R
 	}
 }
 
-// handleGetUserUsageRequest handles get-user-usage operation.
+// handleDeleteUsersIDRequest handles delete-users-id operation.
 //
-// Get the current CPU, memory and disk usage of the server.
+// Delete a user. Websites added by the user are transferred to the owner. The owner can not be
+// deleted. Only owners and admins can delete users.
 //
-// GET /user/usage
-func (s *Server) handleGetUserUsageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /users/{userId}
+func (s *Server) handleDeleteUsersIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -645,15 +699,15 @@ func (s *Server) handleGetUserUsageRequest(args [0]string, argsEscaped bool, w h
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetUserUsageOperation,
-			ID:   "get-user-usage",
+			Name: DeleteUsersIDOperation,
+			ID:   "delete-users-id",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetUserUsageOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, DeleteUsersIDOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -693,7 +747,7 @@ func (s *Server) handleGetUserUsageRequest(args [0]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodeGetUserUsageParams(args, argsEscaped, r)
+	params, err := decodeDeleteUsersIDParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -706,13 +760,13 @@ func (s *Server) handleGetUserUsageRequest(args [0]string, argsEscaped bool, w h
 
 	var rawBody []byte
 
-	var response GetUserUsageRes
+	var response DeleteUsersIDRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUserUsageOperation,
-			OperationSummary: "Get Resource Usage",
-			OperationID:      "get-user-usage",
+			OperationName:    DeleteUsersIDOperation,
+			OperationSummary: "Delete User",
+			OperationID:      "delete-users-id",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -720,14 +774,18 @@ func (s *Server) handleGetUserUsageRequest(args [0]string, argsEscaped bool, w h
 					Name: "_me_sess",
 					In:   "cookie",
 				}: params.MeSess,
+				{
+					Name: "userId",
+					In:   "path",
+				}: params.UserId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetUserUsageParams
-			Response = GetUserUsageRes
+			Params   = DeleteUsersIDParams
+			Response = DeleteUsersIDRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -736,14 +794,14 @@ func (s *Server) handleGetUserUsageRequest(args [0]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackGetUserUsageParams,
+			unpackDeleteUsersIDParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUserUsage(ctx, params)
+				response, err = s.h.DeleteUsersID(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUserUsage(ctx, params)
+		response, err = s.h.DeleteUsersID(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -751,7 +809,7 @@ func (s *Server) handleGetUserUsageRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeGetUserUsageResponse(response, w); err != nil {
+	if err := encodeDeleteUsersIDResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -760,12 +818,14 @@ func (s *Server) handleGetUserUsageRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handleGetWebsiteIDBrowsersRequest handles get-website-id-browsers operation.
+// handleDeleteWebsitesIDRequest handles delete-websites-id operation.
 //
-// Get a list of browsers and their stats.
+// Delete a website. The website is removed immediately and its page views and custom events are
+// purged in the background. The progress of the purge can be followed using the website deletion
+// endpoint.
 //
-// GET /website/{hostname}/browsers
-func (s *Server) handleGetWebsiteIDBrowsersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /websites/{hostname}
+func (s *Server) handleDeleteWebsitesIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -773,15 +833,15 @@ func (s *Server) handleGetWebsiteIDBrowsersRequest(args [1]string, argsEscaped b
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetWebsiteIDBrowsersOperation,
-			ID:   "get-website-id-browsers",
+			Name: DeleteWebsitesIDOperation,
+			ID:   "delete-websites-id",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDBrowsersOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, DeleteWebsitesIDOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -797,11 +857,29 @@ func (s *Server) handleGetWebsiteIDBrowsersRequest(args [1]string, argsEscaped b
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteWebsitesIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -821,7 +899,7 @@ func (s *Server) handleGetWebsiteIDBrowsersRequest(args [1]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeGetWebsiteIDBrowsersParams(args, argsEscaped, r)
+	params, err := decodeDeleteWebsitesIDParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -834,100 +912,32 @@ func (s *Server) handleGetWebsiteIDBrowsersRequest(args [1]string, argsEscaped b
 
 	var rawBody []byte
 
-	var response GetWebsiteIDBrowsersRes
+	var response DeleteWebsitesIDRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWebsiteIDBrowsersOperation,
-			OperationSummary: "Get Browser Stats",
-			OperationID:      "get-website-id-browsers",
+			OperationName:    DeleteWebsitesIDOperation,
+			OperationSummary: "Delete Website",
+			OperationID:      "delete-websites-id",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
-				{
-					Name: "_me_sess",
-					In:   "cookie",
-				}: params.MeSess,
 				{
 					Name: "hostname",
 					In:   "path",
 				}: params.Hostname,
 				{
-					Name: "summary",
-					In:   "query",
-				}: params.Summary,
-				{
-					Name: "start",
-					In:   "query",
-				}: params.Start,
-				{
-					Name: "end",
-					In:   "query",
-				}: params.End,
-				{
-					Name: "path",
-					In:   "query",
-				}: params.Path,
-				{
-					Name: "referrer",
-					In:   "query",
-				}: params.Referrer,
-				{
-					Name: "utm_source",
-					In:   "query",
-				}: params.UtmSource,
-				{
-					Name: "utm_medium",
-					In:   "query",
-				}: params.UtmMedium,
-				{
-					Name: "utm_campaign",
+					Name: "dryRun",
 					In:   "query",
-				}: params.UtmCampaign,
-				{
-					Name: "browser",
-					In:   "query",
-				}: params.Browser,
-				{
-					Name: "os",
-					In:   "query",
-				}: params.Os,
-				{
-					Name: "device",
-					In:   "query",
-				}: params.Device,
-				{
-					Name: "country",
-					In:   "query",
-				}: params.Country,
-				{
-					Name: "language",
-					In:   "query",
-				}: params.Language,
-				{
-					Name: "prop_name",
-					In:   "query",
-				}: params.PropName,
-				{
-					Name: "prop_value",
-					In:   "query",
-				}: params.PropValue,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
+				}: params.DryRun,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetWebsiteIDBrowsersParams
-			Response = GetWebsiteIDBrowsersRes
+			Params   = DeleteWebsitesIDParams
+			Response = DeleteWebsitesIDRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -936,14 +946,14 @@ func (s *Server) handleGetWebsiteIDBrowsersRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackGetWebsiteIDBrowsersParams,
+			unpackDeleteWebsitesIDParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWebsiteIDBrowsers(ctx, params)
+				response, err = s.h.DeleteWebsitesID(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWebsiteIDBrowsers(ctx, params)
+		response, err = s.h.DeleteWebsitesID(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -951,7 +961,7 @@ func (s *Server) handleGetWebsiteIDBrowsersRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetWebsiteIDBrowsersResponse(response, w); err != nil {
+	if err := encodeDeleteWebsitesIDResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -960,12 +970,12 @@ func (s *Server) handleGetWebsiteIDBrowsersRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleGetWebsiteIDCampaignsRequest handles get-website-id-campaigns operation.
+// handleDeleteWebsitesIDGoalsIDRequest handles delete-websites-id-goals-id operation.
 //
-// Get a list of UTM campaigns and their stats.
+// Delete a conversion goal.
 //
-// GET /website/{hostname}/campaigns
-func (s *Server) handleGetWebsiteIDCampaignsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /websites/{hostname}/goals/{goalId}
+func (s *Server) handleDeleteWebsitesIDGoalsIDRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -973,15 +983,15 @@ func (s *Server) handleGetWebsiteIDCampaignsRequest(args [1]string, argsEscaped
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetWebsiteIDCampaignsOperation,
-			ID:   "get-website-id-campaigns",
+			Name: DeleteWebsitesIDGoalsIDOperation,
+			ID:   "delete-websites-id-goals-id",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDCampaignsOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, DeleteWebsitesIDGoalsIDOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -997,11 +1007,29 @@ func (s *Server) handleGetWebsiteIDCampaignsRequest(args [1]string, argsEscaped
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteWebsitesIDGoalsIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -1021,7 +1049,7 @@ func (s *Server) handleGetWebsiteIDCampaignsRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeGetWebsiteIDCampaignsParams(args, argsEscaped, r)
+	params, err := decodeDeleteWebsitesIDGoalsIDParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1034,100 +1062,32 @@ func (s *Server) handleGetWebsiteIDCampaignsRequest(args [1]string, argsEscaped
 
 	var rawBody []byte
 
-	var response GetWebsiteIDCampaignsRes
+	var response DeleteWebsitesIDGoalsIDRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWebsiteIDCampaignsOperation,
-			OperationSummary: "Get UTM Campaign Stats",
-			OperationID:      "get-website-id-campaigns",
+			OperationName:    DeleteWebsitesIDGoalsIDOperation,
+			OperationSummary: "Delete Goal",
+			OperationID:      "delete-websites-id-goals-id",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
-				{
-					Name: "_me_sess",
-					In:   "cookie",
-				}: params.MeSess,
 				{
 					Name: "hostname",
 					In:   "path",
 				}: params.Hostname,
 				{
-					Name: "summary",
-					In:   "query",
-				}: params.Summary,
-				{
-					Name: "start",
-					In:   "query",
-				}: params.Start,
-				{
-					Name: "end",
-					In:   "query",
-				}: params.End,
-				{
-					Name: "path",
-					In:   "query",
-				}: params.Path,
-				{
-					Name: "referrer",
-					In:   "query",
-				}: params.Referrer,
-				{
-					Name: "utm_source",
-					In:   "query",
-				}: params.UtmSource,
-				{
-					Name: "utm_medium",
-					In:   "query",
-				}: params.UtmMedium,
-				{
-					Name: "utm_campaign",
-					In:   "query",
-				}: params.UtmCampaign,
-				{
-					Name: "browser",
-					In:   "query",
-				}: params.Browser,
-				{
-					Name: "os",
-					In:   "query",
-				}: params.Os,
-				{
-					Name: "device",
-					In:   "query",
-				}: params.Device,
-				{
-					Name: "country",
-					In:   "query",
-				}: params.Country,
-				{
-					Name: "language",
-					In:   "query",
-				}: params.Language,
-				{
-					Name: "prop_name",
-					In:   "query",
-				}: params.PropName,
-				{
-					Name: "prop_value",
-					In:   "query",
-				}: params.PropValue,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
+					Name: "goalId",
+					In:   "path",
+				}: params.GoalId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetWebsiteIDCampaignsParams
-			Response = GetWebsiteIDCampaignsRes
+			Params   = DeleteWebsitesIDGoalsIDParams
+			Response = DeleteWebsitesIDGoalsIDRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1136,14 +1096,14 @@ func (s *Server) handleGetWebsiteIDCampaignsRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackGetWebsiteIDCampaignsParams,
+			unpackDeleteWebsitesIDGoalsIDParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWebsiteIDCampaigns(ctx, params)
+				response, err = s.h.DeleteWebsitesIDGoalsID(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWebsiteIDCampaigns(ctx, params)
+		response, err = s.h.DeleteWebsitesIDGoalsID(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1151,7 +1111,7 @@ func (s *Server) handleGetWebsiteIDCampaignsRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetWebsiteIDCampaignsResponse(response, w); err != nil {
+	if err := encodeDeleteWebsitesIDGoalsIDResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1160,12 +1120,12 @@ func (s *Server) handleGetWebsiteIDCampaignsRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleGetWebsiteIDCountryRequest handles get-website-id-country operation.
+// handleDeleteWebsitesIDWebhooksIDRequest handles delete-websites-id-webhooks-id operation.
 //
-// Get a list of countries and their stats.
+// Delete a webhook and its delivery log. Deliveries in progress are not retried.
 //
-// GET /website/{hostname}/countries
-func (s *Server) handleGetWebsiteIDCountryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /websites/{hostname}/webhooks/{webhookId}
+func (s *Server) handleDeleteWebsitesIDWebhooksIDRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1173,15 +1133,15 @@ func (s *Server) handleGetWebsiteIDCountryRequest(args [1]string, argsEscaped bo
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetWebsiteIDCountryOperation,
-			ID:   "get-website-id-country",
+			Name: DeleteWebsitesIDWebhooksIDOperation,
+			ID:   "delete-websites-id-webhooks-id",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDCountryOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, DeleteWebsitesIDWebhooksIDOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1197,11 +1157,29 @@ func (s *Server) handleGetWebsiteIDCountryRequest(args [1]string, argsEscaped bo
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteWebsitesIDWebhooksIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -1221,7 +1199,7 @@ func (s *Server) handleGetWebsiteIDCountryRequest(args [1]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeGetWebsiteIDCountryParams(args, argsEscaped, r)
+	params, err := decodeDeleteWebsitesIDWebhooksIDParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1234,100 +1212,32 @@ func (s *Server) handleGetWebsiteIDCountryRequest(args [1]string, argsEscaped bo
 
 	var rawBody []byte
 
-	var response GetWebsiteIDCountryRes
+	var response DeleteWebsitesIDWebhooksIDRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWebsiteIDCountryOperation,
-			OperationSummary: "Get Country Stats",
-			OperationID:      "get-website-id-country",
+			OperationName:    DeleteWebsitesIDWebhooksIDOperation,
+			OperationSummary: "Delete Webhook",
+			OperationID:      "delete-websites-id-webhooks-id",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
-				{
-					Name: "_me_sess",
-					In:   "cookie",
-				}: params.MeSess,
 				{
 					Name: "hostname",
 					In:   "path",
 				}: params.Hostname,
 				{
-					Name: "summary",
-					In:   "query",
-				}: params.Summary,
-				{
-					Name: "start",
-					In:   "query",
-				}: params.Start,
-				{
-					Name: "end",
-					In:   "query",
-				}: params.End,
-				{
-					Name: "path",
-					In:   "query",
-				}: params.Path,
-				{
-					Name: "referrer",
-					In:   "query",
-				}: params.Referrer,
-				{
-					Name: "utm_source",
-					In:   "query",
-				}: params.UtmSource,
-				{
-					Name: "utm_medium",
-					In:   "query",
-				}: params.UtmMedium,
-				{
-					Name: "utm_campaign",
-					In:   "query",
-				}: params.UtmCampaign,
-				{
-					Name: "browser",
-					In:   "query",
-				}: params.Browser,
-				{
-					Name: "os",
-					In:   "query",
-				}: params.Os,
-				{
-					Name: "device",
-					In:   "query",
-				}: params.Device,
-				{
-					Name: "country",
-					In:   "query",
-				}: params.Country,
-				{
-					Name: "language",
-					In:   "query",
-				}: params.Language,
-				{
-					Name: "prop_name",
-					In:   "query",
-				}: params.PropName,
-				{
-					Name: "prop_value",
-					In:   "query",
-				}: params.PropValue,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
+					Name: "webhookId",
+					In:   "path",
+				}: params.WebhookId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetWebsiteIDCountryParams
-			Response = GetWebsiteIDCountryRes
+			Params   = DeleteWebsitesIDWebhooksIDParams
+			Response = DeleteWebsitesIDWebhooksIDRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1336,14 +1246,14 @@ func (s *Server) handleGetWebsiteIDCountryRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetWebsiteIDCountryParams,
+			unpackDeleteWebsitesIDWebhooksIDParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWebsiteIDCountry(ctx, params)
+				response, err = s.h.DeleteWebsitesIDWebhooksID(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWebsiteIDCountry(ctx, params)
+		response, err = s.h.DeleteWebsitesIDWebhooksID(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1351,7 +1261,7 @@ func (s *Server) handleGetWebsiteIDCountryRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetWebsiteIDCountryResponse(response, w); err != nil {
+	if err := encodeDeleteWebsitesIDWebhooksIDResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1360,12 +1270,12 @@ func (s *Server) handleGetWebsiteIDCountryRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleGetWebsiteIDDeviceRequest handles get-website-id-device operation.
+// handleGetAPIKeysRequest handles get-api-keys operation.
 //
-// Get a list of devices and their stats.
+// Get a list of the API keys of the user. The keys themselves are only returned once when created.
 //
-// GET /website/{hostname}/devices
-func (s *Server) handleGetWebsiteIDDeviceRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api-keys
+func (s *Server) handleGetAPIKeysRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1373,15 +1283,15 @@ func (s *Server) handleGetWebsiteIDDeviceRequest(args [1]string, argsEscaped boo
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetWebsiteIDDeviceOperation,
-			ID:   "get-website-id-device",
+			Name: GetAPIKeysOperation,
+			ID:   "get-api-keys",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDDeviceOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, GetAPIKeysOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1421,7 +1331,7 @@ func (s *Server) handleGetWebsiteIDDeviceRequest(args [1]string, argsEscaped boo
 			return
 		}
 	}
-	params, err := decodeGetWebsiteIDDeviceParams(args, argsEscaped, r)
+	params, err := decodeGetAPIKeysParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1434,13 +1344,13 @@ func (s *Server) handleGetWebsiteIDDeviceRequest(args [1]string, argsEscaped boo
 
 	var rawBody []byte
 
-	var response GetWebsiteIDDeviceRes
+	var response GetAPIKeysRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWebsiteIDDeviceOperation,
-			OperationSummary: "Get Device Stats",
-			OperationID:      "get-website-id-device",
+			OperationName:    GetAPIKeysOperation,
+			OperationSummary: "List API Keys",
+			OperationID:      "get-api-keys",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -1448,86 +1358,14 @@ func (s *Server) handleGetWebsiteIDDeviceRequest(args [1]string, argsEscaped boo
 					Name: "_me_sess",
 					In:   "cookie",
 				}: params.MeSess,
-				{
-					Name: "hostname",
-					In:   "path",
-				}: params.Hostname,
-				{
-					Name: "summary",
-					In:   "query",
-				}: params.Summary,
-				{
-					Name: "start",
-					In:   "query",
-				}: params.Start,
-				{
-					Name: "end",
-					In:   "query",
-				}: params.End,
-				{
-					Name: "path",
-					In:   "query",
-				}: params.Path,
-				{
-					Name: "referrer",
-					In:   "query",
-				}: params.Referrer,
-				{
-					Name: "utm_source",
-					In:   "query",
-				}: params.UtmSource,
-				{
-					Name: "utm_medium",
-					In:   "query",
-				}: params.UtmMedium,
-				{
-					Name: "utm_campaign",
-					In:   "query",
-				}: params.UtmCampaign,
-				{
-					Name: "browser",
-					In:   "query",
-				}: params.Browser,
-				{
-					Name: "os",
-					In:   "query",
-				}: params.Os,
-				{
-					Name: "device",
-					In:   "query",
-				}: params.Device,
-				{
-					Name: "country",
-					In:   "query",
-				}: params.Country,
-				{
-					Name: "language",
-					In:   "query",
-				}: params.Language,
-				{
-					Name: "prop_name",
-					In:   "query",
-				}: params.PropName,
-				{
-					Name: "prop_value",
-					In:   "query",
-				}: params.PropValue,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetWebsiteIDDeviceParams
-			Response = GetWebsiteIDDeviceRes
+			Params   = GetAPIKeysParams
+			Response = GetAPIKeysRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1536,14 +1374,14 @@ func (s *Server) handleGetWebsiteIDDeviceRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackGetWebsiteIDDeviceParams,
+			unpackGetAPIKeysParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWebsiteIDDevice(ctx, params)
+				response, err = s.h.GetAPIKeys(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWebsiteIDDevice(ctx, params)
+		response, err = s.h.GetAPIKeys(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1551,7 +1389,7 @@ func (s *Server) handleGetWebsiteIDDeviceRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeGetWebsiteIDDeviceResponse(response, w); err != nil {
+	if err := encodeGetAPIKeysResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1560,12 +1398,13 @@ func (s *Server) handleGetWebsiteIDDeviceRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleGetWebsiteIDLanguageRequest handles get-website-id-language operation.
+// handleGetAuditRequest handles get-audit operation.
 //
-// Get a list of languages and their stats.
+// List the administrative actions performed on the server, newest first. Only owners and admins can
+// read the audit log.
 //
-// GET /website/{hostname}/languages
-func (s *Server) handleGetWebsiteIDLanguageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /audit
+func (s *Server) handleGetAuditRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1573,15 +1412,15 @@ func (s *Server) handleGetWebsiteIDLanguageRequest(args [1]string, argsEscaped b
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetWebsiteIDLanguageOperation,
-			ID:   "get-website-id-language",
+			Name: GetAuditOperation,
+			ID:   "get-audit",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDLanguageOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, GetAuditOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1621,7 +1460,7 @@ func (s *Server) handleGetWebsiteIDLanguageRequest(args [1]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeGetWebsiteIDLanguageParams(args, argsEscaped, r)
+	params, err := decodeGetAuditParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1634,32 +1473,32 @@ func (s *Server) handleGetWebsiteIDLanguageRequest(args [1]string, argsEscaped b
 
 	var rawBody []byte
 
-	var response GetWebsiteIDLanguageRes
+	var response GetAuditRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWebsiteIDLanguageOperation,
-			OperationSummary: "Get Language Stats",
-			OperationID:      "get-website-id-language",
+			OperationName:    GetAuditOperation,
+			OperationSummary: "List Audit Log",
+			OperationID:      "get-audit",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
-				{
-					Name: "locale",
-					In:   "query",
-				}: params.Locale,
 				{
 					Name: "_me_sess",
 					In:   "cookie",
 				}: params.MeSess,
 				{
-					Name: "hostname",
-					In:   "path",
-				}: params.Hostname,
+					Name: "actor",
+					In:   "query",
+				}: params.Actor,
 				{
-					Name: "summary",
+					Name: "action",
 					In:   "query",
-				}: params.Summary,
+				}: params.Action,
+				{
+					Name: "target",
+					In:   "query",
+				}: params.Target,
 				{
 					Name: "start",
 					In:   "query",
@@ -1668,54 +1507,6 @@ func (s *Server) handleGetWebsiteIDLanguageRequest(args [1]string, argsEscaped b
 					Name: "end",
 					In:   "query",
 				}: params.End,
-				{
-					Name: "path",
-					In:   "query",
-				}: params.Path,
-				{
-					Name: "referrer",
-					In:   "query",
-				}: params.Referrer,
-				{
-					Name: "utm_source",
-					In:   "query",
-				}: params.UtmSource,
-				{
-					Name: "utm_medium",
-					In:   "query",
-				}: params.UtmMedium,
-				{
-					Name: "utm_campaign",
-					In:   "query",
-				}: params.UtmCampaign,
-				{
-					Name: "browser",
-					In:   "query",
-				}: params.Browser,
-				{
-					Name: "os",
-					In:   "query",
-				}: params.Os,
-				{
-					Name: "device",
-					In:   "query",
-				}: params.Device,
-				{
-					Name: "country",
-					In:   "query",
-				}: params.Country,
-				{
-					Name: "language",
-					In:   "query",
-				}: params.Language,
-				{
-					Name: "prop_name",
-					In:   "query",
-				}: params.PropName,
-				{
-					Name: "prop_value",
-					In:   "query",
-				}: params.PropValue,
 				{
 					Name: "limit",
 					In:   "query",
@@ -1730,8 +1521,8 @@ func (s *Server) handleGetWebsiteIDLanguageRequest(args [1]string, argsEscaped b
 
 		type (
 			Request  = struct{}
-			Params   = GetWebsiteIDLanguageParams
-			Response = GetWebsiteIDLanguageRes
+			Params   = GetAuditParams
+			Response = GetAuditRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1740,14 +1531,14 @@ func (s *Server) handleGetWebsiteIDLanguageRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackGetWebsiteIDLanguageParams,
+			unpackGetAuditParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWebsiteIDLanguage(ctx, params)
+				response, err = s.h.GetAudit(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWebsiteIDLanguage(ctx, params)
+		response, err = s.h.GetAudit(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1755,7 +1546,7 @@ func (s *Server) handleGetWebsiteIDLanguageRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetWebsiteIDLanguageResponse(response, w); err != nil {
+	if err := encodeGetAuditResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1764,12 +1555,13 @@ func (s *Server) handleGetWebsiteIDLanguageRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleGetWebsiteIDMediumsRequest handles get-website-id-mediums operation.
+// handleGetAuthLockoutsRequest handles get-auth-lockouts operation.
 //
-// Get a list of UTM mediums and their stats.
+// List the usernames and IP prefixes locked out after too many failed logins or invalid API keys.
+// Only owners and admins can list lockouts.
 //
-// GET /website/{hostname}/mediums
-func (s *Server) handleGetWebsiteIDMediumsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /auth/lockouts
+func (s *Server) handleGetAuthLockoutsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1777,15 +1569,15 @@ func (s *Server) handleGetWebsiteIDMediumsRequest(args [1]string, argsEscaped bo
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetWebsiteIDMediumsOperation,
-			ID:   "get-website-id-mediums",
+			Name: GetAuthLockoutsOperation,
+			ID:   "get-auth-lockouts",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDMediumsOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, GetAuthLockoutsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1825,7 +1617,7 @@ func (s *Server) handleGetWebsiteIDMediumsRequest(args [1]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeGetWebsiteIDMediumsParams(args, argsEscaped, r)
+	params, err := decodeGetAuthLockoutsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1838,13 +1630,13 @@ func (s *Server) handleGetWebsiteIDMediumsRequest(args [1]string, argsEscaped bo
 
 	var rawBody []byte
 
-	var response GetWebsiteIDMediumsRes
+	var response GetAuthLockoutsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWebsiteIDMediumsOperation,
-			OperationSummary: "Get UTM Medium Stats",
-			OperationID:      "get-website-id-mediums",
+			OperationName:    GetAuthLockoutsOperation,
+			OperationSummary: "List Login Lockouts",
+			OperationID:      "get-auth-lockouts",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -1852,86 +1644,14 @@ func (s *Server) handleGetWebsiteIDMediumsRequest(args [1]string, argsEscaped bo
 					Name: "_me_sess",
 					In:   "cookie",
 				}: params.MeSess,
-				{
-					Name: "hostname",
-					In:   "path",
-				}: params.Hostname,
-				{
-					Name: "summary",
-					In:   "query",
-				}: params.Summary,
-				{
-					Name: "start",
-					In:   "query",
-				}: params.Start,
-				{
-					Name: "end",
-					In:   "query",
-				}: params.End,
-				{
-					Name: "path",
-					In:   "query",
-				}: params.Path,
-				{
-					Name: "referrer",
-					In:   "query",
-				}: params.Referrer,
-				{
-					Name: "utm_source",
-					In:   "query",
-				}: params.UtmSource,
-				{
-					Name: "utm_medium",
-					In:   "query",
-				}: params.UtmMedium,
-				{
-					Name: "utm_campaign",
-					In:   "query",
-				}: params.UtmCampaign,
-				{
-					Name: "browser",
-					In:   "query",
-				}: params.Browser,
-				{
-					Name: "os",
-					In:   "query",
-				}: params.Os,
-				{
-					Name: "device",
-					In:   "query",
-				}: params.Device,
-				{
-					Name: "country",
-					In:   "query",
-				}: params.Country,
-				{
-					Name: "language",
-					In:   "query",
-				}: params.Language,
-				{
-					Name: "prop_name",
-					In:   "query",
-				}: params.PropName,
-				{
-					Name: "prop_value",
-					In:   "query",
-				}: params.PropValue,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetWebsiteIDMediumsParams
-			Response = GetWebsiteIDMediumsRes
+			Params   = GetAuthLockoutsParams
+			Response = GetAuthLockoutsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1940,14 +1660,14 @@ func (s *Server) handleGetWebsiteIDMediumsRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetWebsiteIDMediumsParams,
+			unpackGetAuthLockoutsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWebsiteIDMediums(ctx, params)
+				response, err = s.h.GetAuthLockouts(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWebsiteIDMediums(ctx, params)
+		response, err = s.h.GetAuthLockouts(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1955,7 +1675,7 @@ func (s *Server) handleGetWebsiteIDMediumsRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetWebsiteIDMediumsResponse(response, w); err != nil {
+	if err := encodeGetAuthLockoutsResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1964,68 +1684,90 @@ func (s *Server) handleGetWebsiteIDMediumsRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleGetWebsiteIDOsRequest handles get-website-id-os operation.
+// handleGetAuthMethodsRequest handles get-auth-methods operation.
 //
-// Get a list of OS and their stats.
+// Get the login methods enabled on the server.
 //
-// GET /website/{hostname}/os
-func (s *Server) handleGetWebsiteIDOsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /auth/methods
+func (s *Server) handleGetAuthMethodsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
 
 	var (
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: GetWebsiteIDOsOperation,
-			ID:   "get-website-id-os",
-		}
+		err error
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDOsOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
+
+	var rawBody []byte
+
+	var response GetAuthMethodsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetAuthMethodsOperation,
+			OperationSummary: "Get Login Methods",
+			OperationID:      "get-auth-methods",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetAuthMethodsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAuthMethods(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAuthMethods(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetAuthMethodsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
 		}
+		return
 	}
-	params, err := decodeGetWebsiteIDOsParams(args, argsEscaped, r)
+}
+
+// handleGetAuthOidcCallbackRequest handles get-auth-oidc-callback operation.
+//
+// Callback from the OpenID Connect identity provider. Users are created on their first login if
+// auto-provisioning is enabled.
+//
+// GET /auth/oidc/callback
+func (s *Server) handleGetAuthOidcCallbackRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetAuthOidcCallbackOperation,
+			ID:   "get-auth-oidc-callback",
+		}
+	)
+	params, err := decodeGetAuthOidcCallbackParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2038,100 +1780,44 @@ func (s *Server) handleGetWebsiteIDOsRequest(args [1]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response GetWebsiteIDOsRes
+	var response GetAuthOidcCallbackRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWebsiteIDOsOperation,
-			OperationSummary: "Get OS Stats",
-			OperationID:      "get-website-id-os",
+			OperationName:    GetAuthOidcCallbackOperation,
+			OperationSummary: "Complete Single Sign-On",
+			OperationID:      "get-auth-oidc-callback",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "_me_sess",
-					In:   "cookie",
-				}: params.MeSess,
-				{
-					Name: "hostname",
-					In:   "path",
-				}: params.Hostname,
-				{
-					Name: "summary",
-					In:   "query",
-				}: params.Summary,
-				{
-					Name: "start",
-					In:   "query",
-				}: params.Start,
-				{
-					Name: "end",
-					In:   "query",
-				}: params.End,
-				{
-					Name: "path",
-					In:   "query",
-				}: params.Path,
-				{
-					Name: "referrer",
-					In:   "query",
-				}: params.Referrer,
-				{
-					Name: "utm_source",
-					In:   "query",
-				}: params.UtmSource,
-				{
-					Name: "utm_medium",
-					In:   "query",
-				}: params.UtmMedium,
-				{
-					Name: "utm_campaign",
-					In:   "query",
-				}: params.UtmCampaign,
-				{
-					Name: "browser",
-					In:   "query",
-				}: params.Browser,
-				{
-					Name: "os",
-					In:   "query",
-				}: params.Os,
-				{
-					Name: "device",
-					In:   "query",
-				}: params.Device,
-				{
-					Name: "country",
-					In:   "query",
-				}: params.Country,
-				{
-					Name: "language",
+					Name: "code",
 					In:   "query",
-				}: params.Language,
+				}: params.Code,
 				{
-					Name: "prop_name",
+					Name: "state",
 					In:   "query",
-				}: params.PropName,
+				}: params.State,
 				{
-					Name: "prop_value",
+					Name: "error",
 					In:   "query",
-				}: params.PropValue,
+				}: params.Error,
 				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
+					Name: "_me_oidc",
+					In:   "cookie",
+				}: params.MeOidc,
 				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
+					Name: "User-Agent",
+					In:   "header",
+				}: params.UserAgent,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetWebsiteIDOsParams
-			Response = GetWebsiteIDOsRes
+			Params   = GetAuthOidcCallbackParams
+			Response = GetAuthOidcCallbackRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2140,14 +1826,14 @@ func (s *Server) handleGetWebsiteIDOsRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetWebsiteIDOsParams,
+			unpackGetAuthOidcCallbackParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWebsiteIDOs(ctx, params)
+				response, err = s.h.GetAuthOidcCallback(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWebsiteIDOs(ctx, params)
+		response, err = s.h.GetAuthOidcCallback(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2155,7 +1841,7 @@ func (s *Server) handleGetWebsiteIDOsRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetWebsiteIDOsResponse(response, w); err != nil {
+	if err := encodeGetAuthOidcCallbackResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2164,174 +1850,39 @@ func (s *Server) handleGetWebsiteIDOsRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleGetWebsiteIDPagesRequest handles get-website-id-pages operation.
+// handleGetAuthOidcLoginRequest handles get-auth-oidc-login operation.
 //
-// Get a list of pages and their stats.
+// Redirect to the OpenID Connect identity provider to log in.
 //
-// GET /website/{hostname}/pages
-func (s *Server) handleGetWebsiteIDPagesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /auth/oidc/login
+func (s *Server) handleGetAuthOidcLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
 
 	var (
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: GetWebsiteIDPagesOperation,
-			ID:   "get-website-id-pages",
-		}
+		err error
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDPagesOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeGetWebsiteIDPagesParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response GetWebsiteIDPagesRes
+	var response GetAuthOidcLoginRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWebsiteIDPagesOperation,
-			OperationSummary: "Get Page Stats",
-			OperationID:      "get-website-id-pages",
+			OperationName:    GetAuthOidcLoginOperation,
+			OperationSummary: "Start Single Sign-On",
+			OperationID:      "get-auth-oidc-login",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "_me_sess",
-					In:   "cookie",
-				}: params.MeSess,
-				{
-					Name: "hostname",
-					In:   "path",
-				}: params.Hostname,
-				{
-					Name: "summary",
-					In:   "query",
-				}: params.Summary,
-				{
-					Name: "start",
-					In:   "query",
-				}: params.Start,
-				{
-					Name: "end",
-					In:   "query",
-				}: params.End,
-				{
-					Name: "path",
-					In:   "query",
-				}: params.Path,
-				{
-					Name: "referrer",
-					In:   "query",
-				}: params.Referrer,
-				{
-					Name: "utm_source",
-					In:   "query",
-				}: params.UtmSource,
-				{
-					Name: "utm_medium",
-					In:   "query",
-				}: params.UtmMedium,
-				{
-					Name: "utm_campaign",
-					In:   "query",
-				}: params.UtmCampaign,
-				{
-					Name: "browser",
-					In:   "query",
-				}: params.Browser,
-				{
-					Name: "os",
-					In:   "query",
-				}: params.Os,
-				{
-					Name: "device",
-					In:   "query",
-				}: params.Device,
-				{
-					Name: "country",
-					In:   "query",
-				}: params.Country,
-				{
-					Name: "language",
-					In:   "query",
-				}: params.Language,
-				{
-					Name: "prop_name",
-					In:   "query",
-				}: params.PropName,
-				{
-					Name: "prop_value",
-					In:   "query",
-				}: params.PropValue,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetWebsiteIDPagesParams
-			Response = GetWebsiteIDPagesRes
+			Params   = struct{}
+			Response = GetAuthOidcLoginRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2340,14 +1891,14 @@ func (s *Server) handleGetWebsiteIDPagesRequest(args [1]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackGetWebsiteIDPagesParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWebsiteIDPages(ctx, params)
+				response, err = s.h.GetAuthOidcLogin(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWebsiteIDPages(ctx, params)
+		response, err = s.h.GetAuthOidcLogin(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2355,7 +1906,7 @@ func (s *Server) handleGetWebsiteIDPagesRequest(args [1]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeGetWebsiteIDPagesResponse(response, w); err != nil {
+	if err := encodeGetAuthOidcLoginResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2364,13 +1915,12 @@ func (s *Server) handleGetWebsiteIDPagesRequest(args [1]string, argsEscaped bool
 	}
 }
 
-// handleGetWebsiteIDPropertiesRequest handles get-website-id-properties operation.
+// handleGetEventPingRequest handles get-event-ping operation.
 //
-// Get a list of custom properties and their stats. If a property name is provided, it will return
-// the stats for that property instead.
+// Ping endpoint to determine if the user is unique or not.
 //
-// GET /website/{hostname}/properties
-func (s *Server) handleGetWebsiteIDPropertiesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /event/ping
+func (s *Server) handleGetEventPingRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -2378,55 +1928,11 @@ func (s *Server) handleGetWebsiteIDPropertiesRequest(args [1]string, argsEscaped
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetWebsiteIDPropertiesOperation,
-			ID:   "get-website-id-properties",
+			Name: GetEventPingOperation,
+			ID:   "get-event-ping",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDPropertiesOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeGetWebsiteIDPropertiesParams(args, argsEscaped, r)
+	params, err := decodeGetEventPingParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2439,96 +1945,32 @@ func (s *Server) handleGetWebsiteIDPropertiesRequest(args [1]string, argsEscaped
 
 	var rawBody []byte
 
-	var response GetWebsiteIDPropertiesRes
+	var response GetEventPingRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWebsiteIDPropertiesOperation,
-			OperationSummary: "Get Property Stats",
-			OperationID:      "get-website-id-properties",
+			OperationName:    GetEventPingOperation,
+			OperationSummary: "Ping",
+			OperationID:      "get-event-ping",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "_me_sess",
-					In:   "cookie",
-				}: params.MeSess,
-				{
-					Name: "hostname",
-					In:   "path",
-				}: params.Hostname,
-				{
-					Name: "start",
-					In:   "query",
-				}: params.Start,
-				{
-					Name: "end",
-					In:   "query",
-				}: params.End,
-				{
-					Name: "path",
-					In:   "query",
-				}: params.Path,
-				{
-					Name: "referrer",
-					In:   "query",
-				}: params.Referrer,
-				{
-					Name: "utm_source",
-					In:   "query",
-				}: params.UtmSource,
-				{
-					Name: "utm_medium",
-					In:   "query",
-				}: params.UtmMedium,
-				{
-					Name: "utm_campaign",
-					In:   "query",
-				}: params.UtmCampaign,
-				{
-					Name: "browser",
-					In:   "query",
-				}: params.Browser,
-				{
-					Name: "os",
-					In:   "query",
-				}: params.Os,
-				{
-					Name: "device",
-					In:   "query",
-				}: params.Device,
-				{
-					Name: "country",
-					In:   "query",
-				}: params.Country,
-				{
-					Name: "language",
-					In:   "query",
-				}: params.Language,
-				{
-					Name: "prop_name",
-					In:   "query",
-				}: params.PropName,
-				{
-					Name: "prop_value",
-					In:   "query",
-				}: params.PropValue,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
+					Name: "If-Modified-Since",
+					In:   "header",
+				}: params.IfModifiedSince,
 				{
-					Name: "offset",
+					Name: "u",
 					In:   "query",
-				}: params.Offset,
+				}: params.U,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetWebsiteIDPropertiesParams
-			Response = GetWebsiteIDPropertiesRes
+			Params   = GetEventPingParams
+			Response = GetEventPingRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2537,14 +1979,14 @@ func (s *Server) handleGetWebsiteIDPropertiesRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackGetWebsiteIDPropertiesParams,
+			unpackGetEventPingParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWebsiteIDProperties(ctx, params)
+				response, err = s.h.GetEventPing(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWebsiteIDProperties(ctx, params)
+		response, err = s.h.GetEventPing(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2552,7 +1994,7 @@ func (s *Server) handleGetWebsiteIDPropertiesRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetWebsiteIDPropertiesResponse(response, w); err != nil {
+	if err := encodeGetEventPingResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2561,12 +2003,12 @@ func (s *Server) handleGetWebsiteIDPropertiesRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleGetWebsiteIDReferrersRequest handles get-website-id-referrers operation.
+// handleGetReportsRequest handles get-reports operation.
 //
-// Get a list of referrers and their stats.
+// Get a list of the scheduled email reports the user is subscribed to.
 //
-// GET /website/{hostname}/referrers
-func (s *Server) handleGetWebsiteIDReferrersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /reports
+func (s *Server) handleGetReportsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -2574,15 +2016,15 @@ func (s *Server) handleGetWebsiteIDReferrersRequest(args [1]string, argsEscaped
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetWebsiteIDReferrersOperation,
-			ID:   "get-website-id-referrers",
+			Name: GetReportsOperation,
+			ID:   "get-reports",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDReferrersOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, GetReportsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2622,7 +2064,7 @@ func (s *Server) handleGetWebsiteIDReferrersRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeGetWebsiteIDReferrersParams(args, argsEscaped, r)
+	params, err := decodeGetReportsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2635,104 +2077,28 @@ func (s *Server) handleGetWebsiteIDReferrersRequest(args [1]string, argsEscaped
 
 	var rawBody []byte
 
-	var response GetWebsiteIDReferrersRes
+	var response GetReportsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWebsiteIDReferrersOperation,
-			OperationSummary: "Get Referrer Stats",
-			OperationID:      "get-website-id-referrers",
+			OperationName:    GetReportsOperation,
+			OperationSummary: "List Email Reports",
+			OperationID:      "get-reports",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
-				{
-					Name: "grouped",
-					In:   "query",
-				}: params.Grouped,
 				{
 					Name: "_me_sess",
 					In:   "cookie",
 				}: params.MeSess,
-				{
-					Name: "hostname",
-					In:   "path",
-				}: params.Hostname,
-				{
-					Name: "summary",
-					In:   "query",
-				}: params.Summary,
-				{
-					Name: "start",
-					In:   "query",
-				}: params.Start,
-				{
-					Name: "end",
-					In:   "query",
-				}: params.End,
-				{
-					Name: "path",
-					In:   "query",
-				}: params.Path,
-				{
-					Name: "referrer",
-					In:   "query",
-				}: params.Referrer,
-				{
-					Name: "utm_source",
-					In:   "query",
-				}: params.UtmSource,
-				{
-					Name: "utm_medium",
-					In:   "query",
-				}: params.UtmMedium,
-				{
-					Name: "utm_campaign",
-					In:   "query",
-				}: params.UtmCampaign,
-				{
-					Name: "browser",
-					In:   "query",
-				}: params.Browser,
-				{
-					Name: "os",
-					In:   "query",
-				}: params.Os,
-				{
-					Name: "device",
-					In:   "query",
-				}: params.Device,
-				{
-					Name: "country",
-					In:   "query",
-				}: params.Country,
-				{
-					Name: "language",
-					In:   "query",
-				}: params.Language,
-				{
-					Name: "prop_name",
-					In:   "query",
-				}: params.PropName,
-				{
-					Name: "prop_value",
-					In:   "query",
-				}: params.PropValue,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetWebsiteIDReferrersParams
-			Response = GetWebsiteIDReferrersRes
+			Params   = GetReportsParams
+			Response = GetReportsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2741,14 +2107,14 @@ func (s *Server) handleGetWebsiteIDReferrersRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackGetWebsiteIDReferrersParams,
+			unpackGetReportsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWebsiteIDReferrers(ctx, params)
+				response, err = s.h.GetReports(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWebsiteIDReferrers(ctx, params)
+		response, err = s.h.GetReports(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2756,7 +2122,7 @@ func (s *Server) handleGetWebsiteIDReferrersRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetWebsiteIDReferrersResponse(response, w); err != nil {
+	if err := encodeGetReportsResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2765,12 +2131,12 @@ func (s *Server) handleGetWebsiteIDReferrersRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleGetWebsiteIDSourcesRequest handles get-website-id-sources operation.
+// handleGetTenantSettingsRequest handles get-tenant-settings operation.
 //
-// Get a list of UTM sources and their stats.
+// Get a list of all tenant settings.
 //
-// GET /website/{hostname}/sources
-func (s *Server) handleGetWebsiteIDSourcesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /tenant/settings
+func (s *Server) handleGetTenantSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -2778,15 +2144,15 @@ func (s *Server) handleGetWebsiteIDSourcesRequest(args [1]string, argsEscaped bo
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetWebsiteIDSourcesOperation,
-			ID:   "get-website-id-sources",
+			Name: GetTenantSettingsOperation,
+			ID:   "get-tenant-settings",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDSourcesOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, GetTenantSettingsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2826,7 +2192,7 @@ func (s *Server) handleGetWebsiteIDSourcesRequest(args [1]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeGetWebsiteIDSourcesParams(args, argsEscaped, r)
+	params, err := decodeGetTenantSettingsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2839,13 +2205,13 @@ func (s *Server) handleGetWebsiteIDSourcesRequest(args [1]string, argsEscaped bo
 
 	var rawBody []byte
 
-	var response GetWebsiteIDSourcesRes
+	var response GetTenantSettingsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWebsiteIDSourcesOperation,
-			OperationSummary: "Get UTM Source Stats",
-			OperationID:      "get-website-id-sources",
+			OperationName:    GetTenantSettingsOperation,
+			OperationSummary: "List Tenant Settings",
+			OperationID:      "get-tenant-settings",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -2853,86 +2219,14 @@ func (s *Server) handleGetWebsiteIDSourcesRequest(args [1]string, argsEscaped bo
 					Name: "_me_sess",
 					In:   "cookie",
 				}: params.MeSess,
-				{
-					Name: "hostname",
-					In:   "path",
-				}: params.Hostname,
-				{
-					Name: "summary",
-					In:   "query",
-				}: params.Summary,
-				{
-					Name: "start",
-					In:   "query",
-				}: params.Start,
-				{
-					Name: "end",
-					In:   "query",
-				}: params.End,
-				{
-					Name: "path",
-					In:   "query",
-				}: params.Path,
-				{
-					Name: "referrer",
-					In:   "query",
-				}: params.Referrer,
-				{
-					Name: "utm_source",
-					In:   "query",
-				}: params.UtmSource,
-				{
-					Name: "utm_medium",
-					In:   "query",
-				}: params.UtmMedium,
-				{
-					Name: "utm_campaign",
-					In:   "query",
-				}: params.UtmCampaign,
-				{
-					Name: "browser",
-					In:   "query",
-				}: params.Browser,
-				{
-					Name: "os",
-					In:   "query",
-				}: params.Os,
-				{
-					Name: "device",
-					In:   "query",
-				}: params.Device,
-				{
-					Name: "country",
-					In:   "query",
-				}: params.Country,
-				{
-					Name: "language",
-					In:   "query",
-				}: params.Language,
-				{
-					Name: "prop_name",
-					In:   "query",
-				}: params.PropName,
-				{
-					Name: "prop_value",
-					In:   "query",
-				}: params.PropValue,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetWebsiteIDSourcesParams
-			Response = GetWebsiteIDSourcesRes
+			Params   = GetTenantSettingsParams
+			Response = GetTenantSettingsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2941,14 +2235,14 @@ func (s *Server) handleGetWebsiteIDSourcesRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetWebsiteIDSourcesParams,
+			unpackGetTenantSettingsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWebsiteIDSources(ctx, params)
+				response, err = s.h.GetTenantSettings(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWebsiteIDSources(ctx, params)
+		response, err = s.h.GetTenantSettings(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2956,7 +2250,7 @@ func (s *Server) handleGetWebsiteIDSourcesRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetWebsiteIDSourcesResponse(response, w); err != nil {
+	if err := encodeGetTenantSettingsResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2965,12 +2259,12 @@ func (s *Server) handleGetWebsiteIDSourcesRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleGetWebsiteIDSummaryRequest handles get-website-id-summary operation.
+// handleGetUserRequest handles get-user operation.
 //
-// Get a summary of the website's stats.
+// Retrieve the information of the user with the matching user ID.
 //
-// GET /website/{hostname}/summary
-func (s *Server) handleGetWebsiteIDSummaryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /user
+func (s *Server) handleGetUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -2978,15 +2272,15 @@ func (s *Server) handleGetWebsiteIDSummaryRequest(args [1]string, argsEscaped bo
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetWebsiteIDSummaryOperation,
-			ID:   "get-website-id-summary",
+			Name: GetUserOperation,
+			ID:   "get-user",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDSummaryOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, GetUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3026,7 +2320,7 @@ func (s *Server) handleGetWebsiteIDSummaryRequest(args [1]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeGetWebsiteIDSummaryParams(args, argsEscaped, r)
+	params, err := decodeGetUserParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3039,96 +2333,28 @@ func (s *Server) handleGetWebsiteIDSummaryRequest(args [1]string, argsEscaped bo
 
 	var rawBody []byte
 
-	var response GetWebsiteIDSummaryRes
+	var response GetUserRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWebsiteIDSummaryOperation,
-			OperationSummary: "Get Stat Summary",
-			OperationID:      "get-website-id-summary",
+			OperationName:    GetUserOperation,
+			OperationSummary: "Get User Info",
+			OperationID:      "get-user",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
-				{
-					Name: "previous",
-					In:   "query",
-				}: params.Previous,
-				{
-					Name: "interval",
-					In:   "query",
-				}: params.Interval,
 				{
 					Name: "_me_sess",
 					In:   "cookie",
 				}: params.MeSess,
-				{
-					Name: "hostname",
-					In:   "path",
-				}: params.Hostname,
-				{
-					Name: "start",
-					In:   "query",
-				}: params.Start,
-				{
-					Name: "end",
-					In:   "query",
-				}: params.End,
-				{
-					Name: "path",
-					In:   "query",
-				}: params.Path,
-				{
-					Name: "referrer",
-					In:   "query",
-				}: params.Referrer,
-				{
-					Name: "utm_source",
-					In:   "query",
-				}: params.UtmSource,
-				{
-					Name: "utm_medium",
-					In:   "query",
-				}: params.UtmMedium,
-				{
-					Name: "utm_campaign",
-					In:   "query",
-				}: params.UtmCampaign,
-				{
-					Name: "browser",
-					In:   "query",
-				}: params.Browser,
-				{
-					Name: "os",
-					In:   "query",
-				}: params.Os,
-				{
-					Name: "device",
-					In:   "query",
-				}: params.Device,
-				{
-					Name: "country",
-					In:   "query",
-				}: params.Country,
-				{
-					Name: "language",
-					In:   "query",
-				}: params.Language,
-				{
-					Name: "prop_name",
-					In:   "query",
-				}: params.PropName,
-				{
-					Name: "prop_value",
-					In:   "query",
-				}: params.PropValue,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetWebsiteIDSummaryParams
-			Response = GetWebsiteIDSummaryRes
+			Params   = GetUserParams
+			Response = GetUserRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3137,14 +2363,14 @@ func (s *Server) handleGetWebsiteIDSummaryRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetWebsiteIDSummaryParams,
+			unpackGetUserParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWebsiteIDSummary(ctx, params)
+				response, err = s.h.GetUser(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWebsiteIDSummary(ctx, params)
+		response, err = s.h.GetUser(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3152,7 +2378,7 @@ func (s *Server) handleGetWebsiteIDSummaryRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetWebsiteIDSummaryResponse(response, w); err != nil {
+	if err := encodeGetUserResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3161,12 +2387,12 @@ func (s *Server) handleGetWebsiteIDSummaryRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleGetWebsiteIDTimeRequest handles get-website-id-time operation.
+// handleGetUserSessionsRequest handles get-user-sessions operation.
 //
-// Get a list of pages and their time stats.
+// List the active sessions of the user.
 //
-// GET /website/{hostname}/time
-func (s *Server) handleGetWebsiteIDTimeRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /user/sessions
+func (s *Server) handleGetUserSessionsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -3174,15 +2400,15 @@ func (s *Server) handleGetWebsiteIDTimeRequest(args [1]string, argsEscaped bool,
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetWebsiteIDTimeOperation,
-			ID:   "get-website-id-time",
+			Name: GetUserSessionsOperation,
+			ID:   "get-user-sessions",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDTimeOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, GetUserSessionsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3222,7 +2448,7 @@ func (s *Server) handleGetWebsiteIDTimeRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeGetWebsiteIDTimeParams(args, argsEscaped, r)
+	params, err := decodeGetUserSessionsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3235,13 +2461,13 @@ func (s *Server) handleGetWebsiteIDTimeRequest(args [1]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response GetWebsiteIDTimeRes
+	var response GetUserSessionsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWebsiteIDTimeOperation,
-			OperationSummary: "Get Time Stats",
-			OperationID:      "get-website-id-time",
+			OperationName:    GetUserSessionsOperation,
+			OperationSummary: "List Sessions",
+			OperationID:      "get-user-sessions",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -3249,86 +2475,6739 @@ func (s *Server) handleGetWebsiteIDTimeRequest(args [1]string, argsEscaped bool,
 					Name: "_me_sess",
 					In:   "cookie",
 				}: params.MeSess,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetUserSessionsParams
+			Response = GetUserSessionsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetUserSessionsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUserSessions(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUserSessions(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetUserSessionsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUserUsageRequest handles get-user-usage operation.
+//
+// Get the current CPU, memory and disk usage of the server and the data retention of the analytics
+// database.
+//
+// GET /user/usage
+func (s *Server) handleGetUserUsageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUserUsageOperation,
+			ID:   "get-user-usage",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetUserUsageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetUserUsageParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetUserUsageRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUserUsageOperation,
+			OperationSummary: "Get Resource Usage",
+			OperationID:      "get-user-usage",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
 				{
-					Name: "hostname",
-					In:   "path",
-				}: params.Hostname,
-				{
-					Name: "summary",
-					In:   "query",
-				}: params.Summary,
-				{
-					Name: "start",
-					In:   "query",
-				}: params.Start,
-				{
-					Name: "end",
-					In:   "query",
-				}: params.End,
-				{
-					Name: "path",
-					In:   "query",
-				}: params.Path,
-				{
-					Name: "referrer",
-					In:   "query",
-				}: params.Referrer,
-				{
-					Name: "utm_source",
-					In:   "query",
-				}: params.UtmSource,
-				{
-					Name: "utm_medium",
-					In:   "query",
-				}: params.UtmMedium,
-				{
-					Name: "utm_campaign",
-					In:   "query",
-				}: params.UtmCampaign,
-				{
-					Name: "browser",
-					In:   "query",
-				}: params.Browser,
-				{
-					Name: "os",
-					In:   "query",
-				}: params.Os,
-				{
-					Name: "device",
-					In:   "query",
-				}: params.Device,
-				{
-					Name: "country",
-					In:   "query",
-				}: params.Country,
-				{
-					Name: "language",
-					In:   "query",
-				}: params.Language,
-				{
-					Name: "prop_name",
-					In:   "query",
-				}: params.PropName,
-				{
-					Name: "prop_value",
-					In:   "query",
-				}: params.PropValue,
+					Name: "_me_sess",
+					In:   "cookie",
+				}: params.MeSess,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetUserUsageParams
+			Response = GetUserUsageRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetUserUsageParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUserUsage(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUserUsage(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetUserUsageResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUsersRequest handles get-users operation.
+//
+// Get a list of all users and the websites they are members of. Only owners and admins can list
+// users.
+//
+// GET /users
+func (s *Server) handleGetUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUsersOperation,
+			ID:   "get-users",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetUsersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetUsersParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetUsersRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUsersOperation,
+			OperationSummary: "List Users",
+			OperationID:      "get-users",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "_me_sess",
+					In:   "cookie",
+				}: params.MeSess,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetUsersParams
+			Response = GetUsersRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetUsersParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUsers(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUsers(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetUsersResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDBrowsersRequest handles get-website-id-browsers operation.
+//
+// Get a list of browsers and their stats.
+//
+// GET /website/{hostname}/browsers
+func (s *Server) handleGetWebsiteIDBrowsersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDBrowsersOperation,
+			ID:   "get-website-id-browsers",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDBrowsersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDBrowsersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDBrowsersParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDBrowsersRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDBrowsersOperation,
+			OperationSummary: "Get Browser Stats",
+			OperationID:      "get-website-id-browsers",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "summary",
+					In:   "query",
+				}: params.Summary,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "path",
+					In:   "query",
+				}: params.Path,
+				{
+					Name: "original_hostname",
+					In:   "query",
+				}: params.OriginalHostname,
+				{
+					Name: "referrer",
+					In:   "query",
+				}: params.Referrer,
+				{
+					Name: "utm_source",
+					In:   "query",
+				}: params.UtmSource,
+				{
+					Name: "utm_medium",
+					In:   "query",
+				}: params.UtmMedium,
+				{
+					Name: "utm_campaign",
+					In:   "query",
+				}: params.UtmCampaign,
+				{
+					Name: "browser",
+					In:   "query",
+				}: params.Browser,
+				{
+					Name: "os",
+					In:   "query",
+				}: params.Os,
+				{
+					Name: "device",
+					In:   "query",
+				}: params.Device,
+				{
+					Name: "country",
+					In:   "query",
+				}: params.Country,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "prop_name",
+					In:   "query",
+				}: params.PropName,
+				{
+					Name: "prop_value",
+					In:   "query",
+				}: params.PropValue,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDBrowsersParams
+			Response = GetWebsiteIDBrowsersRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDBrowsersParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDBrowsers(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDBrowsers(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDBrowsersResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDCampaignsRequest handles get-website-id-campaigns operation.
+//
+// Get a list of UTM campaigns and their stats.
+//
+// GET /website/{hostname}/campaigns
+func (s *Server) handleGetWebsiteIDCampaignsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDCampaignsOperation,
+			ID:   "get-website-id-campaigns",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDCampaignsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDCampaignsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDCampaignsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDCampaignsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDCampaignsOperation,
+			OperationSummary: "Get UTM Campaign Stats",
+			OperationID:      "get-website-id-campaigns",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "summary",
+					In:   "query",
+				}: params.Summary,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "path",
+					In:   "query",
+				}: params.Path,
+				{
+					Name: "original_hostname",
+					In:   "query",
+				}: params.OriginalHostname,
+				{
+					Name: "referrer",
+					In:   "query",
+				}: params.Referrer,
+				{
+					Name: "utm_source",
+					In:   "query",
+				}: params.UtmSource,
+				{
+					Name: "utm_medium",
+					In:   "query",
+				}: params.UtmMedium,
+				{
+					Name: "utm_campaign",
+					In:   "query",
+				}: params.UtmCampaign,
+				{
+					Name: "browser",
+					In:   "query",
+				}: params.Browser,
+				{
+					Name: "os",
+					In:   "query",
+				}: params.Os,
+				{
+					Name: "device",
+					In:   "query",
+				}: params.Device,
+				{
+					Name: "country",
+					In:   "query",
+				}: params.Country,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "prop_name",
+					In:   "query",
+				}: params.PropName,
+				{
+					Name: "prop_value",
+					In:   "query",
+				}: params.PropValue,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDCampaignsParams
+			Response = GetWebsiteIDCampaignsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDCampaignsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDCampaigns(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDCampaigns(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDCampaignsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDCountryRequest handles get-website-id-country operation.
+//
+// Get a list of countries and their stats.
+//
+// GET /website/{hostname}/countries
+func (s *Server) handleGetWebsiteIDCountryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDCountryOperation,
+			ID:   "get-website-id-country",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDCountryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDCountryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDCountryParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDCountryRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDCountryOperation,
+			OperationSummary: "Get Country Stats",
+			OperationID:      "get-website-id-country",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "summary",
+					In:   "query",
+				}: params.Summary,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "path",
+					In:   "query",
+				}: params.Path,
+				{
+					Name: "original_hostname",
+					In:   "query",
+				}: params.OriginalHostname,
+				{
+					Name: "referrer",
+					In:   "query",
+				}: params.Referrer,
+				{
+					Name: "utm_source",
+					In:   "query",
+				}: params.UtmSource,
+				{
+					Name: "utm_medium",
+					In:   "query",
+				}: params.UtmMedium,
+				{
+					Name: "utm_campaign",
+					In:   "query",
+				}: params.UtmCampaign,
+				{
+					Name: "browser",
+					In:   "query",
+				}: params.Browser,
+				{
+					Name: "os",
+					In:   "query",
+				}: params.Os,
+				{
+					Name: "device",
+					In:   "query",
+				}: params.Device,
+				{
+					Name: "country",
+					In:   "query",
+				}: params.Country,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "prop_name",
+					In:   "query",
+				}: params.PropName,
+				{
+					Name: "prop_value",
+					In:   "query",
+				}: params.PropValue,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDCountryParams
+			Response = GetWebsiteIDCountryRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDCountryParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDCountry(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDCountry(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDCountryResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDDeviceRequest handles get-website-id-device operation.
+//
+// Get a list of devices and their stats.
+//
+// GET /website/{hostname}/devices
+func (s *Server) handleGetWebsiteIDDeviceRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDDeviceOperation,
+			ID:   "get-website-id-device",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDDeviceOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDDeviceOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDDeviceParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDDeviceRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDDeviceOperation,
+			OperationSummary: "Get Device Stats",
+			OperationID:      "get-website-id-device",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "summary",
+					In:   "query",
+				}: params.Summary,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "path",
+					In:   "query",
+				}: params.Path,
+				{
+					Name: "original_hostname",
+					In:   "query",
+				}: params.OriginalHostname,
+				{
+					Name: "referrer",
+					In:   "query",
+				}: params.Referrer,
+				{
+					Name: "utm_source",
+					In:   "query",
+				}: params.UtmSource,
+				{
+					Name: "utm_medium",
+					In:   "query",
+				}: params.UtmMedium,
+				{
+					Name: "utm_campaign",
+					In:   "query",
+				}: params.UtmCampaign,
+				{
+					Name: "browser",
+					In:   "query",
+				}: params.Browser,
+				{
+					Name: "os",
+					In:   "query",
+				}: params.Os,
+				{
+					Name: "device",
+					In:   "query",
+				}: params.Device,
+				{
+					Name: "country",
+					In:   "query",
+				}: params.Country,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "prop_name",
+					In:   "query",
+				}: params.PropName,
+				{
+					Name: "prop_value",
+					In:   "query",
+				}: params.PropValue,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDDeviceParams
+			Response = GetWebsiteIDDeviceRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDDeviceParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDDevice(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDDevice(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDDeviceResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDFunnelRequest handles get-website-id-funnel operation.
+//
+// Get the number of visits reaching each step of an ordered funnel and how many dropped off between
+// steps. A visit only reaches a step if it completed every previous step in order. Visits are only
+// tracked when enabled on the server with `VISIT_TRACKING`, otherwise every page view is its own
+// visit.
+//
+// GET /website/{hostname}/funnel
+func (s *Server) handleGetWebsiteIDFunnelRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDFunnelOperation,
+			ID:   "get-website-id-funnel",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDFunnelOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDFunnelOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDFunnelParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDFunnelRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDFunnelOperation,
+			OperationSummary: "Get Funnel Stats",
+			OperationID:      "get-website-id-funnel",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "steps",
+					In:   "query",
+				}: params.Steps,
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "path",
+					In:   "query",
+				}: params.Path,
+				{
+					Name: "original_hostname",
+					In:   "query",
+				}: params.OriginalHostname,
+				{
+					Name: "referrer",
+					In:   "query",
+				}: params.Referrer,
+				{
+					Name: "utm_source",
+					In:   "query",
+				}: params.UtmSource,
+				{
+					Name: "utm_medium",
+					In:   "query",
+				}: params.UtmMedium,
+				{
+					Name: "utm_campaign",
+					In:   "query",
+				}: params.UtmCampaign,
+				{
+					Name: "browser",
+					In:   "query",
+				}: params.Browser,
+				{
+					Name: "os",
+					In:   "query",
+				}: params.Os,
+				{
+					Name: "device",
+					In:   "query",
+				}: params.Device,
+				{
+					Name: "country",
+					In:   "query",
+				}: params.Country,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "prop_name",
+					In:   "query",
+				}: params.PropName,
+				{
+					Name: "prop_value",
+					In:   "query",
+				}: params.PropValue,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDFunnelParams
+			Response = GetWebsiteIDFunnelRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDFunnelParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDFunnel(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDFunnel(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDFunnelResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDGoalsRequest handles get-website-id-goals operation.
+//
+// Get the conversions, unique converters and conversion rate for each goal of the website.
+//
+// GET /website/{hostname}/goals
+func (s *Server) handleGetWebsiteIDGoalsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDGoalsOperation,
+			ID:   "get-website-id-goals",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDGoalsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDGoalsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDGoalsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDGoalsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDGoalsOperation,
+			OperationSummary: "Get Goal Stats",
+			OperationID:      "get-website-id-goals",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "path",
+					In:   "query",
+				}: params.Path,
+				{
+					Name: "original_hostname",
+					In:   "query",
+				}: params.OriginalHostname,
+				{
+					Name: "referrer",
+					In:   "query",
+				}: params.Referrer,
+				{
+					Name: "utm_source",
+					In:   "query",
+				}: params.UtmSource,
+				{
+					Name: "utm_medium",
+					In:   "query",
+				}: params.UtmMedium,
+				{
+					Name: "utm_campaign",
+					In:   "query",
+				}: params.UtmCampaign,
+				{
+					Name: "browser",
+					In:   "query",
+				}: params.Browser,
+				{
+					Name: "os",
+					In:   "query",
+				}: params.Os,
+				{
+					Name: "device",
+					In:   "query",
+				}: params.Device,
+				{
+					Name: "country",
+					In:   "query",
+				}: params.Country,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "prop_name",
+					In:   "query",
+				}: params.PropName,
+				{
+					Name: "prop_value",
+					In:   "query",
+				}: params.PropValue,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDGoalsParams
+			Response = GetWebsiteIDGoalsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDGoalsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDGoals(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDGoals(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDGoalsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDHostnamesRequest handles get-website-id-hostnames operation.
+//
+// Get a list of the hostnames and aliases page views were received on and their stats.
+//
+// GET /website/{hostname}/hostnames
+func (s *Server) handleGetWebsiteIDHostnamesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDHostnamesOperation,
+			ID:   "get-website-id-hostnames",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDHostnamesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDHostnamesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDHostnamesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDHostnamesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDHostnamesOperation,
+			OperationSummary: "Get Hostname Stats",
+			OperationID:      "get-website-id-hostnames",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "summary",
+					In:   "query",
+				}: params.Summary,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "path",
+					In:   "query",
+				}: params.Path,
+				{
+					Name: "original_hostname",
+					In:   "query",
+				}: params.OriginalHostname,
+				{
+					Name: "referrer",
+					In:   "query",
+				}: params.Referrer,
+				{
+					Name: "utm_source",
+					In:   "query",
+				}: params.UtmSource,
+				{
+					Name: "utm_medium",
+					In:   "query",
+				}: params.UtmMedium,
+				{
+					Name: "utm_campaign",
+					In:   "query",
+				}: params.UtmCampaign,
+				{
+					Name: "browser",
+					In:   "query",
+				}: params.Browser,
+				{
+					Name: "os",
+					In:   "query",
+				}: params.Os,
+				{
+					Name: "device",
+					In:   "query",
+				}: params.Device,
+				{
+					Name: "country",
+					In:   "query",
+				}: params.Country,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "prop_name",
+					In:   "query",
+				}: params.PropName,
+				{
+					Name: "prop_value",
+					In:   "query",
+				}: params.PropValue,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDHostnamesParams
+			Response = GetWebsiteIDHostnamesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDHostnamesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDHostnames(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDHostnames(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDHostnamesResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDLanguageRequest handles get-website-id-language operation.
+//
+// Get a list of languages and their stats.
+//
+// GET /website/{hostname}/languages
+func (s *Server) handleGetWebsiteIDLanguageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDLanguageOperation,
+			ID:   "get-website-id-language",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDLanguageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDLanguageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDLanguageParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDLanguageRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDLanguageOperation,
+			OperationSummary: "Get Language Stats",
+			OperationID:      "get-website-id-language",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "locale",
+					In:   "query",
+				}: params.Locale,
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "summary",
+					In:   "query",
+				}: params.Summary,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "path",
+					In:   "query",
+				}: params.Path,
+				{
+					Name: "original_hostname",
+					In:   "query",
+				}: params.OriginalHostname,
+				{
+					Name: "referrer",
+					In:   "query",
+				}: params.Referrer,
+				{
+					Name: "utm_source",
+					In:   "query",
+				}: params.UtmSource,
+				{
+					Name: "utm_medium",
+					In:   "query",
+				}: params.UtmMedium,
+				{
+					Name: "utm_campaign",
+					In:   "query",
+				}: params.UtmCampaign,
+				{
+					Name: "browser",
+					In:   "query",
+				}: params.Browser,
+				{
+					Name: "os",
+					In:   "query",
+				}: params.Os,
+				{
+					Name: "device",
+					In:   "query",
+				}: params.Device,
+				{
+					Name: "country",
+					In:   "query",
+				}: params.Country,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "prop_name",
+					In:   "query",
+				}: params.PropName,
+				{
+					Name: "prop_value",
+					In:   "query",
+				}: params.PropValue,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDLanguageParams
+			Response = GetWebsiteIDLanguageRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDLanguageParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDLanguage(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDLanguage(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDLanguageResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDMediumsRequest handles get-website-id-mediums operation.
+//
+// Get a list of UTM mediums and their stats.
+//
+// GET /website/{hostname}/mediums
+func (s *Server) handleGetWebsiteIDMediumsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDMediumsOperation,
+			ID:   "get-website-id-mediums",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDMediumsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDMediumsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDMediumsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDMediumsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDMediumsOperation,
+			OperationSummary: "Get UTM Medium Stats",
+			OperationID:      "get-website-id-mediums",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "summary",
+					In:   "query",
+				}: params.Summary,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "path",
+					In:   "query",
+				}: params.Path,
+				{
+					Name: "original_hostname",
+					In:   "query",
+				}: params.OriginalHostname,
+				{
+					Name: "referrer",
+					In:   "query",
+				}: params.Referrer,
+				{
+					Name: "utm_source",
+					In:   "query",
+				}: params.UtmSource,
+				{
+					Name: "utm_medium",
+					In:   "query",
+				}: params.UtmMedium,
+				{
+					Name: "utm_campaign",
+					In:   "query",
+				}: params.UtmCampaign,
+				{
+					Name: "browser",
+					In:   "query",
+				}: params.Browser,
+				{
+					Name: "os",
+					In:   "query",
+				}: params.Os,
+				{
+					Name: "device",
+					In:   "query",
+				}: params.Device,
+				{
+					Name: "country",
+					In:   "query",
+				}: params.Country,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "prop_name",
+					In:   "query",
+				}: params.PropName,
+				{
+					Name: "prop_value",
+					In:   "query",
+				}: params.PropValue,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDMediumsParams
+			Response = GetWebsiteIDMediumsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDMediumsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDMediums(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDMediums(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDMediumsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDOsRequest handles get-website-id-os operation.
+//
+// Get a list of OS and their stats.
+//
+// GET /website/{hostname}/os
+func (s *Server) handleGetWebsiteIDOsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDOsOperation,
+			ID:   "get-website-id-os",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDOsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDOsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDOsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDOsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDOsOperation,
+			OperationSummary: "Get OS Stats",
+			OperationID:      "get-website-id-os",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "summary",
+					In:   "query",
+				}: params.Summary,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "path",
+					In:   "query",
+				}: params.Path,
+				{
+					Name: "original_hostname",
+					In:   "query",
+				}: params.OriginalHostname,
+				{
+					Name: "referrer",
+					In:   "query",
+				}: params.Referrer,
+				{
+					Name: "utm_source",
+					In:   "query",
+				}: params.UtmSource,
+				{
+					Name: "utm_medium",
+					In:   "query",
+				}: params.UtmMedium,
+				{
+					Name: "utm_campaign",
+					In:   "query",
+				}: params.UtmCampaign,
+				{
+					Name: "browser",
+					In:   "query",
+				}: params.Browser,
+				{
+					Name: "os",
+					In:   "query",
+				}: params.Os,
+				{
+					Name: "device",
+					In:   "query",
+				}: params.Device,
+				{
+					Name: "country",
+					In:   "query",
+				}: params.Country,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "prop_name",
+					In:   "query",
+				}: params.PropName,
+				{
+					Name: "prop_value",
+					In:   "query",
+				}: params.PropValue,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDOsParams
+			Response = GetWebsiteIDOsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDOsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDOs(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDOs(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDOsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDPagesRequest handles get-website-id-pages operation.
+//
+// Get a list of pages and their stats.
+//
+// GET /website/{hostname}/pages
+func (s *Server) handleGetWebsiteIDPagesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDPagesOperation,
+			ID:   "get-website-id-pages",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDPagesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDPagesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDPagesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDPagesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDPagesOperation,
+			OperationSummary: "Get Page Stats",
+			OperationID:      "get-website-id-pages",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "summary",
+					In:   "query",
+				}: params.Summary,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "path",
+					In:   "query",
+				}: params.Path,
+				{
+					Name: "original_hostname",
+					In:   "query",
+				}: params.OriginalHostname,
+				{
+					Name: "referrer",
+					In:   "query",
+				}: params.Referrer,
+				{
+					Name: "utm_source",
+					In:   "query",
+				}: params.UtmSource,
+				{
+					Name: "utm_medium",
+					In:   "query",
+				}: params.UtmMedium,
+				{
+					Name: "utm_campaign",
+					In:   "query",
+				}: params.UtmCampaign,
+				{
+					Name: "browser",
+					In:   "query",
+				}: params.Browser,
+				{
+					Name: "os",
+					In:   "query",
+				}: params.Os,
+				{
+					Name: "device",
+					In:   "query",
+				}: params.Device,
+				{
+					Name: "country",
+					In:   "query",
+				}: params.Country,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "prop_name",
+					In:   "query",
+				}: params.PropName,
+				{
+					Name: "prop_value",
+					In:   "query",
+				}: params.PropValue,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDPagesParams
+			Response = GetWebsiteIDPagesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDPagesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDPages(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDPages(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDPagesResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDPagesEntryRequest handles get-website-id-pages-entry operation.
+//
+// Get a list of pages that start visits and their stats. Consecutive page views from the same
+// visitor are grouped into a visit until they are inactive for 30 minutes. Visits are only tracked
+// when enabled on the server with `VISIT_TRACKING`, otherwise every page view is its own visit.
+//
+// GET /website/{hostname}/pages/entry
+func (s *Server) handleGetWebsiteIDPagesEntryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDPagesEntryOperation,
+			ID:   "get-website-id-pages-entry",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDPagesEntryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDPagesEntryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDPagesEntryParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDPagesEntryRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDPagesEntryOperation,
+			OperationSummary: "Get Entry Page Stats",
+			OperationID:      "get-website-id-pages-entry",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "summary",
+					In:   "query",
+				}: params.Summary,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "path",
+					In:   "query",
+				}: params.Path,
+				{
+					Name: "original_hostname",
+					In:   "query",
+				}: params.OriginalHostname,
+				{
+					Name: "referrer",
+					In:   "query",
+				}: params.Referrer,
+				{
+					Name: "utm_source",
+					In:   "query",
+				}: params.UtmSource,
+				{
+					Name: "utm_medium",
+					In:   "query",
+				}: params.UtmMedium,
+				{
+					Name: "utm_campaign",
+					In:   "query",
+				}: params.UtmCampaign,
+				{
+					Name: "browser",
+					In:   "query",
+				}: params.Browser,
+				{
+					Name: "os",
+					In:   "query",
+				}: params.Os,
+				{
+					Name: "device",
+					In:   "query",
+				}: params.Device,
+				{
+					Name: "country",
+					In:   "query",
+				}: params.Country,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "prop_name",
+					In:   "query",
+				}: params.PropName,
+				{
+					Name: "prop_value",
+					In:   "query",
+				}: params.PropValue,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDPagesEntryParams
+			Response = GetWebsiteIDPagesEntryRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDPagesEntryParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDPagesEntry(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDPagesEntry(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDPagesEntryResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDPagesExitRequest handles get-website-id-pages-exit operation.
+//
+// Get a list of pages that end visits and their stats. Consecutive page views from the same visitor
+// are grouped into a visit until they are inactive for 30 minutes. Visits are only tracked when
+// enabled on the server with `VISIT_TRACKING`, otherwise every page view is its own visit.
+//
+// GET /website/{hostname}/pages/exit
+func (s *Server) handleGetWebsiteIDPagesExitRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDPagesExitOperation,
+			ID:   "get-website-id-pages-exit",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDPagesExitOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDPagesExitOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDPagesExitParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDPagesExitRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDPagesExitOperation,
+			OperationSummary: "Get Exit Page Stats",
+			OperationID:      "get-website-id-pages-exit",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "summary",
+					In:   "query",
+				}: params.Summary,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "path",
+					In:   "query",
+				}: params.Path,
+				{
+					Name: "original_hostname",
+					In:   "query",
+				}: params.OriginalHostname,
+				{
+					Name: "referrer",
+					In:   "query",
+				}: params.Referrer,
+				{
+					Name: "utm_source",
+					In:   "query",
+				}: params.UtmSource,
+				{
+					Name: "utm_medium",
+					In:   "query",
+				}: params.UtmMedium,
+				{
+					Name: "utm_campaign",
+					In:   "query",
+				}: params.UtmCampaign,
+				{
+					Name: "browser",
+					In:   "query",
+				}: params.Browser,
+				{
+					Name: "os",
+					In:   "query",
+				}: params.Os,
+				{
+					Name: "device",
+					In:   "query",
+				}: params.Device,
+				{
+					Name: "country",
+					In:   "query",
+				}: params.Country,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "prop_name",
+					In:   "query",
+				}: params.PropName,
+				{
+					Name: "prop_value",
+					In:   "query",
+				}: params.PropValue,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDPagesExitParams
+			Response = GetWebsiteIDPagesExitRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDPagesExitParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDPagesExit(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDPagesExit(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDPagesExitResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDPropertiesRequest handles get-website-id-properties operation.
+//
+// Get a list of custom properties and their stats. If a property name is provided, it will return
+// the stats for that property instead.
+//
+// GET /website/{hostname}/properties
+func (s *Server) handleGetWebsiteIDPropertiesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDPropertiesOperation,
+			ID:   "get-website-id-properties",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDPropertiesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDPropertiesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDPropertiesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDPropertiesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDPropertiesOperation,
+			OperationSummary: "Get Property Stats",
+			OperationID:      "get-website-id-properties",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "path",
+					In:   "query",
+				}: params.Path,
+				{
+					Name: "original_hostname",
+					In:   "query",
+				}: params.OriginalHostname,
+				{
+					Name: "referrer",
+					In:   "query",
+				}: params.Referrer,
+				{
+					Name: "utm_source",
+					In:   "query",
+				}: params.UtmSource,
+				{
+					Name: "utm_medium",
+					In:   "query",
+				}: params.UtmMedium,
+				{
+					Name: "utm_campaign",
+					In:   "query",
+				}: params.UtmCampaign,
+				{
+					Name: "browser",
+					In:   "query",
+				}: params.Browser,
+				{
+					Name: "os",
+					In:   "query",
+				}: params.Os,
+				{
+					Name: "device",
+					In:   "query",
+				}: params.Device,
+				{
+					Name: "country",
+					In:   "query",
+				}: params.Country,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "prop_name",
+					In:   "query",
+				}: params.PropName,
+				{
+					Name: "prop_value",
+					In:   "query",
+				}: params.PropValue,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDPropertiesParams
+			Response = GetWebsiteIDPropertiesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDPropertiesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDProperties(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDProperties(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDPropertiesResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDRealtimeRequest handles get-website-id-realtime operation.
+//
+// Get the number of visitors active in the last few minutes and the top pages and referrers they are
+// viewing. A visitor is active if any of their visits had a page view within the period. Visits are
+// only tracked when enabled on the server with `VISIT_TRACKING`, otherwise every page view is its
+// own visit. To receive updates as they happen, the same stats are streamed as Server-Sent Events
+// from `/api/website/{hostname}/realtime/stream`.
+//
+// GET /website/{hostname}/realtime
+func (s *Server) handleGetWebsiteIDRealtimeRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDRealtimeOperation,
+			ID:   "get-website-id-realtime",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDRealtimeOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDRealtimeOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDRealtimeParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDRealtimeRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDRealtimeOperation,
+			OperationSummary: "Get Realtime Stats",
+			OperationID:      "get-website-id-realtime",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "minutes",
+					In:   "query",
+				}: params.Minutes,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDRealtimeParams
+			Response = GetWebsiteIDRealtimeRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDRealtimeParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDRealtime(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDRealtime(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDRealtimeResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDReferrersRequest handles get-website-id-referrers operation.
+//
+// Get a list of referrers and their stats.
+//
+// GET /website/{hostname}/referrers
+func (s *Server) handleGetWebsiteIDReferrersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDReferrersOperation,
+			ID:   "get-website-id-referrers",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDReferrersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDReferrersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDReferrersParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDReferrersRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDReferrersOperation,
+			OperationSummary: "Get Referrer Stats",
+			OperationID:      "get-website-id-referrers",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "grouped",
+					In:   "query",
+				}: params.Grouped,
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "summary",
+					In:   "query",
+				}: params.Summary,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "path",
+					In:   "query",
+				}: params.Path,
+				{
+					Name: "original_hostname",
+					In:   "query",
+				}: params.OriginalHostname,
+				{
+					Name: "referrer",
+					In:   "query",
+				}: params.Referrer,
+				{
+					Name: "utm_source",
+					In:   "query",
+				}: params.UtmSource,
+				{
+					Name: "utm_medium",
+					In:   "query",
+				}: params.UtmMedium,
+				{
+					Name: "utm_campaign",
+					In:   "query",
+				}: params.UtmCampaign,
+				{
+					Name: "browser",
+					In:   "query",
+				}: params.Browser,
+				{
+					Name: "os",
+					In:   "query",
+				}: params.Os,
+				{
+					Name: "device",
+					In:   "query",
+				}: params.Device,
+				{
+					Name: "country",
+					In:   "query",
+				}: params.Country,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "prop_name",
+					In:   "query",
+				}: params.PropName,
+				{
+					Name: "prop_value",
+					In:   "query",
+				}: params.PropValue,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDReferrersParams
+			Response = GetWebsiteIDReferrersRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDReferrersParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDReferrers(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDReferrers(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDReferrersResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDSourcesRequest handles get-website-id-sources operation.
+//
+// Get a list of UTM sources and their stats.
+//
+// GET /website/{hostname}/sources
+func (s *Server) handleGetWebsiteIDSourcesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDSourcesOperation,
+			ID:   "get-website-id-sources",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDSourcesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDSourcesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDSourcesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDSourcesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDSourcesOperation,
+			OperationSummary: "Get UTM Source Stats",
+			OperationID:      "get-website-id-sources",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "summary",
+					In:   "query",
+				}: params.Summary,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "path",
+					In:   "query",
+				}: params.Path,
+				{
+					Name: "original_hostname",
+					In:   "query",
+				}: params.OriginalHostname,
+				{
+					Name: "referrer",
+					In:   "query",
+				}: params.Referrer,
+				{
+					Name: "utm_source",
+					In:   "query",
+				}: params.UtmSource,
+				{
+					Name: "utm_medium",
+					In:   "query",
+				}: params.UtmMedium,
+				{
+					Name: "utm_campaign",
+					In:   "query",
+				}: params.UtmCampaign,
+				{
+					Name: "browser",
+					In:   "query",
+				}: params.Browser,
+				{
+					Name: "os",
+					In:   "query",
+				}: params.Os,
+				{
+					Name: "device",
+					In:   "query",
+				}: params.Device,
+				{
+					Name: "country",
+					In:   "query",
+				}: params.Country,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "prop_name",
+					In:   "query",
+				}: params.PropName,
+				{
+					Name: "prop_value",
+					In:   "query",
+				}: params.PropValue,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDSourcesParams
+			Response = GetWebsiteIDSourcesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDSourcesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDSources(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDSources(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDSourcesResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDSummaryRequest handles get-website-id-summary operation.
+//
+// Get a summary of the website's stats.
+//
+// GET /website/{hostname}/summary
+func (s *Server) handleGetWebsiteIDSummaryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDSummaryOperation,
+			ID:   "get-website-id-summary",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDSummaryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDSummaryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDSummaryParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDSummaryRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDSummaryOperation,
+			OperationSummary: "Get Stat Summary",
+			OperationID:      "get-website-id-summary",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "previous",
+					In:   "query",
+				}: params.Previous,
+				{
+					Name: "interval",
+					In:   "query",
+				}: params.Interval,
+				{
+					Name: "anomalies",
+					In:   "query",
+				}: params.Anomalies,
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "path",
+					In:   "query",
+				}: params.Path,
+				{
+					Name: "original_hostname",
+					In:   "query",
+				}: params.OriginalHostname,
+				{
+					Name: "referrer",
+					In:   "query",
+				}: params.Referrer,
+				{
+					Name: "utm_source",
+					In:   "query",
+				}: params.UtmSource,
+				{
+					Name: "utm_medium",
+					In:   "query",
+				}: params.UtmMedium,
+				{
+					Name: "utm_campaign",
+					In:   "query",
+				}: params.UtmCampaign,
+				{
+					Name: "browser",
+					In:   "query",
+				}: params.Browser,
+				{
+					Name: "os",
+					In:   "query",
+				}: params.Os,
+				{
+					Name: "device",
+					In:   "query",
+				}: params.Device,
+				{
+					Name: "country",
+					In:   "query",
+				}: params.Country,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "prop_name",
+					In:   "query",
+				}: params.PropName,
+				{
+					Name: "prop_value",
+					In:   "query",
+				}: params.PropValue,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDSummaryParams
+			Response = GetWebsiteIDSummaryRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDSummaryParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDSummary(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDSummary(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDSummaryResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsiteIDTimeRequest handles get-website-id-time operation.
+//
+// Get a list of pages and their time stats.
+//
+// GET /website/{hostname}/time
+func (s *Server) handleGetWebsiteIDTimeRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsiteIDTimeOperation,
+			ID:   "get-website-id-time",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsiteIDTimeOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsiteIDTimeOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsiteIDTimeParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsiteIDTimeRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsiteIDTimeOperation,
+			OperationSummary: "Get Time Stats",
+			OperationID:      "get-website-id-time",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "summary",
+					In:   "query",
+				}: params.Summary,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "path",
+					In:   "query",
+				}: params.Path,
+				{
+					Name: "original_hostname",
+					In:   "query",
+				}: params.OriginalHostname,
+				{
+					Name: "referrer",
+					In:   "query",
+				}: params.Referrer,
+				{
+					Name: "utm_source",
+					In:   "query",
+				}: params.UtmSource,
+				{
+					Name: "utm_medium",
+					In:   "query",
+				}: params.UtmMedium,
+				{
+					Name: "utm_campaign",
+					In:   "query",
+				}: params.UtmCampaign,
+				{
+					Name: "browser",
+					In:   "query",
+				}: params.Browser,
+				{
+					Name: "os",
+					In:   "query",
+				}: params.Os,
+				{
+					Name: "device",
+					In:   "query",
+				}: params.Device,
+				{
+					Name: "country",
+					In:   "query",
+				}: params.Country,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "prop_name",
+					In:   "query",
+				}: params.PropName,
+				{
+					Name: "prop_value",
+					In:   "query",
+				}: params.PropValue,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsiteIDTimeParams
+			Response = GetWebsiteIDTimeRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsiteIDTimeParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsiteIDTime(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsiteIDTime(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsiteIDTimeResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsitesRequest handles get-websites operation.
+//
+// Get a list of all websites from the user.
+//
+// GET /websites
+func (s *Server) handleGetWebsitesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsitesOperation,
+			ID:   "get-websites",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsitesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsitesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsitesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsitesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsitesOperation,
+			OperationSummary: "List Websites",
+			OperationID:      "get-websites",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "summary",
+					In:   "query",
+				}: params.Summary,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsitesParams
+			Response = GetWebsitesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsitesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsites(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsites(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsitesResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsitesIDRequest handles get-websites-id operation.
+//
+// Get website details for an individual website.
+//
+// GET /websites/{hostname}
+func (s *Server) handleGetWebsitesIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsitesIDOperation,
+			ID:   "get-websites-id",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsitesIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsitesIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsitesIDParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsitesIDRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsitesIDOperation,
+			OperationSummary: "Get Website",
+			OperationID:      "get-websites-id",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsitesIDParams
+			Response = GetWebsitesIDRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsitesIDParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsitesID(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsitesID(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsitesIDResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsitesIDDeletionRequest handles get-websites-id-deletion operation.
+//
+// Get the progress of purging the data of a deleted website. Returns not found once the purge is
+// complete.
+//
+// GET /websites/{hostname}/deletion
+func (s *Server) handleGetWebsitesIDDeletionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsitesIDDeletionOperation,
+			ID:   "get-websites-id-deletion",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsitesIDDeletionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsitesIDDeletionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsitesIDDeletionParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsitesIDDeletionRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsitesIDDeletionOperation,
+			OperationSummary: "Get Website Deletion",
+			OperationID:      "get-websites-id-deletion",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsitesIDDeletionParams
+			Response = GetWebsitesIDDeletionRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsitesIDDeletionParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsitesIDDeletion(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsitesIDDeletion(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsitesIDDeletionResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsitesIDGoalsRequest handles get-websites-id-goals operation.
+//
+// Get a list of all conversion goals for a website.
+//
+// GET /websites/{hostname}/goals
+func (s *Server) handleGetWebsitesIDGoalsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsitesIDGoalsOperation,
+			ID:   "get-websites-id-goals",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsitesIDGoalsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsitesIDGoalsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsitesIDGoalsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsitesIDGoalsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsitesIDGoalsOperation,
+			OperationSummary: "List Goals",
+			OperationID:      "get-websites-id-goals",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsitesIDGoalsParams
+			Response = GetWebsitesIDGoalsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsitesIDGoalsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsitesIDGoals(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsitesIDGoals(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsitesIDGoalsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsitesIDWebhooksRequest handles get-websites-id-webhooks operation.
+//
+// Get a list of the webhooks of a website. Only owners and admins can manage webhooks.
+//
+// GET /websites/{hostname}/webhooks
+func (s *Server) handleGetWebsitesIDWebhooksRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsitesIDWebhooksOperation,
+			ID:   "get-websites-id-webhooks",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsitesIDWebhooksOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsitesIDWebhooksOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsitesIDWebhooksParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsitesIDWebhooksRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsitesIDWebhooksOperation,
+			OperationSummary: "List Webhooks",
+			OperationID:      "get-websites-id-webhooks",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsitesIDWebhooksParams
+			Response = GetWebsitesIDWebhooksRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsitesIDWebhooksParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsitesIDWebhooks(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsitesIDWebhooks(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsitesIDWebhooksResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWebsitesIDWebhooksIDDeliveriesRequest handles get-websites-id-webhooks-id-deliveries operation.
+//
+// Get the delivery log of a webhook, newest first. Deliveries are kept for 30 days.
+//
+// GET /websites/{hostname}/webhooks/{webhookId}/deliveries
+func (s *Server) handleGetWebsitesIDWebhooksIDDeliveriesRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWebsitesIDWebhooksIDDeliveriesOperation,
+			ID:   "get-websites-id-webhooks-id-deliveries",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsitesIDWebhooksIDDeliveriesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWebsitesIDWebhooksIDDeliveriesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWebsitesIDWebhooksIDDeliveriesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetWebsitesIDWebhooksIDDeliveriesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWebsitesIDWebhooksIDDeliveriesOperation,
+			OperationSummary: "List Webhook Deliveries",
+			OperationID:      "get-websites-id-webhooks-id-deliveries",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "webhookId",
+					In:   "path",
+				}: params.WebhookId,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWebsitesIDWebhooksIDDeliveriesParams
+			Response = GetWebsitesIDWebhooksIDDeliveriesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWebsitesIDWebhooksIDDeliveriesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWebsitesIDWebhooksIDDeliveries(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWebsitesIDWebhooksIDDeliveries(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWebsitesIDWebhooksIDDeliveriesResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePatchReportsIDRequest handles patch-reports-id operation.
+//
+// Update the recipient, frequency or stats of an email report.
+//
+// PATCH /reports/{reportId}
+func (s *Server) handlePatchReportsIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PatchReportsIDOperation,
+			ID:   "patch-reports-id",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, PatchReportsIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodePatchReportsIDParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodePatchReportsIDRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response PatchReportsIDRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PatchReportsIDOperation,
+			OperationSummary: "Update Email Report",
+			OperationID:      "patch-reports-id",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "_me_sess",
+					In:   "cookie",
+				}: params.MeSess,
+				{
+					Name: "reportId",
+					In:   "path",
+				}: params.ReportId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *ReportPatch
+			Params   = PatchReportsIDParams
+			Response = PatchReportsIDRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPatchReportsIDParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PatchReportsID(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PatchReportsID(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodePatchReportsIDResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePatchTenantSettingsRequest handles patch-tenant-settings operation.
+//
+// Partial update of tenant settings.
+//
+// PATCH /tenant/settings
+func (s *Server) handlePatchTenantSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PatchTenantSettingsOperation,
+			ID:   "patch-tenant-settings",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, PatchTenantSettingsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodePatchTenantSettingsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodePatchTenantSettingsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response PatchTenantSettingsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PatchTenantSettingsOperation,
+			OperationSummary: "Update Tenant Settings",
+			OperationID:      "patch-tenant-settings",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "_me_sess",
+					In:   "cookie",
+				}: params.MeSess,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *TenantSettings
+			Params   = PatchTenantSettingsParams
+			Response = PatchTenantSettingsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPatchTenantSettingsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PatchTenantSettings(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PatchTenantSettings(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodePatchTenantSettingsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePatchUserRequest handles patch-user operation.
+//
+// Update a user account's details.
+//
+// PATCH /user
+func (s *Server) handlePatchUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PatchUserOperation,
+			ID:   "patch-user",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, PatchUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodePatchUserParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodePatchUserRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response PatchUserRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PatchUserOperation,
+			OperationSummary: "Update User Info",
+			OperationID:      "patch-user",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "_me_sess",
+					In:   "cookie",
+				}: params.MeSess,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *UserPatch
+			Params   = PatchUserParams
+			Response = PatchUserRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPatchUserParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PatchUser(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PatchUser(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodePatchUserResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePatchUsersIDRequest handles patch-users-id operation.
+//
+// Update the role or website memberships of a user. The role of the owner can not be changed. Only
+// owners and admins can update users.
+//
+// PATCH /users/{userId}
+func (s *Server) handlePatchUsersIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PatchUsersIDOperation,
+			ID:   "patch-users-id",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, PatchUsersIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodePatchUsersIDParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodePatchUsersIDRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response PatchUsersIDRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PatchUsersIDOperation,
+			OperationSummary: "Update User",
+			OperationID:      "patch-users-id",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "_me_sess",
+					In:   "cookie",
+				}: params.MeSess,
+				{
+					Name: "userId",
+					In:   "path",
+				}: params.UserId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *UserAccountPatch
+			Params   = PatchUsersIDParams
+			Response = PatchUsersIDRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPatchUsersIDParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PatchUsersID(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PatchUsersID(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodePatchUsersIDResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePatchWebsitesIDRequest handles patch-websites-id operation.
+//
+// Update a website's information. Changing the hostname moves all page views and custom events of
+// the website to the new hostname. If a website with the new hostname already exists, the website
+// can be merged into it by setting merge. Aliases record the page views and custom events received
+// on other hostnames under the website.
+//
+// PATCH /websites/{hostname}
+func (s *Server) handlePatchWebsitesIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PatchWebsitesIDOperation,
+			ID:   "patch-websites-id",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, PatchWebsitesIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PatchWebsitesIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodePatchWebsitesIDParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodePatchWebsitesIDRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response PatchWebsitesIDRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PatchWebsitesIDOperation,
+			OperationSummary: "Update Website",
+			OperationID:      "patch-websites-id",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *WebsitePatch
+			Params   = PatchWebsitesIDParams
+			Response = PatchWebsitesIDRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPatchWebsitesIDParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PatchWebsitesID(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PatchWebsitesID(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodePatchWebsitesIDResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePatchWebsitesIDGoalsIDRequest handles patch-websites-id-goals-id operation.
+//
+// Update a conversion goal.
+//
+// PATCH /websites/{hostname}/goals/{goalId}
+func (s *Server) handlePatchWebsitesIDGoalsIDRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PatchWebsitesIDGoalsIDOperation,
+			ID:   "patch-websites-id-goals-id",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, PatchWebsitesIDGoalsIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PatchWebsitesIDGoalsIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodePatchWebsitesIDGoalsIDParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodePatchWebsitesIDGoalsIDRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response PatchWebsitesIDGoalsIDRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PatchWebsitesIDGoalsIDOperation,
+			OperationSummary: "Update Goal",
+			OperationID:      "patch-websites-id-goals-id",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+				{
+					Name: "goalId",
+					In:   "path",
+				}: params.GoalId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *GoalPatch
+			Params   = PatchWebsitesIDGoalsIDParams
+			Response = PatchWebsitesIDGoalsIDRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPatchWebsitesIDGoalsIDParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PatchWebsitesIDGoalsID(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PatchWebsitesIDGoalsID(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodePatchWebsitesIDGoalsIDResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePostAPIKeysRequest handles post-api-keys operation.
+//
+// Create a new API key for programmatic access to the website and stats endpoints. The key is only
+// returned in this response and can not be retrieved again.
+//
+// POST /api-keys
+func (s *Server) handlePostAPIKeysRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PostAPIKeysOperation,
+			ID:   "post-api-keys",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, PostAPIKeysOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodePostAPIKeysParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodePostAPIKeysRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response PostAPIKeysRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PostAPIKeysOperation,
+			OperationSummary: "Add API Key",
+			OperationID:      "post-api-keys",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "_me_sess",
+					In:   "cookie",
+				}: params.MeSess,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *APIKeyCreate
+			Params   = PostAPIKeysParams
+			Response = PostAPIKeysRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPostAPIKeysParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PostAPIKeys(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PostAPIKeys(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodePostAPIKeysResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePostAuthLoginRequest handles post-auth-login operation.
+//
+// Login to the service and retrieve a session token for authentication.
+//
+// POST /auth/login
+func (s *Server) handlePostAuthLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PostAuthLoginOperation,
+			ID:   "post-auth-login",
+		}
+	)
+	params, err := decodePostAuthLoginParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodePostAuthLoginRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response PostAuthLoginRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PostAuthLoginOperation,
+			OperationSummary: "Login",
+			OperationID:      "post-auth-login",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "User-Agent",
+					In:   "header",
+				}: params.UserAgent,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *AuthLogin
+			Params   = PostAuthLoginParams
+			Response = PostAuthLoginRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPostAuthLoginParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PostAuthLogin(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PostAuthLogin(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodePostAuthLoginResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePostAuthLoginTotpRequest handles post-auth-login-totp operation.
+//
+// Complete a login of a user with two-factor authentication using a code from their authenticator
+// app or an unused recovery code.
+//
+// POST /auth/login/totp
+func (s *Server) handlePostAuthLoginTotpRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PostAuthLoginTotpOperation,
+			ID:   "post-auth-login-totp",
+		}
+	)
+	params, err := decodePostAuthLoginTotpParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodePostAuthLoginTotpRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response PostAuthLoginTotpRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PostAuthLoginTotpOperation,
+			OperationSummary: "Complete Two-Factor Login",
+			OperationID:      "post-auth-login-totp",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "User-Agent",
+					In:   "header",
+				}: params.UserAgent,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *AuthLoginTOTP
+			Params   = PostAuthLoginTotpParams
+			Response = PostAuthLoginTotpRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPostAuthLoginTotpParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PostAuthLoginTotp(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PostAuthLoginTotp(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodePostAuthLoginTotpResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePostAuthLogoutRequest handles post-auth-logout operation.
+//
+// Logout of the service and destroy the session token.
+//
+// POST /auth/logout
+func (s *Server) handlePostAuthLogoutRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PostAuthLogoutOperation,
+			ID:   "post-auth-logout",
+		}
+	)
+	params, err := decodePostAuthLogoutParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response PostAuthLogoutRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PostAuthLogoutOperation,
+			OperationSummary: "Logout",
+			OperationID:      "post-auth-logout",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "_me_sess",
+					In:   "cookie",
+				}: params.MeSess,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = PostAuthLogoutParams
+			Response = PostAuthLogoutRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPostAuthLogoutParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PostAuthLogout(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PostAuthLogout(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodePostAuthLogoutResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePostEventHitRequest handles post-event-hit operation.
+//
+// Send a hit event to register a user view or interaction.
+//
+// POST /event/hit
+func (s *Server) handlePostEventHitRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PostEventHitOperation,
+			ID:   "post-event-hit",
+		}
+	)
+	params, err := decodePostEventHitParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodePostEventHitRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response PostEventHitRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PostEventHitOperation,
+			OperationSummary: "Send Hit Event",
+			OperationID:      "post-event-hit",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
 				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
+					Name: "User-Agent",
+					In:   "header",
+				}: params.UserAgent,
 				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
+					Name: "Accept-Language",
+					In:   "header",
+				}: params.AcceptLanguage,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetWebsiteIDTimeParams
-			Response = GetWebsiteIDTimeRes
+			Request  = EventHit
+			Params   = PostEventHitParams
+			Response = PostEventHitRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3337,14 +9216,14 @@ func (s *Server) handleGetWebsiteIDTimeRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetWebsiteIDTimeParams,
+			unpackPostEventHitParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWebsiteIDTime(ctx, params)
+				response, err = s.h.PostEventHit(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWebsiteIDTime(ctx, params)
+		response, err = s.h.PostEventHit(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3352,7 +9231,7 @@ func (s *Server) handleGetWebsiteIDTimeRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetWebsiteIDTimeResponse(response, w); err != nil {
+	if err := encodePostEventHitResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3361,12 +9240,13 @@ func (s *Server) handleGetWebsiteIDTimeRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetWebsitesRequest handles get-websites operation.
+// handlePostReportsRequest handles post-reports operation.
 //
-// Get a list of all websites from the user.
+// Subscribe to a scheduled email report of the stats of a website. The first report is sent at the
+// end of the current period.
 //
-// GET /websites
-func (s *Server) handleGetWebsitesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /reports
+func (s *Server) handlePostReportsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -3374,15 +9254,15 @@ func (s *Server) handleGetWebsitesRequest(args [0]string, argsEscaped bool, w ht
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetWebsitesOperation,
-			ID:   "get-websites",
+			Name: PostReportsOperation,
+			ID:   "post-reports",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsitesOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, PostReportsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3422,7 +9302,7 @@ func (s *Server) handleGetWebsitesRequest(args [0]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodeGetWebsitesParams(args, argsEscaped, r)
+	params, err := decodePostReportsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3434,33 +9314,44 @@ func (s *Server) handleGetWebsitesRequest(args [0]string, argsEscaped bool, w ht
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodePostReportsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetWebsitesRes
+	var response PostReportsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWebsitesOperation,
-			OperationSummary: "List Websites",
-			OperationID:      "get-websites",
-			Body:             nil,
+			OperationName:    PostReportsOperation,
+			OperationSummary: "Add Email Report",
+			OperationID:      "post-reports",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
 					Name: "_me_sess",
 					In:   "cookie",
 				}: params.MeSess,
-				{
-					Name: "summary",
-					In:   "query",
-				}: params.Summary,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetWebsitesParams
-			Response = GetWebsitesRes
+			Request  = *ReportCreate
+			Params   = PostReportsParams
+			Response = PostReportsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3469,14 +9360,14 @@ func (s *Server) handleGetWebsitesRequest(args [0]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackGetWebsitesParams,
+			unpackPostReportsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWebsites(ctx, params)
+				response, err = s.h.PostReports(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWebsites(ctx, params)
+		response, err = s.h.PostReports(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3484,7 +9375,7 @@ func (s *Server) handleGetWebsitesRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeGetWebsitesResponse(response, w); err != nil {
+	if err := encodePostReportsResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3493,12 +9384,14 @@ func (s *Server) handleGetWebsitesRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleGetWebsitesIDRequest handles get-websites-id operation.
+// handlePostUserTotpRequest handles post-user-totp operation.
 //
-// Get website details for an individual website.
+// Generate a new TOTP secret for the user to add to their authenticator app. Two-factor
+// authentication is only enabled once a code is confirmed, and is only available if the server is
+// configured with a TOTP secret.
 //
-// GET /websites/{hostname}
-func (s *Server) handleGetWebsitesIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /user/totp
+func (s *Server) handlePostUserTotpRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -3506,15 +9399,15 @@ func (s *Server) handleGetWebsitesIDRequest(args [1]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetWebsitesIDOperation,
-			ID:   "get-websites-id",
+			Name: PostUserTotpOperation,
+			ID:   "post-user-totp",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetWebsitesIDOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, PostUserTotpOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3554,7 +9447,7 @@ func (s *Server) handleGetWebsitesIDRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeGetWebsitesIDParams(args, argsEscaped, r)
+	params, err := decodePostUserTotpParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3567,13 +9460,13 @@ func (s *Server) handleGetWebsitesIDRequest(args [1]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response GetWebsitesIDRes
+	var response PostUserTotpRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWebsitesIDOperation,
-			OperationSummary: "Get Website",
-			OperationID:      "get-websites-id",
+			OperationName:    PostUserTotpOperation,
+			OperationSummary: "Start Two-Factor Enrolment",
+			OperationID:      "post-user-totp",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -3581,18 +9474,14 @@ func (s *Server) handleGetWebsitesIDRequest(args [1]string, argsEscaped bool, w
 					Name: "_me_sess",
 					In:   "cookie",
 				}: params.MeSess,
-				{
-					Name: "hostname",
-					In:   "path",
-				}: params.Hostname,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetWebsitesIDParams
-			Response = GetWebsitesIDRes
+			Params   = PostUserTotpParams
+			Response = PostUserTotpRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3601,14 +9490,14 @@ func (s *Server) handleGetWebsitesIDRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetWebsitesIDParams,
+			unpackPostUserTotpParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWebsitesID(ctx, params)
+				response, err = s.h.PostUserTotp(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWebsitesID(ctx, params)
+		response, err = s.h.PostUserTotp(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3616,7 +9505,7 @@ func (s *Server) handleGetWebsitesIDRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetWebsitesIDResponse(response, w); err != nil {
+	if err := encodePostUserTotpResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3625,12 +9514,13 @@ func (s *Server) handleGetWebsitesIDRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handlePatchTenantSettingsRequest handles patch-tenant-settings operation.
+// handlePostUserTotpConfirmRequest handles post-user-totp-confirm operation.
 //
-// Partial update of tenant settings.
+// Enable two-factor authentication with a code from the authenticator app. The returned recovery
+// codes are only shown once.
 //
-// PATCH /tenant/settings
-func (s *Server) handlePatchTenantSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /user/totp/confirm
+func (s *Server) handlePostUserTotpConfirmRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -3638,15 +9528,15 @@ func (s *Server) handlePatchTenantSettingsRequest(args [0]string, argsEscaped bo
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PatchTenantSettingsOperation,
-			ID:   "patch-tenant-settings",
+			Name: PostUserTotpConfirmOperation,
+			ID:   "post-user-totp-confirm",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, PatchTenantSettingsOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, PostUserTotpConfirmOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3686,7 +9576,7 @@ func (s *Server) handlePatchTenantSettingsRequest(args [0]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodePatchTenantSettingsParams(args, argsEscaped, r)
+	params, err := decodePostUserTotpConfirmParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3698,7 +9588,7 @@ func (s *Server) handlePatchTenantSettingsRequest(args [0]string, argsEscaped bo
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodePatchTenantSettingsRequest(r)
+	request, rawBody, close, err := s.decodePostUserTotpConfirmRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -3714,13 +9604,13 @@ func (s *Server) handlePatchTenantSettingsRequest(args [0]string, argsEscaped bo
 		}
 	}()
 
-	var response PatchTenantSettingsRes
+	var response PostUserTotpConfirmRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PatchTenantSettingsOperation,
-			OperationSummary: "Update Tenant Settings",
-			OperationID:      "patch-tenant-settings",
+			OperationName:    PostUserTotpConfirmOperation,
+			OperationSummary: "Confirm Two-Factor Enrolment",
+			OperationID:      "post-user-totp-confirm",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -3733,9 +9623,9 @@ func (s *Server) handlePatchTenantSettingsRequest(args [0]string, argsEscaped bo
 		}
 
 		type (
-			Request  = *TenantSettings
-			Params   = PatchTenantSettingsParams
-			Response = PatchTenantSettingsRes
+			Request  = *TOTPConfirm
+			Params   = PostUserTotpConfirmParams
+			Response = PostUserTotpConfirmRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3744,14 +9634,14 @@ func (s *Server) handlePatchTenantSettingsRequest(args [0]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackPatchTenantSettingsParams,
+			unpackPostUserTotpConfirmParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PatchTenantSettings(ctx, request, params)
+				response, err = s.h.PostUserTotpConfirm(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PatchTenantSettings(ctx, request, params)
+		response, err = s.h.PostUserTotpConfirm(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3759,7 +9649,7 @@ func (s *Server) handlePatchTenantSettingsRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodePatchTenantSettingsResponse(response, w); err != nil {
+	if err := encodePostUserTotpConfirmResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3768,12 +9658,12 @@ func (s *Server) handlePatchTenantSettingsRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handlePatchUserRequest handles patch-user operation.
+// handlePostUserTotpDisableRequest handles post-user-totp-disable operation.
 //
-// Update a user account's details.
+// Disable two-factor authentication for the user and remove their recovery codes.
 //
-// PATCH /user
-func (s *Server) handlePatchUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /user/totp/disable
+func (s *Server) handlePostUserTotpDisableRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -3781,15 +9671,15 @@ func (s *Server) handlePatchUserRequest(args [0]string, argsEscaped bool, w http
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PatchUserOperation,
-			ID:   "patch-user",
+			Name: PostUserTotpDisableOperation,
+			ID:   "post-user-totp-disable",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, PatchUserOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, PostUserTotpDisableOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3829,7 +9719,7 @@ func (s *Server) handlePatchUserRequest(args [0]string, argsEscaped bool, w http
 			return
 		}
 	}
-	params, err := decodePatchUserParams(args, argsEscaped, r)
+	params, err := decodePostUserTotpDisableParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3841,7 +9731,7 @@ func (s *Server) handlePatchUserRequest(args [0]string, argsEscaped bool, w http
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodePatchUserRequest(r)
+	request, rawBody, close, err := s.decodePostUserTotpDisableRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -3857,13 +9747,13 @@ func (s *Server) handlePatchUserRequest(args [0]string, argsEscaped bool, w http
 		}
 	}()
 
-	var response PatchUserRes
+	var response PostUserTotpDisableRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PatchUserOperation,
-			OperationSummary: "Update User Info",
-			OperationID:      "patch-user",
+			OperationName:    PostUserTotpDisableOperation,
+			OperationSummary: "Disable Two-Factor Authentication",
+			OperationID:      "post-user-totp-disable",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -3876,9 +9766,9 @@ func (s *Server) handlePatchUserRequest(args [0]string, argsEscaped bool, w http
 		}
 
 		type (
-			Request  = *UserPatch
-			Params   = PatchUserParams
-			Response = PatchUserRes
+			Request  = *TOTPDisable
+			Params   = PostUserTotpDisableParams
+			Response = PostUserTotpDisableRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3887,14 +9777,14 @@ func (s *Server) handlePatchUserRequest(args [0]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			unpackPatchUserParams,
+			unpackPostUserTotpDisableParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PatchUser(ctx, request, params)
+				response, err = s.h.PostUserTotpDisable(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PatchUser(ctx, request, params)
+		response, err = s.h.PostUserTotpDisable(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3902,7 +9792,7 @@ func (s *Server) handlePatchUserRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodePatchUserResponse(response, w); err != nil {
+	if err := encodePostUserTotpDisableResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3911,12 +9801,13 @@ func (s *Server) handlePatchUserRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handlePatchWebsitesIDRequest handles patch-websites-id operation.
+// handlePostUsersRequest handles post-users operation.
 //
-// Update a website's information.
+// Invite a new user with the given role. Viewers can only access the websites they are members of.
+// Only owners and admins can add users.
 //
-// PATCH /websites/{hostname}
-func (s *Server) handlePatchWebsitesIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /users
+func (s *Server) handlePostUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -3924,15 +9815,15 @@ func (s *Server) handlePatchWebsitesIDRequest(args [1]string, argsEscaped bool,
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PatchWebsitesIDOperation,
-			ID:   "patch-websites-id",
+			Name: PostUsersOperation,
+			ID:   "post-users",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, PatchWebsitesIDOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, PostUsersOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3972,7 +9863,7 @@ func (s *Server) handlePatchWebsitesIDRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodePatchWebsitesIDParams(args, argsEscaped, r)
+	params, err := decodePostUsersParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3984,7 +9875,7 @@ func (s *Server) handlePatchWebsitesIDRequest(args [1]string, argsEscaped bool,
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodePatchWebsitesIDRequest(r)
+	request, rawBody, close, err := s.decodePostUsersRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -4000,13 +9891,13 @@ func (s *Server) handlePatchWebsitesIDRequest(args [1]string, argsEscaped bool,
 		}
 	}()
 
-	var response PatchWebsitesIDRes
+	var response PostUsersRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PatchWebsitesIDOperation,
-			OperationSummary: "Update Website",
-			OperationID:      "patch-websites-id",
+			OperationName:    PostUsersOperation,
+			OperationSummary: "Add User",
+			OperationID:      "post-users",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -4014,102 +9905,14 @@ func (s *Server) handlePatchWebsitesIDRequest(args [1]string, argsEscaped bool,
 					Name: "_me_sess",
 					In:   "cookie",
 				}: params.MeSess,
-				{
-					Name: "hostname",
-					In:   "path",
-				}: params.Hostname,
 			},
 			Raw: r,
 		}
-
-		type (
-			Request  = *WebsitePatch
-			Params   = PatchWebsitesIDParams
-			Response = PatchWebsitesIDRes
-		)
-		response, err = middleware.HookMiddleware[
-			Request,
-			Params,
-			Response,
-		](
-			m,
-			mreq,
-			unpackPatchWebsitesIDParams,
-			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PatchWebsitesID(ctx, request, params)
-				return response, err
-			},
-		)
-	} else {
-		response, err = s.h.PatchWebsitesID(ctx, request, params)
-	}
-	if err != nil {
-		defer recordError("Internal", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-
-	if err := encodePatchWebsitesIDResponse(response, w); err != nil {
-		defer recordError("EncodeResponse", err)
-		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
-			s.cfg.ErrorHandler(ctx, w, r, err)
-		}
-		return
-	}
-}
-
-// handlePostAuthLoginRequest handles post-auth-login operation.
-//
-// Login to the service and retrieve a session token for authentication.
-//
-// POST /auth/login
-func (s *Server) handlePostAuthLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
-	statusWriter := &codeRecorder{ResponseWriter: w}
-	w = statusWriter
-	ctx := r.Context()
-
-	var (
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: PostAuthLoginOperation,
-			ID:   "post-auth-login",
-		}
-	)
-
-	var rawBody []byte
-	request, rawBody, close, err := s.decodePostAuthLoginRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
-
-	var response PostAuthLoginRes
-	if m := s.cfg.Middleware; m != nil {
-		mreq := middleware.Request{
-			Context:          ctx,
-			OperationName:    PostAuthLoginOperation,
-			OperationSummary: "Login",
-			OperationID:      "post-auth-login",
-			Body:             request,
-			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
-		}
-
-		type (
-			Request  = *AuthLogin
-			Params   = struct{}
-			Response = PostAuthLoginRes
+
+		type (
+			Request  = *UserAccountCreate
+			Params   = PostUsersParams
+			Response = PostUsersRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4118,14 +9921,14 @@ func (s *Server) handlePostAuthLoginRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackPostUsersParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PostAuthLogin(ctx, request)
+				response, err = s.h.PostUsers(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PostAuthLogin(ctx, request)
+		response, err = s.h.PostUsers(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4133,7 +9936,7 @@ func (s *Server) handlePostAuthLoginRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodePostAuthLoginResponse(response, w); err != nil {
+	if err := encodePostUsersResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4142,12 +9945,12 @@ func (s *Server) handlePostAuthLoginRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handlePostAuthLogoutRequest handles post-auth-logout operation.
+// handlePostWebsitesRequest handles post-websites operation.
 //
-// Logout of the service and destroy the session token.
+// Add a new website.
 //
-// POST /auth/logout
-func (s *Server) handlePostAuthLogoutRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /websites
+func (s *Server) handlePostWebsitesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -4155,45 +9958,107 @@ func (s *Server) handlePostAuthLogoutRequest(args [0]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PostAuthLogoutOperation,
-			ID:   "post-auth-logout",
+			Name: PostWebsitesOperation,
+			ID:   "post-websites",
 		}
 	)
-	params, err := decodePostAuthLogoutParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, PostWebsitesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PostWebsitesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodePostWebsitesRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var rawBody []byte
-
-	var response PostAuthLogoutRes
+	var response PostWebsitesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PostAuthLogoutOperation,
-			OperationSummary: "Logout",
-			OperationID:      "post-auth-logout",
-			Body:             nil,
+			OperationName:    PostWebsitesOperation,
+			OperationSummary: "Add Website",
+			OperationID:      "post-websites",
+			Body:             request,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "_me_sess",
-					In:   "cookie",
-				}: params.MeSess,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = PostAuthLogoutParams
-			Response = PostAuthLogoutRes
+			Request  = *WebsiteCreate
+			Params   = struct{}
+			Response = PostWebsitesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4202,14 +10067,14 @@ func (s *Server) handlePostAuthLogoutRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackPostAuthLogoutParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PostAuthLogout(ctx, params)
+				response, err = s.h.PostWebsites(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PostAuthLogout(ctx, params)
+		response, err = s.h.PostWebsites(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4217,7 +10082,7 @@ func (s *Server) handlePostAuthLogoutRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodePostAuthLogoutResponse(response, w); err != nil {
+	if err := encodePostWebsitesResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4226,12 +10091,13 @@ func (s *Server) handlePostAuthLogoutRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handlePostEventHitRequest handles post-event-hit operation.
+// handlePostWebsitesIDGoalsRequest handles post-websites-id-goals operation.
 //
-// Send a hit event to register a user view or interaction.
+// Add a new conversion goal to a website. Pathname goals match page views by a glob pattern, while
+// event goals match custom event properties by name and optionally value.
 //
-// POST /event/hit
-func (s *Server) handlePostEventHitRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /websites/{hostname}/goals
+func (s *Server) handlePostWebsitesIDGoalsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -4239,11 +10105,73 @@ func (s *Server) handlePostEventHitRequest(args [0]string, argsEscaped bool, w h
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PostEventHitOperation,
-			ID:   "post-event-hit",
+			Name: PostWebsitesIDGoalsOperation,
+			ID:   "post-websites-id-goals",
 		}
 	)
-	params, err := decodePostEventHitParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, PostWebsitesIDGoalsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PostWebsitesIDGoalsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodePostWebsitesIDGoalsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4255,7 +10183,7 @@ func (s *Server) handlePostEventHitRequest(args [0]string, argsEscaped bool, w h
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodePostEventHitRequest(r)
+	request, rawBody, close, err := s.decodePostWebsitesIDGoalsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -4271,32 +10199,28 @@ func (s *Server) handlePostEventHitRequest(args [0]string, argsEscaped bool, w h
 		}
 	}()
 
-	var response PostEventHitRes
+	var response PostWebsitesIDGoalsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PostEventHitOperation,
-			OperationSummary: "Send Hit Event",
-			OperationID:      "post-event-hit",
+			OperationName:    PostWebsitesIDGoalsOperation,
+			OperationSummary: "Add Goal",
+			OperationID:      "post-websites-id-goals",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "User-Agent",
-					In:   "header",
-				}: params.UserAgent,
-				{
-					Name: "Accept-Language",
-					In:   "header",
-				}: params.AcceptLanguage,
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = EventHit
-			Params   = PostEventHitParams
-			Response = PostEventHitRes
+			Request  = *GoalCreate
+			Params   = PostWebsitesIDGoalsParams
+			Response = PostWebsitesIDGoalsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4305,14 +10229,14 @@ func (s *Server) handlePostEventHitRequest(args [0]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackPostEventHitParams,
+			unpackPostWebsitesIDGoalsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PostEventHit(ctx, request, params)
+				response, err = s.h.PostWebsitesIDGoals(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PostEventHit(ctx, request, params)
+		response, err = s.h.PostWebsitesIDGoals(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4320,7 +10244,7 @@ func (s *Server) handlePostEventHitRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodePostEventHitResponse(response, w); err != nil {
+	if err := encodePostWebsitesIDGoalsResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4329,12 +10253,13 @@ func (s *Server) handlePostEventHitRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handlePostWebsitesRequest handles post-websites operation.
+// handlePostWebsitesIDWebhooksRequest handles post-websites-id-webhooks operation.
 //
-// Add a new website.
+// Create a webhook posting a signed JSON payload to a URL when its trigger fires. The signing secret
+// is only returned in this response.
 //
-// POST /websites
-func (s *Server) handlePostWebsitesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /websites/{hostname}/webhooks
+func (s *Server) handlePostWebsitesIDWebhooksRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -4342,15 +10267,15 @@ func (s *Server) handlePostWebsitesRequest(args [0]string, argsEscaped bool, w h
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PostWebsitesOperation,
-			ID:   "post-websites",
+			Name: PostWebsitesIDWebhooksOperation,
+			ID:   "post-websites-id-webhooks",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, PostWebsitesOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, PostWebsitesIDWebhooksOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4366,11 +10291,29 @@ func (s *Server) handlePostWebsitesRequest(args [0]string, argsEscaped bool, w h
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PostWebsitesIDWebhooksOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -4390,9 +10333,19 @@ func (s *Server) handlePostWebsitesRequest(args [0]string, argsEscaped bool, w h
 			return
 		}
 	}
+	params, err := decodePostWebsitesIDWebhooksParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodePostWebsitesRequest(r)
+	request, rawBody, close, err := s.decodePostWebsitesIDWebhooksRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -4408,23 +10361,28 @@ func (s *Server) handlePostWebsitesRequest(args [0]string, argsEscaped bool, w h
 		}
 	}()
 
-	var response PostWebsitesRes
+	var response PostWebsitesIDWebhooksRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PostWebsitesOperation,
-			OperationSummary: "Add Website",
-			OperationID:      "post-websites",
+			OperationName:    PostWebsitesIDWebhooksOperation,
+			OperationSummary: "Add Webhook",
+			OperationID:      "post-websites-id-webhooks",
 			Body:             request,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "hostname",
+					In:   "path",
+				}: params.Hostname,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *WebsiteCreate
-			Params   = struct{}
-			Response = PostWebsitesRes
+			Request  = *WebhookCreate
+			Params   = PostWebsitesIDWebhooksParams
+			Response = PostWebsitesIDWebhooksRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4433,14 +10391,14 @@ func (s *Server) handlePostWebsitesRequest(args [0]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			nil,
+			unpackPostWebsitesIDWebhooksParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PostWebsites(ctx, request)
+				response, err = s.h.PostWebsitesIDWebhooks(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PostWebsites(ctx, request)
+		response, err = s.h.PostWebsitesIDWebhooks(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4448,7 +10406,7 @@ func (s *Server) handlePostWebsitesRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodePostWebsitesResponse(response, w); err != nil {
+	if err := encodePostWebsitesIDWebhooksResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
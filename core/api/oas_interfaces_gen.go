@@ -1,18 +1,74 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type DeleteAPIKeysIDRes interface {
+	deleteAPIKeysIDRes()
+}
+
+type DeleteAuthLockoutsRes interface {
+	deleteAuthLockoutsRes()
+}
+
+type DeleteReportsIDRes interface {
+	deleteReportsIDRes()
+}
+
 type DeleteUserRes interface {
 	deleteUserRes()
 }
 
+type DeleteUserSessionsRes interface {
+	deleteUserSessionsRes()
+}
+
+type DeleteUsersIDRes interface {
+	deleteUsersIDRes()
+}
+
+type DeleteWebsitesIDGoalsIDRes interface {
+	deleteWebsitesIDGoalsIDRes()
+}
+
 type DeleteWebsitesIDRes interface {
 	deleteWebsitesIDRes()
 }
 
+type DeleteWebsitesIDWebhooksIDRes interface {
+	deleteWebsitesIDWebhooksIDRes()
+}
+
+type GetAPIKeysRes interface {
+	getAPIKeysRes()
+}
+
+type GetAuditRes interface {
+	getAuditRes()
+}
+
+type GetAuthLockoutsRes interface {
+	getAuthLockoutsRes()
+}
+
+type GetAuthMethodsRes interface {
+	getAuthMethodsRes()
+}
+
+type GetAuthOidcCallbackRes interface {
+	getAuthOidcCallbackRes()
+}
+
+type GetAuthOidcLoginRes interface {
+	getAuthOidcLoginRes()
+}
+
 type GetEventPingRes interface {
 	getEventPingRes()
 }
 
+type GetReportsRes interface {
+	getReportsRes()
+}
+
 type GetTenantSettingsRes interface {
 	getTenantSettingsRes()
 }
@@ -21,10 +77,18 @@ type GetUserRes interface {
 	getUserRes()
 }
 
+type GetUserSessionsRes interface {
+	getUserSessionsRes()
+}
+
 type GetUserUsageRes interface {
 	getUserUsageRes()
 }
 
+type GetUsersRes interface {
+	getUsersRes()
+}
+
 type GetWebsiteIDBrowsersRes interface {
 	getWebsiteIDBrowsersRes()
 }
@@ -41,6 +105,18 @@ type GetWebsiteIDDeviceRes interface {
 	getWebsiteIDDeviceRes()
 }
 
+type GetWebsiteIDFunnelRes interface {
+	getWebsiteIDFunnelRes()
+}
+
+type GetWebsiteIDGoalsRes interface {
+	getWebsiteIDGoalsRes()
+}
+
+type GetWebsiteIDHostnamesRes interface {
+	getWebsiteIDHostnamesRes()
+}
+
 type GetWebsiteIDLanguageRes interface {
 	getWebsiteIDLanguageRes()
 }
@@ -53,6 +129,14 @@ type GetWebsiteIDOsRes interface {
 	getWebsiteIDOsRes()
 }
 
+type GetWebsiteIDPagesEntryRes interface {
+	getWebsiteIDPagesEntryRes()
+}
+
+type GetWebsiteIDPagesExitRes interface {
+	getWebsiteIDPagesExitRes()
+}
+
 type GetWebsiteIDPagesRes interface {
 	getWebsiteIDPagesRes()
 }
@@ -61,6 +145,10 @@ type GetWebsiteIDPropertiesRes interface {
 	getWebsiteIDPropertiesRes()
 }
 
+type GetWebsiteIDRealtimeRes interface {
+	getWebsiteIDRealtimeRes()
+}
+
 type GetWebsiteIDReferrersRes interface {
 	getWebsiteIDReferrersRes()
 }
@@ -77,14 +165,34 @@ type GetWebsiteIDTimeRes interface {
 	getWebsiteIDTimeRes()
 }
 
+type GetWebsitesIDDeletionRes interface {
+	getWebsitesIDDeletionRes()
+}
+
+type GetWebsitesIDGoalsRes interface {
+	getWebsitesIDGoalsRes()
+}
+
 type GetWebsitesIDRes interface {
 	getWebsitesIDRes()
 }
 
+type GetWebsitesIDWebhooksIDDeliveriesRes interface {
+	getWebsitesIDWebhooksIDDeliveriesRes()
+}
+
+type GetWebsitesIDWebhooksRes interface {
+	getWebsitesIDWebhooksRes()
+}
+
 type GetWebsitesRes interface {
 	getWebsitesRes()
 }
 
+type PatchReportsIDRes interface {
+	patchReportsIDRes()
+}
+
 type PatchTenantSettingsRes interface {
 	patchTenantSettingsRes()
 }
@@ -93,14 +201,30 @@ type PatchUserRes interface {
 	patchUserRes()
 }
 
+type PatchUsersIDRes interface {
+	patchUsersIDRes()
+}
+
+type PatchWebsitesIDGoalsIDRes interface {
+	patchWebsitesIDGoalsIDRes()
+}
+
 type PatchWebsitesIDRes interface {
 	patchWebsitesIDRes()
 }
 
+type PostAPIKeysRes interface {
+	postAPIKeysRes()
+}
+
 type PostAuthLoginRes interface {
 	postAuthLoginRes()
 }
 
+type PostAuthLoginTotpRes interface {
+	postAuthLoginTotpRes()
+}
+
 type PostAuthLogoutRes interface {
 	postAuthLogoutRes()
 }
@@ -109,6 +233,34 @@ type PostEventHitRes interface {
 	postEventHitRes()
 }
 
+type PostReportsRes interface {
+	postReportsRes()
+}
+
+type PostUserTotpConfirmRes interface {
+	postUserTotpConfirmRes()
+}
+
+type PostUserTotpDisableRes interface {
+	postUserTotpDisableRes()
+}
+
+type PostUserTotpRes interface {
+	postUserTotpRes()
+}
+
+type PostUsersRes interface {
+	postUsersRes()
+}
+
+type PostWebsitesIDGoalsRes interface {
+	postWebsitesIDGoalsRes()
+}
+
+type PostWebsitesIDWebhooksRes interface {
+	postWebsitesIDWebhooksRes()
+}
+
 type PostWebsitesRes interface {
 	postWebsitesRes()
 }
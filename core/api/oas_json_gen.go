@@ -6,6 +6,7 @@ import (
 	"math/bits"
 	"net/netip"
 	"strconv"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/go-faster/jx"
@@ -14,68 +15,100 @@ import (
 )
 
 // Encode implements json.Marshaler.
-func (s *AuthLogin) Encode(e *jx.Encoder) {
+func (s *APIKeyCreate) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *AuthLogin) encodeFields(e *jx.Encoder) {
+func (s *APIKeyCreate) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("username")
-		e.Str(s.Username)
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("password")
-		e.Str(s.Password)
+		e.FieldStart("role")
+		s.Role.Encode(e)
+	}
+	{
+		if s.Hostname.Set {
+			e.FieldStart("hostname")
+			s.Hostname.Encode(e)
+		}
+	}
+	{
+		if s.ExpiresAt.Set {
+			e.FieldStart("expiresAt")
+			s.ExpiresAt.Encode(e, json.EncodeDateTime)
+		}
 	}
 }
 
-var jsonFieldsNameOfAuthLogin = [2]string{
-	0: "username",
-	1: "password",
+var jsonFieldsNameOfAPIKeyCreate = [4]string{
+	0: "name",
+	1: "role",
+	2: "hostname",
+	3: "expiresAt",
 }
 
-// Decode decodes AuthLogin from json.
-func (s *AuthLogin) Decode(d *jx.Decoder) error {
+// Decode decodes APIKeyCreate from json.
+func (s *APIKeyCreate) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode AuthLogin to nil")
+		return errors.New("invalid: unable to decode APIKeyCreate to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "username":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.Username = string(v)
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"username\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "password":
+		case "role":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Password = string(v)
-				if err != nil {
+				if err := s.Role.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
+				return errors.Wrap(err, "decode field \"role\"")
+			}
+		case "hostname":
+			if err := func() error {
+				s.Hostname.Reset()
+				if err := s.Hostname.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hostname\"")
+			}
+		case "expiresAt":
+			if err := func() error {
+				s.ExpiresAt.Reset()
+				if err := s.ExpiresAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"expiresAt\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode AuthLogin")
+		return errors.Wrap(err, "decode APIKeyCreate")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -92,8 +125,8 @@ func (s *AuthLogin) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfAuthLogin) {
-					name = jsonFieldsNameOfAuthLogin[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAPIKeyCreate) {
+					name = jsonFieldsNameOfAPIKeyCreate[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -114,67 +147,84 @@ func (s *AuthLogin) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *AuthLogin) MarshalJSON() ([]byte, error) {
+func (s *APIKeyCreate) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *AuthLogin) UnmarshalJSON(data []byte) error {
+func (s *APIKeyCreate) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *BadRequestError) Encode(e *jx.Encoder) {
+func (s *APIKeyCreated) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *BadRequestError) encodeFields(e *jx.Encoder) {
+func (s *APIKeyCreated) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("error")
-		s.Error.Encode(e)
+		e.FieldStart("key")
+		e.Str(s.Key)
+	}
+	{
+		e.FieldStart("apiKey")
+		s.ApiKey.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfBadRequestError = [1]string{
-	0: "error",
+var jsonFieldsNameOfAPIKeyCreated = [2]string{
+	0: "key",
+	1: "apiKey",
 }
 
-// Decode decodes BadRequestError from json.
-func (s *BadRequestError) Decode(d *jx.Decoder) error {
+// Decode decodes APIKeyCreated from json.
+func (s *APIKeyCreated) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode BadRequestError to nil")
+		return errors.New("invalid: unable to decode APIKeyCreated to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "error":
+		case "key":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Error.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Key = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"error\"")
+				return errors.Wrap(err, "decode field \"key\"")
+			}
+		case "apiKey":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.ApiKey.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"apiKey\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode BadRequestError")
+		return errors.Wrap(err, "decode APIKeyCreated")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -186,8 +236,8 @@ func (s *BadRequestError) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfBadRequestError) {
-					name = jsonFieldsNameOfBadRequestError[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAPIKeyCreated) {
+					name = jsonFieldsNameOfAPIKeyCreated[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -208,87 +258,169 @@ func (s *BadRequestError) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *BadRequestError) MarshalJSON() ([]byte, error) {
+func (s *APIKeyCreated) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *BadRequestError) UnmarshalJSON(data []byte) error {
+func (s *APIKeyCreated) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *BadRequestErrorError) Encode(e *jx.Encoder) {
+func (s *APIKeyGet) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *BadRequestErrorError) encodeFields(e *jx.Encoder) {
+func (s *APIKeyGet) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("code")
-		e.Int32(s.Code)
+		e.FieldStart("id")
+		e.Str(s.ID)
 	}
 	{
-		e.FieldStart("message")
-		e.Str(s.Message)
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("role")
+		s.Role.Encode(e)
+	}
+	{
+		if s.Hostname.Set {
+			e.FieldStart("hostname")
+			s.Hostname.Encode(e)
+		}
+	}
+	{
+		if s.ExpiresAt.Set {
+			e.FieldStart("expiresAt")
+			s.ExpiresAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.LastUsedAt.Set {
+			e.FieldStart("lastUsedAt")
+			s.LastUsedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
 	}
 }
 
-var jsonFieldsNameOfBadRequestErrorError = [2]string{
-	0: "code",
-	1: "message",
+var jsonFieldsNameOfAPIKeyGet = [7]string{
+	0: "id",
+	1: "name",
+	2: "role",
+	3: "hostname",
+	4: "expiresAt",
+	5: "lastUsedAt",
+	6: "createdAt",
 }
 
-// Decode decodes BadRequestErrorError from json.
-func (s *BadRequestErrorError) Decode(d *jx.Decoder) error {
+// Decode decodes APIKeyGet from json.
+func (s *APIKeyGet) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode BadRequestErrorError to nil")
+		return errors.New("invalid: unable to decode APIKeyGet to nil")
 	}
 	var requiredBitSet [1]uint8
-	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "code":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int32()
-				s.Code = int32(v)
+				v, err := d.Str()
+				s.ID = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"code\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "message":
+		case "name":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.Message = string(v)
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "role":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Role.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"role\"")
+			}
+		case "hostname":
+			if err := func() error {
+				s.Hostname.Reset()
+				if err := s.Hostname.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hostname\"")
+			}
+		case "expiresAt":
+			if err := func() error {
+				s.ExpiresAt.Reset()
+				if err := s.ExpiresAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"expiresAt\"")
+			}
+		case "lastUsedAt":
+			if err := func() error {
+				s.LastUsedAt.Reset()
+				if err := s.LastUsedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"lastUsedAt\"")
+			}
+		case "createdAt":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
 			}
 		default:
-			return errors.Errorf("unexpected field %q", k)
+			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode BadRequestErrorError")
+		return errors.Wrap(err, "decode APIKeyGet")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b01000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -300,8 +432,8 @@ func (s *BadRequestErrorError) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfBadRequestErrorError) {
-					name = jsonFieldsNameOfBadRequestErrorError[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAPIKeyGet) {
+					name = jsonFieldsNameOfAPIKeyGet[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -322,62 +454,138 @@ func (s *BadRequestErrorError) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *BadRequestErrorError) MarshalJSON() ([]byte, error) {
+func (s *APIKeyGet) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *BadRequestErrorError) UnmarshalJSON(data []byte) error {
+func (s *APIKeyGet) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes APIKeyRole as json.
+func (s APIKeyRole) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes APIKeyRole from json.
+func (s *APIKeyRole) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode APIKeyRole to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch APIKeyRole(v) {
+	case APIKeyRoleRead:
+		*s = APIKeyRoleRead
+	case APIKeyRoleAdmin:
+		*s = APIKeyRoleAdmin
+	default:
+		*s = APIKeyRole(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s APIKeyRole) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *APIKeyRole) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ConflictError) Encode(e *jx.Encoder) {
+func (s *AuditChange) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ConflictError) encodeFields(e *jx.Encoder) {
+func (s *AuditChange) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("error")
-		s.Error.Encode(e)
+		e.FieldStart("field")
+		e.Str(s.Field)
+	}
+	{
+		if s.Before.Set {
+			e.FieldStart("before")
+			s.Before.Encode(e)
+		}
+	}
+	{
+		if s.After.Set {
+			e.FieldStart("after")
+			s.After.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfConflictError = [1]string{
-	0: "error",
+var jsonFieldsNameOfAuditChange = [3]string{
+	0: "field",
+	1: "before",
+	2: "after",
 }
 
-// Decode decodes ConflictError from json.
-func (s *ConflictError) Decode(d *jx.Decoder) error {
+// Decode decodes AuditChange from json.
+func (s *AuditChange) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ConflictError to nil")
+		return errors.New("invalid: unable to decode AuditChange to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "error":
+		case "field":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Error.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Field = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"error\"")
+				return errors.Wrap(err, "decode field \"field\"")
+			}
+		case "before":
+			if err := func() error {
+				s.Before.Reset()
+				if err := s.Before.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"before\"")
+			}
+		case "after":
+			if err := func() error {
+				s.After.Reset()
+				if err := s.After.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"after\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ConflictError")
+		return errors.Wrap(err, "decode AuditChange")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -394,8 +602,8 @@ func (s *ConflictError) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfConflictError) {
-					name = jsonFieldsNameOfConflictError[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAuditChange) {
+					name = jsonFieldsNameOfAuditChange[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -416,87 +624,216 @@ func (s *ConflictError) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ConflictError) MarshalJSON() ([]byte, error) {
+func (s *AuditChange) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ConflictError) UnmarshalJSON(data []byte) error {
+func (s *AuditChange) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ConflictErrorError) Encode(e *jx.Encoder) {
+func (s *AuditEntry) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ConflictErrorError) encodeFields(e *jx.Encoder) {
+func (s *AuditEntry) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("code")
-		e.Int32(s.Code)
+		e.FieldStart("id")
+		e.Str(s.ID)
 	}
 	{
-		e.FieldStart("message")
-		e.Str(s.Message)
+		e.FieldStart("actorId")
+		e.Str(s.ActorId)
+	}
+	{
+		e.FieldStart("actorName")
+		e.Str(s.ActorName)
+	}
+	{
+		if s.ApiKeyId.Set {
+			e.FieldStart("apiKeyId")
+			s.ApiKeyId.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("action")
+		e.Str(s.Action)
+	}
+	{
+		e.FieldStart("target")
+		e.Str(s.Target)
+	}
+	{
+		e.FieldStart("changes")
+		e.ArrStart()
+		for _, elem := range s.Changes {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		if s.IP.Set {
+			e.FieldStart("ip")
+			s.IP.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("dateCreated")
+		e.Int64(s.DateCreated)
 	}
 }
 
-var jsonFieldsNameOfConflictErrorError = [2]string{
-	0: "code",
-	1: "message",
+var jsonFieldsNameOfAuditEntry = [9]string{
+	0: "id",
+	1: "actorId",
+	2: "actorName",
+	3: "apiKeyId",
+	4: "action",
+	5: "target",
+	6: "changes",
+	7: "ip",
+	8: "dateCreated",
 }
 
-// Decode decodes ConflictErrorError from json.
-func (s *ConflictErrorError) Decode(d *jx.Decoder) error {
+// Decode decodes AuditEntry from json.
+func (s *AuditEntry) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ConflictErrorError to nil")
+		return errors.New("invalid: unable to decode AuditEntry to nil")
 	}
-	var requiredBitSet [1]uint8
-	s.setDefaults()
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "code":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int32()
-				s.Code = int32(v)
+				v, err := d.Str()
+				s.ID = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"code\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "message":
+		case "actorId":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.Message = string(v)
+				s.ActorId = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"actorId\"")
+			}
+		case "actorName":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.ActorName = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"actorName\"")
+			}
+		case "apiKeyId":
+			if err := func() error {
+				s.ApiKeyId.Reset()
+				if err := s.ApiKeyId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"apiKeyId\"")
+			}
+		case "action":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.Action = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"action\"")
+			}
+		case "target":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Str()
+				s.Target = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"target\"")
+			}
+		case "changes":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				s.Changes = make([]AuditChange, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem AuditChange
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Changes = append(s.Changes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"changes\"")
+			}
+		case "ip":
+			if err := func() error {
+				s.IP.Reset()
+				if err := s.IP.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ip\"")
+			}
+		case "dateCreated":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.DateCreated = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"dateCreated\"")
 			}
 		default:
-			return errors.Errorf("unexpected field %q", k)
+			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ConflictErrorError")
+		return errors.Wrap(err, "decode AuditEntry")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
+	for i, mask := range [2]uint8{
+		0b01110111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -508,8 +845,8 @@ func (s *ConflictErrorError) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfConflictErrorError) {
-					name = jsonFieldsNameOfConflictErrorError[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAuditEntry) {
+					name = jsonFieldsNameOfAuditEntry[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -530,101 +867,86 @@ func (s *ConflictErrorError) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ConflictErrorError) MarshalJSON() ([]byte, error) {
+func (s *AuditEntry) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ConflictErrorError) UnmarshalJSON(data []byte) error {
+func (s *AuditEntry) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *EventCustom) Encode(e *jx.Encoder) {
+func (s *AuthLogin) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *EventCustom) encodeFields(e *jx.Encoder) {
-	{
-		if s.B.Set {
-			e.FieldStart("b")
-			s.B.Encode(e)
-		}
-	}
+func (s *AuthLogin) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("g")
-		e.Str(s.G)
+		e.FieldStart("username")
+		e.Str(s.Username)
 	}
 	{
-		e.FieldStart("d")
-		s.D.Encode(e)
+		e.FieldStart("password")
+		e.Str(s.Password)
 	}
 }
 
-var jsonFieldsNameOfEventCustom = [3]string{
-	0: "b",
-	1: "g",
-	2: "d",
+var jsonFieldsNameOfAuthLogin = [2]string{
+	0: "username",
+	1: "password",
 }
 
-// Decode decodes EventCustom from json.
-func (s *EventCustom) Decode(d *jx.Decoder) error {
+// Decode decodes AuthLogin from json.
+func (s *AuthLogin) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EventCustom to nil")
+		return errors.New("invalid: unable to decode AuthLogin to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "b":
+		case "username":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.B.Reset()
-				if err := s.B.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Username = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"b\"")
+				return errors.Wrap(err, "decode field \"username\"")
 			}
-		case "g":
+		case "password":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.G = string(v)
+				s.Password = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"g\"")
-			}
-		case "d":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				if err := s.D.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"d\"")
+				return errors.Wrap(err, "decode field \"password\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode EventCustom")
+		return errors.Wrap(err, "decode AuthLogin")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000110,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -636,8 +958,8 @@ func (s *EventCustom) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfEventCustom) {
-					name = jsonFieldsNameOfEventCustom[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAuthLogin) {
+					name = jsonFieldsNameOfAuthLogin[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -658,447 +980,6417 @@ func (s *EventCustom) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EventCustom) MarshalJSON() ([]byte, error) {
+func (s *AuthLogin) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EventCustom) UnmarshalJSON(data []byte) error {
+func (s *AuthLogin) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s EventCustomD) Encode(e *jx.Encoder) {
+func (s *AuthLoginChallenge) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s EventCustomD) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		elem.Encode(e)
+// encodeFields encodes fields.
+func (s *AuthLoginChallenge) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("challenge")
+		e.Str(s.Challenge)
 	}
 }
 
-// Decode decodes EventCustomD from json.
-func (s *EventCustomD) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfAuthLoginChallenge = [1]string{
+	0: "challenge",
+}
+
+// Decode decodes AuthLoginChallenge from json.
+func (s *AuthLoginChallenge) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EventCustomD to nil")
+		return errors.New("invalid: unable to decode AuthLoginChallenge to nil")
 	}
-	m := s.init()
+	var requiredBitSet [1]uint8
+
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem EventCustomDItem
-		if err := func() error {
-			if err := elem.Decode(d); err != nil {
-				return err
+		switch string(k) {
+		case "challenge":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Challenge = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"challenge\"")
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+		default:
+			return d.Skip()
 		}
-		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode EventCustomD")
+		return errors.Wrap(err, "decode AuthLoginChallenge")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfAuthLoginChallenge) {
+					name = jsonFieldsNameOfAuthLoginChallenge[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s EventCustomD) MarshalJSON() ([]byte, error) {
+func (s *AuthLoginChallenge) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EventCustomD) UnmarshalJSON(data []byte) error {
+func (s *AuthLoginChallenge) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EventCustomDItem as json.
-func (s EventCustomDItem) Encode(e *jx.Encoder) {
-	switch s.Type {
-	case StringEventCustomDItem:
-		e.Str(s.String)
-	case IntEventCustomDItem:
-		e.Int(s.Int)
-	case BoolEventCustomDItem:
-		e.Bool(s.Bool)
+// Encode implements json.Marshaler.
+func (s *AuthLoginTOTP) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *AuthLoginTOTP) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("challenge")
+		e.Str(s.Challenge)
+	}
+	{
+		e.FieldStart("code")
+		e.Str(s.Code)
 	}
 }
 
-// Decode decodes EventCustomDItem from json.
-func (s *EventCustomDItem) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfAuthLoginTOTP = [2]string{
+	0: "challenge",
+	1: "code",
+}
+
+// Decode decodes AuthLoginTOTP from json.
+func (s *AuthLoginTOTP) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EventCustomDItem to nil")
+		return errors.New("invalid: unable to decode AuthLoginTOTP to nil")
 	}
-	// Sum type type_discriminator.
-	switch t := d.Next(); t {
-	case jx.Bool:
-		v, err := d.Bool()
-		s.Bool = bool(v)
-		if err != nil {
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "challenge":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Challenge = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"challenge\"")
+			}
+		case "code":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Code = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"code\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode AuthLoginTOTP")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfAuthLoginTOTP) {
+					name = jsonFieldsNameOfAuthLoginTOTP[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *AuthLoginTOTP) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *AuthLoginTOTP) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *AuthMethods) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *AuthMethods) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("password")
+		e.Bool(s.Password)
+	}
+	{
+		e.FieldStart("sso")
+		e.Bool(s.SSO)
+	}
+}
+
+var jsonFieldsNameOfAuthMethods = [2]string{
+	0: "password",
+	1: "sso",
+}
+
+// Decode decodes AuthMethods from json.
+func (s *AuthMethods) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode AuthMethods to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "password":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Bool()
+				s.Password = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"password\"")
+			}
+		case "sso":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Bool()
+				s.SSO = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sso\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode AuthMethods")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfAuthMethods) {
+					name = jsonFieldsNameOfAuthMethods[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *AuthMethods) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *AuthMethods) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *BadRequestError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *BadRequestError) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("error")
+		s.Error.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfBadRequestError = [1]string{
+	0: "error",
+}
+
+// Decode decodes BadRequestError from json.
+func (s *BadRequestError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode BadRequestError to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "error":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Error.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode BadRequestError")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfBadRequestError) {
+					name = jsonFieldsNameOfBadRequestError[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *BadRequestError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *BadRequestError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *BadRequestErrorError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *BadRequestErrorError) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("code")
+		e.Int32(s.Code)
+	}
+	{
+		e.FieldStart("message")
+		e.Str(s.Message)
+	}
+}
+
+var jsonFieldsNameOfBadRequestErrorError = [2]string{
+	0: "code",
+	1: "message",
+}
+
+// Decode decodes BadRequestErrorError from json.
+func (s *BadRequestErrorError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode BadRequestErrorError to nil")
+	}
+	var requiredBitSet [1]uint8
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "code":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int32()
+				s.Code = int32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"code\"")
+			}
+		case "message":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode BadRequestErrorError")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfBadRequestErrorError) {
+					name = jsonFieldsNameOfBadRequestErrorError[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *BadRequestErrorError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *BadRequestErrorError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ConflictError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ConflictError) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("error")
+		s.Error.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfConflictError = [1]string{
+	0: "error",
+}
+
+// Decode decodes ConflictError from json.
+func (s *ConflictError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ConflictError to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "error":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Error.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ConflictError")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfConflictError) {
+					name = jsonFieldsNameOfConflictError[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ConflictError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ConflictError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ConflictErrorError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ConflictErrorError) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("code")
+		e.Int32(s.Code)
+	}
+	{
+		e.FieldStart("message")
+		e.Str(s.Message)
+	}
+}
+
+var jsonFieldsNameOfConflictErrorError = [2]string{
+	0: "code",
+	1: "message",
+}
+
+// Decode decodes ConflictErrorError from json.
+func (s *ConflictErrorError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ConflictErrorError to nil")
+	}
+	var requiredBitSet [1]uint8
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "code":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int32()
+				s.Code = int32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"code\"")
+			}
+		case "message":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ConflictErrorError")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfConflictErrorError) {
+					name = jsonFieldsNameOfConflictErrorError[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ConflictErrorError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ConflictErrorError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *EventCustom) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *EventCustom) encodeFields(e *jx.Encoder) {
+	{
+		if s.B.Set {
+			e.FieldStart("b")
+			s.B.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("g")
+		e.Str(s.G)
+	}
+	{
+		e.FieldStart("d")
+		s.D.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfEventCustom = [3]string{
+	0: "b",
+	1: "g",
+	2: "d",
+}
+
+// Decode decodes EventCustom from json.
+func (s *EventCustom) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EventCustom to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "b":
+			if err := func() error {
+				s.B.Reset()
+				if err := s.B.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"b\"")
+			}
+		case "g":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.G = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"g\"")
+			}
+		case "d":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.D.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"d\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EventCustom")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000110,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEventCustom) {
+					name = jsonFieldsNameOfEventCustom[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *EventCustom) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EventCustom) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s EventCustomD) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s EventCustomD) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		elem.Encode(e)
+	}
+}
+
+// Decode decodes EventCustomD from json.
+func (s *EventCustomD) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EventCustomD to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem EventCustomDItem
+		if err := func() error {
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EventCustomD")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s EventCustomD) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EventCustomD) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EventCustomDItem as json.
+func (s EventCustomDItem) Encode(e *jx.Encoder) {
+	switch s.Type {
+	case StringEventCustomDItem:
+		e.Str(s.String)
+	case IntEventCustomDItem:
+		e.Int(s.Int)
+	case BoolEventCustomDItem:
+		e.Bool(s.Bool)
+	}
+}
+
+// Decode decodes EventCustomDItem from json.
+func (s *EventCustomDItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EventCustomDItem to nil")
+	}
+	// Sum type type_discriminator.
+	switch t := d.Next(); t {
+	case jx.Bool:
+		v, err := d.Bool()
+		s.Bool = bool(v)
+		if err != nil {
+			return err
+		}
+		s.Type = BoolEventCustomDItem
+	case jx.Number:
+		v, err := d.Int()
+		s.Int = int(v)
+		if err != nil {
+			return err
+		}
+		s.Type = IntEventCustomDItem
+	case jx.String:
+		v, err := d.Str()
+		s.String = string(v)
+		if err != nil {
+			return err
+		}
+		s.Type = StringEventCustomDItem
+	default:
+		return errors.Errorf("unexpected json type %q", t)
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s EventCustomDItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EventCustomDItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EventHit as json.
+func (s EventHit) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+func (s EventHit) encodeFields(e *jx.Encoder) {
+	switch s.Type {
+	case EventLoadEventHit:
+		e.FieldStart("e")
+		e.Str("load")
+		{
+			s := s.EventLoad
+			{
+				e.FieldStart("b")
+				e.Str(s.B)
+			}
+			{
+				e.FieldStart("u")
+				json.EncodeURI(e, s.U)
+			}
+			{
+				if s.R.Set {
+					e.FieldStart("r")
+					s.R.Encode(e)
+				}
+			}
+			{
+				e.FieldStart("p")
+				e.Bool(s.P)
+			}
+			{
+				e.FieldStart("q")
+				e.Bool(s.Q)
+			}
+			{
+				if s.T.Set {
+					e.FieldStart("t")
+					s.T.Encode(e)
+				}
+			}
+			{
+				if s.D.Set {
+					e.FieldStart("d")
+					s.D.Encode(e)
+				}
+			}
+		}
+	case EventUnloadEventHit:
+		e.FieldStart("e")
+		e.Str("unload")
+		{
+			s := s.EventUnload
+			{
+				e.FieldStart("b")
+				e.Str(s.B)
+			}
+			{
+				e.FieldStart("m")
+				e.Int(s.M)
+			}
+		}
+	case EventCustomEventHit:
+		e.FieldStart("e")
+		e.Str("custom")
+		{
+			s := s.EventCustom
+			{
+				if s.B.Set {
+					e.FieldStart("b")
+					s.B.Encode(e)
+				}
+			}
+			{
+				e.FieldStart("g")
+				e.Str(s.G)
+			}
+			{
+				e.FieldStart("d")
+				s.D.Encode(e)
+			}
+		}
+	}
+}
+
+// Decode decodes EventHit from json.
+func (s *EventHit) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EventHit to nil")
+	}
+	// Sum type discriminator.
+	if typ := d.Next(); typ != jx.Object {
+		return errors.Errorf("unexpected json type %q", typ)
+	}
+
+	var found bool
+	if err := d.Capture(func(d *jx.Decoder) error {
+		return d.ObjBytes(func(d *jx.Decoder, key []byte) error {
+			if found {
+				return d.Skip()
+			}
+			switch string(key) {
+			case "e":
+				typ, err := d.Str()
+				if err != nil {
+					return err
+				}
+				switch typ {
+				case "load":
+					s.Type = EventLoadEventHit
+					found = true
+				case "unload":
+					s.Type = EventUnloadEventHit
+					found = true
+				case "custom":
+					s.Type = EventCustomEventHit
+					found = true
+				default:
+					return errors.Errorf("unknown type %s", typ)
+				}
+				return nil
+			}
+			return d.Skip()
+		})
+	}); err != nil {
+		return errors.Wrap(err, "capture")
+	}
+	if !found {
+		return errors.New("unable to detect sum type variant")
+	}
+	switch s.Type {
+	case EventLoadEventHit:
+		if err := s.EventLoad.Decode(d); err != nil {
+			return err
+		}
+	case EventUnloadEventHit:
+		if err := s.EventUnload.Decode(d); err != nil {
+			return err
+		}
+	case EventCustomEventHit:
+		if err := s.EventCustom.Decode(d); err != nil {
+			return err
+		}
+	default:
+		return errors.Errorf("inferred invalid type: %s", s.Type)
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s EventHit) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EventHit) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *EventLoad) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *EventLoad) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("b")
+		e.Str(s.B)
+	}
+	{
+		e.FieldStart("u")
+		json.EncodeURI(e, s.U)
+	}
+	{
+		if s.R.Set {
+			e.FieldStart("r")
+			s.R.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("p")
+		e.Bool(s.P)
+	}
+	{
+		e.FieldStart("q")
+		e.Bool(s.Q)
+	}
+	{
+		if s.T.Set {
+			e.FieldStart("t")
+			s.T.Encode(e)
+		}
+	}
+	{
+		if s.D.Set {
+			e.FieldStart("d")
+			s.D.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfEventLoad = [7]string{
+	0: "b",
+	1: "u",
+	2: "r",
+	3: "p",
+	4: "q",
+	5: "t",
+	6: "d",
+}
+
+// Decode decodes EventLoad from json.
+func (s *EventLoad) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EventLoad to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "b":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.B = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"b\"")
+			}
+		case "u":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeURI(d)
+				s.U = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"u\"")
+			}
+		case "r":
+			if err := func() error {
+				s.R.Reset()
+				if err := s.R.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"r\"")
+			}
+		case "p":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Bool()
+				s.P = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"p\"")
+			}
+		case "q":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Bool()
+				s.Q = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"q\"")
+			}
+		case "t":
+			if err := func() error {
+				s.T.Reset()
+				if err := s.T.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"t\"")
+			}
+		case "d":
+			if err := func() error {
+				s.D.Reset()
+				if err := s.D.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"d\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EventLoad")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEventLoad) {
+					name = jsonFieldsNameOfEventLoad[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *EventLoad) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EventLoad) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s EventLoadD) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s EventLoadD) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		elem.Encode(e)
+	}
+}
+
+// Decode decodes EventLoadD from json.
+func (s *EventLoadD) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EventLoadD to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem EventLoadDItem
+		if err := func() error {
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EventLoadD")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s EventLoadD) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EventLoadD) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EventLoadDItem as json.
+func (s EventLoadDItem) Encode(e *jx.Encoder) {
+	switch s.Type {
+	case StringEventLoadDItem:
+		e.Str(s.String)
+	case IntEventLoadDItem:
+		e.Int(s.Int)
+	case BoolEventLoadDItem:
+		e.Bool(s.Bool)
+	}
+}
+
+// Decode decodes EventLoadDItem from json.
+func (s *EventLoadDItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EventLoadDItem to nil")
+	}
+	// Sum type type_discriminator.
+	switch t := d.Next(); t {
+	case jx.Bool:
+		v, err := d.Bool()
+		s.Bool = bool(v)
+		if err != nil {
+			return err
+		}
+		s.Type = BoolEventLoadDItem
+	case jx.Number:
+		v, err := d.Int()
+		s.Int = int(v)
+		if err != nil {
+			return err
+		}
+		s.Type = IntEventLoadDItem
+	case jx.String:
+		v, err := d.Str()
+		s.String = string(v)
+		if err != nil {
+			return err
+		}
+		s.Type = StringEventLoadDItem
+	default:
+		return errors.Errorf("unexpected json type %q", t)
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s EventLoadDItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EventLoadDItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *EventUnload) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *EventUnload) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("b")
+		e.Str(s.B)
+	}
+	{
+		e.FieldStart("m")
+		e.Int(s.M)
+	}
+}
+
+var jsonFieldsNameOfEventUnload = [2]string{
+	0: "b",
+	1: "m",
+}
+
+// Decode decodes EventUnload from json.
+func (s *EventUnload) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EventUnload to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "b":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.B = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"b\"")
+			}
+		case "m":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.M = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"m\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EventUnload")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEventUnload) {
+					name = jsonFieldsNameOfEventUnload[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *EventUnload) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EventUnload) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ForbiddenError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ForbiddenError) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("error")
+		s.Error.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfForbiddenError = [1]string{
+	0: "error",
+}
+
+// Decode decodes ForbiddenError from json.
+func (s *ForbiddenError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ForbiddenError to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "error":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Error.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ForbiddenError")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfForbiddenError) {
+					name = jsonFieldsNameOfForbiddenError[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ForbiddenError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ForbiddenError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ForbiddenErrorError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ForbiddenErrorError) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("code")
+		e.Int32(s.Code)
+	}
+	{
+		e.FieldStart("message")
+		e.Str(s.Message)
+	}
+}
+
+var jsonFieldsNameOfForbiddenErrorError = [2]string{
+	0: "code",
+	1: "message",
+}
+
+// Decode decodes ForbiddenErrorError from json.
+func (s *ForbiddenErrorError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ForbiddenErrorError to nil")
+	}
+	var requiredBitSet [1]uint8
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "code":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int32()
+				s.Code = int32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"code\"")
+			}
+		case "message":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ForbiddenErrorError")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfForbiddenErrorError) {
+					name = jsonFieldsNameOfForbiddenErrorError[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ForbiddenErrorError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ForbiddenErrorError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *GoalCreate) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *GoalCreate) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("type")
+		s.Type.Encode(e)
+	}
+	{
+		if s.Pathname.Set {
+			e.FieldStart("pathname")
+			s.Pathname.Encode(e)
+		}
+	}
+	{
+		if s.EventName.Set {
+			e.FieldStart("eventName")
+			s.EventName.Encode(e)
+		}
+	}
+	{
+		if s.EventValue.Set {
+			e.FieldStart("eventValue")
+			s.EventValue.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfGoalCreate = [5]string{
+	0: "name",
+	1: "type",
+	2: "pathname",
+	3: "eventName",
+	4: "eventValue",
+}
+
+// Decode decodes GoalCreate from json.
+func (s *GoalCreate) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GoalCreate to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "type":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "pathname":
+			if err := func() error {
+				s.Pathname.Reset()
+				if err := s.Pathname.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pathname\"")
+			}
+		case "eventName":
+			if err := func() error {
+				s.EventName.Reset()
+				if err := s.EventName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"eventName\"")
+			}
+		case "eventValue":
+			if err := func() error {
+				s.EventValue.Reset()
+				if err := s.EventValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"eventValue\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode GoalCreate")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfGoalCreate) {
+					name = jsonFieldsNameOfGoalCreate[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GoalCreate) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GoalCreate) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *GoalGet) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *GoalGet) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Str(s.ID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("type")
+		s.Type.Encode(e)
+	}
+	{
+		if s.Pathname.Set {
+			e.FieldStart("pathname")
+			s.Pathname.Encode(e)
+		}
+	}
+	{
+		if s.EventName.Set {
+			e.FieldStart("eventName")
+			s.EventName.Encode(e)
+		}
+	}
+	{
+		if s.EventValue.Set {
+			e.FieldStart("eventValue")
+			s.EventValue.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfGoalGet = [6]string{
+	0: "id",
+	1: "name",
+	2: "type",
+	3: "pathname",
+	4: "eventName",
+	5: "eventValue",
+}
+
+// Decode decodes GoalGet from json.
+func (s *GoalGet) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GoalGet to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.ID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "type":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "pathname":
+			if err := func() error {
+				s.Pathname.Reset()
+				if err := s.Pathname.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pathname\"")
+			}
+		case "eventName":
+			if err := func() error {
+				s.EventName.Reset()
+				if err := s.EventName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"eventName\"")
+			}
+		case "eventValue":
+			if err := func() error {
+				s.EventValue.Reset()
+				if err := s.EventValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"eventValue\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode GoalGet")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfGoalGet) {
+					name = jsonFieldsNameOfGoalGet[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GoalGet) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GoalGet) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *GoalPatch) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *GoalPatch) encodeFields(e *jx.Encoder) {
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
+	{
+		if s.Pathname.Set {
+			e.FieldStart("pathname")
+			s.Pathname.Encode(e)
+		}
+	}
+	{
+		if s.EventName.Set {
+			e.FieldStart("eventName")
+			s.EventName.Encode(e)
+		}
+	}
+	{
+		if s.EventValue.Set {
+			e.FieldStart("eventValue")
+			s.EventValue.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfGoalPatch = [5]string{
+	0: "name",
+	1: "type",
+	2: "pathname",
+	3: "eventName",
+	4: "eventValue",
+}
+
+// Decode decodes GoalPatch from json.
+func (s *GoalPatch) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GoalPatch to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "pathname":
+			if err := func() error {
+				s.Pathname.Reset()
+				if err := s.Pathname.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pathname\"")
+			}
+		case "eventName":
+			if err := func() error {
+				s.EventName.Reset()
+				if err := s.EventName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"eventName\"")
+			}
+		case "eventValue":
+			if err := func() error {
+				s.EventValue.Reset()
+				if err := s.EventValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"eventValue\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode GoalPatch")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GoalPatch) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GoalPatch) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GoalType as json.
+func (s GoalType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes GoalType from json.
+func (s *GoalType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GoalType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch GoalType(v) {
+	case GoalTypePathname:
+		*s = GoalTypePathname
+	case GoalTypeEvent:
+		*s = GoalTypeEvent
+	default:
+		*s = GoalType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s GoalType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GoalType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *InternalServerError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *InternalServerError) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("error")
+		s.Error.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfInternalServerError = [1]string{
+	0: "error",
+}
+
+// Decode decodes InternalServerError from json.
+func (s *InternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode InternalServerError to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "error":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Error.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode InternalServerError")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfInternalServerError) {
+					name = jsonFieldsNameOfInternalServerError[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *InternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *InternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *InternalServerErrorError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *InternalServerErrorError) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("code")
+		e.Int32(s.Code)
+	}
+	{
+		e.FieldStart("message")
+		e.Str(s.Message)
+	}
+}
+
+var jsonFieldsNameOfInternalServerErrorError = [2]string{
+	0: "code",
+	1: "message",
+}
+
+// Decode decodes InternalServerErrorError from json.
+func (s *InternalServerErrorError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode InternalServerErrorError to nil")
+	}
+	var requiredBitSet [1]uint8
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "code":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int32()
+				s.Code = int32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"code\"")
+			}
+		case "message":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode InternalServerErrorError")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfInternalServerErrorError) {
+					name = jsonFieldsNameOfInternalServerErrorError[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *InternalServerErrorError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *InternalServerErrorError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *LoginLockout) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *LoginLockout) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("type")
+		s.Type.Encode(e)
+	}
+	{
+		e.FieldStart("value")
+		e.Str(s.Value)
+	}
+	{
+		e.FieldStart("lockouts")
+		e.Int(s.Lockouts)
+	}
+	{
+		e.FieldStart("dateExpires")
+		e.Int64(s.DateExpires)
+	}
+}
+
+var jsonFieldsNameOfLoginLockout = [4]string{
+	0: "type",
+	1: "value",
+	2: "lockouts",
+	3: "dateExpires",
+}
+
+// Decode decodes LoginLockout from json.
+func (s *LoginLockout) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LoginLockout to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "type":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "value":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Value = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"value\"")
+			}
+		case "lockouts":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Lockouts = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"lockouts\"")
+			}
+		case "dateExpires":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int64()
+				s.DateExpires = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"dateExpires\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode LoginLockout")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfLoginLockout) {
+					name = jsonFieldsNameOfLoginLockout[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LoginLockout) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LoginLockout) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes LoginLockoutType as json.
+func (s LoginLockoutType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes LoginLockoutType from json.
+func (s *LoginLockoutType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LoginLockoutType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch LoginLockoutType(v) {
+	case LoginLockoutTypeUsername:
+		*s = LoginLockoutTypeUsername
+	case LoginLockoutTypeIP:
+		*s = LoginLockoutTypeIP
+	default:
+		*s = LoginLockoutType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s LoginLockoutType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LoginLockoutType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NotFoundError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NotFoundError) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("error")
+		s.Error.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfNotFoundError = [1]string{
+	0: "error",
+}
+
+// Decode decodes NotFoundError from json.
+func (s *NotFoundError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NotFoundError to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "error":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Error.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NotFoundError")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNotFoundError) {
+					name = jsonFieldsNameOfNotFoundError[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NotFoundError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NotFoundError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NotFoundErrorError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NotFoundErrorError) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("code")
+		e.Int32(s.Code)
+	}
+	{
+		e.FieldStart("message")
+		e.Str(s.Message)
+	}
+}
+
+var jsonFieldsNameOfNotFoundErrorError = [2]string{
+	0: "code",
+	1: "message",
+}
+
+// Decode decodes NotFoundErrorError from json.
+func (s *NotFoundErrorError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NotFoundErrorError to nil")
+	}
+	var requiredBitSet [1]uint8
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "code":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int32()
+				s.Code = int32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"code\"")
+			}
+		case "message":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return errors.Errorf("unexpected field %q", k)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NotFoundErrorError")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNotFoundErrorError) {
+					name = jsonFieldsNameOfNotFoundErrorError[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NotFoundErrorError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NotFoundErrorError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes bool as json.
+func (o OptBool) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Bool(bool(o.Value))
+}
+
+// Decode decodes bool from json.
+func (o *OptBool) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBool to nil")
+	}
+	o.Set = true
+	v, err := d.Bool()
+	if err != nil {
+		return err
+	}
+	o.Value = bool(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBool) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBool) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes time.Time as json.
+func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+	if !o.Set {
+		return
+	}
+	format(e, o.Value)
+}
+
+// Decode decodes time.Time from json.
+func (o *OptDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDateTime to nil")
+	}
+	o.Set = true
+	v, err := format(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDateTime) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e, json.EncodeDateTime)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDateTime) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d, json.DecodeDateTime)
+}
+
+// Encode encodes EventLoadD as json.
+func (o OptEventLoadD) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes EventLoadD from json.
+func (o *OptEventLoadD) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEventLoadD to nil")
+	}
+	o.Set = true
+	o.Value = make(EventLoadD)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEventLoadD) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEventLoadD) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes float32 as json.
+func (o OptFloat32) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Float32(float32(o.Value))
+}
+
+// Decode decodes float32 from json.
+func (o *OptFloat32) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptFloat32 to nil")
+	}
+	o.Set = true
+	v, err := d.Float32()
+	if err != nil {
+		return err
+	}
+	o.Value = float32(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptFloat32) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptFloat32) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GoalType as json.
+func (o OptGoalType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes GoalType from json.
+func (o *OptGoalType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptGoalType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptGoalType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptGoalType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int as json.
+func (o OptInt) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes int from json.
+func (o *OptInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt to nil")
+	}
+	o.Set = true
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	o.Value = int(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int as json.
+func (o OptNilInt) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes int from json.
+func (o *OptNilInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilInt to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v int
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	o.Value = int(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ReportFrequency as json.
+func (o OptReportFrequency) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes ReportFrequency from json.
+func (o *OptReportFrequency) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptReportFrequency to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptReportFrequency) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptReportFrequency) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StatsSummaryPrevious as json.
+func (o OptStatsSummaryPrevious) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes StatsSummaryPrevious from json.
+func (o *OptStatsSummaryPrevious) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptStatsSummaryPrevious to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptStatsSummaryPrevious) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptStatsSummaryPrevious) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes string as json.
+func (o OptString) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes string from json.
+func (o *OptString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptString to nil")
+	}
+	o.Set = true
+	v, err := d.Str()
+	if err != nil {
+		return err
+	}
+	o.Value = string(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptString) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptString) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UserAccountPatchRole as json.
+func (o OptUserAccountPatchRole) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes UserAccountPatchRole from json.
+func (o *OptUserAccountPatchRole) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptUserAccountPatchRole to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptUserAccountPatchRole) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptUserAccountPatchRole) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UserSettings as json.
+func (o OptUserSettings) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes UserSettings from json.
+func (o *OptUserSettings) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptUserSettings to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptUserSettings) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptUserSettings) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UserSettingsLanguage as json.
+func (o OptUserSettingsLanguage) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes UserSettingsLanguage from json.
+func (o *OptUserSettingsLanguage) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptUserSettingsLanguage to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptUserSettingsLanguage) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptUserSettingsLanguage) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes WebsiteGetSummary as json.
+func (o OptWebsiteGetSummary) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes WebsiteGetSummary from json.
+func (o *OptWebsiteGetSummary) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptWebsiteGetSummary to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptWebsiteGetSummary) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptWebsiteGetSummary) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ReportCreate) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ReportCreate) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("hostname")
+		e.Str(s.Hostname)
+	}
+	{
+		e.FieldStart("email")
+		e.Str(s.Email)
+	}
+	{
+		e.FieldStart("frequency")
+		s.Frequency.Encode(e)
+	}
+	{
+		e.FieldStart("stats")
+		e.ArrStart()
+		for _, elem := range s.Stats {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfReportCreate = [4]string{
+	0: "hostname",
+	1: "email",
+	2: "frequency",
+	3: "stats",
+}
+
+// Decode decodes ReportCreate from json.
+func (s *ReportCreate) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReportCreate to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "hostname":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Hostname = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hostname\"")
+			}
+		case "email":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Email = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email\"")
+			}
+		case "frequency":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Frequency.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"frequency\"")
+			}
+		case "stats":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.Stats = make([]ReportStat, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ReportStat
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Stats = append(s.Stats, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"stats\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ReportCreate")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfReportCreate) {
+					name = jsonFieldsNameOfReportCreate[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ReportCreate) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ReportCreate) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ReportFrequency as json.
+func (s ReportFrequency) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ReportFrequency from json.
+func (s *ReportFrequency) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReportFrequency to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ReportFrequency(v) {
+	case ReportFrequencyDaily:
+		*s = ReportFrequencyDaily
+	case ReportFrequencyWeekly:
+		*s = ReportFrequencyWeekly
+	case ReportFrequencyMonthly:
+		*s = ReportFrequencyMonthly
+	default:
+		*s = ReportFrequency(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ReportFrequency) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ReportFrequency) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ReportGet) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ReportGet) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Str(s.ID)
+	}
+	{
+		e.FieldStart("hostname")
+		e.Str(s.Hostname)
+	}
+	{
+		e.FieldStart("email")
+		e.Str(s.Email)
+	}
+	{
+		e.FieldStart("frequency")
+		s.Frequency.Encode(e)
+	}
+	{
+		e.FieldStart("stats")
+		e.ArrStart()
+		for _, elem := range s.Stats {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		if s.LastSentAt.Set {
+			e.FieldStart("lastSentAt")
+			s.LastSentAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+}
+
+var jsonFieldsNameOfReportGet = [7]string{
+	0: "id",
+	1: "hostname",
+	2: "email",
+	3: "frequency",
+	4: "stats",
+	5: "lastSentAt",
+	6: "createdAt",
+}
+
+// Decode decodes ReportGet from json.
+func (s *ReportGet) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReportGet to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.ID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "hostname":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Hostname = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hostname\"")
+			}
+		case "email":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Email = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email\"")
+			}
+		case "frequency":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Frequency.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"frequency\"")
+			}
+		case "stats":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				s.Stats = make([]ReportStat, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ReportStat
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Stats = append(s.Stats, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"stats\"")
+			}
+		case "lastSentAt":
+			if err := func() error {
+				s.LastSentAt.Reset()
+				if err := s.LastSentAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"lastSentAt\"")
+			}
+		case "createdAt":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ReportGet")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b01011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfReportGet) {
+					name = jsonFieldsNameOfReportGet[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ReportGet) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ReportGet) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ReportPatch) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ReportPatch) encodeFields(e *jx.Encoder) {
+	{
+		if s.Email.Set {
+			e.FieldStart("email")
+			s.Email.Encode(e)
+		}
+	}
+	{
+		if s.Frequency.Set {
+			e.FieldStart("frequency")
+			s.Frequency.Encode(e)
+		}
+	}
+	{
+		if s.Stats != nil {
+			e.FieldStart("stats")
+			e.ArrStart()
+			for _, elem := range s.Stats {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfReportPatch = [3]string{
+	0: "email",
+	1: "frequency",
+	2: "stats",
+}
+
+// Decode decodes ReportPatch from json.
+func (s *ReportPatch) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReportPatch to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "email":
+			if err := func() error {
+				s.Email.Reset()
+				if err := s.Email.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email\"")
+			}
+		case "frequency":
+			if err := func() error {
+				s.Frequency.Reset()
+				if err := s.Frequency.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"frequency\"")
+			}
+		case "stats":
+			if err := func() error {
+				s.Stats = make([]ReportStat, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ReportStat
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Stats = append(s.Stats, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"stats\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ReportPatch")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ReportPatch) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ReportPatch) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ReportStat as json.
+func (s ReportStat) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ReportStat from json.
+func (s *ReportStat) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReportStat to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ReportStat(v) {
+	case ReportStatSummary:
+		*s = ReportStatSummary
+	case ReportStatPages:
+		*s = ReportStatPages
+	case ReportStatReferrers:
+		*s = ReportStatReferrers
+	default:
+		*s = ReportStat(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ReportStat) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ReportStat) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StatsAnomaly) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StatsAnomaly) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("metric")
+		s.Metric.Encode(e)
+	}
+	{
+		e.FieldStart("value")
+		e.Float64(s.Value)
+	}
+	{
+		e.FieldStart("expected")
+		e.Float64(s.Expected)
+	}
+	{
+		e.FieldStart("score")
+		e.Float64(s.Score)
+	}
+}
+
+var jsonFieldsNameOfStatsAnomaly = [4]string{
+	0: "metric",
+	1: "value",
+	2: "expected",
+	3: "score",
+}
+
+// Decode decodes StatsAnomaly from json.
+func (s *StatsAnomaly) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsAnomaly to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "metric":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Metric.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"metric\"")
+			}
+		case "value":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Float64()
+				s.Value = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"value\"")
+			}
+		case "expected":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float64()
+				s.Expected = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"expected\"")
+			}
+		case "score":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float64()
+				s.Score = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StatsAnomaly")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStatsAnomaly) {
+					name = jsonFieldsNameOfStatsAnomaly[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StatsAnomaly) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsAnomaly) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StatsAnomalyMetric as json.
+func (s StatsAnomalyMetric) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes StatsAnomalyMetric from json.
+func (s *StatsAnomalyMetric) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsAnomalyMetric to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch StatsAnomalyMetric(v) {
+	case StatsAnomalyMetricVisitors:
+		*s = StatsAnomalyMetricVisitors
+	case StatsAnomalyMetricPageviews:
+		*s = StatsAnomalyMetricPageviews
+	case StatsAnomalyMetricBouncePercentage:
+		*s = StatsAnomalyMetricBouncePercentage
+	case StatsAnomalyMetricDuration:
+		*s = StatsAnomalyMetricDuration
+	default:
+		*s = StatsAnomalyMetric(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s StatsAnomalyMetric) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsAnomalyMetric) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StatsBrowsers as json.
+func (s StatsBrowsers) Encode(e *jx.Encoder) {
+	unwrapped := []StatsBrowsersItem(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes StatsBrowsers from json.
+func (s *StatsBrowsers) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsBrowsers to nil")
+	}
+	var unwrapped []StatsBrowsersItem
+	if err := func() error {
+		unwrapped = make([]StatsBrowsersItem, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem StatsBrowsersItem
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = StatsBrowsers(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s StatsBrowsers) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsBrowsers) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StatsBrowsersItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StatsBrowsersItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("browser")
+		e.Str(s.Browser)
+	}
+	{
+		e.FieldStart("visitors")
+		e.Int(s.Visitors)
+	}
+	{
+		e.FieldStart("visitors_percentage")
+		e.Float32(s.VisitorsPercentage)
+	}
+	{
+		if s.BouncePercentage.Set {
+			e.FieldStart("bounce_percentage")
+			s.BouncePercentage.Encode(e)
+		}
+	}
+	{
+		if s.Duration.Set {
+			e.FieldStart("duration")
+			s.Duration.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfStatsBrowsersItem = [5]string{
+	0: "browser",
+	1: "visitors",
+	2: "visitors_percentage",
+	3: "bounce_percentage",
+	4: "duration",
+}
+
+// Decode decodes StatsBrowsersItem from json.
+func (s *StatsBrowsersItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsBrowsersItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "browser":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Browser = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"browser\"")
+			}
+		case "visitors":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Visitors = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors\"")
+			}
+		case "visitors_percentage":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float32()
+				s.VisitorsPercentage = float32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors_percentage\"")
+			}
+		case "bounce_percentage":
+			if err := func() error {
+				s.BouncePercentage.Reset()
+				if err := s.BouncePercentage.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+			}
+		case "duration":
+			if err := func() error {
+				s.Duration.Reset()
+				if err := s.Duration.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StatsBrowsersItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStatsBrowsersItem) {
+					name = jsonFieldsNameOfStatsBrowsersItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StatsBrowsersItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsBrowsersItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StatsCountries as json.
+func (s StatsCountries) Encode(e *jx.Encoder) {
+	unwrapped := []StatsCountriesItem(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes StatsCountries from json.
+func (s *StatsCountries) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsCountries to nil")
+	}
+	var unwrapped []StatsCountriesItem
+	if err := func() error {
+		unwrapped = make([]StatsCountriesItem, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem StatsCountriesItem
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = StatsCountries(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s StatsCountries) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsCountries) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StatsCountriesItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StatsCountriesItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("country")
+		e.Str(s.Country)
+	}
+	{
+		e.FieldStart("visitors")
+		e.Int(s.Visitors)
+	}
+	{
+		e.FieldStart("visitors_percentage")
+		e.Float32(s.VisitorsPercentage)
+	}
+	{
+		if s.BouncePercentage.Set {
+			e.FieldStart("bounce_percentage")
+			s.BouncePercentage.Encode(e)
+		}
+	}
+	{
+		if s.Duration.Set {
+			e.FieldStart("duration")
+			s.Duration.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfStatsCountriesItem = [5]string{
+	0: "country",
+	1: "visitors",
+	2: "visitors_percentage",
+	3: "bounce_percentage",
+	4: "duration",
+}
+
+// Decode decodes StatsCountriesItem from json.
+func (s *StatsCountriesItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsCountriesItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "country":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Country = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"country\"")
+			}
+		case "visitors":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Visitors = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors\"")
+			}
+		case "visitors_percentage":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float32()
+				s.VisitorsPercentage = float32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors_percentage\"")
+			}
+		case "bounce_percentage":
+			if err := func() error {
+				s.BouncePercentage.Reset()
+				if err := s.BouncePercentage.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+			}
+		case "duration":
+			if err := func() error {
+				s.Duration.Reset()
+				if err := s.Duration.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StatsCountriesItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStatsCountriesItem) {
+					name = jsonFieldsNameOfStatsCountriesItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StatsCountriesItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsCountriesItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StatsDevices as json.
+func (s StatsDevices) Encode(e *jx.Encoder) {
+	unwrapped := []StatsDevicesItem(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes StatsDevices from json.
+func (s *StatsDevices) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsDevices to nil")
+	}
+	var unwrapped []StatsDevicesItem
+	if err := func() error {
+		unwrapped = make([]StatsDevicesItem, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem StatsDevicesItem
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = StatsDevices(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s StatsDevices) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsDevices) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StatsDevicesItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StatsDevicesItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("device")
+		e.Str(s.Device)
+	}
+	{
+		e.FieldStart("visitors")
+		e.Int(s.Visitors)
+	}
+	{
+		e.FieldStart("visitors_percentage")
+		e.Float32(s.VisitorsPercentage)
+	}
+	{
+		if s.BouncePercentage.Set {
+			e.FieldStart("bounce_percentage")
+			s.BouncePercentage.Encode(e)
+		}
+	}
+	{
+		if s.Duration.Set {
+			e.FieldStart("duration")
+			s.Duration.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfStatsDevicesItem = [5]string{
+	0: "device",
+	1: "visitors",
+	2: "visitors_percentage",
+	3: "bounce_percentage",
+	4: "duration",
+}
+
+// Decode decodes StatsDevicesItem from json.
+func (s *StatsDevicesItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsDevicesItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "device":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Device = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"device\"")
+			}
+		case "visitors":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Visitors = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors\"")
+			}
+		case "visitors_percentage":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float32()
+				s.VisitorsPercentage = float32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors_percentage\"")
+			}
+		case "bounce_percentage":
+			if err := func() error {
+				s.BouncePercentage.Reset()
+				if err := s.BouncePercentage.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+			}
+		case "duration":
+			if err := func() error {
+				s.Duration.Reset()
+				if err := s.Duration.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StatsDevicesItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStatsDevicesItem) {
+					name = jsonFieldsNameOfStatsDevicesItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StatsDevicesItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsDevicesItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StatsFunnel as json.
+func (s StatsFunnel) Encode(e *jx.Encoder) {
+	unwrapped := []StatsFunnelItem(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes StatsFunnel from json.
+func (s *StatsFunnel) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsFunnel to nil")
+	}
+	var unwrapped []StatsFunnelItem
+	if err := func() error {
+		unwrapped = make([]StatsFunnelItem, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem StatsFunnelItem
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = StatsFunnel(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s StatsFunnel) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsFunnel) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StatsFunnelItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StatsFunnelItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("step")
+		e.Int(s.Step)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("visits")
+		e.Int(s.Visits)
+	}
+	{
+		e.FieldStart("conversion_rate")
+		e.Float32(s.ConversionRate)
+	}
+	{
+		e.FieldStart("dropoff")
+		e.Int(s.Dropoff)
+	}
+	{
+		e.FieldStart("dropoff_rate")
+		e.Float32(s.DropoffRate)
+	}
+}
+
+var jsonFieldsNameOfStatsFunnelItem = [6]string{
+	0: "step",
+	1: "name",
+	2: "visits",
+	3: "conversion_rate",
+	4: "dropoff",
+	5: "dropoff_rate",
+}
+
+// Decode decodes StatsFunnelItem from json.
+func (s *StatsFunnelItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsFunnelItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "step":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Step = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"step\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "visits":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Visits = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visits\"")
+			}
+		case "conversion_rate":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float32()
+				s.ConversionRate = float32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"conversion_rate\"")
+			}
+		case "dropoff":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.Dropoff = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"dropoff\"")
+			}
+		case "dropoff_rate":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Float32()
+				s.DropoffRate = float32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"dropoff_rate\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StatsFunnelItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStatsFunnelItem) {
+					name = jsonFieldsNameOfStatsFunnelItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StatsFunnelItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsFunnelItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StatsGoals as json.
+func (s StatsGoals) Encode(e *jx.Encoder) {
+	unwrapped := []StatsGoalsItem(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes StatsGoals from json.
+func (s *StatsGoals) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsGoals to nil")
+	}
+	var unwrapped []StatsGoalsItem
+	if err := func() error {
+		unwrapped = make([]StatsGoalsItem, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem StatsGoalsItem
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = StatsGoals(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s StatsGoals) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsGoals) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StatsGoalsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StatsGoalsItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Str(s.ID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("conversions")
+		e.Int(s.Conversions)
+	}
+	{
+		e.FieldStart("converters")
+		e.Int(s.Converters)
+	}
+	{
+		e.FieldStart("conversion_rate")
+		e.Float32(s.ConversionRate)
+	}
+}
+
+var jsonFieldsNameOfStatsGoalsItem = [5]string{
+	0: "id",
+	1: "name",
+	2: "conversions",
+	3: "converters",
+	4: "conversion_rate",
+}
+
+// Decode decodes StatsGoalsItem from json.
+func (s *StatsGoalsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsGoalsItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.ID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "conversions":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Conversions = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"conversions\"")
+			}
+		case "converters":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.Converters = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"converters\"")
+			}
+		case "conversion_rate":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Float32()
+				s.ConversionRate = float32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"conversion_rate\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StatsGoalsItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStatsGoalsItem) {
+					name = jsonFieldsNameOfStatsGoalsItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StatsGoalsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsGoalsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StatsHostnames as json.
+func (s StatsHostnames) Encode(e *jx.Encoder) {
+	unwrapped := []StatsHostnamesItem(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes StatsHostnames from json.
+func (s *StatsHostnames) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsHostnames to nil")
+	}
+	var unwrapped []StatsHostnamesItem
+	if err := func() error {
+		unwrapped = make([]StatsHostnamesItem, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem StatsHostnamesItem
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = StatsHostnames(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s StatsHostnames) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsHostnames) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StatsHostnamesItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StatsHostnamesItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("hostname")
+		e.Str(s.Hostname)
+	}
+	{
+		e.FieldStart("visitors")
+		e.Int(s.Visitors)
+	}
+	{
+		e.FieldStart("visitors_percentage")
+		e.Float32(s.VisitorsPercentage)
+	}
+	{
+		if s.BouncePercentage.Set {
+			e.FieldStart("bounce_percentage")
+			s.BouncePercentage.Encode(e)
+		}
+	}
+	{
+		if s.Duration.Set {
+			e.FieldStart("duration")
+			s.Duration.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfStatsHostnamesItem = [5]string{
+	0: "hostname",
+	1: "visitors",
+	2: "visitors_percentage",
+	3: "bounce_percentage",
+	4: "duration",
+}
+
+// Decode decodes StatsHostnamesItem from json.
+func (s *StatsHostnamesItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsHostnamesItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "hostname":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Hostname = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hostname\"")
+			}
+		case "visitors":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Visitors = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors\"")
+			}
+		case "visitors_percentage":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float32()
+				s.VisitorsPercentage = float32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors_percentage\"")
+			}
+		case "bounce_percentage":
+			if err := func() error {
+				s.BouncePercentage.Reset()
+				if err := s.BouncePercentage.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+			}
+		case "duration":
+			if err := func() error {
+				s.Duration.Reset()
+				if err := s.Duration.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StatsHostnamesItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStatsHostnamesItem) {
+					name = jsonFieldsNameOfStatsHostnamesItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StatsHostnamesItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsHostnamesItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StatsLanguages as json.
+func (s StatsLanguages) Encode(e *jx.Encoder) {
+	unwrapped := []StatsLanguagesItem(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes StatsLanguages from json.
+func (s *StatsLanguages) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsLanguages to nil")
+	}
+	var unwrapped []StatsLanguagesItem
+	if err := func() error {
+		unwrapped = make([]StatsLanguagesItem, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem StatsLanguagesItem
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = StatsLanguages(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s StatsLanguages) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsLanguages) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StatsLanguagesItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StatsLanguagesItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("language")
+		e.Str(s.Language)
+	}
+	{
+		e.FieldStart("visitors")
+		e.Int(s.Visitors)
+	}
+	{
+		e.FieldStart("visitors_percentage")
+		e.Float32(s.VisitorsPercentage)
+	}
+	{
+		if s.BouncePercentage.Set {
+			e.FieldStart("bounce_percentage")
+			s.BouncePercentage.Encode(e)
+		}
+	}
+	{
+		if s.Duration.Set {
+			e.FieldStart("duration")
+			s.Duration.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfStatsLanguagesItem = [5]string{
+	0: "language",
+	1: "visitors",
+	2: "visitors_percentage",
+	3: "bounce_percentage",
+	4: "duration",
+}
+
+// Decode decodes StatsLanguagesItem from json.
+func (s *StatsLanguagesItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsLanguagesItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "language":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Language = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"language\"")
+			}
+		case "visitors":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Visitors = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors\"")
+			}
+		case "visitors_percentage":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float32()
+				s.VisitorsPercentage = float32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors_percentage\"")
+			}
+		case "bounce_percentage":
+			if err := func() error {
+				s.BouncePercentage.Reset()
+				if err := s.BouncePercentage.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+			}
+		case "duration":
+			if err := func() error {
+				s.Duration.Reset()
+				if err := s.Duration.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StatsLanguagesItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStatsLanguagesItem) {
+					name = jsonFieldsNameOfStatsLanguagesItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StatsLanguagesItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsLanguagesItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StatsOS as json.
+func (s StatsOS) Encode(e *jx.Encoder) {
+	unwrapped := []StatsOSItem(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes StatsOS from json.
+func (s *StatsOS) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsOS to nil")
+	}
+	var unwrapped []StatsOSItem
+	if err := func() error {
+		unwrapped = make([]StatsOSItem, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem StatsOSItem
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
 			return err
 		}
-		s.Type = BoolEventCustomDItem
-	case jx.Number:
-		v, err := d.Int()
-		s.Int = int(v)
-		if err != nil {
-			return err
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = StatsOS(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s StatsOS) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsOS) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StatsOSItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StatsOSItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("os")
+		e.Str(s.Os)
+	}
+	{
+		e.FieldStart("visitors")
+		e.Int(s.Visitors)
+	}
+	{
+		e.FieldStart("visitors_percentage")
+		e.Float32(s.VisitorsPercentage)
+	}
+	{
+		if s.BouncePercentage.Set {
+			e.FieldStart("bounce_percentage")
+			s.BouncePercentage.Encode(e)
 		}
-		s.Type = IntEventCustomDItem
-	case jx.String:
-		v, err := d.Str()
-		s.String = string(v)
-		if err != nil {
+	}
+	{
+		if s.Duration.Set {
+			e.FieldStart("duration")
+			s.Duration.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfStatsOSItem = [5]string{
+	0: "os",
+	1: "visitors",
+	2: "visitors_percentage",
+	3: "bounce_percentage",
+	4: "duration",
+}
+
+// Decode decodes StatsOSItem from json.
+func (s *StatsOSItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsOSItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "os":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Os = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"os\"")
+			}
+		case "visitors":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Visitors = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors\"")
+			}
+		case "visitors_percentage":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float32()
+				s.VisitorsPercentage = float32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors_percentage\"")
+			}
+		case "bounce_percentage":
+			if err := func() error {
+				s.BouncePercentage.Reset()
+				if err := s.BouncePercentage.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+			}
+		case "duration":
+			if err := func() error {
+				s.Duration.Reset()
+				if err := s.Duration.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StatsOSItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStatsOSItem) {
+					name = jsonFieldsNameOfStatsOSItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StatsOSItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsOSItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StatsPages as json.
+func (s StatsPages) Encode(e *jx.Encoder) {
+	unwrapped := []StatsPagesItem(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes StatsPages from json.
+func (s *StatsPages) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsPages to nil")
+	}
+	var unwrapped []StatsPagesItem
+	if err := func() error {
+		unwrapped = make([]StatsPagesItem, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem StatsPagesItem
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
 			return err
 		}
-		s.Type = StringEventCustomDItem
-	default:
-		return errors.Errorf("unexpected json type %q", t)
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = StatsPages(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s EventCustomDItem) MarshalJSON() ([]byte, error) {
+func (s StatsPages) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EventCustomDItem) UnmarshalJSON(data []byte) error {
+func (s *StatsPages) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EventHit as json.
-func (s EventHit) Encode(e *jx.Encoder) {
+// Encode implements json.Marshaler.
+func (s *StatsPagesItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-func (s EventHit) encodeFields(e *jx.Encoder) {
-	switch s.Type {
-	case EventLoadEventHit:
-		e.FieldStart("e")
-		e.Str("load")
-		{
-			s := s.EventLoad
-			{
-				e.FieldStart("b")
-				e.Str(s.B)
-			}
-			{
-				e.FieldStart("u")
-				json.EncodeURI(e, s.U)
-			}
-			{
-				if s.R.Set {
-					e.FieldStart("r")
-					s.R.Encode(e)
+// encodeFields encodes fields.
+func (s *StatsPagesItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("path")
+		e.Str(s.Path)
+	}
+	{
+		e.FieldStart("visitors")
+		e.Int(s.Visitors)
+	}
+	{
+		e.FieldStart("visitors_percentage")
+		e.Float32(s.VisitorsPercentage)
+	}
+	{
+		if s.Pageviews.Set {
+			e.FieldStart("pageviews")
+			s.Pageviews.Encode(e)
+		}
+	}
+	{
+		if s.PageviewsPercentage.Set {
+			e.FieldStart("pageviews_percentage")
+			s.PageviewsPercentage.Encode(e)
+		}
+	}
+	{
+		if s.BouncePercentage.Set {
+			e.FieldStart("bounce_percentage")
+			s.BouncePercentage.Encode(e)
+		}
+	}
+	{
+		if s.Duration.Set {
+			e.FieldStart("duration")
+			s.Duration.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfStatsPagesItem = [7]string{
+	0: "path",
+	1: "visitors",
+	2: "visitors_percentage",
+	3: "pageviews",
+	4: "pageviews_percentage",
+	5: "bounce_percentage",
+	6: "duration",
+}
+
+// Decode decodes StatsPagesItem from json.
+func (s *StatsPagesItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsPagesItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "path":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Path = string(v)
+				if err != nil {
+					return err
 				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"path\"")
 			}
-			{
-				e.FieldStart("p")
-				e.Bool(s.P)
+		case "visitors":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Visitors = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors\"")
 			}
-			{
-				e.FieldStart("q")
-				e.Bool(s.Q)
+		case "visitors_percentage":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float32()
+				s.VisitorsPercentage = float32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors_percentage\"")
 			}
-			{
-				if s.T.Set {
-					e.FieldStart("t")
-					s.T.Encode(e)
+		case "pageviews":
+			if err := func() error {
+				s.Pageviews.Reset()
+				if err := s.Pageviews.Decode(d); err != nil {
+					return err
 				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pageviews\"")
 			}
-			{
-				if s.D.Set {
-					e.FieldStart("d")
-					s.D.Encode(e)
+		case "pageviews_percentage":
+			if err := func() error {
+				s.PageviewsPercentage.Reset()
+				if err := s.PageviewsPercentage.Decode(d); err != nil {
+					return err
 				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pageviews_percentage\"")
 			}
-		}
-	case EventUnloadEventHit:
-		e.FieldStart("e")
-		e.Str("unload")
-		{
-			s := s.EventUnload
-			{
-				e.FieldStart("b")
-				e.Str(s.B)
+		case "bounce_percentage":
+			if err := func() error {
+				s.BouncePercentage.Reset()
+				if err := s.BouncePercentage.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bounce_percentage\"")
 			}
-			{
-				e.FieldStart("m")
-				e.Int(s.M)
+		case "duration":
+			if err := func() error {
+				s.Duration.Reset()
+				if err := s.Duration.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration\"")
 			}
+		default:
+			return d.Skip()
 		}
-	case EventCustomEventHit:
-		e.FieldStart("e")
-		e.Str("custom")
-		{
-			s := s.EventCustom
-			{
-				if s.B.Set {
-					e.FieldStart("b")
-					s.B.Encode(e)
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StatsPagesItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStatsPagesItem) {
+					name = jsonFieldsNameOfStatsPagesItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-			}
-			{
-				e.FieldStart("g")
-				e.Str(s.G)
-			}
-			{
-				e.FieldStart("d")
-				s.D.Encode(e)
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
 		}
 	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StatsPagesItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsPagesItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StatsProperties as json.
+func (s StatsProperties) Encode(e *jx.Encoder) {
+	unwrapped := []StatsPropertiesItem(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
 }
 
-// Decode decodes EventHit from json.
-func (s *EventHit) Decode(d *jx.Decoder) error {
+// Decode decodes StatsProperties from json.
+func (s *StatsProperties) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EventHit to nil")
-	}
-	// Sum type discriminator.
-	if typ := d.Next(); typ != jx.Object {
-		return errors.Errorf("unexpected json type %q", typ)
+		return errors.New("invalid: unable to decode StatsProperties to nil")
 	}
-
-	var found bool
-	if err := d.Capture(func(d *jx.Decoder) error {
-		return d.ObjBytes(func(d *jx.Decoder, key []byte) error {
-			if found {
-				return d.Skip()
-			}
-			switch string(key) {
-			case "e":
-				typ, err := d.Str()
-				if err != nil {
-					return err
-				}
-				switch typ {
-				case "load":
-					s.Type = EventLoadEventHit
-					found = true
-				case "unload":
-					s.Type = EventUnloadEventHit
-					found = true
-				case "custom":
-					s.Type = EventCustomEventHit
-					found = true
-				default:
-					return errors.Errorf("unknown type %s", typ)
-				}
-				return nil
+	var unwrapped []StatsPropertiesItem
+	if err := func() error {
+		unwrapped = make([]StatsPropertiesItem, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem StatsPropertiesItem
+			if err := elem.Decode(d); err != nil {
+				return err
 			}
-			return d.Skip()
-		})
-	}); err != nil {
-		return errors.Wrap(err, "capture")
-	}
-	if !found {
-		return errors.New("unable to detect sum type variant")
-	}
-	switch s.Type {
-	case EventLoadEventHit:
-		if err := s.EventLoad.Decode(d); err != nil {
-			return err
-		}
-	case EventUnloadEventHit:
-		if err := s.EventUnload.Decode(d); err != nil {
-			return err
-		}
-	case EventCustomEventHit:
-		if err := s.EventCustom.Decode(d); err != nil {
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
 			return err
 		}
-	default:
-		return errors.Errorf("inferred invalid type: %s", s.Type)
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = StatsProperties(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s EventHit) MarshalJSON() ([]byte, error) {
+func (s StatsProperties) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EventHit) UnmarshalJSON(data []byte) error {
+func (s *StatsProperties) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *EventLoad) Encode(e *jx.Encoder) {
+func (s *StatsPropertiesItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *EventLoad) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("b")
-		e.Str(s.B)
-	}
-	{
-		e.FieldStart("u")
-		json.EncodeURI(e, s.U)
-	}
+func (s *StatsPropertiesItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.R.Set {
-			e.FieldStart("r")
-			s.R.Encode(e)
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("p")
-		e.Bool(s.P)
-	}
-	{
-		e.FieldStart("q")
-		e.Bool(s.Q)
+		if s.Value.Set {
+			e.FieldStart("value")
+			s.Value.Encode(e)
+		}
 	}
 	{
-		if s.T.Set {
-			e.FieldStart("t")
-			s.T.Encode(e)
-		}
+		e.FieldStart("events")
+		e.Int(s.Events)
 	}
 	{
-		if s.D.Set {
-			e.FieldStart("d")
-			s.D.Encode(e)
-		}
+		e.FieldStart("events_percentage")
+		e.Float32(s.EventsPercentage)
 	}
 }
 
-var jsonFieldsNameOfEventLoad = [7]string{
-	0: "b",
-	1: "u",
-	2: "r",
-	3: "p",
-	4: "q",
-	5: "t",
-	6: "d",
+var jsonFieldsNameOfStatsPropertiesItem = [4]string{
+	0: "name",
+	1: "value",
+	2: "events",
+	3: "events_percentage",
 }
 
-// Decode decodes EventLoad from json.
-func (s *EventLoad) Decode(d *jx.Decoder) error {
+// Decode decodes StatsPropertiesItem from json.
+func (s *StatsPropertiesItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EventLoad to nil")
+		return errors.New("invalid: unable to decode StatsPropertiesItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "b":
-			requiredBitSet[0] |= 1 << 0
+		case "name":
 			if err := func() error {
-				v, err := d.Str()
-				s.B = string(v)
-				if err != nil {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"b\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "u":
-			requiredBitSet[0] |= 1 << 1
+		case "value":
 			if err := func() error {
-				v, err := json.DecodeURI(d)
-				s.U = v
-				if err != nil {
+				s.Value.Reset()
+				if err := s.Value.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"u\"")
+				return errors.Wrap(err, "decode field \"value\"")
 			}
-		case "r":
+		case "events":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.R.Reset()
-				if err := s.R.Decode(d); err != nil {
+				v, err := d.Int()
+				s.Events = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"r\"")
+				return errors.Wrap(err, "decode field \"events\"")
 			}
-		case "p":
+		case "events_percentage":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				v, err := d.Bool()
-				s.P = bool(v)
+				v, err := d.Float32()
+				s.EventsPercentage = float32(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"p\"")
+				return errors.Wrap(err, "decode field \"events_percentage\"")
 			}
-		case "q":
-			requiredBitSet[0] |= 1 << 4
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StatsPropertiesItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001100,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStatsPropertiesItem) {
+					name = jsonFieldsNameOfStatsPropertiesItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StatsPropertiesItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsPropertiesItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StatsRealtime) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StatsRealtime) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("visitors")
+		e.Int(s.Visitors)
+	}
+	{
+		e.FieldStart("pages")
+		e.ArrStart()
+		for _, elem := range s.Pages {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("referrers")
+		e.ArrStart()
+		for _, elem := range s.Referrers {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfStatsRealtime = [3]string{
+	0: "visitors",
+	1: "pages",
+	2: "referrers",
+}
+
+// Decode decodes StatsRealtime from json.
+func (s *StatsRealtime) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsRealtime to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "visitors":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Bool()
-				s.Q = bool(v)
+				v, err := d.Int()
+				s.Visitors = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"q\"")
+				return errors.Wrap(err, "decode field \"visitors\"")
 			}
-		case "t":
+		case "pages":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.T.Reset()
-				if err := s.T.Decode(d); err != nil {
+				s.Pages = make([]StatsRealtimePagesItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem StatsRealtimePagesItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Pages = append(s.Pages, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"t\"")
+				return errors.Wrap(err, "decode field \"pages\"")
 			}
-		case "d":
+		case "referrers":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.D.Reset()
-				if err := s.D.Decode(d); err != nil {
+				s.Referrers = make([]StatsRealtimeReferrersItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem StatsRealtimeReferrersItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Referrers = append(s.Referrers, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"d\"")
+				return errors.Wrap(err, "decode field \"referrers\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode EventLoad")
+		return errors.Wrap(err, "decode StatsRealtime")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00011011,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1110,8 +7402,8 @@ func (s *EventLoad) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfEventLoad) {
-					name = jsonFieldsNameOfEventLoad[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfStatsRealtime) {
+					name = jsonFieldsNameOfStatsRealtime[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1132,194 +7424,81 @@ func (s *EventLoad) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EventLoad) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EventLoad) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s EventLoadD) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s EventLoadD) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		elem.Encode(e)
-	}
-}
-
-// Decode decodes EventLoadD from json.
-func (s *EventLoadD) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode EventLoadD to nil")
-	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem EventLoadDItem
-		if err := func() error {
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode EventLoadD")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s EventLoadD) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EventLoadD) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes EventLoadDItem as json.
-func (s EventLoadDItem) Encode(e *jx.Encoder) {
-	switch s.Type {
-	case StringEventLoadDItem:
-		e.Str(s.String)
-	case IntEventLoadDItem:
-		e.Int(s.Int)
-	case BoolEventLoadDItem:
-		e.Bool(s.Bool)
-	}
-}
-
-// Decode decodes EventLoadDItem from json.
-func (s *EventLoadDItem) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode EventLoadDItem to nil")
-	}
-	// Sum type type_discriminator.
-	switch t := d.Next(); t {
-	case jx.Bool:
-		v, err := d.Bool()
-		s.Bool = bool(v)
-		if err != nil {
-			return err
-		}
-		s.Type = BoolEventLoadDItem
-	case jx.Number:
-		v, err := d.Int()
-		s.Int = int(v)
-		if err != nil {
-			return err
-		}
-		s.Type = IntEventLoadDItem
-	case jx.String:
-		v, err := d.Str()
-		s.String = string(v)
-		if err != nil {
-			return err
-		}
-		s.Type = StringEventLoadDItem
-	default:
-		return errors.Errorf("unexpected json type %q", t)
-	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s EventLoadDItem) MarshalJSON() ([]byte, error) {
+func (s *StatsRealtime) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EventLoadDItem) UnmarshalJSON(data []byte) error {
+func (s *StatsRealtime) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *EventUnload) Encode(e *jx.Encoder) {
+func (s *StatsRealtimePagesItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *EventUnload) encodeFields(e *jx.Encoder) {
+func (s *StatsRealtimePagesItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("b")
-		e.Str(s.B)
+		e.FieldStart("path")
+		e.Str(s.Path)
 	}
 	{
-		e.FieldStart("m")
-		e.Int(s.M)
+		e.FieldStart("visitors")
+		e.Int(s.Visitors)
 	}
 }
 
-var jsonFieldsNameOfEventUnload = [2]string{
-	0: "b",
-	1: "m",
+var jsonFieldsNameOfStatsRealtimePagesItem = [2]string{
+	0: "path",
+	1: "visitors",
 }
 
-// Decode decodes EventUnload from json.
-func (s *EventUnload) Decode(d *jx.Decoder) error {
+// Decode decodes StatsRealtimePagesItem from json.
+func (s *StatsRealtimePagesItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EventUnload to nil")
+		return errors.New("invalid: unable to decode StatsRealtimePagesItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "b":
+		case "path":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.B = string(v)
+				s.Path = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"b\"")
+				return errors.Wrap(err, "decode field \"path\"")
 			}
-		case "m":
+		case "visitors":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Int()
-				s.M = int(v)
+				s.Visitors = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"m\"")
+				return errors.Wrap(err, "decode field \"visitors\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode EventUnload")
+		return errors.Wrap(err, "decode StatsRealtimePagesItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -1336,8 +7515,8 @@ func (s *EventUnload) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfEventUnload) {
-					name = jsonFieldsNameOfEventUnload[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfStatsRealtimePagesItem) {
+					name = jsonFieldsNameOfStatsRealtimePagesItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1358,67 +7537,86 @@ func (s *EventUnload) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EventUnload) MarshalJSON() ([]byte, error) {
+func (s *StatsRealtimePagesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EventUnload) UnmarshalJSON(data []byte) error {
+func (s *StatsRealtimePagesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ForbiddenError) Encode(e *jx.Encoder) {
+func (s *StatsRealtimeReferrersItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ForbiddenError) encodeFields(e *jx.Encoder) {
+func (s *StatsRealtimeReferrersItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("error")
-		s.Error.Encode(e)
+		e.FieldStart("referrer")
+		e.Str(s.Referrer)
+	}
+	{
+		e.FieldStart("visitors")
+		e.Int(s.Visitors)
 	}
 }
 
-var jsonFieldsNameOfForbiddenError = [1]string{
-	0: "error",
+var jsonFieldsNameOfStatsRealtimeReferrersItem = [2]string{
+	0: "referrer",
+	1: "visitors",
 }
 
-// Decode decodes ForbiddenError from json.
-func (s *ForbiddenError) Decode(d *jx.Decoder) error {
+// Decode decodes StatsRealtimeReferrersItem from json.
+func (s *StatsRealtimeReferrersItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ForbiddenError to nil")
+		return errors.New("invalid: unable to decode StatsRealtimeReferrersItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "error":
+		case "referrer":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Error.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Referrer = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"error\"")
+				return errors.Wrap(err, "decode field \"referrer\"")
+			}
+		case "visitors":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Visitors = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ForbiddenError")
+		return errors.Wrap(err, "decode StatsRealtimeReferrersItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1430,8 +7628,8 @@ func (s *ForbiddenError) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfForbiddenError) {
-					name = jsonFieldsNameOfForbiddenError[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfStatsRealtimeReferrersItem) {
+					name = jsonFieldsNameOfStatsRealtimeReferrersItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1444,95 +7642,195 @@ func (s *ForbiddenError) Decode(d *jx.Decoder) error {
 			}
 		}
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StatsRealtimeReferrersItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsRealtimeReferrersItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StatsReferrers as json.
+func (s StatsReferrers) Encode(e *jx.Encoder) {
+	unwrapped := []StatsReferrersItem(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes StatsReferrers from json.
+func (s *StatsReferrers) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsReferrers to nil")
+	}
+	var unwrapped []StatsReferrersItem
+	if err := func() error {
+		unwrapped = make([]StatsReferrersItem, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem StatsReferrersItem
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = StatsReferrers(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ForbiddenError) MarshalJSON() ([]byte, error) {
+func (s StatsReferrers) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ForbiddenError) UnmarshalJSON(data []byte) error {
+func (s *StatsReferrers) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ForbiddenErrorError) Encode(e *jx.Encoder) {
+func (s *StatsReferrersItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ForbiddenErrorError) encodeFields(e *jx.Encoder) {
+func (s *StatsReferrersItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("code")
-		e.Int32(s.Code)
+		e.FieldStart("referrer")
+		e.Str(s.Referrer)
 	}
 	{
-		e.FieldStart("message")
-		e.Str(s.Message)
+		e.FieldStart("visitors")
+		e.Int(s.Visitors)
+	}
+	{
+		e.FieldStart("visitors_percentage")
+		e.Float32(s.VisitorsPercentage)
+	}
+	{
+		if s.BouncePercentage.Set {
+			e.FieldStart("bounce_percentage")
+			s.BouncePercentage.Encode(e)
+		}
+	}
+	{
+		if s.Duration.Set {
+			e.FieldStart("duration")
+			s.Duration.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfForbiddenErrorError = [2]string{
-	0: "code",
-	1: "message",
+var jsonFieldsNameOfStatsReferrersItem = [5]string{
+	0: "referrer",
+	1: "visitors",
+	2: "visitors_percentage",
+	3: "bounce_percentage",
+	4: "duration",
 }
 
-// Decode decodes ForbiddenErrorError from json.
-func (s *ForbiddenErrorError) Decode(d *jx.Decoder) error {
+// Decode decodes StatsReferrersItem from json.
+func (s *StatsReferrersItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ForbiddenErrorError to nil")
+		return errors.New("invalid: unable to decode StatsReferrersItem to nil")
 	}
 	var requiredBitSet [1]uint8
-	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "code":
+		case "referrer":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int32()
-				s.Code = int32(v)
+				v, err := d.Str()
+				s.Referrer = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"code\"")
+				return errors.Wrap(err, "decode field \"referrer\"")
 			}
-		case "message":
+		case "visitors":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Message = string(v)
+				v, err := d.Int()
+				s.Visitors = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"visitors\"")
+			}
+		case "visitors_percentage":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float32()
+				s.VisitorsPercentage = float32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors_percentage\"")
+			}
+		case "bounce_percentage":
+			if err := func() error {
+				s.BouncePercentage.Reset()
+				if err := s.BouncePercentage.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+			}
+		case "duration":
+			if err := func() error {
+				s.Duration.Reset()
+				if err := s.Duration.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration\"")
 			}
 		default:
-			return errors.Errorf("unexpected field %q", k)
+			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ForbiddenErrorError")
+		return errors.Wrap(err, "decode StatsReferrersItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1544,8 +7842,8 @@ func (s *ForbiddenErrorError) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfForbiddenErrorError) {
-					name = jsonFieldsNameOfForbiddenErrorError[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfStatsReferrersItem) {
+					name = jsonFieldsNameOfStatsReferrersItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1566,62 +7864,107 @@ func (s *ForbiddenErrorError) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ForbiddenErrorError) MarshalJSON() ([]byte, error) {
+func (s *StatsReferrersItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ForbiddenErrorError) UnmarshalJSON(data []byte) error {
+func (s *StatsReferrersItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *InternalServerError) Encode(e *jx.Encoder) {
+func (s *StatsSummary) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *InternalServerError) encodeFields(e *jx.Encoder) {
+func (s *StatsSummary) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("error")
-		s.Error.Encode(e)
+		e.FieldStart("current")
+		s.Current.Encode(e)
+	}
+	{
+		if s.Previous.Set {
+			e.FieldStart("previous")
+			s.Previous.Encode(e)
+		}
+	}
+	{
+		if s.Interval != nil {
+			e.FieldStart("interval")
+			e.ArrStart()
+			for _, elem := range s.Interval {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
 }
 
-var jsonFieldsNameOfInternalServerError = [1]string{
-	0: "error",
+var jsonFieldsNameOfStatsSummary = [3]string{
+	0: "current",
+	1: "previous",
+	2: "interval",
 }
 
-// Decode decodes InternalServerError from json.
-func (s *InternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes StatsSummary from json.
+func (s *StatsSummary) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode InternalServerError to nil")
+		return errors.New("invalid: unable to decode StatsSummary to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "error":
+		case "current":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Error.Decode(d); err != nil {
+				if err := s.Current.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"error\"")
+				return errors.Wrap(err, "decode field \"current\"")
+			}
+		case "previous":
+			if err := func() error {
+				s.Previous.Reset()
+				if err := s.Previous.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"previous\"")
+			}
+		case "interval":
+			if err := func() error {
+				s.Interval = make([]StatsSummaryIntervalItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem StatsSummaryIntervalItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Interval = append(s.Interval, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"interval\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode InternalServerError")
+		return errors.Wrap(err, "decode StatsSummary")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -1638,8 +7981,8 @@ func (s *InternalServerError) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfInternalServerError) {
-					name = jsonFieldsNameOfInternalServerError[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfStatsSummary) {
+					name = jsonFieldsNameOfStatsSummary[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1660,87 +8003,120 @@ func (s *InternalServerError) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *InternalServerError) MarshalJSON() ([]byte, error) {
+func (s *StatsSummary) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *InternalServerError) UnmarshalJSON(data []byte) error {
+func (s *StatsSummary) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *InternalServerErrorError) Encode(e *jx.Encoder) {
+func (s *StatsSummaryCurrent) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *InternalServerErrorError) encodeFields(e *jx.Encoder) {
+func (s *StatsSummaryCurrent) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("code")
-		e.Int32(s.Code)
+		e.FieldStart("visitors")
+		e.Int(s.Visitors)
 	}
 	{
-		e.FieldStart("message")
-		e.Str(s.Message)
+		e.FieldStart("pageviews")
+		e.Int(s.Pageviews)
+	}
+	{
+		e.FieldStart("bounce_percentage")
+		e.Float32(s.BouncePercentage)
+	}
+	{
+		e.FieldStart("duration")
+		e.Int(s.Duration)
 	}
 }
 
-var jsonFieldsNameOfInternalServerErrorError = [2]string{
-	0: "code",
-	1: "message",
+var jsonFieldsNameOfStatsSummaryCurrent = [4]string{
+	0: "visitors",
+	1: "pageviews",
+	2: "bounce_percentage",
+	3: "duration",
 }
 
-// Decode decodes InternalServerErrorError from json.
-func (s *InternalServerErrorError) Decode(d *jx.Decoder) error {
+// Decode decodes StatsSummaryCurrent from json.
+func (s *StatsSummaryCurrent) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode InternalServerErrorError to nil")
+		return errors.New("invalid: unable to decode StatsSummaryCurrent to nil")
 	}
 	var requiredBitSet [1]uint8
-	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "code":
+		case "visitors":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int32()
-				s.Code = int32(v)
+				v, err := d.Int()
+				s.Visitors = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"code\"")
+				return errors.Wrap(err, "decode field \"visitors\"")
 			}
-		case "message":
+		case "pageviews":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Message = string(v)
+				v, err := d.Int()
+				s.Pageviews = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"pageviews\"")
+			}
+		case "bounce_percentage":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float32()
+				s.BouncePercentage = float32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+			}
+		case "duration":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.Duration = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration\"")
 			}
 		default:
-			return errors.Errorf("unexpected field %q", k)
+			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode InternalServerErrorError")
+		return errors.Wrap(err, "decode StatsSummaryCurrent")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1752,8 +8128,8 @@ func (s *InternalServerErrorError) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfInternalServerErrorError) {
-					name = jsonFieldsNameOfInternalServerErrorError[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfStatsSummaryCurrent) {
+					name = jsonFieldsNameOfStatsSummaryCurrent[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1774,62 +8150,160 @@ func (s *InternalServerErrorError) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *InternalServerErrorError) MarshalJSON() ([]byte, error) {
+func (s *StatsSummaryCurrent) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *InternalServerErrorError) UnmarshalJSON(data []byte) error {
+func (s *StatsSummaryCurrent) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *NotFoundError) Encode(e *jx.Encoder) {
+func (s *StatsSummaryIntervalItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *NotFoundError) encodeFields(e *jx.Encoder) {
+func (s *StatsSummaryIntervalItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("error")
-		s.Error.Encode(e)
+		e.FieldStart("date")
+		e.Str(s.Date)
+	}
+	{
+		if s.Visitors.Set {
+			e.FieldStart("visitors")
+			s.Visitors.Encode(e)
+		}
+	}
+	{
+		if s.Pageviews.Set {
+			e.FieldStart("pageviews")
+			s.Pageviews.Encode(e)
+		}
+	}
+	{
+		if s.BouncePercentage.Set {
+			e.FieldStart("bounce_percentage")
+			s.BouncePercentage.Encode(e)
+		}
+	}
+	{
+		if s.Duration.Set {
+			e.FieldStart("duration")
+			s.Duration.Encode(e)
+		}
+	}
+	{
+		if s.Anomalies != nil {
+			e.FieldStart("anomalies")
+			e.ArrStart()
+			for _, elem := range s.Anomalies {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
 }
 
-var jsonFieldsNameOfNotFoundError = [1]string{
-	0: "error",
+var jsonFieldsNameOfStatsSummaryIntervalItem = [6]string{
+	0: "date",
+	1: "visitors",
+	2: "pageviews",
+	3: "bounce_percentage",
+	4: "duration",
+	5: "anomalies",
 }
 
-// Decode decodes NotFoundError from json.
-func (s *NotFoundError) Decode(d *jx.Decoder) error {
+// Decode decodes StatsSummaryIntervalItem from json.
+func (s *StatsSummaryIntervalItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NotFoundError to nil")
+		return errors.New("invalid: unable to decode StatsSummaryIntervalItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "error":
+		case "date":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Error.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Date = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"error\"")
+				return errors.Wrap(err, "decode field \"date\"")
+			}
+		case "visitors":
+			if err := func() error {
+				s.Visitors.Reset()
+				if err := s.Visitors.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors\"")
+			}
+		case "pageviews":
+			if err := func() error {
+				s.Pageviews.Reset()
+				if err := s.Pageviews.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pageviews\"")
+			}
+		case "bounce_percentage":
+			if err := func() error {
+				s.BouncePercentage.Reset()
+				if err := s.BouncePercentage.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+			}
+		case "duration":
+			if err := func() error {
+				s.Duration.Reset()
+				if err := s.Duration.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration\"")
+			}
+		case "anomalies":
+			if err := func() error {
+				s.Anomalies = make([]StatsAnomaly, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem StatsAnomaly
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Anomalies = append(s.Anomalies, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"anomalies\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode NotFoundError")
+		return errors.Wrap(err, "decode StatsSummaryIntervalItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -1846,8 +8320,8 @@ func (s *NotFoundError) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfNotFoundError) {
-					name = jsonFieldsNameOfNotFoundError[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfStatsSummaryIntervalItem) {
+					name = jsonFieldsNameOfStatsSummaryIntervalItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1868,87 +8342,120 @@ func (s *NotFoundError) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NotFoundError) MarshalJSON() ([]byte, error) {
+func (s *StatsSummaryIntervalItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NotFoundError) UnmarshalJSON(data []byte) error {
+func (s *StatsSummaryIntervalItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *NotFoundErrorError) Encode(e *jx.Encoder) {
+func (s *StatsSummaryPrevious) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *NotFoundErrorError) encodeFields(e *jx.Encoder) {
+func (s *StatsSummaryPrevious) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("code")
-		e.Int32(s.Code)
+		e.FieldStart("visitors")
+		e.Int(s.Visitors)
 	}
 	{
-		e.FieldStart("message")
-		e.Str(s.Message)
+		e.FieldStart("pageviews")
+		e.Int(s.Pageviews)
+	}
+	{
+		e.FieldStart("bounce_percentage")
+		e.Float32(s.BouncePercentage)
+	}
+	{
+		e.FieldStart("duration")
+		e.Int(s.Duration)
 	}
 }
 
-var jsonFieldsNameOfNotFoundErrorError = [2]string{
-	0: "code",
-	1: "message",
+var jsonFieldsNameOfStatsSummaryPrevious = [4]string{
+	0: "visitors",
+	1: "pageviews",
+	2: "bounce_percentage",
+	3: "duration",
 }
 
-// Decode decodes NotFoundErrorError from json.
-func (s *NotFoundErrorError) Decode(d *jx.Decoder) error {
+// Decode decodes StatsSummaryPrevious from json.
+func (s *StatsSummaryPrevious) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NotFoundErrorError to nil")
+		return errors.New("invalid: unable to decode StatsSummaryPrevious to nil")
 	}
 	var requiredBitSet [1]uint8
-	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "code":
+		case "visitors":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int32()
-				s.Code = int32(v)
+				v, err := d.Int()
+				s.Visitors = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"code\"")
+				return errors.Wrap(err, "decode field \"visitors\"")
 			}
-		case "message":
+		case "pageviews":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Message = string(v)
+				v, err := d.Int()
+				s.Pageviews = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pageviews\"")
+			}
+		case "bounce_percentage":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float32()
+				s.BouncePercentage = float32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+			}
+		case "duration":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.Duration = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"duration\"")
 			}
 		default:
-			return errors.Errorf("unexpected field %q", k)
+			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode NotFoundErrorError")
+		return errors.Wrap(err, "decode StatsSummaryPrevious")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1960,8 +8467,8 @@ func (s *NotFoundErrorError) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfNotFoundErrorError) {
-					name = jsonFieldsNameOfNotFoundErrorError[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfStatsSummaryPrevious) {
+					name = jsonFieldsNameOfStatsSummaryPrevious[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1982,327 +8489,252 @@ func (s *NotFoundErrorError) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NotFoundErrorError) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NotFoundErrorError) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes bool as json.
-func (o OptBool) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Bool(bool(o.Value))
-}
-
-// Decode decodes bool from json.
-func (o *OptBool) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptBool to nil")
-	}
-	o.Set = true
-	v, err := d.Bool()
-	if err != nil {
-		return err
-	}
-	o.Value = bool(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptBool) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptBool) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes EventLoadD as json.
-func (o OptEventLoadD) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
-}
-
-// Decode decodes EventLoadD from json.
-func (o *OptEventLoadD) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptEventLoadD to nil")
-	}
-	o.Set = true
-	o.Value = make(EventLoadD)
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptEventLoadD) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptEventLoadD) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes float32 as json.
-func (o OptFloat32) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Float32(float32(o.Value))
-}
-
-// Decode decodes float32 from json.
-func (o *OptFloat32) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptFloat32 to nil")
-	}
-	o.Set = true
-	v, err := d.Float32()
-	if err != nil {
-		return err
-	}
-	o.Value = float32(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptFloat32) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptFloat32) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes int as json.
-func (o OptInt) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Int(int(o.Value))
-}
-
-// Decode decodes int from json.
-func (o *OptInt) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptInt to nil")
-	}
-	o.Set = true
-	v, err := d.Int()
-	if err != nil {
-		return err
-	}
-	o.Value = int(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptInt) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptInt) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes StatsSummaryPrevious as json.
-func (o OptStatsSummaryPrevious) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
-}
-
-// Decode decodes StatsSummaryPrevious from json.
-func (o *OptStatsSummaryPrevious) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptStatsSummaryPrevious to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptStatsSummaryPrevious) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptStatsSummaryPrevious) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes string as json.
-func (o OptString) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
-}
-
-// Decode decodes string from json.
-func (o *OptString) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptString to nil")
-	}
-	o.Set = true
-	v, err := d.Str()
-	if err != nil {
-		return err
-	}
-	o.Value = string(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptString) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptString) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes UserSettings as json.
-func (o OptUserSettings) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
-}
-
-// Decode decodes UserSettings from json.
-func (o *OptUserSettings) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptUserSettings to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptUserSettings) MarshalJSON() ([]byte, error) {
+func (s *StatsSummaryPrevious) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptUserSettings) UnmarshalJSON(data []byte) error {
+func (s *StatsSummaryPrevious) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes UserSettingsLanguage as json.
-func (o OptUserSettingsLanguage) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode encodes StatsTime as json.
+func (s StatsTime) Encode(e *jx.Encoder) {
+	unwrapped := []StatsTimeItem(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
 	}
-	e.Str(string(o.Value))
+	e.ArrEnd()
 }
 
-// Decode decodes UserSettingsLanguage from json.
-func (o *OptUserSettingsLanguage) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptUserSettingsLanguage to nil")
+// Decode decodes StatsTime from json.
+func (s *StatsTime) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsTime to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	var unwrapped []StatsTimeItem
+	if err := func() error {
+		unwrapped = make([]StatsTimeItem, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem StatsTimeItem
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = StatsTime(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptUserSettingsLanguage) MarshalJSON() ([]byte, error) {
+func (s StatsTime) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptUserSettingsLanguage) UnmarshalJSON(data []byte) error {
+func (s *StatsTime) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes WebsiteGetSummary as json.
-func (o OptWebsiteGetSummary) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *StatsTimeItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StatsTimeItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("path")
+		e.Str(s.Path)
+	}
+	{
+		if s.Visitors.Set {
+			e.FieldStart("visitors")
+			s.Visitors.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("duration")
+		e.Int(s.Duration)
+	}
+	{
+		if s.DurationUpperQuartile.Set {
+			e.FieldStart("duration_upper_quartile")
+			s.DurationUpperQuartile.Encode(e)
+		}
+	}
+	{
+		if s.DurationLowerQuartile.Set {
+			e.FieldStart("duration_lower_quartile")
+			s.DurationLowerQuartile.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("duration_percentage")
+		e.Float32(s.DurationPercentage)
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes WebsiteGetSummary from json.
-func (o *OptWebsiteGetSummary) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptWebsiteGetSummary to nil")
+var jsonFieldsNameOfStatsTimeItem = [6]string{
+	0: "path",
+	1: "visitors",
+	2: "duration",
+	3: "duration_upper_quartile",
+	4: "duration_lower_quartile",
+	5: "duration_percentage",
+}
+
+// Decode decodes StatsTimeItem from json.
+func (s *StatsTimeItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StatsTimeItem to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "path":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Path = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"path\"")
+			}
+		case "visitors":
+			if err := func() error {
+				s.Visitors.Reset()
+				if err := s.Visitors.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors\"")
+			}
+		case "duration":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Duration = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration\"")
+			}
+		case "duration_upper_quartile":
+			if err := func() error {
+				s.DurationUpperQuartile.Reset()
+				if err := s.DurationUpperQuartile.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration_upper_quartile\"")
+			}
+		case "duration_lower_quartile":
+			if err := func() error {
+				s.DurationLowerQuartile.Reset()
+				if err := s.DurationLowerQuartile.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration_lower_quartile\"")
+			}
+		case "duration_percentage":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Float32()
+				s.DurationPercentage = float32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration_percentage\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StatsTimeItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00100101,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStatsTimeItem) {
+					name = jsonFieldsNameOfStatsTimeItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptWebsiteGetSummary) MarshalJSON() ([]byte, error) {
+func (s *StatsTimeItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptWebsiteGetSummary) UnmarshalJSON(data []byte) error {
+func (s *StatsTimeItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes StatsBrowsers as json.
-func (s StatsBrowsers) Encode(e *jx.Encoder) {
-	unwrapped := []StatsBrowsersItem(s)
+// Encode encodes StatsUTMCampaigns as json.
+func (s StatsUTMCampaigns) Encode(e *jx.Encoder) {
+	unwrapped := []StatsUTMCampaignsItem(s)
 
 	e.ArrStart()
 	for _, elem := range unwrapped {
@@ -2311,16 +8743,16 @@ func (s StatsBrowsers) Encode(e *jx.Encoder) {
 	e.ArrEnd()
 }
 
-// Decode decodes StatsBrowsers from json.
-func (s *StatsBrowsers) Decode(d *jx.Decoder) error {
+// Decode decodes StatsUTMCampaigns from json.
+func (s *StatsUTMCampaigns) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsBrowsers to nil")
+		return errors.New("invalid: unable to decode StatsUTMCampaigns to nil")
 	}
-	var unwrapped []StatsBrowsersItem
+	var unwrapped []StatsUTMCampaignsItem
 	if err := func() error {
-		unwrapped = make([]StatsBrowsersItem, 0)
+		unwrapped = make([]StatsUTMCampaignsItem, 0)
 		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem StatsBrowsersItem
+			var elem StatsUTMCampaignsItem
 			if err := elem.Decode(d); err != nil {
 				return err
 			}
@@ -2333,35 +8765,35 @@ func (s *StatsBrowsers) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = StatsBrowsers(unwrapped)
+	*s = StatsUTMCampaigns(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s StatsBrowsers) MarshalJSON() ([]byte, error) {
+func (s StatsUTMCampaigns) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsBrowsers) UnmarshalJSON(data []byte) error {
+func (s *StatsUTMCampaigns) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StatsBrowsersItem) Encode(e *jx.Encoder) {
+func (s *StatsUTMCampaignsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *StatsBrowsersItem) encodeFields(e *jx.Encoder) {
+func (s *StatsUTMCampaignsItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("browser")
-		e.Str(s.Browser)
+		e.FieldStart("campaign")
+		e.Str(s.Campaign)
 	}
 	{
 		e.FieldStart("visitors")
@@ -2385,34 +8817,34 @@ func (s *StatsBrowsersItem) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfStatsBrowsersItem = [5]string{
-	0: "browser",
+var jsonFieldsNameOfStatsUTMCampaignsItem = [5]string{
+	0: "campaign",
 	1: "visitors",
 	2: "visitors_percentage",
 	3: "bounce_percentage",
 	4: "duration",
 }
 
-// Decode decodes StatsBrowsersItem from json.
-func (s *StatsBrowsersItem) Decode(d *jx.Decoder) error {
+// Decode decodes StatsUTMCampaignsItem from json.
+func (s *StatsUTMCampaignsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsBrowsersItem to nil")
+		return errors.New("invalid: unable to decode StatsUTMCampaignsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "browser":
+		case "campaign":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.Browser = string(v)
+				s.Campaign = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"browser\"")
+				return errors.Wrap(err, "decode field \"campaign\"")
 			}
 		case "visitors":
 			requiredBitSet[0] |= 1 << 1
@@ -2463,7 +8895,7 @@ func (s *StatsBrowsersItem) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StatsBrowsersItem")
+		return errors.Wrap(err, "decode StatsUTMCampaignsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -2480,8 +8912,8 @@ func (s *StatsBrowsersItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStatsBrowsersItem) {
-					name = jsonFieldsNameOfStatsBrowsersItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfStatsUTMCampaignsItem) {
+					name = jsonFieldsNameOfStatsUTMCampaignsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2502,21 +8934,21 @@ func (s *StatsBrowsersItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StatsBrowsersItem) MarshalJSON() ([]byte, error) {
+func (s *StatsUTMCampaignsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsBrowsersItem) UnmarshalJSON(data []byte) error {
+func (s *StatsUTMCampaignsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes StatsCountries as json.
-func (s StatsCountries) Encode(e *jx.Encoder) {
-	unwrapped := []StatsCountriesItem(s)
+// Encode encodes StatsUTMMediums as json.
+func (s StatsUTMMediums) Encode(e *jx.Encoder) {
+	unwrapped := []StatsUTMMediumsItem(s)
 
 	e.ArrStart()
 	for _, elem := range unwrapped {
@@ -2525,16 +8957,16 @@ func (s StatsCountries) Encode(e *jx.Encoder) {
 	e.ArrEnd()
 }
 
-// Decode decodes StatsCountries from json.
-func (s *StatsCountries) Decode(d *jx.Decoder) error {
+// Decode decodes StatsUTMMediums from json.
+func (s *StatsUTMMediums) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsCountries to nil")
+		return errors.New("invalid: unable to decode StatsUTMMediums to nil")
 	}
-	var unwrapped []StatsCountriesItem
+	var unwrapped []StatsUTMMediumsItem
 	if err := func() error {
-		unwrapped = make([]StatsCountriesItem, 0)
+		unwrapped = make([]StatsUTMMediumsItem, 0)
 		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem StatsCountriesItem
+			var elem StatsUTMMediumsItem
 			if err := elem.Decode(d); err != nil {
 				return err
 			}
@@ -2547,35 +8979,35 @@ func (s *StatsCountries) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = StatsCountries(unwrapped)
+	*s = StatsUTMMediums(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s StatsCountries) MarshalJSON() ([]byte, error) {
+func (s StatsUTMMediums) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsCountries) UnmarshalJSON(data []byte) error {
+func (s *StatsUTMMediums) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StatsCountriesItem) Encode(e *jx.Encoder) {
+func (s *StatsUTMMediumsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *StatsCountriesItem) encodeFields(e *jx.Encoder) {
+func (s *StatsUTMMediumsItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("country")
-		e.Str(s.Country)
+		e.FieldStart("medium")
+		e.Str(s.Medium)
 	}
 	{
 		e.FieldStart("visitors")
@@ -2599,34 +9031,34 @@ func (s *StatsCountriesItem) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfStatsCountriesItem = [5]string{
-	0: "country",
+var jsonFieldsNameOfStatsUTMMediumsItem = [5]string{
+	0: "medium",
 	1: "visitors",
 	2: "visitors_percentage",
 	3: "bounce_percentage",
 	4: "duration",
 }
 
-// Decode decodes StatsCountriesItem from json.
-func (s *StatsCountriesItem) Decode(d *jx.Decoder) error {
+// Decode decodes StatsUTMMediumsItem from json.
+func (s *StatsUTMMediumsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsCountriesItem to nil")
+		return errors.New("invalid: unable to decode StatsUTMMediumsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "country":
+		case "medium":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.Country = string(v)
+				s.Medium = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"country\"")
+				return errors.Wrap(err, "decode field \"medium\"")
 			}
 		case "visitors":
 			requiredBitSet[0] |= 1 << 1
@@ -2677,7 +9109,7 @@ func (s *StatsCountriesItem) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StatsCountriesItem")
+		return errors.Wrap(err, "decode StatsUTMMediumsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -2694,8 +9126,8 @@ func (s *StatsCountriesItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStatsCountriesItem) {
-					name = jsonFieldsNameOfStatsCountriesItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfStatsUTMMediumsItem) {
+					name = jsonFieldsNameOfStatsUTMMediumsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2716,21 +9148,21 @@ func (s *StatsCountriesItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StatsCountriesItem) MarshalJSON() ([]byte, error) {
+func (s *StatsUTMMediumsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsCountriesItem) UnmarshalJSON(data []byte) error {
+func (s *StatsUTMMediumsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes StatsDevices as json.
-func (s StatsDevices) Encode(e *jx.Encoder) {
-	unwrapped := []StatsDevicesItem(s)
+// Encode encodes StatsUTMSources as json.
+func (s StatsUTMSources) Encode(e *jx.Encoder) {
+	unwrapped := []StatsUTMSourcesItem(s)
 
 	e.ArrStart()
 	for _, elem := range unwrapped {
@@ -2739,16 +9171,16 @@ func (s StatsDevices) Encode(e *jx.Encoder) {
 	e.ArrEnd()
 }
 
-// Decode decodes StatsDevices from json.
-func (s *StatsDevices) Decode(d *jx.Decoder) error {
+// Decode decodes StatsUTMSources from json.
+func (s *StatsUTMSources) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsDevices to nil")
+		return errors.New("invalid: unable to decode StatsUTMSources to nil")
 	}
-	var unwrapped []StatsDevicesItem
+	var unwrapped []StatsUTMSourcesItem
 	if err := func() error {
-		unwrapped = make([]StatsDevicesItem, 0)
+		unwrapped = make([]StatsUTMSourcesItem, 0)
 		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem StatsDevicesItem
+			var elem StatsUTMSourcesItem
 			if err := elem.Decode(d); err != nil {
 				return err
 			}
@@ -2761,35 +9193,35 @@ func (s *StatsDevices) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = StatsDevices(unwrapped)
+	*s = StatsUTMSources(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s StatsDevices) MarshalJSON() ([]byte, error) {
+func (s StatsUTMSources) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsDevices) UnmarshalJSON(data []byte) error {
+func (s *StatsUTMSources) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StatsDevicesItem) Encode(e *jx.Encoder) {
+func (s *StatsUTMSourcesItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *StatsDevicesItem) encodeFields(e *jx.Encoder) {
+func (s *StatsUTMSourcesItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("device")
-		e.Str(s.Device)
+		e.FieldStart("source")
+		e.Str(s.Source)
 	}
 	{
 		e.FieldStart("visitors")
@@ -2813,34 +9245,34 @@ func (s *StatsDevicesItem) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfStatsDevicesItem = [5]string{
-	0: "device",
+var jsonFieldsNameOfStatsUTMSourcesItem = [5]string{
+	0: "source",
 	1: "visitors",
 	2: "visitors_percentage",
 	3: "bounce_percentage",
 	4: "duration",
 }
 
-// Decode decodes StatsDevicesItem from json.
-func (s *StatsDevicesItem) Decode(d *jx.Decoder) error {
+// Decode decodes StatsUTMSourcesItem from json.
+func (s *StatsUTMSourcesItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsDevicesItem to nil")
+		return errors.New("invalid: unable to decode StatsUTMSourcesItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "device":
+		case "source":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.Device = string(v)
+				s.Source = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"device\"")
+				return errors.Wrap(err, "decode field \"source\"")
 			}
 		case "visitors":
 			requiredBitSet[0] |= 1 << 1
@@ -2891,7 +9323,7 @@ func (s *StatsDevicesItem) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StatsDevicesItem")
+		return errors.Wrap(err, "decode StatsUTMSourcesItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -2908,8 +9340,8 @@ func (s *StatsDevicesItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStatsDevicesItem) {
-					name = jsonFieldsNameOfStatsDevicesItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfStatsUTMSourcesItem) {
+					name = jsonFieldsNameOfStatsUTMSourcesItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2930,21 +9362,21 @@ func (s *StatsDevicesItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StatsDevicesItem) MarshalJSON() ([]byte, error) {
+func (s *StatsUTMSourcesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsDevicesItem) UnmarshalJSON(data []byte) error {
+func (s *StatsUTMSourcesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes StatsLanguages as json.
-func (s StatsLanguages) Encode(e *jx.Encoder) {
-	unwrapped := []StatsLanguagesItem(s)
+// Encode encodes StatsVisitPages as json.
+func (s StatsVisitPages) Encode(e *jx.Encoder) {
+	unwrapped := []StatsVisitPagesItem(s)
 
 	e.ArrStart()
 	for _, elem := range unwrapped {
@@ -2953,16 +9385,16 @@ func (s StatsLanguages) Encode(e *jx.Encoder) {
 	e.ArrEnd()
 }
 
-// Decode decodes StatsLanguages from json.
-func (s *StatsLanguages) Decode(d *jx.Decoder) error {
+// Decode decodes StatsVisitPages from json.
+func (s *StatsVisitPages) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsLanguages to nil")
+		return errors.New("invalid: unable to decode StatsVisitPages to nil")
 	}
-	var unwrapped []StatsLanguagesItem
+	var unwrapped []StatsVisitPagesItem
 	if err := func() error {
-		unwrapped = make([]StatsLanguagesItem, 0)
+		unwrapped = make([]StatsVisitPagesItem, 0)
 		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem StatsLanguagesItem
+			var elem StatsVisitPagesItem
 			if err := elem.Decode(d); err != nil {
 				return err
 			}
@@ -2975,35 +9407,35 @@ func (s *StatsLanguages) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = StatsLanguages(unwrapped)
+	*s = StatsVisitPages(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s StatsLanguages) MarshalJSON() ([]byte, error) {
+func (s StatsVisitPages) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsLanguages) UnmarshalJSON(data []byte) error {
+func (s *StatsVisitPages) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StatsLanguagesItem) Encode(e *jx.Encoder) {
+func (s *StatsVisitPagesItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *StatsLanguagesItem) encodeFields(e *jx.Encoder) {
+func (s *StatsVisitPagesItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("language")
-		e.Str(s.Language)
+		e.FieldStart("path")
+		e.Str(s.Path)
 	}
 	{
 		e.FieldStart("visitors")
@@ -3027,34 +9459,34 @@ func (s *StatsLanguagesItem) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfStatsLanguagesItem = [5]string{
-	0: "language",
+var jsonFieldsNameOfStatsVisitPagesItem = [5]string{
+	0: "path",
 	1: "visitors",
 	2: "visitors_percentage",
 	3: "bounce_percentage",
 	4: "duration",
 }
 
-// Decode decodes StatsLanguagesItem from json.
-func (s *StatsLanguagesItem) Decode(d *jx.Decoder) error {
+// Decode decodes StatsVisitPagesItem from json.
+func (s *StatsVisitPagesItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsLanguagesItem to nil")
+		return errors.New("invalid: unable to decode StatsVisitPagesItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "language":
+		case "path":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.Language = string(v)
+				s.Path = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"language\"")
+				return errors.Wrap(err, "decode field \"path\"")
 			}
 		case "visitors":
 			requiredBitSet[0] |= 1 << 1
@@ -3071,46 +9503,142 @@ func (s *StatsLanguagesItem) Decode(d *jx.Decoder) error {
 		case "visitors_percentage":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Float32()
-				s.VisitorsPercentage = float32(v)
+				v, err := d.Float32()
+				s.VisitorsPercentage = float32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visitors_percentage\"")
+			}
+		case "bounce_percentage":
+			if err := func() error {
+				s.BouncePercentage.Reset()
+				if err := s.BouncePercentage.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+			}
+		case "duration":
+			if err := func() error {
+				s.Duration.Reset()
+				if err := s.Duration.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StatsVisitPagesItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStatsVisitPagesItem) {
+					name = jsonFieldsNameOfStatsVisitPagesItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StatsVisitPagesItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StatsVisitPagesItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *TOTPConfirm) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TOTPConfirm) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("code")
+		e.Str(s.Code)
+	}
+}
+
+var jsonFieldsNameOfTOTPConfirm = [1]string{
+	0: "code",
+}
+
+// Decode decodes TOTPConfirm from json.
+func (s *TOTPConfirm) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TOTPConfirm to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "code":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Code = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"visitors_percentage\"")
-			}
-		case "bounce_percentage":
-			if err := func() error {
-				s.BouncePercentage.Reset()
-				if err := s.BouncePercentage.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bounce_percentage\"")
-			}
-		case "duration":
-			if err := func() error {
-				s.Duration.Reset()
-				if err := s.Duration.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"duration\"")
+				return errors.Wrap(err, "decode field \"code\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StatsLanguagesItem")
+		return errors.Wrap(err, "decode TOTPConfirm")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3122,8 +9650,8 @@ func (s *StatsLanguagesItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStatsLanguagesItem) {
-					name = jsonFieldsNameOfStatsLanguagesItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTOTPConfirm) {
+					name = jsonFieldsNameOfTOTPConfirm[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3144,187 +9672,290 @@ func (s *StatsLanguagesItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StatsLanguagesItem) MarshalJSON() ([]byte, error) {
+func (s *TOTPConfirm) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsLanguagesItem) UnmarshalJSON(data []byte) error {
+func (s *TOTPConfirm) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes StatsOS as json.
-func (s StatsOS) Encode(e *jx.Encoder) {
-	unwrapped := []StatsOSItem(s)
+// Encode implements json.Marshaler.
+func (s *TOTPDisable) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
+// encodeFields encodes fields.
+func (s *TOTPDisable) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("password")
+		e.Str(s.Password)
 	}
-	e.ArrEnd()
 }
 
-// Decode decodes StatsOS from json.
-func (s *StatsOS) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfTOTPDisable = [1]string{
+	0: "password",
+}
+
+// Decode decodes TOTPDisable from json.
+func (s *TOTPDisable) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsOS to nil")
+		return errors.New("invalid: unable to decode TOTPDisable to nil")
 	}
-	var unwrapped []StatsOSItem
-	if err := func() error {
-		unwrapped = make([]StatsOSItem, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem StatsOSItem
-			if err := elem.Decode(d); err != nil {
-				return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "password":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Password = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"password\"")
 			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode TOTPDisable")
 	}
-	*s = StatsOS(unwrapped)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTOTPDisable) {
+					name = jsonFieldsNameOfTOTPDisable[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s StatsOS) MarshalJSON() ([]byte, error) {
+func (s *TOTPDisable) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsOS) UnmarshalJSON(data []byte) error {
+func (s *TOTPDisable) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StatsOSItem) Encode(e *jx.Encoder) {
+func (s *TOTPEnrolment) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *StatsOSItem) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("os")
-		e.Str(s.Os)
-	}
-	{
-		e.FieldStart("visitors")
-		e.Int(s.Visitors)
-	}
-	{
-		e.FieldStart("visitors_percentage")
-		e.Float32(s.VisitorsPercentage)
-	}
+func (s *TOTPEnrolment) encodeFields(e *jx.Encoder) {
 	{
-		if s.BouncePercentage.Set {
-			e.FieldStart("bounce_percentage")
-			s.BouncePercentage.Encode(e)
-		}
+		e.FieldStart("secret")
+		e.Str(s.Secret)
 	}
 	{
-		if s.Duration.Set {
-			e.FieldStart("duration")
-			s.Duration.Encode(e)
-		}
+		e.FieldStart("uri")
+		e.Str(s.URI)
 	}
 }
 
-var jsonFieldsNameOfStatsOSItem = [5]string{
-	0: "os",
-	1: "visitors",
-	2: "visitors_percentage",
-	3: "bounce_percentage",
-	4: "duration",
+var jsonFieldsNameOfTOTPEnrolment = [2]string{
+	0: "secret",
+	1: "uri",
 }
 
-// Decode decodes StatsOSItem from json.
-func (s *StatsOSItem) Decode(d *jx.Decoder) error {
+// Decode decodes TOTPEnrolment from json.
+func (s *TOTPEnrolment) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsOSItem to nil")
+		return errors.New("invalid: unable to decode TOTPEnrolment to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "os":
+		case "secret":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.Os = string(v)
+				s.Secret = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"os\"")
+				return errors.Wrap(err, "decode field \"secret\"")
 			}
-		case "visitors":
+		case "uri":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int()
-				s.Visitors = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"visitors\"")
-			}
-		case "visitors_percentage":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Float32()
-				s.VisitorsPercentage = float32(v)
+				v, err := d.Str()
+				s.URI = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"visitors_percentage\"")
+				return errors.Wrap(err, "decode field \"uri\"")
 			}
-		case "bounce_percentage":
-			if err := func() error {
-				s.BouncePercentage.Reset()
-				if err := s.BouncePercentage.Decode(d); err != nil {
-					return err
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TOTPEnrolment")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTOTPEnrolment) {
+					name = jsonFieldsNameOfTOTPEnrolment[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "duration":
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *TOTPEnrolment) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TOTPEnrolment) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *TOTPRecoveryCodes) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TOTPRecoveryCodes) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("recoveryCodes")
+		e.ArrStart()
+		for _, elem := range s.RecoveryCodes {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfTOTPRecoveryCodes = [1]string{
+	0: "recoveryCodes",
+}
+
+// Decode decodes TOTPRecoveryCodes from json.
+func (s *TOTPRecoveryCodes) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TOTPRecoveryCodes to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "recoveryCodes":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Duration.Reset()
-				if err := s.Duration.Decode(d); err != nil {
+				s.RecoveryCodes = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.RecoveryCodes = append(s.RecoveryCodes, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"duration\"")
+				return errors.Wrap(err, "decode field \"recoveryCodes\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StatsOSItem")
+		return errors.Wrap(err, "decode TOTPRecoveryCodes")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3336,8 +9967,8 @@ func (s *StatsOSItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStatsOSItem) {
-					name = jsonFieldsNameOfStatsOSItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTOTPRecoveryCodes) {
+					name = jsonFieldsNameOfTOTPRecoveryCodes[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3358,418 +9989,264 @@ func (s *StatsOSItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StatsOSItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsOSItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes StatsPages as json.
-func (s StatsPages) Encode(e *jx.Encoder) {
-	unwrapped := []StatsPagesItem(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
-}
-
-// Decode decodes StatsPages from json.
-func (s *StatsPages) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode StatsPages to nil")
-	}
-	var unwrapped []StatsPagesItem
-	if err := func() error {
-		unwrapped = make([]StatsPagesItem, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem StatsPagesItem
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = StatsPages(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s StatsPages) MarshalJSON() ([]byte, error) {
+func (s *TOTPRecoveryCodes) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsPages) UnmarshalJSON(data []byte) error {
+func (s *TOTPRecoveryCodes) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StatsPagesItem) Encode(e *jx.Encoder) {
+func (s *TenantSettings) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *StatsPagesItem) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("path")
-		e.Str(s.Path)
-	}
-	{
-		e.FieldStart("visitors")
-		e.Int(s.Visitors)
-	}
+func (s *TenantSettings) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("visitors_percentage")
-		e.Float32(s.VisitorsPercentage)
+		if s.ScriptType != nil {
+			e.FieldStart("script_type")
+			e.ArrStart()
+			for _, elem := range s.ScriptType {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
 	{
-		if s.Pageviews.Set {
-			e.FieldStart("pageviews")
-			s.Pageviews.Encode(e)
+		if s.BlockAbusiveIPs.Set {
+			e.FieldStart("blockAbusiveIPs")
+			s.BlockAbusiveIPs.Encode(e)
 		}
 	}
 	{
-		if s.PageviewsPercentage.Set {
-			e.FieldStart("pageviews_percentage")
-			s.PageviewsPercentage.Encode(e)
+		if s.BlockTorExitNodes.Set {
+			e.FieldStart("blockTorExitNodes")
+			s.BlockTorExitNodes.Encode(e)
 		}
 	}
 	{
-		if s.BouncePercentage.Set {
-			e.FieldStart("bounce_percentage")
-			s.BouncePercentage.Encode(e)
+		if s.BlockedIPs != nil {
+			e.FieldStart("blockedIPs")
+			e.ArrStart()
+			for _, elem := range s.BlockedIPs {
+				json.EncodeIPv4(e, elem)
+			}
+			e.ArrEnd()
 		}
 	}
 	{
-		if s.Duration.Set {
-			e.FieldStart("duration")
-			s.Duration.Encode(e)
+		if s.RetentionDays.Set {
+			e.FieldStart("retentionDays")
+			s.RetentionDays.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfStatsPagesItem = [7]string{
-	0: "path",
-	1: "visitors",
-	2: "visitors_percentage",
-	3: "pageviews",
-	4: "pageviews_percentage",
-	5: "bounce_percentage",
-	6: "duration",
+var jsonFieldsNameOfTenantSettings = [5]string{
+	0: "script_type",
+	1: "blockAbusiveIPs",
+	2: "blockTorExitNodes",
+	3: "blockedIPs",
+	4: "retentionDays",
 }
 
-// Decode decodes StatsPagesItem from json.
-func (s *StatsPagesItem) Decode(d *jx.Decoder) error {
+// Decode decodes TenantSettings from json.
+func (s *TenantSettings) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsPagesItem to nil")
+		return errors.New("invalid: unable to decode TenantSettings to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "path":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.Path = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"path\"")
-			}
-		case "visitors":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int()
-				s.Visitors = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"visitors\"")
-			}
-		case "visitors_percentage":
-			requiredBitSet[0] |= 1 << 2
+		case "script_type":
 			if err := func() error {
-				v, err := d.Float32()
-				s.VisitorsPercentage = float32(v)
-				if err != nil {
+				s.ScriptType = make([]TenantSettingsScriptTypeItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TenantSettingsScriptTypeItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.ScriptType = append(s.ScriptType, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"visitors_percentage\"")
+				return errors.Wrap(err, "decode field \"script_type\"")
 			}
-		case "pageviews":
+		case "blockAbusiveIPs":
 			if err := func() error {
-				s.Pageviews.Reset()
-				if err := s.Pageviews.Decode(d); err != nil {
+				s.BlockAbusiveIPs.Reset()
+				if err := s.BlockAbusiveIPs.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"pageviews\"")
+				return errors.Wrap(err, "decode field \"blockAbusiveIPs\"")
 			}
-		case "pageviews_percentage":
+		case "blockTorExitNodes":
 			if err := func() error {
-				s.PageviewsPercentage.Reset()
-				if err := s.PageviewsPercentage.Decode(d); err != nil {
+				s.BlockTorExitNodes.Reset()
+				if err := s.BlockTorExitNodes.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"pageviews_percentage\"")
+				return errors.Wrap(err, "decode field \"blockTorExitNodes\"")
 			}
-		case "bounce_percentage":
+		case "blockedIPs":
 			if err := func() error {
-				s.BouncePercentage.Reset()
-				if err := s.BouncePercentage.Decode(d); err != nil {
+				s.BlockedIPs = make([]netip.Addr, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem netip.Addr
+					v, err := json.DecodeIPv4(d)
+					elem = v
+					if err != nil {
+						return err
+					}
+					s.BlockedIPs = append(s.BlockedIPs, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+				return errors.Wrap(err, "decode field \"blockedIPs\"")
 			}
-		case "duration":
+		case "retentionDays":
 			if err := func() error {
-				s.Duration.Reset()
-				if err := s.Duration.Decode(d); err != nil {
+				s.RetentionDays.Reset()
+				if err := s.RetentionDays.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"duration\"")
+				return errors.Wrap(err, "decode field \"retentionDays\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StatsPagesItem")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfStatsPagesItem) {
-					name = jsonFieldsNameOfStatsPagesItem[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return errors.Wrap(err, "decode TenantSettings")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StatsPagesItem) MarshalJSON() ([]byte, error) {
+func (s *TenantSettings) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsPagesItem) UnmarshalJSON(data []byte) error {
+func (s *TenantSettings) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes StatsProperties as json.
-func (s StatsProperties) Encode(e *jx.Encoder) {
-	unwrapped := []StatsPropertiesItem(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
+// Encode encodes TenantSettingsScriptTypeItem as json.
+func (s TenantSettingsScriptTypeItem) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes StatsProperties from json.
-func (s *StatsProperties) Decode(d *jx.Decoder) error {
+// Decode decodes TenantSettingsScriptTypeItem from json.
+func (s *TenantSettingsScriptTypeItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsProperties to nil")
+		return errors.New("invalid: unable to decode TenantSettingsScriptTypeItem to nil")
 	}
-	var unwrapped []StatsPropertiesItem
-	if err := func() error {
-		unwrapped = make([]StatsPropertiesItem, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem StatsPropertiesItem
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = StatsProperties(unwrapped)
+	// Try to use constant string.
+	switch TenantSettingsScriptTypeItem(v) {
+	case TenantSettingsScriptTypeItemDefault:
+		*s = TenantSettingsScriptTypeItemDefault
+	case TenantSettingsScriptTypeItemClickEvents:
+		*s = TenantSettingsScriptTypeItemClickEvents
+	case TenantSettingsScriptTypeItemPageEvents:
+		*s = TenantSettingsScriptTypeItemPageEvents
+	default:
+		*s = TenantSettingsScriptTypeItem(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s StatsProperties) MarshalJSON() ([]byte, error) {
+func (s TenantSettingsScriptTypeItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsProperties) UnmarshalJSON(data []byte) error {
+func (s *TenantSettingsScriptTypeItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StatsPropertiesItem) Encode(e *jx.Encoder) {
+func (s *TooManyRequestsError) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *StatsPropertiesItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
-		}
-	}
-	{
-		if s.Value.Set {
-			e.FieldStart("value")
-			s.Value.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("events")
-		e.Int(s.Events)
-	}
+func (s *TooManyRequestsError) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("events_percentage")
-		e.Float32(s.EventsPercentage)
+		e.FieldStart("error")
+		s.Error.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfStatsPropertiesItem = [4]string{
-	0: "name",
-	1: "value",
-	2: "events",
-	3: "events_percentage",
+var jsonFieldsNameOfTooManyRequestsError = [1]string{
+	0: "error",
 }
 
-// Decode decodes StatsPropertiesItem from json.
-func (s *StatsPropertiesItem) Decode(d *jx.Decoder) error {
+// Decode decodes TooManyRequestsError from json.
+func (s *TooManyRequestsError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsPropertiesItem to nil")
+		return errors.New("invalid: unable to decode TooManyRequestsError to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "value":
-			if err := func() error {
-				s.Value.Reset()
-				if err := s.Value.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"value\"")
-			}
-		case "events":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Int()
-				s.Events = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"events\"")
-			}
-		case "events_percentage":
-			requiredBitSet[0] |= 1 << 3
+		case "error":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Float32()
-				s.EventsPercentage = float32(v)
-				if err != nil {
+				if err := s.Error.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"events_percentage\"")
+				return errors.Wrap(err, "decode field \"error\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StatsPropertiesItem")
+		return errors.Wrap(err, "decode TooManyRequestsError")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00001100,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3781,8 +10258,8 @@ func (s *StatsPropertiesItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStatsPropertiesItem) {
-					name = jsonFieldsNameOfStatsPropertiesItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTooManyRequestsError) {
+					name = jsonFieldsNameOfTooManyRequestsError[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3803,187 +10280,87 @@ func (s *StatsPropertiesItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StatsPropertiesItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsPropertiesItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes StatsReferrers as json.
-func (s StatsReferrers) Encode(e *jx.Encoder) {
-	unwrapped := []StatsReferrersItem(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
-}
-
-// Decode decodes StatsReferrers from json.
-func (s *StatsReferrers) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode StatsReferrers to nil")
-	}
-	var unwrapped []StatsReferrersItem
-	if err := func() error {
-		unwrapped = make([]StatsReferrersItem, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem StatsReferrersItem
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = StatsReferrers(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s StatsReferrers) MarshalJSON() ([]byte, error) {
+func (s *TooManyRequestsError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsReferrers) UnmarshalJSON(data []byte) error {
+func (s *TooManyRequestsError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StatsReferrersItem) Encode(e *jx.Encoder) {
+func (s *TooManyRequestsErrorError) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *StatsReferrersItem) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("referrer")
-		e.Str(s.Referrer)
-	}
-	{
-		e.FieldStart("visitors")
-		e.Int(s.Visitors)
-	}
-	{
-		e.FieldStart("visitors_percentage")
-		e.Float32(s.VisitorsPercentage)
-	}
+func (s *TooManyRequestsErrorError) encodeFields(e *jx.Encoder) {
 	{
-		if s.BouncePercentage.Set {
-			e.FieldStart("bounce_percentage")
-			s.BouncePercentage.Encode(e)
-		}
+		e.FieldStart("code")
+		e.Int32(s.Code)
 	}
 	{
-		if s.Duration.Set {
-			e.FieldStart("duration")
-			s.Duration.Encode(e)
-		}
+		e.FieldStart("message")
+		e.Str(s.Message)
 	}
 }
 
-var jsonFieldsNameOfStatsReferrersItem = [5]string{
-	0: "referrer",
-	1: "visitors",
-	2: "visitors_percentage",
-	3: "bounce_percentage",
-	4: "duration",
+var jsonFieldsNameOfTooManyRequestsErrorError = [2]string{
+	0: "code",
+	1: "message",
 }
 
-// Decode decodes StatsReferrersItem from json.
-func (s *StatsReferrersItem) Decode(d *jx.Decoder) error {
+// Decode decodes TooManyRequestsErrorError from json.
+func (s *TooManyRequestsErrorError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsReferrersItem to nil")
+		return errors.New("invalid: unable to decode TooManyRequestsErrorError to nil")
 	}
 	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "referrer":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.Referrer = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"referrer\"")
-			}
-		case "visitors":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int()
-				s.Visitors = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"visitors\"")
-			}
-		case "visitors_percentage":
-			requiredBitSet[0] |= 1 << 2
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "code":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Float32()
-				s.VisitorsPercentage = float32(v)
+				v, err := d.Int32()
+				s.Code = int32(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"visitors_percentage\"")
-			}
-		case "bounce_percentage":
-			if err := func() error {
-				s.BouncePercentage.Reset()
-				if err := s.BouncePercentage.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+				return errors.Wrap(err, "decode field \"code\"")
 			}
-		case "duration":
+		case "message":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Duration.Reset()
-				if err := s.Duration.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"duration\"")
+				return errors.Wrap(err, "decode field \"message\"")
 			}
 		default:
-			return d.Skip()
+			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StatsReferrersItem")
+		return errors.Wrap(err, "decode TooManyRequestsErrorError")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3995,8 +10372,8 @@ func (s *StatsReferrersItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStatsReferrersItem) {
-					name = jsonFieldsNameOfStatsReferrersItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTooManyRequestsErrorError) {
+					name = jsonFieldsNameOfTooManyRequestsErrorError[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4017,107 +10394,62 @@ func (s *StatsReferrersItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StatsReferrersItem) MarshalJSON() ([]byte, error) {
+func (s *TooManyRequestsErrorError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsReferrersItem) UnmarshalJSON(data []byte) error {
+func (s *TooManyRequestsErrorError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StatsSummary) Encode(e *jx.Encoder) {
+func (s *UnauthorisedError) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *StatsSummary) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("current")
-		s.Current.Encode(e)
-	}
-	{
-		if s.Previous.Set {
-			e.FieldStart("previous")
-			s.Previous.Encode(e)
-		}
-	}
+func (s *UnauthorisedError) encodeFields(e *jx.Encoder) {
 	{
-		if s.Interval != nil {
-			e.FieldStart("interval")
-			e.ArrStart()
-			for _, elem := range s.Interval {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
+		e.FieldStart("error")
+		s.Error.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfStatsSummary = [3]string{
-	0: "current",
-	1: "previous",
-	2: "interval",
+var jsonFieldsNameOfUnauthorisedError = [1]string{
+	0: "error",
 }
 
-// Decode decodes StatsSummary from json.
-func (s *StatsSummary) Decode(d *jx.Decoder) error {
+// Decode decodes UnauthorisedError from json.
+func (s *UnauthorisedError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsSummary to nil")
+		return errors.New("invalid: unable to decode UnauthorisedError to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "current":
+		case "error":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Current.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"current\"")
-			}
-		case "previous":
-			if err := func() error {
-				s.Previous.Reset()
-				if err := s.Previous.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"previous\"")
-			}
-		case "interval":
-			if err := func() error {
-				s.Interval = make([]StatsSummaryIntervalItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem StatsSummaryIntervalItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Interval = append(s.Interval, elem)
-					return nil
-				}); err != nil {
+				if err := s.Error.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"interval\"")
+				return errors.Wrap(err, "decode field \"error\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StatsSummary")
+		return errors.Wrap(err, "decode UnauthorisedError")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -4134,8 +10466,8 @@ func (s *StatsSummary) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStatsSummary) {
-					name = jsonFieldsNameOfStatsSummary[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUnauthorisedError) {
+					name = jsonFieldsNameOfUnauthorisedError[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4156,120 +10488,87 @@ func (s *StatsSummary) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StatsSummary) MarshalJSON() ([]byte, error) {
+func (s *UnauthorisedError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsSummary) UnmarshalJSON(data []byte) error {
+func (s *UnauthorisedError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StatsSummaryCurrent) Encode(e *jx.Encoder) {
+func (s *UnauthorisedErrorError) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *StatsSummaryCurrent) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("visitors")
-		e.Int(s.Visitors)
-	}
-	{
-		e.FieldStart("pageviews")
-		e.Int(s.Pageviews)
-	}
+func (s *UnauthorisedErrorError) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("bounce_percentage")
-		e.Float32(s.BouncePercentage)
+		e.FieldStart("code")
+		e.Int32(s.Code)
 	}
 	{
-		e.FieldStart("duration")
-		e.Int(s.Duration)
+		e.FieldStart("message")
+		e.Str(s.Message)
 	}
 }
 
-var jsonFieldsNameOfStatsSummaryCurrent = [4]string{
-	0: "visitors",
-	1: "pageviews",
-	2: "bounce_percentage",
-	3: "duration",
+var jsonFieldsNameOfUnauthorisedErrorError = [2]string{
+	0: "code",
+	1: "message",
 }
 
-// Decode decodes StatsSummaryCurrent from json.
-func (s *StatsSummaryCurrent) Decode(d *jx.Decoder) error {
+// Decode decodes UnauthorisedErrorError from json.
+func (s *UnauthorisedErrorError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsSummaryCurrent to nil")
+		return errors.New("invalid: unable to decode UnauthorisedErrorError to nil")
 	}
 	var requiredBitSet [1]uint8
+	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "visitors":
+		case "code":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int()
-				s.Visitors = int(v)
+				v, err := d.Int32()
+				s.Code = int32(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"visitors\"")
+				return errors.Wrap(err, "decode field \"code\"")
 			}
-		case "pageviews":
+		case "message":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int()
-				s.Pageviews = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"pageviews\"")
-			}
-		case "bounce_percentage":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Float32()
-				s.BouncePercentage = float32(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bounce_percentage\"")
-			}
-		case "duration":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Int()
-				s.Duration = int(v)
+				v, err := d.Str()
+				s.Message = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"duration\"")
+				return errors.Wrap(err, "decode field \"message\"")
 			}
 		default:
-			return d.Skip()
+			return errors.Errorf("unexpected field %q", k)
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StatsSummaryCurrent")
+		return errors.Wrap(err, "decode UnauthorisedErrorError")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00001111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4281,8 +10580,8 @@ func (s *StatsSummaryCurrent) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStatsSummaryCurrent) {
-					name = jsonFieldsNameOfStatsSummaryCurrent[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUnauthorisedErrorError) {
+					name = jsonFieldsNameOfUnauthorisedErrorError[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4303,137 +10602,147 @@ func (s *StatsSummaryCurrent) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StatsSummaryCurrent) MarshalJSON() ([]byte, error) {
+func (s *UnauthorisedErrorError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsSummaryCurrent) UnmarshalJSON(data []byte) error {
+func (s *UnauthorisedErrorError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StatsSummaryIntervalItem) Encode(e *jx.Encoder) {
+func (s *UserAccount) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *StatsSummaryIntervalItem) encodeFields(e *jx.Encoder) {
+func (s *UserAccount) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("date")
-		e.Str(s.Date)
+		e.FieldStart("id")
+		e.Str(s.ID)
 	}
 	{
-		if s.Visitors.Set {
-			e.FieldStart("visitors")
-			s.Visitors.Encode(e)
-		}
+		e.FieldStart("username")
+		e.Str(s.Username)
 	}
 	{
-		if s.Pageviews.Set {
-			e.FieldStart("pageviews")
-			s.Pageviews.Encode(e)
-		}
+		e.FieldStart("role")
+		s.Role.Encode(e)
 	}
 	{
-		if s.BouncePercentage.Set {
-			e.FieldStart("bounce_percentage")
-			s.BouncePercentage.Encode(e)
+		e.FieldStart("websites")
+		e.ArrStart()
+		for _, elem := range s.Websites {
+			e.Str(elem)
 		}
+		e.ArrEnd()
 	}
 	{
-		if s.Duration.Set {
-			e.FieldStart("duration")
-			s.Duration.Encode(e)
-		}
+		e.FieldStart("dateCreated")
+		e.Int64(s.DateCreated)
 	}
 }
 
-var jsonFieldsNameOfStatsSummaryIntervalItem = [5]string{
-	0: "date",
-	1: "visitors",
-	2: "pageviews",
-	3: "bounce_percentage",
-	4: "duration",
+var jsonFieldsNameOfUserAccount = [5]string{
+	0: "id",
+	1: "username",
+	2: "role",
+	3: "websites",
+	4: "dateCreated",
 }
 
-// Decode decodes StatsSummaryIntervalItem from json.
-func (s *StatsSummaryIntervalItem) Decode(d *jx.Decoder) error {
+// Decode decodes UserAccount from json.
+func (s *UserAccount) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsSummaryIntervalItem to nil")
+		return errors.New("invalid: unable to decode UserAccount to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "date":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.Date = string(v)
+				s.ID = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"date\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "visitors":
+		case "username":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Visitors.Reset()
-				if err := s.Visitors.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Username = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"visitors\"")
+				return errors.Wrap(err, "decode field \"username\"")
 			}
-		case "pageviews":
+		case "role":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Pageviews.Reset()
-				if err := s.Pageviews.Decode(d); err != nil {
+				if err := s.Role.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"pageviews\"")
+				return errors.Wrap(err, "decode field \"role\"")
 			}
-		case "bounce_percentage":
+		case "websites":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.BouncePercentage.Reset()
-				if err := s.BouncePercentage.Decode(d); err != nil {
+				s.Websites = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Websites = append(s.Websites, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+				return errors.Wrap(err, "decode field \"websites\"")
 			}
-		case "duration":
+		case "dateCreated":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.Duration.Reset()
-				if err := s.Duration.Decode(d); err != nil {
+				v, err := d.Int64()
+				s.DateCreated = int64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"duration\"")
+				return errors.Wrap(err, "decode field \"dateCreated\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StatsSummaryIntervalItem")
+		return errors.Wrap(err, "decode UserAccount")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00011111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4445,8 +10754,8 @@ func (s *StatsSummaryIntervalItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStatsSummaryIntervalItem) {
-					name = jsonFieldsNameOfStatsSummaryIntervalItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUserAccount) {
+					name = jsonFieldsNameOfUserAccount[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4467,120 +10776,131 @@ func (s *StatsSummaryIntervalItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StatsSummaryIntervalItem) MarshalJSON() ([]byte, error) {
+func (s *UserAccount) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsSummaryIntervalItem) UnmarshalJSON(data []byte) error {
+func (s *UserAccount) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StatsSummaryPrevious) Encode(e *jx.Encoder) {
+func (s *UserAccountCreate) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *StatsSummaryPrevious) encodeFields(e *jx.Encoder) {
+func (s *UserAccountCreate) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("visitors")
-		e.Int(s.Visitors)
+		e.FieldStart("username")
+		e.Str(s.Username)
 	}
 	{
-		e.FieldStart("pageviews")
-		e.Int(s.Pageviews)
+		e.FieldStart("password")
+		e.Str(s.Password)
 	}
 	{
-		e.FieldStart("bounce_percentage")
-		e.Float32(s.BouncePercentage)
+		e.FieldStart("role")
+		s.Role.Encode(e)
 	}
 	{
-		e.FieldStart("duration")
-		e.Int(s.Duration)
+		if s.Websites != nil {
+			e.FieldStart("websites")
+			e.ArrStart()
+			for _, elem := range s.Websites {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
 	}
 }
 
-var jsonFieldsNameOfStatsSummaryPrevious = [4]string{
-	0: "visitors",
-	1: "pageviews",
-	2: "bounce_percentage",
-	3: "duration",
+var jsonFieldsNameOfUserAccountCreate = [4]string{
+	0: "username",
+	1: "password",
+	2: "role",
+	3: "websites",
 }
 
-// Decode decodes StatsSummaryPrevious from json.
-func (s *StatsSummaryPrevious) Decode(d *jx.Decoder) error {
+// Decode decodes UserAccountCreate from json.
+func (s *UserAccountCreate) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsSummaryPrevious to nil")
+		return errors.New("invalid: unable to decode UserAccountCreate to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "visitors":
+		case "username":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int()
-				s.Visitors = int(v)
+				v, err := d.Str()
+				s.Username = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"visitors\"")
+				return errors.Wrap(err, "decode field \"username\"")
 			}
-		case "pageviews":
+		case "password":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int()
-				s.Pageviews = int(v)
+				v, err := d.Str()
+				s.Password = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"pageviews\"")
+				return errors.Wrap(err, "decode field \"password\"")
 			}
-		case "bounce_percentage":
+		case "role":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Float32()
-				s.BouncePercentage = float32(v)
-				if err != nil {
+				if err := s.Role.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+				return errors.Wrap(err, "decode field \"role\"")
 			}
-		case "duration":
-			requiredBitSet[0] |= 1 << 3
+		case "websites":
 			if err := func() error {
-				v, err := d.Int()
-				s.Duration = int(v)
-				if err != nil {
+				s.Websites = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Websites = append(s.Websites, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"duration\"")
+				return errors.Wrap(err, "decode field \"websites\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StatsSummaryPrevious")
+		return errors.Wrap(err, "decode UserAccountCreate")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00001111,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4592,8 +10912,8 @@ func (s *StatsSummaryPrevious) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStatsSummaryPrevious) {
-					name = jsonFieldsNameOfStatsSummaryPrevious[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUserAccountCreate) {
+					name = jsonFieldsNameOfUserAccountCreate[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4614,204 +10934,323 @@ func (s *StatsSummaryPrevious) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StatsSummaryPrevious) MarshalJSON() ([]byte, error) {
+func (s *UserAccountCreate) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsSummaryPrevious) UnmarshalJSON(data []byte) error {
+func (s *UserAccountCreate) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes StatsTime as json.
-func (s StatsTime) Encode(e *jx.Encoder) {
-	unwrapped := []StatsTimeItem(s)
+// Encode encodes UserAccountCreateRole as json.
+func (s UserAccountCreateRole) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
 
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
+// Decode decodes UserAccountCreateRole from json.
+func (s *UserAccountCreateRole) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UserAccountCreateRole to nil")
 	}
-	e.ArrEnd()
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch UserAccountCreateRole(v) {
+	case UserAccountCreateRoleAdmin:
+		*s = UserAccountCreateRoleAdmin
+	case UserAccountCreateRoleViewer:
+		*s = UserAccountCreateRoleViewer
+	default:
+		*s = UserAccountCreateRole(v)
+	}
+
+	return nil
 }
 
-// Decode decodes StatsTime from json.
-func (s *StatsTime) Decode(d *jx.Decoder) error {
+// MarshalJSON implements stdjson.Marshaler.
+func (s UserAccountCreateRole) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UserAccountCreateRole) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *UserAccountPatch) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *UserAccountPatch) encodeFields(e *jx.Encoder) {
+	{
+		if s.Role.Set {
+			e.FieldStart("role")
+			s.Role.Encode(e)
+		}
+	}
+	{
+		if s.Websites != nil {
+			e.FieldStart("websites")
+			e.ArrStart()
+			for _, elem := range s.Websites {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfUserAccountPatch = [2]string{
+	0: "role",
+	1: "websites",
+}
+
+// Decode decodes UserAccountPatch from json.
+func (s *UserAccountPatch) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsTime to nil")
+		return errors.New("invalid: unable to decode UserAccountPatch to nil")
 	}
-	var unwrapped []StatsTimeItem
-	if err := func() error {
-		unwrapped = make([]StatsTimeItem, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem StatsTimeItem
-			if err := elem.Decode(d); err != nil {
-				return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "role":
+			if err := func() error {
+				s.Role.Reset()
+				if err := s.Role.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"role\"")
 			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
+		case "websites":
+			if err := func() error {
+				s.Websites = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Websites = append(s.Websites, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"websites\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode UserAccountPatch")
 	}
-	*s = StatsTime(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s StatsTime) MarshalJSON() ([]byte, error) {
+func (s *UserAccountPatch) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsTime) UnmarshalJSON(data []byte) error {
+func (s *UserAccountPatch) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UserAccountPatchRole as json.
+func (s UserAccountPatchRole) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes UserAccountPatchRole from json.
+func (s *UserAccountPatchRole) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UserAccountPatchRole to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch UserAccountPatchRole(v) {
+	case UserAccountPatchRoleAdmin:
+		*s = UserAccountPatchRoleAdmin
+	case UserAccountPatchRoleViewer:
+		*s = UserAccountPatchRoleViewer
+	default:
+		*s = UserAccountPatchRole(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s UserAccountPatchRole) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UserAccountPatchRole) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StatsTimeItem) Encode(e *jx.Encoder) {
+func (s *UserGet) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *StatsTimeItem) encodeFields(e *jx.Encoder) {
+func (s *UserGet) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("path")
-		e.Str(s.Path)
+		e.FieldStart("username")
+		e.Str(s.Username)
 	}
 	{
-		if s.Visitors.Set {
-			e.FieldStart("visitors")
-			s.Visitors.Encode(e)
-		}
+		e.FieldStart("role")
+		s.Role.Encode(e)
 	}
 	{
-		e.FieldStart("duration")
-		e.Int(s.Duration)
+		e.FieldStart("settings")
+		s.Settings.Encode(e)
 	}
 	{
-		if s.DurationUpperQuartile.Set {
-			e.FieldStart("duration_upper_quartile")
-			s.DurationUpperQuartile.Encode(e)
-		}
+		e.FieldStart("totpEnabled")
+		e.Bool(s.TotpEnabled)
 	}
 	{
-		if s.DurationLowerQuartile.Set {
-			e.FieldStart("duration_lower_quartile")
-			s.DurationLowerQuartile.Encode(e)
-		}
+		e.FieldStart("dateCreated")
+		e.Int64(s.DateCreated)
 	}
 	{
-		e.FieldStart("duration_percentage")
-		e.Float32(s.DurationPercentage)
-	}
-}
-
-var jsonFieldsNameOfStatsTimeItem = [6]string{
-	0: "path",
-	1: "visitors",
-	2: "duration",
-	3: "duration_upper_quartile",
-	4: "duration_lower_quartile",
-	5: "duration_percentage",
+		e.FieldStart("dateUpdated")
+		e.Int64(s.DateUpdated)
+	}
 }
 
-// Decode decodes StatsTimeItem from json.
-func (s *StatsTimeItem) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfUserGet = [6]string{
+	0: "username",
+	1: "role",
+	2: "settings",
+	3: "totpEnabled",
+	4: "dateCreated",
+	5: "dateUpdated",
+}
+
+// Decode decodes UserGet from json.
+func (s *UserGet) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsTimeItem to nil")
+		return errors.New("invalid: unable to decode UserGet to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "path":
+		case "username":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.Path = string(v)
+				s.Username = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"path\"")
+				return errors.Wrap(err, "decode field \"username\"")
 			}
-		case "visitors":
+		case "role":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Visitors.Reset()
-				if err := s.Visitors.Decode(d); err != nil {
+				if err := s.Role.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"visitors\"")
+				return errors.Wrap(err, "decode field \"role\"")
 			}
-		case "duration":
+		case "settings":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Int()
-				s.Duration = int(v)
-				if err != nil {
+				if err := s.Settings.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"duration\"")
+				return errors.Wrap(err, "decode field \"settings\"")
 			}
-		case "duration_upper_quartile":
+		case "totpEnabled":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.DurationUpperQuartile.Reset()
-				if err := s.DurationUpperQuartile.Decode(d); err != nil {
+				v, err := d.Bool()
+				s.TotpEnabled = bool(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"duration_upper_quartile\"")
+				return errors.Wrap(err, "decode field \"totpEnabled\"")
 			}
-		case "duration_lower_quartile":
+		case "dateCreated":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.DurationLowerQuartile.Reset()
-				if err := s.DurationLowerQuartile.Decode(d); err != nil {
+				v, err := d.Int64()
+				s.DateCreated = int64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"duration_lower_quartile\"")
+				return errors.Wrap(err, "decode field \"dateCreated\"")
 			}
-		case "duration_percentage":
+		case "dateUpdated":
 			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				v, err := d.Float32()
-				s.DurationPercentage = float32(v)
+				v, err := d.Int64()
+				s.DateUpdated = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"duration_percentage\"")
+				return errors.Wrap(err, "decode field \"dateUpdated\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StatsTimeItem")
+		return errors.Wrap(err, "decode UserGet")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00100101,
+		0b00111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4823,8 +11262,8 @@ func (s *StatsTimeItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStatsTimeItem) {
-					name = jsonFieldsNameOfStatsTimeItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUserGet) {
+					name = jsonFieldsNameOfUserGet[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4845,401 +11284,259 @@ func (s *StatsTimeItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StatsTimeItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsTimeItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes StatsUTMCampaigns as json.
-func (s StatsUTMCampaigns) Encode(e *jx.Encoder) {
-	unwrapped := []StatsUTMCampaignsItem(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
-}
-
-// Decode decodes StatsUTMCampaigns from json.
-func (s *StatsUTMCampaigns) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode StatsUTMCampaigns to nil")
-	}
-	var unwrapped []StatsUTMCampaignsItem
-	if err := func() error {
-		unwrapped = make([]StatsUTMCampaignsItem, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem StatsUTMCampaignsItem
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = StatsUTMCampaigns(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s StatsUTMCampaigns) MarshalJSON() ([]byte, error) {
+func (s *UserGet) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsUTMCampaigns) UnmarshalJSON(data []byte) error {
+func (s *UserGet) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StatsUTMCampaignsItem) Encode(e *jx.Encoder) {
+func (s *UserPatch) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *StatsUTMCampaignsItem) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("campaign")
-		e.Str(s.Campaign)
-	}
-	{
-		e.FieldStart("visitors")
-		e.Int(s.Visitors)
-	}
+func (s *UserPatch) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("visitors_percentage")
-		e.Float32(s.VisitorsPercentage)
+		if s.Username.Set {
+			e.FieldStart("username")
+			s.Username.Encode(e)
+		}
 	}
 	{
-		if s.BouncePercentage.Set {
-			e.FieldStart("bounce_percentage")
-			s.BouncePercentage.Encode(e)
+		if s.Password.Set {
+			e.FieldStart("password")
+			s.Password.Encode(e)
 		}
 	}
 	{
-		if s.Duration.Set {
-			e.FieldStart("duration")
-			s.Duration.Encode(e)
+		if s.Settings.Set {
+			e.FieldStart("settings")
+			s.Settings.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfStatsUTMCampaignsItem = [5]string{
-	0: "campaign",
-	1: "visitors",
-	2: "visitors_percentage",
-	3: "bounce_percentage",
-	4: "duration",
+var jsonFieldsNameOfUserPatch = [3]string{
+	0: "username",
+	1: "password",
+	2: "settings",
 }
 
-// Decode decodes StatsUTMCampaignsItem from json.
-func (s *StatsUTMCampaignsItem) Decode(d *jx.Decoder) error {
+// Decode decodes UserPatch from json.
+func (s *UserPatch) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsUTMCampaignsItem to nil")
+		return errors.New("invalid: unable to decode UserPatch to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "campaign":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.Campaign = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"campaign\"")
-			}
-		case "visitors":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int()
-				s.Visitors = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"visitors\"")
-			}
-		case "visitors_percentage":
-			requiredBitSet[0] |= 1 << 2
+		case "username":
 			if err := func() error {
-				v, err := d.Float32()
-				s.VisitorsPercentage = float32(v)
-				if err != nil {
+				s.Username.Reset()
+				if err := s.Username.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"visitors_percentage\"")
+				return errors.Wrap(err, "decode field \"username\"")
 			}
-		case "bounce_percentage":
+		case "password":
 			if err := func() error {
-				s.BouncePercentage.Reset()
-				if err := s.BouncePercentage.Decode(d); err != nil {
+				s.Password.Reset()
+				if err := s.Password.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+				return errors.Wrap(err, "decode field \"password\"")
 			}
-		case "duration":
+		case "settings":
 			if err := func() error {
-				s.Duration.Reset()
-				if err := s.Duration.Decode(d); err != nil {
+				s.Settings.Reset()
+				if err := s.Settings.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"duration\"")
+				return errors.Wrap(err, "decode field \"settings\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StatsUTMCampaignsItem")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfStatsUTMCampaignsItem) {
-					name = jsonFieldsNameOfStatsUTMCampaignsItem[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return errors.Wrap(err, "decode UserPatch")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StatsUTMCampaignsItem) MarshalJSON() ([]byte, error) {
+func (s *UserPatch) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsUTMCampaignsItem) UnmarshalJSON(data []byte) error {
+func (s *UserPatch) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
-}
-
-// Encode encodes StatsUTMMediums as json.
-func (s StatsUTMMediums) Encode(e *jx.Encoder) {
-	unwrapped := []StatsUTMMediumsItem(s)
+}
 
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
+// Encode encodes UserRole as json.
+func (s UserRole) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes StatsUTMMediums from json.
-func (s *StatsUTMMediums) Decode(d *jx.Decoder) error {
+// Decode decodes UserRole from json.
+func (s *UserRole) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsUTMMediums to nil")
+		return errors.New("invalid: unable to decode UserRole to nil")
 	}
-	var unwrapped []StatsUTMMediumsItem
-	if err := func() error {
-		unwrapped = make([]StatsUTMMediumsItem, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem StatsUTMMediumsItem
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = StatsUTMMediums(unwrapped)
+	// Try to use constant string.
+	switch UserRole(v) {
+	case UserRoleOwner:
+		*s = UserRoleOwner
+	case UserRoleAdmin:
+		*s = UserRoleAdmin
+	case UserRoleViewer:
+		*s = UserRoleViewer
+	default:
+		*s = UserRole(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s StatsUTMMediums) MarshalJSON() ([]byte, error) {
+func (s UserRole) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsUTMMediums) UnmarshalJSON(data []byte) error {
+func (s *UserRole) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StatsUTMMediumsItem) Encode(e *jx.Encoder) {
+func (s *UserSession) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *StatsUTMMediumsItem) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("medium")
-		e.Str(s.Medium)
-	}
+func (s *UserSession) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("visitors")
-		e.Int(s.Visitors)
+		e.FieldStart("userAgent")
+		e.Str(s.UserAgent)
 	}
 	{
-		e.FieldStart("visitors_percentage")
-		e.Float32(s.VisitorsPercentage)
+		e.FieldStart("current")
+		e.Bool(s.Current)
 	}
 	{
-		if s.BouncePercentage.Set {
-			e.FieldStart("bounce_percentage")
-			s.BouncePercentage.Encode(e)
-		}
+		e.FieldStart("dateCreated")
+		e.Int64(s.DateCreated)
 	}
 	{
-		if s.Duration.Set {
-			e.FieldStart("duration")
-			s.Duration.Encode(e)
-		}
+		e.FieldStart("dateExpires")
+		e.Int64(s.DateExpires)
 	}
 }
 
-var jsonFieldsNameOfStatsUTMMediumsItem = [5]string{
-	0: "medium",
-	1: "visitors",
-	2: "visitors_percentage",
-	3: "bounce_percentage",
-	4: "duration",
+var jsonFieldsNameOfUserSession = [4]string{
+	0: "userAgent",
+	1: "current",
+	2: "dateCreated",
+	3: "dateExpires",
 }
 
-// Decode decodes StatsUTMMediumsItem from json.
-func (s *StatsUTMMediumsItem) Decode(d *jx.Decoder) error {
+// Decode decodes UserSession from json.
+func (s *UserSession) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsUTMMediumsItem to nil")
+		return errors.New("invalid: unable to decode UserSession to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "medium":
+		case "userAgent":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.Medium = string(v)
+				s.UserAgent = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"medium\"")
+				return errors.Wrap(err, "decode field \"userAgent\"")
 			}
-		case "visitors":
+		case "current":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int()
-				s.Visitors = int(v)
+				v, err := d.Bool()
+				s.Current = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"visitors\"")
+				return errors.Wrap(err, "decode field \"current\"")
 			}
-		case "visitors_percentage":
+		case "dateCreated":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Float32()
-				s.VisitorsPercentage = float32(v)
+				v, err := d.Int64()
+				s.DateCreated = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"visitors_percentage\"")
-			}
-		case "bounce_percentage":
-			if err := func() error {
-				s.BouncePercentage.Reset()
-				if err := s.BouncePercentage.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+				return errors.Wrap(err, "decode field \"dateCreated\"")
 			}
-		case "duration":
+		case "dateExpires":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Duration.Reset()
-				if err := s.Duration.Decode(d); err != nil {
+				v, err := d.Int64()
+				s.DateExpires = int64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"duration\"")
+				return errors.Wrap(err, "decode field \"dateExpires\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StatsUTMMediumsItem")
+		return errors.Wrap(err, "decode UserSession")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5251,8 +11548,8 @@ func (s *StatsUTMMediumsItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStatsUTMMediumsItem) {
-					name = jsonFieldsNameOfStatsUTMMediumsItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUserSession) {
+					name = jsonFieldsNameOfUserSession[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5273,187 +11570,214 @@ func (s *StatsUTMMediumsItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StatsUTMMediumsItem) MarshalJSON() ([]byte, error) {
+func (s *UserSession) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsUTMMediumsItem) UnmarshalJSON(data []byte) error {
+func (s *UserSession) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes StatsUTMSources as json.
-func (s StatsUTMSources) Encode(e *jx.Encoder) {
-	unwrapped := []StatsUTMSourcesItem(s)
+// Encode implements json.Marshaler.
+func (s *UserSettings) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
+// encodeFields encodes fields.
+func (s *UserSettings) encodeFields(e *jx.Encoder) {
+	{
+		if s.Language.Set {
+			e.FieldStart("language")
+			s.Language.Encode(e)
+		}
 	}
-	e.ArrEnd()
 }
 
-// Decode decodes StatsUTMSources from json.
-func (s *StatsUTMSources) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfUserSettings = [1]string{
+	0: "language",
+}
+
+// Decode decodes UserSettings from json.
+func (s *UserSettings) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsUTMSources to nil")
+		return errors.New("invalid: unable to decode UserSettings to nil")
 	}
-	var unwrapped []StatsUTMSourcesItem
-	if err := func() error {
-		unwrapped = make([]StatsUTMSourcesItem, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem StatsUTMSourcesItem
-			if err := elem.Decode(d); err != nil {
-				return err
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "language":
+			if err := func() error {
+				s.Language.Reset()
+				if err := s.Language.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"language\"")
 			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode UserSettings")
 	}
-	*s = StatsUTMSources(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s StatsUTMSources) MarshalJSON() ([]byte, error) {
+func (s *UserSettings) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsUTMSources) UnmarshalJSON(data []byte) error {
+func (s *UserSettings) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UserSettingsLanguage as json.
+func (s UserSettingsLanguage) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes UserSettingsLanguage from json.
+func (s *UserSettingsLanguage) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UserSettingsLanguage to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch UserSettingsLanguage(v) {
+	case UserSettingsLanguageEn:
+		*s = UserSettingsLanguageEn
+	default:
+		*s = UserSettingsLanguage(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s UserSettingsLanguage) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UserSettingsLanguage) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *StatsUTMSourcesItem) Encode(e *jx.Encoder) {
+func (s *UserUsageGet) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *StatsUTMSourcesItem) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("source")
-		e.Str(s.Source)
-	}
+func (s *UserUsageGet) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("visitors")
-		e.Int(s.Visitors)
+		e.FieldStart("cpu")
+		s.CPU.Encode(e)
 	}
 	{
-		e.FieldStart("visitors_percentage")
-		e.Float32(s.VisitorsPercentage)
+		e.FieldStart("memory")
+		s.Memory.Encode(e)
 	}
 	{
-		if s.BouncePercentage.Set {
-			e.FieldStart("bounce_percentage")
-			s.BouncePercentage.Encode(e)
-		}
+		e.FieldStart("disk")
+		s.Disk.Encode(e)
 	}
 	{
-		if s.Duration.Set {
-			e.FieldStart("duration")
-			s.Duration.Encode(e)
-		}
+		e.FieldStart("retention")
+		s.Retention.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfStatsUTMSourcesItem = [5]string{
-	0: "source",
-	1: "visitors",
-	2: "visitors_percentage",
-	3: "bounce_percentage",
-	4: "duration",
+var jsonFieldsNameOfUserUsageGet = [4]string{
+	0: "cpu",
+	1: "memory",
+	2: "disk",
+	3: "retention",
 }
 
-// Decode decodes StatsUTMSourcesItem from json.
-func (s *StatsUTMSourcesItem) Decode(d *jx.Decoder) error {
+// Decode decodes UserUsageGet from json.
+func (s *UserUsageGet) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StatsUTMSourcesItem to nil")
+		return errors.New("invalid: unable to decode UserUsageGet to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "source":
+		case "cpu":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Source = string(v)
-				if err != nil {
+				if err := s.CPU.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"source\"")
+				return errors.Wrap(err, "decode field \"cpu\"")
 			}
-		case "visitors":
+		case "memory":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int()
-				s.Visitors = int(v)
-				if err != nil {
+				if err := s.Memory.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"visitors\"")
+				return errors.Wrap(err, "decode field \"memory\"")
 			}
-		case "visitors_percentage":
+		case "disk":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Float32()
-				s.VisitorsPercentage = float32(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"visitors_percentage\"")
-			}
-		case "bounce_percentage":
-			if err := func() error {
-				s.BouncePercentage.Reset()
-				if err := s.BouncePercentage.Decode(d); err != nil {
+				if err := s.Disk.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bounce_percentage\"")
+				return errors.Wrap(err, "decode field \"disk\"")
 			}
-		case "duration":
+		case "retention":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Duration.Reset()
-				if err := s.Duration.Decode(d); err != nil {
+				if err := s.Retention.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"duration\"")
+				return errors.Wrap(err, "decode field \"retention\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StatsUTMSourcesItem")
+		return errors.Wrap(err, "decode UserUsageGet")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5465,8 +11789,8 @@ func (s *StatsUTMSourcesItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStatsUTMSourcesItem) {
-					name = jsonFieldsNameOfStatsUTMSourcesItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUserUsageGet) {
+					name = jsonFieldsNameOfUserUsageGet[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5487,247 +11811,103 @@ func (s *StatsUTMSourcesItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StatsUTMSourcesItem) MarshalJSON() ([]byte, error) {
+func (s *UserUsageGet) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StatsUTMSourcesItem) UnmarshalJSON(data []byte) error {
+func (s *UserUsageGet) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TenantSettings) Encode(e *jx.Encoder) {
+func (s *UserUsageGetCPU) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TenantSettings) encodeFields(e *jx.Encoder) {
-	{
-		if s.ScriptType != nil {
-			e.FieldStart("script_type")
-			e.ArrStart()
-			for _, elem := range s.ScriptType {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
+func (s *UserUsageGetCPU) encodeFields(e *jx.Encoder) {
 	{
-		if s.BlockAbusiveIPs.Set {
-			e.FieldStart("blockAbusiveIPs")
-			s.BlockAbusiveIPs.Encode(e)
-		}
+		e.FieldStart("usage")
+		e.Float32(s.Usage)
 	}
 	{
-		if s.BlockTorExitNodes.Set {
-			e.FieldStart("blockTorExitNodes")
-			s.BlockTorExitNodes.Encode(e)
-		}
+		e.FieldStart("cores")
+		e.Int(s.Cores)
 	}
 	{
-		if s.BlockedIPs != nil {
-			e.FieldStart("blockedIPs")
-			e.ArrStart()
-			for _, elem := range s.BlockedIPs {
-				json.EncodeIPv4(e, elem)
-			}
-			e.ArrEnd()
-		}
+		e.FieldStart("threads")
+		e.Int(s.Threads)
 	}
 }
 
-var jsonFieldsNameOfTenantSettings = [4]string{
-	0: "script_type",
-	1: "blockAbusiveIPs",
-	2: "blockTorExitNodes",
-	3: "blockedIPs",
+var jsonFieldsNameOfUserUsageGetCPU = [3]string{
+	0: "usage",
+	1: "cores",
+	2: "threads",
 }
 
-// Decode decodes TenantSettings from json.
-func (s *TenantSettings) Decode(d *jx.Decoder) error {
+// Decode decodes UserUsageGetCPU from json.
+func (s *UserUsageGetCPU) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TenantSettings to nil")
+		return errors.New("invalid: unable to decode UserUsageGetCPU to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "script_type":
-			if err := func() error {
-				s.ScriptType = make([]TenantSettingsScriptTypeItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem TenantSettingsScriptTypeItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.ScriptType = append(s.ScriptType, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"script_type\"")
-			}
-		case "blockAbusiveIPs":
-			if err := func() error {
-				s.BlockAbusiveIPs.Reset()
-				if err := s.BlockAbusiveIPs.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"blockAbusiveIPs\"")
-			}
-		case "blockTorExitNodes":
+		case "usage":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.BlockTorExitNodes.Reset()
-				if err := s.BlockTorExitNodes.Decode(d); err != nil {
+				v, err := d.Float32()
+				s.Usage = float32(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"blockTorExitNodes\"")
+				return errors.Wrap(err, "decode field \"usage\"")
 			}
-		case "blockedIPs":
+		case "cores":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.BlockedIPs = make([]netip.Addr, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem netip.Addr
-					v, err := json.DecodeIPv4(d)
-					elem = v
-					if err != nil {
-						return err
-					}
-					s.BlockedIPs = append(s.BlockedIPs, elem)
-					return nil
-				}); err != nil {
+				v, err := d.Int()
+				s.Cores = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"blockedIPs\"")
+				return errors.Wrap(err, "decode field \"cores\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode TenantSettings")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *TenantSettings) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TenantSettings) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes TenantSettingsScriptTypeItem as json.
-func (s TenantSettingsScriptTypeItem) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes TenantSettingsScriptTypeItem from json.
-func (s *TenantSettingsScriptTypeItem) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode TenantSettingsScriptTypeItem to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch TenantSettingsScriptTypeItem(v) {
-	case TenantSettingsScriptTypeItemDefault:
-		*s = TenantSettingsScriptTypeItemDefault
-	case TenantSettingsScriptTypeItemClickEvents:
-		*s = TenantSettingsScriptTypeItemClickEvents
-	case TenantSettingsScriptTypeItemPageEvents:
-		*s = TenantSettingsScriptTypeItemPageEvents
-	default:
-		*s = TenantSettingsScriptTypeItem(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s TenantSettingsScriptTypeItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TenantSettingsScriptTypeItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *UnauthorisedError) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *UnauthorisedError) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("error")
-		s.Error.Encode(e)
-	}
-}
-
-var jsonFieldsNameOfUnauthorisedError = [1]string{
-	0: "error",
-}
-
-// Decode decodes UnauthorisedError from json.
-func (s *UnauthorisedError) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode UnauthorisedError to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "error":
-			requiredBitSet[0] |= 1 << 0
+		case "threads":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				if err := s.Error.Decode(d); err != nil {
+				v, err := d.Int()
+				s.Threads = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"error\"")
+				return errors.Wrap(err, "decode field \"threads\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UnauthorisedError")
+		return errors.Wrap(err, "decode UserUsageGetCPU")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5739,8 +11919,8 @@ func (s *UnauthorisedError) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUnauthorisedError) {
-					name = jsonFieldsNameOfUnauthorisedError[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUserUsageGetCPU) {
+					name = jsonFieldsNameOfUserUsageGetCPU[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5761,82 +11941,81 @@ func (s *UnauthorisedError) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UnauthorisedError) MarshalJSON() ([]byte, error) {
+func (s *UserUsageGetCPU) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UnauthorisedError) UnmarshalJSON(data []byte) error {
+func (s *UserUsageGetCPU) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UnauthorisedErrorError) Encode(e *jx.Encoder) {
+func (s *UserUsageGetDisk) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UnauthorisedErrorError) encodeFields(e *jx.Encoder) {
+func (s *UserUsageGetDisk) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("code")
-		e.Int32(s.Code)
+		e.FieldStart("used")
+		e.Int64(s.Used)
 	}
 	{
-		e.FieldStart("message")
-		e.Str(s.Message)
+		e.FieldStart("total")
+		e.Int64(s.Total)
 	}
 }
 
-var jsonFieldsNameOfUnauthorisedErrorError = [2]string{
-	0: "code",
-	1: "message",
+var jsonFieldsNameOfUserUsageGetDisk = [2]string{
+	0: "used",
+	1: "total",
 }
 
-// Decode decodes UnauthorisedErrorError from json.
-func (s *UnauthorisedErrorError) Decode(d *jx.Decoder) error {
+// Decode decodes UserUsageGetDisk from json.
+func (s *UserUsageGetDisk) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UnauthorisedErrorError to nil")
+		return errors.New("invalid: unable to decode UserUsageGetDisk to nil")
 	}
 	var requiredBitSet [1]uint8
-	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "code":
+		case "used":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int32()
-				s.Code = int32(v)
+				v, err := d.Int64()
+				s.Used = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"code\"")
+				return errors.Wrap(err, "decode field \"used\"")
 			}
-		case "message":
+		case "total":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Message = string(v)
+				v, err := d.Int64()
+				s.Total = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"total\"")
 			}
 		default:
-			return errors.Errorf("unexpected field %q", k)
+			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UnauthorisedErrorError")
+		return errors.Wrap(err, "decode UserUsageGetDisk")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -5853,8 +12032,8 @@ func (s *UnauthorisedErrorError) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUnauthorisedErrorError) {
-					name = jsonFieldsNameOfUnauthorisedErrorError[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUserUsageGetDisk) {
+					name = jsonFieldsNameOfUserUsageGetDisk[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5875,118 +12054,86 @@ func (s *UnauthorisedErrorError) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UnauthorisedErrorError) MarshalJSON() ([]byte, error) {
+func (s *UserUsageGetDisk) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UnauthorisedErrorError) UnmarshalJSON(data []byte) error {
+func (s *UserUsageGetDisk) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UserGet) Encode(e *jx.Encoder) {
+func (s *UserUsageGetMemory) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UserGet) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("username")
-		e.Str(s.Username)
-	}
-	{
-		e.FieldStart("settings")
-		s.Settings.Encode(e)
-	}
+func (s *UserUsageGetMemory) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("dateCreated")
-		e.Int64(s.DateCreated)
+		e.FieldStart("used")
+		e.Int64(s.Used)
 	}
 	{
-		e.FieldStart("dateUpdated")
-		e.Int64(s.DateUpdated)
+		e.FieldStart("total")
+		e.Int64(s.Total)
 	}
 }
 
-var jsonFieldsNameOfUserGet = [4]string{
-	0: "username",
-	1: "settings",
-	2: "dateCreated",
-	3: "dateUpdated",
+var jsonFieldsNameOfUserUsageGetMemory = [2]string{
+	0: "used",
+	1: "total",
 }
 
-// Decode decodes UserGet from json.
-func (s *UserGet) Decode(d *jx.Decoder) error {
+// Decode decodes UserUsageGetMemory from json.
+func (s *UserUsageGetMemory) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UserGet to nil")
+		return errors.New("invalid: unable to decode UserUsageGetMemory to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "username":
+		case "used":
 			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.Username = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"username\"")
-			}
-		case "settings":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				if err := s.Settings.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"settings\"")
-			}
-		case "dateCreated":
-			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
 				v, err := d.Int64()
-				s.DateCreated = int64(v)
+				s.Used = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"dateCreated\"")
+				return errors.Wrap(err, "decode field \"used\"")
 			}
-		case "dateUpdated":
-			requiredBitSet[0] |= 1 << 3
+		case "total":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Int64()
-				s.DateUpdated = int64(v)
+				s.Total = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"dateUpdated\"")
+				return errors.Wrap(err, "decode field \"total\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UserGet")
+		return errors.Wrap(err, "decode UserUsageGetMemory")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00001111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5998,8 +12145,8 @@ func (s *UserGet) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUserGet) {
-					name = jsonFieldsNameOfUserGet[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUserUsageGetMemory) {
+					name = jsonFieldsNameOfUserUsageGetMemory[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6020,296 +12167,342 @@ func (s *UserGet) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UserGet) MarshalJSON() ([]byte, error) {
+func (s *UserUsageGetMemory) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UserGet) UnmarshalJSON(data []byte) error {
+func (s *UserUsageGetMemory) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UserPatch) Encode(e *jx.Encoder) {
+func (s *UserUsageGetRetention) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UserPatch) encodeFields(e *jx.Encoder) {
-	{
-		if s.Username.Set {
-			e.FieldStart("username")
-			s.Username.Encode(e)
-		}
-	}
+func (s *UserUsageGetRetention) encodeFields(e *jx.Encoder) {
 	{
-		if s.Password.Set {
-			e.FieldStart("password")
-			s.Password.Encode(e)
-		}
+		e.FieldStart("days")
+		e.Int(s.Days)
 	}
 	{
-		if s.Settings.Set {
-			e.FieldStart("settings")
-			s.Settings.Encode(e)
+		if s.Oldest.Set {
+			e.FieldStart("oldest")
+			s.Oldest.Encode(e, json.EncodeDateTime)
 		}
 	}
 }
 
-var jsonFieldsNameOfUserPatch = [3]string{
-	0: "username",
-	1: "password",
-	2: "settings",
+var jsonFieldsNameOfUserUsageGetRetention = [2]string{
+	0: "days",
+	1: "oldest",
 }
 
-// Decode decodes UserPatch from json.
-func (s *UserPatch) Decode(d *jx.Decoder) error {
+// Decode decodes UserUsageGetRetention from json.
+func (s *UserUsageGetRetention) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UserPatch to nil")
+		return errors.New("invalid: unable to decode UserUsageGetRetention to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "username":
-			if err := func() error {
-				s.Username.Reset()
-				if err := s.Username.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"username\"")
-			}
-		case "password":
+		case "days":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Password.Reset()
-				if err := s.Password.Decode(d); err != nil {
+				v, err := d.Int()
+				s.Days = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
+				return errors.Wrap(err, "decode field \"days\"")
 			}
-		case "settings":
+		case "oldest":
 			if err := func() error {
-				s.Settings.Reset()
-				if err := s.Settings.Decode(d); err != nil {
+				s.Oldest.Reset()
+				if err := s.Oldest.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"settings\"")
+				return errors.Wrap(err, "decode field \"oldest\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UserPatch")
+		return errors.Wrap(err, "decode UserUsageGetRetention")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUserUsageGetRetention) {
+					name = jsonFieldsNameOfUserUsageGetRetention[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UserPatch) MarshalJSON() ([]byte, error) {
+func (s *UserUsageGetRetention) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UserPatch) UnmarshalJSON(data []byte) error {
+func (s *UserUsageGetRetention) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UserSettings) Encode(e *jx.Encoder) {
+func (s *WebhookCreate) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UserSettings) encodeFields(e *jx.Encoder) {
+func (s *WebhookCreate) encodeFields(e *jx.Encoder) {
 	{
-		if s.Language.Set {
-			e.FieldStart("language")
-			s.Language.Encode(e)
+		e.FieldStart("url")
+		json.EncodeURI(e, s.URL)
+	}
+	{
+		e.FieldStart("trigger")
+		s.Trigger.Encode(e)
+	}
+	{
+		if s.EventName.Set {
+			e.FieldStart("eventName")
+			s.EventName.Encode(e)
+		}
+	}
+	{
+		if s.Threshold.Set {
+			e.FieldStart("threshold")
+			s.Threshold.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfUserSettings = [1]string{
-	0: "language",
+var jsonFieldsNameOfWebhookCreate = [4]string{
+	0: "url",
+	1: "trigger",
+	2: "eventName",
+	3: "threshold",
 }
 
-// Decode decodes UserSettings from json.
-func (s *UserSettings) Decode(d *jx.Decoder) error {
+// Decode decodes WebhookCreate from json.
+func (s *WebhookCreate) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UserSettings to nil")
+		return errors.New("invalid: unable to decode WebhookCreate to nil")
 	}
-	s.setDefaults()
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "language":
+		case "url":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeURI(d)
+				s.URL = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"url\"")
+			}
+		case "trigger":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Trigger.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"trigger\"")
+			}
+		case "eventName":
 			if err := func() error {
-				s.Language.Reset()
-				if err := s.Language.Decode(d); err != nil {
+				s.EventName.Reset()
+				if err := s.EventName.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"language\"")
+				return errors.Wrap(err, "decode field \"eventName\"")
+			}
+		case "threshold":
+			if err := func() error {
+				s.Threshold.Reset()
+				if err := s.Threshold.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"threshold\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UserSettings")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *UserSettings) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UserSettings) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes UserSettingsLanguage as json.
-func (s UserSettingsLanguage) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes UserSettingsLanguage from json.
-func (s *UserSettingsLanguage) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode UserSettingsLanguage to nil")
+		return errors.Wrap(err, "decode WebhookCreate")
 	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfWebhookCreate) {
+					name = jsonFieldsNameOfWebhookCreate[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	// Try to use constant string.
-	switch UserSettingsLanguage(v) {
-	case UserSettingsLanguageEn:
-		*s = UserSettingsLanguageEn
-	default:
-		*s = UserSettingsLanguage(v)
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s UserSettingsLanguage) MarshalJSON() ([]byte, error) {
+func (s *WebhookCreate) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UserSettingsLanguage) UnmarshalJSON(data []byte) error {
+func (s *WebhookCreate) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UserUsageGet) Encode(e *jx.Encoder) {
+func (s *WebhookCreated) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UserUsageGet) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("cpu")
-		s.CPU.Encode(e)
-	}
+func (s *WebhookCreated) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("memory")
-		s.Memory.Encode(e)
+		e.FieldStart("secret")
+		e.Str(s.Secret)
 	}
 	{
-		e.FieldStart("disk")
-		s.Disk.Encode(e)
+		e.FieldStart("webhook")
+		s.Webhook.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfUserUsageGet = [3]string{
-	0: "cpu",
-	1: "memory",
-	2: "disk",
+var jsonFieldsNameOfWebhookCreated = [2]string{
+	0: "secret",
+	1: "webhook",
 }
 
-// Decode decodes UserUsageGet from json.
-func (s *UserUsageGet) Decode(d *jx.Decoder) error {
+// Decode decodes WebhookCreated from json.
+func (s *WebhookCreated) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UserUsageGet to nil")
+		return errors.New("invalid: unable to decode WebhookCreated to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "cpu":
+		case "secret":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.CPU.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Secret = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"cpu\"")
+				return errors.Wrap(err, "decode field \"secret\"")
 			}
-		case "memory":
+		case "webhook":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.Memory.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"memory\"")
-			}
-		case "disk":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				if err := s.Disk.Decode(d); err != nil {
+				if err := s.Webhook.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"disk\"")
+				return errors.Wrap(err, "decode field \"webhook\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UserUsageGet")
+		return errors.Wrap(err, "decode WebhookCreated")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6321,8 +12514,8 @@ func (s *UserUsageGet) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUserUsageGet) {
-					name = jsonFieldsNameOfUserUsageGet[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfWebhookCreated) {
+					name = jsonFieldsNameOfWebhookCreated[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6343,103 +12536,186 @@ func (s *UserUsageGet) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UserUsageGet) MarshalJSON() ([]byte, error) {
+func (s *WebhookCreated) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UserUsageGet) UnmarshalJSON(data []byte) error {
+func (s *WebhookCreated) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UserUsageGetCPU) Encode(e *jx.Encoder) {
+func (s *WebhookDelivery) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UserUsageGetCPU) encodeFields(e *jx.Encoder) {
+func (s *WebhookDelivery) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("usage")
-		e.Float32(s.Usage)
+		e.FieldStart("id")
+		e.Str(s.ID)
 	}
 	{
-		e.FieldStart("cores")
-		e.Int(s.Cores)
+		e.FieldStart("trigger")
+		s.Trigger.Encode(e)
 	}
 	{
-		e.FieldStart("threads")
-		e.Int(s.Threads)
+		e.FieldStart("payload")
+		e.Str(s.Payload)
+	}
+	{
+		if s.StatusCode.Set {
+			e.FieldStart("statusCode")
+			s.StatusCode.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("attempts")
+		e.Int(s.Attempts)
+	}
+	{
+		if s.Error.Set {
+			e.FieldStart("error")
+			s.Error.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("completedAt")
+		json.EncodeDateTime(e, s.CompletedAt)
 	}
 }
 
-var jsonFieldsNameOfUserUsageGetCPU = [3]string{
-	0: "usage",
-	1: "cores",
-	2: "threads",
+var jsonFieldsNameOfWebhookDelivery = [8]string{
+	0: "id",
+	1: "trigger",
+	2: "payload",
+	3: "statusCode",
+	4: "attempts",
+	5: "error",
+	6: "createdAt",
+	7: "completedAt",
 }
 
-// Decode decodes UserUsageGetCPU from json.
-func (s *UserUsageGetCPU) Decode(d *jx.Decoder) error {
+// Decode decodes WebhookDelivery from json.
+func (s *WebhookDelivery) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UserUsageGetCPU to nil")
+		return errors.New("invalid: unable to decode WebhookDelivery to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "usage":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Float32()
-				s.Usage = float32(v)
+				v, err := d.Str()
+				s.ID = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"usage\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "cores":
+		case "trigger":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int()
-				s.Cores = int(v)
-				if err != nil {
+				if err := s.Trigger.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"cores\"")
+				return errors.Wrap(err, "decode field \"trigger\"")
 			}
-		case "threads":
+		case "payload":
 			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Payload = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"payload\"")
+			}
+		case "statusCode":
+			if err := func() error {
+				s.StatusCode.Reset()
+				if err := s.StatusCode.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"statusCode\"")
+			}
+		case "attempts":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
 				v, err := d.Int()
-				s.Threads = int(v)
+				s.Attempts = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"threads\"")
+				return errors.Wrap(err, "decode field \"attempts\"")
+			}
+		case "error":
+			if err := func() error {
+				s.Error.Reset()
+				if err := s.Error.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error\"")
+			}
+		case "createdAt":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
+			}
+		case "completedAt":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CompletedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"completedAt\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UserUsageGetCPU")
+		return errors.Wrap(err, "decode WebhookDelivery")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b11010111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6451,8 +12727,8 @@ func (s *UserUsageGetCPU) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUserUsageGetCPU) {
-					name = jsonFieldsNameOfUserUsageGetCPU[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfWebhookDelivery) {
+					name = jsonFieldsNameOfWebhookDelivery[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6473,86 +12749,186 @@ func (s *UserUsageGetCPU) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UserUsageGetCPU) MarshalJSON() ([]byte, error) {
+func (s *WebhookDelivery) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UserUsageGetCPU) UnmarshalJSON(data []byte) error {
+func (s *WebhookDelivery) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UserUsageGetDisk) Encode(e *jx.Encoder) {
+func (s *WebhookGet) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UserUsageGetDisk) encodeFields(e *jx.Encoder) {
+func (s *WebhookGet) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("used")
-		e.Int64(s.Used)
+		e.FieldStart("id")
+		e.Str(s.ID)
 	}
 	{
-		e.FieldStart("total")
-		e.Int64(s.Total)
+		e.FieldStart("hostname")
+		e.Str(s.Hostname)
+	}
+	{
+		e.FieldStart("url")
+		json.EncodeURI(e, s.URL)
+	}
+	{
+		e.FieldStart("trigger")
+		s.Trigger.Encode(e)
+	}
+	{
+		if s.EventName.Set {
+			e.FieldStart("eventName")
+			s.EventName.Encode(e)
+		}
+	}
+	{
+		if s.Threshold.Set {
+			e.FieldStart("threshold")
+			s.Threshold.Encode(e)
+		}
+	}
+	{
+		if s.LastTriggeredAt.Set {
+			e.FieldStart("lastTriggeredAt")
+			s.LastTriggeredAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
 	}
 }
 
-var jsonFieldsNameOfUserUsageGetDisk = [2]string{
-	0: "used",
-	1: "total",
+var jsonFieldsNameOfWebhookGet = [8]string{
+	0: "id",
+	1: "hostname",
+	2: "url",
+	3: "trigger",
+	4: "eventName",
+	5: "threshold",
+	6: "lastTriggeredAt",
+	7: "createdAt",
 }
 
-// Decode decodes UserUsageGetDisk from json.
-func (s *UserUsageGetDisk) Decode(d *jx.Decoder) error {
+// Decode decodes WebhookGet from json.
+func (s *WebhookGet) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UserUsageGetDisk to nil")
+		return errors.New("invalid: unable to decode WebhookGet to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "used":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.Used = int64(v)
+				v, err := d.Str()
+				s.ID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "hostname":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Hostname = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hostname\"")
+			}
+		case "url":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeURI(d)
+				s.URL = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"used\"")
+				return errors.Wrap(err, "decode field \"url\"")
 			}
-		case "total":
-			requiredBitSet[0] |= 1 << 1
+		case "trigger":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				v, err := d.Int64()
-				s.Total = int64(v)
+				if err := s.Trigger.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"trigger\"")
+			}
+		case "eventName":
+			if err := func() error {
+				s.EventName.Reset()
+				if err := s.EventName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"eventName\"")
+			}
+		case "threshold":
+			if err := func() error {
+				s.Threshold.Reset()
+				if err := s.Threshold.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"threshold\"")
+			}
+		case "lastTriggeredAt":
+			if err := func() error {
+				s.LastTriggeredAt.Reset()
+				if err := s.LastTriggeredAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"lastTriggeredAt\"")
+			}
+		case "createdAt":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"total\"")
+				return errors.Wrap(err, "decode field \"createdAt\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UserUsageGetDisk")
+		return errors.Wrap(err, "decode WebhookGet")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b10001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6564,8 +12940,8 @@ func (s *UserUsageGetDisk) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUserUsageGetDisk) {
-					name = jsonFieldsNameOfUserUsageGetDisk[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfWebhookGet) {
+					name = jsonFieldsNameOfWebhookGet[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6586,86 +12962,113 @@ func (s *UserUsageGetDisk) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UserUsageGetDisk) MarshalJSON() ([]byte, error) {
+func (s *WebhookGet) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UserUsageGetDisk) UnmarshalJSON(data []byte) error {
+func (s *WebhookGet) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes WebhookTrigger as json.
+func (s WebhookTrigger) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes WebhookTrigger from json.
+func (s *WebhookTrigger) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode WebhookTrigger to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch WebhookTrigger(v) {
+	case WebhookTriggerTrafficSpike:
+		*s = WebhookTriggerTrafficSpike
+	case WebhookTriggerNewReferrer:
+		*s = WebhookTriggerNewReferrer
+	case WebhookTriggerCustomEvent:
+		*s = WebhookTriggerCustomEvent
+	case WebhookTriggerAnomaly:
+		*s = WebhookTriggerAnomaly
+	default:
+		*s = WebhookTrigger(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s WebhookTrigger) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *WebhookTrigger) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UserUsageGetMemory) Encode(e *jx.Encoder) {
+func (s *WebsiteCreate) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UserUsageGetMemory) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("used")
-		e.Int64(s.Used)
-	}
+func (s *WebsiteCreate) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("total")
-		e.Int64(s.Total)
+		e.FieldStart("hostname")
+		e.Str(s.Hostname)
 	}
 }
 
-var jsonFieldsNameOfUserUsageGetMemory = [2]string{
-	0: "used",
-	1: "total",
+var jsonFieldsNameOfWebsiteCreate = [1]string{
+	0: "hostname",
 }
 
-// Decode decodes UserUsageGetMemory from json.
-func (s *UserUsageGetMemory) Decode(d *jx.Decoder) error {
+// Decode decodes WebsiteCreate from json.
+func (s *WebsiteCreate) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UserUsageGetMemory to nil")
+		return errors.New("invalid: unable to decode WebsiteCreate to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "used":
+		case "hostname":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.Used = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"used\"")
-			}
-		case "total":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int64()
-				s.Total = int64(v)
+				v, err := d.Str()
+				s.Hostname = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"total\"")
+				return errors.Wrap(err, "decode field \"hostname\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UserUsageGetMemory")
+		return errors.Wrap(err, "decode WebsiteCreate")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6677,8 +13080,8 @@ func (s *UserUsageGetMemory) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUserUsageGetMemory) {
-					name = jsonFieldsNameOfUserUsageGetMemory[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfWebsiteCreate) {
+					name = jsonFieldsNameOfWebsiteCreate[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6699,41 +13102,61 @@ func (s *UserUsageGetMemory) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UserUsageGetMemory) MarshalJSON() ([]byte, error) {
+func (s *WebsiteCreate) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UserUsageGetMemory) UnmarshalJSON(data []byte) error {
+func (s *WebsiteCreate) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *WebsiteCreate) Encode(e *jx.Encoder) {
+func (s *WebsiteDeletion) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *WebsiteCreate) encodeFields(e *jx.Encoder) {
+func (s *WebsiteDeletion) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("hostname")
 		e.Str(s.Hostname)
 	}
+	{
+		e.FieldStart("views")
+		e.Int64(s.Views)
+	}
+	{
+		e.FieldStart("events")
+		e.Int64(s.Events)
+	}
+	{
+		e.FieldStart("deletedViews")
+		e.Int64(s.DeletedViews)
+	}
+	{
+		e.FieldStart("deletedEvents")
+		e.Int64(s.DeletedEvents)
+	}
 }
 
-var jsonFieldsNameOfWebsiteCreate = [1]string{
+var jsonFieldsNameOfWebsiteDeletion = [5]string{
 	0: "hostname",
+	1: "views",
+	2: "events",
+	3: "deletedViews",
+	4: "deletedEvents",
 }
 
-// Decode decodes WebsiteCreate from json.
-func (s *WebsiteCreate) Decode(d *jx.Decoder) error {
+// Decode decodes WebsiteDeletion from json.
+func (s *WebsiteDeletion) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode WebsiteCreate to nil")
+		return errors.New("invalid: unable to decode WebsiteDeletion to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -6751,17 +13174,65 @@ func (s *WebsiteCreate) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"hostname\"")
 			}
+		case "views":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.Views = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"views\"")
+			}
+		case "events":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int64()
+				s.Events = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"events\"")
+			}
+		case "deletedViews":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int64()
+				s.DeletedViews = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"deletedViews\"")
+			}
+		case "deletedEvents":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int64()
+				s.DeletedEvents = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"deletedEvents\"")
+			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode WebsiteCreate")
+		return errors.Wrap(err, "decode WebsiteDeletion")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00011111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6773,8 +13244,8 @@ func (s *WebsiteCreate) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfWebsiteCreate) {
-					name = jsonFieldsNameOfWebsiteCreate[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfWebsiteDeletion) {
+					name = jsonFieldsNameOfWebsiteDeletion[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6795,14 +13266,14 @@ func (s *WebsiteCreate) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *WebsiteCreate) MarshalJSON() ([]byte, error) {
+func (s *WebsiteDeletion) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *WebsiteCreate) UnmarshalJSON(data []byte) error {
+func (s *WebsiteDeletion) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -6826,11 +13297,29 @@ func (s *WebsiteGet) encodeFields(e *jx.Encoder) {
 			s.Summary.Encode(e)
 		}
 	}
+	{
+		if s.RetentionDays.Set {
+			e.FieldStart("retentionDays")
+			s.RetentionDays.Encode(e)
+		}
+	}
+	{
+		if s.Aliases != nil {
+			e.FieldStart("aliases")
+			e.ArrStart()
+			for _, elem := range s.Aliases {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
 }
 
-var jsonFieldsNameOfWebsiteGet = [2]string{
+var jsonFieldsNameOfWebsiteGet = [4]string{
 	0: "hostname",
 	1: "summary",
+	2: "retentionDays",
+	3: "aliases",
 }
 
 // Decode decodes WebsiteGet from json.
@@ -6864,6 +13353,35 @@ func (s *WebsiteGet) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"summary\"")
 			}
+		case "retentionDays":
+			if err := func() error {
+				s.RetentionDays.Reset()
+				if err := s.RetentionDays.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"retentionDays\"")
+			}
+		case "aliases":
+			if err := func() error {
+				s.Aliases = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Aliases = append(s.Aliases, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"aliases\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -7031,10 +13549,42 @@ func (s *WebsitePatch) encodeFields(e *jx.Encoder) {
 			s.Hostname.Encode(e)
 		}
 	}
+	{
+		if s.RetentionDays.Set {
+			e.FieldStart("retentionDays")
+			s.RetentionDays.Encode(e)
+		}
+	}
+	{
+		if s.Aliases != nil {
+			e.FieldStart("aliases")
+			e.ArrStart()
+			for _, elem := range s.Aliases {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Merge.Set {
+			e.FieldStart("merge")
+			s.Merge.Encode(e)
+		}
+	}
+	{
+		if s.MergeAccess.Set {
+			e.FieldStart("mergeAccess")
+			s.MergeAccess.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfWebsitePatch = [1]string{
+var jsonFieldsNameOfWebsitePatch = [5]string{
 	0: "hostname",
+	1: "retentionDays",
+	2: "aliases",
+	3: "merge",
+	4: "mergeAccess",
 }
 
 // Decode decodes WebsitePatch from json.
@@ -7042,6 +13592,7 @@ func (s *WebsitePatch) Decode(d *jx.Decoder) error {
 	if s == nil {
 		return errors.New("invalid: unable to decode WebsitePatch to nil")
 	}
+	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
@@ -7055,6 +13606,55 @@ func (s *WebsitePatch) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"hostname\"")
 			}
+		case "retentionDays":
+			if err := func() error {
+				s.RetentionDays.Reset()
+				if err := s.RetentionDays.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"retentionDays\"")
+			}
+		case "aliases":
+			if err := func() error {
+				s.Aliases = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Aliases = append(s.Aliases, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"aliases\"")
+			}
+		case "merge":
+			if err := func() error {
+				s.Merge.Reset()
+				if err := s.Merge.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"merge\"")
+			}
+		case "mergeAccess":
+			if err := func() error {
+				s.MergeAccess.Reset()
+				if err := s.MergeAccess.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mergeAccess\"")
+			}
 		default:
 			return d.Skip()
 		}
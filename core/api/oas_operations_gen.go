@@ -6,32 +6,70 @@ package api
 type OperationName = string
 
 const (
-	DeleteUserOperation             OperationName = "DeleteUser"
-	DeleteWebsitesIDOperation       OperationName = "DeleteWebsitesID"
-	GetEventPingOperation           OperationName = "GetEventPing"
-	GetTenantSettingsOperation      OperationName = "GetTenantSettings"
-	GetUserOperation                OperationName = "GetUser"
-	GetUserUsageOperation           OperationName = "GetUserUsage"
-	GetWebsiteIDBrowsersOperation   OperationName = "GetWebsiteIDBrowsers"
-	GetWebsiteIDCampaignsOperation  OperationName = "GetWebsiteIDCampaigns"
-	GetWebsiteIDCountryOperation    OperationName = "GetWebsiteIDCountry"
-	GetWebsiteIDDeviceOperation     OperationName = "GetWebsiteIDDevice"
-	GetWebsiteIDLanguageOperation   OperationName = "GetWebsiteIDLanguage"
-	GetWebsiteIDMediumsOperation    OperationName = "GetWebsiteIDMediums"
-	GetWebsiteIDOsOperation         OperationName = "GetWebsiteIDOs"
-	GetWebsiteIDPagesOperation      OperationName = "GetWebsiteIDPages"
-	GetWebsiteIDPropertiesOperation OperationName = "GetWebsiteIDProperties"
-	GetWebsiteIDReferrersOperation  OperationName = "GetWebsiteIDReferrers"
-	GetWebsiteIDSourcesOperation    OperationName = "GetWebsiteIDSources"
-	GetWebsiteIDSummaryOperation    OperationName = "GetWebsiteIDSummary"
-	GetWebsiteIDTimeOperation       OperationName = "GetWebsiteIDTime"
-	GetWebsitesOperation            OperationName = "GetWebsites"
-	GetWebsitesIDOperation          OperationName = "GetWebsitesID"
-	PatchTenantSettingsOperation    OperationName = "PatchTenantSettings"
-	PatchUserOperation              OperationName = "PatchUser"
-	PatchWebsitesIDOperation        OperationName = "PatchWebsitesID"
-	PostAuthLoginOperation          OperationName = "PostAuthLogin"
-	PostAuthLogoutOperation         OperationName = "PostAuthLogout"
-	PostEventHitOperation           OperationName = "PostEventHit"
-	PostWebsitesOperation           OperationName = "PostWebsites"
+	DeleteAPIKeysIDOperation                   OperationName = "DeleteAPIKeysID"
+	DeleteAuthLockoutsOperation                OperationName = "DeleteAuthLockouts"
+	DeleteReportsIDOperation                   OperationName = "DeleteReportsID"
+	DeleteUserOperation                        OperationName = "DeleteUser"
+	DeleteUserSessionsOperation                OperationName = "DeleteUserSessions"
+	DeleteUsersIDOperation                     OperationName = "DeleteUsersID"
+	DeleteWebsitesIDOperation                  OperationName = "DeleteWebsitesID"
+	DeleteWebsitesIDGoalsIDOperation           OperationName = "DeleteWebsitesIDGoalsID"
+	DeleteWebsitesIDWebhooksIDOperation        OperationName = "DeleteWebsitesIDWebhooksID"
+	GetAPIKeysOperation                        OperationName = "GetAPIKeys"
+	GetAuditOperation                          OperationName = "GetAudit"
+	GetAuthLockoutsOperation                   OperationName = "GetAuthLockouts"
+	GetAuthMethodsOperation                    OperationName = "GetAuthMethods"
+	GetAuthOidcCallbackOperation               OperationName = "GetAuthOidcCallback"
+	GetAuthOidcLoginOperation                  OperationName = "GetAuthOidcLogin"
+	GetEventPingOperation                      OperationName = "GetEventPing"
+	GetReportsOperation                        OperationName = "GetReports"
+	GetTenantSettingsOperation                 OperationName = "GetTenantSettings"
+	GetUserOperation                           OperationName = "GetUser"
+	GetUserSessionsOperation                   OperationName = "GetUserSessions"
+	GetUserUsageOperation                      OperationName = "GetUserUsage"
+	GetUsersOperation                          OperationName = "GetUsers"
+	GetWebsiteIDBrowsersOperation              OperationName = "GetWebsiteIDBrowsers"
+	GetWebsiteIDCampaignsOperation             OperationName = "GetWebsiteIDCampaigns"
+	GetWebsiteIDCountryOperation               OperationName = "GetWebsiteIDCountry"
+	GetWebsiteIDDeviceOperation                OperationName = "GetWebsiteIDDevice"
+	GetWebsiteIDFunnelOperation                OperationName = "GetWebsiteIDFunnel"
+	GetWebsiteIDGoalsOperation                 OperationName = "GetWebsiteIDGoals"
+	GetWebsiteIDHostnamesOperation             OperationName = "GetWebsiteIDHostnames"
+	GetWebsiteIDLanguageOperation              OperationName = "GetWebsiteIDLanguage"
+	GetWebsiteIDMediumsOperation               OperationName = "GetWebsiteIDMediums"
+	GetWebsiteIDOsOperation                    OperationName = "GetWebsiteIDOs"
+	GetWebsiteIDPagesOperation                 OperationName = "GetWebsiteIDPages"
+	GetWebsiteIDPagesEntryOperation            OperationName = "GetWebsiteIDPagesEntry"
+	GetWebsiteIDPagesExitOperation             OperationName = "GetWebsiteIDPagesExit"
+	GetWebsiteIDPropertiesOperation            OperationName = "GetWebsiteIDProperties"
+	GetWebsiteIDRealtimeOperation              OperationName = "GetWebsiteIDRealtime"
+	GetWebsiteIDReferrersOperation             OperationName = "GetWebsiteIDReferrers"
+	GetWebsiteIDSourcesOperation               OperationName = "GetWebsiteIDSources"
+	GetWebsiteIDSummaryOperation               OperationName = "GetWebsiteIDSummary"
+	GetWebsiteIDTimeOperation                  OperationName = "GetWebsiteIDTime"
+	GetWebsitesOperation                       OperationName = "GetWebsites"
+	GetWebsitesIDOperation                     OperationName = "GetWebsitesID"
+	GetWebsitesIDDeletionOperation             OperationName = "GetWebsitesIDDeletion"
+	GetWebsitesIDGoalsOperation                OperationName = "GetWebsitesIDGoals"
+	GetWebsitesIDWebhooksOperation             OperationName = "GetWebsitesIDWebhooks"
+	GetWebsitesIDWebhooksIDDeliveriesOperation OperationName = "GetWebsitesIDWebhooksIDDeliveries"
+	PatchReportsIDOperation                    OperationName = "PatchReportsID"
+	PatchTenantSettingsOperation               OperationName = "PatchTenantSettings"
+	PatchUserOperation                         OperationName = "PatchUser"
+	PatchUsersIDOperation                      OperationName = "PatchUsersID"
+	PatchWebsitesIDOperation                   OperationName = "PatchWebsitesID"
+	PatchWebsitesIDGoalsIDOperation            OperationName = "PatchWebsitesIDGoalsID"
+	PostAPIKeysOperation                       OperationName = "PostAPIKeys"
+	PostAuthLoginOperation                     OperationName = "PostAuthLogin"
+	PostAuthLoginTotpOperation                 OperationName = "PostAuthLoginTotp"
+	PostAuthLogoutOperation                    OperationName = "PostAuthLogout"
+	PostEventHitOperation                      OperationName = "PostEventHit"
+	PostReportsOperation                       OperationName = "PostReports"
+	PostUserTotpOperation                      OperationName = "PostUserTotp"
+	PostUserTotpConfirmOperation               OperationName = "PostUserTotpConfirm"
+	PostUserTotpDisableOperation               OperationName = "PostUserTotpDisable"
+	PostUsersOperation                         OperationName = "PostUsers"
+	PostWebsitesOperation                      OperationName = "PostWebsites"
+	PostWebsitesIDGoalsOperation               OperationName = "PostWebsitesIDGoals"
+	PostWebsitesIDWebhooksOperation            OperationName = "PostWebsitesIDWebhooks"
 )
@@ -3,6 +3,7 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
 	"net/url"
 	"time"
@@ -15,71 +16,15 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
-// DeleteUserParams is parameters of delete-user operation.
-type DeleteUserParams struct {
-	// Session token for authentication.
-	MeSess string
-}
-
-func unpackDeleteUserParams(packed middleware.Parameters) (params DeleteUserParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "_me_sess",
-			In:   "cookie",
-		}
-		params.MeSess = packed[key].(string)
-	}
-	return params
-}
-
-func decodeDeleteUserParams(args [0]string, argsEscaped bool, r *http.Request) (params DeleteUserParams, _ error) {
-	c := uri.NewCookieDecoder(r)
-	// Decode cookie: _me_sess.
-	if err := func() error {
-		cfg := uri.CookieParameterDecodingConfig{
-			Name:    "_me_sess",
-			Explode: true,
-		}
-		if err := c.HasParam(cfg); err == nil {
-			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.MeSess = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "_me_sess",
-			In:   "cookie",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// DeleteWebsitesIDParams is parameters of delete-websites-id operation.
-type DeleteWebsitesIDParams struct {
+// DeleteAPIKeysIDParams is parameters of delete-api-keys-id operation.
+type DeleteAPIKeysIDParams struct {
 	// Session token for authentication.
 	MeSess string
-	// Hostname for the website.
-	Hostname string
+	// API key ID.
+	KeyId string
 }
 
-func unpackDeleteWebsitesIDParams(packed middleware.Parameters) (params DeleteWebsitesIDParams) {
+func unpackDeleteAPIKeysIDParams(packed middleware.Parameters) (params DeleteAPIKeysIDParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "_me_sess",
@@ -89,15 +34,15 @@ func unpackDeleteWebsitesIDParams(packed middleware.Parameters) (params DeleteWe
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "hostname",
+			Name: "keyId",
 			In:   "path",
 		}
-		params.Hostname = packed[key].(string)
+		params.KeyId = packed[key].(string)
 	}
 	return params
 }
 
-func decodeDeleteWebsitesIDParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteWebsitesIDParams, _ error) {
+func decodeDeleteAPIKeysIDParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteAPIKeysIDParams, _ error) {
 	c := uri.NewCookieDecoder(r)
 	// Decode cookie: _me_sess.
 	if err := func() error {
@@ -133,7 +78,7 @@ func decodeDeleteWebsitesIDParams(args [1]string, argsEscaped bool, r *http.Requ
 			Err:  err,
 		}
 	}
-	// Decode path: hostname.
+	// Decode path: keyId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -145,7 +90,7 @@ func decodeDeleteWebsitesIDParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "hostname",
+				Param:   "keyId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -162,27 +107,7 @@ func decodeDeleteWebsitesIDParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				params.Hostname = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := (validate.String{
-					MinLength:     1,
-					MinLengthSet:  true,
-					MaxLength:     253,
-					MaxLengthSet:  true,
-					Email:         false,
-					Hostname:      true,
-					Regex:         nil,
-					MinNumeric:    0,
-					MinNumericSet: false,
-					MaxNumeric:    0,
-					MaxNumericSet: false,
-				}).Validate(string(params.Hostname)); err != nil {
-					return errors.Wrap(err, "string")
-				}
+				params.KeyId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -193,7 +118,7 @@ func decodeDeleteWebsitesIDParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "hostname",
+			Name: "keyId",
 			In:   "path",
 			Err:  err,
 		}
@@ -201,48 +126,93 @@ func decodeDeleteWebsitesIDParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// GetEventPingParams is parameters of get-event-ping operation.
-type GetEventPingParams struct {
-	// If this exists, then user exists in cache and is not a unique user.
-	IfModifiedSince OptString `json:",omitempty,omitzero"`
-	// Optional query parameter that is the current host and pathname of the page.
-	U OptString `json:",omitempty,omitzero"`
+// DeleteAuthLockoutsParams is parameters of delete-auth-lockouts operation.
+type DeleteAuthLockoutsParams struct {
+	// Session token for authentication.
+	MeSess string
+	// Username to clear.
+	Username OptString `json:",omitempty,omitzero"`
+	// IP address or prefix to clear. Addresses clear the prefix they are grouped into.
+	IP OptString `json:",omitempty,omitzero"`
 }
 
-func unpackGetEventPingParams(packed middleware.Parameters) (params GetEventPingParams) {
+func unpackDeleteAuthLockoutsParams(packed middleware.Parameters) (params DeleteAuthLockoutsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "If-Modified-Since",
-			In:   "header",
+			Name: "_me_sess",
+			In:   "cookie",
+		}
+		params.MeSess = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "username",
+			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.IfModifiedSince = v.(OptString)
+			params.Username = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "u",
+			Name: "ip",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.U = v.(OptString)
+			params.IP = v.(OptString)
 		}
 	}
 	return params
 }
 
-func decodeGetEventPingParams(args [0]string, argsEscaped bool, r *http.Request) (params GetEventPingParams, _ error) {
+func decodeDeleteAuthLockoutsParams(args [0]string, argsEscaped bool, r *http.Request) (params DeleteAuthLockoutsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	h := uri.NewHeaderDecoder(r.Header)
-	// Decode header: If-Modified-Since.
+	c := uri.NewCookieDecoder(r)
+	// Decode cookie: _me_sess.
 	if err := func() error {
-		cfg := uri.HeaderParameterDecodingConfig{
-			Name:    "If-Modified-Since",
-			Explode: false,
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "_me_sess",
+			Explode: true,
 		}
-		if err := h.HasParam(cfg); err == nil {
-			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotIfModifiedSinceVal string
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.MeSess = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "_me_sess",
+			In:   "cookie",
+			Err:  err,
+		}
+	}
+	// Decode query: username.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "username",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUsernameVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -254,12 +224,12 @@ func decodeGetEventPingParams(args [0]string, argsEscaped bool, r *http.Request)
 						return err
 					}
 
-					paramsDotIfModifiedSinceVal = c
+					paramsDotUsernameVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.IfModifiedSince.SetTo(paramsDotIfModifiedSinceVal)
+				params.Username.SetTo(paramsDotUsernameVal)
 				return nil
 			}); err != nil {
 				return err
@@ -268,22 +238,22 @@ func decodeGetEventPingParams(args [0]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "If-Modified-Since",
-			In:   "header",
+			Name: "username",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: u.
+	// Decode query: ip.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "u",
+			Name:    "ip",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUVal string
+				var paramsDotIPVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -295,12 +265,12 @@ func decodeGetEventPingParams(args [0]string, argsEscaped bool, r *http.Request)
 						return err
 					}
 
-					paramsDotUVal = c
+					paramsDotIPVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.U.SetTo(paramsDotUVal)
+				params.IP.SetTo(paramsDotIPVal)
 				return nil
 			}); err != nil {
 				return err
@@ -309,7 +279,7 @@ func decodeGetEventPingParams(args [0]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "u",
+			Name: "ip",
 			In:   "query",
 			Err:  err,
 		}
@@ -317,13 +287,15 @@ func decodeGetEventPingParams(args [0]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// GetTenantSettingsParams is parameters of get-tenant-settings operation.
-type GetTenantSettingsParams struct {
+// DeleteReportsIDParams is parameters of delete-reports-id operation.
+type DeleteReportsIDParams struct {
 	// Session token for authentication.
 	MeSess string
+	// Report subscription ID.
+	ReportId string
 }
 
-func unpackGetTenantSettingsParams(packed middleware.Parameters) (params GetTenantSettingsParams) {
+func unpackDeleteReportsIDParams(packed middleware.Parameters) (params DeleteReportsIDParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "_me_sess",
@@ -331,10 +303,17 @@ func unpackGetTenantSettingsParams(packed middleware.Parameters) (params GetTena
 		}
 		params.MeSess = packed[key].(string)
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "reportId",
+			In:   "path",
+		}
+		params.ReportId = packed[key].(string)
+	}
 	return params
 }
 
-func decodeGetTenantSettingsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetTenantSettingsParams, _ error) {
+func decodeDeleteReportsIDParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteReportsIDParams, _ error) {
 	c := uri.NewCookieDecoder(r)
 	// Decode cookie: _me_sess.
 	if err := func() error {
@@ -370,16 +349,61 @@ func decodeGetTenantSettingsParams(args [0]string, argsEscaped bool, r *http.Req
 			Err:  err,
 		}
 	}
+	// Decode path: reportId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "reportId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ReportId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "reportId",
+			In:   "path",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
 
-// GetUserParams is parameters of get-user operation.
-type GetUserParams struct {
+// DeleteUserParams is parameters of delete-user operation.
+type DeleteUserParams struct {
 	// Session token for authentication.
 	MeSess string
 }
 
-func unpackGetUserParams(packed middleware.Parameters) (params GetUserParams) {
+func unpackDeleteUserParams(packed middleware.Parameters) (params DeleteUserParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "_me_sess",
@@ -390,7 +414,7 @@ func unpackGetUserParams(packed middleware.Parameters) (params GetUserParams) {
 	return params
 }
 
-func decodeGetUserParams(args [0]string, argsEscaped bool, r *http.Request) (params GetUserParams, _ error) {
+func decodeDeleteUserParams(args [0]string, argsEscaped bool, r *http.Request) (params DeleteUserParams, _ error) {
 	c := uri.NewCookieDecoder(r)
 	// Decode cookie: _me_sess.
 	if err := func() error {
@@ -429,13 +453,13 @@ func decodeGetUserParams(args [0]string, argsEscaped bool, r *http.Request) (par
 	return params, nil
 }
 
-// GetUserUsageParams is parameters of get-user-usage operation.
-type GetUserUsageParams struct {
+// DeleteUserSessionsParams is parameters of delete-user-sessions operation.
+type DeleteUserSessionsParams struct {
 	// Session token for authentication.
 	MeSess string
 }
 
-func unpackGetUserUsageParams(packed middleware.Parameters) (params GetUserUsageParams) {
+func unpackDeleteUserSessionsParams(packed middleware.Parameters) (params DeleteUserSessionsParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "_me_sess",
@@ -446,7 +470,7 @@ func unpackGetUserUsageParams(packed middleware.Parameters) (params GetUserUsage
 	return params
 }
 
-func decodeGetUserUsageParams(args [0]string, argsEscaped bool, r *http.Request) (params GetUserUsageParams, _ error) {
+func decodeDeleteUserSessionsParams(args [0]string, argsEscaped bool, r *http.Request) (params DeleteUserSessionsParams, _ error) {
 	c := uri.NewCookieDecoder(r)
 	// Decode cookie: _me_sess.
 	if err := func() error {
@@ -485,49 +509,15 @@ func decodeGetUserUsageParams(args [0]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// GetWebsiteIDBrowsersParams is parameters of get-website-id-browsers operation.
-type GetWebsiteIDBrowsersParams struct {
+// DeleteUsersIDParams is parameters of delete-users-id operation.
+type DeleteUsersIDParams struct {
 	// Session token for authentication.
 	MeSess string
-	// Hostname for the website.
-	Hostname string
-	// Return a summary of the stats.
-	Summary OptBool `json:",omitempty,omitzero"`
-	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
-	Start OptDateTime `json:",omitempty,omitzero"`
-	// Period end date using fdate-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
-	End OptDateTime `json:",omitempty,omitzero"`
-	// Path of the page.
-	Path OptFilterString `json:",omitempty,omitzero"`
-	// Referrer URL of the page hit.
-	Referrer OptFilterString `json:",omitempty,omitzero"`
-	// UTM source of the page hit.
-	UtmSource OptFilterString `json:",omitempty,omitzero"`
-	// UTM medium of the page hit.
-	UtmMedium OptFilterString `json:",omitempty,omitzero"`
-	// UTM campaign of the page hit.
-	UtmCampaign OptFilterString `json:",omitempty,omitzero"`
-	// Browser name.
-	Browser OptFilterString `json:",omitempty,omitzero"`
-	// Operating system name.
-	Os OptFilterString `json:",omitempty,omitzero"`
-	// Device type.
-	Device OptFilterString `json:",omitempty,omitzero"`
-	// Country name.
-	Country OptFilterString `json:",omitempty,omitzero"`
-	// Language code.
-	Language OptFilterString `json:",omitempty,omitzero"`
-	// Name of the property.
-	PropName OptFilterString `json:",omitempty,omitzero"`
-	// Value of the property.
-	PropValue OptFilterString `json:",omitempty,omitzero"`
-	// Limit the number of results.
-	Limit OptInt `json:",omitempty,omitzero"`
-	// Offset the results paired with the limit parameter.
-	Offset OptInt `json:",omitempty,omitzero"`
+	// User ID.
+	UserId string
 }
 
-func unpackGetWebsiteIDBrowsersParams(packed middleware.Parameters) (params GetWebsiteIDBrowsersParams) {
+func unpackDeleteUsersIDParams(packed middleware.Parameters) (params DeleteUsersIDParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "_me_sess",
@@ -537,169 +527,15 @@ func unpackGetWebsiteIDBrowsersParams(packed middleware.Parameters) (params GetW
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "hostname",
+			Name: "userId",
 			In:   "path",
 		}
-		params.Hostname = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "summary",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Summary = v.(OptBool)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "start",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Start = v.(OptDateTime)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "end",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.End = v.(OptDateTime)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "path",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Path = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "referrer",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Referrer = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "utm_source",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.UtmSource = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "utm_medium",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.UtmMedium = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "utm_campaign",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.UtmCampaign = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "browser",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Browser = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "os",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Os = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "device",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Device = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "country",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Country = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "language",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Language = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "prop_name",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.PropName = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "prop_value",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.PropValue = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
-		}
+		params.UserId = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetWebsiteIDBrowsersParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDBrowsersParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
+func decodeDeleteUsersIDParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteUsersIDParams, _ error) {
 	c := uri.NewCookieDecoder(r)
 	// Decode cookie: _me_sess.
 	if err := func() error {
@@ -735,6 +571,85 @@ func decodeGetWebsiteIDBrowsersParams(args [1]string, argsEscaped bool, r *http.
 			Err:  err,
 		}
 	}
+	// Decode path: userId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteWebsitesIDParams is parameters of delete-websites-id operation.
+type DeleteWebsitesIDParams struct {
+	// Hostname for the website.
+	Hostname string
+	// Only report the number of page views and custom events that would be deleted without deleting
+	// anything.
+	DryRun OptBool `json:",omitempty,omitzero"`
+}
+
+func unpackDeleteWebsitesIDParams(packed middleware.Parameters) (params DeleteWebsitesIDParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
+		}
+		params.Hostname = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "dryRun",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.DryRun = v.(OptBool)
+		}
+	}
+	return params
+}
+
+func decodeDeleteWebsitesIDParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteWebsitesIDParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: hostname.
 	if err := func() error {
 		param := args[0]
@@ -800,22 +715,22 @@ func decodeGetWebsiteIDBrowsersParams(args [1]string, argsEscaped bool, r *http.
 			Err:  err,
 		}
 	}
-	// Set default value for query: summary.
+	// Set default value for query: dryRun.
 	{
 		val := bool(false)
-		params.Summary.SetTo(val)
+		params.DryRun.SetTo(val)
 	}
-	// Decode query: summary.
+	// Decode query: dryRun.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "summary",
+			Name:    "dryRun",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSummaryVal bool
+				var paramsDotDryRunVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -827,12 +742,12 @@ func decodeGetWebsiteIDBrowsersParams(args [1]string, argsEscaped bool, r *http.
 						return err
 					}
 
-					paramsDotSummaryVal = c
+					paramsDotDryRunVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Summary.SetTo(paramsDotSummaryVal)
+				params.DryRun.SetTo(paramsDotDryRunVal)
 				return nil
 			}); err != nil {
 				return err
@@ -841,266 +756,509 @@ func decodeGetWebsiteIDBrowsersParams(args [1]string, argsEscaped bool, r *http.
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "summary",
+			Name: "dryRun",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: start.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "start",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartVal time.Time
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+	return params, nil
+}
 
-					c, err := conv.ToDateTime(val)
-					if err != nil {
-						return err
-					}
+// DeleteWebsitesIDGoalsIDParams is parameters of delete-websites-id-goals-id operation.
+type DeleteWebsitesIDGoalsIDParams struct {
+	// Hostname for the website.
+	Hostname string
+	// Goal ID.
+	GoalId string
+}
 
-					paramsDotStartVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Start.SetTo(paramsDotStartVal)
-				return nil
-			}); err != nil {
-				return err
-			}
+func unpackDeleteWebsitesIDGoalsIDParams(packed middleware.Parameters) (params DeleteWebsitesIDGoalsIDParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "start",
-			In:   "query",
-			Err:  err,
+		params.Hostname = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "goalId",
+			In:   "path",
 		}
+		params.GoalId = packed[key].(string)
 	}
-	// Decode query: end.
+	return params
+}
+
+func decodeDeleteWebsitesIDGoalsIDParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteWebsitesIDGoalsIDParams, _ error) {
+	// Decode path: hostname.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "end",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotEndVal time.Time
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToDateTime(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotEndVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.End.SetTo(paramsDotEndVal)
+
+				params.Hostname = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "end",
-			In:   "query",
+			Name: "hostname",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: path.
+	// Decode path: goalId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "path",
-			Style:   uri.QueryStyleDeepObject,
-			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "goalId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPathVal FilterString
-				if err := func() error {
-					return paramsDotPathVal.DecodeURI(d)
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Path.SetTo(paramsDotPathVal)
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.GoalId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "path",
-			In:   "query",
+			Name: "goalId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: referrer.
+	return params, nil
+}
+
+// DeleteWebsitesIDWebhooksIDParams is parameters of delete-websites-id-webhooks-id operation.
+type DeleteWebsitesIDWebhooksIDParams struct {
+	// Hostname for the website.
+	Hostname string
+	// Webhook ID.
+	WebhookId string
+}
+
+func unpackDeleteWebsitesIDWebhooksIDParams(packed middleware.Parameters) (params DeleteWebsitesIDWebhooksIDParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
+		}
+		params.Hostname = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "webhookId",
+			In:   "path",
+		}
+		params.WebhookId = packed[key].(string)
+	}
+	return params
+}
+
+func decodeDeleteWebsitesIDWebhooksIDParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteWebsitesIDWebhooksIDParams, _ error) {
+	// Decode path: hostname.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "referrer",
-			Style:   uri.QueryStyleDeepObject,
-			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotReferrerVal FilterString
-				if err := func() error {
-					return paramsDotReferrerVal.DecodeURI(d)
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Referrer.SetTo(paramsDotReferrerVal)
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Hostname = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "referrer",
-			In:   "query",
+			Name: "hostname",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: utm_source.
+	// Decode path: webhookId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "utm_source",
-			Style:   uri.QueryStyleDeepObject,
-			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "webhookId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUtmSourceVal FilterString
-				if err := func() error {
-					return paramsDotUtmSourceVal.DecodeURI(d)
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.UtmSource.SetTo(paramsDotUtmSourceVal)
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.WebhookId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "utm_source",
-			In:   "query",
+			Name: "webhookId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: utm_medium.
+	return params, nil
+}
+
+// GetAPIKeysParams is parameters of get-api-keys operation.
+type GetAPIKeysParams struct {
+	// Session token for authentication.
+	MeSess string
+}
+
+func unpackGetAPIKeysParams(packed middleware.Parameters) (params GetAPIKeysParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "_me_sess",
+			In:   "cookie",
+		}
+		params.MeSess = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetAPIKeysParams(args [0]string, argsEscaped bool, r *http.Request) (params GetAPIKeysParams, _ error) {
+	c := uri.NewCookieDecoder(r)
+	// Decode cookie: _me_sess.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "utm_medium",
-			Style:   uri.QueryStyleDeepObject,
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "_me_sess",
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUtmMediumVal FilterString
-				if err := func() error {
-					return paramsDotUtmMediumVal.DecodeURI(d)
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.UtmMedium.SetTo(paramsDotUtmMediumVal)
+
+				params.MeSess = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "utm_medium",
-			In:   "query",
+			Name: "_me_sess",
+			In:   "cookie",
 			Err:  err,
 		}
 	}
-	// Decode query: utm_campaign.
+	return params, nil
+}
+
+// GetAuditParams is parameters of get-audit operation.
+type GetAuditParams struct {
+	// Session token for authentication.
+	MeSess string
+	// Only list actions performed by the user with this ID.
+	Actor OptString `json:",omitempty,omitzero"`
+	// Only list actions of this type, e.g. website.delete.
+	Action OptString `json:",omitempty,omitzero"`
+	// Only list actions performed on this website, user or other resource.
+	Target OptString `json:",omitempty,omitzero"`
+	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
+	Start OptDateTime `json:",omitempty,omitzero"`
+	// Period end date using fdate-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
+	End OptDateTime `json:",omitempty,omitzero"`
+	// Limit the number of results.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Offset the results paired with the limit parameter.
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetAuditParams(packed middleware.Parameters) (params GetAuditParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "_me_sess",
+			In:   "cookie",
+		}
+		params.MeSess = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "actor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Actor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "action",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Action = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "target",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Target = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Start = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "end",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.End = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetAuditParams(args [0]string, argsEscaped bool, r *http.Request) (params GetAuditParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	c := uri.NewCookieDecoder(r)
+	// Decode cookie: _me_sess.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "utm_campaign",
-			Style:   uri.QueryStyleDeepObject,
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "_me_sess",
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUtmCampaignVal FilterString
-				if err := func() error {
-					return paramsDotUtmCampaignVal.DecodeURI(d)
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.UtmCampaign.SetTo(paramsDotUtmCampaignVal)
+
+				params.MeSess = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "utm_campaign",
-			In:   "query",
+			Name: "_me_sess",
+			In:   "cookie",
 			Err:  err,
 		}
 	}
-	// Decode query: browser.
+	// Decode query: actor.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "browser",
-			Style:   uri.QueryStyleDeepObject,
+			Name:    "actor",
+			Style:   uri.QueryStyleForm,
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotBrowserVal FilterString
+				var paramsDotActorVal string
 				if err := func() error {
-					return paramsDotBrowserVal.DecodeURI(d)
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotActorVal = c
+					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Browser.SetTo(paramsDotBrowserVal)
+				params.Actor.SetTo(paramsDotActorVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1109,29 +1267,39 @@ func decodeGetWebsiteIDBrowsersParams(args [1]string, argsEscaped bool, r *http.
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "browser",
+			Name: "actor",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: os.
+	// Decode query: action.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "os",
-			Style:   uri.QueryStyleDeepObject,
+			Name:    "action",
+			Style:   uri.QueryStyleForm,
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOsVal FilterString
+				var paramsDotActionVal string
 				if err := func() error {
-					return paramsDotOsVal.DecodeURI(d)
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotActionVal = c
+					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Os.SetTo(paramsDotOsVal)
+				params.Action.SetTo(paramsDotActionVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1140,29 +1308,39 @@ func decodeGetWebsiteIDBrowsersParams(args [1]string, argsEscaped bool, r *http.
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "os",
+			Name: "action",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: device.
+	// Decode query: target.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "device",
-			Style:   uri.QueryStyleDeepObject,
+			Name:    "target",
+			Style:   uri.QueryStyleForm,
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotDeviceVal FilterString
+				var paramsDotTargetVal string
 				if err := func() error {
-					return paramsDotDeviceVal.DecodeURI(d)
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTargetVal = c
+					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Device.SetTo(paramsDotDeviceVal)
+				params.Target.SetTo(paramsDotTargetVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1171,91 +1349,39 @@ func decodeGetWebsiteIDBrowsersParams(args [1]string, argsEscaped bool, r *http.
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "device",
+			Name: "target",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: country.
+	// Decode query: start.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "country",
-			Style:   uri.QueryStyleDeepObject,
+			Name:    "start",
+			Style:   uri.QueryStyleForm,
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCountryVal FilterString
+				var paramsDotStartVal time.Time
 				if err := func() error {
-					return paramsDotCountryVal.DecodeURI(d)
-				}(); err != nil {
-					return err
-				}
-				params.Country.SetTo(paramsDotCountryVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "country",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: language.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "language",
-			Style:   uri.QueryStyleDeepObject,
-			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
-		}
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLanguageVal FilterString
-				if err := func() error {
-					return paramsDotLanguageVal.DecodeURI(d)
-				}(); err != nil {
-					return err
-				}
-				params.Language.SetTo(paramsDotLanguageVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "language",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: prop_name.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "prop_name",
-			Style:   uri.QueryStyleDeepObject,
-			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
-		}
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPropNameVal FilterString
-				if err := func() error {
-					return paramsDotPropNameVal.DecodeURI(d)
+					paramsDotStartVal = c
+					return nil
 				}(); err != nil {
 					return err
 				}
-				params.PropName.SetTo(paramsDotPropNameVal)
+				params.Start.SetTo(paramsDotStartVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1264,29 +1390,39 @@ func decodeGetWebsiteIDBrowsersParams(args [1]string, argsEscaped bool, r *http.
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "prop_name",
+			Name: "start",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: prop_value.
+	// Decode query: end.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "prop_value",
-			Style:   uri.QueryStyleDeepObject,
+			Name:    "end",
+			Style:   uri.QueryStyleForm,
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPropValueVal FilterString
+				var paramsDotEndVal time.Time
 				if err := func() error {
-					return paramsDotPropValueVal.DecodeURI(d)
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEndVal = c
+					return nil
 				}(); err != nil {
 					return err
 				}
-				params.PropValue.SetTo(paramsDotPropValueVal)
+				params.End.SetTo(paramsDotEndVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1295,7 +1431,7 @@ func decodeGetWebsiteIDBrowsersParams(args [1]string, argsEscaped bool, r *http.
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "prop_value",
+			Name: "end",
 			In:   "query",
 			Err:  err,
 		}
@@ -1435,49 +1571,13 @@ func decodeGetWebsiteIDBrowsersParams(args [1]string, argsEscaped bool, r *http.
 	return params, nil
 }
 
-// GetWebsiteIDCampaignsParams is parameters of get-website-id-campaigns operation.
-type GetWebsiteIDCampaignsParams struct {
+// GetAuthLockoutsParams is parameters of get-auth-lockouts operation.
+type GetAuthLockoutsParams struct {
 	// Session token for authentication.
 	MeSess string
-	// Hostname for the website.
-	Hostname string
-	// Return a summary of the stats.
-	Summary OptBool `json:",omitempty,omitzero"`
-	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
-	Start OptDateTime `json:",omitempty,omitzero"`
-	// Period end date using fdate-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
-	End OptDateTime `json:",omitempty,omitzero"`
-	// Path of the page.
-	Path OptFilterString `json:",omitempty,omitzero"`
-	// Referrer URL of the page hit.
-	Referrer OptFilterString `json:",omitempty,omitzero"`
-	// UTM source of the page hit.
-	UtmSource OptFilterString `json:",omitempty,omitzero"`
-	// UTM medium of the page hit.
-	UtmMedium OptFilterString `json:",omitempty,omitzero"`
-	// UTM campaign of the page hit.
-	UtmCampaign OptFilterString `json:",omitempty,omitzero"`
-	// Browser name.
-	Browser OptFilterString `json:",omitempty,omitzero"`
-	// Operating system name.
-	Os OptFilterString `json:",omitempty,omitzero"`
-	// Device type.
-	Device OptFilterString `json:",omitempty,omitzero"`
-	// Country name.
-	Country OptFilterString `json:",omitempty,omitzero"`
-	// Language code.
-	Language OptFilterString `json:",omitempty,omitzero"`
-	// Name of the property.
-	PropName OptFilterString `json:",omitempty,omitzero"`
-	// Value of the property.
-	PropValue OptFilterString `json:",omitempty,omitzero"`
-	// Limit the number of results.
-	Limit OptInt `json:",omitempty,omitzero"`
-	// Offset the results paired with the limit parameter.
-	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetWebsiteIDCampaignsParams(packed middleware.Parameters) (params GetWebsiteIDCampaignsParams) {
+func unpackGetAuthLockoutsParams(packed middleware.Parameters) (params GetAuthLockoutsParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "_me_sess",
@@ -1485,225 +1585,162 @@ func unpackGetWebsiteIDCampaignsParams(packed middleware.Parameters) (params Get
 		}
 		params.MeSess = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "hostname",
-			In:   "path",
-		}
-		params.Hostname = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "summary",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Summary = v.(OptBool)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "start",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Start = v.(OptDateTime)
+	return params
+}
+
+func decodeGetAuthLockoutsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetAuthLockoutsParams, _ error) {
+	c := uri.NewCookieDecoder(r)
+	// Decode cookie: _me_sess.
+	if err := func() error {
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "_me_sess",
+			Explode: true,
 		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "end",
-			In:   "query",
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.MeSess = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
 		}
-		if v, ok := packed[key]; ok {
-			params.End = v.(OptDateTime)
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "_me_sess",
+			In:   "cookie",
+			Err:  err,
 		}
 	}
+	return params, nil
+}
+
+// GetAuthOidcCallbackParams is parameters of get-auth-oidc-callback operation.
+type GetAuthOidcCallbackParams struct {
+	// Authorization code from the identity provider.
+	Code OptString `json:",omitempty,omitzero"`
+	// State of the login.
+	State string
+	// Error from the identity provider if the login failed.
+	Error OptString `json:",omitempty,omitzero"`
+	// State of the login started in this browser.
+	MeOidc string
+	// Shown in the list of active sessions.
+	UserAgent OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetAuthOidcCallbackParams(packed middleware.Parameters) (params GetAuthOidcCallbackParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "path",
+			Name: "code",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Path = v.(OptFilterString)
+			params.Code = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "referrer",
+			Name: "state",
 			In:   "query",
 		}
-		if v, ok := packed[key]; ok {
-			params.Referrer = v.(OptFilterString)
-		}
+		params.State = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "utm_source",
+			Name: "error",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.UtmSource = v.(OptFilterString)
+			params.Error = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "utm_medium",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.UtmMedium = v.(OptFilterString)
+			Name: "_me_oidc",
+			In:   "cookie",
 		}
+		params.MeOidc = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "utm_campaign",
-			In:   "query",
+			Name: "User-Agent",
+			In:   "header",
 		}
 		if v, ok := packed[key]; ok {
-			params.UtmCampaign = v.(OptFilterString)
+			params.UserAgent = v.(OptString)
 		}
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "browser",
-			In:   "query",
+	return params
+}
+
+func decodeGetAuthOidcCallbackParams(args [0]string, argsEscaped bool, r *http.Request) (params GetAuthOidcCallbackParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	c := uri.NewCookieDecoder(r)
+	// Decode query: code.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "code",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if v, ok := packed[key]; ok {
-			params.Browser = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "os",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Os = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "device",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Device = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "country",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Country = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "language",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Language = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "prop_name",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.PropName = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "prop_value",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.PropValue = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
-		}
-	}
-	return params
-}
 
-func decodeGetWebsiteIDCampaignsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDCampaignsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	c := uri.NewCookieDecoder(r)
-	// Decode cookie: _me_sess.
-	if err := func() error {
-		cfg := uri.CookieParameterDecodingConfig{
-			Name:    "_me_sess",
-			Explode: true,
-		}
-		if err := c.HasParam(cfg); err == nil {
-			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCodeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCodeVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.MeSess = c
+				params.Code.SetTo(paramsDotCodeVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "_me_sess",
-			In:   "cookie",
+			Name: "code",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: hostname.
+	// Decode query: state.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "state",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "hostname",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -1714,75 +1751,50 @@ func decodeGetWebsiteIDCampaignsParams(args [1]string, argsEscaped bool, r *http
 					return err
 				}
 
-				params.Hostname = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := (validate.String{
-					MinLength:     1,
-					MinLengthSet:  true,
-					MaxLength:     253,
-					MaxLengthSet:  true,
-					Email:         false,
-					Hostname:      true,
-					Regex:         nil,
-					MinNumeric:    0,
-					MinNumericSet: false,
-					MaxNumeric:    0,
-					MaxNumericSet: false,
-				}).Validate(string(params.Hostname)); err != nil {
-					return errors.Wrap(err, "string")
-				}
+				params.State = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
-			return validate.ErrFieldRequired
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "hostname",
-			In:   "path",
+			Name: "state",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: summary.
-	{
-		val := bool(false)
-		params.Summary.SetTo(val)
-	}
-	// Decode query: summary.
+	// Decode query: error.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "summary",
+			Name:    "error",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSummaryVal bool
+				var paramsDotErrorVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotSummaryVal = c
+					paramsDotErrorVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Summary.SetTo(paramsDotSummaryVal)
+				params.Error.SetTo(paramsDotErrorVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1791,80 +1803,71 @@ func decodeGetWebsiteIDCampaignsParams(args [1]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "summary",
+			Name: "error",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: start.
+	// Decode cookie: _me_oidc.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "start",
-			Style:   uri.QueryStyleForm,
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "_me_oidc",
 			Explode: true,
 		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartVal time.Time
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToDateTime(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotStartVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Start.SetTo(paramsDotStartVal)
+
+				params.MeOidc = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "start",
-			In:   "query",
+			Name: "_me_oidc",
+			In:   "cookie",
 			Err:  err,
 		}
 	}
-	// Decode query: end.
+	// Decode header: User-Agent.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "end",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "User-Agent",
+			Explode: false,
 		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotEndVal time.Time
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUserAgentVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToDateTime(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotEndVal = c
+					paramsDotUserAgentVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.End.SetTo(paramsDotEndVal)
+				params.UserAgent.SetTo(paramsDotUserAgentVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1873,60 +1876,73 @@ func decodeGetWebsiteIDCampaignsParams(args [1]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "end",
-			In:   "query",
+			Name: "User-Agent",
+			In:   "header",
 			Err:  err,
 		}
 	}
-	// Decode query: path.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "path",
-			Style:   uri.QueryStyleDeepObject,
-			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPathVal FilterString
-				if err := func() error {
-					return paramsDotPathVal.DecodeURI(d)
-				}(); err != nil {
-					return err
-				}
-				params.Path.SetTo(paramsDotPathVal)
-				return nil
-			}); err != nil {
-				return err
-			}
+// GetEventPingParams is parameters of get-event-ping operation.
+type GetEventPingParams struct {
+	// If this exists, then user exists in cache and is not a unique user.
+	IfModifiedSince OptString `json:",omitempty,omitzero"`
+	// Optional query parameter that is the current host and pathname of the page.
+	U OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetEventPingParams(packed middleware.Parameters) (params GetEventPingParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Modified-Since",
+			In:   "header",
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "path",
+		if v, ok := packed[key]; ok {
+			params.IfModifiedSince = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "u",
 			In:   "query",
-			Err:  err,
+		}
+		if v, ok := packed[key]; ok {
+			params.U = v.(OptString)
 		}
 	}
-	// Decode query: referrer.
+	return params
+}
+
+func decodeGetEventPingParams(args [0]string, argsEscaped bool, r *http.Request) (params GetEventPingParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: If-Modified-Since.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "referrer",
-			Style:   uri.QueryStyleDeepObject,
-			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Modified-Since",
+			Explode: false,
 		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotReferrerVal FilterString
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfModifiedSinceVal string
 				if err := func() error {
-					return paramsDotReferrerVal.DecodeURI(d)
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfModifiedSinceVal = c
+					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Referrer.SetTo(paramsDotReferrerVal)
+				params.IfModifiedSince.SetTo(paramsDotIfModifiedSinceVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1935,29 +1951,39 @@ func decodeGetWebsiteIDCampaignsParams(args [1]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "referrer",
-			In:   "query",
+			Name: "If-Modified-Since",
+			In:   "header",
 			Err:  err,
 		}
 	}
-	// Decode query: utm_source.
+	// Decode query: u.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "utm_source",
-			Style:   uri.QueryStyleDeepObject,
+			Name:    "u",
+			Style:   uri.QueryStyleForm,
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUtmSourceVal FilterString
+				var paramsDotUVal string
 				if err := func() error {
-					return paramsDotUtmSourceVal.DecodeURI(d)
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUVal = c
+					return nil
 				}(); err != nil {
 					return err
 				}
-				params.UtmSource.SetTo(paramsDotUtmSourceVal)
+				params.U.SetTo(paramsDotUVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1966,431 +1992,359 @@ func decodeGetWebsiteIDCampaignsParams(args [1]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "utm_source",
+			Name: "u",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: utm_medium.
+	return params, nil
+}
+
+// GetReportsParams is parameters of get-reports operation.
+type GetReportsParams struct {
+	// Session token for authentication.
+	MeSess string
+}
+
+func unpackGetReportsParams(packed middleware.Parameters) (params GetReportsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "_me_sess",
+			In:   "cookie",
+		}
+		params.MeSess = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetReportsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetReportsParams, _ error) {
+	c := uri.NewCookieDecoder(r)
+	// Decode cookie: _me_sess.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "utm_medium",
-			Style:   uri.QueryStyleDeepObject,
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "_me_sess",
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUtmMediumVal FilterString
-				if err := func() error {
-					return paramsDotUtmMediumVal.DecodeURI(d)
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.UtmMedium.SetTo(paramsDotUtmMediumVal)
+
+				params.MeSess = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "utm_medium",
-			In:   "query",
+			Name: "_me_sess",
+			In:   "cookie",
 			Err:  err,
 		}
 	}
-	// Decode query: utm_campaign.
+	return params, nil
+}
+
+// GetTenantSettingsParams is parameters of get-tenant-settings operation.
+type GetTenantSettingsParams struct {
+	// Session token for authentication.
+	MeSess string
+}
+
+func unpackGetTenantSettingsParams(packed middleware.Parameters) (params GetTenantSettingsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "_me_sess",
+			In:   "cookie",
+		}
+		params.MeSess = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetTenantSettingsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetTenantSettingsParams, _ error) {
+	c := uri.NewCookieDecoder(r)
+	// Decode cookie: _me_sess.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "utm_campaign",
-			Style:   uri.QueryStyleDeepObject,
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "_me_sess",
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUtmCampaignVal FilterString
-				if err := func() error {
-					return paramsDotUtmCampaignVal.DecodeURI(d)
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.UtmCampaign.SetTo(paramsDotUtmCampaignVal)
+
+				params.MeSess = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "utm_campaign",
-			In:   "query",
+			Name: "_me_sess",
+			In:   "cookie",
 			Err:  err,
 		}
 	}
-	// Decode query: browser.
+	return params, nil
+}
+
+// GetUserParams is parameters of get-user operation.
+type GetUserParams struct {
+	// Session token for authentication.
+	MeSess string
+}
+
+func unpackGetUserParams(packed middleware.Parameters) (params GetUserParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "_me_sess",
+			In:   "cookie",
+		}
+		params.MeSess = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetUserParams(args [0]string, argsEscaped bool, r *http.Request) (params GetUserParams, _ error) {
+	c := uri.NewCookieDecoder(r)
+	// Decode cookie: _me_sess.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "browser",
-			Style:   uri.QueryStyleDeepObject,
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "_me_sess",
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotBrowserVal FilterString
-				if err := func() error {
-					return paramsDotBrowserVal.DecodeURI(d)
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Browser.SetTo(paramsDotBrowserVal)
+
+				params.MeSess = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "browser",
-			In:   "query",
+			Name: "_me_sess",
+			In:   "cookie",
 			Err:  err,
 		}
 	}
-	// Decode query: os.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "os",
-			Style:   uri.QueryStyleDeepObject,
-			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOsVal FilterString
-				if err := func() error {
-					return paramsDotOsVal.DecodeURI(d)
-				}(); err != nil {
-					return err
-				}
-				params.Os.SetTo(paramsDotOsVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "os",
-			In:   "query",
-			Err:  err,
+// GetUserSessionsParams is parameters of get-user-sessions operation.
+type GetUserSessionsParams struct {
+	// Session token for authentication.
+	MeSess string
+}
+
+func unpackGetUserSessionsParams(packed middleware.Parameters) (params GetUserSessionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "_me_sess",
+			In:   "cookie",
 		}
+		params.MeSess = packed[key].(string)
 	}
-	// Decode query: device.
+	return params
+}
+
+func decodeGetUserSessionsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetUserSessionsParams, _ error) {
+	c := uri.NewCookieDecoder(r)
+	// Decode cookie: _me_sess.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "device",
-			Style:   uri.QueryStyleDeepObject,
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "_me_sess",
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotDeviceVal FilterString
-				if err := func() error {
-					return paramsDotDeviceVal.DecodeURI(d)
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Device.SetTo(paramsDotDeviceVal)
+
+				params.MeSess = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "device",
-			In:   "query",
+			Name: "_me_sess",
+			In:   "cookie",
 			Err:  err,
 		}
 	}
-	// Decode query: country.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "country",
-			Style:   uri.QueryStyleDeepObject,
-			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCountryVal FilterString
-				if err := func() error {
-					return paramsDotCountryVal.DecodeURI(d)
-				}(); err != nil {
-					return err
-				}
-				params.Country.SetTo(paramsDotCountryVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "country",
-			In:   "query",
-			Err:  err,
+// GetUserUsageParams is parameters of get-user-usage operation.
+type GetUserUsageParams struct {
+	// Session token for authentication.
+	MeSess string
+}
+
+func unpackGetUserUsageParams(packed middleware.Parameters) (params GetUserUsageParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "_me_sess",
+			In:   "cookie",
 		}
+		params.MeSess = packed[key].(string)
 	}
-	// Decode query: language.
+	return params
+}
+
+func decodeGetUserUsageParams(args [0]string, argsEscaped bool, r *http.Request) (params GetUserUsageParams, _ error) {
+	c := uri.NewCookieDecoder(r)
+	// Decode cookie: _me_sess.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "language",
-			Style:   uri.QueryStyleDeepObject,
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "_me_sess",
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLanguageVal FilterString
-				if err := func() error {
-					return paramsDotLanguageVal.DecodeURI(d)
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Language.SetTo(paramsDotLanguageVal)
+
+				params.MeSess = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "language",
-			In:   "query",
+			Name: "_me_sess",
+			In:   "cookie",
 			Err:  err,
 		}
 	}
-	// Decode query: prop_name.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "prop_name",
-			Style:   uri.QueryStyleDeepObject,
-			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPropNameVal FilterString
-				if err := func() error {
-					return paramsDotPropNameVal.DecodeURI(d)
-				}(); err != nil {
-					return err
-				}
-				params.PropName.SetTo(paramsDotPropNameVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "prop_name",
-			In:   "query",
-			Err:  err,
+// GetUsersParams is parameters of get-users operation.
+type GetUsersParams struct {
+	// Session token for authentication.
+	MeSess string
+}
+
+func unpackGetUsersParams(packed middleware.Parameters) (params GetUsersParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "_me_sess",
+			In:   "cookie",
 		}
+		params.MeSess = packed[key].(string)
 	}
-	// Decode query: prop_value.
+	return params
+}
+
+func decodeGetUsersParams(args [0]string, argsEscaped bool, r *http.Request) (params GetUsersParams, _ error) {
+	c := uri.NewCookieDecoder(r)
+	// Decode cookie: _me_sess.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "prop_value",
-			Style:   uri.QueryStyleDeepObject,
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "_me_sess",
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPropValueVal FilterString
-				if err := func() error {
-					return paramsDotPropValueVal.DecodeURI(d)
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.PropValue.SetTo(paramsDotPropValueVal)
+
+				params.MeSess = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "prop_value",
-			In:   "query",
+			Name: "_me_sess",
+			In:   "cookie",
 			Err:  err,
 		}
 	}
-	// Decode query: limit.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+	return params, nil
+}
 
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           5000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: offset.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Offset.SetTo(paramsDotOffsetVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        true,
-							Max:           5000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetWebsiteIDCountryParams is parameters of get-website-id-country operation.
-type GetWebsiteIDCountryParams struct {
-	// Session token for authentication.
-	MeSess string
+// GetWebsiteIDBrowsersParams is parameters of get-website-id-browsers operation.
+type GetWebsiteIDBrowsersParams struct {
 	// Hostname for the website.
 	Hostname string
+	// Export the full report as `csv` or newline delimited JSON (`ndjson`) instead of JSON. Rows are
+	// streamed as they are read and include every column, so the summary, limit and offset parameters
+	// are ignored when exporting. Sending an `Accept` header of `text/csv` or `application/x-ndjson` has
+	// the same effect.
+	Format OptFormat `json:",omitempty,omitzero"`
 	// Return a summary of the stats.
 	Summary OptBool `json:",omitempty,omitzero"`
 	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
@@ -2399,6 +2353,8 @@ type GetWebsiteIDCountryParams struct {
 	End OptDateTime `json:",omitempty,omitzero"`
 	// Path of the page.
 	Path OptFilterString `json:",omitempty,omitzero"`
+	// Hostname or alias the page hit was received on.
+	OriginalHostname OptFilterString `json:",omitempty,omitzero"`
 	// Referrer URL of the page hit.
 	Referrer OptFilterString `json:",omitempty,omitzero"`
 	// UTM source of the page hit.
@@ -2427,20 +2383,22 @@ type GetWebsiteIDCountryParams struct {
 	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetWebsiteIDCountryParams(packed middleware.Parameters) (params GetWebsiteIDCountryParams) {
+func unpackGetWebsiteIDBrowsersParams(packed middleware.Parameters) (params GetWebsiteIDBrowsersParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "_me_sess",
-			In:   "cookie",
+			Name: "hostname",
+			In:   "path",
 		}
-		params.MeSess = packed[key].(string)
+		params.Hostname = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "hostname",
-			In:   "path",
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptFormat)
 		}
-		params.Hostname = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
@@ -2478,6 +2436,15 @@ func unpackGetWebsiteIDCountryParams(packed middleware.Parameters) (params GetWe
 			params.Path = v.(OptFilterString)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_hostname",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OriginalHostname = v.(OptFilterString)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "referrer",
@@ -2598,43 +2565,8 @@ func unpackGetWebsiteIDCountryParams(packed middleware.Parameters) (params GetWe
 	return params
 }
 
-func decodeGetWebsiteIDCountryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDCountryParams, _ error) {
+func decodeGetWebsiteIDBrowsersParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDBrowsersParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	c := uri.NewCookieDecoder(r)
-	// Decode cookie: _me_sess.
-	if err := func() error {
-		cfg := uri.CookieParameterDecodingConfig{
-			Name:    "_me_sess",
-			Explode: true,
-		}
-		if err := c.HasParam(cfg); err == nil {
-			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.MeSess = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "_me_sess",
-			In:   "cookie",
-			Err:  err,
-		}
-	}
 	// Decode path: hostname.
 	if err := func() error {
 		param := args[0]
@@ -2700,6 +2632,62 @@ func decodeGetWebsiteIDCountryParams(args [1]string, argsEscaped bool, r *http.R
 			Err:  err,
 		}
 	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal Format
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = Format(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Set default value for query: summary.
 	{
 		val := bool(false)
@@ -2859,6 +2847,37 @@ func decodeGetWebsiteIDCountryParams(args [1]string, argsEscaped bool, r *http.R
 			Err:  err,
 		}
 	}
+	// Decode query: original_hostname.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "original_hostname",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOriginalHostnameVal FilterString
+				if err := func() error {
+					return paramsDotOriginalHostnameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.OriginalHostname.SetTo(paramsDotOriginalHostnameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "original_hostname",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Decode query: referrer.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -3335,12 +3354,15 @@ func decodeGetWebsiteIDCountryParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// GetWebsiteIDDeviceParams is parameters of get-website-id-device operation.
-type GetWebsiteIDDeviceParams struct {
-	// Session token for authentication.
-	MeSess string
+// GetWebsiteIDCampaignsParams is parameters of get-website-id-campaigns operation.
+type GetWebsiteIDCampaignsParams struct {
 	// Hostname for the website.
 	Hostname string
+	// Export the full report as `csv` or newline delimited JSON (`ndjson`) instead of JSON. Rows are
+	// streamed as they are read and include every column, so the summary, limit and offset parameters
+	// are ignored when exporting. Sending an `Accept` header of `text/csv` or `application/x-ndjson` has
+	// the same effect.
+	Format OptFormat `json:",omitempty,omitzero"`
 	// Return a summary of the stats.
 	Summary OptBool `json:",omitempty,omitzero"`
 	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
@@ -3349,6 +3371,8 @@ type GetWebsiteIDDeviceParams struct {
 	End OptDateTime `json:",omitempty,omitzero"`
 	// Path of the page.
 	Path OptFilterString `json:",omitempty,omitzero"`
+	// Hostname or alias the page hit was received on.
+	OriginalHostname OptFilterString `json:",omitempty,omitzero"`
 	// Referrer URL of the page hit.
 	Referrer OptFilterString `json:",omitempty,omitzero"`
 	// UTM source of the page hit.
@@ -3377,20 +3401,22 @@ type GetWebsiteIDDeviceParams struct {
 	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetWebsiteIDDeviceParams(packed middleware.Parameters) (params GetWebsiteIDDeviceParams) {
+func unpackGetWebsiteIDCampaignsParams(packed middleware.Parameters) (params GetWebsiteIDCampaignsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "_me_sess",
-			In:   "cookie",
+			Name: "hostname",
+			In:   "path",
 		}
-		params.MeSess = packed[key].(string)
+		params.Hostname = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "hostname",
-			In:   "path",
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptFormat)
 		}
-		params.Hostname = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
@@ -3428,6 +3454,15 @@ func unpackGetWebsiteIDDeviceParams(packed middleware.Parameters) (params GetWeb
 			params.Path = v.(OptFilterString)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_hostname",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OriginalHostname = v.(OptFilterString)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "referrer",
@@ -3548,43 +3583,8 @@ func unpackGetWebsiteIDDeviceParams(packed middleware.Parameters) (params GetWeb
 	return params
 }
 
-func decodeGetWebsiteIDDeviceParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDDeviceParams, _ error) {
+func decodeGetWebsiteIDCampaignsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDCampaignsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	c := uri.NewCookieDecoder(r)
-	// Decode cookie: _me_sess.
-	if err := func() error {
-		cfg := uri.CookieParameterDecodingConfig{
-			Name:    "_me_sess",
-			Explode: true,
-		}
-		if err := c.HasParam(cfg); err == nil {
-			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.MeSess = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "_me_sess",
-			In:   "cookie",
-			Err:  err,
-		}
-	}
 	// Decode path: hostname.
 	if err := func() error {
 		param := args[0]
@@ -3650,6 +3650,62 @@ func decodeGetWebsiteIDDeviceParams(args [1]string, argsEscaped bool, r *http.Re
 			Err:  err,
 		}
 	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal Format
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = Format(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Set default value for query: summary.
 	{
 		val := bool(false)
@@ -3809,6 +3865,37 @@ func decodeGetWebsiteIDDeviceParams(args [1]string, argsEscaped bool, r *http.Re
 			Err:  err,
 		}
 	}
+	// Decode query: original_hostname.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "original_hostname",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOriginalHostnameVal FilterString
+				if err := func() error {
+					return paramsDotOriginalHostnameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.OriginalHostname.SetTo(paramsDotOriginalHostnameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "original_hostname",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Decode query: referrer.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -4285,14 +4372,15 @@ func decodeGetWebsiteIDDeviceParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// GetWebsiteIDLanguageParams is parameters of get-website-id-language operation.
-type GetWebsiteIDLanguageParams struct {
-	// Whether to return the language name or the language dialect/locale.
-	Locale OptBool `json:",omitempty,omitzero"`
-	// Session token for authentication.
-	MeSess string
+// GetWebsiteIDCountryParams is parameters of get-website-id-country operation.
+type GetWebsiteIDCountryParams struct {
 	// Hostname for the website.
 	Hostname string
+	// Export the full report as `csv` or newline delimited JSON (`ndjson`) instead of JSON. Rows are
+	// streamed as they are read and include every column, so the summary, limit and offset parameters
+	// are ignored when exporting. Sending an `Accept` header of `text/csv` or `application/x-ndjson` has
+	// the same effect.
+	Format OptFormat `json:",omitempty,omitzero"`
 	// Return a summary of the stats.
 	Summary OptBool `json:",omitempty,omitzero"`
 	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
@@ -4301,6 +4389,8 @@ type GetWebsiteIDLanguageParams struct {
 	End OptDateTime `json:",omitempty,omitzero"`
 	// Path of the page.
 	Path OptFilterString `json:",omitempty,omitzero"`
+	// Hostname or alias the page hit was received on.
+	OriginalHostname OptFilterString `json:",omitempty,omitzero"`
 	// Referrer URL of the page hit.
 	Referrer OptFilterString `json:",omitempty,omitzero"`
 	// UTM source of the page hit.
@@ -4329,29 +4419,22 @@ type GetWebsiteIDLanguageParams struct {
 	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetWebsiteIDLanguageParams(packed middleware.Parameters) (params GetWebsiteIDLanguageParams) {
+func unpackGetWebsiteIDCountryParams(packed middleware.Parameters) (params GetWebsiteIDCountryParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "locale",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Locale = v.(OptBool)
+			Name: "hostname",
+			In:   "path",
 		}
+		params.Hostname = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "_me_sess",
-			In:   "cookie",
+			Name: "format",
+			In:   "query",
 		}
-		params.MeSess = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "hostname",
-			In:   "path",
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptFormat)
 		}
-		params.Hostname = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
@@ -4389,6 +4472,15 @@ func unpackGetWebsiteIDLanguageParams(packed middleware.Parameters) (params GetW
 			params.Path = v.(OptFilterString)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_hostname",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OriginalHostname = v.(OptFilterString)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "referrer",
@@ -4509,89 +4601,8 @@ func unpackGetWebsiteIDLanguageParams(packed middleware.Parameters) (params GetW
 	return params
 }
 
-func decodeGetWebsiteIDLanguageParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDLanguageParams, _ error) {
+func decodeGetWebsiteIDCountryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDCountryParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	c := uri.NewCookieDecoder(r)
-	// Set default value for query: locale.
-	{
-		val := bool(false)
-		params.Locale.SetTo(val)
-	}
-	// Decode query: locale.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "locale",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLocaleVal bool
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToBool(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLocaleVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Locale.SetTo(paramsDotLocaleVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "locale",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode cookie: _me_sess.
-	if err := func() error {
-		cfg := uri.CookieParameterDecodingConfig{
-			Name:    "_me_sess",
-			Explode: true,
-		}
-		if err := c.HasParam(cfg); err == nil {
-			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.MeSess = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "_me_sess",
-			In:   "cookie",
-			Err:  err,
-		}
-	}
 	// Decode path: hostname.
 	if err := func() error {
 		param := args[0]
@@ -4657,29 +4668,85 @@ func decodeGetWebsiteIDLanguageParams(args [1]string, argsEscaped bool, r *http.
 			Err:  err,
 		}
 	}
-	// Set default value for query: summary.
-	{
-		val := bool(false)
-		params.Summary.SetTo(val)
-	}
-	// Decode query: summary.
+	// Decode query: format.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "summary",
+			Name:    "format",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSummaryVal bool
+				var paramsDotFormatVal Format
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = Format(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: summary.
+	{
+		val := bool(false)
+		params.Summary.SetTo(val)
+	}
+	// Decode query: summary.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "summary",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSummaryVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
 					if err != nil {
 						return err
 					}
@@ -4816,6 +4883,37 @@ func decodeGetWebsiteIDLanguageParams(args [1]string, argsEscaped bool, r *http.
 			Err:  err,
 		}
 	}
+	// Decode query: original_hostname.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "original_hostname",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOriginalHostnameVal FilterString
+				if err := func() error {
+					return paramsDotOriginalHostnameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.OriginalHostname.SetTo(paramsDotOriginalHostnameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "original_hostname",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Decode query: referrer.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -5292,12 +5390,15 @@ func decodeGetWebsiteIDLanguageParams(args [1]string, argsEscaped bool, r *http.
 	return params, nil
 }
 
-// GetWebsiteIDMediumsParams is parameters of get-website-id-mediums operation.
-type GetWebsiteIDMediumsParams struct {
-	// Session token for authentication.
-	MeSess string
+// GetWebsiteIDDeviceParams is parameters of get-website-id-device operation.
+type GetWebsiteIDDeviceParams struct {
 	// Hostname for the website.
 	Hostname string
+	// Export the full report as `csv` or newline delimited JSON (`ndjson`) instead of JSON. Rows are
+	// streamed as they are read and include every column, so the summary, limit and offset parameters
+	// are ignored when exporting. Sending an `Accept` header of `text/csv` or `application/x-ndjson` has
+	// the same effect.
+	Format OptFormat `json:",omitempty,omitzero"`
 	// Return a summary of the stats.
 	Summary OptBool `json:",omitempty,omitzero"`
 	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
@@ -5306,6 +5407,8 @@ type GetWebsiteIDMediumsParams struct {
 	End OptDateTime `json:",omitempty,omitzero"`
 	// Path of the page.
 	Path OptFilterString `json:",omitempty,omitzero"`
+	// Hostname or alias the page hit was received on.
+	OriginalHostname OptFilterString `json:",omitempty,omitzero"`
 	// Referrer URL of the page hit.
 	Referrer OptFilterString `json:",omitempty,omitzero"`
 	// UTM source of the page hit.
@@ -5334,20 +5437,22 @@ type GetWebsiteIDMediumsParams struct {
 	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetWebsiteIDMediumsParams(packed middleware.Parameters) (params GetWebsiteIDMediumsParams) {
+func unpackGetWebsiteIDDeviceParams(packed middleware.Parameters) (params GetWebsiteIDDeviceParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "_me_sess",
-			In:   "cookie",
+			Name: "hostname",
+			In:   "path",
 		}
-		params.MeSess = packed[key].(string)
+		params.Hostname = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "hostname",
-			In:   "path",
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptFormat)
 		}
-		params.Hostname = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
@@ -5385,6 +5490,15 @@ func unpackGetWebsiteIDMediumsParams(packed middleware.Parameters) (params GetWe
 			params.Path = v.(OptFilterString)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_hostname",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OriginalHostname = v.(OptFilterString)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "referrer",
@@ -5505,43 +5619,8 @@ func unpackGetWebsiteIDMediumsParams(packed middleware.Parameters) (params GetWe
 	return params
 }
 
-func decodeGetWebsiteIDMediumsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDMediumsParams, _ error) {
+func decodeGetWebsiteIDDeviceParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDDeviceParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	c := uri.NewCookieDecoder(r)
-	// Decode cookie: _me_sess.
-	if err := func() error {
-		cfg := uri.CookieParameterDecodingConfig{
-			Name:    "_me_sess",
-			Explode: true,
-		}
-		if err := c.HasParam(cfg); err == nil {
-			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.MeSess = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "_me_sess",
-			In:   "cookie",
-			Err:  err,
-		}
-	}
 	// Decode path: hostname.
 	if err := func() error {
 		param := args[0]
@@ -5607,6 +5686,62 @@ func decodeGetWebsiteIDMediumsParams(args [1]string, argsEscaped bool, r *http.R
 			Err:  err,
 		}
 	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal Format
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = Format(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Set default value for query: summary.
 	{
 		val := bool(false)
@@ -5766,6 +5901,37 @@ func decodeGetWebsiteIDMediumsParams(args [1]string, argsEscaped bool, r *http.R
 			Err:  err,
 		}
 	}
+	// Decode query: original_hostname.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "original_hostname",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOriginalHostnameVal FilterString
+				if err := func() error {
+					return paramsDotOriginalHostnameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.OriginalHostname.SetTo(paramsDotOriginalHostnameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "original_hostname",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Decode query: referrer.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -6242,20 +6408,27 @@ func decodeGetWebsiteIDMediumsParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// GetWebsiteIDOsParams is parameters of get-website-id-os operation.
-type GetWebsiteIDOsParams struct {
-	// Session token for authentication.
-	MeSess string
+// GetWebsiteIDFunnelParams is parameters of get-website-id-funnel operation.
+type GetWebsiteIDFunnelParams struct {
+	// Ordered list of funnel steps. A step is either a pathname glob pattern prefixed with `path:` (e.g.
+	// `path:/pricing*`) or a custom property prefixed with `event:` with an optional value (e.g.
+	// `event:signup` or `event:plan=pro`).
+	Steps []string `json:",omitempty"`
 	// Hostname for the website.
 	Hostname string
-	// Return a summary of the stats.
-	Summary OptBool `json:",omitempty,omitzero"`
+	// Export the full report as `csv` or newline delimited JSON (`ndjson`) instead of JSON. Rows are
+	// streamed as they are read and include every column, so the summary, limit and offset parameters
+	// are ignored when exporting. Sending an `Accept` header of `text/csv` or `application/x-ndjson` has
+	// the same effect.
+	Format OptFormat `json:",omitempty,omitzero"`
 	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
 	Start OptDateTime `json:",omitempty,omitzero"`
 	// Period end date using fdate-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
 	End OptDateTime `json:",omitempty,omitzero"`
 	// Path of the page.
 	Path OptFilterString `json:",omitempty,omitzero"`
+	// Hostname or alias the page hit was received on.
+	OriginalHostname OptFilterString `json:",omitempty,omitzero"`
 	// Referrer URL of the page hit.
 	Referrer OptFilterString `json:",omitempty,omitzero"`
 	// UTM source of the page hit.
@@ -6278,19 +6451,15 @@ type GetWebsiteIDOsParams struct {
 	PropName OptFilterString `json:",omitempty,omitzero"`
 	// Value of the property.
 	PropValue OptFilterString `json:",omitempty,omitzero"`
-	// Limit the number of results.
-	Limit OptInt `json:",omitempty,omitzero"`
-	// Offset the results paired with the limit parameter.
-	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetWebsiteIDOsParams(packed middleware.Parameters) (params GetWebsiteIDOsParams) {
+func unpackGetWebsiteIDFunnelParams(packed middleware.Parameters) (params GetWebsiteIDFunnelParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "_me_sess",
-			In:   "cookie",
+			Name: "steps",
+			In:   "query",
 		}
-		params.MeSess = packed[key].(string)
+		params.Steps = packed[key].([]string)
 	}
 	{
 		key := middleware.ParameterKey{
@@ -6301,11 +6470,11 @@ func unpackGetWebsiteIDOsParams(packed middleware.Parameters) (params GetWebsite
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "summary",
+			Name: "format",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Summary = v.(OptBool)
+			params.Format = v.(OptFormat)
 		}
 	}
 	{
@@ -6335,6 +6504,15 @@ func unpackGetWebsiteIDOsParams(packed middleware.Parameters) (params GetWebsite
 			params.Path = v.(OptFilterString)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_hostname",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OriginalHostname = v.(OptFilterString)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "referrer",
@@ -6434,61 +6612,98 @@ func unpackGetWebsiteIDOsParams(packed middleware.Parameters) (params GetWebsite
 			params.PropValue = v.(OptFilterString)
 		}
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
-		}
-	}
 	return params
 }
 
-func decodeGetWebsiteIDOsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDOsParams, _ error) {
+func decodeGetWebsiteIDFunnelParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDFunnelParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	c := uri.NewCookieDecoder(r)
-	// Decode cookie: _me_sess.
+	// Decode query: steps.
 	if err := func() error {
-		cfg := uri.CookieParameterDecodingConfig{
-			Name:    "_me_sess",
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "steps",
+			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
-		if err := c.HasParam(cfg); err == nil {
-			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
 
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotStepsVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
 
-				params.MeSess = c
-				return nil
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotStepsVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Steps = append(params.Steps, paramsDotStepsVal)
+					return nil
+				})
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if params.Steps == nil {
+					return errors.New("nil is invalid value")
+				}
+				if err := (validate.Array{
+					MinLength:    1,
+					MinLengthSet: true,
+					MaxLength:    10,
+					MaxLengthSet: true,
+				}).ValidateLength(len(params.Steps)); err != nil {
+					return errors.Wrap(err, "array")
+				}
+				var failures []validate.FieldError
+				for i, elem := range params.Steps {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:     1,
+							MinLengthSet:  true,
+							MaxLength:     0,
+							MaxLengthSet:  false,
+							Email:         false,
+							Hostname:      false,
+							Regex:         nil,
+							MinNumeric:    0,
+							MinNumericSet: false,
+							MaxNumeric:    0,
+							MaxNumericSet: false,
+						}).Validate(string(elem)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		} else {
 			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "_me_sess",
-			In:   "cookie",
+			Name: "steps",
+			In:   "query",
 			Err:  err,
 		}
 	}
@@ -6557,48 +6772,58 @@ func decodeGetWebsiteIDOsParams(args [1]string, argsEscaped bool, r *http.Reques
 			Err:  err,
 		}
 	}
-	// Set default value for query: summary.
-	{
-		val := bool(false)
-		params.Summary.SetTo(val)
-	}
-	// Decode query: summary.
+	// Decode query: format.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "summary",
+			Name:    "format",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSummaryVal bool
+				var paramsDotFormatVal Format
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotSummaryVal = c
+					paramsDotFormatVal = Format(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Summary.SetTo(paramsDotSummaryVal)
+				params.Format.SetTo(paramsDotFormatVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "summary",
+			Name: "format",
 			In:   "query",
 			Err:  err,
 		}
@@ -6716,6 +6941,37 @@ func decodeGetWebsiteIDOsParams(args [1]string, argsEscaped bool, r *http.Reques
 			Err:  err,
 		}
 	}
+	// Decode query: original_hostname.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "original_hostname",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOriginalHostnameVal FilterString
+				if err := func() error {
+					return paramsDotOriginalHostnameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.OriginalHostname.SetTo(paramsDotOriginalHostnameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "original_hostname",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Decode query: referrer.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -7057,155 +7313,26 @@ func decodeGetWebsiteIDOsParams(args [1]string, argsEscaped bool, r *http.Reques
 			Err:  err,
 		}
 	}
-	// Decode query: limit.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           5000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: offset.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Offset.SetTo(paramsDotOffsetVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        true,
-							Max:           5000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
-			Err:  err,
-		}
-	}
 	return params, nil
 }
 
-// GetWebsiteIDPagesParams is parameters of get-website-id-pages operation.
-type GetWebsiteIDPagesParams struct {
-	// Session token for authentication.
-	MeSess string
+// GetWebsiteIDGoalsParams is parameters of get-website-id-goals operation.
+type GetWebsiteIDGoalsParams struct {
 	// Hostname for the website.
 	Hostname string
-	// Return a summary of the stats.
-	Summary OptBool `json:",omitempty,omitzero"`
+	// Export the full report as `csv` or newline delimited JSON (`ndjson`) instead of JSON. Rows are
+	// streamed as they are read and include every column, so the summary, limit and offset parameters
+	// are ignored when exporting. Sending an `Accept` header of `text/csv` or `application/x-ndjson` has
+	// the same effect.
+	Format OptFormat `json:",omitempty,omitzero"`
 	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
 	Start OptDateTime `json:",omitempty,omitzero"`
 	// Period end date using fdate-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
 	End OptDateTime `json:",omitempty,omitzero"`
 	// Path of the page.
 	Path OptFilterString `json:",omitempty,omitzero"`
+	// Hostname or alias the page hit was received on.
+	OriginalHostname OptFilterString `json:",omitempty,omitzero"`
 	// Referrer URL of the page hit.
 	Referrer OptFilterString `json:",omitempty,omitzero"`
 	// UTM source of the page hit.
@@ -7228,20 +7355,9 @@ type GetWebsiteIDPagesParams struct {
 	PropName OptFilterString `json:",omitempty,omitzero"`
 	// Value of the property.
 	PropValue OptFilterString `json:",omitempty,omitzero"`
-	// Limit the number of results.
-	Limit OptInt `json:",omitempty,omitzero"`
-	// Offset the results paired with the limit parameter.
-	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetWebsiteIDPagesParams(packed middleware.Parameters) (params GetWebsiteIDPagesParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "_me_sess",
-			In:   "cookie",
-		}
-		params.MeSess = packed[key].(string)
-	}
+func unpackGetWebsiteIDGoalsParams(packed middleware.Parameters) (params GetWebsiteIDGoalsParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "hostname",
@@ -7251,11 +7367,11 @@ func unpackGetWebsiteIDPagesParams(packed middleware.Parameters) (params GetWebs
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "summary",
+			Name: "format",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Summary = v.(OptBool)
+			params.Format = v.(OptFormat)
 		}
 	}
 	{
@@ -7285,6 +7401,15 @@ func unpackGetWebsiteIDPagesParams(packed middleware.Parameters) (params GetWebs
 			params.Path = v.(OptFilterString)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_hostname",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OriginalHostname = v.(OptFilterString)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "referrer",
@@ -7384,64 +7509,11 @@ func unpackGetWebsiteIDPagesParams(packed middleware.Parameters) (params GetWebs
 			params.PropValue = v.(OptFilterString)
 		}
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
-		}
-	}
 	return params
 }
 
-func decodeGetWebsiteIDPagesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDPagesParams, _ error) {
+func decodeGetWebsiteIDGoalsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDGoalsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	c := uri.NewCookieDecoder(r)
-	// Decode cookie: _me_sess.
-	if err := func() error {
-		cfg := uri.CookieParameterDecodingConfig{
-			Name:    "_me_sess",
-			Explode: true,
-		}
-		if err := c.HasParam(cfg); err == nil {
-			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.MeSess = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "_me_sess",
-			In:   "cookie",
-			Err:  err,
-		}
-	}
 	// Decode path: hostname.
 	if err := func() error {
 		param := args[0]
@@ -7507,48 +7579,58 @@ func decodeGetWebsiteIDPagesParams(args [1]string, argsEscaped bool, r *http.Req
 			Err:  err,
 		}
 	}
-	// Set default value for query: summary.
-	{
-		val := bool(false)
-		params.Summary.SetTo(val)
-	}
-	// Decode query: summary.
+	// Decode query: format.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "summary",
+			Name:    "format",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSummaryVal bool
+				var paramsDotFormatVal Format
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotSummaryVal = c
+					paramsDotFormatVal = Format(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Summary.SetTo(paramsDotSummaryVal)
+				params.Format.SetTo(paramsDotFormatVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "summary",
+			Name: "format",
 			In:   "query",
 			Err:  err,
 		}
@@ -7666,6 +7748,37 @@ func decodeGetWebsiteIDPagesParams(args [1]string, argsEscaped bool, r *http.Req
 			Err:  err,
 		}
 	}
+	// Decode query: original_hostname.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "original_hostname",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOriginalHostnameVal FilterString
+				if err := func() error {
+					return paramsDotOriginalHostnameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.OriginalHostname.SetTo(paramsDotOriginalHostnameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "original_hostname",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Decode query: referrer.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -8007,153 +8120,28 @@ func decodeGetWebsiteIDPagesParams(args [1]string, argsEscaped bool, r *http.Req
 			Err:  err,
 		}
 	}
-	// Decode query: limit.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           5000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: offset.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Offset.SetTo(paramsDotOffsetVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        true,
-							Max:           5000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
-			Err:  err,
-		}
-	}
 	return params, nil
 }
 
-// GetWebsiteIDPropertiesParams is parameters of get-website-id-properties operation.
-type GetWebsiteIDPropertiesParams struct {
-	// Session token for authentication.
-	MeSess string
+// GetWebsiteIDHostnamesParams is parameters of get-website-id-hostnames operation.
+type GetWebsiteIDHostnamesParams struct {
 	// Hostname for the website.
 	Hostname string
+	// Export the full report as `csv` or newline delimited JSON (`ndjson`) instead of JSON. Rows are
+	// streamed as they are read and include every column, so the summary, limit and offset parameters
+	// are ignored when exporting. Sending an `Accept` header of `text/csv` or `application/x-ndjson` has
+	// the same effect.
+	Format OptFormat `json:",omitempty,omitzero"`
+	// Return a summary of the stats.
+	Summary OptBool `json:",omitempty,omitzero"`
 	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
 	Start OptDateTime `json:",omitempty,omitzero"`
 	// Period end date using fdate-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
 	End OptDateTime `json:",omitempty,omitzero"`
 	// Path of the page.
 	Path OptFilterString `json:",omitempty,omitzero"`
+	// Hostname or alias the page hit was received on.
+	OriginalHostname OptFilterString `json:",omitempty,omitzero"`
 	// Referrer URL of the page hit.
 	Referrer OptFilterString `json:",omitempty,omitzero"`
 	// UTM source of the page hit.
@@ -8182,20 +8170,31 @@ type GetWebsiteIDPropertiesParams struct {
 	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetWebsiteIDPropertiesParams(packed middleware.Parameters) (params GetWebsiteIDPropertiesParams) {
+func unpackGetWebsiteIDHostnamesParams(packed middleware.Parameters) (params GetWebsiteIDHostnamesParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "_me_sess",
-			In:   "cookie",
+			Name: "hostname",
+			In:   "path",
 		}
-		params.MeSess = packed[key].(string)
+		params.Hostname = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "hostname",
-			In:   "path",
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptFormat)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "summary",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Summary = v.(OptBool)
 		}
-		params.Hostname = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
@@ -8224,6 +8223,15 @@ func unpackGetWebsiteIDPropertiesParams(packed middleware.Parameters) (params Ge
 			params.Path = v.(OptFilterString)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_hostname",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OriginalHostname = v.(OptFilterString)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "referrer",
@@ -8344,43 +8352,8 @@ func unpackGetWebsiteIDPropertiesParams(packed middleware.Parameters) (params Ge
 	return params
 }
 
-func decodeGetWebsiteIDPropertiesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDPropertiesParams, _ error) {
+func decodeGetWebsiteIDHostnamesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDHostnamesParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	c := uri.NewCookieDecoder(r)
-	// Decode cookie: _me_sess.
-	if err := func() error {
-		cfg := uri.CookieParameterDecodingConfig{
-			Name:    "_me_sess",
-			Explode: true,
-		}
-		if err := c.HasParam(cfg); err == nil {
-			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.MeSess = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "_me_sess",
-			In:   "cookie",
-			Err:  err,
-		}
-	}
 	// Decode path: hostname.
 	if err := func() error {
 		param := args[0]
@@ -8446,6 +8419,108 @@ func decodeGetWebsiteIDPropertiesParams(args [1]string, argsEscaped bool, r *htt
 			Err:  err,
 		}
 	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal Format
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = Format(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: summary.
+	{
+		val := bool(false)
+		params.Summary.SetTo(val)
+	}
+	// Decode query: summary.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "summary",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSummaryVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSummaryVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Summary.SetTo(paramsDotSummaryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "summary",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Decode query: start.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -8559,6 +8634,37 @@ func decodeGetWebsiteIDPropertiesParams(args [1]string, argsEscaped bool, r *htt
 			Err:  err,
 		}
 	}
+	// Decode query: original_hostname.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "original_hostname",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOriginalHostnameVal FilterString
+				if err := func() error {
+					return paramsDotOriginalHostnameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.OriginalHostname.SetTo(paramsDotOriginalHostnameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "original_hostname",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Decode query: referrer.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -9035,14 +9141,17 @@ func decodeGetWebsiteIDPropertiesParams(args [1]string, argsEscaped bool, r *htt
 	return params, nil
 }
 
-// GetWebsiteIDReferrersParams is parameters of get-website-id-referrers operation.
-type GetWebsiteIDReferrersParams struct {
-	// Whether to return the grouped aggregation name or only URLs.
-	Grouped OptBool `json:",omitempty,omitzero"`
-	// Session token for authentication.
-	MeSess string
+// GetWebsiteIDLanguageParams is parameters of get-website-id-language operation.
+type GetWebsiteIDLanguageParams struct {
+	// Whether to return the language name or the language dialect/locale.
+	Locale OptBool `json:",omitempty,omitzero"`
 	// Hostname for the website.
 	Hostname string
+	// Export the full report as `csv` or newline delimited JSON (`ndjson`) instead of JSON. Rows are
+	// streamed as they are read and include every column, so the summary, limit and offset parameters
+	// are ignored when exporting. Sending an `Accept` header of `text/csv` or `application/x-ndjson` has
+	// the same effect.
+	Format OptFormat `json:",omitempty,omitzero"`
 	// Return a summary of the stats.
 	Summary OptBool `json:",omitempty,omitzero"`
 	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
@@ -9051,6 +9160,8 @@ type GetWebsiteIDReferrersParams struct {
 	End OptDateTime `json:",omitempty,omitzero"`
 	// Path of the page.
 	Path OptFilterString `json:",omitempty,omitzero"`
+	// Hostname or alias the page hit was received on.
+	OriginalHostname OptFilterString `json:",omitempty,omitzero"`
 	// Referrer URL of the page hit.
 	Referrer OptFilterString `json:",omitempty,omitzero"`
 	// UTM source of the page hit.
@@ -9079,29 +9190,31 @@ type GetWebsiteIDReferrersParams struct {
 	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetWebsiteIDReferrersParams(packed middleware.Parameters) (params GetWebsiteIDReferrersParams) {
+func unpackGetWebsiteIDLanguageParams(packed middleware.Parameters) (params GetWebsiteIDLanguageParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "grouped",
+			Name: "locale",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Grouped = v.(OptBool)
+			params.Locale = v.(OptBool)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "_me_sess",
-			In:   "cookie",
+			Name: "hostname",
+			In:   "path",
 		}
-		params.MeSess = packed[key].(string)
+		params.Hostname = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "hostname",
-			In:   "path",
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptFormat)
 		}
-		params.Hostname = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
@@ -9139,6 +9252,15 @@ func unpackGetWebsiteIDReferrersParams(packed middleware.Parameters) (params Get
 			params.Path = v.(OptFilterString)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_hostname",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OriginalHostname = v.(OptFilterString)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "referrer",
@@ -9259,25 +9381,24 @@ func unpackGetWebsiteIDReferrersParams(packed middleware.Parameters) (params Get
 	return params
 }
 
-func decodeGetWebsiteIDReferrersParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDReferrersParams, _ error) {
+func decodeGetWebsiteIDLanguageParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDLanguageParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	c := uri.NewCookieDecoder(r)
-	// Set default value for query: grouped.
+	// Set default value for query: locale.
 	{
-		val := bool(true)
-		params.Grouped.SetTo(val)
+		val := bool(false)
+		params.Locale.SetTo(val)
 	}
-	// Decode query: grouped.
+	// Decode query: locale.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "grouped",
+			Name:    "locale",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotGroupedVal bool
+				var paramsDotLocaleVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -9289,12 +9410,12 @@ func decodeGetWebsiteIDReferrersParams(args [1]string, argsEscaped bool, r *http
 						return err
 					}
 
-					paramsDotGroupedVal = c
+					paramsDotLocaleVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Grouped.SetTo(paramsDotGroupedVal)
+				params.Locale.SetTo(paramsDotLocaleVal)
 				return nil
 			}); err != nil {
 				return err
@@ -9303,54 +9424,20 @@ func decodeGetWebsiteIDReferrersParams(args [1]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "grouped",
+			Name: "locale",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode cookie: _me_sess.
+	// Decode path: hostname.
 	if err := func() error {
-		cfg := uri.CookieParameterDecodingConfig{
-			Name:    "_me_sess",
-			Explode: true,
-		}
-		if err := c.HasParam(cfg); err == nil {
-			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.MeSess = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "_me_sess",
-			In:   "cookie",
-			Err:  err,
-		}
-	}
-	// Decode path: hostname.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
@@ -9407,6 +9494,62 @@ func decodeGetWebsiteIDReferrersParams(args [1]string, argsEscaped bool, r *http
 			Err:  err,
 		}
 	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal Format
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = Format(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Set default value for query: summary.
 	{
 		val := bool(false)
@@ -9566,6 +9709,37 @@ func decodeGetWebsiteIDReferrersParams(args [1]string, argsEscaped bool, r *http
 			Err:  err,
 		}
 	}
+	// Decode query: original_hostname.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "original_hostname",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOriginalHostnameVal FilterString
+				if err := func() error {
+					return paramsDotOriginalHostnameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.OriginalHostname.SetTo(paramsDotOriginalHostnameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "original_hostname",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Decode query: referrer.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -10042,12 +10216,15 @@ func decodeGetWebsiteIDReferrersParams(args [1]string, argsEscaped bool, r *http
 	return params, nil
 }
 
-// GetWebsiteIDSourcesParams is parameters of get-website-id-sources operation.
-type GetWebsiteIDSourcesParams struct {
-	// Session token for authentication.
-	MeSess string
+// GetWebsiteIDMediumsParams is parameters of get-website-id-mediums operation.
+type GetWebsiteIDMediumsParams struct {
 	// Hostname for the website.
 	Hostname string
+	// Export the full report as `csv` or newline delimited JSON (`ndjson`) instead of JSON. Rows are
+	// streamed as they are read and include every column, so the summary, limit and offset parameters
+	// are ignored when exporting. Sending an `Accept` header of `text/csv` or `application/x-ndjson` has
+	// the same effect.
+	Format OptFormat `json:",omitempty,omitzero"`
 	// Return a summary of the stats.
 	Summary OptBool `json:",omitempty,omitzero"`
 	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
@@ -10056,6 +10233,8 @@ type GetWebsiteIDSourcesParams struct {
 	End OptDateTime `json:",omitempty,omitzero"`
 	// Path of the page.
 	Path OptFilterString `json:",omitempty,omitzero"`
+	// Hostname or alias the page hit was received on.
+	OriginalHostname OptFilterString `json:",omitempty,omitzero"`
 	// Referrer URL of the page hit.
 	Referrer OptFilterString `json:",omitempty,omitzero"`
 	// UTM source of the page hit.
@@ -10084,20 +10263,22 @@ type GetWebsiteIDSourcesParams struct {
 	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetWebsiteIDSourcesParams(packed middleware.Parameters) (params GetWebsiteIDSourcesParams) {
+func unpackGetWebsiteIDMediumsParams(packed middleware.Parameters) (params GetWebsiteIDMediumsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "_me_sess",
-			In:   "cookie",
+			Name: "hostname",
+			In:   "path",
 		}
-		params.MeSess = packed[key].(string)
+		params.Hostname = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "hostname",
-			In:   "path",
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptFormat)
 		}
-		params.Hostname = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
@@ -10135,6 +10316,15 @@ func unpackGetWebsiteIDSourcesParams(packed middleware.Parameters) (params GetWe
 			params.Path = v.(OptFilterString)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_hostname",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OriginalHostname = v.(OptFilterString)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "referrer",
@@ -10255,43 +10445,8 @@ func unpackGetWebsiteIDSourcesParams(packed middleware.Parameters) (params GetWe
 	return params
 }
 
-func decodeGetWebsiteIDSourcesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDSourcesParams, _ error) {
+func decodeGetWebsiteIDMediumsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDMediumsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	c := uri.NewCookieDecoder(r)
-	// Decode cookie: _me_sess.
-	if err := func() error {
-		cfg := uri.CookieParameterDecodingConfig{
-			Name:    "_me_sess",
-			Explode: true,
-		}
-		if err := c.HasParam(cfg); err == nil {
-			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.MeSess = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "_me_sess",
-			In:   "cookie",
-			Err:  err,
-		}
-	}
 	// Decode path: hostname.
 	if err := func() error {
 		param := args[0]
@@ -10357,6 +10512,62 @@ func decodeGetWebsiteIDSourcesParams(args [1]string, argsEscaped bool, r *http.R
 			Err:  err,
 		}
 	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal Format
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = Format(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Set default value for query: summary.
 	{
 		val := bool(false)
@@ -10516,6 +10727,37 @@ func decodeGetWebsiteIDSourcesParams(args [1]string, argsEscaped bool, r *http.R
 			Err:  err,
 		}
 	}
+	// Decode query: original_hostname.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "original_hostname",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOriginalHostnameVal FilterString
+				if err := func() error {
+					return paramsDotOriginalHostnameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.OriginalHostname.SetTo(paramsDotOriginalHostnameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "original_hostname",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Decode query: referrer.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -10992,24 +11234,25 @@ func decodeGetWebsiteIDSourcesParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// GetWebsiteIDSummaryParams is parameters of get-website-id-summary operation.
-type GetWebsiteIDSummaryParams struct {
-	// Retrieve the data from the previous period as well. This is useful when comparing data from the
-	// previous period to the current period. Requires the start and end period parameters to be set.
-	Previous OptBool `json:",omitempty,omitzero"`
-	// The interval to group the data by. This can be set to minute, hour, day, week or month. This will
-	// return an interval property if set.
-	Interval OptGetWebsiteIDSummaryInterval `json:",omitempty,omitzero"`
-	// Session token for authentication.
-	MeSess string
+// GetWebsiteIDOsParams is parameters of get-website-id-os operation.
+type GetWebsiteIDOsParams struct {
 	// Hostname for the website.
 	Hostname string
+	// Export the full report as `csv` or newline delimited JSON (`ndjson`) instead of JSON. Rows are
+	// streamed as they are read and include every column, so the summary, limit and offset parameters
+	// are ignored when exporting. Sending an `Accept` header of `text/csv` or `application/x-ndjson` has
+	// the same effect.
+	Format OptFormat `json:",omitempty,omitzero"`
+	// Return a summary of the stats.
+	Summary OptBool `json:",omitempty,omitzero"`
 	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
 	Start OptDateTime `json:",omitempty,omitzero"`
 	// Period end date using fdate-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
 	End OptDateTime `json:",omitempty,omitzero"`
 	// Path of the page.
 	Path OptFilterString `json:",omitempty,omitzero"`
+	// Hostname or alias the page hit was received on.
+	OriginalHostname OptFilterString `json:",omitempty,omitzero"`
 	// Referrer URL of the page hit.
 	Referrer OptFilterString `json:",omitempty,omitzero"`
 	// UTM source of the page hit.
@@ -11032,40 +11275,37 @@ type GetWebsiteIDSummaryParams struct {
 	PropName OptFilterString `json:",omitempty,omitzero"`
 	// Value of the property.
 	PropValue OptFilterString `json:",omitempty,omitzero"`
+	// Limit the number of results.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Offset the results paired with the limit parameter.
+	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetWebsiteIDSummaryParams(packed middleware.Parameters) (params GetWebsiteIDSummaryParams) {
+func unpackGetWebsiteIDOsParams(packed middleware.Parameters) (params GetWebsiteIDOsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "previous",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Previous = v.(OptBool)
+			Name: "hostname",
+			In:   "path",
 		}
+		params.Hostname = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "interval",
+			Name: "format",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Interval = v.(OptGetWebsiteIDSummaryInterval)
+			params.Format = v.(OptFormat)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "_me_sess",
-			In:   "cookie",
+			Name: "summary",
+			In:   "query",
 		}
-		params.MeSess = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "hostname",
-			In:   "path",
+		if v, ok := packed[key]; ok {
+			params.Summary = v.(OptBool)
 		}
-		params.Hostname = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
@@ -11094,6 +11334,15 @@ func unpackGetWebsiteIDSummaryParams(packed middleware.Parameters) (params GetWe
 			params.Path = v.(OptFilterString)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_hostname",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OriginalHostname = v.(OptFilterString)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "referrer",
@@ -11193,69 +11442,105 @@ func unpackGetWebsiteIDSummaryParams(packed middleware.Parameters) (params GetWe
 			params.PropValue = v.(OptFilterString)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
 	return params
 }
 
-func decodeGetWebsiteIDSummaryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDSummaryParams, _ error) {
+func decodeGetWebsiteIDOsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDOsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	c := uri.NewCookieDecoder(r)
-	// Set default value for query: previous.
-	{
-		val := bool(false)
-		params.Previous.SetTo(val)
-	}
-	// Decode query: previous.
+	// Decode path: hostname.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "previous",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPreviousVal bool
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToBool(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotPreviousVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Previous.SetTo(paramsDotPreviousVal)
+
+				params.Hostname = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "previous",
-			In:   "query",
+			Name: "hostname",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: interval.
+	// Decode query: format.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "interval",
+			Name:    "format",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotIntervalVal GetWebsiteIDSummaryInterval
+				var paramsDotFormatVal Format
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -11267,18 +11552,18 @@ func decodeGetWebsiteIDSummaryParams(args [1]string, argsEscaped bool, r *http.R
 						return err
 					}
 
-					paramsDotIntervalVal = GetWebsiteIDSummaryInterval(c)
+					paramsDotFormatVal = Format(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Interval.SetTo(paramsDotIntervalVal)
+				params.Format.SetTo(paramsDotFormatVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Interval.Get(); ok {
+				if value, ok := params.Format.Get(); ok {
 					if err := func() error {
 						if err := value.Validate(); err != nil {
 							return err
@@ -11296,107 +11581,54 @@ func decodeGetWebsiteIDSummaryParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "interval",
+			Name: "format",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode cookie: _me_sess.
+	// Set default value for query: summary.
+	{
+		val := bool(false)
+		params.Summary.SetTo(val)
+	}
+	// Decode query: summary.
 	if err := func() error {
-		cfg := uri.CookieParameterDecodingConfig{
-			Name:    "_me_sess",
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "summary",
+			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
-		if err := c.HasParam(cfg); err == nil {
-			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
 
-				params.MeSess = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "_me_sess",
-			In:   "cookie",
-			Err:  err,
-		}
-	}
-	// Decode path: hostname.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "hostname",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSummaryVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					paramsDotSummaryVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Hostname = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := (validate.String{
-					MinLength:     1,
-					MinLengthSet:  true,
-					MaxLength:     253,
-					MaxLengthSet:  true,
-					Email:         false,
-					Hostname:      true,
-					Regex:         nil,
-					MinNumeric:    0,
-					MinNumericSet: false,
-					MaxNumeric:    0,
-					MaxNumericSet: false,
-				}).Validate(string(params.Hostname)); err != nil {
-					return errors.Wrap(err, "string")
-				}
+				params.Summary.SetTo(paramsDotSummaryVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "hostname",
-			In:   "path",
+			Name: "summary",
+			In:   "query",
 			Err:  err,
 		}
 	}
@@ -11513,10 +11745,41 @@ func decodeGetWebsiteIDSummaryParams(args [1]string, argsEscaped bool, r *http.R
 			Err:  err,
 		}
 	}
-	// Decode query: referrer.
+	// Decode query: original_hostname.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "referrer",
+			Name:    "original_hostname",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOriginalHostnameVal FilterString
+				if err := func() error {
+					return paramsDotOriginalHostnameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.OriginalHostname.SetTo(paramsDotOriginalHostnameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "original_hostname",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: referrer.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "referrer",
 			Style:   uri.QueryStyleDeepObject,
 			Explode: true,
 			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
@@ -11840,73 +12103,8799 @@ func decodeGetWebsiteIDSummaryParams(args [1]string, argsEscaped bool, r *http.R
 				}(); err != nil {
 					return err
 				}
-				params.PropValue.SetTo(paramsDotPropValueVal)
+				params.PropValue.SetTo(paramsDotPropValueVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "prop_value",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWebsiteIDPagesParams is parameters of get-website-id-pages operation.
+type GetWebsiteIDPagesParams struct {
+	// Hostname for the website.
+	Hostname string
+	// Export the full report as `csv` or newline delimited JSON (`ndjson`) instead of JSON. Rows are
+	// streamed as they are read and include every column, so the summary, limit and offset parameters
+	// are ignored when exporting. Sending an `Accept` header of `text/csv` or `application/x-ndjson` has
+	// the same effect.
+	Format OptFormat `json:",omitempty,omitzero"`
+	// Return a summary of the stats.
+	Summary OptBool `json:",omitempty,omitzero"`
+	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
+	Start OptDateTime `json:",omitempty,omitzero"`
+	// Period end date using fdate-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
+	End OptDateTime `json:",omitempty,omitzero"`
+	// Path of the page.
+	Path OptFilterString `json:",omitempty,omitzero"`
+	// Hostname or alias the page hit was received on.
+	OriginalHostname OptFilterString `json:",omitempty,omitzero"`
+	// Referrer URL of the page hit.
+	Referrer OptFilterString `json:",omitempty,omitzero"`
+	// UTM source of the page hit.
+	UtmSource OptFilterString `json:",omitempty,omitzero"`
+	// UTM medium of the page hit.
+	UtmMedium OptFilterString `json:",omitempty,omitzero"`
+	// UTM campaign of the page hit.
+	UtmCampaign OptFilterString `json:",omitempty,omitzero"`
+	// Browser name.
+	Browser OptFilterString `json:",omitempty,omitzero"`
+	// Operating system name.
+	Os OptFilterString `json:",omitempty,omitzero"`
+	// Device type.
+	Device OptFilterString `json:",omitempty,omitzero"`
+	// Country name.
+	Country OptFilterString `json:",omitempty,omitzero"`
+	// Language code.
+	Language OptFilterString `json:",omitempty,omitzero"`
+	// Name of the property.
+	PropName OptFilterString `json:",omitempty,omitzero"`
+	// Value of the property.
+	PropValue OptFilterString `json:",omitempty,omitzero"`
+	// Limit the number of results.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Offset the results paired with the limit parameter.
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetWebsiteIDPagesParams(packed middleware.Parameters) (params GetWebsiteIDPagesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
+		}
+		params.Hostname = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptFormat)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "summary",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Summary = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Start = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "end",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.End = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "path",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Path = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_hostname",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OriginalHostname = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "referrer",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Referrer = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_source",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmSource = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_medium",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmMedium = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_campaign",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmCampaign = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "browser",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Browser = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "os",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Os = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "device",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Device = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "country",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Country = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "language",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Language = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "prop_name",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PropName = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "prop_value",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PropValue = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetWebsiteIDPagesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDPagesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: hostname.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Hostname = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "hostname",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal Format
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = Format(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: summary.
+	{
+		val := bool(false)
+		params.Summary.SetTo(val)
+	}
+	// Decode query: summary.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "summary",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSummaryVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSummaryVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Summary.SetTo(paramsDotSummaryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "summary",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: start.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Start.SetTo(paramsDotStartVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: end.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "end",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEndVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEndVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.End.SetTo(paramsDotEndVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "end",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: path.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "path",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPathVal FilterString
+				if err := func() error {
+					return paramsDotPathVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Path.SetTo(paramsDotPathVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "path",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: original_hostname.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "original_hostname",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOriginalHostnameVal FilterString
+				if err := func() error {
+					return paramsDotOriginalHostnameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.OriginalHostname.SetTo(paramsDotOriginalHostnameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "original_hostname",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: referrer.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "referrer",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotReferrerVal FilterString
+				if err := func() error {
+					return paramsDotReferrerVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Referrer.SetTo(paramsDotReferrerVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "referrer",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_source.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_source",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmSourceVal FilterString
+				if err := func() error {
+					return paramsDotUtmSourceVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmSource.SetTo(paramsDotUtmSourceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_source",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_medium.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_medium",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmMediumVal FilterString
+				if err := func() error {
+					return paramsDotUtmMediumVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmMedium.SetTo(paramsDotUtmMediumVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_medium",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_campaign.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_campaign",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmCampaignVal FilterString
+				if err := func() error {
+					return paramsDotUtmCampaignVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmCampaign.SetTo(paramsDotUtmCampaignVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_campaign",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: browser.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "browser",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBrowserVal FilterString
+				if err := func() error {
+					return paramsDotBrowserVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Browser.SetTo(paramsDotBrowserVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "browser",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: os.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "os",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOsVal FilterString
+				if err := func() error {
+					return paramsDotOsVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Os.SetTo(paramsDotOsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "os",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: device.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "device",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDeviceVal FilterString
+				if err := func() error {
+					return paramsDotDeviceVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Device.SetTo(paramsDotDeviceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "device",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: country.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "country",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCountryVal FilterString
+				if err := func() error {
+					return paramsDotCountryVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Country.SetTo(paramsDotCountryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "country",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: language.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "language",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLanguageVal FilterString
+				if err := func() error {
+					return paramsDotLanguageVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Language.SetTo(paramsDotLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "language",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: prop_name.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "prop_name",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPropNameVal FilterString
+				if err := func() error {
+					return paramsDotPropNameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.PropName.SetTo(paramsDotPropNameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "prop_name",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: prop_value.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "prop_value",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPropValueVal FilterString
+				if err := func() error {
+					return paramsDotPropValueVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.PropValue.SetTo(paramsDotPropValueVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "prop_value",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWebsiteIDPagesEntryParams is parameters of get-website-id-pages-entry operation.
+type GetWebsiteIDPagesEntryParams struct {
+	// Hostname for the website.
+	Hostname string
+	// Export the full report as `csv` or newline delimited JSON (`ndjson`) instead of JSON. Rows are
+	// streamed as they are read and include every column, so the summary, limit and offset parameters
+	// are ignored when exporting. Sending an `Accept` header of `text/csv` or `application/x-ndjson` has
+	// the same effect.
+	Format OptFormat `json:",omitempty,omitzero"`
+	// Return a summary of the stats.
+	Summary OptBool `json:",omitempty,omitzero"`
+	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
+	Start OptDateTime `json:",omitempty,omitzero"`
+	// Period end date using fdate-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
+	End OptDateTime `json:",omitempty,omitzero"`
+	// Path of the page.
+	Path OptFilterString `json:",omitempty,omitzero"`
+	// Hostname or alias the page hit was received on.
+	OriginalHostname OptFilterString `json:",omitempty,omitzero"`
+	// Referrer URL of the page hit.
+	Referrer OptFilterString `json:",omitempty,omitzero"`
+	// UTM source of the page hit.
+	UtmSource OptFilterString `json:",omitempty,omitzero"`
+	// UTM medium of the page hit.
+	UtmMedium OptFilterString `json:",omitempty,omitzero"`
+	// UTM campaign of the page hit.
+	UtmCampaign OptFilterString `json:",omitempty,omitzero"`
+	// Browser name.
+	Browser OptFilterString `json:",omitempty,omitzero"`
+	// Operating system name.
+	Os OptFilterString `json:",omitempty,omitzero"`
+	// Device type.
+	Device OptFilterString `json:",omitempty,omitzero"`
+	// Country name.
+	Country OptFilterString `json:",omitempty,omitzero"`
+	// Language code.
+	Language OptFilterString `json:",omitempty,omitzero"`
+	// Name of the property.
+	PropName OptFilterString `json:",omitempty,omitzero"`
+	// Value of the property.
+	PropValue OptFilterString `json:",omitempty,omitzero"`
+	// Limit the number of results.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Offset the results paired with the limit parameter.
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetWebsiteIDPagesEntryParams(packed middleware.Parameters) (params GetWebsiteIDPagesEntryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
+		}
+		params.Hostname = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptFormat)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "summary",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Summary = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Start = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "end",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.End = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "path",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Path = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_hostname",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OriginalHostname = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "referrer",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Referrer = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_source",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmSource = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_medium",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmMedium = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_campaign",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmCampaign = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "browser",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Browser = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "os",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Os = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "device",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Device = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "country",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Country = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "language",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Language = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "prop_name",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PropName = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "prop_value",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PropValue = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetWebsiteIDPagesEntryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDPagesEntryParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: hostname.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Hostname = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "hostname",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal Format
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = Format(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: summary.
+	{
+		val := bool(false)
+		params.Summary.SetTo(val)
+	}
+	// Decode query: summary.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "summary",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSummaryVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSummaryVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Summary.SetTo(paramsDotSummaryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "summary",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: start.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Start.SetTo(paramsDotStartVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: end.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "end",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEndVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEndVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.End.SetTo(paramsDotEndVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "end",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: path.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "path",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPathVal FilterString
+				if err := func() error {
+					return paramsDotPathVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Path.SetTo(paramsDotPathVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "path",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: original_hostname.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "original_hostname",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOriginalHostnameVal FilterString
+				if err := func() error {
+					return paramsDotOriginalHostnameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.OriginalHostname.SetTo(paramsDotOriginalHostnameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "original_hostname",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: referrer.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "referrer",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotReferrerVal FilterString
+				if err := func() error {
+					return paramsDotReferrerVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Referrer.SetTo(paramsDotReferrerVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "referrer",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_source.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_source",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmSourceVal FilterString
+				if err := func() error {
+					return paramsDotUtmSourceVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmSource.SetTo(paramsDotUtmSourceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_source",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_medium.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_medium",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmMediumVal FilterString
+				if err := func() error {
+					return paramsDotUtmMediumVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmMedium.SetTo(paramsDotUtmMediumVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_medium",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_campaign.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_campaign",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmCampaignVal FilterString
+				if err := func() error {
+					return paramsDotUtmCampaignVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmCampaign.SetTo(paramsDotUtmCampaignVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_campaign",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: browser.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "browser",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBrowserVal FilterString
+				if err := func() error {
+					return paramsDotBrowserVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Browser.SetTo(paramsDotBrowserVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "browser",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: os.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "os",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOsVal FilterString
+				if err := func() error {
+					return paramsDotOsVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Os.SetTo(paramsDotOsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "os",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: device.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "device",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDeviceVal FilterString
+				if err := func() error {
+					return paramsDotDeviceVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Device.SetTo(paramsDotDeviceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "device",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: country.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "country",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCountryVal FilterString
+				if err := func() error {
+					return paramsDotCountryVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Country.SetTo(paramsDotCountryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "country",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: language.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "language",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLanguageVal FilterString
+				if err := func() error {
+					return paramsDotLanguageVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Language.SetTo(paramsDotLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "language",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: prop_name.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "prop_name",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPropNameVal FilterString
+				if err := func() error {
+					return paramsDotPropNameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.PropName.SetTo(paramsDotPropNameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "prop_name",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: prop_value.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "prop_value",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPropValueVal FilterString
+				if err := func() error {
+					return paramsDotPropValueVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.PropValue.SetTo(paramsDotPropValueVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "prop_value",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWebsiteIDPagesExitParams is parameters of get-website-id-pages-exit operation.
+type GetWebsiteIDPagesExitParams struct {
+	// Hostname for the website.
+	Hostname string
+	// Export the full report as `csv` or newline delimited JSON (`ndjson`) instead of JSON. Rows are
+	// streamed as they are read and include every column, so the summary, limit and offset parameters
+	// are ignored when exporting. Sending an `Accept` header of `text/csv` or `application/x-ndjson` has
+	// the same effect.
+	Format OptFormat `json:",omitempty,omitzero"`
+	// Return a summary of the stats.
+	Summary OptBool `json:",omitempty,omitzero"`
+	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
+	Start OptDateTime `json:",omitempty,omitzero"`
+	// Period end date using fdate-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
+	End OptDateTime `json:",omitempty,omitzero"`
+	// Path of the page.
+	Path OptFilterString `json:",omitempty,omitzero"`
+	// Hostname or alias the page hit was received on.
+	OriginalHostname OptFilterString `json:",omitempty,omitzero"`
+	// Referrer URL of the page hit.
+	Referrer OptFilterString `json:",omitempty,omitzero"`
+	// UTM source of the page hit.
+	UtmSource OptFilterString `json:",omitempty,omitzero"`
+	// UTM medium of the page hit.
+	UtmMedium OptFilterString `json:",omitempty,omitzero"`
+	// UTM campaign of the page hit.
+	UtmCampaign OptFilterString `json:",omitempty,omitzero"`
+	// Browser name.
+	Browser OptFilterString `json:",omitempty,omitzero"`
+	// Operating system name.
+	Os OptFilterString `json:",omitempty,omitzero"`
+	// Device type.
+	Device OptFilterString `json:",omitempty,omitzero"`
+	// Country name.
+	Country OptFilterString `json:",omitempty,omitzero"`
+	// Language code.
+	Language OptFilterString `json:",omitempty,omitzero"`
+	// Name of the property.
+	PropName OptFilterString `json:",omitempty,omitzero"`
+	// Value of the property.
+	PropValue OptFilterString `json:",omitempty,omitzero"`
+	// Limit the number of results.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Offset the results paired with the limit parameter.
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetWebsiteIDPagesExitParams(packed middleware.Parameters) (params GetWebsiteIDPagesExitParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
+		}
+		params.Hostname = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptFormat)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "summary",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Summary = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Start = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "end",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.End = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "path",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Path = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_hostname",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OriginalHostname = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "referrer",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Referrer = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_source",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmSource = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_medium",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmMedium = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_campaign",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmCampaign = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "browser",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Browser = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "os",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Os = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "device",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Device = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "country",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Country = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "language",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Language = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "prop_name",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PropName = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "prop_value",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PropValue = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetWebsiteIDPagesExitParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDPagesExitParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: hostname.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Hostname = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "hostname",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal Format
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = Format(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: summary.
+	{
+		val := bool(false)
+		params.Summary.SetTo(val)
+	}
+	// Decode query: summary.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "summary",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSummaryVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSummaryVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Summary.SetTo(paramsDotSummaryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "summary",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: start.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Start.SetTo(paramsDotStartVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: end.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "end",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEndVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEndVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.End.SetTo(paramsDotEndVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "end",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: path.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "path",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPathVal FilterString
+				if err := func() error {
+					return paramsDotPathVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Path.SetTo(paramsDotPathVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "path",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: original_hostname.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "original_hostname",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOriginalHostnameVal FilterString
+				if err := func() error {
+					return paramsDotOriginalHostnameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.OriginalHostname.SetTo(paramsDotOriginalHostnameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "original_hostname",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: referrer.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "referrer",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotReferrerVal FilterString
+				if err := func() error {
+					return paramsDotReferrerVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Referrer.SetTo(paramsDotReferrerVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "referrer",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_source.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_source",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmSourceVal FilterString
+				if err := func() error {
+					return paramsDotUtmSourceVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmSource.SetTo(paramsDotUtmSourceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_source",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_medium.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_medium",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmMediumVal FilterString
+				if err := func() error {
+					return paramsDotUtmMediumVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmMedium.SetTo(paramsDotUtmMediumVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_medium",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_campaign.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_campaign",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmCampaignVal FilterString
+				if err := func() error {
+					return paramsDotUtmCampaignVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmCampaign.SetTo(paramsDotUtmCampaignVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_campaign",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: browser.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "browser",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBrowserVal FilterString
+				if err := func() error {
+					return paramsDotBrowserVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Browser.SetTo(paramsDotBrowserVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "browser",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: os.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "os",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOsVal FilterString
+				if err := func() error {
+					return paramsDotOsVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Os.SetTo(paramsDotOsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "os",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: device.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "device",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDeviceVal FilterString
+				if err := func() error {
+					return paramsDotDeviceVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Device.SetTo(paramsDotDeviceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "device",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: country.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "country",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCountryVal FilterString
+				if err := func() error {
+					return paramsDotCountryVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Country.SetTo(paramsDotCountryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "country",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: language.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "language",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLanguageVal FilterString
+				if err := func() error {
+					return paramsDotLanguageVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Language.SetTo(paramsDotLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "language",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: prop_name.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "prop_name",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPropNameVal FilterString
+				if err := func() error {
+					return paramsDotPropNameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.PropName.SetTo(paramsDotPropNameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "prop_name",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: prop_value.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "prop_value",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPropValueVal FilterString
+				if err := func() error {
+					return paramsDotPropValueVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.PropValue.SetTo(paramsDotPropValueVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "prop_value",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWebsiteIDPropertiesParams is parameters of get-website-id-properties operation.
+type GetWebsiteIDPropertiesParams struct {
+	// Hostname for the website.
+	Hostname string
+	// Export the full report as `csv` or newline delimited JSON (`ndjson`) instead of JSON. Rows are
+	// streamed as they are read and include every column, so the summary, limit and offset parameters
+	// are ignored when exporting. Sending an `Accept` header of `text/csv` or `application/x-ndjson` has
+	// the same effect.
+	Format OptFormat `json:",omitempty,omitzero"`
+	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
+	Start OptDateTime `json:",omitempty,omitzero"`
+	// Period end date using fdate-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
+	End OptDateTime `json:",omitempty,omitzero"`
+	// Path of the page.
+	Path OptFilterString `json:",omitempty,omitzero"`
+	// Hostname or alias the page hit was received on.
+	OriginalHostname OptFilterString `json:",omitempty,omitzero"`
+	// Referrer URL of the page hit.
+	Referrer OptFilterString `json:",omitempty,omitzero"`
+	// UTM source of the page hit.
+	UtmSource OptFilterString `json:",omitempty,omitzero"`
+	// UTM medium of the page hit.
+	UtmMedium OptFilterString `json:",omitempty,omitzero"`
+	// UTM campaign of the page hit.
+	UtmCampaign OptFilterString `json:",omitempty,omitzero"`
+	// Browser name.
+	Browser OptFilterString `json:",omitempty,omitzero"`
+	// Operating system name.
+	Os OptFilterString `json:",omitempty,omitzero"`
+	// Device type.
+	Device OptFilterString `json:",omitempty,omitzero"`
+	// Country name.
+	Country OptFilterString `json:",omitempty,omitzero"`
+	// Language code.
+	Language OptFilterString `json:",omitempty,omitzero"`
+	// Name of the property.
+	PropName OptFilterString `json:",omitempty,omitzero"`
+	// Value of the property.
+	PropValue OptFilterString `json:",omitempty,omitzero"`
+	// Limit the number of results.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Offset the results paired with the limit parameter.
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetWebsiteIDPropertiesParams(packed middleware.Parameters) (params GetWebsiteIDPropertiesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
+		}
+		params.Hostname = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptFormat)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Start = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "end",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.End = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "path",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Path = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_hostname",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OriginalHostname = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "referrer",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Referrer = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_source",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmSource = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_medium",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmMedium = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_campaign",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmCampaign = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "browser",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Browser = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "os",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Os = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "device",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Device = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "country",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Country = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "language",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Language = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "prop_name",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PropName = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "prop_value",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PropValue = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetWebsiteIDPropertiesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDPropertiesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: hostname.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Hostname = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "hostname",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal Format
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = Format(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: start.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Start.SetTo(paramsDotStartVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: end.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "end",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEndVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEndVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.End.SetTo(paramsDotEndVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "end",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: path.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "path",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPathVal FilterString
+				if err := func() error {
+					return paramsDotPathVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Path.SetTo(paramsDotPathVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "path",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: original_hostname.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "original_hostname",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOriginalHostnameVal FilterString
+				if err := func() error {
+					return paramsDotOriginalHostnameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.OriginalHostname.SetTo(paramsDotOriginalHostnameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "original_hostname",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: referrer.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "referrer",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotReferrerVal FilterString
+				if err := func() error {
+					return paramsDotReferrerVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Referrer.SetTo(paramsDotReferrerVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "referrer",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_source.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_source",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmSourceVal FilterString
+				if err := func() error {
+					return paramsDotUtmSourceVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmSource.SetTo(paramsDotUtmSourceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_source",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_medium.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_medium",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmMediumVal FilterString
+				if err := func() error {
+					return paramsDotUtmMediumVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmMedium.SetTo(paramsDotUtmMediumVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_medium",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_campaign.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_campaign",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmCampaignVal FilterString
+				if err := func() error {
+					return paramsDotUtmCampaignVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmCampaign.SetTo(paramsDotUtmCampaignVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_campaign",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: browser.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "browser",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBrowserVal FilterString
+				if err := func() error {
+					return paramsDotBrowserVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Browser.SetTo(paramsDotBrowserVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "browser",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: os.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "os",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOsVal FilterString
+				if err := func() error {
+					return paramsDotOsVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Os.SetTo(paramsDotOsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "os",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: device.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "device",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDeviceVal FilterString
+				if err := func() error {
+					return paramsDotDeviceVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Device.SetTo(paramsDotDeviceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "device",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: country.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "country",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCountryVal FilterString
+				if err := func() error {
+					return paramsDotCountryVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Country.SetTo(paramsDotCountryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "country",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: language.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "language",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLanguageVal FilterString
+				if err := func() error {
+					return paramsDotLanguageVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Language.SetTo(paramsDotLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "language",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: prop_name.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "prop_name",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPropNameVal FilterString
+				if err := func() error {
+					return paramsDotPropNameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.PropName.SetTo(paramsDotPropNameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "prop_name",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: prop_value.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "prop_value",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPropValueVal FilterString
+				if err := func() error {
+					return paramsDotPropValueVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.PropValue.SetTo(paramsDotPropValueVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "prop_value",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWebsiteIDRealtimeParams is parameters of get-website-id-realtime operation.
+type GetWebsiteIDRealtimeParams struct {
+	// Hostname for the website.
+	Hostname string
+	// Number of minutes a visitor is considered active after their last page view.
+	Minutes OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetWebsiteIDRealtimeParams(packed middleware.Parameters) (params GetWebsiteIDRealtimeParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
+		}
+		params.Hostname = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "minutes",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Minutes = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetWebsiteIDRealtimeParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDRealtimeParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: hostname.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Hostname = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "hostname",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: minutes.
+	{
+		val := int(5)
+		params.Minutes.SetTo(val)
+	}
+	// Decode query: minutes.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "minutes",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMinutesVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMinutesVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Minutes.SetTo(paramsDotMinutesVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Minutes.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           30,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "minutes",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWebsiteIDReferrersParams is parameters of get-website-id-referrers operation.
+type GetWebsiteIDReferrersParams struct {
+	// Whether to return the grouped aggregation name or only URLs.
+	Grouped OptBool `json:",omitempty,omitzero"`
+	// Hostname for the website.
+	Hostname string
+	// Export the full report as `csv` or newline delimited JSON (`ndjson`) instead of JSON. Rows are
+	// streamed as they are read and include every column, so the summary, limit and offset parameters
+	// are ignored when exporting. Sending an `Accept` header of `text/csv` or `application/x-ndjson` has
+	// the same effect.
+	Format OptFormat `json:",omitempty,omitzero"`
+	// Return a summary of the stats.
+	Summary OptBool `json:",omitempty,omitzero"`
+	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
+	Start OptDateTime `json:",omitempty,omitzero"`
+	// Period end date using fdate-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
+	End OptDateTime `json:",omitempty,omitzero"`
+	// Path of the page.
+	Path OptFilterString `json:",omitempty,omitzero"`
+	// Hostname or alias the page hit was received on.
+	OriginalHostname OptFilterString `json:",omitempty,omitzero"`
+	// Referrer URL of the page hit.
+	Referrer OptFilterString `json:",omitempty,omitzero"`
+	// UTM source of the page hit.
+	UtmSource OptFilterString `json:",omitempty,omitzero"`
+	// UTM medium of the page hit.
+	UtmMedium OptFilterString `json:",omitempty,omitzero"`
+	// UTM campaign of the page hit.
+	UtmCampaign OptFilterString `json:",omitempty,omitzero"`
+	// Browser name.
+	Browser OptFilterString `json:",omitempty,omitzero"`
+	// Operating system name.
+	Os OptFilterString `json:",omitempty,omitzero"`
+	// Device type.
+	Device OptFilterString `json:",omitempty,omitzero"`
+	// Country name.
+	Country OptFilterString `json:",omitempty,omitzero"`
+	// Language code.
+	Language OptFilterString `json:",omitempty,omitzero"`
+	// Name of the property.
+	PropName OptFilterString `json:",omitempty,omitzero"`
+	// Value of the property.
+	PropValue OptFilterString `json:",omitempty,omitzero"`
+	// Limit the number of results.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Offset the results paired with the limit parameter.
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetWebsiteIDReferrersParams(packed middleware.Parameters) (params GetWebsiteIDReferrersParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "grouped",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Grouped = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
+		}
+		params.Hostname = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptFormat)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "summary",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Summary = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Start = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "end",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.End = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "path",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Path = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_hostname",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OriginalHostname = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "referrer",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Referrer = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_source",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmSource = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_medium",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmMedium = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_campaign",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmCampaign = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "browser",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Browser = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "os",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Os = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "device",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Device = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "country",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Country = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "language",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Language = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "prop_name",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PropName = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "prop_value",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PropValue = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetWebsiteIDReferrersParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDReferrersParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: grouped.
+	{
+		val := bool(true)
+		params.Grouped.SetTo(val)
+	}
+	// Decode query: grouped.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "grouped",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotGroupedVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotGroupedVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Grouped.SetTo(paramsDotGroupedVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "grouped",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode path: hostname.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Hostname = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "hostname",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal Format
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = Format(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: summary.
+	{
+		val := bool(false)
+		params.Summary.SetTo(val)
+	}
+	// Decode query: summary.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "summary",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSummaryVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSummaryVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Summary.SetTo(paramsDotSummaryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "summary",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: start.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Start.SetTo(paramsDotStartVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: end.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "end",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEndVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEndVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.End.SetTo(paramsDotEndVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "end",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: path.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "path",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPathVal FilterString
+				if err := func() error {
+					return paramsDotPathVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Path.SetTo(paramsDotPathVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "path",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: original_hostname.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "original_hostname",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOriginalHostnameVal FilterString
+				if err := func() error {
+					return paramsDotOriginalHostnameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.OriginalHostname.SetTo(paramsDotOriginalHostnameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "original_hostname",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: referrer.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "referrer",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotReferrerVal FilterString
+				if err := func() error {
+					return paramsDotReferrerVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Referrer.SetTo(paramsDotReferrerVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "referrer",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_source.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_source",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmSourceVal FilterString
+				if err := func() error {
+					return paramsDotUtmSourceVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmSource.SetTo(paramsDotUtmSourceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_source",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_medium.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_medium",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmMediumVal FilterString
+				if err := func() error {
+					return paramsDotUtmMediumVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmMedium.SetTo(paramsDotUtmMediumVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_medium",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_campaign.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_campaign",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmCampaignVal FilterString
+				if err := func() error {
+					return paramsDotUtmCampaignVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmCampaign.SetTo(paramsDotUtmCampaignVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_campaign",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: browser.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "browser",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBrowserVal FilterString
+				if err := func() error {
+					return paramsDotBrowserVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Browser.SetTo(paramsDotBrowserVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "browser",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: os.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "os",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOsVal FilterString
+				if err := func() error {
+					return paramsDotOsVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Os.SetTo(paramsDotOsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "os",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: device.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "device",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDeviceVal FilterString
+				if err := func() error {
+					return paramsDotDeviceVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Device.SetTo(paramsDotDeviceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "device",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: country.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "country",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCountryVal FilterString
+				if err := func() error {
+					return paramsDotCountryVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Country.SetTo(paramsDotCountryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "country",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: language.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "language",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLanguageVal FilterString
+				if err := func() error {
+					return paramsDotLanguageVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Language.SetTo(paramsDotLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "language",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: prop_name.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "prop_name",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPropNameVal FilterString
+				if err := func() error {
+					return paramsDotPropNameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.PropName.SetTo(paramsDotPropNameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "prop_name",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: prop_value.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "prop_value",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPropValueVal FilterString
+				if err := func() error {
+					return paramsDotPropValueVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.PropValue.SetTo(paramsDotPropValueVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "prop_value",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWebsiteIDSourcesParams is parameters of get-website-id-sources operation.
+type GetWebsiteIDSourcesParams struct {
+	// Hostname for the website.
+	Hostname string
+	// Export the full report as `csv` or newline delimited JSON (`ndjson`) instead of JSON. Rows are
+	// streamed as they are read and include every column, so the summary, limit and offset parameters
+	// are ignored when exporting. Sending an `Accept` header of `text/csv` or `application/x-ndjson` has
+	// the same effect.
+	Format OptFormat `json:",omitempty,omitzero"`
+	// Return a summary of the stats.
+	Summary OptBool `json:",omitempty,omitzero"`
+	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
+	Start OptDateTime `json:",omitempty,omitzero"`
+	// Period end date using fdate-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
+	End OptDateTime `json:",omitempty,omitzero"`
+	// Path of the page.
+	Path OptFilterString `json:",omitempty,omitzero"`
+	// Hostname or alias the page hit was received on.
+	OriginalHostname OptFilterString `json:",omitempty,omitzero"`
+	// Referrer URL of the page hit.
+	Referrer OptFilterString `json:",omitempty,omitzero"`
+	// UTM source of the page hit.
+	UtmSource OptFilterString `json:",omitempty,omitzero"`
+	// UTM medium of the page hit.
+	UtmMedium OptFilterString `json:",omitempty,omitzero"`
+	// UTM campaign of the page hit.
+	UtmCampaign OptFilterString `json:",omitempty,omitzero"`
+	// Browser name.
+	Browser OptFilterString `json:",omitempty,omitzero"`
+	// Operating system name.
+	Os OptFilterString `json:",omitempty,omitzero"`
+	// Device type.
+	Device OptFilterString `json:",omitempty,omitzero"`
+	// Country name.
+	Country OptFilterString `json:",omitempty,omitzero"`
+	// Language code.
+	Language OptFilterString `json:",omitempty,omitzero"`
+	// Name of the property.
+	PropName OptFilterString `json:",omitempty,omitzero"`
+	// Value of the property.
+	PropValue OptFilterString `json:",omitempty,omitzero"`
+	// Limit the number of results.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Offset the results paired with the limit parameter.
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetWebsiteIDSourcesParams(packed middleware.Parameters) (params GetWebsiteIDSourcesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
+		}
+		params.Hostname = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptFormat)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "summary",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Summary = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Start = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "end",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.End = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "path",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Path = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_hostname",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OriginalHostname = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "referrer",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Referrer = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_source",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmSource = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_medium",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmMedium = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_campaign",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmCampaign = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "browser",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Browser = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "os",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Os = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "device",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Device = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "country",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Country = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "language",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Language = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "prop_name",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PropName = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "prop_value",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PropValue = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetWebsiteIDSourcesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDSourcesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: hostname.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Hostname = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "hostname",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal Format
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = Format(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: summary.
+	{
+		val := bool(false)
+		params.Summary.SetTo(val)
+	}
+	// Decode query: summary.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "summary",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSummaryVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSummaryVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Summary.SetTo(paramsDotSummaryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "summary",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: start.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Start.SetTo(paramsDotStartVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: end.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "end",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEndVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEndVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.End.SetTo(paramsDotEndVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "end",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: path.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "path",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPathVal FilterString
+				if err := func() error {
+					return paramsDotPathVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Path.SetTo(paramsDotPathVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "path",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: original_hostname.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "original_hostname",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOriginalHostnameVal FilterString
+				if err := func() error {
+					return paramsDotOriginalHostnameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.OriginalHostname.SetTo(paramsDotOriginalHostnameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "original_hostname",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: referrer.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "referrer",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotReferrerVal FilterString
+				if err := func() error {
+					return paramsDotReferrerVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Referrer.SetTo(paramsDotReferrerVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "referrer",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_source.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_source",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmSourceVal FilterString
+				if err := func() error {
+					return paramsDotUtmSourceVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmSource.SetTo(paramsDotUtmSourceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_source",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_medium.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_medium",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmMediumVal FilterString
+				if err := func() error {
+					return paramsDotUtmMediumVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmMedium.SetTo(paramsDotUtmMediumVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_medium",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_campaign.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_campaign",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmCampaignVal FilterString
+				if err := func() error {
+					return paramsDotUtmCampaignVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmCampaign.SetTo(paramsDotUtmCampaignVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_campaign",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: browser.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "browser",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBrowserVal FilterString
+				if err := func() error {
+					return paramsDotBrowserVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Browser.SetTo(paramsDotBrowserVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "browser",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: os.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "os",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOsVal FilterString
+				if err := func() error {
+					return paramsDotOsVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Os.SetTo(paramsDotOsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "os",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: device.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "device",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDeviceVal FilterString
+				if err := func() error {
+					return paramsDotDeviceVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Device.SetTo(paramsDotDeviceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "device",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: country.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "country",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCountryVal FilterString
+				if err := func() error {
+					return paramsDotCountryVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Country.SetTo(paramsDotCountryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "country",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: language.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "language",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLanguageVal FilterString
+				if err := func() error {
+					return paramsDotLanguageVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Language.SetTo(paramsDotLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "language",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: prop_name.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "prop_name",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPropNameVal FilterString
+				if err := func() error {
+					return paramsDotPropNameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.PropName.SetTo(paramsDotPropNameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "prop_name",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: prop_value.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "prop_value",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPropValueVal FilterString
+				if err := func() error {
+					return paramsDotPropValueVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.PropValue.SetTo(paramsDotPropValueVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "prop_value",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWebsiteIDSummaryParams is parameters of get-website-id-summary operation.
+type GetWebsiteIDSummaryParams struct {
+	// Retrieve the data from the previous period as well. This is useful when comparing data from the
+	// previous period to the current period. Requires the start and end period parameters to be set.
+	Previous OptBool `json:",omitempty,omitzero"`
+	// The interval to group the data by. This can be set to minute, hour, day, week or month. This will
+	// return an interval property if set.
+	Interval OptGetWebsiteIDSummaryInterval `json:",omitempty,omitzero"`
+	// Flag the intervals that deviate from a baseline of the same intervals in the previous four weeks,
+	// such as the same weekday and hour. Requires the interval parameter to be set to minute, hour, day
+	// or week. This will return an anomalies property for each interval if set.
+	Anomalies OptBool `json:",omitempty,omitzero"`
+	// Hostname for the website.
+	Hostname string
+	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
+	Start OptDateTime `json:",omitempty,omitzero"`
+	// Period end date using fdate-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
+	End OptDateTime `json:",omitempty,omitzero"`
+	// Path of the page.
+	Path OptFilterString `json:",omitempty,omitzero"`
+	// Hostname or alias the page hit was received on.
+	OriginalHostname OptFilterString `json:",omitempty,omitzero"`
+	// Referrer URL of the page hit.
+	Referrer OptFilterString `json:",omitempty,omitzero"`
+	// UTM source of the page hit.
+	UtmSource OptFilterString `json:",omitempty,omitzero"`
+	// UTM medium of the page hit.
+	UtmMedium OptFilterString `json:",omitempty,omitzero"`
+	// UTM campaign of the page hit.
+	UtmCampaign OptFilterString `json:",omitempty,omitzero"`
+	// Browser name.
+	Browser OptFilterString `json:",omitempty,omitzero"`
+	// Operating system name.
+	Os OptFilterString `json:",omitempty,omitzero"`
+	// Device type.
+	Device OptFilterString `json:",omitempty,omitzero"`
+	// Country name.
+	Country OptFilterString `json:",omitempty,omitzero"`
+	// Language code.
+	Language OptFilterString `json:",omitempty,omitzero"`
+	// Name of the property.
+	PropName OptFilterString `json:",omitempty,omitzero"`
+	// Value of the property.
+	PropValue OptFilterString `json:",omitempty,omitzero"`
+}
+
+func unpackGetWebsiteIDSummaryParams(packed middleware.Parameters) (params GetWebsiteIDSummaryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "previous",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Previous = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "interval",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Interval = v.(OptGetWebsiteIDSummaryInterval)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "anomalies",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Anomalies = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
+		}
+		params.Hostname = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Start = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "end",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.End = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "path",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Path = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_hostname",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OriginalHostname = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "referrer",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Referrer = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_source",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmSource = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_medium",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmMedium = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_campaign",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmCampaign = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "browser",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Browser = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "os",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Os = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "device",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Device = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "country",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Country = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "language",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Language = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "prop_name",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PropName = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "prop_value",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PropValue = v.(OptFilterString)
+		}
+	}
+	return params
+}
+
+func decodeGetWebsiteIDSummaryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDSummaryParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: previous.
+	{
+		val := bool(false)
+		params.Previous.SetTo(val)
+	}
+	// Decode query: previous.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "previous",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPreviousVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPreviousVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Previous.SetTo(paramsDotPreviousVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "previous",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: interval.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "interval",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIntervalVal GetWebsiteIDSummaryInterval
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIntervalVal = GetWebsiteIDSummaryInterval(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Interval.SetTo(paramsDotIntervalVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Interval.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "interval",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: anomalies.
+	{
+		val := bool(false)
+		params.Anomalies.SetTo(val)
+	}
+	// Decode query: anomalies.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "anomalies",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAnomaliesVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAnomaliesVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Anomalies.SetTo(paramsDotAnomaliesVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "anomalies",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode path: hostname.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Hostname = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "hostname",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: start.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Start.SetTo(paramsDotStartVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: end.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "end",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEndVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEndVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.End.SetTo(paramsDotEndVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "end",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: path.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "path",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPathVal FilterString
+				if err := func() error {
+					return paramsDotPathVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Path.SetTo(paramsDotPathVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "path",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: original_hostname.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "original_hostname",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOriginalHostnameVal FilterString
+				if err := func() error {
+					return paramsDotOriginalHostnameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.OriginalHostname.SetTo(paramsDotOriginalHostnameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "original_hostname",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: referrer.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "referrer",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotReferrerVal FilterString
+				if err := func() error {
+					return paramsDotReferrerVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Referrer.SetTo(paramsDotReferrerVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "referrer",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_source.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_source",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmSourceVal FilterString
+				if err := func() error {
+					return paramsDotUtmSourceVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmSource.SetTo(paramsDotUtmSourceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_source",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_medium.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_medium",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmMediumVal FilterString
+				if err := func() error {
+					return paramsDotUtmMediumVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmMedium.SetTo(paramsDotUtmMediumVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_medium",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_campaign.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_campaign",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmCampaignVal FilterString
+				if err := func() error {
+					return paramsDotUtmCampaignVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmCampaign.SetTo(paramsDotUtmCampaignVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_campaign",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: browser.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "browser",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBrowserVal FilterString
+				if err := func() error {
+					return paramsDotBrowserVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Browser.SetTo(paramsDotBrowserVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "browser",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: os.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "os",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOsVal FilterString
+				if err := func() error {
+					return paramsDotOsVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Os.SetTo(paramsDotOsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "os",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: device.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "device",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDeviceVal FilterString
+				if err := func() error {
+					return paramsDotDeviceVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Device.SetTo(paramsDotDeviceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "device",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: country.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "country",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCountryVal FilterString
+				if err := func() error {
+					return paramsDotCountryVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Country.SetTo(paramsDotCountryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "country",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: language.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "language",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLanguageVal FilterString
+				if err := func() error {
+					return paramsDotLanguageVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Language.SetTo(paramsDotLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "language",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: prop_name.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "prop_name",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPropNameVal FilterString
+				if err := func() error {
+					return paramsDotPropNameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.PropName.SetTo(paramsDotPropNameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "prop_name",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: prop_value.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "prop_value",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPropValueVal FilterString
+				if err := func() error {
+					return paramsDotPropValueVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.PropValue.SetTo(paramsDotPropValueVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "prop_value",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWebsiteIDTimeParams is parameters of get-website-id-time operation.
+type GetWebsiteIDTimeParams struct {
+	// Hostname for the website.
+	Hostname string
+	// Export the full report as `csv` or newline delimited JSON (`ndjson`) instead of JSON. Rows are
+	// streamed as they are read and include every column, so the summary, limit and offset parameters
+	// are ignored when exporting. Sending an `Accept` header of `text/csv` or `application/x-ndjson` has
+	// the same effect.
+	Format OptFormat `json:",omitempty,omitzero"`
+	// Return a summary of the stats.
+	Summary OptBool `json:",omitempty,omitzero"`
+	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
+	Start OptDateTime `json:",omitempty,omitzero"`
+	// Period end date using fdate-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
+	End OptDateTime `json:",omitempty,omitzero"`
+	// Path of the page.
+	Path OptFilterString `json:",omitempty,omitzero"`
+	// Hostname or alias the page hit was received on.
+	OriginalHostname OptFilterString `json:",omitempty,omitzero"`
+	// Referrer URL of the page hit.
+	Referrer OptFilterString `json:",omitempty,omitzero"`
+	// UTM source of the page hit.
+	UtmSource OptFilterString `json:",omitempty,omitzero"`
+	// UTM medium of the page hit.
+	UtmMedium OptFilterString `json:",omitempty,omitzero"`
+	// UTM campaign of the page hit.
+	UtmCampaign OptFilterString `json:",omitempty,omitzero"`
+	// Browser name.
+	Browser OptFilterString `json:",omitempty,omitzero"`
+	// Operating system name.
+	Os OptFilterString `json:",omitempty,omitzero"`
+	// Device type.
+	Device OptFilterString `json:",omitempty,omitzero"`
+	// Country name.
+	Country OptFilterString `json:",omitempty,omitzero"`
+	// Language code.
+	Language OptFilterString `json:",omitempty,omitzero"`
+	// Name of the property.
+	PropName OptFilterString `json:",omitempty,omitzero"`
+	// Value of the property.
+	PropValue OptFilterString `json:",omitempty,omitzero"`
+	// Limit the number of results.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Offset the results paired with the limit parameter.
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetWebsiteIDTimeParams(packed middleware.Parameters) (params GetWebsiteIDTimeParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
+		}
+		params.Hostname = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptFormat)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "summary",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Summary = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Start = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "end",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.End = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "path",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Path = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "original_hostname",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OriginalHostname = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "referrer",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Referrer = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_source",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmSource = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_medium",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmMedium = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "utm_campaign",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UtmCampaign = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "browser",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Browser = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "os",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Os = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "device",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Device = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "country",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Country = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "language",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Language = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "prop_name",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PropName = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "prop_value",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PropValue = v.(OptFilterString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetWebsiteIDTimeParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDTimeParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: hostname.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Hostname = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "hostname",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal Format
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = Format(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: summary.
+	{
+		val := bool(false)
+		params.Summary.SetTo(val)
+	}
+	// Decode query: summary.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "summary",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSummaryVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSummaryVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Summary.SetTo(paramsDotSummaryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "summary",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: start.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Start.SetTo(paramsDotStartVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: end.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "end",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEndVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEndVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.End.SetTo(paramsDotEndVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "end",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: path.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "path",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPathVal FilterString
+				if err := func() error {
+					return paramsDotPathVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Path.SetTo(paramsDotPathVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "path",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: original_hostname.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "original_hostname",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOriginalHostnameVal FilterString
+				if err := func() error {
+					return paramsDotOriginalHostnameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.OriginalHostname.SetTo(paramsDotOriginalHostnameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "original_hostname",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: referrer.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "referrer",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotReferrerVal FilterString
+				if err := func() error {
+					return paramsDotReferrerVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Referrer.SetTo(paramsDotReferrerVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "referrer",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_source.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_source",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmSourceVal FilterString
+				if err := func() error {
+					return paramsDotUtmSourceVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmSource.SetTo(paramsDotUtmSourceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_source",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_medium.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_medium",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmMediumVal FilterString
+				if err := func() error {
+					return paramsDotUtmMediumVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmMedium.SetTo(paramsDotUtmMediumVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_medium",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: utm_campaign.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "utm_campaign",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUtmCampaignVal FilterString
+				if err := func() error {
+					return paramsDotUtmCampaignVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.UtmCampaign.SetTo(paramsDotUtmCampaignVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "utm_campaign",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: browser.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "browser",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBrowserVal FilterString
+				if err := func() error {
+					return paramsDotBrowserVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Browser.SetTo(paramsDotBrowserVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "browser",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: os.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "os",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOsVal FilterString
+				if err := func() error {
+					return paramsDotOsVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Os.SetTo(paramsDotOsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "os",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: device.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "device",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDeviceVal FilterString
+				if err := func() error {
+					return paramsDotDeviceVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Device.SetTo(paramsDotDeviceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "device",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: country.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "country",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCountryVal FilterString
+				if err := func() error {
+					return paramsDotCountryVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Country.SetTo(paramsDotCountryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "country",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: language.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "language",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLanguageVal FilterString
+				if err := func() error {
+					return paramsDotLanguageVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Language.SetTo(paramsDotLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "language",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: prop_name.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "prop_name",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPropNameVal FilterString
+				if err := func() error {
+					return paramsDotPropNameVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.PropName.SetTo(paramsDotPropNameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "prop_name",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: prop_value.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "prop_value",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPropValueVal FilterString
+				if err := func() error {
+					return paramsDotPropValueVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.PropValue.SetTo(paramsDotPropValueVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "prop_value",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWebsitesParams is parameters of get-websites operation.
+type GetWebsitesParams struct {
+	// Return a summary of the stats.
+	Summary OptBool `json:",omitempty,omitzero"`
+}
+
+func unpackGetWebsitesParams(packed middleware.Parameters) (params GetWebsitesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "summary",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Summary = v.(OptBool)
+		}
+	}
+	return params
+}
+
+func decodeGetWebsitesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetWebsitesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: summary.
+	{
+		val := bool(false)
+		params.Summary.SetTo(val)
+	}
+	// Decode query: summary.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "summary",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSummaryVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSummaryVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Summary.SetTo(paramsDotSummaryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "summary",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWebsitesIDParams is parameters of get-websites-id operation.
+type GetWebsitesIDParams struct {
+	// Hostname for the website.
+	Hostname string
+}
+
+func unpackGetWebsitesIDParams(packed middleware.Parameters) (params GetWebsitesIDParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
+		}
+		params.Hostname = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetWebsitesIDParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsitesIDParams, _ error) {
+	// Decode path: hostname.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Hostname = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "hostname",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWebsitesIDDeletionParams is parameters of get-websites-id-deletion operation.
+type GetWebsitesIDDeletionParams struct {
+	// Hostname for the website.
+	Hostname string
+}
+
+func unpackGetWebsitesIDDeletionParams(packed middleware.Parameters) (params GetWebsitesIDDeletionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
+		}
+		params.Hostname = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetWebsitesIDDeletionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsitesIDDeletionParams, _ error) {
+	// Decode path: hostname.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Hostname = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "hostname",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWebsitesIDGoalsParams is parameters of get-websites-id-goals operation.
+type GetWebsitesIDGoalsParams struct {
+	// Hostname for the website.
+	Hostname string
+}
+
+func unpackGetWebsitesIDGoalsParams(packed middleware.Parameters) (params GetWebsitesIDGoalsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
+		}
+		params.Hostname = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetWebsitesIDGoalsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsitesIDGoalsParams, _ error) {
+	// Decode path: hostname.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Hostname = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "hostname",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWebsitesIDWebhooksParams is parameters of get-websites-id-webhooks operation.
+type GetWebsitesIDWebhooksParams struct {
+	// Hostname for the website.
+	Hostname string
+}
+
+func unpackGetWebsitesIDWebhooksParams(packed middleware.Parameters) (params GetWebsitesIDWebhooksParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
+		}
+		params.Hostname = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetWebsitesIDWebhooksParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsitesIDWebhooksParams, _ error) {
+	// Decode path: hostname.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Hostname = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
+				}
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "prop_value",
-			In:   "query",
+			Name: "hostname",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetWebsiteIDTimeParams is parameters of get-website-id-time operation.
-type GetWebsiteIDTimeParams struct {
-	// Session token for authentication.
-	MeSess string
+// GetWebsitesIDWebhooksIDDeliveriesParams is parameters of get-websites-id-webhooks-id-deliveries operation.
+type GetWebsitesIDWebhooksIDDeliveriesParams struct {
 	// Hostname for the website.
 	Hostname string
-	// Return a summary of the stats.
-	Summary OptBool `json:",omitempty,omitzero"`
-	// Period start date using date-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
-	Start OptDateTime `json:",omitempty,omitzero"`
-	// Period end date using fdate-time notation in RFC3339 format, for example, (2017-07-21T17:32:28Z).
-	End OptDateTime `json:",omitempty,omitzero"`
-	// Path of the page.
-	Path OptFilterString `json:",omitempty,omitzero"`
-	// Referrer URL of the page hit.
-	Referrer OptFilterString `json:",omitempty,omitzero"`
-	// UTM source of the page hit.
-	UtmSource OptFilterString `json:",omitempty,omitzero"`
-	// UTM medium of the page hit.
-	UtmMedium OptFilterString `json:",omitempty,omitzero"`
-	// UTM campaign of the page hit.
-	UtmCampaign OptFilterString `json:",omitempty,omitzero"`
-	// Browser name.
-	Browser OptFilterString `json:",omitempty,omitzero"`
-	// Operating system name.
-	Os OptFilterString `json:",omitempty,omitzero"`
-	// Device type.
-	Device OptFilterString `json:",omitempty,omitzero"`
-	// Country name.
-	Country OptFilterString `json:",omitempty,omitzero"`
-	// Language code.
-	Language OptFilterString `json:",omitempty,omitzero"`
-	// Name of the property.
-	PropName OptFilterString `json:",omitempty,omitzero"`
-	// Value of the property.
-	PropValue OptFilterString `json:",omitempty,omitzero"`
+	// Webhook ID.
+	WebhookId string
 	// Limit the number of results.
 	Limit OptInt `json:",omitempty,omitzero"`
 	// Offset the results paired with the limit parameter.
 	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetWebsiteIDTimeParams(packed middleware.Parameters) (params GetWebsiteIDTimeParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "_me_sess",
-			In:   "cookie",
-		}
-		params.MeSess = packed[key].(string)
-	}
+func unpackGetWebsitesIDWebhooksIDDeliveriesParams(packed middleware.Parameters) (params GetWebsitesIDWebhooksIDDeliveriesParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "hostname",
@@ -11916,138 +20905,10 @@ func unpackGetWebsiteIDTimeParams(packed middleware.Parameters) (params GetWebsi
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "summary",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Summary = v.(OptBool)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "start",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Start = v.(OptDateTime)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "end",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.End = v.(OptDateTime)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "path",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Path = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "referrer",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Referrer = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "utm_source",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.UtmSource = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "utm_medium",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.UtmMedium = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "utm_campaign",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.UtmCampaign = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "browser",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Browser = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "os",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Os = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "device",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Device = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "country",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Country = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "language",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Language = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "prop_name",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.PropName = v.(OptFilterString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "prop_value",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.PropValue = v.(OptFilterString)
+			Name: "webhookId",
+			In:   "path",
 		}
+		params.WebhookId = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
@@ -12070,43 +20931,8 @@ func unpackGetWebsiteIDTimeParams(packed middleware.Parameters) (params GetWebsi
 	return params
 }
 
-func decodeGetWebsiteIDTimeParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsiteIDTimeParams, _ error) {
+func decodeGetWebsitesIDWebhooksIDDeliveriesParams(args [2]string, argsEscaped bool, r *http.Request) (params GetWebsitesIDWebhooksIDDeliveriesParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	c := uri.NewCookieDecoder(r)
-	// Decode cookie: _me_sess.
-	if err := func() error {
-		cfg := uri.CookieParameterDecodingConfig{
-			Name:    "_me_sess",
-			Explode: true,
-		}
-		if err := c.HasParam(cfg); err == nil {
-			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.MeSess = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "_me_sess",
-			In:   "cookie",
-			Err:  err,
-		}
-	}
 	// Decode path: hostname.
 	if err := func() error {
 		param := args[0]
@@ -12172,650 +20998,933 @@ func decodeGetWebsiteIDTimeParams(args [1]string, argsEscaped bool, r *http.Requ
 			Err:  err,
 		}
 	}
-	// Set default value for query: summary.
-	{
-		val := bool(false)
-		params.Summary.SetTo(val)
-	}
-	// Decode query: summary.
+	// Decode path: webhookId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "summary",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "webhookId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSummaryVal bool
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToBool(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotSummaryVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Summary.SetTo(paramsDotSummaryVal)
+
+				params.WebhookId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "summary",
-			In:   "query",
+			Name: "webhookId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: start.
+	// Decode query: limit.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "start",
+			Name:    "limit",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartVal time.Time
+				var paramsDotLimitVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToDateTime(val)
+					c, err := conv.ToInt(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotStartVal = c
+					paramsDotLimitVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Start.SetTo(paramsDotStartVal)
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "start",
+			Name: "limit",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: end.
+	// Decode query: offset.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "end",
+			Name:    "offset",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotEndVal time.Time
+				var paramsDotOffsetVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToDateTime(val)
+					c, err := conv.ToInt(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotEndVal = c
+					paramsDotOffsetVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.End.SetTo(paramsDotEndVal)
+				params.Offset.SetTo(paramsDotOffsetVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "end",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: path.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "path",
-			Style:   uri.QueryStyleDeepObject,
-			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPathVal FilterString
-				if err := func() error {
-					return paramsDotPathVal.DecodeURI(d)
-				}(); err != nil {
-					return err
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        true,
+							Max:           5000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
 				}
-				params.Path.SetTo(paramsDotPathVal)
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "path",
+			Name: "offset",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: referrer.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "referrer",
-			Style:   uri.QueryStyleDeepObject,
-			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotReferrerVal FilterString
-				if err := func() error {
-					return paramsDotReferrerVal.DecodeURI(d)
-				}(); err != nil {
-					return err
-				}
-				params.Referrer.SetTo(paramsDotReferrerVal)
-				return nil
-			}); err != nil {
-				return err
-			}
+// PatchReportsIDParams is parameters of patch-reports-id operation.
+type PatchReportsIDParams struct {
+	// Session token for authentication.
+	MeSess string
+	// Report subscription ID.
+	ReportId string
+}
+
+func unpackPatchReportsIDParams(packed middleware.Parameters) (params PatchReportsIDParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "_me_sess",
+			In:   "cookie",
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "referrer",
-			In:   "query",
-			Err:  err,
+		params.MeSess = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "reportId",
+			In:   "path",
 		}
+		params.ReportId = packed[key].(string)
 	}
-	// Decode query: utm_source.
+	return params
+}
+
+func decodePatchReportsIDParams(args [1]string, argsEscaped bool, r *http.Request) (params PatchReportsIDParams, _ error) {
+	c := uri.NewCookieDecoder(r)
+	// Decode cookie: _me_sess.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "utm_source",
-			Style:   uri.QueryStyleDeepObject,
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "_me_sess",
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUtmSourceVal FilterString
-				if err := func() error {
-					return paramsDotUtmSourceVal.DecodeURI(d)
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.UtmSource.SetTo(paramsDotUtmSourceVal)
+
+				params.MeSess = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "utm_source",
-			In:   "query",
+			Name: "_me_sess",
+			In:   "cookie",
 			Err:  err,
 		}
 	}
-	// Decode query: utm_medium.
+	// Decode path: reportId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "utm_medium",
-			Style:   uri.QueryStyleDeepObject,
-			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "reportId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUtmMediumVal FilterString
-				if err := func() error {
-					return paramsDotUtmMediumVal.DecodeURI(d)
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.UtmMedium.SetTo(paramsDotUtmMediumVal)
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ReportId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "utm_medium",
-			In:   "query",
+			Name: "reportId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: utm_campaign.
+	return params, nil
+}
+
+// PatchTenantSettingsParams is parameters of patch-tenant-settings operation.
+type PatchTenantSettingsParams struct {
+	// Session token for authentication.
+	MeSess string
+}
+
+func unpackPatchTenantSettingsParams(packed middleware.Parameters) (params PatchTenantSettingsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "_me_sess",
+			In:   "cookie",
+		}
+		params.MeSess = packed[key].(string)
+	}
+	return params
+}
+
+func decodePatchTenantSettingsParams(args [0]string, argsEscaped bool, r *http.Request) (params PatchTenantSettingsParams, _ error) {
+	c := uri.NewCookieDecoder(r)
+	// Decode cookie: _me_sess.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "utm_campaign",
-			Style:   uri.QueryStyleDeepObject,
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "_me_sess",
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUtmCampaignVal FilterString
-				if err := func() error {
-					return paramsDotUtmCampaignVal.DecodeURI(d)
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.UtmCampaign.SetTo(paramsDotUtmCampaignVal)
+
+				params.MeSess = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "utm_campaign",
-			In:   "query",
+			Name: "_me_sess",
+			In:   "cookie",
 			Err:  err,
 		}
 	}
-	// Decode query: browser.
+	return params, nil
+}
+
+// PatchUserParams is parameters of patch-user operation.
+type PatchUserParams struct {
+	// Session token for authentication.
+	MeSess string
+}
+
+func unpackPatchUserParams(packed middleware.Parameters) (params PatchUserParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "_me_sess",
+			In:   "cookie",
+		}
+		params.MeSess = packed[key].(string)
+	}
+	return params
+}
+
+func decodePatchUserParams(args [0]string, argsEscaped bool, r *http.Request) (params PatchUserParams, _ error) {
+	c := uri.NewCookieDecoder(r)
+	// Decode cookie: _me_sess.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "browser",
-			Style:   uri.QueryStyleDeepObject,
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "_me_sess",
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotBrowserVal FilterString
-				if err := func() error {
-					return paramsDotBrowserVal.DecodeURI(d)
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Browser.SetTo(paramsDotBrowserVal)
+
+				params.MeSess = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "browser",
-			In:   "query",
+			Name: "_me_sess",
+			In:   "cookie",
 			Err:  err,
 		}
 	}
-	// Decode query: os.
+	return params, nil
+}
+
+// PatchUsersIDParams is parameters of patch-users-id operation.
+type PatchUsersIDParams struct {
+	// Session token for authentication.
+	MeSess string
+	// User ID.
+	UserId string
+}
+
+func unpackPatchUsersIDParams(packed middleware.Parameters) (params PatchUsersIDParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "_me_sess",
+			In:   "cookie",
+		}
+		params.MeSess = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(string)
+	}
+	return params
+}
+
+func decodePatchUsersIDParams(args [1]string, argsEscaped bool, r *http.Request) (params PatchUsersIDParams, _ error) {
+	c := uri.NewCookieDecoder(r)
+	// Decode cookie: _me_sess.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "os",
-			Style:   uri.QueryStyleDeepObject,
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "_me_sess",
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOsVal FilterString
-				if err := func() error {
-					return paramsDotOsVal.DecodeURI(d)
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Os.SetTo(paramsDotOsVal)
+
+				params.MeSess = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "os",
-			In:   "query",
+			Name: "_me_sess",
+			In:   "cookie",
 			Err:  err,
 		}
 	}
-	// Decode query: device.
+	// Decode path: userId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "device",
-			Style:   uri.QueryStyleDeepObject,
-			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotDeviceVal FilterString
-				if err := func() error {
-					return paramsDotDeviceVal.DecodeURI(d)
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Device.SetTo(paramsDotDeviceVal)
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "device",
-			In:   "query",
+			Name: "userId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: country.
+	return params, nil
+}
+
+// PatchWebsitesIDParams is parameters of patch-websites-id operation.
+type PatchWebsitesIDParams struct {
+	// Hostname for the website.
+	Hostname string
+}
+
+func unpackPatchWebsitesIDParams(packed middleware.Parameters) (params PatchWebsitesIDParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
+		}
+		params.Hostname = packed[key].(string)
+	}
+	return params
+}
+
+func decodePatchWebsitesIDParams(args [1]string, argsEscaped bool, r *http.Request) (params PatchWebsitesIDParams, _ error) {
+	// Decode path: hostname.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "country",
-			Style:   uri.QueryStyleDeepObject,
-			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCountryVal FilterString
-				if err := func() error {
-					return paramsDotCountryVal.DecodeURI(d)
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Country.SetTo(paramsDotCountryVal)
+
+				params.Hostname = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
+				}
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "country",
-			In:   "query",
+			Name: "hostname",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: language.
+	return params, nil
+}
+
+// PatchWebsitesIDGoalsIDParams is parameters of patch-websites-id-goals-id operation.
+type PatchWebsitesIDGoalsIDParams struct {
+	// Hostname for the website.
+	Hostname string
+	// Goal ID.
+	GoalId string
+}
+
+func unpackPatchWebsitesIDGoalsIDParams(packed middleware.Parameters) (params PatchWebsitesIDGoalsIDParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
+		}
+		params.Hostname = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "goalId",
+			In:   "path",
+		}
+		params.GoalId = packed[key].(string)
+	}
+	return params
+}
+
+func decodePatchWebsitesIDGoalsIDParams(args [2]string, argsEscaped bool, r *http.Request) (params PatchWebsitesIDGoalsIDParams, _ error) {
+	// Decode path: hostname.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "language",
-			Style:   uri.QueryStyleDeepObject,
-			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLanguageVal FilterString
-				if err := func() error {
-					return paramsDotLanguageVal.DecodeURI(d)
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Language.SetTo(paramsDotLanguageVal)
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Hostname = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "language",
-			In:   "query",
+			Name: "hostname",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: prop_name.
+	// Decode path: goalId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "prop_name",
-			Style:   uri.QueryStyleDeepObject,
-			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "goalId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPropNameVal FilterString
-				if err := func() error {
-					return paramsDotPropNameVal.DecodeURI(d)
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.PropName.SetTo(paramsDotPropNameVal)
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.GoalId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "prop_name",
-			In:   "query",
+			Name: "goalId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: prop_value.
+	return params, nil
+}
+
+// PostAPIKeysParams is parameters of post-api-keys operation.
+type PostAPIKeysParams struct {
+	// Session token for authentication.
+	MeSess string
+}
+
+func unpackPostAPIKeysParams(packed middleware.Parameters) (params PostAPIKeysParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "_me_sess",
+			In:   "cookie",
+		}
+		params.MeSess = packed[key].(string)
+	}
+	return params
+}
+
+func decodePostAPIKeysParams(args [0]string, argsEscaped bool, r *http.Request) (params PostAPIKeysParams, _ error) {
+	c := uri.NewCookieDecoder(r)
+	// Decode cookie: _me_sess.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "prop_value",
-			Style:   uri.QueryStyleDeepObject,
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "_me_sess",
 			Explode: true,
-			Fields:  []uri.QueryParameterObjectField{{Name: "eq", Required: false}, {Name: "neq", Required: false}, {Name: "contains", Required: false}, {Name: "not_contains", Required: false}, {Name: "starts_with", Required: false}, {Name: "not_starts_with", Required: false}, {Name: "ends_with", Required: false}, {Name: "not_ends_with", Required: false}, {Name: "in", Required: false}, {Name: "not_in", Required: false}},
 		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPropValueVal FilterString
-				if err := func() error {
-					return paramsDotPropValueVal.DecodeURI(d)
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.PropValue.SetTo(paramsDotPropValueVal)
+
+				params.MeSess = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "prop_value",
-			In:   "query",
+			Name: "_me_sess",
+			In:   "cookie",
 			Err:  err,
 		}
 	}
-	// Decode query: limit.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+// PostAuthLoginParams is parameters of post-auth-login operation.
+type PostAuthLoginParams struct {
+	// Shown in the list of active sessions.
+	UserAgent OptString `json:",omitempty,omitzero"`
+}
 
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+func unpackPostAuthLoginParams(packed middleware.Parameters) (params PostAuthLoginParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "User-Agent",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.UserAgent = v.(OptString)
+		}
+	}
+	return params
+}
 
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           5000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
+func decodePostAuthLoginParams(args [0]string, argsEscaped bool, r *http.Request) (params PostAuthLoginParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: User-Agent.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "User-Agent",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUserAgentVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
 						return err
 					}
+
+					paramsDotUserAgentVal = c
+					return nil
+				}(); err != nil {
+					return err
 				}
+				params.UserAgent.SetTo(paramsDotUserAgentVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "User-Agent",
+			In:   "header",
 			Err:  err,
 		}
 	}
-	// Decode query: offset.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// PostAuthLoginTotpParams is parameters of post-auth-login-totp operation.
+type PostAuthLoginTotpParams struct {
+	// Shown in the list of active sessions.
+	UserAgent OptString `json:",omitempty,omitzero"`
+}
+
+func unpackPostAuthLoginTotpParams(packed middleware.Parameters) (params PostAuthLoginTotpParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "User-Agent",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.UserAgent = v.(OptString)
 		}
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
+func decodePostAuthLoginTotpParams(args [0]string, argsEscaped bool, r *http.Request) (params PostAuthLoginTotpParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: User-Agent.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "User-Agent",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUserAgentVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotOffsetVal = c
+					paramsDotUserAgentVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+				params.UserAgent.SetTo(paramsDotUserAgentVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        true,
-							Max:           5000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
+			Name: "User-Agent",
+			In:   "header",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetWebsitesParams is parameters of get-websites operation.
-type GetWebsitesParams struct {
+// PostAuthLogoutParams is parameters of post-auth-logout operation.
+type PostAuthLogoutParams struct {
 	// Session token for authentication.
 	MeSess string
-	// Return a summary of the stats.
-	Summary OptBool `json:",omitempty,omitzero"`
 }
 
-func unpackGetWebsitesParams(packed middleware.Parameters) (params GetWebsitesParams) {
+func unpackPostAuthLogoutParams(packed middleware.Parameters) (params PostAuthLogoutParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "_me_sess",
@@ -12823,20 +21932,10 @@ func unpackGetWebsitesParams(packed middleware.Parameters) (params GetWebsitesPa
 		}
 		params.MeSess = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "summary",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Summary = v.(OptBool)
-		}
-	}
 	return params
 }
 
-func decodeGetWebsitesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetWebsitesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
+func decodePostAuthLogoutParams(args [0]string, argsEscaped bool, r *http.Request) (params PostAuthLogoutParams, _ error) {
 	c := uri.NewCookieDecoder(r)
 	// Decode cookie: _me_sess.
 	if err := func() error {
@@ -12872,39 +21971,106 @@ func decodeGetWebsitesParams(args [0]string, argsEscaped bool, r *http.Request)
 			Err:  err,
 		}
 	}
-	// Set default value for query: summary.
+	return params, nil
+}
+
+// PostEventHitParams is parameters of post-event-hit operation.
+type PostEventHitParams struct {
+	// Used to infer user browser, OS and device.
+	UserAgent OptString `json:",omitempty,omitzero"`
+	// Used to infer user language.
+	AcceptLanguage OptString `json:",omitempty,omitzero"`
+}
+
+func unpackPostEventHitParams(packed middleware.Parameters) (params PostEventHitParams) {
 	{
-		val := bool(false)
-		params.Summary.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "User-Agent",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.UserAgent = v.(OptString)
+		}
 	}
-	// Decode query: summary.
+	{
+		key := middleware.ParameterKey{
+			Name: "Accept-Language",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.AcceptLanguage = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodePostEventHitParams(args [0]string, argsEscaped bool, r *http.Request) (params PostEventHitParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: User-Agent.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "summary",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "User-Agent",
+			Explode: false,
 		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUserAgentVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSummaryVal bool
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUserAgentVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.UserAgent.SetTo(paramsDotUserAgentVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "User-Agent",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode header: Accept-Language.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotSummaryVal = c
+					paramsDotAcceptLanguageVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Summary.SetTo(paramsDotSummaryVal)
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
 				return nil
 			}); err != nil {
 				return err
@@ -12913,23 +22079,21 @@ func decodeGetWebsitesParams(args [0]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "summary",
-			In:   "query",
+			Name: "Accept-Language",
+			In:   "header",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetWebsitesIDParams is parameters of get-websites-id operation.
-type GetWebsitesIDParams struct {
+// PostReportsParams is parameters of post-reports operation.
+type PostReportsParams struct {
 	// Session token for authentication.
 	MeSess string
-	// Hostname for the website.
-	Hostname string
 }
 
-func unpackGetWebsitesIDParams(packed middleware.Parameters) (params GetWebsitesIDParams) {
+func unpackPostReportsParams(packed middleware.Parameters) (params PostReportsParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "_me_sess",
@@ -12937,17 +22101,10 @@ func unpackGetWebsitesIDParams(packed middleware.Parameters) (params GetWebsites
 		}
 		params.MeSess = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "hostname",
-			In:   "path",
-		}
-		params.Hostname = packed[key].(string)
-	}
 	return params
 }
 
-func decodeGetWebsitesIDParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebsitesIDParams, _ error) {
+func decodePostReportsParams(args [0]string, argsEscaped bool, r *http.Request) (params PostReportsParams, _ error) {
 	c := uri.NewCookieDecoder(r)
 	// Decode cookie: _me_sess.
 	if err := func() error {
@@ -12983,25 +22140,36 @@ func decodeGetWebsitesIDParams(args [1]string, argsEscaped bool, r *http.Request
 			Err:  err,
 		}
 	}
-	// Decode path: hostname.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+	return params, nil
+}
+
+// PostUserTotpParams is parameters of post-user-totp operation.
+type PostUserTotpParams struct {
+	// Session token for authentication.
+	MeSess string
+}
+
+func unpackPostUserTotpParams(packed middleware.Parameters) (params PostUserTotpParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "_me_sess",
+			In:   "cookie",
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "hostname",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
+		params.MeSess = packed[key].(string)
+	}
+	return params
+}
 
-			if err := func() error {
+func decodePostUserTotpParams(args [0]string, argsEscaped bool, r *http.Request) (params PostUserTotpParams, _ error) {
+	c := uri.NewCookieDecoder(r)
+	// Decode cookie: _me_sess.
+	if err := func() error {
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "_me_sess",
+			Explode: true,
+		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -13012,52 +22180,32 @@ func decodeGetWebsitesIDParams(args [1]string, argsEscaped bool, r *http.Request
 					return err
 				}
 
-				params.Hostname = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := (validate.String{
-					MinLength:     1,
-					MinLengthSet:  true,
-					MaxLength:     253,
-					MaxLengthSet:  true,
-					Email:         false,
-					Hostname:      true,
-					Regex:         nil,
-					MinNumeric:    0,
-					MinNumericSet: false,
-					MaxNumeric:    0,
-					MaxNumericSet: false,
-				}).Validate(string(params.Hostname)); err != nil {
-					return errors.Wrap(err, "string")
-				}
+				params.MeSess = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
-			return validate.ErrFieldRequired
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "hostname",
-			In:   "path",
+			Name: "_me_sess",
+			In:   "cookie",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// PatchTenantSettingsParams is parameters of patch-tenant-settings operation.
-type PatchTenantSettingsParams struct {
+// PostUserTotpConfirmParams is parameters of post-user-totp-confirm operation.
+type PostUserTotpConfirmParams struct {
 	// Session token for authentication.
 	MeSess string
 }
 
-func unpackPatchTenantSettingsParams(packed middleware.Parameters) (params PatchTenantSettingsParams) {
+func unpackPostUserTotpConfirmParams(packed middleware.Parameters) (params PostUserTotpConfirmParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "_me_sess",
@@ -13068,7 +22216,7 @@ func unpackPatchTenantSettingsParams(packed middleware.Parameters) (params Patch
 	return params
 }
 
-func decodePatchTenantSettingsParams(args [0]string, argsEscaped bool, r *http.Request) (params PatchTenantSettingsParams, _ error) {
+func decodePostUserTotpConfirmParams(args [0]string, argsEscaped bool, r *http.Request) (params PostUserTotpConfirmParams, _ error) {
 	c := uri.NewCookieDecoder(r)
 	// Decode cookie: _me_sess.
 	if err := func() error {
@@ -13107,13 +22255,13 @@ func decodePatchTenantSettingsParams(args [0]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// PatchUserParams is parameters of patch-user operation.
-type PatchUserParams struct {
+// PostUserTotpDisableParams is parameters of post-user-totp-disable operation.
+type PostUserTotpDisableParams struct {
 	// Session token for authentication.
 	MeSess string
 }
 
-func unpackPatchUserParams(packed middleware.Parameters) (params PatchUserParams) {
+func unpackPostUserTotpDisableParams(packed middleware.Parameters) (params PostUserTotpDisableParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "_me_sess",
@@ -13124,7 +22272,7 @@ func unpackPatchUserParams(packed middleware.Parameters) (params PatchUserParams
 	return params
 }
 
-func decodePatchUserParams(args [0]string, argsEscaped bool, r *http.Request) (params PatchUserParams, _ error) {
+func decodePostUserTotpDisableParams(args [0]string, argsEscaped bool, r *http.Request) (params PostUserTotpDisableParams, _ error) {
 	c := uri.NewCookieDecoder(r)
 	// Decode cookie: _me_sess.
 	if err := func() error {
@@ -13163,15 +22311,13 @@ func decodePatchUserParams(args [0]string, argsEscaped bool, r *http.Request) (p
 	return params, nil
 }
 
-// PatchWebsitesIDParams is parameters of patch-websites-id operation.
-type PatchWebsitesIDParams struct {
+// PostUsersParams is parameters of post-users operation.
+type PostUsersParams struct {
 	// Session token for authentication.
 	MeSess string
-	// Hostname for the website.
-	Hostname string
 }
 
-func unpackPatchWebsitesIDParams(packed middleware.Parameters) (params PatchWebsitesIDParams) {
+func unpackPostUsersParams(packed middleware.Parameters) (params PostUsersParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "_me_sess",
@@ -13179,17 +22325,10 @@ func unpackPatchWebsitesIDParams(packed middleware.Parameters) (params PatchWebs
 		}
 		params.MeSess = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "hostname",
-			In:   "path",
-		}
-		params.Hostname = packed[key].(string)
-	}
 	return params
 }
 
-func decodePatchWebsitesIDParams(args [1]string, argsEscaped bool, r *http.Request) (params PatchWebsitesIDParams, _ error) {
+func decodePostUsersParams(args [0]string, argsEscaped bool, r *http.Request) (params PostUsersParams, _ error) {
 	c := uri.NewCookieDecoder(r)
 	// Decode cookie: _me_sess.
 	if err := func() error {
@@ -13225,6 +22364,27 @@ func decodePatchWebsitesIDParams(args [1]string, argsEscaped bool, r *http.Reque
 			Err:  err,
 		}
 	}
+	return params, nil
+}
+
+// PostWebsitesIDGoalsParams is parameters of post-websites-id-goals operation.
+type PostWebsitesIDGoalsParams struct {
+	// Hostname for the website.
+	Hostname string
+}
+
+func unpackPostWebsitesIDGoalsParams(packed middleware.Parameters) (params PostWebsitesIDGoalsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "hostname",
+			In:   "path",
+		}
+		params.Hostname = packed[key].(string)
+	}
+	return params
+}
+
+func decodePostWebsitesIDGoalsParams(args [1]string, argsEscaped bool, r *http.Request) (params PostWebsitesIDGoalsParams, _ error) {
 	// Decode path: hostname.
 	if err := func() error {
 		param := args[0]
@@ -13293,33 +22453,43 @@ func decodePatchWebsitesIDParams(args [1]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// PostAuthLogoutParams is parameters of post-auth-logout operation.
-type PostAuthLogoutParams struct {
-	// Session token for authentication.
-	MeSess string
+// PostWebsitesIDWebhooksParams is parameters of post-websites-id-webhooks operation.
+type PostWebsitesIDWebhooksParams struct {
+	// Hostname for the website.
+	Hostname string
 }
 
-func unpackPostAuthLogoutParams(packed middleware.Parameters) (params PostAuthLogoutParams) {
+func unpackPostWebsitesIDWebhooksParams(packed middleware.Parameters) (params PostWebsitesIDWebhooksParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "_me_sess",
-			In:   "cookie",
+			Name: "hostname",
+			In:   "path",
 		}
-		params.MeSess = packed[key].(string)
+		params.Hostname = packed[key].(string)
 	}
 	return params
 }
 
-func decodePostAuthLogoutParams(args [0]string, argsEscaped bool, r *http.Request) (params PostAuthLogoutParams, _ error) {
-	c := uri.NewCookieDecoder(r)
-	// Decode cookie: _me_sess.
+func decodePostWebsitesIDWebhooksParams(args [1]string, argsEscaped bool, r *http.Request) (params PostWebsitesIDWebhooksParams, _ error) {
+	// Decode path: hostname.
 	if err := func() error {
-		cfg := uri.CookieParameterDecodingConfig{
-			Name:    "_me_sess",
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
-		if err := c.HasParam(cfg); err == nil {
-			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "hostname",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -13330,132 +22500,39 @@ func decodePostAuthLogoutParams(args [0]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.MeSess = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "_me_sess",
-			In:   "cookie",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// PostEventHitParams is parameters of post-event-hit operation.
-type PostEventHitParams struct {
-	// Used to infer user browser, OS and device.
-	UserAgent OptString `json:",omitempty,omitzero"`
-	// Used to infer user language.
-	AcceptLanguage OptString `json:",omitempty,omitzero"`
-}
-
-func unpackPostEventHitParams(packed middleware.Parameters) (params PostEventHitParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "User-Agent",
-			In:   "header",
-		}
-		if v, ok := packed[key]; ok {
-			params.UserAgent = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "Accept-Language",
-			In:   "header",
-		}
-		if v, ok := packed[key]; ok {
-			params.AcceptLanguage = v.(OptString)
-		}
-	}
-	return params
-}
-
-func decodePostEventHitParams(args [0]string, argsEscaped bool, r *http.Request) (params PostEventHitParams, _ error) {
-	h := uri.NewHeaderDecoder(r.Header)
-	// Decode header: User-Agent.
-	if err := func() error {
-		cfg := uri.HeaderParameterDecodingConfig{
-			Name:    "User-Agent",
-			Explode: false,
-		}
-		if err := h.HasParam(cfg); err == nil {
-			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUserAgentVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotUserAgentVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.UserAgent.SetTo(paramsDotUserAgentVal)
+				params.Hostname = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "User-Agent",
-			In:   "header",
-			Err:  err,
-		}
-	}
-	// Decode header: Accept-Language.
-	if err := func() error {
-		cfg := uri.HeaderParameterDecodingConfig{
-			Name:    "Accept-Language",
-			Explode: false,
-		}
-		if err := h.HasParam(cfg); err == nil {
-			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAcceptLanguageVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotAcceptLanguageVal = c
-					return nil
-				}(); err != nil {
-					return err
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     253,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      true,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Hostname)); err != nil {
+					return errors.Wrap(err, "string")
 				}
-				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "Accept-Language",
-			In:   "header",
+			Name: "hostname",
+			In:   "path",
 			Err:  err,
 		}
 	}
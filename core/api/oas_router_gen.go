@@ -11,27 +11,135 @@ import (
 )
 
 var (
-	rn29AllowedHeaders = map[string]string{
+	rn19AllowedHeaders = map[string]string{
 		"POST": "Content-Type",
 	}
-	rn33AllowedHeaders = map[string]string{
+	rn67AllowedHeaders = map[string]string{
+		"POST": "Content-Type,User-Agent",
+	}
+	rn68AllowedHeaders = map[string]string{
+		"POST": "Content-Type,User-Agent",
+	}
+	rn24AllowedHeaders = map[string]string{
+		"GET": "User-Agent",
+	}
+	rn72AllowedHeaders = map[string]string{
 		"POST": "Accept-Language,Content-Type,User-Agent",
 	}
-	rn5AllowedHeaders = map[string]string{
+	rn27AllowedHeaders = map[string]string{
 		"GET": "If-Modified-Since",
 	}
-	rn6AllowedHeaders = map[string]string{
+	rn28AllowedHeaders = map[string]string{
+		"POST": "Content-Type",
+	}
+	rn7AllowedHeaders = map[string]string{
+		"PATCH": "Content-Type",
+	}
+	rn29AllowedHeaders = map[string]string{
 		"PATCH": "Content-Type",
 	}
-	rn1AllowedHeaders = map[string]string{
+	rn8AllowedHeaders = map[string]string{
 		"PATCH": "Content-Type",
 	}
-	rn28AllowedHeaders = map[string]string{
+	rn74AllowedHeaders = map[string]string{
+		"POST": "Content-Type",
+	}
+	rn76AllowedHeaders = map[string]string{
+		"POST": "Content-Type",
+	}
+	rn32AllowedHeaders = map[string]string{
 		"POST": "Content-Type",
 	}
-	rn4AllowedHeaders = map[string]string{
+	rn11AllowedHeaders = map[string]string{
 		"PATCH": "Content-Type",
 	}
+	rn36AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn38AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn40AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn41AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn42AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn43AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn44AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn45AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn46AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn47AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn48AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn49AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn51AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn53AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn54AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn56AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn57AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn59AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn60AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn61AllowedHeaders = map[string]string{
+		"GET":  "Authorization",
+		"POST": "Authorization,Content-Type",
+	}
+	rn13AllowedHeaders = map[string]string{
+		"DELETE": "Authorization",
+		"GET":    "Authorization",
+		"PATCH":  "Authorization,Content-Type",
+	}
+	rn62AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn63AllowedHeaders = map[string]string{
+		"GET":  "Authorization",
+		"POST": "Authorization,Content-Type",
+	}
+	rn15AllowedHeaders = map[string]string{
+		"DELETE": "Authorization",
+		"PATCH":  "Authorization,Content-Type",
+	}
+	rn64AllowedHeaders = map[string]string{
+		"GET":  "Authorization",
+		"POST": "Authorization,Content-Type",
+	}
+	rn18AllowedHeaders = map[string]string{
+		"DELETE": "Authorization",
+	}
+	rn65AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
 )
 
 func (s *Server) cutPrefix(path string) (string, bool) {
@@ -64,7 +172,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
-	args := [1]string{}
+	args := [2]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -85,9 +193,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
-			case 'a': // Prefix: "auth/log"
+			case 'a': // Prefix: "a"
 
-				if l := len("auth/log"); len(elem) >= l && elem[0:l] == "auth/log" {
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
 					elem = elem[l:]
 				} else {
 					break
@@ -97,23 +205,24 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 'i': // Prefix: "in"
+				case 'p': // Prefix: "pi-keys"
 
-					if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+					if l := len("pi-keys"); len(elem) >= l && elem[0:l] == "pi-keys" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch r.Method {
+						case "GET":
+							s.handleGetAPIKeysRequest([0]string{}, elemIsEscaped, w, r)
 						case "POST":
-							s.handlePostAuthLoginRequest([0]string{}, elemIsEscaped, w, r)
+							s.handlePostAPIKeysRequest([0]string{}, elemIsEscaped, w, r)
 						default:
 							s.notAllowed(w, r, notAllowedParams{
-								allowedMethods: "POST",
-								allowedHeaders: rn29AllowedHeaders,
+								allowedMethods: "GET,POST",
+								allowedHeaders: rn19AllowedHeaders,
 								acceptPost:     "application/json",
 								acceptPatch:    "",
 							})
@@ -121,228 +230,228 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						return
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
 
-				case 'o': // Prefix: "out"
-
-					if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "POST":
-							s.handlePostAuthLogoutRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, notAllowedParams{
-								allowedMethods: "POST",
-								allowedHeaders: nil,
-								acceptPost:     "",
-								acceptPatch:    "",
-							})
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
 						}
 
-						return
-					}
+						// Param: "keyId"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
 
-				}
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleDeleteAPIKeysIDRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "DELETE",
+									allowedHeaders: nil,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
 
-			case 'e': // Prefix: "event/"
+							return
+						}
 
-				if l := len("event/"); len(elem) >= l && elem[0:l] == "event/" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+					}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'h': // Prefix: "hit"
+				case 'u': // Prefix: "u"
 
-					if l := len("hit"); len(elem) >= l && elem[0:l] == "hit" {
+					if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "POST":
-							s.handlePostEventHitRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, notAllowedParams{
-								allowedMethods: "POST",
-								allowedHeaders: rn33AllowedHeaders,
-								acceptPost:     "application/json",
-								acceptPatch:    "",
-							})
-						}
-
-						return
-					}
-
-				case 'p': // Prefix: "ping"
-
-					if l := len("ping"); len(elem) >= l && elem[0:l] == "ping" {
-						elem = elem[l:]
-					} else {
 						break
 					}
+					switch elem[0] {
+					case 'd': // Prefix: "dit"
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleGetEventPingRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, notAllowedParams{
-								allowedMethods: "GET",
-								allowedHeaders: rn5AllowedHeaders,
-								acceptPost:     "",
-								acceptPatch:    "",
-							})
+						if l := len("dit"); len(elem) >= l && elem[0:l] == "dit" {
+							elem = elem[l:]
+						} else {
+							break
 						}
 
-						return
-					}
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetAuditRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: nil,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
 
-				}
+							return
+						}
 
-			case 't': // Prefix: "tenant/settings"
+					case 't': // Prefix: "th/"
 
-				if l := len("tenant/settings"); len(elem) >= l && elem[0:l] == "tenant/settings" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+						if l := len("th/"); len(elem) >= l && elem[0:l] == "th/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch r.Method {
-					case "GET":
-						s.handleGetTenantSettingsRequest([0]string{}, elemIsEscaped, w, r)
-					case "PATCH":
-						s.handlePatchTenantSettingsRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, notAllowedParams{
-							allowedMethods: "GET,PATCH",
-							allowedHeaders: rn6AllowedHeaders,
-							acceptPost:     "",
-							acceptPatch:    "application/json",
-						})
-					}
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'l': // Prefix: "lo"
 
-					return
-				}
+							if l := len("lo"); len(elem) >= l && elem[0:l] == "lo" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-			case 'u': // Prefix: "user"
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "ckouts"
 
-				if l := len("user"); len(elem) >= l && elem[0:l] == "user" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+								if l := len("ckouts"); len(elem) >= l && elem[0:l] == "ckouts" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-				if len(elem) == 0 {
-					switch r.Method {
-					case "DELETE":
-						s.handleDeleteUserRequest([0]string{}, elemIsEscaped, w, r)
-					case "GET":
-						s.handleGetUserRequest([0]string{}, elemIsEscaped, w, r)
-					case "PATCH":
-						s.handlePatchUserRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, notAllowedParams{
-							allowedMethods: "DELETE,GET,PATCH",
-							allowedHeaders: rn1AllowedHeaders,
-							acceptPost:     "",
-							acceptPatch:    "application/json",
-						})
-					}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleDeleteAuthLockoutsRequest([0]string{}, elemIsEscaped, w, r)
+									case "GET":
+										s.handleGetAuthLockoutsRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "DELETE,GET",
+											allowedHeaders: nil,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
 
-					return
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/usage"
+									return
+								}
 
-					if l := len("/usage"); len(elem) >= l && elem[0:l] == "/usage" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+							case 'g': // Prefix: "g"
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleGetUserUsageRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, notAllowedParams{
-								allowedMethods: "GET",
-								allowedHeaders: nil,
-								acceptPost:     "",
-								acceptPatch:    "",
-							})
-						}
+								if l := len("g"); len(elem) >= l && elem[0:l] == "g" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-						return
-					}
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'i': // Prefix: "in"
 
-				}
+									if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-			case 'w': // Prefix: "website"
+									if len(elem) == 0 {
+										switch r.Method {
+										case "POST":
+											s.handlePostAuthLoginRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, notAllowedParams{
+												allowedMethods: "POST",
+												allowedHeaders: rn67AllowedHeaders,
+												acceptPost:     "application/json",
+												acceptPatch:    "",
+											})
+										}
 
-				if l := len("website"); len(elem) >= l && elem[0:l] == "website" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/totp"
+
+										if l := len("/totp"); len(elem) >= l && elem[0:l] == "/totp" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handlePostAuthLoginTotpRequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, notAllowedParams{
+													allowedMethods: "POST",
+													allowedHeaders: rn68AllowedHeaders,
+													acceptPost:     "application/json",
+													acceptPatch:    "",
+												})
+											}
+
+											return
+										}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
+									}
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+								case 'o': // Prefix: "out"
 
-					// Param: "hostname"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
+									if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handlePostAuthLogoutRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, notAllowedParams{
+												allowedMethods: "POST",
+												allowedHeaders: nil,
+												acceptPost:     "",
+												acceptPatch:    "",
+											})
+										}
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+										return
+									}
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'b': // Prefix: "browsers"
+								}
 
-							if l := len("browsers"); len(elem) >= l && elem[0:l] == "browsers" {
+							}
+
+						case 'm': // Prefix: "methods"
+
+							if l := len("methods"); len(elem) >= l && elem[0:l] == "methods" {
 								elem = elem[l:]
 							} else {
 								break
@@ -352,9 +461,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleGetWebsiteIDBrowsersRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
+									s.handleGetAuthMethodsRequest([0]string{}, elemIsEscaped, w, r)
 								default:
 									s.notAllowed(w, r, notAllowedParams{
 										allowedMethods: "GET",
@@ -367,9 +474,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								return
 							}
 
-						case 'c': // Prefix: "c"
+						case 'o': // Prefix: "oidc/"
 
-							if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+							if l := len("oidc/"); len(elem) >= l && elem[0:l] == "oidc/" {
 								elem = elem[l:]
 							} else {
 								break
@@ -379,9 +486,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 							switch elem[0] {
-							case 'a': // Prefix: "ampaigns"
+							case 'c': // Prefix: "callback"
 
-								if l := len("ampaigns"); len(elem) >= l && elem[0:l] == "ampaigns" {
+								if l := len("callback"); len(elem) >= l && elem[0:l] == "callback" {
 									elem = elem[l:]
 								} else {
 									break
@@ -391,13 +498,11 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									// Leaf node.
 									switch r.Method {
 									case "GET":
-										s.handleGetWebsiteIDCampaignsRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
+										s.handleGetAuthOidcCallbackRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, notAllowedParams{
 											allowedMethods: "GET",
-											allowedHeaders: nil,
+											allowedHeaders: rn24AllowedHeaders,
 											acceptPost:     "",
 											acceptPatch:    "",
 										})
@@ -406,9 +511,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									return
 								}
 
-							case 'o': // Prefix: "ountries"
+							case 'l': // Prefix: "login"
 
-								if l := len("ountries"); len(elem) >= l && elem[0:l] == "ountries" {
+								if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
 									elem = elem[l:]
 								} else {
 									break
@@ -418,9 +523,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									// Leaf node.
 									switch r.Method {
 									case "GET":
-										s.handleGetWebsiteIDCountryRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
+										s.handleGetAuthOidcLoginRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, notAllowedParams{
 											allowedMethods: "GET",
@@ -435,212 +538,265 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 							}
 
-						case 'd': // Prefix: "devices"
+						}
 
-							if l := len("devices"); len(elem) >= l && elem[0:l] == "devices" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+					}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetWebsiteIDDeviceRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, notAllowedParams{
-										allowedMethods: "GET",
-										allowedHeaders: nil,
-										acceptPost:     "",
-										acceptPatch:    "",
-									})
-								}
+				}
 
-								return
-							}
+			case 'e': // Prefix: "event/"
 
-						case 'l': // Prefix: "languages"
+				if l := len("event/"); len(elem) >= l && elem[0:l] == "event/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
 
-							if l := len("languages"); len(elem) >= l && elem[0:l] == "languages" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'h': // Prefix: "hit"
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetWebsiteIDLanguageRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, notAllowedParams{
-										allowedMethods: "GET",
-										allowedHeaders: nil,
-										acceptPost:     "",
-										acceptPatch:    "",
-									})
-								}
+					if l := len("hit"); len(elem) >= l && elem[0:l] == "hit" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-								return
-							}
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handlePostEventHitRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "POST",
+								allowedHeaders: rn72AllowedHeaders,
+								acceptPost:     "application/json",
+								acceptPatch:    "",
+							})
+						}
 
-						case 'm': // Prefix: "mediums"
+						return
+					}
 
-							if l := len("mediums"); len(elem) >= l && elem[0:l] == "mediums" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+				case 'p': // Prefix: "ping"
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetWebsiteIDMediumsRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, notAllowedParams{
-										allowedMethods: "GET",
-										allowedHeaders: nil,
-										acceptPost:     "",
-										acceptPatch:    "",
-									})
-								}
+					if l := len("ping"); len(elem) >= l && elem[0:l] == "ping" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-								return
-							}
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetEventPingRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET",
+								allowedHeaders: rn27AllowedHeaders,
+								acceptPost:     "",
+								acceptPatch:    "",
+							})
+						}
 
-						case 'o': // Prefix: "os"
+						return
+					}
 
-							if l := len("os"); len(elem) >= l && elem[0:l] == "os" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+				}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetWebsiteIDOsRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, notAllowedParams{
-										allowedMethods: "GET",
-										allowedHeaders: nil,
-										acceptPost:     "",
-										acceptPatch:    "",
-									})
-								}
+			case 'r': // Prefix: "reports"
 
-								return
-							}
+				if l := len("reports"); len(elem) >= l && elem[0:l] == "reports" {
+					elem = elem[l:]
+				} else {
+					break
+				}
 
-						case 'p': // Prefix: "p"
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleGetReportsRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handlePostReportsRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, notAllowedParams{
+							allowedMethods: "GET,POST",
+							allowedHeaders: rn28AllowedHeaders,
+							acceptPost:     "application/json",
+							acceptPatch:    "",
+						})
+					}
 
-							if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
 
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case 'a': // Prefix: "ages"
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-								if l := len("ages"); len(elem) >= l && elem[0:l] == "ages" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+					// Param: "reportId"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleGetWebsiteIDPagesRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, notAllowedParams{
-											allowedMethods: "GET",
-											allowedHeaders: nil,
-											acceptPost:     "",
-											acceptPatch:    "",
-										})
-									}
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "DELETE":
+							s.handleDeleteReportsIDRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "PATCH":
+							s.handlePatchReportsIDRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "DELETE,PATCH",
+								allowedHeaders: rn7AllowedHeaders,
+								acceptPost:     "",
+								acceptPatch:    "application/json",
+							})
+						}
 
-									return
-								}
+						return
+					}
 
-							case 'r': // Prefix: "roperties"
+				}
 
-								if l := len("roperties"); len(elem) >= l && elem[0:l] == "roperties" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+			case 't': // Prefix: "tenant/settings"
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleGetWebsiteIDPropertiesRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, notAllowedParams{
-											allowedMethods: "GET",
-											allowedHeaders: nil,
-											acceptPost:     "",
-											acceptPatch:    "",
-										})
-									}
+				if l := len("tenant/settings"); len(elem) >= l && elem[0:l] == "tenant/settings" {
+					elem = elem[l:]
+				} else {
+					break
+				}
 
-									return
-								}
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleGetTenantSettingsRequest([0]string{}, elemIsEscaped, w, r)
+					case "PATCH":
+						s.handlePatchTenantSettingsRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, notAllowedParams{
+							allowedMethods: "GET,PATCH",
+							allowedHeaders: rn29AllowedHeaders,
+							acceptPost:     "",
+							acceptPatch:    "application/json",
+						})
+					}
 
-							}
+					return
+				}
 
-						case 'r': // Prefix: "referrers"
+			case 'u': // Prefix: "user"
 
-							if l := len("referrers"); len(elem) >= l && elem[0:l] == "referrers" {
-								elem = elem[l:]
-							} else {
-								break
+				if l := len("user"); len(elem) >= l && elem[0:l] == "user" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "DELETE":
+						s.handleDeleteUserRequest([0]string{}, elemIsEscaped, w, r)
+					case "GET":
+						s.handleGetUserRequest([0]string{}, elemIsEscaped, w, r)
+					case "PATCH":
+						s.handlePatchUserRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, notAllowedParams{
+							allowedMethods: "DELETE,GET,PATCH",
+							allowedHeaders: rn8AllowedHeaders,
+							acceptPost:     "",
+							acceptPatch:    "application/json",
+						})
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 's': // Prefix: "sessions"
+
+						if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleDeleteUserSessionsRequest([0]string{}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetUserSessionsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "DELETE,GET",
+									allowedHeaders: nil,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
 							}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetWebsiteIDReferrersRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, notAllowedParams{
-										allowedMethods: "GET",
-										allowedHeaders: nil,
-										acceptPost:     "",
-										acceptPatch:    "",
-									})
-								}
+							return
+						}
 
-								return
+					case 't': // Prefix: "totp"
+
+						if l := len("totp"); len(elem) >= l && elem[0:l] == "totp" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "POST":
+								s.handlePostUserTotpRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "POST",
+									allowedHeaders: nil,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
 							}
 
-						case 's': // Prefix: "s"
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
 
-							if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
@@ -650,9 +806,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 							switch elem[0] {
-							case 'o': // Prefix: "ources"
+							case 'c': // Prefix: "confirm"
 
-								if l := len("ources"); len(elem) >= l && elem[0:l] == "ources" {
+								if l := len("confirm"); len(elem) >= l && elem[0:l] == "confirm" {
 									elem = elem[l:]
 								} else {
 									break
@@ -661,15 +817,13 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								if len(elem) == 0 {
 									// Leaf node.
 									switch r.Method {
-									case "GET":
-										s.handleGetWebsiteIDSourcesRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handlePostUserTotpConfirmRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, notAllowedParams{
-											allowedMethods: "GET",
-											allowedHeaders: nil,
-											acceptPost:     "",
+											allowedMethods: "POST",
+											allowedHeaders: rn74AllowedHeaders,
+											acceptPost:     "application/json",
 											acceptPatch:    "",
 										})
 									}
@@ -677,9 +831,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									return
 								}
 
-							case 'u': // Prefix: "ummary"
+							case 'd': // Prefix: "disable"
 
-								if l := len("ummary"); len(elem) >= l && elem[0:l] == "ummary" {
+								if l := len("disable"); len(elem) >= l && elem[0:l] == "disable" {
 									elem = elem[l:]
 								} else {
 									break
@@ -688,15 +842,13 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								if len(elem) == 0 {
 									// Leaf node.
 									switch r.Method {
-									case "GET":
-										s.handleGetWebsiteIDSummaryRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handlePostUserTotpDisableRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, notAllowedParams{
-											allowedMethods: "GET",
-											allowedHeaders: nil,
-											acceptPost:     "",
+											allowedMethods: "POST",
+											allowedHeaders: rn76AllowedHeaders,
+											acceptPost:     "application/json",
 											acceptPatch:    "",
 										})
 									}
@@ -706,33 +858,31 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 							}
 
-						case 't': // Prefix: "time"
+						}
 
-							if l := len("time"); len(elem) >= l && elem[0:l] == "time" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+					case 'u': // Prefix: "usage"
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetWebsiteIDTimeRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, notAllowedParams{
-										allowedMethods: "GET",
-										allowedHeaders: nil,
-										acceptPost:     "",
-										acceptPatch:    "",
-									})
-								}
+						if l := len("usage"); len(elem) >= l && elem[0:l] == "usage" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-								return
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetUserUsageRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: nil,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
 							}
 
+							return
 						}
 
 					}
@@ -748,13 +898,13 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					if len(elem) == 0 {
 						switch r.Method {
 						case "GET":
-							s.handleGetWebsitesRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleGetUsersRequest([0]string{}, elemIsEscaped, w, r)
 						case "POST":
-							s.handlePostWebsitesRequest([0]string{}, elemIsEscaped, w, r)
+							s.handlePostUsersRequest([0]string{}, elemIsEscaped, w, r)
 						default:
 							s.notAllowed(w, r, notAllowedParams{
 								allowedMethods: "GET,POST",
-								allowedHeaders: rn28AllowedHeaders,
+								allowedHeaders: rn32AllowedHeaders,
 								acceptPost:     "application/json",
 								acceptPatch:    "",
 							})
@@ -771,7 +921,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 
-						// Param: "hostname"
+						// Param: "userId"
 						// Leaf parameter, slashes are prohibited
 						idx := strings.IndexByte(elem, '/')
 						if idx >= 0 {
@@ -784,21 +934,17 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							// Leaf node.
 							switch r.Method {
 							case "DELETE":
-								s.handleDeleteWebsitesIDRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							case "GET":
-								s.handleGetWebsitesIDRequest([1]string{
+								s.handleDeleteUsersIDRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
 							case "PATCH":
-								s.handlePatchWebsitesIDRequest([1]string{
+								s.handlePatchUsersIDRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
 							default:
 								s.notAllowed(w, r, notAllowedParams{
-									allowedMethods: "DELETE,GET,PATCH",
-									allowedHeaders: rn4AllowedHeaders,
+									allowedMethods: "DELETE,PATCH",
+									allowedHeaders: rn11AllowedHeaders,
 									acceptPost:     "",
 									acceptPatch:    "application/json",
 								})
@@ -811,109 +957,1393 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 				}
 
-			}
+			case 'w': // Prefix: "website"
 
-		}
-	}
-	s.notFound(w, r)
-}
+				if l := len("website"); len(elem) >= l && elem[0:l] == "website" {
+					elem = elem[l:]
+				} else {
+					break
+				}
 
-// Route is route object.
-type Route struct {
-	name           string
-	summary        string
-	operationID    string
-	operationGroup string
-	pathPattern    string
-	count          int
-	args           [1]string
-}
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
 
-// Name returns ogen operation name.
-//
-// It is guaranteed to be unique and not empty.
-func (r Route) Name() string {
-	return r.name
-}
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "hostname"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'b': // Prefix: "browsers"
+
+							if l := len("browsers"); len(elem) >= l && elem[0:l] == "browsers" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetWebsiteIDBrowsersRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn36AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+						case 'c': // Prefix: "c"
+
+							if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "ampaigns"
+
+								if l := len("ampaigns"); len(elem) >= l && elem[0:l] == "ampaigns" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetWebsiteIDCampaignsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET",
+											allowedHeaders: rn38AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							case 'o': // Prefix: "ountries"
+
+								if l := len("ountries"); len(elem) >= l && elem[0:l] == "ountries" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetWebsiteIDCountryRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET",
+											allowedHeaders: rn40AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							}
+
+						case 'd': // Prefix: "devices"
+
+							if l := len("devices"); len(elem) >= l && elem[0:l] == "devices" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetWebsiteIDDeviceRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn41AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+						case 'f': // Prefix: "funnel"
+
+							if l := len("funnel"); len(elem) >= l && elem[0:l] == "funnel" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetWebsiteIDFunnelRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn42AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+						case 'g': // Prefix: "goals"
+
+							if l := len("goals"); len(elem) >= l && elem[0:l] == "goals" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetWebsiteIDGoalsRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn43AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+						case 'h': // Prefix: "hostnames"
+
+							if l := len("hostnames"); len(elem) >= l && elem[0:l] == "hostnames" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetWebsiteIDHostnamesRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn44AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+						case 'l': // Prefix: "languages"
+
+							if l := len("languages"); len(elem) >= l && elem[0:l] == "languages" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetWebsiteIDLanguageRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn45AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+						case 'm': // Prefix: "mediums"
+
+							if l := len("mediums"); len(elem) >= l && elem[0:l] == "mediums" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetWebsiteIDMediumsRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn46AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+						case 'o': // Prefix: "os"
+
+							if l := len("os"); len(elem) >= l && elem[0:l] == "os" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetWebsiteIDOsRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn47AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+						case 'p': // Prefix: "p"
+
+							if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "ages"
+
+								if l := len("ages"); len(elem) >= l && elem[0:l] == "ages" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleGetWebsiteIDPagesRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET",
+											allowedHeaders: rn48AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/e"
+
+									if l := len("/e"); len(elem) >= l && elem[0:l] == "/e" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'n': // Prefix: "ntry"
+
+										if l := len("ntry"); len(elem) >= l && elem[0:l] == "ntry" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetWebsiteIDPagesEntryRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, notAllowedParams{
+													allowedMethods: "GET",
+													allowedHeaders: rn49AllowedHeaders,
+													acceptPost:     "",
+													acceptPatch:    "",
+												})
+											}
+
+											return
+										}
+
+									case 'x': // Prefix: "xit"
+
+										if l := len("xit"); len(elem) >= l && elem[0:l] == "xit" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetWebsiteIDPagesExitRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, notAllowedParams{
+													allowedMethods: "GET",
+													allowedHeaders: rn51AllowedHeaders,
+													acceptPost:     "",
+													acceptPatch:    "",
+												})
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+							case 'r': // Prefix: "roperties"
+
+								if l := len("roperties"); len(elem) >= l && elem[0:l] == "roperties" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetWebsiteIDPropertiesRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET",
+											allowedHeaders: rn53AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							}
+
+						case 'r': // Prefix: "re"
+
+							if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "altime"
+
+								if l := len("altime"); len(elem) >= l && elem[0:l] == "altime" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetWebsiteIDRealtimeRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET",
+											allowedHeaders: rn54AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							case 'f': // Prefix: "ferrers"
+
+								if l := len("ferrers"); len(elem) >= l && elem[0:l] == "ferrers" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetWebsiteIDReferrersRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET",
+											allowedHeaders: rn56AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							}
+
+						case 's': // Prefix: "s"
+
+							if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'o': // Prefix: "ources"
+
+								if l := len("ources"); len(elem) >= l && elem[0:l] == "ources" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetWebsiteIDSourcesRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET",
+											allowedHeaders: rn57AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							case 'u': // Prefix: "ummary"
+
+								if l := len("ummary"); len(elem) >= l && elem[0:l] == "ummary" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetWebsiteIDSummaryRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET",
+											allowedHeaders: rn59AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							}
+
+						case 't': // Prefix: "time"
+
+							if l := len("time"); len(elem) >= l && elem[0:l] == "time" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetWebsiteIDTimeRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn60AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				case 's': // Prefix: "s"
+
+					if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetWebsitesRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handlePostWebsitesRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET,POST",
+								allowedHeaders: rn61AllowedHeaders,
+								acceptPost:     "application/json",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "hostname"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handleDeleteWebsitesIDRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetWebsitesIDRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PATCH":
+								s.handlePatchWebsitesIDRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "DELETE,GET,PATCH",
+									allowedHeaders: rn13AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "application/json",
+								})
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'd': // Prefix: "deletion"
+
+								if l := len("deletion"); len(elem) >= l && elem[0:l] == "deletion" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetWebsitesIDDeletionRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET",
+											allowedHeaders: rn62AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							case 'g': // Prefix: "goals"
+
+								if l := len("goals"); len(elem) >= l && elem[0:l] == "goals" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleGetWebsitesIDGoalsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handlePostWebsitesIDGoalsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET,POST",
+											allowedHeaders: rn63AllowedHeaders,
+											acceptPost:     "application/json",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "goalId"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleDeleteWebsitesIDGoalsIDRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										case "PATCH":
+											s.handlePatchWebsitesIDGoalsIDRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, notAllowedParams{
+												allowedMethods: "DELETE,PATCH",
+												allowedHeaders: rn15AllowedHeaders,
+												acceptPost:     "",
+												acceptPatch:    "application/json",
+											})
+										}
+
+										return
+									}
+
+								}
+
+							case 'w': // Prefix: "webhooks"
+
+								if l := len("webhooks"); len(elem) >= l && elem[0:l] == "webhooks" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleGetWebsitesIDWebhooksRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handlePostWebsitesIDWebhooksRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET,POST",
+											allowedHeaders: rn64AllowedHeaders,
+											acceptPost:     "application/json",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "webhookId"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[1] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "DELETE":
+											s.handleDeleteWebsitesIDWebhooksIDRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, notAllowedParams{
+												allowedMethods: "DELETE",
+												allowedHeaders: rn18AllowedHeaders,
+												acceptPost:     "",
+												acceptPatch:    "",
+											})
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/deliveries"
+
+										if l := len("/deliveries"); len(elem) >= l && elem[0:l] == "/deliveries" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetWebsitesIDWebhooksIDDeliveriesRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, notAllowedParams{
+													allowedMethods: "GET",
+													allowedHeaders: rn65AllowedHeaders,
+													acceptPost:     "",
+													acceptPatch:    "",
+												})
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				}
+
+			}
+
+		}
+	}
+	s.notFound(w, r)
+}
+
+// Route is route object.
+type Route struct {
+	name           string
+	summary        string
+	operationID    string
+	operationGroup string
+	pathPattern    string
+	count          int
+	args           [2]string
+}
+
+// Name returns ogen operation name.
+//
+// It is guaranteed to be unique and not empty.
+func (r Route) Name() string {
+	return r.name
+}
+
+// Summary returns OpenAPI summary.
+func (r Route) Summary() string {
+	return r.summary
+}
+
+// OperationID returns OpenAPI operationId.
+func (r Route) OperationID() string {
+	return r.operationID
+}
+
+// OperationGroup returns the x-ogen-operation-group value.
+func (r Route) OperationGroup() string {
+	return r.operationGroup
+}
+
+// PathPattern returns OpenAPI path.
+func (r Route) PathPattern() string {
+	return r.pathPattern
+}
+
+// Args returns parsed arguments.
+func (r Route) Args() []string {
+	return r.args[:r.count]
+}
+
+// FindRoute finds Route for given method and path.
+//
+// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
+func (s *Server) FindRoute(method, path string) (Route, bool) {
+	return s.FindPath(method, &url.URL{Path: path})
+}
+
+// FindPath finds Route for given method and URL.
+func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
+	var (
+		elem = u.Path
+		args = r.args
+	)
+	if rawPath := u.RawPath; rawPath != "" {
+		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
+			elem = normalized
+		}
+		defer func() {
+			for i, arg := range r.args[:r.count] {
+				if unescaped, err := url.PathUnescape(arg); err == nil {
+					r.args[i] = unescaped
+				}
+			}
+		}()
+	}
 
-// Summary returns OpenAPI summary.
-func (r Route) Summary() string {
-	return r.summary
-}
+	elem, ok := s.cutPrefix(elem)
+	if !ok {
+		return r, false
+	}
 
-// OperationID returns OpenAPI operationId.
-func (r Route) OperationID() string {
-	return r.operationID
-}
+	// Static code generated router with unwrapped path search.
+	switch {
+	default:
+		if len(elem) == 0 {
+			break
+		}
+		switch elem[0] {
+		case '/': // Prefix: "/"
 
-// OperationGroup returns the x-ogen-operation-group value.
-func (r Route) OperationGroup() string {
-	return r.operationGroup
-}
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				elem = elem[l:]
+			} else {
+				break
+			}
 
-// PathPattern returns OpenAPI path.
-func (r Route) PathPattern() string {
-	return r.pathPattern
-}
+			if len(elem) == 0 {
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "a"
+
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'p': // Prefix: "pi-keys"
+
+					if l := len("pi-keys"); len(elem) >= l && elem[0:l] == "pi-keys" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GetAPIKeysOperation
+							r.summary = "List API Keys"
+							r.operationID = "get-api-keys"
+							r.operationGroup = ""
+							r.pathPattern = "/api-keys"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = PostAPIKeysOperation
+							r.summary = "Add API Key"
+							r.operationID = "post-api-keys"
+							r.operationGroup = ""
+							r.pathPattern = "/api-keys"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "keyId"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = DeleteAPIKeysIDOperation
+								r.summary = "Revoke API Key"
+								r.operationID = "delete-api-keys-id"
+								r.operationGroup = ""
+								r.pathPattern = "/api-keys/{keyId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'u': // Prefix: "u"
+
+					if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'd': // Prefix: "dit"
+
+						if l := len("dit"); len(elem) >= l && elem[0:l] == "dit" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetAuditOperation
+								r.summary = "List Audit Log"
+								r.operationID = "get-audit"
+								r.operationGroup = ""
+								r.pathPattern = "/audit"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 't': // Prefix: "th/"
+
+						if l := len("th/"); len(elem) >= l && elem[0:l] == "th/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'l': // Prefix: "lo"
+
+							if l := len("lo"); len(elem) >= l && elem[0:l] == "lo" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "ckouts"
+
+								if l := len("ckouts"); len(elem) >= l && elem[0:l] == "ckouts" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = DeleteAuthLockoutsOperation
+										r.summary = "Clear Login Lockout"
+										r.operationID = "delete-auth-lockouts"
+										r.operationGroup = ""
+										r.pathPattern = "/auth/lockouts"
+										r.args = args
+										r.count = 0
+										return r, true
+									case "GET":
+										r.name = GetAuthLockoutsOperation
+										r.summary = "List Login Lockouts"
+										r.operationID = "get-auth-lockouts"
+										r.operationGroup = ""
+										r.pathPattern = "/auth/lockouts"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'g': // Prefix: "g"
+
+								if l := len("g"); len(elem) >= l && elem[0:l] == "g" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'i': // Prefix: "in"
+
+									if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "POST":
+											r.name = PostAuthLoginOperation
+											r.summary = "Login"
+											r.operationID = "post-auth-login"
+											r.operationGroup = ""
+											r.pathPattern = "/auth/login"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/totp"
+
+										if l := len("/totp"); len(elem) >= l && elem[0:l] == "/totp" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = PostAuthLoginTotpOperation
+												r.summary = "Complete Two-Factor Login"
+												r.operationID = "post-auth-login-totp"
+												r.operationGroup = ""
+												r.pathPattern = "/auth/login/totp"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 'o': // Prefix: "out"
+
+									if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = PostAuthLogoutOperation
+											r.summary = "Logout"
+											r.operationID = "post-auth-logout"
+											r.operationGroup = ""
+											r.pathPattern = "/auth/logout"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						case 'm': // Prefix: "methods"
+
+							if l := len("methods"); len(elem) >= l && elem[0:l] == "methods" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetAuthMethodsOperation
+									r.summary = "Get Login Methods"
+									r.operationID = "get-auth-methods"
+									r.operationGroup = ""
+									r.pathPattern = "/auth/methods"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'o': // Prefix: "oidc/"
+
+							if l := len("oidc/"); len(elem) >= l && elem[0:l] == "oidc/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "callback"
+
+								if l := len("callback"); len(elem) >= l && elem[0:l] == "callback" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetAuthOidcCallbackOperation
+										r.summary = "Complete Single Sign-On"
+										r.operationID = "get-auth-oidc-callback"
+										r.operationGroup = ""
+										r.pathPattern = "/auth/oidc/callback"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'l': // Prefix: "login"
 
-// Args returns parsed arguments.
-func (r Route) Args() []string {
-	return r.args[:r.count]
-}
+								if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-// FindRoute finds Route for given method and path.
-//
-// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
-func (s *Server) FindRoute(method, path string) (Route, bool) {
-	return s.FindPath(method, &url.URL{Path: path})
-}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetAuthOidcLoginOperation
+										r.summary = "Start Single Sign-On"
+										r.operationID = "get-auth-oidc-login"
+										r.operationGroup = ""
+										r.pathPattern = "/auth/oidc/login"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
 
-// FindPath finds Route for given method and URL.
-func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
-	var (
-		elem = u.Path
-		args = r.args
-	)
-	if rawPath := u.RawPath; rawPath != "" {
-		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
-			elem = normalized
-		}
-		defer func() {
-			for i, arg := range r.args[:r.count] {
-				if unescaped, err := url.PathUnescape(arg); err == nil {
-					r.args[i] = unescaped
-				}
-			}
-		}()
-	}
+							}
 
-	elem, ok := s.cutPrefix(elem)
-	if !ok {
-		return r, false
-	}
+						}
 
-	// Static code generated router with unwrapped path search.
-	switch {
-	default:
-		if len(elem) == 0 {
-			break
-		}
-		switch elem[0] {
-		case '/': // Prefix: "/"
+					}
 
-			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-				elem = elem[l:]
-			} else {
-				break
-			}
+				}
 
-			if len(elem) == 0 {
-				break
-			}
-			switch elem[0] {
-			case 'a': // Prefix: "auth/log"
+			case 'e': // Prefix: "event/"
 
-				if l := len("auth/log"); len(elem) >= l && elem[0:l] == "auth/log" {
+				if l := len("event/"); len(elem) >= l && elem[0:l] == "event/" {
 					elem = elem[l:]
 				} else {
 					break
@@ -923,9 +2353,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 				switch elem[0] {
-				case 'i': // Prefix: "in"
+				case 'h': // Prefix: "hit"
 
-					if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+					if l := len("hit"); len(elem) >= l && elem[0:l] == "hit" {
 						elem = elem[l:]
 					} else {
 						break
@@ -935,11 +2365,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						// Leaf node.
 						switch method {
 						case "POST":
-							r.name = PostAuthLoginOperation
-							r.summary = "Login"
-							r.operationID = "post-auth-login"
+							r.name = PostEventHitOperation
+							r.summary = "Send Hit Event"
+							r.operationID = "post-event-hit"
 							r.operationGroup = ""
-							r.pathPattern = "/auth/login"
+							r.pathPattern = "/event/hit"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -948,9 +2378,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 					}
 
-				case 'o': // Prefix: "out"
+				case 'p': // Prefix: "ping"
 
-					if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+					if l := len("ping"); len(elem) >= l && elem[0:l] == "ping" {
 						elem = elem[l:]
 					} else {
 						break
@@ -959,12 +2389,12 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					if len(elem) == 0 {
 						// Leaf node.
 						switch method {
-						case "POST":
-							r.name = PostAuthLogoutOperation
-							r.summary = "Logout"
-							r.operationID = "post-auth-logout"
+						case "GET":
+							r.name = GetEventPingOperation
+							r.summary = "Ping"
+							r.operationID = "get-event-ping"
 							r.operationGroup = ""
-							r.pathPattern = "/auth/logout"
+							r.pathPattern = "/event/ping"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -975,62 +2405,76 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 				}
 
-			case 'e': // Prefix: "event/"
+			case 'r': // Prefix: "reports"
 
-				if l := len("event/"); len(elem) >= l && elem[0:l] == "event/" {
+				if l := len("reports"); len(elem) >= l && elem[0:l] == "reports" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					break
+					switch method {
+					case "GET":
+						r.name = GetReportsOperation
+						r.summary = "List Email Reports"
+						r.operationID = "get-reports"
+						r.operationGroup = ""
+						r.pathPattern = "/reports"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = PostReportsOperation
+						r.summary = "Add Email Report"
+						r.operationID = "post-reports"
+						r.operationGroup = ""
+						r.pathPattern = "/reports"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
 				}
 				switch elem[0] {
-				case 'h': // Prefix: "hit"
+				case '/': // Prefix: "/"
 
-					if l := len("hit"); len(elem) >= l && elem[0:l] == "hit" {
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "POST":
-							r.name = PostEventHitOperation
-							r.summary = "Send Hit Event"
-							r.operationID = "post-event-hit"
-							r.operationGroup = ""
-							r.pathPattern = "/event/hit"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
-
-				case 'p': // Prefix: "ping"
-
-					if l := len("ping"); len(elem) >= l && elem[0:l] == "ping" {
-						elem = elem[l:]
-					} else {
+					// Param: "reportId"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
 						break
 					}
+					args[0] = elem
+					elem = ""
 
 					if len(elem) == 0 {
 						// Leaf node.
 						switch method {
-						case "GET":
-							r.name = GetEventPingOperation
-							r.summary = "Ping"
-							r.operationID = "get-event-ping"
+						case "DELETE":
+							r.name = DeleteReportsIDOperation
+							r.summary = "Delete Email Report"
+							r.operationID = "delete-reports-id"
 							r.operationGroup = ""
-							r.pathPattern = "/event/ping"
+							r.pathPattern = "/reports/{reportId}"
 							r.args = args
-							r.count = 0
+							r.count = 1
+							return r, true
+						case "PATCH":
+							r.name = PatchReportsIDOperation
+							r.summary = "Update Email Report"
+							r.operationID = "patch-reports-id"
+							r.operationGroup = ""
+							r.pathPattern = "/reports/{reportId}"
+							r.args = args
+							r.count = 1
 							return r, true
 						default:
 							return
@@ -1115,23 +2559,194 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					}
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/usage"
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 's': // Prefix: "sessions"
+
+						if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = DeleteUserSessionsOperation
+								r.summary = "Sign Out All Sessions"
+								r.operationID = "delete-user-sessions"
+								r.operationGroup = ""
+								r.pathPattern = "/user/sessions"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "GET":
+								r.name = GetUserSessionsOperation
+								r.summary = "List Sessions"
+								r.operationID = "get-user-sessions"
+								r.operationGroup = ""
+								r.pathPattern = "/user/sessions"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 't': // Prefix: "totp"
+
+						if l := len("totp"); len(elem) >= l && elem[0:l] == "totp" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								r.name = PostUserTotpOperation
+								r.summary = "Start Two-Factor Enrolment"
+								r.operationID = "post-user-totp"
+								r.operationGroup = ""
+								r.pathPattern = "/user/totp"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "confirm"
+
+								if l := len("confirm"); len(elem) >= l && elem[0:l] == "confirm" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = PostUserTotpConfirmOperation
+										r.summary = "Confirm Two-Factor Enrolment"
+										r.operationID = "post-user-totp-confirm"
+										r.operationGroup = ""
+										r.pathPattern = "/user/totp/confirm"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'd': // Prefix: "disable"
+
+								if l := len("disable"); len(elem) >= l && elem[0:l] == "disable" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = PostUserTotpDisableOperation
+										r.summary = "Disable Two-Factor Authentication"
+										r.operationID = "post-user-totp-disable"
+										r.operationGroup = ""
+										r.pathPattern = "/user/totp/disable"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					case 'u': // Prefix: "usage"
+
+						if l := len("usage"); len(elem) >= l && elem[0:l] == "usage" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetUserUsageOperation
+								r.summary = "Get Resource Usage"
+								r.operationID = "get-user-usage"
+								r.operationGroup = ""
+								r.pathPattern = "/user/usage"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 's': // Prefix: "s"
 
-					if l := len("/usage"); len(elem) >= l && elem[0:l] == "/usage" {
+					if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch method {
 						case "GET":
-							r.name = GetUserUsageOperation
-							r.summary = "Get Resource Usage"
-							r.operationID = "get-user-usage"
+							r.name = GetUsersOperation
+							r.summary = "List Users"
+							r.operationID = "get-users"
+							r.operationGroup = ""
+							r.pathPattern = "/users"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = PostUsersOperation
+							r.summary = "Add User"
+							r.operationID = "post-users"
 							r.operationGroup = ""
-							r.pathPattern = "/user/usage"
+							r.pathPattern = "/users"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -1139,6 +2754,51 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "userId"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = DeleteUsersIDOperation
+								r.summary = "Delete User"
+								r.operationID = "delete-users-id"
+								r.operationGroup = ""
+								r.pathPattern = "/users/{userId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PATCH":
+								r.name = PatchUsersIDOperation
+								r.summary = "Update User"
+								r.operationID = "patch-users-id"
+								r.operationGroup = ""
+								r.pathPattern = "/users/{userId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
 
 				}
 
@@ -1301,6 +2961,81 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 							}
 
+						case 'f': // Prefix: "funnel"
+
+							if l := len("funnel"); len(elem) >= l && elem[0:l] == "funnel" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetWebsiteIDFunnelOperation
+									r.summary = "Get Funnel Stats"
+									r.operationID = "get-website-id-funnel"
+									r.operationGroup = ""
+									r.pathPattern = "/website/{hostname}/funnel"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'g': // Prefix: "goals"
+
+							if l := len("goals"); len(elem) >= l && elem[0:l] == "goals" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetWebsiteIDGoalsOperation
+									r.summary = "Get Goal Stats"
+									r.operationID = "get-website-id-goals"
+									r.operationGroup = ""
+									r.pathPattern = "/website/{hostname}/goals"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'h': // Prefix: "hostnames"
+
+							if l := len("hostnames"); len(elem) >= l && elem[0:l] == "hostnames" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetWebsiteIDHostnamesOperation
+									r.summary = "Get Hostname Stats"
+									r.operationID = "get-website-id-hostnames"
+									r.operationGroup = ""
+									r.pathPattern = "/website/{hostname}/hostnames"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
 						case 'l': // Prefix: "languages"
 
 							if l := len("languages"); len(elem) >= l && elem[0:l] == "languages" {
@@ -1397,7 +3132,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 
 								if len(elem) == 0 {
-									// Leaf node.
 									switch method {
 									case "GET":
 										r.name = GetWebsiteIDPagesOperation
@@ -1412,6 +3146,72 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										return
 									}
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/e"
+
+									if l := len("/e"); len(elem) >= l && elem[0:l] == "/e" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'n': // Prefix: "ntry"
+
+										if l := len("ntry"); len(elem) >= l && elem[0:l] == "ntry" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetWebsiteIDPagesEntryOperation
+												r.summary = "Get Entry Page Stats"
+												r.operationID = "get-website-id-pages-entry"
+												r.operationGroup = ""
+												r.pathPattern = "/website/{hostname}/pages/entry"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'x': // Prefix: "xit"
+
+										if l := len("xit"); len(elem) >= l && elem[0:l] == "xit" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetWebsiteIDPagesExitOperation
+												r.summary = "Get Exit Page Stats"
+												r.operationID = "get-website-id-pages-exit"
+												r.operationGroup = ""
+												r.pathPattern = "/website/{hostname}/pages/exit"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
 
 							case 'r': // Prefix: "roperties"
 
@@ -1440,29 +3240,68 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 							}
 
-						case 'r': // Prefix: "referrers"
+						case 'r': // Prefix: "re"
 
-							if l := len("referrers"); len(elem) >= l && elem[0:l] == "referrers" {
+							if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = GetWebsiteIDReferrersOperation
-									r.summary = "Get Referrer Stats"
-									r.operationID = "get-website-id-referrers"
-									r.operationGroup = ""
-									r.pathPattern = "/website/{hostname}/referrers"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "altime"
+
+								if l := len("altime"); len(elem) >= l && elem[0:l] == "altime" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetWebsiteIDRealtimeOperation
+										r.summary = "Get Realtime Stats"
+										r.operationID = "get-website-id-realtime"
+										r.operationGroup = ""
+										r.pathPattern = "/website/{hostname}/realtime"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'f': // Prefix: "ferrers"
+
+								if l := len("ferrers"); len(elem) >= l && elem[0:l] == "ferrers" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetWebsiteIDReferrersOperation
+										r.summary = "Get Referrer Stats"
+										r.operationID = "get-website-id-referrers"
+										r.operationGroup = ""
+										r.pathPattern = "/website/{hostname}/referrers"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
 								}
+
 							}
 
 						case 's': // Prefix: "s"
@@ -1600,16 +3439,15 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 
 						// Param: "hostname"
-						// Leaf parameter, slashes are prohibited
+						// Match until "/"
 						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
+						if idx < 0 {
+							idx = len(elem)
 						}
-						args[0] = elem
-						elem = ""
+						args[0] = elem[:idx]
+						elem = elem[idx:]
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch method {
 							case "DELETE":
 								r.name = DeleteWebsitesIDOperation
@@ -1642,6 +3480,220 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								return
 							}
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'd': // Prefix: "deletion"
+
+								if l := len("deletion"); len(elem) >= l && elem[0:l] == "deletion" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetWebsitesIDDeletionOperation
+										r.summary = "Get Website Deletion"
+										r.operationID = "get-websites-id-deletion"
+										r.operationGroup = ""
+										r.pathPattern = "/websites/{hostname}/deletion"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'g': // Prefix: "goals"
+
+								if l := len("goals"); len(elem) >= l && elem[0:l] == "goals" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = GetWebsitesIDGoalsOperation
+										r.summary = "List Goals"
+										r.operationID = "get-websites-id-goals"
+										r.operationGroup = ""
+										r.pathPattern = "/websites/{hostname}/goals"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = PostWebsitesIDGoalsOperation
+										r.summary = "Add Goal"
+										r.operationID = "post-websites-id-goals"
+										r.operationGroup = ""
+										r.pathPattern = "/websites/{hostname}/goals"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "goalId"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = DeleteWebsitesIDGoalsIDOperation
+											r.summary = "Delete Goal"
+											r.operationID = "delete-websites-id-goals-id"
+											r.operationGroup = ""
+											r.pathPattern = "/websites/{hostname}/goals/{goalId}"
+											r.args = args
+											r.count = 2
+											return r, true
+										case "PATCH":
+											r.name = PatchWebsitesIDGoalsIDOperation
+											r.summary = "Update Goal"
+											r.operationID = "patch-websites-id-goals-id"
+											r.operationGroup = ""
+											r.pathPattern = "/websites/{hostname}/goals/{goalId}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'w': // Prefix: "webhooks"
+
+								if l := len("webhooks"); len(elem) >= l && elem[0:l] == "webhooks" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = GetWebsitesIDWebhooksOperation
+										r.summary = "List Webhooks"
+										r.operationID = "get-websites-id-webhooks"
+										r.operationGroup = ""
+										r.pathPattern = "/websites/{hostname}/webhooks"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = PostWebsitesIDWebhooksOperation
+										r.summary = "Add Webhook"
+										r.operationID = "post-websites-id-webhooks"
+										r.operationGroup = ""
+										r.pathPattern = "/websites/{hostname}/webhooks"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "webhookId"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[1] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										switch method {
+										case "DELETE":
+											r.name = DeleteWebsitesIDWebhooksIDOperation
+											r.summary = "Delete Webhook"
+											r.operationID = "delete-websites-id-webhooks-id"
+											r.operationGroup = ""
+											r.pathPattern = "/websites/{hostname}/webhooks/{webhookId}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/deliveries"
+
+										if l := len("/deliveries"); len(elem) >= l && elem[0:l] == "/deliveries" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetWebsitesIDWebhooksIDDeliveriesOperation
+												r.summary = "List Webhook Deliveries"
+												r.operationID = "get-websites-id-webhooks-id-deliveries"
+												r.operationGroup = ""
+												r.pathPattern = "/websites/{hostname}/webhooks/{webhookId}/deliveries"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							}
+
+						}
 
 					}
 
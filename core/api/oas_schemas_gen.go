@@ -11,2125 +11,5350 @@ import (
 	"github.com/go-faster/errors"
 )
 
-// Request body for logging in.
-// Ref: #/components/schemas/AuthLogin
-type AuthLogin struct {
-	Username string `json:"username"`
-	Password string `json:"password"`
+// Request body for creating an API key.
+// Ref: #/components/schemas/APIKeyCreate
+type APIKeyCreate struct {
+	Name string     `json:"name"`
+	Role APIKeyRole `json:"role"`
+	// Restrict the key to a single website.
+	Hostname OptString `json:"hostname"`
+	// Date the key expires. The key never expires if omitted.
+	ExpiresAt OptDateTime `json:"expiresAt"`
 }
 
-// GetUsername returns the value of Username.
-func (s *AuthLogin) GetUsername() string {
-	return s.Username
+// GetName returns the value of Name.
+func (s *APIKeyCreate) GetName() string {
+	return s.Name
 }
 
-// GetPassword returns the value of Password.
-func (s *AuthLogin) GetPassword() string {
-	return s.Password
+// GetRole returns the value of Role.
+func (s *APIKeyCreate) GetRole() APIKeyRole {
+	return s.Role
 }
 
-// SetUsername sets the value of Username.
-func (s *AuthLogin) SetUsername(val string) {
-	s.Username = val
+// GetHostname returns the value of Hostname.
+func (s *APIKeyCreate) GetHostname() OptString {
+	return s.Hostname
 }
 
-// SetPassword sets the value of Password.
-func (s *AuthLogin) SetPassword(val string) {
-	s.Password = val
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *APIKeyCreate) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
 }
 
-type BadRequestError struct {
-	Error BadRequestErrorError `json:"error"`
+// SetName sets the value of Name.
+func (s *APIKeyCreate) SetName(val string) {
+	s.Name = val
 }
 
-// GetError returns the value of Error.
-func (s *BadRequestError) GetError() BadRequestErrorError {
-	return s.Error
+// SetRole sets the value of Role.
+func (s *APIKeyCreate) SetRole(val APIKeyRole) {
+	s.Role = val
 }
 
-// SetError sets the value of Error.
-func (s *BadRequestError) SetError(val BadRequestErrorError) {
-	s.Error = val
+// SetHostname sets the value of Hostname.
+func (s *APIKeyCreate) SetHostname(val OptString) {
+	s.Hostname = val
 }
 
-type BadRequestErrorError struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *APIKeyCreate) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
 }
 
-// GetCode returns the value of Code.
-func (s *BadRequestErrorError) GetCode() int32 {
-	return s.Code
+// Response body for a created API key, including the key itself.
+// Ref: #/components/schemas/APIKeyCreated
+type APIKeyCreated struct {
+	// The API key to send as a bearer token. It is not stored and can not be retrieved again.
+	Key    string    `json:"key"`
+	ApiKey APIKeyGet `json:"apiKey"`
 }
 
-// GetMessage returns the value of Message.
-func (s *BadRequestErrorError) GetMessage() string {
-	return s.Message
+// GetKey returns the value of Key.
+func (s *APIKeyCreated) GetKey() string {
+	return s.Key
 }
 
-// SetCode sets the value of Code.
-func (s *BadRequestErrorError) SetCode(val int32) {
-	s.Code = val
+// GetApiKey returns the value of ApiKey.
+func (s *APIKeyCreated) GetApiKey() APIKeyGet {
+	return s.ApiKey
 }
 
-// SetMessage sets the value of Message.
-func (s *BadRequestErrorError) SetMessage(val string) {
-	s.Message = val
+// SetKey sets the value of Key.
+func (s *APIKeyCreated) SetKey(val string) {
+	s.Key = val
 }
 
-// BadRequestErrorHeaders wraps BadRequestError with response headers.
-type BadRequestErrorHeaders struct {
+// SetApiKey sets the value of ApiKey.
+func (s *APIKeyCreated) SetApiKey(val APIKeyGet) {
+	s.ApiKey = val
+}
+
+// APIKeyCreatedHeaders wraps APIKeyCreated with response headers.
+type APIKeyCreatedHeaders struct {
 	XAPICommit OptString
-	Response   BadRequestError
+	Response   APIKeyCreated
 }
 
 // GetXAPICommit returns the value of XAPICommit.
-func (s *BadRequestErrorHeaders) GetXAPICommit() OptString {
+func (s *APIKeyCreatedHeaders) GetXAPICommit() OptString {
 	return s.XAPICommit
 }
 
 // GetResponse returns the value of Response.
-func (s *BadRequestErrorHeaders) GetResponse() BadRequestError {
+func (s *APIKeyCreatedHeaders) GetResponse() APIKeyCreated {
 	return s.Response
 }
 
 // SetXAPICommit sets the value of XAPICommit.
-func (s *BadRequestErrorHeaders) SetXAPICommit(val OptString) {
+func (s *APIKeyCreatedHeaders) SetXAPICommit(val OptString) {
 	s.XAPICommit = val
 }
 
 // SetResponse sets the value of Response.
-func (s *BadRequestErrorHeaders) SetResponse(val BadRequestError) {
+func (s *APIKeyCreatedHeaders) SetResponse(val APIKeyCreated) {
 	s.Response = val
 }
 
-func (*BadRequestErrorHeaders) deleteUserRes()             {}
-func (*BadRequestErrorHeaders) deleteWebsitesIDRes()       {}
-func (*BadRequestErrorHeaders) getEventPingRes()           {}
-func (*BadRequestErrorHeaders) getUserRes()                {}
-func (*BadRequestErrorHeaders) getWebsiteIDBrowsersRes()   {}
-func (*BadRequestErrorHeaders) getWebsiteIDCampaignsRes()  {}
-func (*BadRequestErrorHeaders) getWebsiteIDCountryRes()    {}
-func (*BadRequestErrorHeaders) getWebsiteIDDeviceRes()     {}
-func (*BadRequestErrorHeaders) getWebsiteIDLanguageRes()   {}
-func (*BadRequestErrorHeaders) getWebsiteIDMediumsRes()    {}
-func (*BadRequestErrorHeaders) getWebsiteIDOsRes()         {}
-func (*BadRequestErrorHeaders) getWebsiteIDPagesRes()      {}
-func (*BadRequestErrorHeaders) getWebsiteIDPropertiesRes() {}
-func (*BadRequestErrorHeaders) getWebsiteIDReferrersRes()  {}
-func (*BadRequestErrorHeaders) getWebsiteIDSourcesRes()    {}
-func (*BadRequestErrorHeaders) getWebsiteIDSummaryRes()    {}
-func (*BadRequestErrorHeaders) getWebsiteIDTimeRes()       {}
-func (*BadRequestErrorHeaders) getWebsitesIDRes()          {}
-func (*BadRequestErrorHeaders) getWebsitesRes()            {}
-func (*BadRequestErrorHeaders) patchUserRes()              {}
-func (*BadRequestErrorHeaders) patchWebsitesIDRes()        {}
-func (*BadRequestErrorHeaders) postAuthLoginRes()          {}
-func (*BadRequestErrorHeaders) postEventHitRes()           {}
-func (*BadRequestErrorHeaders) postWebsitesRes()           {}
+func (*APIKeyCreatedHeaders) postAPIKeysRes() {}
 
-type ConflictError struct {
-	Error ConflictErrorError `json:"error"`
+// Response body for getting an API key.
+// Ref: #/components/schemas/APIKeyGet
+type APIKeyGet struct {
+	ID   string     `json:"id"`
+	Name string     `json:"name"`
+	Role APIKeyRole `json:"role"`
+	// Website the key is restricted to. Keys without a hostname can access all websites of the user.
+	Hostname OptString `json:"hostname"`
+	// Date the key expires. Keys without an expiry date never expire.
+	ExpiresAt OptDateTime `json:"expiresAt"`
+	// Date the key was last used.
+	LastUsedAt OptDateTime `json:"lastUsedAt"`
+	CreatedAt  time.Time   `json:"createdAt"`
 }
 
-// GetError returns the value of Error.
-func (s *ConflictError) GetError() ConflictErrorError {
-	return s.Error
+// GetID returns the value of ID.
+func (s *APIKeyGet) GetID() string {
+	return s.ID
 }
 
-// SetError sets the value of Error.
-func (s *ConflictError) SetError(val ConflictErrorError) {
-	s.Error = val
+// GetName returns the value of Name.
+func (s *APIKeyGet) GetName() string {
+	return s.Name
 }
 
-type ConflictErrorError struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
+// GetRole returns the value of Role.
+func (s *APIKeyGet) GetRole() APIKeyRole {
+	return s.Role
 }
 
-// GetCode returns the value of Code.
-func (s *ConflictErrorError) GetCode() int32 {
-	return s.Code
+// GetHostname returns the value of Hostname.
+func (s *APIKeyGet) GetHostname() OptString {
+	return s.Hostname
 }
 
-// GetMessage returns the value of Message.
-func (s *ConflictErrorError) GetMessage() string {
-	return s.Message
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *APIKeyGet) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
 }
 
-// SetCode sets the value of Code.
-func (s *ConflictErrorError) SetCode(val int32) {
-	s.Code = val
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *APIKeyGet) GetLastUsedAt() OptDateTime {
+	return s.LastUsedAt
 }
 
-// SetMessage sets the value of Message.
-func (s *ConflictErrorError) SetMessage(val string) {
-	s.Message = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *APIKeyGet) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// ConflictErrorHeaders wraps ConflictError with response headers.
-type ConflictErrorHeaders struct {
-	XAPICommit OptString
-	Response   ConflictError
+// SetID sets the value of ID.
+func (s *APIKeyGet) SetID(val string) {
+	s.ID = val
 }
 
-// GetXAPICommit returns the value of XAPICommit.
-func (s *ConflictErrorHeaders) GetXAPICommit() OptString {
-	return s.XAPICommit
+// SetName sets the value of Name.
+func (s *APIKeyGet) SetName(val string) {
+	s.Name = val
 }
 
-// GetResponse returns the value of Response.
-func (s *ConflictErrorHeaders) GetResponse() ConflictError {
-	return s.Response
+// SetRole sets the value of Role.
+func (s *APIKeyGet) SetRole(val APIKeyRole) {
+	s.Role = val
 }
 
-// SetXAPICommit sets the value of XAPICommit.
-func (s *ConflictErrorHeaders) SetXAPICommit(val OptString) {
-	s.XAPICommit = val
+// SetHostname sets the value of Hostname.
+func (s *APIKeyGet) SetHostname(val OptString) {
+	s.Hostname = val
 }
 
-// SetResponse sets the value of Response.
-func (s *ConflictErrorHeaders) SetResponse(val ConflictError) {
-	s.Response = val
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *APIKeyGet) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
 }
 
-func (*ConflictErrorHeaders) patchUserRes()    {}
-func (*ConflictErrorHeaders) postWebsitesRes() {}
-
-type CookieAuth struct {
-	APIKey string
-	Roles  []string
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *APIKeyGet) SetLastUsedAt(val OptDateTime) {
+	s.LastUsedAt = val
 }
 
-// GetAPIKey returns the value of APIKey.
-func (s *CookieAuth) GetAPIKey() string {
-	return s.APIKey
+// SetCreatedAt sets the value of CreatedAt.
+func (s *APIKeyGet) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// GetRoles returns the value of Roles.
-func (s *CookieAuth) GetRoles() []string {
-	return s.Roles
+// Rights of an API key. Read-only keys can only read websites and stats, while admin keys can also
+// manage websites and goals.
+// Ref: #/components/schemas/APIKeyRole
+type APIKeyRole string
+
+const (
+	APIKeyRoleRead  APIKeyRole = "read"
+	APIKeyRoleAdmin APIKeyRole = "admin"
+)
+
+// AllValues returns all APIKeyRole values.
+func (APIKeyRole) AllValues() []APIKeyRole {
+	return []APIKeyRole{
+		APIKeyRoleRead,
+		APIKeyRoleAdmin,
+	}
 }
 
-// SetAPIKey sets the value of APIKey.
-func (s *CookieAuth) SetAPIKey(val string) {
-	s.APIKey = val
+// MarshalText implements encoding.TextMarshaler.
+func (s APIKeyRole) MarshalText() ([]byte, error) {
+	switch s {
+	case APIKeyRoleRead:
+		return []byte(s), nil
+	case APIKeyRoleAdmin:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetRoles sets the value of Roles.
-func (s *CookieAuth) SetRoles(val []string) {
-	s.Roles = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *APIKeyRole) UnmarshalText(data []byte) error {
+	switch APIKeyRole(data) {
+	case APIKeyRoleRead:
+		*s = APIKeyRoleRead
+		return nil
+	case APIKeyRoleAdmin:
+		*s = APIKeyRoleAdmin
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// DeleteUserNoContent is response for DeleteUser operation.
-type DeleteUserNoContent struct {
-	XAPICommit OptString
+// Value of a field before and after an action. Secret values are redacted.
+// Ref: #/components/schemas/AuditChange
+type AuditChange struct {
+	Field string `json:"field"`
+	// Value before the action, unset if the field was created.
+	Before OptString `json:"before"`
+	// Value after the action, unset if the field was removed.
+	After OptString `json:"after"`
 }
 
-// GetXAPICommit returns the value of XAPICommit.
-func (s *DeleteUserNoContent) GetXAPICommit() OptString {
-	return s.XAPICommit
+// GetField returns the value of Field.
+func (s *AuditChange) GetField() string {
+	return s.Field
 }
 
-// SetXAPICommit sets the value of XAPICommit.
-func (s *DeleteUserNoContent) SetXAPICommit(val OptString) {
-	s.XAPICommit = val
+// GetBefore returns the value of Before.
+func (s *AuditChange) GetBefore() OptString {
+	return s.Before
 }
 
-func (*DeleteUserNoContent) deleteUserRes() {}
+// GetAfter returns the value of After.
+func (s *AuditChange) GetAfter() OptString {
+	return s.After
+}
 
-// DeleteWebsitesIDNoContent is response for DeleteWebsitesID operation.
-type DeleteWebsitesIDNoContent struct {
-	XAPICommit OptString
+// SetField sets the value of Field.
+func (s *AuditChange) SetField(val string) {
+	s.Field = val
 }
 
-// GetXAPICommit returns the value of XAPICommit.
-func (s *DeleteWebsitesIDNoContent) GetXAPICommit() OptString {
-	return s.XAPICommit
+// SetBefore sets the value of Before.
+func (s *AuditChange) SetBefore(val OptString) {
+	s.Before = val
 }
 
-// SetXAPICommit sets the value of XAPICommit.
-func (s *DeleteWebsitesIDNoContent) SetXAPICommit(val OptString) {
-	s.XAPICommit = val
+// SetAfter sets the value of After.
+func (s *AuditChange) SetAfter(val OptString) {
+	s.After = val
 }
 
-func (*DeleteWebsitesIDNoContent) deleteWebsitesIDRes() {}
+// Response body for an administrative action in the audit log.
+// Ref: #/components/schemas/AuditEntry
+type AuditEntry struct {
+	ID string `json:"id"`
+	// ID of the user who performed the action.
+	ActorId string `json:"actorId"`
+	// Username of the user at the time of the action.
+	ActorName string `json:"actorName"`
+	// ID of the API key used, if any.
+	ApiKeyId OptString `json:"apiKeyId"`
+	// Action performed, e.g. website.delete.
+	Action string `json:"action"`
+	// Website, user or other resource the action was performed on.
+	Target  string        `json:"target"`
+	Changes []AuditChange `json:"changes"`
+	// Client IP address of the request.
+	IP          OptString `json:"ip"`
+	DateCreated int64     `json:"dateCreated"`
+}
 
-// Event with custom properties.
-// Ref: #/components/schemas/EventCustom
-type EventCustom struct {
-	// Optional Beacon ID generated for each user to link multiple events on the same page together.
-	B OptString `json:"b"`
-	// Group name of events. Currently, only the hostname is supported.
-	G string `json:"g"`
-	// Custom event properties.
-	D EventCustomD `json:"d"`
+// GetID returns the value of ID.
+func (s *AuditEntry) GetID() string {
+	return s.ID
 }
 
-// GetB returns the value of B.
-func (s *EventCustom) GetB() OptString {
-	return s.B
+// GetActorId returns the value of ActorId.
+func (s *AuditEntry) GetActorId() string {
+	return s.ActorId
 }
 
-// GetG returns the value of G.
-func (s *EventCustom) GetG() string {
-	return s.G
+// GetActorName returns the value of ActorName.
+func (s *AuditEntry) GetActorName() string {
+	return s.ActorName
 }
 
-// GetD returns the value of D.
-func (s *EventCustom) GetD() EventCustomD {
-	return s.D
+// GetApiKeyId returns the value of ApiKeyId.
+func (s *AuditEntry) GetApiKeyId() OptString {
+	return s.ApiKeyId
 }
 
-// SetB sets the value of B.
-func (s *EventCustom) SetB(val OptString) {
-	s.B = val
+// GetAction returns the value of Action.
+func (s *AuditEntry) GetAction() string {
+	return s.Action
 }
 
-// SetG sets the value of G.
-func (s *EventCustom) SetG(val string) {
-	s.G = val
+// GetTarget returns the value of Target.
+func (s *AuditEntry) GetTarget() string {
+	return s.Target
 }
 
-// SetD sets the value of D.
-func (s *EventCustom) SetD(val EventCustomD) {
-	s.D = val
+// GetChanges returns the value of Changes.
+func (s *AuditEntry) GetChanges() []AuditChange {
+	return s.Changes
 }
 
-// Custom event properties.
-type EventCustomD map[string]EventCustomDItem
+// GetIP returns the value of IP.
+func (s *AuditEntry) GetIP() OptString {
+	return s.IP
+}
 
-func (s *EventCustomD) init() EventCustomD {
-	m := *s
-	if m == nil {
-		m = map[string]EventCustomDItem{}
-		*s = m
-	}
-	return m
+// GetDateCreated returns the value of DateCreated.
+func (s *AuditEntry) GetDateCreated() int64 {
+	return s.DateCreated
 }
 
-// EventCustomDItem represents sum type.
-type EventCustomDItem struct {
-	Type   EventCustomDItemType // switch on this field
-	String string
-	Int    int
-	Bool   bool
+// SetID sets the value of ID.
+func (s *AuditEntry) SetID(val string) {
+	s.ID = val
 }
 
-// EventCustomDItemType is oneOf type of EventCustomDItem.
-type EventCustomDItemType string
+// SetActorId sets the value of ActorId.
+func (s *AuditEntry) SetActorId(val string) {
+	s.ActorId = val
+}
 
-// Possible values for EventCustomDItemType.
-const (
-	StringEventCustomDItem EventCustomDItemType = "string"
-	IntEventCustomDItem    EventCustomDItemType = "int"
-	BoolEventCustomDItem   EventCustomDItemType = "bool"
-)
+// SetActorName sets the value of ActorName.
+func (s *AuditEntry) SetActorName(val string) {
+	s.ActorName = val
+}
 
-// IsString reports whether EventCustomDItem is string.
-func (s EventCustomDItem) IsString() bool { return s.Type == StringEventCustomDItem }
+// SetApiKeyId sets the value of ApiKeyId.
+func (s *AuditEntry) SetApiKeyId(val OptString) {
+	s.ApiKeyId = val
+}
 
-// IsInt reports whether EventCustomDItem is int.
-func (s EventCustomDItem) IsInt() bool { return s.Type == IntEventCustomDItem }
+// SetAction sets the value of Action.
+func (s *AuditEntry) SetAction(val string) {
+	s.Action = val
+}
 
-// IsBool reports whether EventCustomDItem is bool.
-func (s EventCustomDItem) IsBool() bool { return s.Type == BoolEventCustomDItem }
+// SetTarget sets the value of Target.
+func (s *AuditEntry) SetTarget(val string) {
+	s.Target = val
+}
 
-// SetString sets EventCustomDItem to string.
-func (s *EventCustomDItem) SetString(v string) {
-	s.Type = StringEventCustomDItem
-	s.String = v
+// SetChanges sets the value of Changes.
+func (s *AuditEntry) SetChanges(val []AuditChange) {
+	s.Changes = val
 }
 
-// GetString returns string and true boolean if EventCustomDItem is string.
-func (s EventCustomDItem) GetString() (v string, ok bool) {
-	if !s.IsString() {
-		return v, false
-	}
-	return s.String, true
+// SetIP sets the value of IP.
+func (s *AuditEntry) SetIP(val OptString) {
+	s.IP = val
 }
 
-// NewStringEventCustomDItem returns new EventCustomDItem from string.
-func NewStringEventCustomDItem(v string) EventCustomDItem {
-	var s EventCustomDItem
-	s.SetString(v)
-	return s
+// SetDateCreated sets the value of DateCreated.
+func (s *AuditEntry) SetDateCreated(val int64) {
+	s.DateCreated = val
 }
 
-// SetInt sets EventCustomDItem to int.
-func (s *EventCustomDItem) SetInt(v int) {
-	s.Type = IntEventCustomDItem
-	s.Int = v
+// Request body for logging in.
+// Ref: #/components/schemas/AuthLogin
+type AuthLogin struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
 }
 
-// GetInt returns int and true boolean if EventCustomDItem is int.
-func (s EventCustomDItem) GetInt() (v int, ok bool) {
-	if !s.IsInt() {
-		return v, false
-	}
-	return s.Int, true
+// GetUsername returns the value of Username.
+func (s *AuthLogin) GetUsername() string {
+	return s.Username
 }
 
-// NewIntEventCustomDItem returns new EventCustomDItem from int.
-func NewIntEventCustomDItem(v int) EventCustomDItem {
-	var s EventCustomDItem
-	s.SetInt(v)
-	return s
+// GetPassword returns the value of Password.
+func (s *AuthLogin) GetPassword() string {
+	return s.Password
 }
 
-// SetBool sets EventCustomDItem to bool.
-func (s *EventCustomDItem) SetBool(v bool) {
-	s.Type = BoolEventCustomDItem
-	s.Bool = v
+// SetUsername sets the value of Username.
+func (s *AuthLogin) SetUsername(val string) {
+	s.Username = val
 }
 
-// GetBool returns bool and true boolean if EventCustomDItem is bool.
-func (s EventCustomDItem) GetBool() (v bool, ok bool) {
-	if !s.IsBool() {
-		return v, false
-	}
-	return s.Bool, true
+// SetPassword sets the value of Password.
+func (s *AuthLogin) SetPassword(val string) {
+	s.Password = val
 }
 
-// NewBoolEventCustomDItem returns new EventCustomDItem from bool.
-func NewBoolEventCustomDItem(v bool) EventCustomDItem {
-	var s EventCustomDItem
-	s.SetBool(v)
-	return s
+// Response body for a login that requires two-factor authentication.
+// Ref: #/components/schemas/AuthLoginChallenge
+type AuthLoginChallenge struct {
+	// Short-lived token used to complete the login.
+	Challenge string `json:"challenge"`
 }
 
-// Website hit event.
-// Ref: #/components/schemas/EventHit
-// EventHit represents sum type.
-type EventHit struct {
-	Type        EventHitType // switch on this field
-	EventLoad   EventLoad
-	EventUnload EventUnload
-	EventCustom EventCustom
+// GetChallenge returns the value of Challenge.
+func (s *AuthLoginChallenge) GetChallenge() string {
+	return s.Challenge
 }
 
-// EventHitType is oneOf type of EventHit.
-type EventHitType string
+// SetChallenge sets the value of Challenge.
+func (s *AuthLoginChallenge) SetChallenge(val string) {
+	s.Challenge = val
+}
 
-// Possible values for EventHitType.
-const (
-	EventLoadEventHit   EventHitType = "load"
-	EventUnloadEventHit EventHitType = "unload"
-	EventCustomEventHit EventHitType = "custom"
-)
+// AuthLoginChallengeHeaders wraps AuthLoginChallenge with response headers.
+type AuthLoginChallengeHeaders struct {
+	XAPICommit OptString
+	Response   AuthLoginChallenge
+}
 
-// IsEventLoad reports whether EventHit is EventLoad.
-func (s EventHit) IsEventLoad() bool { return s.Type == EventLoadEventHit }
+// GetXAPICommit returns the value of XAPICommit.
+func (s *AuthLoginChallengeHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
 
-// IsEventUnload reports whether EventHit is EventUnload.
-func (s EventHit) IsEventUnload() bool { return s.Type == EventUnloadEventHit }
+// GetResponse returns the value of Response.
+func (s *AuthLoginChallengeHeaders) GetResponse() AuthLoginChallenge {
+	return s.Response
+}
 
-// IsEventCustom reports whether EventHit is EventCustom.
-func (s EventHit) IsEventCustom() bool { return s.Type == EventCustomEventHit }
+// SetXAPICommit sets the value of XAPICommit.
+func (s *AuthLoginChallengeHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
 
-// SetEventLoad sets EventHit to EventLoad.
-func (s *EventHit) SetEventLoad(v EventLoad) {
-	s.Type = EventLoadEventHit
-	s.EventLoad = v
+// SetResponse sets the value of Response.
+func (s *AuthLoginChallengeHeaders) SetResponse(val AuthLoginChallenge) {
+	s.Response = val
 }
 
-// GetEventLoad returns EventLoad and true boolean if EventHit is EventLoad.
-func (s EventHit) GetEventLoad() (v EventLoad, ok bool) {
-	if !s.IsEventLoad() {
-		return v, false
-	}
-	return s.EventLoad, true
+func (*AuthLoginChallengeHeaders) postAuthLoginRes() {}
+
+// Request body for completing a login with two-factor authentication.
+// Ref: #/components/schemas/AuthLoginTOTP
+type AuthLoginTOTP struct {
+	Challenge string `json:"challenge"`
+	// Code from the authenticator app or an unused recovery code.
+	Code string `json:"code"`
 }
 
-// NewEventLoadEventHit returns new EventHit from EventLoad.
-func NewEventLoadEventHit(v EventLoad) EventHit {
-	var s EventHit
-	s.SetEventLoad(v)
-	return s
+// GetChallenge returns the value of Challenge.
+func (s *AuthLoginTOTP) GetChallenge() string {
+	return s.Challenge
 }
 
-// SetEventUnload sets EventHit to EventUnload.
-func (s *EventHit) SetEventUnload(v EventUnload) {
-	s.Type = EventUnloadEventHit
-	s.EventUnload = v
+// GetCode returns the value of Code.
+func (s *AuthLoginTOTP) GetCode() string {
+	return s.Code
 }
 
-// GetEventUnload returns EventUnload and true boolean if EventHit is EventUnload.
-func (s EventHit) GetEventUnload() (v EventUnload, ok bool) {
-	if !s.IsEventUnload() {
-		return v, false
-	}
-	return s.EventUnload, true
+// SetChallenge sets the value of Challenge.
+func (s *AuthLoginTOTP) SetChallenge(val string) {
+	s.Challenge = val
 }
 
-// NewEventUnloadEventHit returns new EventHit from EventUnload.
-func NewEventUnloadEventHit(v EventUnload) EventHit {
-	var s EventHit
-	s.SetEventUnload(v)
-	return s
+// SetCode sets the value of Code.
+func (s *AuthLoginTOTP) SetCode(val string) {
+	s.Code = val
 }
 
-// SetEventCustom sets EventHit to EventCustom.
-func (s *EventHit) SetEventCustom(v EventCustom) {
-	s.Type = EventCustomEventHit
-	s.EventCustom = v
+// Login methods enabled on the server.
+// Ref: #/components/schemas/AuthMethods
+type AuthMethods struct {
+	// Whether users can log in with their password.
+	Password bool `json:"password"`
+	// Whether users can log in with the OpenID Connect identity provider.
+	SSO bool `json:"sso"`
 }
 
-// GetEventCustom returns EventCustom and true boolean if EventHit is EventCustom.
-func (s EventHit) GetEventCustom() (v EventCustom, ok bool) {
-	if !s.IsEventCustom() {
-		return v, false
-	}
-	return s.EventCustom, true
+// GetPassword returns the value of Password.
+func (s *AuthMethods) GetPassword() bool {
+	return s.Password
 }
 
-// NewEventCustomEventHit returns new EventHit from EventCustom.
-func NewEventCustomEventHit(v EventCustom) EventHit {
-	var s EventHit
-	s.SetEventCustom(v)
-	return s
+// GetSSO returns the value of SSO.
+func (s *AuthMethods) GetSSO() bool {
+	return s.SSO
 }
 
-// Page view load event.
-// Ref: #/components/schemas/EventLoad
-type EventLoad struct {
-	// Beacon ID generated for each user to link multiple events on the same page together.
-	B string `json:"b"`
-	// Page URL including query parameters.
-	U url.URL `json:"u"`
-	// Referrer URL.
-	R OptString `json:"r"`
-	// If the user is a unique user or not.
-	P bool `json:"p"`
-	// If the user has visited this page before or not.
-	Q bool `json:"q"`
-	// Timezone of the user.
-	T OptString `json:"t"`
-	// Custom event properties.
-	D OptEventLoadD `json:"d"`
+// SetPassword sets the value of Password.
+func (s *AuthMethods) SetPassword(val bool) {
+	s.Password = val
 }
 
-// GetB returns the value of B.
-func (s *EventLoad) GetB() string {
-	return s.B
+// SetSSO sets the value of SSO.
+func (s *AuthMethods) SetSSO(val bool) {
+	s.SSO = val
 }
 
-// GetU returns the value of U.
-func (s *EventLoad) GetU() url.URL {
-	return s.U
+// AuthMethodsHeaders wraps AuthMethods with response headers.
+type AuthMethodsHeaders struct {
+	XAPICommit OptString
+	Response   AuthMethods
 }
 
-// GetR returns the value of R.
-func (s *EventLoad) GetR() OptString {
-	return s.R
+// GetXAPICommit returns the value of XAPICommit.
+func (s *AuthMethodsHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
 }
 
-// GetP returns the value of P.
-func (s *EventLoad) GetP() bool {
-	return s.P
+// GetResponse returns the value of Response.
+func (s *AuthMethodsHeaders) GetResponse() AuthMethods {
+	return s.Response
 }
 
-// GetQ returns the value of Q.
-func (s *EventLoad) GetQ() bool {
-	return s.Q
+// SetXAPICommit sets the value of XAPICommit.
+func (s *AuthMethodsHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
 }
 
-// GetT returns the value of T.
-func (s *EventLoad) GetT() OptString {
-	return s.T
+// SetResponse sets the value of Response.
+func (s *AuthMethodsHeaders) SetResponse(val AuthMethods) {
+	s.Response = val
 }
 
-// GetD returns the value of D.
-func (s *EventLoad) GetD() OptEventLoadD {
-	return s.D
+func (*AuthMethodsHeaders) getAuthMethodsRes() {}
+
+type BadRequestError struct {
+	Error BadRequestErrorError `json:"error"`
 }
 
-// SetB sets the value of B.
-func (s *EventLoad) SetB(val string) {
-	s.B = val
+// GetError returns the value of Error.
+func (s *BadRequestError) GetError() BadRequestErrorError {
+	return s.Error
 }
 
-// SetU sets the value of U.
-func (s *EventLoad) SetU(val url.URL) {
-	s.U = val
+// SetError sets the value of Error.
+func (s *BadRequestError) SetError(val BadRequestErrorError) {
+	s.Error = val
 }
 
-// SetR sets the value of R.
-func (s *EventLoad) SetR(val OptString) {
-	s.R = val
+type BadRequestErrorError struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
 }
 
-// SetP sets the value of P.
-func (s *EventLoad) SetP(val bool) {
-	s.P = val
+// GetCode returns the value of Code.
+func (s *BadRequestErrorError) GetCode() int32 {
+	return s.Code
 }
 
-// SetQ sets the value of Q.
-func (s *EventLoad) SetQ(val bool) {
-	s.Q = val
+// GetMessage returns the value of Message.
+func (s *BadRequestErrorError) GetMessage() string {
+	return s.Message
 }
 
-// SetT sets the value of T.
-func (s *EventLoad) SetT(val OptString) {
-	s.T = val
+// SetCode sets the value of Code.
+func (s *BadRequestErrorError) SetCode(val int32) {
+	s.Code = val
 }
 
-// SetD sets the value of D.
-func (s *EventLoad) SetD(val OptEventLoadD) {
-	s.D = val
+// SetMessage sets the value of Message.
+func (s *BadRequestErrorError) SetMessage(val string) {
+	s.Message = val
 }
 
-// Custom event properties.
-type EventLoadD map[string]EventLoadDItem
+// BadRequestErrorHeaders wraps BadRequestError with response headers.
+type BadRequestErrorHeaders struct {
+	XAPICommit OptString
+	Response   BadRequestError
+}
 
-func (s *EventLoadD) init() EventLoadD {
-	m := *s
-	if m == nil {
-		m = map[string]EventLoadDItem{}
-		*s = m
-	}
-	return m
+// GetXAPICommit returns the value of XAPICommit.
+func (s *BadRequestErrorHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
 }
 
-// EventLoadDItem represents sum type.
-type EventLoadDItem struct {
-	Type   EventLoadDItemType // switch on this field
-	String string
-	Int    int
-	Bool   bool
+// GetResponse returns the value of Response.
+func (s *BadRequestErrorHeaders) GetResponse() BadRequestError {
+	return s.Response
 }
 
-// EventLoadDItemType is oneOf type of EventLoadDItem.
-type EventLoadDItemType string
+// SetXAPICommit sets the value of XAPICommit.
+func (s *BadRequestErrorHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
 
-// Possible values for EventLoadDItemType.
-const (
-	StringEventLoadDItem EventLoadDItemType = "string"
-	IntEventLoadDItem    EventLoadDItemType = "int"
-	BoolEventLoadDItem   EventLoadDItemType = "bool"
-)
+// SetResponse sets the value of Response.
+func (s *BadRequestErrorHeaders) SetResponse(val BadRequestError) {
+	s.Response = val
+}
 
-// IsString reports whether EventLoadDItem is string.
-func (s EventLoadDItem) IsString() bool { return s.Type == StringEventLoadDItem }
+func (*BadRequestErrorHeaders) deleteAuthLockoutsRes()      {}
+func (*BadRequestErrorHeaders) deleteUserRes()              {}
+func (*BadRequestErrorHeaders) deleteWebsitesIDGoalsIDRes() {}
+func (*BadRequestErrorHeaders) deleteWebsitesIDRes()        {}
+func (*BadRequestErrorHeaders) getAuditRes()                {}
+func (*BadRequestErrorHeaders) getAuthOidcCallbackRes()     {}
+func (*BadRequestErrorHeaders) getEventPingRes()            {}
+func (*BadRequestErrorHeaders) getUserRes()                 {}
+func (*BadRequestErrorHeaders) getWebsiteIDBrowsersRes()    {}
+func (*BadRequestErrorHeaders) getWebsiteIDCampaignsRes()   {}
+func (*BadRequestErrorHeaders) getWebsiteIDCountryRes()     {}
+func (*BadRequestErrorHeaders) getWebsiteIDDeviceRes()      {}
+func (*BadRequestErrorHeaders) getWebsiteIDFunnelRes()      {}
+func (*BadRequestErrorHeaders) getWebsiteIDGoalsRes()       {}
+func (*BadRequestErrorHeaders) getWebsiteIDHostnamesRes()   {}
+func (*BadRequestErrorHeaders) getWebsiteIDLanguageRes()    {}
+func (*BadRequestErrorHeaders) getWebsiteIDMediumsRes()     {}
+func (*BadRequestErrorHeaders) getWebsiteIDOsRes()          {}
+func (*BadRequestErrorHeaders) getWebsiteIDPagesEntryRes()  {}
+func (*BadRequestErrorHeaders) getWebsiteIDPagesExitRes()   {}
+func (*BadRequestErrorHeaders) getWebsiteIDPagesRes()       {}
+func (*BadRequestErrorHeaders) getWebsiteIDPropertiesRes()  {}
+func (*BadRequestErrorHeaders) getWebsiteIDRealtimeRes()    {}
+func (*BadRequestErrorHeaders) getWebsiteIDReferrersRes()   {}
+func (*BadRequestErrorHeaders) getWebsiteIDSourcesRes()     {}
+func (*BadRequestErrorHeaders) getWebsiteIDSummaryRes()     {}
+func (*BadRequestErrorHeaders) getWebsiteIDTimeRes()        {}
+func (*BadRequestErrorHeaders) getWebsitesIDDeletionRes()   {}
+func (*BadRequestErrorHeaders) getWebsitesIDGoalsRes()      {}
+func (*BadRequestErrorHeaders) getWebsitesIDRes()           {}
+func (*BadRequestErrorHeaders) getWebsitesRes()             {}
+func (*BadRequestErrorHeaders) patchReportsIDRes()          {}
+func (*BadRequestErrorHeaders) patchUserRes()               {}
+func (*BadRequestErrorHeaders) patchUsersIDRes()            {}
+func (*BadRequestErrorHeaders) patchWebsitesIDGoalsIDRes()  {}
+func (*BadRequestErrorHeaders) patchWebsitesIDRes()         {}
+func (*BadRequestErrorHeaders) postAPIKeysRes()             {}
+func (*BadRequestErrorHeaders) postAuthLoginRes()           {}
+func (*BadRequestErrorHeaders) postAuthLoginTotpRes()       {}
+func (*BadRequestErrorHeaders) postEventHitRes()            {}
+func (*BadRequestErrorHeaders) postReportsRes()             {}
+func (*BadRequestErrorHeaders) postUserTotpConfirmRes()     {}
+func (*BadRequestErrorHeaders) postUserTotpDisableRes()     {}
+func (*BadRequestErrorHeaders) postUsersRes()               {}
+func (*BadRequestErrorHeaders) postWebsitesIDGoalsRes()     {}
+func (*BadRequestErrorHeaders) postWebsitesIDWebhooksRes()  {}
+func (*BadRequestErrorHeaders) postWebsitesRes()            {}
+
+type BearerAuth struct {
+	Token string
+	Roles []string
+}
+
+// GetToken returns the value of Token.
+func (s *BearerAuth) GetToken() string {
+	return s.Token
+}
 
-// IsInt reports whether EventLoadDItem is int.
-func (s EventLoadDItem) IsInt() bool { return s.Type == IntEventLoadDItem }
-
-// IsBool reports whether EventLoadDItem is bool.
-func (s EventLoadDItem) IsBool() bool { return s.Type == BoolEventLoadDItem }
-
-// SetString sets EventLoadDItem to string.
-func (s *EventLoadDItem) SetString(v string) {
-	s.Type = StringEventLoadDItem
-	s.String = v
+// GetRoles returns the value of Roles.
+func (s *BearerAuth) GetRoles() []string {
+	return s.Roles
 }
 
-// GetString returns string and true boolean if EventLoadDItem is string.
-func (s EventLoadDItem) GetString() (v string, ok bool) {
-	if !s.IsString() {
-		return v, false
-	}
-	return s.String, true
+// SetToken sets the value of Token.
+func (s *BearerAuth) SetToken(val string) {
+	s.Token = val
 }
 
-// NewStringEventLoadDItem returns new EventLoadDItem from string.
-func NewStringEventLoadDItem(v string) EventLoadDItem {
-	var s EventLoadDItem
-	s.SetString(v)
-	return s
+// SetRoles sets the value of Roles.
+func (s *BearerAuth) SetRoles(val []string) {
+	s.Roles = val
 }
 
-// SetInt sets EventLoadDItem to int.
-func (s *EventLoadDItem) SetInt(v int) {
-	s.Type = IntEventLoadDItem
-	s.Int = v
+type ConflictError struct {
+	Error ConflictErrorError `json:"error"`
 }
 
-// GetInt returns int and true boolean if EventLoadDItem is int.
-func (s EventLoadDItem) GetInt() (v int, ok bool) {
-	if !s.IsInt() {
-		return v, false
-	}
-	return s.Int, true
+// GetError returns the value of Error.
+func (s *ConflictError) GetError() ConflictErrorError {
+	return s.Error
 }
 
-// NewIntEventLoadDItem returns new EventLoadDItem from int.
-func NewIntEventLoadDItem(v int) EventLoadDItem {
-	var s EventLoadDItem
-	s.SetInt(v)
-	return s
+// SetError sets the value of Error.
+func (s *ConflictError) SetError(val ConflictErrorError) {
+	s.Error = val
 }
 
-// SetBool sets EventLoadDItem to bool.
-func (s *EventLoadDItem) SetBool(v bool) {
-	s.Type = BoolEventLoadDItem
-	s.Bool = v
+type ConflictErrorError struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
 }
 
-// GetBool returns bool and true boolean if EventLoadDItem is bool.
-func (s EventLoadDItem) GetBool() (v bool, ok bool) {
-	if !s.IsBool() {
-		return v, false
-	}
-	return s.Bool, true
+// GetCode returns the value of Code.
+func (s *ConflictErrorError) GetCode() int32 {
+	return s.Code
 }
 
-// NewBoolEventLoadDItem returns new EventLoadDItem from bool.
-func NewBoolEventLoadDItem(v bool) EventLoadDItem {
-	var s EventLoadDItem
-	s.SetBool(v)
-	return s
+// GetMessage returns the value of Message.
+func (s *ConflictErrorError) GetMessage() string {
+	return s.Message
 }
 
-// Page view unload event.
-// Ref: #/components/schemas/EventUnload
-type EventUnload struct {
-	// Beacon ID generated for each user to link multiple events on the same page together.
-	B string `json:"b"`
-	// Time spent on page in milliseconds.
-	M int `json:"m"`
+// SetCode sets the value of Code.
+func (s *ConflictErrorError) SetCode(val int32) {
+	s.Code = val
 }
 
-// GetB returns the value of B.
-func (s *EventUnload) GetB() string {
-	return s.B
+// SetMessage sets the value of Message.
+func (s *ConflictErrorError) SetMessage(val string) {
+	s.Message = val
 }
 
-// GetM returns the value of M.
-func (s *EventUnload) GetM() int {
-	return s.M
+// ConflictErrorHeaders wraps ConflictError with response headers.
+type ConflictErrorHeaders struct {
+	XAPICommit OptString
+	Response   ConflictError
 }
 
-// SetB sets the value of B.
-func (s *EventUnload) SetB(val string) {
-	s.B = val
+// GetXAPICommit returns the value of XAPICommit.
+func (s *ConflictErrorHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
 }
 
-// SetM sets the value of M.
-func (s *EventUnload) SetM(val int) {
-	s.M = val
+// GetResponse returns the value of Response.
+func (s *ConflictErrorHeaders) GetResponse() ConflictError {
+	return s.Response
 }
 
-// Ref: #/components/schemas/FilterString
-type FilterString struct {
-	// Equal to.
-	Eq OptString `json:"eq"`
-	// Not equal to.
-	Neq OptString `json:"neq"`
-	// Contains.
-	Contains OptString `json:"contains"`
-	// Does not contain.
-	NotContains OptString `json:"not_contains"`
-	// Starts with.
-	StartsWith OptString `json:"starts_with"`
-	// Does not start with.
-	NotStartsWith OptString `json:"not_starts_with"`
-	// Ends with.
-	EndsWith OptString `json:"ends_with"`
-	// Does not end with.
-	NotEndsWith OptString `json:"not_ends_with"`
-	// In.
-	In OptString `json:"in"`
-	// Not in.
-	NotIn OptString `json:"not_in"`
+// SetXAPICommit sets the value of XAPICommit.
+func (s *ConflictErrorHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
 }
 
-// GetEq returns the value of Eq.
-func (s *FilterString) GetEq() OptString {
-	return s.Eq
+// SetResponse sets the value of Response.
+func (s *ConflictErrorHeaders) SetResponse(val ConflictError) {
+	s.Response = val
 }
 
-// GetNeq returns the value of Neq.
-func (s *FilterString) GetNeq() OptString {
-	return s.Neq
+func (*ConflictErrorHeaders) getAuthOidcCallbackRes() {}
+func (*ConflictErrorHeaders) patchUserRes()           {}
+func (*ConflictErrorHeaders) patchWebsitesIDRes()     {}
+func (*ConflictErrorHeaders) postUserTotpConfirmRes() {}
+func (*ConflictErrorHeaders) postUserTotpRes()        {}
+func (*ConflictErrorHeaders) postUsersRes()           {}
+func (*ConflictErrorHeaders) postWebsitesRes()        {}
+
+type CookieAuth struct {
+	APIKey string
+	Roles  []string
 }
 
-// GetContains returns the value of Contains.
-func (s *FilterString) GetContains() OptString {
-	return s.Contains
+// GetAPIKey returns the value of APIKey.
+func (s *CookieAuth) GetAPIKey() string {
+	return s.APIKey
 }
 
-// GetNotContains returns the value of NotContains.
-func (s *FilterString) GetNotContains() OptString {
-	return s.NotContains
+// GetRoles returns the value of Roles.
+func (s *CookieAuth) GetRoles() []string {
+	return s.Roles
 }
 
-// GetStartsWith returns the value of StartsWith.
-func (s *FilterString) GetStartsWith() OptString {
-	return s.StartsWith
+// SetAPIKey sets the value of APIKey.
+func (s *CookieAuth) SetAPIKey(val string) {
+	s.APIKey = val
 }
 
-// GetNotStartsWith returns the value of NotStartsWith.
-func (s *FilterString) GetNotStartsWith() OptString {
-	return s.NotStartsWith
+// SetRoles sets the value of Roles.
+func (s *CookieAuth) SetRoles(val []string) {
+	s.Roles = val
 }
 
-// GetEndsWith returns the value of EndsWith.
-func (s *FilterString) GetEndsWith() OptString {
-	return s.EndsWith
+// DeleteAPIKeysIDNoContent is response for DeleteAPIKeysID operation.
+type DeleteAPIKeysIDNoContent struct {
+	XAPICommit OptString
 }
 
-// GetNotEndsWith returns the value of NotEndsWith.
-func (s *FilterString) GetNotEndsWith() OptString {
-	return s.NotEndsWith
+// GetXAPICommit returns the value of XAPICommit.
+func (s *DeleteAPIKeysIDNoContent) GetXAPICommit() OptString {
+	return s.XAPICommit
 }
 
-// GetIn returns the value of In.
-func (s *FilterString) GetIn() OptString {
-	return s.In
+// SetXAPICommit sets the value of XAPICommit.
+func (s *DeleteAPIKeysIDNoContent) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
 }
 
-// GetNotIn returns the value of NotIn.
-func (s *FilterString) GetNotIn() OptString {
-	return s.NotIn
+func (*DeleteAPIKeysIDNoContent) deleteAPIKeysIDRes() {}
+
+// DeleteAuthLockoutsNoContent is response for DeleteAuthLockouts operation.
+type DeleteAuthLockoutsNoContent struct {
+	XAPICommit OptString
 }
 
-// SetEq sets the value of Eq.
-func (s *FilterString) SetEq(val OptString) {
-	s.Eq = val
+// GetXAPICommit returns the value of XAPICommit.
+func (s *DeleteAuthLockoutsNoContent) GetXAPICommit() OptString {
+	return s.XAPICommit
 }
 
-// SetNeq sets the value of Neq.
-func (s *FilterString) SetNeq(val OptString) {
-	s.Neq = val
+// SetXAPICommit sets the value of XAPICommit.
+func (s *DeleteAuthLockoutsNoContent) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
 }
 
-// SetContains sets the value of Contains.
-func (s *FilterString) SetContains(val OptString) {
-	s.Contains = val
+func (*DeleteAuthLockoutsNoContent) deleteAuthLockoutsRes() {}
+
+// DeleteReportsIDNoContent is response for DeleteReportsID operation.
+type DeleteReportsIDNoContent struct {
+	XAPICommit OptString
 }
 
-// SetNotContains sets the value of NotContains.
-func (s *FilterString) SetNotContains(val OptString) {
-	s.NotContains = val
+// GetXAPICommit returns the value of XAPICommit.
+func (s *DeleteReportsIDNoContent) GetXAPICommit() OptString {
+	return s.XAPICommit
 }
 
-// SetStartsWith sets the value of StartsWith.
-func (s *FilterString) SetStartsWith(val OptString) {
-	s.StartsWith = val
+// SetXAPICommit sets the value of XAPICommit.
+func (s *DeleteReportsIDNoContent) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
 }
 
-// SetNotStartsWith sets the value of NotStartsWith.
-func (s *FilterString) SetNotStartsWith(val OptString) {
-	s.NotStartsWith = val
+func (*DeleteReportsIDNoContent) deleteReportsIDRes() {}
+
+// DeleteUserNoContent is response for DeleteUser operation.
+type DeleteUserNoContent struct {
+	XAPICommit OptString
 }
 
-// SetEndsWith sets the value of EndsWith.
-func (s *FilterString) SetEndsWith(val OptString) {
-	s.EndsWith = val
+// GetXAPICommit returns the value of XAPICommit.
+func (s *DeleteUserNoContent) GetXAPICommit() OptString {
+	return s.XAPICommit
 }
 
-// SetNotEndsWith sets the value of NotEndsWith.
-func (s *FilterString) SetNotEndsWith(val OptString) {
-	s.NotEndsWith = val
+// SetXAPICommit sets the value of XAPICommit.
+func (s *DeleteUserNoContent) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
 }
 
-// SetIn sets the value of In.
-func (s *FilterString) SetIn(val OptString) {
-	s.In = val
+func (*DeleteUserNoContent) deleteUserRes() {}
+
+// DeleteUserSessionsNoContent is response for DeleteUserSessions operation.
+type DeleteUserSessionsNoContent struct {
+	SetCookie  string
+	XAPICommit OptString
 }
 
-// SetNotIn sets the value of NotIn.
-func (s *FilterString) SetNotIn(val OptString) {
-	s.NotIn = val
+// GetSetCookie returns the value of SetCookie.
+func (s *DeleteUserSessionsNoContent) GetSetCookie() string {
+	return s.SetCookie
 }
 
-type ForbiddenError struct {
-	Error ForbiddenErrorError `json:"error"`
+// GetXAPICommit returns the value of XAPICommit.
+func (s *DeleteUserSessionsNoContent) GetXAPICommit() OptString {
+	return s.XAPICommit
 }
 
-// GetError returns the value of Error.
-func (s *ForbiddenError) GetError() ForbiddenErrorError {
-	return s.Error
+// SetSetCookie sets the value of SetCookie.
+func (s *DeleteUserSessionsNoContent) SetSetCookie(val string) {
+	s.SetCookie = val
 }
 
-// SetError sets the value of Error.
-func (s *ForbiddenError) SetError(val ForbiddenErrorError) {
-	s.Error = val
+// SetXAPICommit sets the value of XAPICommit.
+func (s *DeleteUserSessionsNoContent) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
 }
 
-type ForbiddenErrorError struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
+func (*DeleteUserSessionsNoContent) deleteUserSessionsRes() {}
+
+// DeleteUsersIDNoContent is response for DeleteUsersID operation.
+type DeleteUsersIDNoContent struct {
+	XAPICommit OptString
 }
 
-// GetCode returns the value of Code.
-func (s *ForbiddenErrorError) GetCode() int32 {
-	return s.Code
+// GetXAPICommit returns the value of XAPICommit.
+func (s *DeleteUsersIDNoContent) GetXAPICommit() OptString {
+	return s.XAPICommit
 }
 
-// GetMessage returns the value of Message.
-func (s *ForbiddenErrorError) GetMessage() string {
-	return s.Message
+// SetXAPICommit sets the value of XAPICommit.
+func (s *DeleteUsersIDNoContent) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
 }
 
-// SetCode sets the value of Code.
-func (s *ForbiddenErrorError) SetCode(val int32) {
-	s.Code = val
+func (*DeleteUsersIDNoContent) deleteUsersIDRes() {}
+
+type DeleteWebsitesIDAccepted WebsiteDeletionHeaders
+
+func (*DeleteWebsitesIDAccepted) deleteWebsitesIDRes() {}
+
+// DeleteWebsitesIDGoalsIDNoContent is response for DeleteWebsitesIDGoalsID operation.
+type DeleteWebsitesIDGoalsIDNoContent struct {
+	XAPICommit OptString
 }
 
-// SetMessage sets the value of Message.
-func (s *ForbiddenErrorError) SetMessage(val string) {
-	s.Message = val
+// GetXAPICommit returns the value of XAPICommit.
+func (s *DeleteWebsitesIDGoalsIDNoContent) GetXAPICommit() OptString {
+	return s.XAPICommit
 }
 
-// ForbiddenErrorHeaders wraps ForbiddenError with response headers.
-type ForbiddenErrorHeaders struct {
+// SetXAPICommit sets the value of XAPICommit.
+func (s *DeleteWebsitesIDGoalsIDNoContent) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+func (*DeleteWebsitesIDGoalsIDNoContent) deleteWebsitesIDGoalsIDRes() {}
+
+type DeleteWebsitesIDOK WebsiteDeletionHeaders
+
+func (*DeleteWebsitesIDOK) deleteWebsitesIDRes() {}
+
+// DeleteWebsitesIDWebhooksIDNoContent is response for DeleteWebsitesIDWebhooksID operation.
+type DeleteWebsitesIDWebhooksIDNoContent struct {
 	XAPICommit OptString
-	Response   ForbiddenError
 }
 
 // GetXAPICommit returns the value of XAPICommit.
-func (s *ForbiddenErrorHeaders) GetXAPICommit() OptString {
+func (s *DeleteWebsitesIDWebhooksIDNoContent) GetXAPICommit() OptString {
 	return s.XAPICommit
 }
 
-// GetResponse returns the value of Response.
-func (s *ForbiddenErrorHeaders) GetResponse() ForbiddenError {
-	return s.Response
+// SetXAPICommit sets the value of XAPICommit.
+func (s *DeleteWebsitesIDWebhooksIDNoContent) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+func (*DeleteWebsitesIDWebhooksIDNoContent) deleteWebsitesIDWebhooksIDRes() {}
+
+// Event with custom properties.
+// Ref: #/components/schemas/EventCustom
+type EventCustom struct {
+	// Optional Beacon ID generated for each user to link multiple events on the same page together.
+	B OptString `json:"b"`
+	// Group name of events. Currently, only the hostname is supported.
+	G string `json:"g"`
+	// Custom event properties.
+	D EventCustomD `json:"d"`
+}
+
+// GetB returns the value of B.
+func (s *EventCustom) GetB() OptString {
+	return s.B
+}
+
+// GetG returns the value of G.
+func (s *EventCustom) GetG() string {
+	return s.G
+}
+
+// GetD returns the value of D.
+func (s *EventCustom) GetD() EventCustomD {
+	return s.D
+}
+
+// SetB sets the value of B.
+func (s *EventCustom) SetB(val OptString) {
+	s.B = val
+}
+
+// SetG sets the value of G.
+func (s *EventCustom) SetG(val string) {
+	s.G = val
+}
+
+// SetD sets the value of D.
+func (s *EventCustom) SetD(val EventCustomD) {
+	s.D = val
+}
+
+// Custom event properties.
+type EventCustomD map[string]EventCustomDItem
+
+func (s *EventCustomD) init() EventCustomD {
+	m := *s
+	if m == nil {
+		m = map[string]EventCustomDItem{}
+		*s = m
+	}
+	return m
+}
+
+// EventCustomDItem represents sum type.
+type EventCustomDItem struct {
+	Type   EventCustomDItemType // switch on this field
+	String string
+	Int    int
+	Bool   bool
+}
+
+// EventCustomDItemType is oneOf type of EventCustomDItem.
+type EventCustomDItemType string
+
+// Possible values for EventCustomDItemType.
+const (
+	StringEventCustomDItem EventCustomDItemType = "string"
+	IntEventCustomDItem    EventCustomDItemType = "int"
+	BoolEventCustomDItem   EventCustomDItemType = "bool"
+)
+
+// IsString reports whether EventCustomDItem is string.
+func (s EventCustomDItem) IsString() bool { return s.Type == StringEventCustomDItem }
+
+// IsInt reports whether EventCustomDItem is int.
+func (s EventCustomDItem) IsInt() bool { return s.Type == IntEventCustomDItem }
+
+// IsBool reports whether EventCustomDItem is bool.
+func (s EventCustomDItem) IsBool() bool { return s.Type == BoolEventCustomDItem }
+
+// SetString sets EventCustomDItem to string.
+func (s *EventCustomDItem) SetString(v string) {
+	s.Type = StringEventCustomDItem
+	s.String = v
+}
+
+// GetString returns string and true boolean if EventCustomDItem is string.
+func (s EventCustomDItem) GetString() (v string, ok bool) {
+	if !s.IsString() {
+		return v, false
+	}
+	return s.String, true
+}
+
+// NewStringEventCustomDItem returns new EventCustomDItem from string.
+func NewStringEventCustomDItem(v string) EventCustomDItem {
+	var s EventCustomDItem
+	s.SetString(v)
+	return s
+}
+
+// SetInt sets EventCustomDItem to int.
+func (s *EventCustomDItem) SetInt(v int) {
+	s.Type = IntEventCustomDItem
+	s.Int = v
+}
+
+// GetInt returns int and true boolean if EventCustomDItem is int.
+func (s EventCustomDItem) GetInt() (v int, ok bool) {
+	if !s.IsInt() {
+		return v, false
+	}
+	return s.Int, true
+}
+
+// NewIntEventCustomDItem returns new EventCustomDItem from int.
+func NewIntEventCustomDItem(v int) EventCustomDItem {
+	var s EventCustomDItem
+	s.SetInt(v)
+	return s
+}
+
+// SetBool sets EventCustomDItem to bool.
+func (s *EventCustomDItem) SetBool(v bool) {
+	s.Type = BoolEventCustomDItem
+	s.Bool = v
+}
+
+// GetBool returns bool and true boolean if EventCustomDItem is bool.
+func (s EventCustomDItem) GetBool() (v bool, ok bool) {
+	if !s.IsBool() {
+		return v, false
+	}
+	return s.Bool, true
+}
+
+// NewBoolEventCustomDItem returns new EventCustomDItem from bool.
+func NewBoolEventCustomDItem(v bool) EventCustomDItem {
+	var s EventCustomDItem
+	s.SetBool(v)
+	return s
+}
+
+// Website hit event.
+// Ref: #/components/schemas/EventHit
+// EventHit represents sum type.
+type EventHit struct {
+	Type        EventHitType // switch on this field
+	EventLoad   EventLoad
+	EventUnload EventUnload
+	EventCustom EventCustom
+}
+
+// EventHitType is oneOf type of EventHit.
+type EventHitType string
+
+// Possible values for EventHitType.
+const (
+	EventLoadEventHit   EventHitType = "load"
+	EventUnloadEventHit EventHitType = "unload"
+	EventCustomEventHit EventHitType = "custom"
+)
+
+// IsEventLoad reports whether EventHit is EventLoad.
+func (s EventHit) IsEventLoad() bool { return s.Type == EventLoadEventHit }
+
+// IsEventUnload reports whether EventHit is EventUnload.
+func (s EventHit) IsEventUnload() bool { return s.Type == EventUnloadEventHit }
+
+// IsEventCustom reports whether EventHit is EventCustom.
+func (s EventHit) IsEventCustom() bool { return s.Type == EventCustomEventHit }
+
+// SetEventLoad sets EventHit to EventLoad.
+func (s *EventHit) SetEventLoad(v EventLoad) {
+	s.Type = EventLoadEventHit
+	s.EventLoad = v
+}
+
+// GetEventLoad returns EventLoad and true boolean if EventHit is EventLoad.
+func (s EventHit) GetEventLoad() (v EventLoad, ok bool) {
+	if !s.IsEventLoad() {
+		return v, false
+	}
+	return s.EventLoad, true
+}
+
+// NewEventLoadEventHit returns new EventHit from EventLoad.
+func NewEventLoadEventHit(v EventLoad) EventHit {
+	var s EventHit
+	s.SetEventLoad(v)
+	return s
+}
+
+// SetEventUnload sets EventHit to EventUnload.
+func (s *EventHit) SetEventUnload(v EventUnload) {
+	s.Type = EventUnloadEventHit
+	s.EventUnload = v
+}
+
+// GetEventUnload returns EventUnload and true boolean if EventHit is EventUnload.
+func (s EventHit) GetEventUnload() (v EventUnload, ok bool) {
+	if !s.IsEventUnload() {
+		return v, false
+	}
+	return s.EventUnload, true
+}
+
+// NewEventUnloadEventHit returns new EventHit from EventUnload.
+func NewEventUnloadEventHit(v EventUnload) EventHit {
+	var s EventHit
+	s.SetEventUnload(v)
+	return s
+}
+
+// SetEventCustom sets EventHit to EventCustom.
+func (s *EventHit) SetEventCustom(v EventCustom) {
+	s.Type = EventCustomEventHit
+	s.EventCustom = v
+}
+
+// GetEventCustom returns EventCustom and true boolean if EventHit is EventCustom.
+func (s EventHit) GetEventCustom() (v EventCustom, ok bool) {
+	if !s.IsEventCustom() {
+		return v, false
+	}
+	return s.EventCustom, true
+}
+
+// NewEventCustomEventHit returns new EventHit from EventCustom.
+func NewEventCustomEventHit(v EventCustom) EventHit {
+	var s EventHit
+	s.SetEventCustom(v)
+	return s
+}
+
+// Page view load event.
+// Ref: #/components/schemas/EventLoad
+type EventLoad struct {
+	// Beacon ID generated for each user to link multiple events on the same page together.
+	B string `json:"b"`
+	// Page URL including query parameters.
+	U url.URL `json:"u"`
+	// Referrer URL.
+	R OptString `json:"r"`
+	// If the user is a unique user or not.
+	P bool `json:"p"`
+	// If the user has visited this page before or not.
+	Q bool `json:"q"`
+	// Timezone of the user.
+	T OptString `json:"t"`
+	// Custom event properties.
+	D OptEventLoadD `json:"d"`
+}
+
+// GetB returns the value of B.
+func (s *EventLoad) GetB() string {
+	return s.B
+}
+
+// GetU returns the value of U.
+func (s *EventLoad) GetU() url.URL {
+	return s.U
+}
+
+// GetR returns the value of R.
+func (s *EventLoad) GetR() OptString {
+	return s.R
+}
+
+// GetP returns the value of P.
+func (s *EventLoad) GetP() bool {
+	return s.P
+}
+
+// GetQ returns the value of Q.
+func (s *EventLoad) GetQ() bool {
+	return s.Q
+}
+
+// GetT returns the value of T.
+func (s *EventLoad) GetT() OptString {
+	return s.T
+}
+
+// GetD returns the value of D.
+func (s *EventLoad) GetD() OptEventLoadD {
+	return s.D
+}
+
+// SetB sets the value of B.
+func (s *EventLoad) SetB(val string) {
+	s.B = val
+}
+
+// SetU sets the value of U.
+func (s *EventLoad) SetU(val url.URL) {
+	s.U = val
+}
+
+// SetR sets the value of R.
+func (s *EventLoad) SetR(val OptString) {
+	s.R = val
+}
+
+// SetP sets the value of P.
+func (s *EventLoad) SetP(val bool) {
+	s.P = val
+}
+
+// SetQ sets the value of Q.
+func (s *EventLoad) SetQ(val bool) {
+	s.Q = val
+}
+
+// SetT sets the value of T.
+func (s *EventLoad) SetT(val OptString) {
+	s.T = val
+}
+
+// SetD sets the value of D.
+func (s *EventLoad) SetD(val OptEventLoadD) {
+	s.D = val
+}
+
+// Custom event properties.
+type EventLoadD map[string]EventLoadDItem
+
+func (s *EventLoadD) init() EventLoadD {
+	m := *s
+	if m == nil {
+		m = map[string]EventLoadDItem{}
+		*s = m
+	}
+	return m
+}
+
+// EventLoadDItem represents sum type.
+type EventLoadDItem struct {
+	Type   EventLoadDItemType // switch on this field
+	String string
+	Int    int
+	Bool   bool
+}
+
+// EventLoadDItemType is oneOf type of EventLoadDItem.
+type EventLoadDItemType string
+
+// Possible values for EventLoadDItemType.
+const (
+	StringEventLoadDItem EventLoadDItemType = "string"
+	IntEventLoadDItem    EventLoadDItemType = "int"
+	BoolEventLoadDItem   EventLoadDItemType = "bool"
+)
+
+// IsString reports whether EventLoadDItem is string.
+func (s EventLoadDItem) IsString() bool { return s.Type == StringEventLoadDItem }
+
+// IsInt reports whether EventLoadDItem is int.
+func (s EventLoadDItem) IsInt() bool { return s.Type == IntEventLoadDItem }
+
+// IsBool reports whether EventLoadDItem is bool.
+func (s EventLoadDItem) IsBool() bool { return s.Type == BoolEventLoadDItem }
+
+// SetString sets EventLoadDItem to string.
+func (s *EventLoadDItem) SetString(v string) {
+	s.Type = StringEventLoadDItem
+	s.String = v
+}
+
+// GetString returns string and true boolean if EventLoadDItem is string.
+func (s EventLoadDItem) GetString() (v string, ok bool) {
+	if !s.IsString() {
+		return v, false
+	}
+	return s.String, true
+}
+
+// NewStringEventLoadDItem returns new EventLoadDItem from string.
+func NewStringEventLoadDItem(v string) EventLoadDItem {
+	var s EventLoadDItem
+	s.SetString(v)
+	return s
+}
+
+// SetInt sets EventLoadDItem to int.
+func (s *EventLoadDItem) SetInt(v int) {
+	s.Type = IntEventLoadDItem
+	s.Int = v
+}
+
+// GetInt returns int and true boolean if EventLoadDItem is int.
+func (s EventLoadDItem) GetInt() (v int, ok bool) {
+	if !s.IsInt() {
+		return v, false
+	}
+	return s.Int, true
+}
+
+// NewIntEventLoadDItem returns new EventLoadDItem from int.
+func NewIntEventLoadDItem(v int) EventLoadDItem {
+	var s EventLoadDItem
+	s.SetInt(v)
+	return s
+}
+
+// SetBool sets EventLoadDItem to bool.
+func (s *EventLoadDItem) SetBool(v bool) {
+	s.Type = BoolEventLoadDItem
+	s.Bool = v
+}
+
+// GetBool returns bool and true boolean if EventLoadDItem is bool.
+func (s EventLoadDItem) GetBool() (v bool, ok bool) {
+	if !s.IsBool() {
+		return v, false
+	}
+	return s.Bool, true
+}
+
+// NewBoolEventLoadDItem returns new EventLoadDItem from bool.
+func NewBoolEventLoadDItem(v bool) EventLoadDItem {
+	var s EventLoadDItem
+	s.SetBool(v)
+	return s
+}
+
+// Page view unload event.
+// Ref: #/components/schemas/EventUnload
+type EventUnload struct {
+	// Beacon ID generated for each user to link multiple events on the same page together.
+	B string `json:"b"`
+	// Time spent on page in milliseconds.
+	M int `json:"m"`
+}
+
+// GetB returns the value of B.
+func (s *EventUnload) GetB() string {
+	return s.B
+}
+
+// GetM returns the value of M.
+func (s *EventUnload) GetM() int {
+	return s.M
+}
+
+// SetB sets the value of B.
+func (s *EventUnload) SetB(val string) {
+	s.B = val
+}
+
+// SetM sets the value of M.
+func (s *EventUnload) SetM(val int) {
+	s.M = val
+}
+
+// Ref: #/components/schemas/FilterString
+type FilterString struct {
+	// Equal to.
+	Eq OptString `json:"eq"`
+	// Not equal to.
+	Neq OptString `json:"neq"`
+	// Contains.
+	Contains OptString `json:"contains"`
+	// Does not contain.
+	NotContains OptString `json:"not_contains"`
+	// Starts with.
+	StartsWith OptString `json:"starts_with"`
+	// Does not start with.
+	NotStartsWith OptString `json:"not_starts_with"`
+	// Ends with.
+	EndsWith OptString `json:"ends_with"`
+	// Does not end with.
+	NotEndsWith OptString `json:"not_ends_with"`
+	// In a comma separated list of values.
+	In OptString `json:"in"`
+	// Not in a comma separated list of values.
+	NotIn OptString `json:"not_in"`
+}
+
+// GetEq returns the value of Eq.
+func (s *FilterString) GetEq() OptString {
+	return s.Eq
+}
+
+// GetNeq returns the value of Neq.
+func (s *FilterString) GetNeq() OptString {
+	return s.Neq
+}
+
+// GetContains returns the value of Contains.
+func (s *FilterString) GetContains() OptString {
+	return s.Contains
+}
+
+// GetNotContains returns the value of NotContains.
+func (s *FilterString) GetNotContains() OptString {
+	return s.NotContains
+}
+
+// GetStartsWith returns the value of StartsWith.
+func (s *FilterString) GetStartsWith() OptString {
+	return s.StartsWith
+}
+
+// GetNotStartsWith returns the value of NotStartsWith.
+func (s *FilterString) GetNotStartsWith() OptString {
+	return s.NotStartsWith
+}
+
+// GetEndsWith returns the value of EndsWith.
+func (s *FilterString) GetEndsWith() OptString {
+	return s.EndsWith
+}
+
+// GetNotEndsWith returns the value of NotEndsWith.
+func (s *FilterString) GetNotEndsWith() OptString {
+	return s.NotEndsWith
+}
+
+// GetIn returns the value of In.
+func (s *FilterString) GetIn() OptString {
+	return s.In
+}
+
+// GetNotIn returns the value of NotIn.
+func (s *FilterString) GetNotIn() OptString {
+	return s.NotIn
+}
+
+// SetEq sets the value of Eq.
+func (s *FilterString) SetEq(val OptString) {
+	s.Eq = val
+}
+
+// SetNeq sets the value of Neq.
+func (s *FilterString) SetNeq(val OptString) {
+	s.Neq = val
+}
+
+// SetContains sets the value of Contains.
+func (s *FilterString) SetContains(val OptString) {
+	s.Contains = val
+}
+
+// SetNotContains sets the value of NotContains.
+func (s *FilterString) SetNotContains(val OptString) {
+	s.NotContains = val
+}
+
+// SetStartsWith sets the value of StartsWith.
+func (s *FilterString) SetStartsWith(val OptString) {
+	s.StartsWith = val
+}
+
+// SetNotStartsWith sets the value of NotStartsWith.
+func (s *FilterString) SetNotStartsWith(val OptString) {
+	s.NotStartsWith = val
+}
+
+// SetEndsWith sets the value of EndsWith.
+func (s *FilterString) SetEndsWith(val OptString) {
+	s.EndsWith = val
+}
+
+// SetNotEndsWith sets the value of NotEndsWith.
+func (s *FilterString) SetNotEndsWith(val OptString) {
+	s.NotEndsWith = val
+}
+
+// SetIn sets the value of In.
+func (s *FilterString) SetIn(val OptString) {
+	s.In = val
+}
+
+// SetNotIn sets the value of NotIn.
+func (s *FilterString) SetNotIn(val OptString) {
+	s.NotIn = val
+}
+
+type ForbiddenError struct {
+	Error ForbiddenErrorError `json:"error"`
+}
+
+// GetError returns the value of Error.
+func (s *ForbiddenError) GetError() ForbiddenErrorError {
+	return s.Error
+}
+
+// SetError sets the value of Error.
+func (s *ForbiddenError) SetError(val ForbiddenErrorError) {
+	s.Error = val
+}
+
+type ForbiddenErrorError struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *ForbiddenErrorError) GetCode() int32 {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *ForbiddenErrorError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *ForbiddenErrorError) SetCode(val int32) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ForbiddenErrorError) SetMessage(val string) {
+	s.Message = val
+}
+
+// ForbiddenErrorHeaders wraps ForbiddenError with response headers.
+type ForbiddenErrorHeaders struct {
+	XAPICommit OptString
+	Response   ForbiddenError
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *ForbiddenErrorHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *ForbiddenErrorHeaders) GetResponse() ForbiddenError {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *ForbiddenErrorHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ForbiddenErrorHeaders) SetResponse(val ForbiddenError) {
+	s.Response = val
+}
+
+func (*ForbiddenErrorHeaders) deleteAPIKeysIDRes()                   {}
+func (*ForbiddenErrorHeaders) deleteAuthLockoutsRes()                {}
+func (*ForbiddenErrorHeaders) deleteReportsIDRes()                   {}
+func (*ForbiddenErrorHeaders) deleteUserRes()                        {}
+func (*ForbiddenErrorHeaders) deleteUserSessionsRes()                {}
+func (*ForbiddenErrorHeaders) deleteUsersIDRes()                     {}
+func (*ForbiddenErrorHeaders) deleteWebsitesIDGoalsIDRes()           {}
+func (*ForbiddenErrorHeaders) deleteWebsitesIDRes()                  {}
+func (*ForbiddenErrorHeaders) deleteWebsitesIDWebhooksIDRes()        {}
+func (*ForbiddenErrorHeaders) getAuditRes()                          {}
+func (*ForbiddenErrorHeaders) getAuthLockoutsRes()                   {}
+func (*ForbiddenErrorHeaders) getAuthOidcCallbackRes()               {}
+func (*ForbiddenErrorHeaders) getUsersRes()                          {}
+func (*ForbiddenErrorHeaders) getWebsiteIDBrowsersRes()              {}
+func (*ForbiddenErrorHeaders) getWebsiteIDCampaignsRes()             {}
+func (*ForbiddenErrorHeaders) getWebsiteIDCountryRes()               {}
+func (*ForbiddenErrorHeaders) getWebsiteIDDeviceRes()                {}
+func (*ForbiddenErrorHeaders) getWebsiteIDFunnelRes()                {}
+func (*ForbiddenErrorHeaders) getWebsiteIDGoalsRes()                 {}
+func (*ForbiddenErrorHeaders) getWebsiteIDHostnamesRes()             {}
+func (*ForbiddenErrorHeaders) getWebsiteIDLanguageRes()              {}
+func (*ForbiddenErrorHeaders) getWebsiteIDMediumsRes()               {}
+func (*ForbiddenErrorHeaders) getWebsiteIDOsRes()                    {}
+func (*ForbiddenErrorHeaders) getWebsiteIDPropertiesRes()            {}
+func (*ForbiddenErrorHeaders) getWebsiteIDRealtimeRes()              {}
+func (*ForbiddenErrorHeaders) getWebsiteIDReferrersRes()             {}
+func (*ForbiddenErrorHeaders) getWebsiteIDSourcesRes()               {}
+func (*ForbiddenErrorHeaders) getWebsitesIDGoalsRes()                {}
+func (*ForbiddenErrorHeaders) getWebsitesIDWebhooksIDDeliveriesRes() {}
+func (*ForbiddenErrorHeaders) getWebsitesIDWebhooksRes()             {}
+func (*ForbiddenErrorHeaders) patchReportsIDRes()                    {}
+func (*ForbiddenErrorHeaders) patchTenantSettingsRes()               {}
+func (*ForbiddenErrorHeaders) patchUserRes()                         {}
+func (*ForbiddenErrorHeaders) patchUsersIDRes()                      {}
+func (*ForbiddenErrorHeaders) patchWebsitesIDGoalsIDRes()            {}
+func (*ForbiddenErrorHeaders) patchWebsitesIDRes()                   {}
+func (*ForbiddenErrorHeaders) postAPIKeysRes()                       {}
+func (*ForbiddenErrorHeaders) postAuthLoginRes()                     {}
+func (*ForbiddenErrorHeaders) postReportsRes()                       {}
+func (*ForbiddenErrorHeaders) postUserTotpConfirmRes()               {}
+func (*ForbiddenErrorHeaders) postUserTotpDisableRes()               {}
+func (*ForbiddenErrorHeaders) postUserTotpRes()                      {}
+func (*ForbiddenErrorHeaders) postUsersRes()                         {}
+func (*ForbiddenErrorHeaders) postWebsitesIDGoalsRes()               {}
+func (*ForbiddenErrorHeaders) postWebsitesIDWebhooksRes()            {}
+func (*ForbiddenErrorHeaders) postWebsitesRes()                      {}
+
+type Format string
+
+const (
+	FormatCsv    Format = "csv"
+	FormatNdjson Format = "ndjson"
+)
+
+// AllValues returns all Format values.
+func (Format) AllValues() []Format {
+	return []Format{
+		FormatCsv,
+		FormatNdjson,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Format) MarshalText() ([]byte, error) {
+	switch s {
+	case FormatCsv:
+		return []byte(s), nil
+	case FormatNdjson:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Format) UnmarshalText(data []byte) error {
+	switch Format(data) {
+	case FormatCsv:
+		*s = FormatCsv
+		return nil
+	case FormatNdjson:
+		*s = FormatNdjson
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// GetAPIKeysOKHeaders wraps []APIKeyGet with response headers.
+type GetAPIKeysOKHeaders struct {
+	XAPICommit OptString
+	Response   []APIKeyGet
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *GetAPIKeysOKHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *GetAPIKeysOKHeaders) GetResponse() []APIKeyGet {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *GetAPIKeysOKHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetAPIKeysOKHeaders) SetResponse(val []APIKeyGet) {
+	s.Response = val
+}
+
+func (*GetAPIKeysOKHeaders) getAPIKeysRes() {}
+
+// GetAuditOKHeaders wraps []AuditEntry with response headers.
+type GetAuditOKHeaders struct {
+	XAPICommit OptString
+	Response   []AuditEntry
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *GetAuditOKHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *GetAuditOKHeaders) GetResponse() []AuditEntry {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *GetAuditOKHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetAuditOKHeaders) SetResponse(val []AuditEntry) {
+	s.Response = val
+}
+
+func (*GetAuditOKHeaders) getAuditRes() {}
+
+// GetAuthLockoutsOKHeaders wraps []LoginLockout with response headers.
+type GetAuthLockoutsOKHeaders struct {
+	XAPICommit OptString
+	Response   []LoginLockout
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *GetAuthLockoutsOKHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *GetAuthLockoutsOKHeaders) GetResponse() []LoginLockout {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *GetAuthLockoutsOKHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetAuthLockoutsOKHeaders) SetResponse(val []LoginLockout) {
+	s.Response = val
+}
+
+func (*GetAuthLockoutsOKHeaders) getAuthLockoutsRes() {}
+
+// GetAuthOidcCallbackFound is response for GetAuthOidcCallback operation.
+type GetAuthOidcCallbackFound struct {
+	Location   string
+	SetCookie  string
+	XAPICommit OptString
+}
+
+// GetLocation returns the value of Location.
+func (s *GetAuthOidcCallbackFound) GetLocation() string {
+	return s.Location
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *GetAuthOidcCallbackFound) GetSetCookie() string {
+	return s.SetCookie
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *GetAuthOidcCallbackFound) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// SetLocation sets the value of Location.
+func (s *GetAuthOidcCallbackFound) SetLocation(val string) {
+	s.Location = val
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *GetAuthOidcCallbackFound) SetSetCookie(val string) {
+	s.SetCookie = val
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *GetAuthOidcCallbackFound) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+func (*GetAuthOidcCallbackFound) getAuthOidcCallbackRes() {}
+
+// GetAuthOidcLoginFound is response for GetAuthOidcLogin operation.
+type GetAuthOidcLoginFound struct {
+	Location   string
+	SetCookie  string
+	XAPICommit OptString
+}
+
+// GetLocation returns the value of Location.
+func (s *GetAuthOidcLoginFound) GetLocation() string {
+	return s.Location
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *GetAuthOidcLoginFound) GetSetCookie() string {
+	return s.SetCookie
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *GetAuthOidcLoginFound) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// SetLocation sets the value of Location.
+func (s *GetAuthOidcLoginFound) SetLocation(val string) {
+	s.Location = val
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *GetAuthOidcLoginFound) SetSetCookie(val string) {
+	s.SetCookie = val
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *GetAuthOidcLoginFound) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+func (*GetAuthOidcLoginFound) getAuthOidcLoginRes() {}
+
+// This is set to 0 if the user is a unique user, otherwise 1.
+type GetEventPingOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetEventPingOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// GetEventPingOKHeaders wraps GetEventPingOK with response headers.
+type GetEventPingOKHeaders struct {
+	CacheControl string
+	LastModified string
+	Response     GetEventPingOK
+}
+
+// GetCacheControl returns the value of CacheControl.
+func (s *GetEventPingOKHeaders) GetCacheControl() string {
+	return s.CacheControl
+}
+
+// GetLastModified returns the value of LastModified.
+func (s *GetEventPingOKHeaders) GetLastModified() string {
+	return s.LastModified
+}
+
+// GetResponse returns the value of Response.
+func (s *GetEventPingOKHeaders) GetResponse() GetEventPingOK {
+	return s.Response
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *GetEventPingOKHeaders) SetCacheControl(val string) {
+	s.CacheControl = val
+}
+
+// SetLastModified sets the value of LastModified.
+func (s *GetEventPingOKHeaders) SetLastModified(val string) {
+	s.LastModified = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetEventPingOKHeaders) SetResponse(val GetEventPingOK) {
+	s.Response = val
+}
+
+func (*GetEventPingOKHeaders) getEventPingRes() {}
+
+// GetReportsOKHeaders wraps []ReportGet with response headers.
+type GetReportsOKHeaders struct {
+	XAPICommit OptString
+	Response   []ReportGet
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *GetReportsOKHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *GetReportsOKHeaders) GetResponse() []ReportGet {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *GetReportsOKHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetReportsOKHeaders) SetResponse(val []ReportGet) {
+	s.Response = val
+}
+
+func (*GetReportsOKHeaders) getReportsRes() {}
+
+// GetUserSessionsOKHeaders wraps []UserSession with response headers.
+type GetUserSessionsOKHeaders struct {
+	XAPICommit OptString
+	Response   []UserSession
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *GetUserSessionsOKHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *GetUserSessionsOKHeaders) GetResponse() []UserSession {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *GetUserSessionsOKHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetUserSessionsOKHeaders) SetResponse(val []UserSession) {
+	s.Response = val
+}
+
+func (*GetUserSessionsOKHeaders) getUserSessionsRes() {}
+
+// GetUsersOKHeaders wraps []UserAccount with response headers.
+type GetUsersOKHeaders struct {
+	XAPICommit OptString
+	Response   []UserAccount
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *GetUsersOKHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *GetUsersOKHeaders) GetResponse() []UserAccount {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *GetUsersOKHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetUsersOKHeaders) SetResponse(val []UserAccount) {
+	s.Response = val
+}
+
+func (*GetUsersOKHeaders) getUsersRes() {}
+
+type GetWebsiteIDBrowsersApplicationXNdjsonOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDBrowsersApplicationXNdjsonOK) getWebsiteIDBrowsersRes() {}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDBrowsersOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDBrowsersOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders wraps GetWebsiteIDBrowsersOKApplicationXNdjson with response headers.
+type GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders struct {
+	ContentDisposition OptString
+	XAPICommit         OptString
+	Response           GetWebsiteIDBrowsersOKApplicationXNdjson
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders) GetResponse() GetWebsiteIDBrowsersOKApplicationXNdjson {
+	return s.Response
+}
+
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders) SetResponse(val GetWebsiteIDBrowsersOKApplicationXNdjson) {
+	s.Response = val
+}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDBrowsersOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDBrowsersOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type GetWebsiteIDBrowsersTextCsvOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDBrowsersTextCsvOK) getWebsiteIDBrowsersRes() {}
+
+type GetWebsiteIDCampaignsApplicationXNdjsonOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDCampaignsApplicationXNdjsonOK) getWebsiteIDCampaignsRes() {}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDCampaignsOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDCampaignsOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDCampaignsOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDCampaignsOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type GetWebsiteIDCampaignsTextCsvOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDCampaignsTextCsvOK) getWebsiteIDCampaignsRes() {}
+
+type GetWebsiteIDCountryApplicationXNdjsonOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDCountryApplicationXNdjsonOK) getWebsiteIDCountryRes() {}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDCountryOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDCountryOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDCountryOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDCountryOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type GetWebsiteIDCountryTextCsvOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDCountryTextCsvOK) getWebsiteIDCountryRes() {}
+
+type GetWebsiteIDDeviceApplicationXNdjsonOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDDeviceApplicationXNdjsonOK) getWebsiteIDDeviceRes() {}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDDeviceOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDDeviceOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDDeviceOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDDeviceOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type GetWebsiteIDDeviceTextCsvOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDDeviceTextCsvOK) getWebsiteIDDeviceRes() {}
+
+type GetWebsiteIDFunnelApplicationXNdjsonOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDFunnelApplicationXNdjsonOK) getWebsiteIDFunnelRes() {}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDFunnelOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDFunnelOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDFunnelOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDFunnelOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type GetWebsiteIDFunnelTextCsvOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDFunnelTextCsvOK) getWebsiteIDFunnelRes() {}
+
+type GetWebsiteIDGoalsApplicationXNdjsonOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDGoalsApplicationXNdjsonOK) getWebsiteIDGoalsRes() {}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDGoalsOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDGoalsOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDGoalsOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDGoalsOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type GetWebsiteIDGoalsTextCsvOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDGoalsTextCsvOK) getWebsiteIDGoalsRes() {}
+
+type GetWebsiteIDHostnamesApplicationXNdjsonOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDHostnamesApplicationXNdjsonOK) getWebsiteIDHostnamesRes() {}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDHostnamesOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDHostnamesOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDHostnamesOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDHostnamesOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type GetWebsiteIDHostnamesTextCsvOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDHostnamesTextCsvOK) getWebsiteIDHostnamesRes() {}
+
+type GetWebsiteIDLanguageApplicationXNdjsonOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDLanguageApplicationXNdjsonOK) getWebsiteIDLanguageRes() {}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDLanguageOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDLanguageOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDLanguageOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDLanguageOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type GetWebsiteIDLanguageTextCsvOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDLanguageTextCsvOK) getWebsiteIDLanguageRes() {}
+
+type GetWebsiteIDMediumsApplicationXNdjsonOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDMediumsApplicationXNdjsonOK) getWebsiteIDMediumsRes() {}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDMediumsOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDMediumsOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDMediumsOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDMediumsOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type GetWebsiteIDMediumsTextCsvOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDMediumsTextCsvOK) getWebsiteIDMediumsRes() {}
+
+type GetWebsiteIDOsApplicationXNdjsonOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDOsApplicationXNdjsonOK) getWebsiteIDOsRes() {}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDOsOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDOsOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDOsOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDOsOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type GetWebsiteIDOsTextCsvOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDOsTextCsvOK) getWebsiteIDOsRes() {}
+
+type GetWebsiteIDPagesApplicationXNdjsonOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDPagesApplicationXNdjsonOK) getWebsiteIDPagesRes() {}
+
+type GetWebsiteIDPagesEntryApplicationXNdjsonOK StatsVisitPagesApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDPagesEntryApplicationXNdjsonOK) getWebsiteIDPagesEntryRes() {}
+
+type GetWebsiteIDPagesEntryTextCsvOK StatsVisitPagesApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDPagesEntryTextCsvOK) getWebsiteIDPagesEntryRes() {}
+
+type GetWebsiteIDPagesExitApplicationXNdjsonOK StatsVisitPagesApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDPagesExitApplicationXNdjsonOK) getWebsiteIDPagesExitRes() {}
+
+type GetWebsiteIDPagesExitTextCsvOK StatsVisitPagesApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDPagesExitTextCsvOK) getWebsiteIDPagesExitRes() {}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDPagesOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDPagesOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDPagesOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDPagesOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type GetWebsiteIDPagesTextCsvOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDPagesTextCsvOK) getWebsiteIDPagesRes() {}
+
+type GetWebsiteIDPropertiesApplicationXNdjsonOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDPropertiesApplicationXNdjsonOK) getWebsiteIDPropertiesRes() {}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDPropertiesOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDPropertiesOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDPropertiesOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDPropertiesOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type GetWebsiteIDPropertiesTextCsvOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDPropertiesTextCsvOK) getWebsiteIDPropertiesRes() {}
+
+type GetWebsiteIDReferrersApplicationXNdjsonOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDReferrersApplicationXNdjsonOK) getWebsiteIDReferrersRes() {}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDReferrersOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDReferrersOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDReferrersOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDReferrersOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type GetWebsiteIDReferrersTextCsvOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDReferrersTextCsvOK) getWebsiteIDReferrersRes() {}
+
+type GetWebsiteIDSourcesApplicationXNdjsonOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDSourcesApplicationXNdjsonOK) getWebsiteIDSourcesRes() {}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDSourcesOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDSourcesOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDSourcesOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDSourcesOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type GetWebsiteIDSourcesTextCsvOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDSourcesTextCsvOK) getWebsiteIDSourcesRes() {}
+
+type GetWebsiteIDSummaryInterval string
+
+const (
+	GetWebsiteIDSummaryIntervalMinute GetWebsiteIDSummaryInterval = "minute"
+	GetWebsiteIDSummaryIntervalHour   GetWebsiteIDSummaryInterval = "hour"
+	GetWebsiteIDSummaryIntervalDay    GetWebsiteIDSummaryInterval = "day"
+	GetWebsiteIDSummaryIntervalWeek   GetWebsiteIDSummaryInterval = "week"
+	GetWebsiteIDSummaryIntervalMonth  GetWebsiteIDSummaryInterval = "month"
+)
+
+// AllValues returns all GetWebsiteIDSummaryInterval values.
+func (GetWebsiteIDSummaryInterval) AllValues() []GetWebsiteIDSummaryInterval {
+	return []GetWebsiteIDSummaryInterval{
+		GetWebsiteIDSummaryIntervalMinute,
+		GetWebsiteIDSummaryIntervalHour,
+		GetWebsiteIDSummaryIntervalDay,
+		GetWebsiteIDSummaryIntervalWeek,
+		GetWebsiteIDSummaryIntervalMonth,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetWebsiteIDSummaryInterval) MarshalText() ([]byte, error) {
+	switch s {
+	case GetWebsiteIDSummaryIntervalMinute:
+		return []byte(s), nil
+	case GetWebsiteIDSummaryIntervalHour:
+		return []byte(s), nil
+	case GetWebsiteIDSummaryIntervalDay:
+		return []byte(s), nil
+	case GetWebsiteIDSummaryIntervalWeek:
+		return []byte(s), nil
+	case GetWebsiteIDSummaryIntervalMonth:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetWebsiteIDSummaryInterval) UnmarshalText(data []byte) error {
+	switch GetWebsiteIDSummaryInterval(data) {
+	case GetWebsiteIDSummaryIntervalMinute:
+		*s = GetWebsiteIDSummaryIntervalMinute
+		return nil
+	case GetWebsiteIDSummaryIntervalHour:
+		*s = GetWebsiteIDSummaryIntervalHour
+		return nil
+	case GetWebsiteIDSummaryIntervalDay:
+		*s = GetWebsiteIDSummaryIntervalDay
+		return nil
+	case GetWebsiteIDSummaryIntervalWeek:
+		*s = GetWebsiteIDSummaryIntervalWeek
+		return nil
+	case GetWebsiteIDSummaryIntervalMonth:
+		*s = GetWebsiteIDSummaryIntervalMonth
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetWebsiteIDTimeApplicationXNdjsonOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDTimeApplicationXNdjsonOK) getWebsiteIDTimeRes() {}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDTimeOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDTimeOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type GetWebsiteIDTimeOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetWebsiteIDTimeOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type GetWebsiteIDTimeTextCsvOK GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders
+
+func (*GetWebsiteIDTimeTextCsvOK) getWebsiteIDTimeRes() {}
+
+// GetWebsitesIDGoalsOKHeaders wraps []GoalGet with response headers.
+type GetWebsitesIDGoalsOKHeaders struct {
+	XAPICommit OptString
+	Response   []GoalGet
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *GetWebsitesIDGoalsOKHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *GetWebsitesIDGoalsOKHeaders) GetResponse() []GoalGet {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *GetWebsitesIDGoalsOKHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetWebsitesIDGoalsOKHeaders) SetResponse(val []GoalGet) {
+	s.Response = val
+}
+
+func (*GetWebsitesIDGoalsOKHeaders) getWebsitesIDGoalsRes() {}
+
+// GetWebsitesIDWebhooksIDDeliveriesOKHeaders wraps []WebhookDelivery with response headers.
+type GetWebsitesIDWebhooksIDDeliveriesOKHeaders struct {
+	XAPICommit OptString
+	Response   []WebhookDelivery
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *GetWebsitesIDWebhooksIDDeliveriesOKHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *GetWebsitesIDWebhooksIDDeliveriesOKHeaders) GetResponse() []WebhookDelivery {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *GetWebsitesIDWebhooksIDDeliveriesOKHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetWebsitesIDWebhooksIDDeliveriesOKHeaders) SetResponse(val []WebhookDelivery) {
+	s.Response = val
+}
+
+func (*GetWebsitesIDWebhooksIDDeliveriesOKHeaders) getWebsitesIDWebhooksIDDeliveriesRes() {}
+
+// GetWebsitesIDWebhooksOKHeaders wraps []WebhookGet with response headers.
+type GetWebsitesIDWebhooksOKHeaders struct {
+	XAPICommit OptString
+	Response   []WebhookGet
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *GetWebsitesIDWebhooksOKHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *GetWebsitesIDWebhooksOKHeaders) GetResponse() []WebhookGet {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *GetWebsitesIDWebhooksOKHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetWebsitesIDWebhooksOKHeaders) SetResponse(val []WebhookGet) {
+	s.Response = val
+}
+
+func (*GetWebsitesIDWebhooksOKHeaders) getWebsitesIDWebhooksRes() {}
+
+// GetWebsitesOKHeaders wraps []WebsiteGet with response headers.
+type GetWebsitesOKHeaders struct {
+	XAPICommit OptString
+	Response   []WebsiteGet
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *GetWebsitesOKHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *GetWebsitesOKHeaders) GetResponse() []WebsiteGet {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *GetWebsitesOKHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetWebsitesOKHeaders) SetResponse(val []WebsiteGet) {
+	s.Response = val
+}
+
+func (*GetWebsitesOKHeaders) getWebsitesRes() {}
+
+// Request body for creating a goal.
+// Ref: #/components/schemas/GoalCreate
+type GoalCreate struct {
+	Name       string    `json:"name"`
+	Type       GoalType  `json:"type"`
+	Pathname   OptString `json:"pathname"`
+	EventName  OptString `json:"eventName"`
+	EventValue OptString `json:"eventValue"`
+}
+
+// GetName returns the value of Name.
+func (s *GoalCreate) GetName() string {
+	return s.Name
+}
+
+// GetType returns the value of Type.
+func (s *GoalCreate) GetType() GoalType {
+	return s.Type
+}
+
+// GetPathname returns the value of Pathname.
+func (s *GoalCreate) GetPathname() OptString {
+	return s.Pathname
+}
+
+// GetEventName returns the value of EventName.
+func (s *GoalCreate) GetEventName() OptString {
+	return s.EventName
+}
+
+// GetEventValue returns the value of EventValue.
+func (s *GoalCreate) GetEventValue() OptString {
+	return s.EventValue
+}
+
+// SetName sets the value of Name.
+func (s *GoalCreate) SetName(val string) {
+	s.Name = val
+}
+
+// SetType sets the value of Type.
+func (s *GoalCreate) SetType(val GoalType) {
+	s.Type = val
+}
+
+// SetPathname sets the value of Pathname.
+func (s *GoalCreate) SetPathname(val OptString) {
+	s.Pathname = val
+}
+
+// SetEventName sets the value of EventName.
+func (s *GoalCreate) SetEventName(val OptString) {
+	s.EventName = val
+}
+
+// SetEventValue sets the value of EventValue.
+func (s *GoalCreate) SetEventValue(val OptString) {
+	s.EventValue = val
+}
+
+// Response body for getting a goal.
+// Ref: #/components/schemas/GoalGet
+type GoalGet struct {
+	ID   string   `json:"id"`
+	Name string   `json:"name"`
+	Type GoalType `json:"type"`
+	// Glob pattern matched against the page pathname for pathname goals.
+	Pathname OptString `json:"pathname"`
+	// Custom property name matched for event goals.
+	EventName OptString `json:"eventName"`
+	// Custom property value matched for event goals. Matches any value if empty.
+	EventValue OptString `json:"eventValue"`
+}
+
+// GetID returns the value of ID.
+func (s *GoalGet) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *GoalGet) GetName() string {
+	return s.Name
+}
+
+// GetType returns the value of Type.
+func (s *GoalGet) GetType() GoalType {
+	return s.Type
+}
+
+// GetPathname returns the value of Pathname.
+func (s *GoalGet) GetPathname() OptString {
+	return s.Pathname
+}
+
+// GetEventName returns the value of EventName.
+func (s *GoalGet) GetEventName() OptString {
+	return s.EventName
+}
+
+// GetEventValue returns the value of EventValue.
+func (s *GoalGet) GetEventValue() OptString {
+	return s.EventValue
+}
+
+// SetID sets the value of ID.
+func (s *GoalGet) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *GoalGet) SetName(val string) {
+	s.Name = val
+}
+
+// SetType sets the value of Type.
+func (s *GoalGet) SetType(val GoalType) {
+	s.Type = val
+}
+
+// SetPathname sets the value of Pathname.
+func (s *GoalGet) SetPathname(val OptString) {
+	s.Pathname = val
+}
+
+// SetEventName sets the value of EventName.
+func (s *GoalGet) SetEventName(val OptString) {
+	s.EventName = val
+}
+
+// SetEventValue sets the value of EventValue.
+func (s *GoalGet) SetEventValue(val OptString) {
+	s.EventValue = val
+}
+
+// GoalGetHeaders wraps GoalGet with response headers.
+type GoalGetHeaders struct {
+	XAPICommit OptString
+	Response   GoalGet
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *GoalGetHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *GoalGetHeaders) GetResponse() GoalGet {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *GoalGetHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GoalGetHeaders) SetResponse(val GoalGet) {
+	s.Response = val
+}
+
+func (*GoalGetHeaders) patchWebsitesIDGoalsIDRes() {}
+func (*GoalGetHeaders) postWebsitesIDGoalsRes()    {}
+
+// Request body for updating a goal.
+// Ref: #/components/schemas/GoalPatch
+type GoalPatch struct {
+	Name       OptString   `json:"name"`
+	Type       OptGoalType `json:"type"`
+	Pathname   OptString   `json:"pathname"`
+	EventName  OptString   `json:"eventName"`
+	EventValue OptString   `json:"eventValue"`
+}
+
+// GetName returns the value of Name.
+func (s *GoalPatch) GetName() OptString {
+	return s.Name
+}
+
+// GetType returns the value of Type.
+func (s *GoalPatch) GetType() OptGoalType {
+	return s.Type
+}
+
+// GetPathname returns the value of Pathname.
+func (s *GoalPatch) GetPathname() OptString {
+	return s.Pathname
+}
+
+// GetEventName returns the value of EventName.
+func (s *GoalPatch) GetEventName() OptString {
+	return s.EventName
+}
+
+// GetEventValue returns the value of EventValue.
+func (s *GoalPatch) GetEventValue() OptString {
+	return s.EventValue
+}
+
+// SetName sets the value of Name.
+func (s *GoalPatch) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetType sets the value of Type.
+func (s *GoalPatch) SetType(val OptGoalType) {
+	s.Type = val
+}
+
+// SetPathname sets the value of Pathname.
+func (s *GoalPatch) SetPathname(val OptString) {
+	s.Pathname = val
+}
+
+// SetEventName sets the value of EventName.
+func (s *GoalPatch) SetEventName(val OptString) {
+	s.EventName = val
+}
+
+// SetEventValue sets the value of EventValue.
+func (s *GoalPatch) SetEventValue(val OptString) {
+	s.EventValue = val
+}
+
+// Goal matching strategy.
+// Ref: #/components/schemas/GoalType
+type GoalType string
+
+const (
+	GoalTypePathname GoalType = "pathname"
+	GoalTypeEvent    GoalType = "event"
+)
+
+// AllValues returns all GoalType values.
+func (GoalType) AllValues() []GoalType {
+	return []GoalType{
+		GoalTypePathname,
+		GoalTypeEvent,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GoalType) MarshalText() ([]byte, error) {
+	switch s {
+	case GoalTypePathname:
+		return []byte(s), nil
+	case GoalTypeEvent:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GoalType) UnmarshalText(data []byte) error {
+	switch GoalType(data) {
+	case GoalTypePathname:
+		*s = GoalTypePathname
+		return nil
+	case GoalTypeEvent:
+		*s = GoalTypeEvent
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type InternalServerError struct {
+	Error InternalServerErrorError `json:"error"`
+}
+
+// GetError returns the value of Error.
+func (s *InternalServerError) GetError() InternalServerErrorError {
+	return s.Error
+}
+
+// SetError sets the value of Error.
+func (s *InternalServerError) SetError(val InternalServerErrorError) {
+	s.Error = val
+}
+
+type InternalServerErrorError struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *InternalServerErrorError) GetCode() int32 {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *InternalServerErrorError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *InternalServerErrorError) SetCode(val int32) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *InternalServerErrorError) SetMessage(val string) {
+	s.Message = val
+}
+
+// InternalServerErrorHeaders wraps InternalServerError with response headers.
+type InternalServerErrorHeaders struct {
+	XAPICommit OptString
+	Response   InternalServerError
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *InternalServerErrorHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *InternalServerErrorHeaders) GetResponse() InternalServerError {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *InternalServerErrorHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *InternalServerErrorHeaders) SetResponse(val InternalServerError) {
+	s.Response = val
+}
+
+func (*InternalServerErrorHeaders) deleteAPIKeysIDRes()                   {}
+func (*InternalServerErrorHeaders) deleteAuthLockoutsRes()                {}
+func (*InternalServerErrorHeaders) deleteReportsIDRes()                   {}
+func (*InternalServerErrorHeaders) deleteUserRes()                        {}
+func (*InternalServerErrorHeaders) deleteUserSessionsRes()                {}
+func (*InternalServerErrorHeaders) deleteUsersIDRes()                     {}
+func (*InternalServerErrorHeaders) deleteWebsitesIDGoalsIDRes()           {}
+func (*InternalServerErrorHeaders) deleteWebsitesIDRes()                  {}
+func (*InternalServerErrorHeaders) deleteWebsitesIDWebhooksIDRes()        {}
+func (*InternalServerErrorHeaders) getAPIKeysRes()                        {}
+func (*InternalServerErrorHeaders) getAuditRes()                          {}
+func (*InternalServerErrorHeaders) getAuthLockoutsRes()                   {}
+func (*InternalServerErrorHeaders) getAuthMethodsRes()                    {}
+func (*InternalServerErrorHeaders) getAuthOidcCallbackRes()               {}
+func (*InternalServerErrorHeaders) getAuthOidcLoginRes()                  {}
+func (*InternalServerErrorHeaders) getEventPingRes()                      {}
+func (*InternalServerErrorHeaders) getReportsRes()                        {}
+func (*InternalServerErrorHeaders) getTenantSettingsRes()                 {}
+func (*InternalServerErrorHeaders) getUserRes()                           {}
+func (*InternalServerErrorHeaders) getUserSessionsRes()                   {}
+func (*InternalServerErrorHeaders) getUserUsageRes()                      {}
+func (*InternalServerErrorHeaders) getUsersRes()                          {}
+func (*InternalServerErrorHeaders) getWebsiteIDBrowsersRes()              {}
+func (*InternalServerErrorHeaders) getWebsiteIDCampaignsRes()             {}
+func (*InternalServerErrorHeaders) getWebsiteIDCountryRes()               {}
+func (*InternalServerErrorHeaders) getWebsiteIDDeviceRes()                {}
+func (*InternalServerErrorHeaders) getWebsiteIDFunnelRes()                {}
+func (*InternalServerErrorHeaders) getWebsiteIDGoalsRes()                 {}
+func (*InternalServerErrorHeaders) getWebsiteIDHostnamesRes()             {}
+func (*InternalServerErrorHeaders) getWebsiteIDLanguageRes()              {}
+func (*InternalServerErrorHeaders) getWebsiteIDMediumsRes()               {}
+func (*InternalServerErrorHeaders) getWebsiteIDOsRes()                    {}
+func (*InternalServerErrorHeaders) getWebsiteIDPagesEntryRes()            {}
+func (*InternalServerErrorHeaders) getWebsiteIDPagesExitRes()             {}
+func (*InternalServerErrorHeaders) getWebsiteIDPagesRes()                 {}
+func (*InternalServerErrorHeaders) getWebsiteIDPropertiesRes()            {}
+func (*InternalServerErrorHeaders) getWebsiteIDRealtimeRes()              {}
+func (*InternalServerErrorHeaders) getWebsiteIDReferrersRes()             {}
+func (*InternalServerErrorHeaders) getWebsiteIDSourcesRes()               {}
+func (*InternalServerErrorHeaders) getWebsiteIDSummaryRes()               {}
+func (*InternalServerErrorHeaders) getWebsiteIDTimeRes()                  {}
+func (*InternalServerErrorHeaders) getWebsitesIDDeletionRes()             {}
+func (*InternalServerErrorHeaders) getWebsitesIDGoalsRes()                {}
+func (*InternalServerErrorHeaders) getWebsitesIDRes()                     {}
+func (*InternalServerErrorHeaders) getWebsitesIDWebhooksIDDeliveriesRes() {}
+func (*InternalServerErrorHeaders) getWebsitesIDWebhooksRes()             {}
+func (*InternalServerErrorHeaders) getWebsitesRes()                       {}
+func (*InternalServerErrorHeaders) patchReportsIDRes()                    {}
+func (*InternalServerErrorHeaders) patchTenantSettingsRes()               {}
+func (*InternalServerErrorHeaders) patchUserRes()                         {}
+func (*InternalServerErrorHeaders) patchUsersIDRes()                      {}
+func (*InternalServerErrorHeaders) patchWebsitesIDGoalsIDRes()            {}
+func (*InternalServerErrorHeaders) patchWebsitesIDRes()                   {}
+func (*InternalServerErrorHeaders) postAPIKeysRes()                       {}
+func (*InternalServerErrorHeaders) postAuthLoginRes()                     {}
+func (*InternalServerErrorHeaders) postAuthLoginTotpRes()                 {}
+func (*InternalServerErrorHeaders) postAuthLogoutRes()                    {}
+func (*InternalServerErrorHeaders) postEventHitRes()                      {}
+func (*InternalServerErrorHeaders) postReportsRes()                       {}
+func (*InternalServerErrorHeaders) postUserTotpConfirmRes()               {}
+func (*InternalServerErrorHeaders) postUserTotpDisableRes()               {}
+func (*InternalServerErrorHeaders) postUserTotpRes()                      {}
+func (*InternalServerErrorHeaders) postUsersRes()                         {}
+func (*InternalServerErrorHeaders) postWebsitesIDGoalsRes()               {}
+func (*InternalServerErrorHeaders) postWebsitesIDWebhooksRes()            {}
+func (*InternalServerErrorHeaders) postWebsitesRes()                      {}
+
+// Response body for a username or IP prefix locked out of logging in.
+// Ref: #/components/schemas/LoginLockout
+type LoginLockout struct {
+	Type LoginLockoutType `json:"type"`
+	// Locked out username or IP prefix.
+	Value string `json:"value"`
+	// Number of consecutive lockouts. Each lockout lasts twice as long as the previous one.
+	Lockouts    int   `json:"lockouts"`
+	DateExpires int64 `json:"dateExpires"`
+}
+
+// GetType returns the value of Type.
+func (s *LoginLockout) GetType() LoginLockoutType {
+	return s.Type
+}
+
+// GetValue returns the value of Value.
+func (s *LoginLockout) GetValue() string {
+	return s.Value
+}
+
+// GetLockouts returns the value of Lockouts.
+func (s *LoginLockout) GetLockouts() int {
+	return s.Lockouts
+}
+
+// GetDateExpires returns the value of DateExpires.
+func (s *LoginLockout) GetDateExpires() int64 {
+	return s.DateExpires
+}
+
+// SetType sets the value of Type.
+func (s *LoginLockout) SetType(val LoginLockoutType) {
+	s.Type = val
+}
+
+// SetValue sets the value of Value.
+func (s *LoginLockout) SetValue(val string) {
+	s.Value = val
+}
+
+// SetLockouts sets the value of Lockouts.
+func (s *LoginLockout) SetLockouts(val int) {
+	s.Lockouts = val
+}
+
+// SetDateExpires sets the value of DateExpires.
+func (s *LoginLockout) SetDateExpires(val int64) {
+	s.DateExpires = val
+}
+
+type LoginLockoutType string
+
+const (
+	LoginLockoutTypeUsername LoginLockoutType = "username"
+	LoginLockoutTypeIP       LoginLockoutType = "ip"
+)
+
+// AllValues returns all LoginLockoutType values.
+func (LoginLockoutType) AllValues() []LoginLockoutType {
+	return []LoginLockoutType{
+		LoginLockoutTypeUsername,
+		LoginLockoutTypeIP,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s LoginLockoutType) MarshalText() ([]byte, error) {
+	switch s {
+	case LoginLockoutTypeUsername:
+		return []byte(s), nil
+	case LoginLockoutTypeIP:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *LoginLockoutType) UnmarshalText(data []byte) error {
+	switch LoginLockoutType(data) {
+	case LoginLockoutTypeUsername:
+		*s = LoginLockoutTypeUsername
+		return nil
+	case LoginLockoutTypeIP:
+		*s = LoginLockoutTypeIP
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type NotFoundError struct {
+	Error NotFoundErrorError `json:"error"`
+}
+
+// GetError returns the value of Error.
+func (s *NotFoundError) GetError() NotFoundErrorError {
+	return s.Error
+}
+
+// SetError sets the value of Error.
+func (s *NotFoundError) SetError(val NotFoundErrorError) {
+	s.Error = val
+}
+
+type NotFoundErrorError struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *NotFoundErrorError) GetCode() int32 {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *NotFoundErrorError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *NotFoundErrorError) SetCode(val int32) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *NotFoundErrorError) SetMessage(val string) {
+	s.Message = val
+}
+
+// NotFoundErrorHeaders wraps NotFoundError with response headers.
+type NotFoundErrorHeaders struct {
+	XAPICommit OptString
+	Response   NotFoundError
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *NotFoundErrorHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *NotFoundErrorHeaders) GetResponse() NotFoundError {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *NotFoundErrorHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *NotFoundErrorHeaders) SetResponse(val NotFoundError) {
+	s.Response = val
+}
+
+func (*NotFoundErrorHeaders) deleteAPIKeysIDRes()                   {}
+func (*NotFoundErrorHeaders) deleteAuthLockoutsRes()                {}
+func (*NotFoundErrorHeaders) deleteReportsIDRes()                   {}
+func (*NotFoundErrorHeaders) deleteUserRes()                        {}
+func (*NotFoundErrorHeaders) deleteUsersIDRes()                     {}
+func (*NotFoundErrorHeaders) deleteWebsitesIDGoalsIDRes()           {}
+func (*NotFoundErrorHeaders) deleteWebsitesIDRes()                  {}
+func (*NotFoundErrorHeaders) deleteWebsitesIDWebhooksIDRes()        {}
+func (*NotFoundErrorHeaders) getAuthOidcCallbackRes()               {}
+func (*NotFoundErrorHeaders) getAuthOidcLoginRes()                  {}
+func (*NotFoundErrorHeaders) getUserRes()                           {}
+func (*NotFoundErrorHeaders) getWebsiteIDBrowsersRes()              {}
+func (*NotFoundErrorHeaders) getWebsiteIDCampaignsRes()             {}
+func (*NotFoundErrorHeaders) getWebsiteIDCountryRes()               {}
+func (*NotFoundErrorHeaders) getWebsiteIDDeviceRes()                {}
+func (*NotFoundErrorHeaders) getWebsiteIDFunnelRes()                {}
+func (*NotFoundErrorHeaders) getWebsiteIDGoalsRes()                 {}
+func (*NotFoundErrorHeaders) getWebsiteIDHostnamesRes()             {}
+func (*NotFoundErrorHeaders) getWebsiteIDLanguageRes()              {}
+func (*NotFoundErrorHeaders) getWebsiteIDMediumsRes()               {}
+func (*NotFoundErrorHeaders) getWebsiteIDOsRes()                    {}
+func (*NotFoundErrorHeaders) getWebsiteIDPagesEntryRes()            {}
+func (*NotFoundErrorHeaders) getWebsiteIDPagesExitRes()             {}
+func (*NotFoundErrorHeaders) getWebsiteIDPagesRes()                 {}
+func (*NotFoundErrorHeaders) getWebsiteIDPropertiesRes()            {}
+func (*NotFoundErrorHeaders) getWebsiteIDRealtimeRes()              {}
+func (*NotFoundErrorHeaders) getWebsiteIDReferrersRes()             {}
+func (*NotFoundErrorHeaders) getWebsiteIDSourcesRes()               {}
+func (*NotFoundErrorHeaders) getWebsiteIDSummaryRes()               {}
+func (*NotFoundErrorHeaders) getWebsiteIDTimeRes()                  {}
+func (*NotFoundErrorHeaders) getWebsitesIDDeletionRes()             {}
+func (*NotFoundErrorHeaders) getWebsitesIDGoalsRes()                {}
+func (*NotFoundErrorHeaders) getWebsitesIDRes()                     {}
+func (*NotFoundErrorHeaders) getWebsitesIDWebhooksIDDeliveriesRes() {}
+func (*NotFoundErrorHeaders) getWebsitesIDWebhooksRes()             {}
+func (*NotFoundErrorHeaders) getWebsitesRes()                       {}
+func (*NotFoundErrorHeaders) patchReportsIDRes()                    {}
+func (*NotFoundErrorHeaders) patchUserRes()                         {}
+func (*NotFoundErrorHeaders) patchUsersIDRes()                      {}
+func (*NotFoundErrorHeaders) patchWebsitesIDGoalsIDRes()            {}
+func (*NotFoundErrorHeaders) patchWebsitesIDRes()                   {}
+func (*NotFoundErrorHeaders) postAPIKeysRes()                       {}
+func (*NotFoundErrorHeaders) postEventHitRes()                      {}
+func (*NotFoundErrorHeaders) postReportsRes()                       {}
+func (*NotFoundErrorHeaders) postUserTotpConfirmRes()               {}
+func (*NotFoundErrorHeaders) postUserTotpDisableRes()               {}
+func (*NotFoundErrorHeaders) postUsersRes()                         {}
+func (*NotFoundErrorHeaders) postWebsitesIDGoalsRes()               {}
+func (*NotFoundErrorHeaders) postWebsitesIDWebhooksRes()            {}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEventLoadD returns new OptEventLoadD with value set to v.
+func NewOptEventLoadD(v EventLoadD) OptEventLoadD {
+	return OptEventLoadD{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEventLoadD is optional EventLoadD.
+type OptEventLoadD struct {
+	Value EventLoadD
+	Set   bool
+}
+
+// IsSet returns true if OptEventLoadD was set.
+func (o OptEventLoadD) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEventLoadD) Reset() {
+	var v EventLoadD
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEventLoadD) SetTo(v EventLoadD) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEventLoadD) Get() (v EventLoadD, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEventLoadD) Or(d EventLoadD) EventLoadD {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFilterString returns new OptFilterString with value set to v.
+func NewOptFilterString(v FilterString) OptFilterString {
+	return OptFilterString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFilterString is optional FilterString.
+type OptFilterString struct {
+	Value FilterString
+	Set   bool
+}
+
+// IsSet returns true if OptFilterString was set.
+func (o OptFilterString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFilterString) Reset() {
+	var v FilterString
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFilterString) SetTo(v FilterString) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFilterString) Get() (v FilterString, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFilterString) Or(d FilterString) FilterString {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat32 returns new OptFloat32 with value set to v.
+func NewOptFloat32(v float32) OptFloat32 {
+	return OptFloat32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat32 is optional float32.
+type OptFloat32 struct {
+	Value float32
+	Set   bool
+}
+
+// IsSet returns true if OptFloat32 was set.
+func (o OptFloat32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat32) Reset() {
+	var v float32
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat32) SetTo(v float32) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat32) Get() (v float32, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat32) Or(d float32) float32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFormat returns new OptFormat with value set to v.
+func NewOptFormat(v Format) OptFormat {
+	return OptFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFormat is optional Format.
+type OptFormat struct {
+	Value Format
+	Set   bool
+}
+
+// IsSet returns true if OptFormat was set.
+func (o OptFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFormat) Reset() {
+	var v Format
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFormat) SetTo(v Format) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFormat) Get() (v Format, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFormat) Or(d Format) Format {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetWebsiteIDSummaryInterval returns new OptGetWebsiteIDSummaryInterval with value set to v.
+func NewOptGetWebsiteIDSummaryInterval(v GetWebsiteIDSummaryInterval) OptGetWebsiteIDSummaryInterval {
+	return OptGetWebsiteIDSummaryInterval{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetWebsiteIDSummaryInterval is optional GetWebsiteIDSummaryInterval.
+type OptGetWebsiteIDSummaryInterval struct {
+	Value GetWebsiteIDSummaryInterval
+	Set   bool
+}
+
+// IsSet returns true if OptGetWebsiteIDSummaryInterval was set.
+func (o OptGetWebsiteIDSummaryInterval) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetWebsiteIDSummaryInterval) Reset() {
+	var v GetWebsiteIDSummaryInterval
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetWebsiteIDSummaryInterval) SetTo(v GetWebsiteIDSummaryInterval) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetWebsiteIDSummaryInterval) Get() (v GetWebsiteIDSummaryInterval, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetWebsiteIDSummaryInterval) Or(d GetWebsiteIDSummaryInterval) GetWebsiteIDSummaryInterval {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGoalType returns new OptGoalType with value set to v.
+func NewOptGoalType(v GoalType) OptGoalType {
+	return OptGoalType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGoalType is optional GoalType.
+type OptGoalType struct {
+	Value GoalType
+	Set   bool
+}
+
+// IsSet returns true if OptGoalType was set.
+func (o OptGoalType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGoalType) Reset() {
+	var v GoalType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGoalType) SetTo(v GoalType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGoalType) Get() (v GoalType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGoalType) Or(d GoalType) GoalType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilInt returns new OptNilInt with value set to v.
+func NewOptNilInt(v int) OptNilInt {
+	return OptNilInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilInt is optional nullable int.
+type OptNilInt struct {
+	Value int
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilInt was set.
+func (o OptNilInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilInt) SetTo(v int) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilInt) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilInt) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v int
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilInt) Get() (v int, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptReportFrequency returns new OptReportFrequency with value set to v.
+func NewOptReportFrequency(v ReportFrequency) OptReportFrequency {
+	return OptReportFrequency{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptReportFrequency is optional ReportFrequency.
+type OptReportFrequency struct {
+	Value ReportFrequency
+	Set   bool
+}
+
+// IsSet returns true if OptReportFrequency was set.
+func (o OptReportFrequency) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptReportFrequency) Reset() {
+	var v ReportFrequency
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptReportFrequency) SetTo(v ReportFrequency) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptReportFrequency) Get() (v ReportFrequency, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptReportFrequency) Or(d ReportFrequency) ReportFrequency {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptStatsSummaryPrevious returns new OptStatsSummaryPrevious with value set to v.
+func NewOptStatsSummaryPrevious(v StatsSummaryPrevious) OptStatsSummaryPrevious {
+	return OptStatsSummaryPrevious{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptStatsSummaryPrevious is optional StatsSummaryPrevious.
+type OptStatsSummaryPrevious struct {
+	Value StatsSummaryPrevious
+	Set   bool
+}
+
+// IsSet returns true if OptStatsSummaryPrevious was set.
+func (o OptStatsSummaryPrevious) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptStatsSummaryPrevious) Reset() {
+	var v StatsSummaryPrevious
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptStatsSummaryPrevious) SetTo(v StatsSummaryPrevious) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptStatsSummaryPrevious) Get() (v StatsSummaryPrevious, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptStatsSummaryPrevious) Or(d StatsSummaryPrevious) StatsSummaryPrevious {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUserAccountPatchRole returns new OptUserAccountPatchRole with value set to v.
+func NewOptUserAccountPatchRole(v UserAccountPatchRole) OptUserAccountPatchRole {
+	return OptUserAccountPatchRole{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUserAccountPatchRole is optional UserAccountPatchRole.
+type OptUserAccountPatchRole struct {
+	Value UserAccountPatchRole
+	Set   bool
+}
+
+// IsSet returns true if OptUserAccountPatchRole was set.
+func (o OptUserAccountPatchRole) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUserAccountPatchRole) Reset() {
+	var v UserAccountPatchRole
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUserAccountPatchRole) SetTo(v UserAccountPatchRole) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUserAccountPatchRole) Get() (v UserAccountPatchRole, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUserAccountPatchRole) Or(d UserAccountPatchRole) UserAccountPatchRole {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUserSettings returns new OptUserSettings with value set to v.
+func NewOptUserSettings(v UserSettings) OptUserSettings {
+	return OptUserSettings{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUserSettings is optional UserSettings.
+type OptUserSettings struct {
+	Value UserSettings
+	Set   bool
 }
 
-// SetXAPICommit sets the value of XAPICommit.
-func (s *ForbiddenErrorHeaders) SetXAPICommit(val OptString) {
-	s.XAPICommit = val
-}
+// IsSet returns true if OptUserSettings was set.
+func (o OptUserSettings) IsSet() bool { return o.Set }
 
-// SetResponse sets the value of Response.
-func (s *ForbiddenErrorHeaders) SetResponse(val ForbiddenError) {
-	s.Response = val
+// Reset unsets value.
+func (o *OptUserSettings) Reset() {
+	var v UserSettings
+	o.Value = v
+	o.Set = false
 }
 
-func (*ForbiddenErrorHeaders) deleteUserRes()             {}
-func (*ForbiddenErrorHeaders) deleteWebsitesIDRes()       {}
-func (*ForbiddenErrorHeaders) getWebsiteIDBrowsersRes()   {}
-func (*ForbiddenErrorHeaders) getWebsiteIDCampaignsRes()  {}
-func (*ForbiddenErrorHeaders) getWebsiteIDCountryRes()    {}
-func (*ForbiddenErrorHeaders) getWebsiteIDDeviceRes()     {}
-func (*ForbiddenErrorHeaders) getWebsiteIDLanguageRes()   {}
-func (*ForbiddenErrorHeaders) getWebsiteIDMediumsRes()    {}
-func (*ForbiddenErrorHeaders) getWebsiteIDOsRes()         {}
-func (*ForbiddenErrorHeaders) getWebsiteIDPropertiesRes() {}
-func (*ForbiddenErrorHeaders) getWebsiteIDReferrersRes()  {}
-func (*ForbiddenErrorHeaders) getWebsiteIDSourcesRes()    {}
-func (*ForbiddenErrorHeaders) patchTenantSettingsRes()    {}
-func (*ForbiddenErrorHeaders) patchUserRes()              {}
-func (*ForbiddenErrorHeaders) patchWebsitesIDRes()        {}
-func (*ForbiddenErrorHeaders) postWebsitesRes()           {}
+// SetTo sets value to v.
+func (o *OptUserSettings) SetTo(v UserSettings) {
+	o.Set = true
+	o.Value = v
+}
 
-// This is set to 0 if the user is a unique user, otherwise 1.
-type GetEventPingOK struct {
-	Data io.Reader
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUserSettings) Get() (v UserSettings, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s GetEventPingOK) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
+// Or returns value if set, or given parameter if does not.
+func (o OptUserSettings) Or(d UserSettings) UserSettings {
+	if v, ok := o.Get(); ok {
+		return v
 	}
-	return s.Data.Read(p)
+	return d
 }
 
-// GetEventPingOKHeaders wraps GetEventPingOK with response headers.
-type GetEventPingOKHeaders struct {
-	CacheControl string
-	LastModified string
-	Response     GetEventPingOK
+// NewOptUserSettingsLanguage returns new OptUserSettingsLanguage with value set to v.
+func NewOptUserSettingsLanguage(v UserSettingsLanguage) OptUserSettingsLanguage {
+	return OptUserSettingsLanguage{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetCacheControl returns the value of CacheControl.
-func (s *GetEventPingOKHeaders) GetCacheControl() string {
-	return s.CacheControl
+// OptUserSettingsLanguage is optional UserSettingsLanguage.
+type OptUserSettingsLanguage struct {
+	Value UserSettingsLanguage
+	Set   bool
 }
 
-// GetLastModified returns the value of LastModified.
-func (s *GetEventPingOKHeaders) GetLastModified() string {
-	return s.LastModified
+// IsSet returns true if OptUserSettingsLanguage was set.
+func (o OptUserSettingsLanguage) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUserSettingsLanguage) Reset() {
+	var v UserSettingsLanguage
+	o.Value = v
+	o.Set = false
 }
 
-// GetResponse returns the value of Response.
-func (s *GetEventPingOKHeaders) GetResponse() GetEventPingOK {
-	return s.Response
+// SetTo sets value to v.
+func (o *OptUserSettingsLanguage) SetTo(v UserSettingsLanguage) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetCacheControl sets the value of CacheControl.
-func (s *GetEventPingOKHeaders) SetCacheControl(val string) {
-	s.CacheControl = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUserSettingsLanguage) Get() (v UserSettingsLanguage, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetLastModified sets the value of LastModified.
-func (s *GetEventPingOKHeaders) SetLastModified(val string) {
-	s.LastModified = val
+// Or returns value if set, or given parameter if does not.
+func (o OptUserSettingsLanguage) Or(d UserSettingsLanguage) UserSettingsLanguage {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetResponse sets the value of Response.
-func (s *GetEventPingOKHeaders) SetResponse(val GetEventPingOK) {
-	s.Response = val
+// NewOptWebsiteGetSummary returns new OptWebsiteGetSummary with value set to v.
+func NewOptWebsiteGetSummary(v WebsiteGetSummary) OptWebsiteGetSummary {
+	return OptWebsiteGetSummary{
+		Value: v,
+		Set:   true,
+	}
 }
 
-func (*GetEventPingOKHeaders) getEventPingRes() {}
+// OptWebsiteGetSummary is optional WebsiteGetSummary.
+type OptWebsiteGetSummary struct {
+	Value WebsiteGetSummary
+	Set   bool
+}
 
-type GetWebsiteIDSummaryInterval string
+// IsSet returns true if OptWebsiteGetSummary was set.
+func (o OptWebsiteGetSummary) IsSet() bool { return o.Set }
 
-const (
-	GetWebsiteIDSummaryIntervalMinute GetWebsiteIDSummaryInterval = "minute"
-	GetWebsiteIDSummaryIntervalHour   GetWebsiteIDSummaryInterval = "hour"
-	GetWebsiteIDSummaryIntervalDay    GetWebsiteIDSummaryInterval = "day"
-	GetWebsiteIDSummaryIntervalWeek   GetWebsiteIDSummaryInterval = "week"
-	GetWebsiteIDSummaryIntervalMonth  GetWebsiteIDSummaryInterval = "month"
-)
+// Reset unsets value.
+func (o *OptWebsiteGetSummary) Reset() {
+	var v WebsiteGetSummary
+	o.Value = v
+	o.Set = false
+}
 
-// AllValues returns all GetWebsiteIDSummaryInterval values.
-func (GetWebsiteIDSummaryInterval) AllValues() []GetWebsiteIDSummaryInterval {
-	return []GetWebsiteIDSummaryInterval{
-		GetWebsiteIDSummaryIntervalMinute,
-		GetWebsiteIDSummaryIntervalHour,
-		GetWebsiteIDSummaryIntervalDay,
-		GetWebsiteIDSummaryIntervalWeek,
-		GetWebsiteIDSummaryIntervalMonth,
-	}
+// SetTo sets value to v.
+func (o *OptWebsiteGetSummary) SetTo(v WebsiteGetSummary) {
+	o.Set = true
+	o.Value = v
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s GetWebsiteIDSummaryInterval) MarshalText() ([]byte, error) {
-	switch s {
-	case GetWebsiteIDSummaryIntervalMinute:
-		return []byte(s), nil
-	case GetWebsiteIDSummaryIntervalHour:
-		return []byte(s), nil
-	case GetWebsiteIDSummaryIntervalDay:
-		return []byte(s), nil
-	case GetWebsiteIDSummaryIntervalWeek:
-		return []byte(s), nil
-	case GetWebsiteIDSummaryIntervalMonth:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
+// Get returns value and boolean that denotes whether value was set.
+func (o OptWebsiteGetSummary) Get() (v WebsiteGetSummary, ok bool) {
+	if !o.Set {
+		return v, false
 	}
+	return o.Value, true
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *GetWebsiteIDSummaryInterval) UnmarshalText(data []byte) error {
-	switch GetWebsiteIDSummaryInterval(data) {
-	case GetWebsiteIDSummaryIntervalMinute:
-		*s = GetWebsiteIDSummaryIntervalMinute
-		return nil
-	case GetWebsiteIDSummaryIntervalHour:
-		*s = GetWebsiteIDSummaryIntervalHour
-		return nil
-	case GetWebsiteIDSummaryIntervalDay:
-		*s = GetWebsiteIDSummaryIntervalDay
-		return nil
-	case GetWebsiteIDSummaryIntervalWeek:
-		*s = GetWebsiteIDSummaryIntervalWeek
-		return nil
-	case GetWebsiteIDSummaryIntervalMonth:
-		*s = GetWebsiteIDSummaryIntervalMonth
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
+// Or returns value if set, or given parameter if does not.
+func (o OptWebsiteGetSummary) Or(d WebsiteGetSummary) WebsiteGetSummary {
+	if v, ok := o.Get(); ok {
+		return v
 	}
+	return d
 }
 
-// GetWebsitesOKHeaders wraps []WebsiteGet with response headers.
-type GetWebsitesOKHeaders struct {
+// PostAuthLoginOK is response for PostAuthLogin operation.
+type PostAuthLoginOK struct {
+	SetCookie  string
 	XAPICommit OptString
-	Response   []WebsiteGet
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *PostAuthLoginOK) GetSetCookie() string {
+	return s.SetCookie
 }
 
 // GetXAPICommit returns the value of XAPICommit.
-func (s *GetWebsitesOKHeaders) GetXAPICommit() OptString {
+func (s *PostAuthLoginOK) GetXAPICommit() OptString {
 	return s.XAPICommit
 }
 
-// GetResponse returns the value of Response.
-func (s *GetWebsitesOKHeaders) GetResponse() []WebsiteGet {
-	return s.Response
+// SetSetCookie sets the value of SetCookie.
+func (s *PostAuthLoginOK) SetSetCookie(val string) {
+	s.SetCookie = val
 }
 
 // SetXAPICommit sets the value of XAPICommit.
-func (s *GetWebsitesOKHeaders) SetXAPICommit(val OptString) {
+func (s *PostAuthLoginOK) SetXAPICommit(val OptString) {
 	s.XAPICommit = val
 }
 
-// SetResponse sets the value of Response.
-func (s *GetWebsitesOKHeaders) SetResponse(val []WebsiteGet) {
-	s.Response = val
+func (*PostAuthLoginOK) postAuthLoginRes() {}
+
+// PostAuthLoginTotpOK is response for PostAuthLoginTotp operation.
+type PostAuthLoginTotpOK struct {
+	SetCookie  string
+	XAPICommit OptString
 }
 
-func (*GetWebsitesOKHeaders) getWebsitesRes() {}
+// GetSetCookie returns the value of SetCookie.
+func (s *PostAuthLoginTotpOK) GetSetCookie() string {
+	return s.SetCookie
+}
 
-type InternalServerError struct {
-	Error InternalServerErrorError `json:"error"`
+// GetXAPICommit returns the value of XAPICommit.
+func (s *PostAuthLoginTotpOK) GetXAPICommit() OptString {
+	return s.XAPICommit
 }
 
-// GetError returns the value of Error.
-func (s *InternalServerError) GetError() InternalServerErrorError {
-	return s.Error
+// SetSetCookie sets the value of SetCookie.
+func (s *PostAuthLoginTotpOK) SetSetCookie(val string) {
+	s.SetCookie = val
 }
 
-// SetError sets the value of Error.
-func (s *InternalServerError) SetError(val InternalServerErrorError) {
-	s.Error = val
+// SetXAPICommit sets the value of XAPICommit.
+func (s *PostAuthLoginTotpOK) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
 }
 
-type InternalServerErrorError struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
+func (*PostAuthLoginTotpOK) postAuthLoginTotpRes() {}
+
+// PostAuthLogoutNoContent is response for PostAuthLogout operation.
+type PostAuthLogoutNoContent struct {
+	SetCookie  string
+	XAPICommit OptString
 }
 
-// GetCode returns the value of Code.
-func (s *InternalServerErrorError) GetCode() int32 {
-	return s.Code
+// GetSetCookie returns the value of SetCookie.
+func (s *PostAuthLogoutNoContent) GetSetCookie() string {
+	return s.SetCookie
 }
 
-// GetMessage returns the value of Message.
-func (s *InternalServerErrorError) GetMessage() string {
-	return s.Message
+// GetXAPICommit returns the value of XAPICommit.
+func (s *PostAuthLogoutNoContent) GetXAPICommit() OptString {
+	return s.XAPICommit
 }
 
-// SetCode sets the value of Code.
-func (s *InternalServerErrorError) SetCode(val int32) {
-	s.Code = val
+// SetSetCookie sets the value of SetCookie.
+func (s *PostAuthLogoutNoContent) SetSetCookie(val string) {
+	s.SetCookie = val
 }
 
-// SetMessage sets the value of Message.
-func (s *InternalServerErrorError) SetMessage(val string) {
-	s.Message = val
+// SetXAPICommit sets the value of XAPICommit.
+func (s *PostAuthLogoutNoContent) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
 }
 
-// InternalServerErrorHeaders wraps InternalServerError with response headers.
-type InternalServerErrorHeaders struct {
+func (*PostAuthLogoutNoContent) postAuthLogoutRes() {}
+
+// PostEventHitNoContent is response for PostEventHit operation.
+type PostEventHitNoContent struct{}
+
+func (*PostEventHitNoContent) postEventHitRes() {}
+
+// PostUserTotpDisableNoContent is response for PostUserTotpDisable operation.
+type PostUserTotpDisableNoContent struct {
 	XAPICommit OptString
-	Response   InternalServerError
 }
 
 // GetXAPICommit returns the value of XAPICommit.
-func (s *InternalServerErrorHeaders) GetXAPICommit() OptString {
+func (s *PostUserTotpDisableNoContent) GetXAPICommit() OptString {
 	return s.XAPICommit
 }
 
-// GetResponse returns the value of Response.
-func (s *InternalServerErrorHeaders) GetResponse() InternalServerError {
-	return s.Response
-}
-
 // SetXAPICommit sets the value of XAPICommit.
-func (s *InternalServerErrorHeaders) SetXAPICommit(val OptString) {
+func (s *PostUserTotpDisableNoContent) SetXAPICommit(val OptString) {
 	s.XAPICommit = val
 }
 
-// SetResponse sets the value of Response.
-func (s *InternalServerErrorHeaders) SetResponse(val InternalServerError) {
-	s.Response = val
+func (*PostUserTotpDisableNoContent) postUserTotpDisableRes() {}
+
+// Request body for subscribing to a report.
+// Ref: #/components/schemas/ReportCreate
+type ReportCreate struct {
+	Hostname  string          `json:"hostname"`
+	Email     string          `json:"email"`
+	Frequency ReportFrequency `json:"frequency"`
+	Stats     []ReportStat    `json:"stats"`
 }
 
-func (*InternalServerErrorHeaders) deleteUserRes()             {}
-func (*InternalServerErrorHeaders) deleteWebsitesIDRes()       {}
-func (*InternalServerErrorHeaders) getEventPingRes()           {}
-func (*InternalServerErrorHeaders) getTenantSettingsRes()      {}
-func (*InternalServerErrorHeaders) getUserRes()                {}
-func (*InternalServerErrorHeaders) getUserUsageRes()           {}
-func (*InternalServerErrorHeaders) getWebsiteIDBrowsersRes()   {}
-func (*InternalServerErrorHeaders) getWebsiteIDCampaignsRes()  {}
-func (*InternalServerErrorHeaders) getWebsiteIDCountryRes()    {}
-func (*InternalServerErrorHeaders) getWebsiteIDDeviceRes()     {}
-func (*InternalServerErrorHeaders) getWebsiteIDLanguageRes()   {}
-func (*InternalServerErrorHeaders) getWebsiteIDMediumsRes()    {}
-func (*InternalServerErrorHeaders) getWebsiteIDOsRes()         {}
-func (*InternalServerErrorHeaders) getWebsiteIDPagesRes()      {}
-func (*InternalServerErrorHeaders) getWebsiteIDPropertiesRes() {}
-func (*InternalServerErrorHeaders) getWebsiteIDReferrersRes()  {}
-func (*InternalServerErrorHeaders) getWebsiteIDSourcesRes()    {}
-func (*InternalServerErrorHeaders) getWebsiteIDSummaryRes()    {}
-func (*InternalServerErrorHeaders) getWebsiteIDTimeRes()       {}
-func (*InternalServerErrorHeaders) getWebsitesIDRes()          {}
-func (*InternalServerErrorHeaders) getWebsitesRes()            {}
-func (*InternalServerErrorHeaders) patchTenantSettingsRes()    {}
-func (*InternalServerErrorHeaders) patchUserRes()              {}
-func (*InternalServerErrorHeaders) patchWebsitesIDRes()        {}
-func (*InternalServerErrorHeaders) postAuthLoginRes()          {}
-func (*InternalServerErrorHeaders) postAuthLogoutRes()         {}
-func (*InternalServerErrorHeaders) postEventHitRes()           {}
-func (*InternalServerErrorHeaders) postWebsitesRes()           {}
+// GetHostname returns the value of Hostname.
+func (s *ReportCreate) GetHostname() string {
+	return s.Hostname
+}
 
-type NotFoundError struct {
-	Error NotFoundErrorError `json:"error"`
+// GetEmail returns the value of Email.
+func (s *ReportCreate) GetEmail() string {
+	return s.Email
 }
 
-// GetError returns the value of Error.
-func (s *NotFoundError) GetError() NotFoundErrorError {
-	return s.Error
+// GetFrequency returns the value of Frequency.
+func (s *ReportCreate) GetFrequency() ReportFrequency {
+	return s.Frequency
 }
 
-// SetError sets the value of Error.
-func (s *NotFoundError) SetError(val NotFoundErrorError) {
-	s.Error = val
+// GetStats returns the value of Stats.
+func (s *ReportCreate) GetStats() []ReportStat {
+	return s.Stats
 }
 
-type NotFoundErrorError struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
+// SetHostname sets the value of Hostname.
+func (s *ReportCreate) SetHostname(val string) {
+	s.Hostname = val
 }
 
-// GetCode returns the value of Code.
-func (s *NotFoundErrorError) GetCode() int32 {
-	return s.Code
+// SetEmail sets the value of Email.
+func (s *ReportCreate) SetEmail(val string) {
+	s.Email = val
 }
 
-// GetMessage returns the value of Message.
-func (s *NotFoundErrorError) GetMessage() string {
-	return s.Message
+// SetFrequency sets the value of Frequency.
+func (s *ReportCreate) SetFrequency(val ReportFrequency) {
+	s.Frequency = val
 }
 
-// SetCode sets the value of Code.
-func (s *NotFoundErrorError) SetCode(val int32) {
-	s.Code = val
+// SetStats sets the value of Stats.
+func (s *ReportCreate) SetStats(val []ReportStat) {
+	s.Stats = val
 }
 
-// SetMessage sets the value of Message.
-func (s *NotFoundErrorError) SetMessage(val string) {
-	s.Message = val
+// How often a report is sent. Reports cover the last full day, week starting on Monday or month in
+// UTC.
+// Ref: #/components/schemas/ReportFrequency
+type ReportFrequency string
+
+const (
+	ReportFrequencyDaily   ReportFrequency = "daily"
+	ReportFrequencyWeekly  ReportFrequency = "weekly"
+	ReportFrequencyMonthly ReportFrequency = "monthly"
+)
+
+// AllValues returns all ReportFrequency values.
+func (ReportFrequency) AllValues() []ReportFrequency {
+	return []ReportFrequency{
+		ReportFrequencyDaily,
+		ReportFrequencyWeekly,
+		ReportFrequencyMonthly,
+	}
 }
 
-// NotFoundErrorHeaders wraps NotFoundError with response headers.
-type NotFoundErrorHeaders struct {
-	XAPICommit OptString
-	Response   NotFoundError
+// MarshalText implements encoding.TextMarshaler.
+func (s ReportFrequency) MarshalText() ([]byte, error) {
+	switch s {
+	case ReportFrequencyDaily:
+		return []byte(s), nil
+	case ReportFrequencyWeekly:
+		return []byte(s), nil
+	case ReportFrequencyMonthly:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetXAPICommit returns the value of XAPICommit.
-func (s *NotFoundErrorHeaders) GetXAPICommit() OptString {
-	return s.XAPICommit
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReportFrequency) UnmarshalText(data []byte) error {
+	switch ReportFrequency(data) {
+	case ReportFrequencyDaily:
+		*s = ReportFrequencyDaily
+		return nil
+	case ReportFrequencyWeekly:
+		*s = ReportFrequencyWeekly
+		return nil
+	case ReportFrequencyMonthly:
+		*s = ReportFrequencyMonthly
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetResponse returns the value of Response.
-func (s *NotFoundErrorHeaders) GetResponse() NotFoundError {
-	return s.Response
+// Response body for getting a report subscription.
+// Ref: #/components/schemas/ReportGet
+type ReportGet struct {
+	ID        string          `json:"id"`
+	Hostname  string          `json:"hostname"`
+	Email     string          `json:"email"`
+	Frequency ReportFrequency `json:"frequency"`
+	Stats     []ReportStat    `json:"stats"`
+	// End of the period covered by the last sent report. Omitted if no report has been sent yet.
+	LastSentAt OptDateTime `json:"lastSentAt"`
+	CreatedAt  time.Time   `json:"createdAt"`
 }
 
-// SetXAPICommit sets the value of XAPICommit.
-func (s *NotFoundErrorHeaders) SetXAPICommit(val OptString) {
-	s.XAPICommit = val
+// GetID returns the value of ID.
+func (s *ReportGet) GetID() string {
+	return s.ID
 }
 
-// SetResponse sets the value of Response.
-func (s *NotFoundErrorHeaders) SetResponse(val NotFoundError) {
-	s.Response = val
+// GetHostname returns the value of Hostname.
+func (s *ReportGet) GetHostname() string {
+	return s.Hostname
 }
 
-func (*NotFoundErrorHeaders) deleteUserRes()             {}
-func (*NotFoundErrorHeaders) deleteWebsitesIDRes()       {}
-func (*NotFoundErrorHeaders) getUserRes()                {}
-func (*NotFoundErrorHeaders) getWebsiteIDBrowsersRes()   {}
-func (*NotFoundErrorHeaders) getWebsiteIDCampaignsRes()  {}
-func (*NotFoundErrorHeaders) getWebsiteIDCountryRes()    {}
-func (*NotFoundErrorHeaders) getWebsiteIDDeviceRes()     {}
-func (*NotFoundErrorHeaders) getWebsiteIDLanguageRes()   {}
-func (*NotFoundErrorHeaders) getWebsiteIDMediumsRes()    {}
-func (*NotFoundErrorHeaders) getWebsiteIDOsRes()         {}
-func (*NotFoundErrorHeaders) getWebsiteIDPagesRes()      {}
-func (*NotFoundErrorHeaders) getWebsiteIDPropertiesRes() {}
-func (*NotFoundErrorHeaders) getWebsiteIDReferrersRes()  {}
-func (*NotFoundErrorHeaders) getWebsiteIDSourcesRes()    {}
-func (*NotFoundErrorHeaders) getWebsiteIDSummaryRes()    {}
-func (*NotFoundErrorHeaders) getWebsiteIDTimeRes()       {}
-func (*NotFoundErrorHeaders) getWebsitesIDRes()          {}
-func (*NotFoundErrorHeaders) getWebsitesRes()            {}
-func (*NotFoundErrorHeaders) patchUserRes()              {}
-func (*NotFoundErrorHeaders) patchWebsitesIDRes()        {}
-func (*NotFoundErrorHeaders) postEventHitRes()           {}
+// GetEmail returns the value of Email.
+func (s *ReportGet) GetEmail() string {
+	return s.Email
+}
 
-// NewOptBool returns new OptBool with value set to v.
-func NewOptBool(v bool) OptBool {
-	return OptBool{
-		Value: v,
-		Set:   true,
-	}
+// GetFrequency returns the value of Frequency.
+func (s *ReportGet) GetFrequency() ReportFrequency {
+	return s.Frequency
 }
 
-// OptBool is optional bool.
-type OptBool struct {
-	Value bool
-	Set   bool
+// GetStats returns the value of Stats.
+func (s *ReportGet) GetStats() []ReportStat {
+	return s.Stats
 }
 
-// IsSet returns true if OptBool was set.
-func (o OptBool) IsSet() bool { return o.Set }
+// GetLastSentAt returns the value of LastSentAt.
+func (s *ReportGet) GetLastSentAt() OptDateTime {
+	return s.LastSentAt
+}
 
-// Reset unsets value.
-func (o *OptBool) Reset() {
-	var v bool
-	o.Value = v
-	o.Set = false
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ReportGet) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// SetTo sets value to v.
-func (o *OptBool) SetTo(v bool) {
-	o.Set = true
-	o.Value = v
+// SetID sets the value of ID.
+func (s *ReportGet) SetID(val string) {
+	s.ID = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptBool) Get() (v bool, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetHostname sets the value of Hostname.
+func (s *ReportGet) SetHostname(val string) {
+	s.Hostname = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptBool) Or(d bool) bool {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetEmail sets the value of Email.
+func (s *ReportGet) SetEmail(val string) {
+	s.Email = val
 }
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
-		Value: v,
-		Set:   true,
-	}
+// SetFrequency sets the value of Frequency.
+func (s *ReportGet) SetFrequency(val ReportFrequency) {
+	s.Frequency = val
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
-	Set   bool
+// SetStats sets the value of Stats.
+func (s *ReportGet) SetStats(val []ReportStat) {
+	s.Stats = val
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
+// SetLastSentAt sets the value of LastSentAt.
+func (s *ReportGet) SetLastSentAt(val OptDateTime) {
+	s.LastSentAt = val
+}
 
-// Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ReportGet) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
-	o.Set = true
-	o.Value = v
+// ReportGetHeaders wraps ReportGet with response headers.
+type ReportGetHeaders struct {
+	XAPICommit OptString
+	Response   ReportGet
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetXAPICommit returns the value of XAPICommit.
+func (s *ReportGetHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetResponse returns the value of Response.
+func (s *ReportGetHeaders) GetResponse() ReportGet {
+	return s.Response
 }
 
-// NewOptEventLoadD returns new OptEventLoadD with value set to v.
-func NewOptEventLoadD(v EventLoadD) OptEventLoadD {
-	return OptEventLoadD{
-		Value: v,
-		Set:   true,
-	}
+// SetXAPICommit sets the value of XAPICommit.
+func (s *ReportGetHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
 }
 
-// OptEventLoadD is optional EventLoadD.
-type OptEventLoadD struct {
-	Value EventLoadD
-	Set   bool
+// SetResponse sets the value of Response.
+func (s *ReportGetHeaders) SetResponse(val ReportGet) {
+	s.Response = val
 }
 
-// IsSet returns true if OptEventLoadD was set.
-func (o OptEventLoadD) IsSet() bool { return o.Set }
+func (*ReportGetHeaders) patchReportsIDRes() {}
+func (*ReportGetHeaders) postReportsRes()    {}
 
-// Reset unsets value.
-func (o *OptEventLoadD) Reset() {
-	var v EventLoadD
-	o.Value = v
-	o.Set = false
+// Request body for updating a report subscription.
+// Ref: #/components/schemas/ReportPatch
+type ReportPatch struct {
+	Email     OptString          `json:"email"`
+	Frequency OptReportFrequency `json:"frequency"`
+	Stats     []ReportStat       `json:"stats"`
 }
 
-// SetTo sets value to v.
-func (o *OptEventLoadD) SetTo(v EventLoadD) {
-	o.Set = true
-	o.Value = v
+// GetEmail returns the value of Email.
+func (s *ReportPatch) GetEmail() OptString {
+	return s.Email
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptEventLoadD) Get() (v EventLoadD, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetFrequency returns the value of Frequency.
+func (s *ReportPatch) GetFrequency() OptReportFrequency {
+	return s.Frequency
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptEventLoadD) Or(d EventLoadD) EventLoadD {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetStats returns the value of Stats.
+func (s *ReportPatch) GetStats() []ReportStat {
+	return s.Stats
 }
 
-// NewOptFilterString returns new OptFilterString with value set to v.
-func NewOptFilterString(v FilterString) OptFilterString {
-	return OptFilterString{
-		Value: v,
-		Set:   true,
-	}
+// SetEmail sets the value of Email.
+func (s *ReportPatch) SetEmail(val OptString) {
+	s.Email = val
 }
 
-// OptFilterString is optional FilterString.
-type OptFilterString struct {
-	Value FilterString
-	Set   bool
+// SetFrequency sets the value of Frequency.
+func (s *ReportPatch) SetFrequency(val OptReportFrequency) {
+	s.Frequency = val
 }
 
-// IsSet returns true if OptFilterString was set.
-func (o OptFilterString) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptFilterString) Reset() {
-	var v FilterString
-	o.Value = v
-	o.Set = false
+// SetStats sets the value of Stats.
+func (s *ReportPatch) SetStats(val []ReportStat) {
+	s.Stats = val
 }
 
-// SetTo sets value to v.
-func (o *OptFilterString) SetTo(v FilterString) {
-	o.Set = true
-	o.Value = v
-}
+// Section of stats included in a report.
+// Ref: #/components/schemas/ReportStat
+type ReportStat string
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptFilterString) Get() (v FilterString, ok bool) {
-	if !o.Set {
-		return v, false
+const (
+	ReportStatSummary   ReportStat = "summary"
+	ReportStatPages     ReportStat = "pages"
+	ReportStatReferrers ReportStat = "referrers"
+)
+
+// AllValues returns all ReportStat values.
+func (ReportStat) AllValues() []ReportStat {
+	return []ReportStat{
+		ReportStatSummary,
+		ReportStatPages,
+		ReportStatReferrers,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptFilterString) Or(d FilterString) FilterString {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s ReportStat) MarshalText() ([]byte, error) {
+	switch s {
+	case ReportStatSummary:
+		return []byte(s), nil
+	case ReportStatPages:
+		return []byte(s), nil
+	case ReportStatReferrers:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptFloat32 returns new OptFloat32 with value set to v.
-func NewOptFloat32(v float32) OptFloat32 {
-	return OptFloat32{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReportStat) UnmarshalText(data []byte) error {
+	switch ReportStat(data) {
+	case ReportStatSummary:
+		*s = ReportStatSummary
+		return nil
+	case ReportStatPages:
+		*s = ReportStatPages
+		return nil
+	case ReportStatReferrers:
+		*s = ReportStatReferrers
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptFloat32 is optional float32.
-type OptFloat32 struct {
-	Value float32
-	Set   bool
+// Metric of an interval that deviates from the median of the same interval in previous weeks.
+// Ref: #/components/schemas/StatsAnomaly
+type StatsAnomaly struct {
+	Metric StatsAnomalyMetric `json:"metric"`
+	// Value of the metric in the interval.
+	Value float64 `json:"value"`
+	// Baseline value of the metric.
+	Expected float64 `json:"expected"`
+	// Robust z-score of the value compared with the baseline. Negative if the value is below the
+	// baseline.
+	Score float64 `json:"score"`
 }
 
-// IsSet returns true if OptFloat32 was set.
-func (o OptFloat32) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptFloat32) Reset() {
-	var v float32
-	o.Value = v
-	o.Set = false
+// GetMetric returns the value of Metric.
+func (s *StatsAnomaly) GetMetric() StatsAnomalyMetric {
+	return s.Metric
 }
 
-// SetTo sets value to v.
-func (o *OptFloat32) SetTo(v float32) {
-	o.Set = true
-	o.Value = v
+// GetValue returns the value of Value.
+func (s *StatsAnomaly) GetValue() float64 {
+	return s.Value
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptFloat32) Get() (v float32, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetExpected returns the value of Expected.
+func (s *StatsAnomaly) GetExpected() float64 {
+	return s.Expected
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptFloat32) Or(d float32) float32 {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetScore returns the value of Score.
+func (s *StatsAnomaly) GetScore() float64 {
+	return s.Score
 }
 
-// NewOptGetWebsiteIDSummaryInterval returns new OptGetWebsiteIDSummaryInterval with value set to v.
-func NewOptGetWebsiteIDSummaryInterval(v GetWebsiteIDSummaryInterval) OptGetWebsiteIDSummaryInterval {
-	return OptGetWebsiteIDSummaryInterval{
-		Value: v,
-		Set:   true,
-	}
+// SetMetric sets the value of Metric.
+func (s *StatsAnomaly) SetMetric(val StatsAnomalyMetric) {
+	s.Metric = val
 }
 
-// OptGetWebsiteIDSummaryInterval is optional GetWebsiteIDSummaryInterval.
-type OptGetWebsiteIDSummaryInterval struct {
-	Value GetWebsiteIDSummaryInterval
-	Set   bool
+// SetValue sets the value of Value.
+func (s *StatsAnomaly) SetValue(val float64) {
+	s.Value = val
 }
 
-// IsSet returns true if OptGetWebsiteIDSummaryInterval was set.
-func (o OptGetWebsiteIDSummaryInterval) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptGetWebsiteIDSummaryInterval) Reset() {
-	var v GetWebsiteIDSummaryInterval
-	o.Value = v
-	o.Set = false
+// SetExpected sets the value of Expected.
+func (s *StatsAnomaly) SetExpected(val float64) {
+	s.Expected = val
 }
 
-// SetTo sets value to v.
-func (o *OptGetWebsiteIDSummaryInterval) SetTo(v GetWebsiteIDSummaryInterval) {
-	o.Set = true
-	o.Value = v
+// SetScore sets the value of Score.
+func (s *StatsAnomaly) SetScore(val float64) {
+	s.Score = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptGetWebsiteIDSummaryInterval) Get() (v GetWebsiteIDSummaryInterval, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
-}
+type StatsAnomalyMetric string
 
-// Or returns value if set, or given parameter if does not.
-func (o OptGetWebsiteIDSummaryInterval) Or(d GetWebsiteIDSummaryInterval) GetWebsiteIDSummaryInterval {
-	if v, ok := o.Get(); ok {
-		return v
+const (
+	StatsAnomalyMetricVisitors         StatsAnomalyMetric = "visitors"
+	StatsAnomalyMetricPageviews        StatsAnomalyMetric = "pageviews"
+	StatsAnomalyMetricBouncePercentage StatsAnomalyMetric = "bounce_percentage"
+	StatsAnomalyMetricDuration         StatsAnomalyMetric = "duration"
+)
+
+// AllValues returns all StatsAnomalyMetric values.
+func (StatsAnomalyMetric) AllValues() []StatsAnomalyMetric {
+	return []StatsAnomalyMetric{
+		StatsAnomalyMetricVisitors,
+		StatsAnomalyMetricPageviews,
+		StatsAnomalyMetricBouncePercentage,
+		StatsAnomalyMetricDuration,
 	}
-	return d
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
-		Value: v,
-		Set:   true,
+// MarshalText implements encoding.TextMarshaler.
+func (s StatsAnomalyMetric) MarshalText() ([]byte, error) {
+	switch s {
+	case StatsAnomalyMetricVisitors:
+		return []byte(s), nil
+	case StatsAnomalyMetricPageviews:
+		return []byte(s), nil
+	case StatsAnomalyMetricBouncePercentage:
+		return []byte(s), nil
+	case StatsAnomalyMetricDuration:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
-	Set   bool
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *StatsAnomalyMetric) UnmarshalText(data []byte) error {
+	switch StatsAnomalyMetric(data) {
+	case StatsAnomalyMetricVisitors:
+		*s = StatsAnomalyMetricVisitors
+		return nil
+	case StatsAnomalyMetricPageviews:
+		*s = StatsAnomalyMetricPageviews
+		return nil
+	case StatsAnomalyMetricBouncePercentage:
+		*s = StatsAnomalyMetricBouncePercentage
+		return nil
+	case StatsAnomalyMetricDuration:
+		*s = StatsAnomalyMetricDuration
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+type StatsBrowsers []StatsBrowsersItem
 
-// Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
+// StatsBrowsersHeaders wraps StatsBrowsers with response headers.
+type StatsBrowsersHeaders struct {
+	ContentDisposition OptString
+	XAPICommit         OptString
+	Response           StatsBrowsers
 }
 
-// SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
-	o.Set = true
-	o.Value = v
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *StatsBrowsersHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetXAPICommit returns the value of XAPICommit.
+func (s *StatsBrowsersHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetResponse returns the value of Response.
+func (s *StatsBrowsersHeaders) GetResponse() StatsBrowsers {
+	return s.Response
 }
 
-// NewOptStatsSummaryPrevious returns new OptStatsSummaryPrevious with value set to v.
-func NewOptStatsSummaryPrevious(v StatsSummaryPrevious) OptStatsSummaryPrevious {
-	return OptStatsSummaryPrevious{
-		Value: v,
-		Set:   true,
-	}
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *StatsBrowsersHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
 }
 
-// OptStatsSummaryPrevious is optional StatsSummaryPrevious.
-type OptStatsSummaryPrevious struct {
-	Value StatsSummaryPrevious
-	Set   bool
+// SetXAPICommit sets the value of XAPICommit.
+func (s *StatsBrowsersHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
 }
 
-// IsSet returns true if OptStatsSummaryPrevious was set.
-func (o OptStatsSummaryPrevious) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptStatsSummaryPrevious) Reset() {
-	var v StatsSummaryPrevious
-	o.Value = v
-	o.Set = false
+// SetResponse sets the value of Response.
+func (s *StatsBrowsersHeaders) SetResponse(val StatsBrowsers) {
+	s.Response = val
 }
 
-// SetTo sets value to v.
-func (o *OptStatsSummaryPrevious) SetTo(v StatsSummaryPrevious) {
-	o.Set = true
-	o.Value = v
-}
+func (*StatsBrowsersHeaders) getWebsiteIDBrowsersRes() {}
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptStatsSummaryPrevious) Get() (v StatsSummaryPrevious, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+type StatsBrowsersItem struct {
+	// Browser name.
+	Browser string `json:"browser"`
+	// Number of unique visitors from browser.
+	Visitors int `json:"visitors"`
+	// Percentage of unique visitors from browser relative to all visitors.
+	VisitorsPercentage float32 `json:"visitors_percentage"`
+	// Bounce rate percentage from browser.
+	BouncePercentage OptFloat32 `json:"bounce_percentage"`
+	// Total time spent on page from browser in milliseconds.
+	Duration OptInt `json:"duration"`
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptStatsSummaryPrevious) Or(d StatsSummaryPrevious) StatsSummaryPrevious {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetBrowser returns the value of Browser.
+func (s *StatsBrowsersItem) GetBrowser() string {
+	return s.Browser
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// GetVisitors returns the value of Visitors.
+func (s *StatsBrowsersItem) GetVisitors() int {
+	return s.Visitors
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// GetVisitorsPercentage returns the value of VisitorsPercentage.
+func (s *StatsBrowsersItem) GetVisitorsPercentage() float32 {
+	return s.VisitorsPercentage
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// GetBouncePercentage returns the value of BouncePercentage.
+func (s *StatsBrowsersItem) GetBouncePercentage() OptFloat32 {
+	return s.BouncePercentage
+}
 
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// GetDuration returns the value of Duration.
+func (s *StatsBrowsersItem) GetDuration() OptInt {
+	return s.Duration
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// SetBrowser sets the value of Browser.
+func (s *StatsBrowsersItem) SetBrowser(val string) {
+	s.Browser = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetVisitors sets the value of Visitors.
+func (s *StatsBrowsersItem) SetVisitors(val int) {
+	s.Visitors = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetVisitorsPercentage sets the value of VisitorsPercentage.
+func (s *StatsBrowsersItem) SetVisitorsPercentage(val float32) {
+	s.VisitorsPercentage = val
 }
 
-// NewOptUserSettings returns new OptUserSettings with value set to v.
-func NewOptUserSettings(v UserSettings) OptUserSettings {
-	return OptUserSettings{
-		Value: v,
-		Set:   true,
-	}
+// SetBouncePercentage sets the value of BouncePercentage.
+func (s *StatsBrowsersItem) SetBouncePercentage(val OptFloat32) {
+	s.BouncePercentage = val
 }
 
-// OptUserSettings is optional UserSettings.
-type OptUserSettings struct {
-	Value UserSettings
-	Set   bool
+// SetDuration sets the value of Duration.
+func (s *StatsBrowsersItem) SetDuration(val OptInt) {
+	s.Duration = val
 }
 
-// IsSet returns true if OptUserSettings was set.
-func (o OptUserSettings) IsSet() bool { return o.Set }
+type StatsCountries []StatsCountriesItem
 
-// Reset unsets value.
-func (o *OptUserSettings) Reset() {
-	var v UserSettings
-	o.Value = v
-	o.Set = false
+// StatsCountriesHeaders wraps StatsCountries with response headers.
+type StatsCountriesHeaders struct {
+	ContentDisposition OptString
+	XAPICommit         OptString
+	Response           StatsCountries
 }
 
-// SetTo sets value to v.
-func (o *OptUserSettings) SetTo(v UserSettings) {
-	o.Set = true
-	o.Value = v
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *StatsCountriesHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptUserSettings) Get() (v UserSettings, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetXAPICommit returns the value of XAPICommit.
+func (s *StatsCountriesHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptUserSettings) Or(d UserSettings) UserSettings {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetResponse returns the value of Response.
+func (s *StatsCountriesHeaders) GetResponse() StatsCountries {
+	return s.Response
 }
 
-// NewOptUserSettingsLanguage returns new OptUserSettingsLanguage with value set to v.
-func NewOptUserSettingsLanguage(v UserSettingsLanguage) OptUserSettingsLanguage {
-	return OptUserSettingsLanguage{
-		Value: v,
-		Set:   true,
-	}
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *StatsCountriesHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
 }
 
-// OptUserSettingsLanguage is optional UserSettingsLanguage.
-type OptUserSettingsLanguage struct {
-	Value UserSettingsLanguage
-	Set   bool
+// SetXAPICommit sets the value of XAPICommit.
+func (s *StatsCountriesHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
 }
 
-// IsSet returns true if OptUserSettingsLanguage was set.
-func (o OptUserSettingsLanguage) IsSet() bool { return o.Set }
+// SetResponse sets the value of Response.
+func (s *StatsCountriesHeaders) SetResponse(val StatsCountries) {
+	s.Response = val
+}
 
-// Reset unsets value.
-func (o *OptUserSettingsLanguage) Reset() {
-	var v UserSettingsLanguage
-	o.Value = v
-	o.Set = false
+func (*StatsCountriesHeaders) getWebsiteIDCountryRes() {}
+
+type StatsCountriesItem struct {
+	// Country name.
+	Country string `json:"country"`
+	// Number of unique visitors from country.
+	Visitors int `json:"visitors"`
+	// Percentage of unique visitors from country relative to all visitors.
+	VisitorsPercentage float32 `json:"visitors_percentage"`
+	// Bounce rate percentage from country.
+	BouncePercentage OptFloat32 `json:"bounce_percentage"`
+	// Total time spent on page from country in milliseconds.
+	Duration OptInt `json:"duration"`
 }
 
-// SetTo sets value to v.
-func (o *OptUserSettingsLanguage) SetTo(v UserSettingsLanguage) {
-	o.Set = true
-	o.Value = v
+// GetCountry returns the value of Country.
+func (s *StatsCountriesItem) GetCountry() string {
+	return s.Country
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptUserSettingsLanguage) Get() (v UserSettingsLanguage, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetVisitors returns the value of Visitors.
+func (s *StatsCountriesItem) GetVisitors() int {
+	return s.Visitors
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptUserSettingsLanguage) Or(d UserSettingsLanguage) UserSettingsLanguage {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetVisitorsPercentage returns the value of VisitorsPercentage.
+func (s *StatsCountriesItem) GetVisitorsPercentage() float32 {
+	return s.VisitorsPercentage
 }
 
-// NewOptWebsiteGetSummary returns new OptWebsiteGetSummary with value set to v.
-func NewOptWebsiteGetSummary(v WebsiteGetSummary) OptWebsiteGetSummary {
-	return OptWebsiteGetSummary{
-		Value: v,
-		Set:   true,
-	}
+// GetBouncePercentage returns the value of BouncePercentage.
+func (s *StatsCountriesItem) GetBouncePercentage() OptFloat32 {
+	return s.BouncePercentage
 }
 
-// OptWebsiteGetSummary is optional WebsiteGetSummary.
-type OptWebsiteGetSummary struct {
-	Value WebsiteGetSummary
-	Set   bool
+// GetDuration returns the value of Duration.
+func (s *StatsCountriesItem) GetDuration() OptInt {
+	return s.Duration
 }
 
-// IsSet returns true if OptWebsiteGetSummary was set.
-func (o OptWebsiteGetSummary) IsSet() bool { return o.Set }
+// SetCountry sets the value of Country.
+func (s *StatsCountriesItem) SetCountry(val string) {
+	s.Country = val
+}
 
-// Reset unsets value.
-func (o *OptWebsiteGetSummary) Reset() {
-	var v WebsiteGetSummary
-	o.Value = v
-	o.Set = false
+// SetVisitors sets the value of Visitors.
+func (s *StatsCountriesItem) SetVisitors(val int) {
+	s.Visitors = val
 }
 
-// SetTo sets value to v.
-func (o *OptWebsiteGetSummary) SetTo(v WebsiteGetSummary) {
-	o.Set = true
-	o.Value = v
+// SetVisitorsPercentage sets the value of VisitorsPercentage.
+func (s *StatsCountriesItem) SetVisitorsPercentage(val float32) {
+	s.VisitorsPercentage = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptWebsiteGetSummary) Get() (v WebsiteGetSummary, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetBouncePercentage sets the value of BouncePercentage.
+func (s *StatsCountriesItem) SetBouncePercentage(val OptFloat32) {
+	s.BouncePercentage = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptWebsiteGetSummary) Or(d WebsiteGetSummary) WebsiteGetSummary {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetDuration sets the value of Duration.
+func (s *StatsCountriesItem) SetDuration(val OptInt) {
+	s.Duration = val
 }
 
-// PostAuthLoginOK is response for PostAuthLogin operation.
-type PostAuthLoginOK struct {
-	SetCookie  string
-	XAPICommit OptString
+type StatsDevices []StatsDevicesItem
+
+// StatsDevicesHeaders wraps StatsDevices with response headers.
+type StatsDevicesHeaders struct {
+	ContentDisposition OptString
+	XAPICommit         OptString
+	Response           StatsDevices
 }
 
-// GetSetCookie returns the value of SetCookie.
-func (s *PostAuthLoginOK) GetSetCookie() string {
-	return s.SetCookie
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *StatsDevicesHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
 }
 
 // GetXAPICommit returns the value of XAPICommit.
-func (s *PostAuthLoginOK) GetXAPICommit() OptString {
+func (s *StatsDevicesHeaders) GetXAPICommit() OptString {
 	return s.XAPICommit
 }
 
-// SetSetCookie sets the value of SetCookie.
-func (s *PostAuthLoginOK) SetSetCookie(val string) {
-	s.SetCookie = val
+// GetResponse returns the value of Response.
+func (s *StatsDevicesHeaders) GetResponse() StatsDevices {
+	return s.Response
+}
+
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *StatsDevicesHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
 }
 
 // SetXAPICommit sets the value of XAPICommit.
-func (s *PostAuthLoginOK) SetXAPICommit(val OptString) {
+func (s *StatsDevicesHeaders) SetXAPICommit(val OptString) {
 	s.XAPICommit = val
 }
 
-func (*PostAuthLoginOK) postAuthLoginRes() {}
+// SetResponse sets the value of Response.
+func (s *StatsDevicesHeaders) SetResponse(val StatsDevices) {
+	s.Response = val
+}
 
-// PostAuthLogoutNoContent is response for PostAuthLogout operation.
-type PostAuthLogoutNoContent struct {
-	SetCookie  string
-	XAPICommit OptString
+func (*StatsDevicesHeaders) getWebsiteIDDeviceRes() {}
+
+type StatsDevicesItem struct {
+	// Device name.
+	Device string `json:"device"`
+	// Number of unique visitors from device.
+	Visitors int `json:"visitors"`
+	// Percentage of unique visitors from device relative to all visitors.
+	VisitorsPercentage float32 `json:"visitors_percentage"`
+	// Bounce rate percentage from device.
+	BouncePercentage OptFloat32 `json:"bounce_percentage"`
+	// Total time spent on page from device in milliseconds.
+	Duration OptInt `json:"duration"`
 }
 
-// GetSetCookie returns the value of SetCookie.
-func (s *PostAuthLogoutNoContent) GetSetCookie() string {
-	return s.SetCookie
+// GetDevice returns the value of Device.
+func (s *StatsDevicesItem) GetDevice() string {
+	return s.Device
 }
 
-// GetXAPICommit returns the value of XAPICommit.
-func (s *PostAuthLogoutNoContent) GetXAPICommit() OptString {
-	return s.XAPICommit
+// GetVisitors returns the value of Visitors.
+func (s *StatsDevicesItem) GetVisitors() int {
+	return s.Visitors
 }
 
-// SetSetCookie sets the value of SetCookie.
-func (s *PostAuthLogoutNoContent) SetSetCookie(val string) {
-	s.SetCookie = val
+// GetVisitorsPercentage returns the value of VisitorsPercentage.
+func (s *StatsDevicesItem) GetVisitorsPercentage() float32 {
+	return s.VisitorsPercentage
 }
 
-// SetXAPICommit sets the value of XAPICommit.
-func (s *PostAuthLogoutNoContent) SetXAPICommit(val OptString) {
-	s.XAPICommit = val
+// GetBouncePercentage returns the value of BouncePercentage.
+func (s *StatsDevicesItem) GetBouncePercentage() OptFloat32 {
+	return s.BouncePercentage
 }
 
-func (*PostAuthLogoutNoContent) postAuthLogoutRes() {}
+// GetDuration returns the value of Duration.
+func (s *StatsDevicesItem) GetDuration() OptInt {
+	return s.Duration
+}
 
-// PostEventHitNoContent is response for PostEventHit operation.
-type PostEventHitNoContent struct{}
+// SetDevice sets the value of Device.
+func (s *StatsDevicesItem) SetDevice(val string) {
+	s.Device = val
+}
 
-func (*PostEventHitNoContent) postEventHitRes() {}
+// SetVisitors sets the value of Visitors.
+func (s *StatsDevicesItem) SetVisitors(val int) {
+	s.Visitors = val
+}
 
-type StatsBrowsers []StatsBrowsersItem
+// SetVisitorsPercentage sets the value of VisitorsPercentage.
+func (s *StatsDevicesItem) SetVisitorsPercentage(val float32) {
+	s.VisitorsPercentage = val
+}
 
-// StatsBrowsersHeaders wraps StatsBrowsers with response headers.
-type StatsBrowsersHeaders struct {
-	XAPICommit OptString
-	Response   StatsBrowsers
+// SetBouncePercentage sets the value of BouncePercentage.
+func (s *StatsDevicesItem) SetBouncePercentage(val OptFloat32) {
+	s.BouncePercentage = val
+}
+
+// SetDuration sets the value of Duration.
+func (s *StatsDevicesItem) SetDuration(val OptInt) {
+	s.Duration = val
+}
+
+type StatsFunnel []StatsFunnelItem
+
+// StatsFunnelHeaders wraps StatsFunnel with response headers.
+type StatsFunnelHeaders struct {
+	ContentDisposition OptString
+	XAPICommit         OptString
+	Response           StatsFunnel
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *StatsFunnelHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
 }
 
 // GetXAPICommit returns the value of XAPICommit.
-func (s *StatsBrowsersHeaders) GetXAPICommit() OptString {
+func (s *StatsFunnelHeaders) GetXAPICommit() OptString {
 	return s.XAPICommit
 }
 
 // GetResponse returns the value of Response.
-func (s *StatsBrowsersHeaders) GetResponse() StatsBrowsers {
+func (s *StatsFunnelHeaders) GetResponse() StatsFunnel {
 	return s.Response
 }
 
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *StatsFunnelHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
+}
+
 // SetXAPICommit sets the value of XAPICommit.
-func (s *StatsBrowsersHeaders) SetXAPICommit(val OptString) {
+func (s *StatsFunnelHeaders) SetXAPICommit(val OptString) {
 	s.XAPICommit = val
 }
 
 // SetResponse sets the value of Response.
-func (s *StatsBrowsersHeaders) SetResponse(val StatsBrowsers) {
+func (s *StatsFunnelHeaders) SetResponse(val StatsFunnel) {
 	s.Response = val
 }
 
-func (*StatsBrowsersHeaders) getWebsiteIDBrowsersRes() {}
+func (*StatsFunnelHeaders) getWebsiteIDFunnelRes() {}
 
-type StatsBrowsersItem struct {
-	// Browser name.
-	Browser string `json:"browser"`
-	// Number of unique visitors from browser.
-	Visitors int `json:"visitors"`
-	// Percentage of unique visitors from browser relative to all visitors.
-	VisitorsPercentage float32 `json:"visitors_percentage"`
-	// Bounce rate percentage from browser.
-	BouncePercentage OptFloat32 `json:"bounce_percentage"`
-	// Total time spent on page from browser in milliseconds.
-	Duration OptInt `json:"duration"`
+type StatsFunnelItem struct {
+	// Position of the step in the funnel, starting from 1.
+	Step int `json:"step"`
+	// Step definition as given in the request.
+	Name string `json:"name"`
+	// Number of visits that reached the step.
+	Visits int `json:"visits"`
+	// Percentage of visits from the first step that reached this step.
+	ConversionRate float32 `json:"conversion_rate"`
+	// Number of visits from the previous step that did not reach this step.
+	Dropoff int `json:"dropoff"`
+	// Percentage of visits from the previous step that did not reach this step.
+	DropoffRate float32 `json:"dropoff_rate"`
 }
 
-// GetBrowser returns the value of Browser.
-func (s *StatsBrowsersItem) GetBrowser() string {
-	return s.Browser
+// GetStep returns the value of Step.
+func (s *StatsFunnelItem) GetStep() int {
+	return s.Step
 }
 
-// GetVisitors returns the value of Visitors.
-func (s *StatsBrowsersItem) GetVisitors() int {
-	return s.Visitors
+// GetName returns the value of Name.
+func (s *StatsFunnelItem) GetName() string {
+	return s.Name
 }
 
-// GetVisitorsPercentage returns the value of VisitorsPercentage.
-func (s *StatsBrowsersItem) GetVisitorsPercentage() float32 {
-	return s.VisitorsPercentage
+// GetVisits returns the value of Visits.
+func (s *StatsFunnelItem) GetVisits() int {
+	return s.Visits
 }
 
-// GetBouncePercentage returns the value of BouncePercentage.
-func (s *StatsBrowsersItem) GetBouncePercentage() OptFloat32 {
-	return s.BouncePercentage
+// GetConversionRate returns the value of ConversionRate.
+func (s *StatsFunnelItem) GetConversionRate() float32 {
+	return s.ConversionRate
 }
 
-// GetDuration returns the value of Duration.
-func (s *StatsBrowsersItem) GetDuration() OptInt {
-	return s.Duration
+// GetDropoff returns the value of Dropoff.
+func (s *StatsFunnelItem) GetDropoff() int {
+	return s.Dropoff
 }
 
-// SetBrowser sets the value of Browser.
-func (s *StatsBrowsersItem) SetBrowser(val string) {
-	s.Browser = val
+// GetDropoffRate returns the value of DropoffRate.
+func (s *StatsFunnelItem) GetDropoffRate() float32 {
+	return s.DropoffRate
 }
 
-// SetVisitors sets the value of Visitors.
-func (s *StatsBrowsersItem) SetVisitors(val int) {
-	s.Visitors = val
+// SetStep sets the value of Step.
+func (s *StatsFunnelItem) SetStep(val int) {
+	s.Step = val
 }
 
-// SetVisitorsPercentage sets the value of VisitorsPercentage.
-func (s *StatsBrowsersItem) SetVisitorsPercentage(val float32) {
-	s.VisitorsPercentage = val
+// SetName sets the value of Name.
+func (s *StatsFunnelItem) SetName(val string) {
+	s.Name = val
 }
 
-// SetBouncePercentage sets the value of BouncePercentage.
-func (s *StatsBrowsersItem) SetBouncePercentage(val OptFloat32) {
-	s.BouncePercentage = val
+// SetVisits sets the value of Visits.
+func (s *StatsFunnelItem) SetVisits(val int) {
+	s.Visits = val
 }
 
-// SetDuration sets the value of Duration.
-func (s *StatsBrowsersItem) SetDuration(val OptInt) {
-	s.Duration = val
+// SetConversionRate sets the value of ConversionRate.
+func (s *StatsFunnelItem) SetConversionRate(val float32) {
+	s.ConversionRate = val
 }
 
-type StatsCountries []StatsCountriesItem
+// SetDropoff sets the value of Dropoff.
+func (s *StatsFunnelItem) SetDropoff(val int) {
+	s.Dropoff = val
+}
 
-// StatsCountriesHeaders wraps StatsCountries with response headers.
-type StatsCountriesHeaders struct {
-	XAPICommit OptString
-	Response   StatsCountries
+// SetDropoffRate sets the value of DropoffRate.
+func (s *StatsFunnelItem) SetDropoffRate(val float32) {
+	s.DropoffRate = val
+}
+
+type StatsGoals []StatsGoalsItem
+
+// StatsGoalsHeaders wraps StatsGoals with response headers.
+type StatsGoalsHeaders struct {
+	ContentDisposition OptString
+	XAPICommit         OptString
+	Response           StatsGoals
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *StatsGoalsHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
 }
 
 // GetXAPICommit returns the value of XAPICommit.
-func (s *StatsCountriesHeaders) GetXAPICommit() OptString {
+func (s *StatsGoalsHeaders) GetXAPICommit() OptString {
 	return s.XAPICommit
 }
 
 // GetResponse returns the value of Response.
-func (s *StatsCountriesHeaders) GetResponse() StatsCountries {
+func (s *StatsGoalsHeaders) GetResponse() StatsGoals {
 	return s.Response
 }
 
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *StatsGoalsHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
+}
+
 // SetXAPICommit sets the value of XAPICommit.
-func (s *StatsCountriesHeaders) SetXAPICommit(val OptString) {
+func (s *StatsGoalsHeaders) SetXAPICommit(val OptString) {
 	s.XAPICommit = val
 }
 
 // SetResponse sets the value of Response.
-func (s *StatsCountriesHeaders) SetResponse(val StatsCountries) {
+func (s *StatsGoalsHeaders) SetResponse(val StatsGoals) {
 	s.Response = val
 }
 
-func (*StatsCountriesHeaders) getWebsiteIDCountryRes() {}
+func (*StatsGoalsHeaders) getWebsiteIDGoalsRes() {}
 
-type StatsCountriesItem struct {
-	// Country name.
-	Country string `json:"country"`
-	// Number of unique visitors from country.
-	Visitors int `json:"visitors"`
-	// Percentage of unique visitors from country relative to all visitors.
-	VisitorsPercentage float32 `json:"visitors_percentage"`
-	// Bounce rate percentage from country.
-	BouncePercentage OptFloat32 `json:"bounce_percentage"`
-	// Total time spent on page from country in milliseconds.
-	Duration OptInt `json:"duration"`
+type StatsGoalsItem struct {
+	// Goal ID.
+	ID string `json:"id"`
+	// Goal name.
+	Name string `json:"name"`
+	// Number of times the goal was completed.
+	Conversions int `json:"conversions"`
+	// Number of unique visitors that completed the goal.
+	Converters int `json:"converters"`
+	// Percentage of unique visitors that completed the goal.
+	ConversionRate float32 `json:"conversion_rate"`
 }
 
-// GetCountry returns the value of Country.
-func (s *StatsCountriesItem) GetCountry() string {
-	return s.Country
+// GetID returns the value of ID.
+func (s *StatsGoalsItem) GetID() string {
+	return s.ID
 }
 
-// GetVisitors returns the value of Visitors.
-func (s *StatsCountriesItem) GetVisitors() int {
-	return s.Visitors
+// GetName returns the value of Name.
+func (s *StatsGoalsItem) GetName() string {
+	return s.Name
 }
 
-// GetVisitorsPercentage returns the value of VisitorsPercentage.
-func (s *StatsCountriesItem) GetVisitorsPercentage() float32 {
-	return s.VisitorsPercentage
+// GetConversions returns the value of Conversions.
+func (s *StatsGoalsItem) GetConversions() int {
+	return s.Conversions
 }
 
-// GetBouncePercentage returns the value of BouncePercentage.
-func (s *StatsCountriesItem) GetBouncePercentage() OptFloat32 {
-	return s.BouncePercentage
+// GetConverters returns the value of Converters.
+func (s *StatsGoalsItem) GetConverters() int {
+	return s.Converters
 }
 
-// GetDuration returns the value of Duration.
-func (s *StatsCountriesItem) GetDuration() OptInt {
-	return s.Duration
+// GetConversionRate returns the value of ConversionRate.
+func (s *StatsGoalsItem) GetConversionRate() float32 {
+	return s.ConversionRate
 }
 
-// SetCountry sets the value of Country.
-func (s *StatsCountriesItem) SetCountry(val string) {
-	s.Country = val
+// SetID sets the value of ID.
+func (s *StatsGoalsItem) SetID(val string) {
+	s.ID = val
 }
 
-// SetVisitors sets the value of Visitors.
-func (s *StatsCountriesItem) SetVisitors(val int) {
-	s.Visitors = val
+// SetName sets the value of Name.
+func (s *StatsGoalsItem) SetName(val string) {
+	s.Name = val
 }
 
-// SetVisitorsPercentage sets the value of VisitorsPercentage.
-func (s *StatsCountriesItem) SetVisitorsPercentage(val float32) {
-	s.VisitorsPercentage = val
+// SetConversions sets the value of Conversions.
+func (s *StatsGoalsItem) SetConversions(val int) {
+	s.Conversions = val
 }
 
-// SetBouncePercentage sets the value of BouncePercentage.
-func (s *StatsCountriesItem) SetBouncePercentage(val OptFloat32) {
-	s.BouncePercentage = val
+// SetConverters sets the value of Converters.
+func (s *StatsGoalsItem) SetConverters(val int) {
+	s.Converters = val
 }
 
-// SetDuration sets the value of Duration.
-func (s *StatsCountriesItem) SetDuration(val OptInt) {
-	s.Duration = val
+// SetConversionRate sets the value of ConversionRate.
+func (s *StatsGoalsItem) SetConversionRate(val float32) {
+	s.ConversionRate = val
 }
 
-type StatsDevices []StatsDevicesItem
+type StatsHostnames []StatsHostnamesItem
 
-// StatsDevicesHeaders wraps StatsDevices with response headers.
-type StatsDevicesHeaders struct {
-	XAPICommit OptString
-	Response   StatsDevices
+// StatsHostnamesHeaders wraps StatsHostnames with response headers.
+type StatsHostnamesHeaders struct {
+	ContentDisposition OptString
+	XAPICommit         OptString
+	Response           StatsHostnames
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *StatsHostnamesHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
 }
 
 // GetXAPICommit returns the value of XAPICommit.
-func (s *StatsDevicesHeaders) GetXAPICommit() OptString {
+func (s *StatsHostnamesHeaders) GetXAPICommit() OptString {
 	return s.XAPICommit
 }
 
 // GetResponse returns the value of Response.
-func (s *StatsDevicesHeaders) GetResponse() StatsDevices {
+func (s *StatsHostnamesHeaders) GetResponse() StatsHostnames {
 	return s.Response
 }
 
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *StatsHostnamesHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
+}
+
 // SetXAPICommit sets the value of XAPICommit.
-func (s *StatsDevicesHeaders) SetXAPICommit(val OptString) {
+func (s *StatsHostnamesHeaders) SetXAPICommit(val OptString) {
 	s.XAPICommit = val
 }
 
 // SetResponse sets the value of Response.
-func (s *StatsDevicesHeaders) SetResponse(val StatsDevices) {
+func (s *StatsHostnamesHeaders) SetResponse(val StatsHostnames) {
 	s.Response = val
 }
 
-func (*StatsDevicesHeaders) getWebsiteIDDeviceRes() {}
+func (*StatsHostnamesHeaders) getWebsiteIDHostnamesRes() {}
 
-type StatsDevicesItem struct {
-	// Device name.
-	Device string `json:"device"`
-	// Number of unique visitors from device.
+type StatsHostnamesItem struct {
+	// Hostname or alias the page views were received on.
+	Hostname string `json:"hostname"`
+	// Number of unique visitors on hostname.
 	Visitors int `json:"visitors"`
-	// Percentage of unique visitors from device relative to all visitors.
+	// Percentage of unique visitors on hostname relative to all visitors.
 	VisitorsPercentage float32 `json:"visitors_percentage"`
-	// Bounce rate percentage from device.
+	// Bounce rate percentage on hostname.
 	BouncePercentage OptFloat32 `json:"bounce_percentage"`
-	// Total time spent on page from device in milliseconds.
+	// Total time spent on page on hostname in milliseconds.
 	Duration OptInt `json:"duration"`
 }
 
-// GetDevice returns the value of Device.
-func (s *StatsDevicesItem) GetDevice() string {
-	return s.Device
+// GetHostname returns the value of Hostname.
+func (s *StatsHostnamesItem) GetHostname() string {
+	return s.Hostname
 }
 
 // GetVisitors returns the value of Visitors.
-func (s *StatsDevicesItem) GetVisitors() int {
+func (s *StatsHostnamesItem) GetVisitors() int {
 	return s.Visitors
 }
 
 // GetVisitorsPercentage returns the value of VisitorsPercentage.
-func (s *StatsDevicesItem) GetVisitorsPercentage() float32 {
+func (s *StatsHostnamesItem) GetVisitorsPercentage() float32 {
 	return s.VisitorsPercentage
 }
 
 // GetBouncePercentage returns the value of BouncePercentage.
-func (s *StatsDevicesItem) GetBouncePercentage() OptFloat32 {
+func (s *StatsHostnamesItem) GetBouncePercentage() OptFloat32 {
 	return s.BouncePercentage
 }
 
 // GetDuration returns the value of Duration.
-func (s *StatsDevicesItem) GetDuration() OptInt {
+func (s *StatsHostnamesItem) GetDuration() OptInt {
 	return s.Duration
 }
 
-// SetDevice sets the value of Device.
-func (s *StatsDevicesItem) SetDevice(val string) {
-	s.Device = val
+// SetHostname sets the value of Hostname.
+func (s *StatsHostnamesItem) SetHostname(val string) {
+	s.Hostname = val
 }
 
 // SetVisitors sets the value of Visitors.
-func (s *StatsDevicesItem) SetVisitors(val int) {
+func (s *StatsHostnamesItem) SetVisitors(val int) {
 	s.Visitors = val
 }
 
 // SetVisitorsPercentage sets the value of VisitorsPercentage.
-func (s *StatsDevicesItem) SetVisitorsPercentage(val float32) {
+func (s *StatsHostnamesItem) SetVisitorsPercentage(val float32) {
 	s.VisitorsPercentage = val
 }
 
 // SetBouncePercentage sets the value of BouncePercentage.
-func (s *StatsDevicesItem) SetBouncePercentage(val OptFloat32) {
+func (s *StatsHostnamesItem) SetBouncePercentage(val OptFloat32) {
 	s.BouncePercentage = val
 }
 
 // SetDuration sets the value of Duration.
-func (s *StatsDevicesItem) SetDuration(val OptInt) {
+func (s *StatsHostnamesItem) SetDuration(val OptInt) {
 	s.Duration = val
 }
 
@@ -2137,8 +5362,14 @@ type StatsLanguages []StatsLanguagesItem
 
 // StatsLanguagesHeaders wraps StatsLanguages with response headers.
 type StatsLanguagesHeaders struct {
-	XAPICommit OptString
-	Response   StatsLanguages
+	ContentDisposition OptString
+	XAPICommit         OptString
+	Response           StatsLanguages
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *StatsLanguagesHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
 }
 
 // GetXAPICommit returns the value of XAPICommit.
@@ -2151,6 +5382,11 @@ func (s *StatsLanguagesHeaders) GetResponse() StatsLanguages {
 	return s.Response
 }
 
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *StatsLanguagesHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
+}
+
 // SetXAPICommit sets the value of XAPICommit.
 func (s *StatsLanguagesHeaders) SetXAPICommit(val OptString) {
 	s.XAPICommit = val
@@ -2230,8 +5466,14 @@ type StatsOS []StatsOSItem
 
 // StatsOSHeaders wraps StatsOS with response headers.
 type StatsOSHeaders struct {
-	XAPICommit OptString
-	Response   StatsOS
+	ContentDisposition OptString
+	XAPICommit         OptString
+	Response           StatsOS
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *StatsOSHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
 }
 
 // GetXAPICommit returns the value of XAPICommit.
@@ -2244,6 +5486,11 @@ func (s *StatsOSHeaders) GetResponse() StatsOS {
 	return s.Response
 }
 
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *StatsOSHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
+}
+
 // SetXAPICommit sets the value of XAPICommit.
 func (s *StatsOSHeaders) SetXAPICommit(val OptString) {
 	s.XAPICommit = val
@@ -2323,8 +5570,14 @@ type StatsPages []StatsPagesItem
 
 // StatsPagesHeaders wraps StatsPages with response headers.
 type StatsPagesHeaders struct {
-	XAPICommit OptString
-	Response   StatsPages
+	ContentDisposition OptString
+	XAPICommit         OptString
+	Response           StatsPages
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *StatsPagesHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
 }
 
 // GetXAPICommit returns the value of XAPICommit.
@@ -2337,6 +5590,11 @@ func (s *StatsPagesHeaders) GetResponse() StatsPages {
 	return s.Response
 }
 
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *StatsPagesHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
+}
+
 // SetXAPICommit sets the value of XAPICommit.
 func (s *StatsPagesHeaders) SetXAPICommit(val OptString) {
 	s.XAPICommit = val
@@ -2440,8 +5698,14 @@ type StatsProperties []StatsPropertiesItem
 
 // StatsPropertiesHeaders wraps StatsProperties with response headers.
 type StatsPropertiesHeaders struct {
-	XAPICommit OptString
-	Response   StatsProperties
+	ContentDisposition OptString
+	XAPICommit         OptString
+	Response           StatsProperties
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *StatsPropertiesHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
 }
 
 // GetXAPICommit returns the value of XAPICommit.
@@ -2454,6 +5718,11 @@ func (s *StatsPropertiesHeaders) GetResponse() StatsProperties {
 	return s.Response
 }
 
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *StatsPropertiesHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
+}
+
 // SetXAPICommit sets the value of XAPICommit.
 func (s *StatsPropertiesHeaders) SetXAPICommit(val OptString) {
 	s.XAPICommit = val
@@ -2487,42 +5756,171 @@ func (s *StatsPropertiesItem) GetValue() OptString {
 	return s.Value
 }
 
-// GetEvents returns the value of Events.
-func (s *StatsPropertiesItem) GetEvents() int {
-	return s.Events
+// GetEvents returns the value of Events.
+func (s *StatsPropertiesItem) GetEvents() int {
+	return s.Events
+}
+
+// GetEventsPercentage returns the value of EventsPercentage.
+func (s *StatsPropertiesItem) GetEventsPercentage() float32 {
+	return s.EventsPercentage
+}
+
+// SetName sets the value of Name.
+func (s *StatsPropertiesItem) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetValue sets the value of Value.
+func (s *StatsPropertiesItem) SetValue(val OptString) {
+	s.Value = val
+}
+
+// SetEvents sets the value of Events.
+func (s *StatsPropertiesItem) SetEvents(val int) {
+	s.Events = val
+}
+
+// SetEventsPercentage sets the value of EventsPercentage.
+func (s *StatsPropertiesItem) SetEventsPercentage(val float32) {
+	s.EventsPercentage = val
+}
+
+// Visitors active in the last few minutes and the pages and referrers they are viewing.
+// Ref: #/components/schemas/StatsRealtime
+type StatsRealtime struct {
+	// Number of active visitors.
+	Visitors int `json:"visitors"`
+	// Pages with the most active visitors.
+	Pages []StatsRealtimePagesItem `json:"pages"`
+	// Referrers with the most active visitors.
+	Referrers []StatsRealtimeReferrersItem `json:"referrers"`
+}
+
+// GetVisitors returns the value of Visitors.
+func (s *StatsRealtime) GetVisitors() int {
+	return s.Visitors
+}
+
+// GetPages returns the value of Pages.
+func (s *StatsRealtime) GetPages() []StatsRealtimePagesItem {
+	return s.Pages
+}
+
+// GetReferrers returns the value of Referrers.
+func (s *StatsRealtime) GetReferrers() []StatsRealtimeReferrersItem {
+	return s.Referrers
+}
+
+// SetVisitors sets the value of Visitors.
+func (s *StatsRealtime) SetVisitors(val int) {
+	s.Visitors = val
+}
+
+// SetPages sets the value of Pages.
+func (s *StatsRealtime) SetPages(val []StatsRealtimePagesItem) {
+	s.Pages = val
+}
+
+// SetReferrers sets the value of Referrers.
+func (s *StatsRealtime) SetReferrers(val []StatsRealtimeReferrersItem) {
+	s.Referrers = val
+}
+
+// StatsRealtimeHeaders wraps StatsRealtime with response headers.
+type StatsRealtimeHeaders struct {
+	XAPICommit OptString
+	Response   StatsRealtime
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *StatsRealtimeHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *StatsRealtimeHeaders) GetResponse() StatsRealtime {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *StatsRealtimeHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *StatsRealtimeHeaders) SetResponse(val StatsRealtime) {
+	s.Response = val
+}
+
+func (*StatsRealtimeHeaders) getWebsiteIDRealtimeRes() {}
+
+type StatsRealtimePagesItem struct {
+	// Pathname of the page.
+	Path string `json:"path"`
+	// Number of active visitors that viewed the page.
+	Visitors int `json:"visitors"`
+}
+
+// GetPath returns the value of Path.
+func (s *StatsRealtimePagesItem) GetPath() string {
+	return s.Path
+}
+
+// GetVisitors returns the value of Visitors.
+func (s *StatsRealtimePagesItem) GetVisitors() int {
+	return s.Visitors
+}
+
+// SetPath sets the value of Path.
+func (s *StatsRealtimePagesItem) SetPath(val string) {
+	s.Path = val
 }
 
-// GetEventsPercentage returns the value of EventsPercentage.
-func (s *StatsPropertiesItem) GetEventsPercentage() float32 {
-	return s.EventsPercentage
+// SetVisitors sets the value of Visitors.
+func (s *StatsRealtimePagesItem) SetVisitors(val int) {
+	s.Visitors = val
 }
 
-// SetName sets the value of Name.
-func (s *StatsPropertiesItem) SetName(val OptString) {
-	s.Name = val
+type StatsRealtimeReferrersItem struct {
+	// Referrer group or hostname. Empty for direct traffic.
+	Referrer string `json:"referrer"`
+	// Number of active visitors from the referrer.
+	Visitors int `json:"visitors"`
 }
 
-// SetValue sets the value of Value.
-func (s *StatsPropertiesItem) SetValue(val OptString) {
-	s.Value = val
+// GetReferrer returns the value of Referrer.
+func (s *StatsRealtimeReferrersItem) GetReferrer() string {
+	return s.Referrer
 }
 
-// SetEvents sets the value of Events.
-func (s *StatsPropertiesItem) SetEvents(val int) {
-	s.Events = val
+// GetVisitors returns the value of Visitors.
+func (s *StatsRealtimeReferrersItem) GetVisitors() int {
+	return s.Visitors
 }
 
-// SetEventsPercentage sets the value of EventsPercentage.
-func (s *StatsPropertiesItem) SetEventsPercentage(val float32) {
-	s.EventsPercentage = val
+// SetReferrer sets the value of Referrer.
+func (s *StatsRealtimeReferrersItem) SetReferrer(val string) {
+	s.Referrer = val
+}
+
+// SetVisitors sets the value of Visitors.
+func (s *StatsRealtimeReferrersItem) SetVisitors(val int) {
+	s.Visitors = val
 }
 
 type StatsReferrers []StatsReferrersItem
 
 // StatsReferrersHeaders wraps StatsReferrers with response headers.
 type StatsReferrersHeaders struct {
-	XAPICommit OptString
-	Response   StatsReferrers
+	ContentDisposition OptString
+	XAPICommit         OptString
+	Response           StatsReferrers
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *StatsReferrersHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
 }
 
 // GetXAPICommit returns the value of XAPICommit.
@@ -2535,6 +5933,11 @@ func (s *StatsReferrersHeaders) GetResponse() StatsReferrers {
 	return s.Response
 }
 
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *StatsReferrersHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
+}
+
 // SetXAPICommit sets the value of XAPICommit.
 func (s *StatsReferrersHeaders) SetXAPICommit(val OptString) {
 	s.XAPICommit = val
@@ -2728,6 +6131,8 @@ type StatsSummaryIntervalItem struct {
 	Pageviews        OptInt     `json:"pageviews"`
 	BouncePercentage OptFloat32 `json:"bounce_percentage"`
 	Duration         OptInt     `json:"duration"`
+	// Metrics of the interval that deviate from the baseline. Only returned if anomalies are requested.
+	Anomalies []StatsAnomaly `json:"anomalies"`
 }
 
 // GetDate returns the value of Date.
@@ -2755,6 +6160,11 @@ func (s *StatsSummaryIntervalItem) GetDuration() OptInt {
 	return s.Duration
 }
 
+// GetAnomalies returns the value of Anomalies.
+func (s *StatsSummaryIntervalItem) GetAnomalies() []StatsAnomaly {
+	return s.Anomalies
+}
+
 // SetDate sets the value of Date.
 func (s *StatsSummaryIntervalItem) SetDate(val string) {
 	s.Date = val
@@ -2780,6 +6190,11 @@ func (s *StatsSummaryIntervalItem) SetDuration(val OptInt) {
 	s.Duration = val
 }
 
+// SetAnomalies sets the value of Anomalies.
+func (s *StatsSummaryIntervalItem) SetAnomalies(val []StatsAnomaly) {
+	s.Anomalies = val
+}
+
 type StatsSummaryPrevious struct {
 	Visitors         int     `json:"visitors"`
 	Pageviews        int     `json:"pageviews"`
@@ -2831,8 +6246,14 @@ type StatsTime []StatsTimeItem
 
 // StatsTimeHeaders wraps StatsTime with response headers.
 type StatsTimeHeaders struct {
-	XAPICommit OptString
-	Response   StatsTime
+	ContentDisposition OptString
+	XAPICommit         OptString
+	Response           StatsTime
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *StatsTimeHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
 }
 
 // GetXAPICommit returns the value of XAPICommit.
@@ -2845,6 +6266,11 @@ func (s *StatsTimeHeaders) GetResponse() StatsTime {
 	return s.Response
 }
 
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *StatsTimeHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
+}
+
 // SetXAPICommit sets the value of XAPICommit.
 func (s *StatsTimeHeaders) SetXAPICommit(val OptString) {
 	s.XAPICommit = val
@@ -2936,8 +6362,14 @@ type StatsUTMCampaigns []StatsUTMCampaignsItem
 
 // StatsUTMCampaignsHeaders wraps StatsUTMCampaigns with response headers.
 type StatsUTMCampaignsHeaders struct {
-	XAPICommit OptString
-	Response   StatsUTMCampaigns
+	ContentDisposition OptString
+	XAPICommit         OptString
+	Response           StatsUTMCampaigns
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *StatsUTMCampaignsHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
 }
 
 // GetXAPICommit returns the value of XAPICommit.
@@ -2950,6 +6382,11 @@ func (s *StatsUTMCampaignsHeaders) GetResponse() StatsUTMCampaigns {
 	return s.Response
 }
 
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *StatsUTMCampaignsHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
+}
+
 // SetXAPICommit sets the value of XAPICommit.
 func (s *StatsUTMCampaignsHeaders) SetXAPICommit(val OptString) {
 	s.XAPICommit = val
@@ -3029,8 +6466,14 @@ type StatsUTMMediums []StatsUTMMediumsItem
 
 // StatsUTMMediumsHeaders wraps StatsUTMMediums with response headers.
 type StatsUTMMediumsHeaders struct {
-	XAPICommit OptString
-	Response   StatsUTMMediums
+	ContentDisposition OptString
+	XAPICommit         OptString
+	Response           StatsUTMMediums
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *StatsUTMMediumsHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
 }
 
 // GetXAPICommit returns the value of XAPICommit.
@@ -3043,6 +6486,11 @@ func (s *StatsUTMMediumsHeaders) GetResponse() StatsUTMMediums {
 	return s.Response
 }
 
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *StatsUTMMediumsHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
+}
+
 // SetXAPICommit sets the value of XAPICommit.
 func (s *StatsUTMMediumsHeaders) SetXAPICommit(val OptString) {
 	s.XAPICommit = val
@@ -3122,8 +6570,14 @@ type StatsUTMSources []StatsUTMSourcesItem
 
 // StatsUTMSourcesHeaders wraps StatsUTMSources with response headers.
 type StatsUTMSourcesHeaders struct {
-	XAPICommit OptString
-	Response   StatsUTMSources
+	ContentDisposition OptString
+	XAPICommit         OptString
+	Response           StatsUTMSources
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *StatsUTMSourcesHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
 }
 
 // GetXAPICommit returns the value of XAPICommit.
@@ -3136,6 +6590,11 @@ func (s *StatsUTMSourcesHeaders) GetResponse() StatsUTMSources {
 	return s.Response
 }
 
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *StatsUTMSourcesHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
+}
+
 // SetXAPICommit sets the value of XAPICommit.
 func (s *StatsUTMSourcesHeaders) SetXAPICommit(val OptString) {
 	s.XAPICommit = val
@@ -3211,6 +6670,314 @@ func (s *StatsUTMSourcesItem) SetDuration(val OptInt) {
 	s.Duration = val
 }
 
+type StatsVisitPages []StatsVisitPagesItem
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type StatsVisitPagesApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s StatsVisitPagesApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// StatsVisitPagesApplicationXNdjsonHeaders wraps StatsVisitPagesApplicationXNdjson with response headers.
+type StatsVisitPagesApplicationXNdjsonHeaders struct {
+	ContentDisposition OptString
+	XAPICommit         OptString
+	Response           StatsVisitPagesApplicationXNdjson
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *StatsVisitPagesApplicationXNdjsonHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *StatsVisitPagesApplicationXNdjsonHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *StatsVisitPagesApplicationXNdjsonHeaders) GetResponse() StatsVisitPagesApplicationXNdjson {
+	return s.Response
+}
+
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *StatsVisitPagesApplicationXNdjsonHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *StatsVisitPagesApplicationXNdjsonHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *StatsVisitPagesApplicationXNdjsonHeaders) SetResponse(val StatsVisitPagesApplicationXNdjson) {
+	s.Response = val
+}
+
+// StatsVisitPagesHeaders wraps StatsVisitPages with response headers.
+type StatsVisitPagesHeaders struct {
+	ContentDisposition OptString
+	XAPICommit         OptString
+	Response           StatsVisitPages
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *StatsVisitPagesHeaders) GetContentDisposition() OptString {
+	return s.ContentDisposition
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *StatsVisitPagesHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *StatsVisitPagesHeaders) GetResponse() StatsVisitPages {
+	return s.Response
+}
+
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *StatsVisitPagesHeaders) SetContentDisposition(val OptString) {
+	s.ContentDisposition = val
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *StatsVisitPagesHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *StatsVisitPagesHeaders) SetResponse(val StatsVisitPages) {
+	s.Response = val
+}
+
+func (*StatsVisitPagesHeaders) getWebsiteIDPagesEntryRes() {}
+func (*StatsVisitPagesHeaders) getWebsiteIDPagesExitRes()  {}
+
+type StatsVisitPagesItem struct {
+	// Pathname of the page.
+	Path string `json:"path"`
+	// Number of visits that started or ended on the given page.
+	Visitors int `json:"visitors"`
+	// Percentage of visits that started or ended on the given page relative to all visits.
+	VisitorsPercentage float32 `json:"visitors_percentage"`
+	// Percentage of visits that only viewed a single page.
+	BouncePercentage OptFloat32 `json:"bounce_percentage"`
+	// Median total time spent on the visit in milliseconds.
+	Duration OptInt `json:"duration"`
+}
+
+// GetPath returns the value of Path.
+func (s *StatsVisitPagesItem) GetPath() string {
+	return s.Path
+}
+
+// GetVisitors returns the value of Visitors.
+func (s *StatsVisitPagesItem) GetVisitors() int {
+	return s.Visitors
+}
+
+// GetVisitorsPercentage returns the value of VisitorsPercentage.
+func (s *StatsVisitPagesItem) GetVisitorsPercentage() float32 {
+	return s.VisitorsPercentage
+}
+
+// GetBouncePercentage returns the value of BouncePercentage.
+func (s *StatsVisitPagesItem) GetBouncePercentage() OptFloat32 {
+	return s.BouncePercentage
+}
+
+// GetDuration returns the value of Duration.
+func (s *StatsVisitPagesItem) GetDuration() OptInt {
+	return s.Duration
+}
+
+// SetPath sets the value of Path.
+func (s *StatsVisitPagesItem) SetPath(val string) {
+	s.Path = val
+}
+
+// SetVisitors sets the value of Visitors.
+func (s *StatsVisitPagesItem) SetVisitors(val int) {
+	s.Visitors = val
+}
+
+// SetVisitorsPercentage sets the value of VisitorsPercentage.
+func (s *StatsVisitPagesItem) SetVisitorsPercentage(val float32) {
+	s.VisitorsPercentage = val
+}
+
+// SetBouncePercentage sets the value of BouncePercentage.
+func (s *StatsVisitPagesItem) SetBouncePercentage(val OptFloat32) {
+	s.BouncePercentage = val
+}
+
+// SetDuration sets the value of Duration.
+func (s *StatsVisitPagesItem) SetDuration(val OptInt) {
+	s.Duration = val
+}
+
+// Report exported as CSV with a header row or as newline delimited JSON with one object per row.
+// Ref: #/components/schemas/Export
+type StatsVisitPagesTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s StatsVisitPagesTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// Request body for confirming two-factor enrolment.
+// Ref: #/components/schemas/TOTPConfirm
+type TOTPConfirm struct {
+	Code string `json:"code"`
+}
+
+// GetCode returns the value of Code.
+func (s *TOTPConfirm) GetCode() string {
+	return s.Code
+}
+
+// SetCode sets the value of Code.
+func (s *TOTPConfirm) SetCode(val string) {
+	s.Code = val
+}
+
+// Request body for disabling two-factor authentication.
+// Ref: #/components/schemas/TOTPDisable
+type TOTPDisable struct {
+	Password string `json:"password"`
+}
+
+// GetPassword returns the value of Password.
+func (s *TOTPDisable) GetPassword() string {
+	return s.Password
+}
+
+// SetPassword sets the value of Password.
+func (s *TOTPDisable) SetPassword(val string) {
+	s.Password = val
+}
+
+// Response body for starting two-factor enrolment.
+// Ref: #/components/schemas/TOTPEnrolment
+type TOTPEnrolment struct {
+	// Base32 encoded secret to enter into an authenticator app.
+	Secret string `json:"secret"`
+	// Otpauth URI of the secret, usually shown as a QR code.
+	URI string `json:"uri"`
+}
+
+// GetSecret returns the value of Secret.
+func (s *TOTPEnrolment) GetSecret() string {
+	return s.Secret
+}
+
+// GetURI returns the value of URI.
+func (s *TOTPEnrolment) GetURI() string {
+	return s.URI
+}
+
+// SetSecret sets the value of Secret.
+func (s *TOTPEnrolment) SetSecret(val string) {
+	s.Secret = val
+}
+
+// SetURI sets the value of URI.
+func (s *TOTPEnrolment) SetURI(val string) {
+	s.URI = val
+}
+
+// TOTPEnrolmentHeaders wraps TOTPEnrolment with response headers.
+type TOTPEnrolmentHeaders struct {
+	XAPICommit OptString
+	Response   TOTPEnrolment
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *TOTPEnrolmentHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *TOTPEnrolmentHeaders) GetResponse() TOTPEnrolment {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *TOTPEnrolmentHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *TOTPEnrolmentHeaders) SetResponse(val TOTPEnrolment) {
+	s.Response = val
+}
+
+func (*TOTPEnrolmentHeaders) postUserTotpRes() {}
+
+// Response body with one-time recovery codes, each usable once instead of a code from the
+// authenticator app.
+// Ref: #/components/schemas/TOTPRecoveryCodes
+type TOTPRecoveryCodes struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// GetRecoveryCodes returns the value of RecoveryCodes.
+func (s *TOTPRecoveryCodes) GetRecoveryCodes() []string {
+	return s.RecoveryCodes
+}
+
+// SetRecoveryCodes sets the value of RecoveryCodes.
+func (s *TOTPRecoveryCodes) SetRecoveryCodes(val []string) {
+	s.RecoveryCodes = val
+}
+
+// TOTPRecoveryCodesHeaders wraps TOTPRecoveryCodes with response headers.
+type TOTPRecoveryCodesHeaders struct {
+	XAPICommit OptString
+	Response   TOTPRecoveryCodes
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *TOTPRecoveryCodesHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *TOTPRecoveryCodesHeaders) GetResponse() TOTPRecoveryCodes {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *TOTPRecoveryCodesHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *TOTPRecoveryCodesHeaders) SetResponse(val TOTPRecoveryCodes) {
+	s.Response = val
+}
+
+func (*TOTPRecoveryCodesHeaders) postUserTotpConfirmRes() {}
+
 // Schema for tenant setting.
 // Ref: #/components/schemas/TenantSettings
 type TenantSettings struct {
@@ -3221,6 +6988,8 @@ type TenantSettings struct {
 	BlockTorExitNodes OptBool `json:"blockTorExitNodes"`
 	// List of manually blocked IP addresses.
 	BlockedIPs []netip.Addr `json:"blockedIPs"`
+	// Number of days analytics data is kept for before it is deleted. 0 keeps data forever.
+	RetentionDays OptInt `json:"retentionDays"`
 }
 
 // GetScriptType returns the value of ScriptType.
@@ -3243,6 +7012,11 @@ func (s *TenantSettings) GetBlockedIPs() []netip.Addr {
 	return s.BlockedIPs
 }
 
+// GetRetentionDays returns the value of RetentionDays.
+func (s *TenantSettings) GetRetentionDays() OptInt {
+	return s.RetentionDays
+}
+
 // SetScriptType sets the value of ScriptType.
 func (s *TenantSettings) SetScriptType(val []TenantSettingsScriptTypeItem) {
 	s.ScriptType = val
@@ -3263,6 +7037,11 @@ func (s *TenantSettings) SetBlockedIPs(val []netip.Addr) {
 	s.BlockedIPs = val
 }
 
+// SetRetentionDays sets the value of RetentionDays.
+func (s *TenantSettings) SetRetentionDays(val OptInt) {
+	s.RetentionDays = val
+}
+
 // TenantSettingsHeaders wraps TenantSettings with response headers.
 type TenantSettingsHeaders struct {
 	XAPICommit OptString
@@ -3340,6 +7119,85 @@ func (s *TenantSettingsScriptTypeItem) UnmarshalText(data []byte) error {
 	}
 }
 
+type TooManyRequestsError struct {
+	Error TooManyRequestsErrorError `json:"error"`
+}
+
+// GetError returns the value of Error.
+func (s *TooManyRequestsError) GetError() TooManyRequestsErrorError {
+	return s.Error
+}
+
+// SetError sets the value of Error.
+func (s *TooManyRequestsError) SetError(val TooManyRequestsErrorError) {
+	s.Error = val
+}
+
+type TooManyRequestsErrorError struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *TooManyRequestsErrorError) GetCode() int32 {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *TooManyRequestsErrorError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *TooManyRequestsErrorError) SetCode(val int32) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *TooManyRequestsErrorError) SetMessage(val string) {
+	s.Message = val
+}
+
+// TooManyRequestsErrorHeaders wraps TooManyRequestsError with response headers.
+type TooManyRequestsErrorHeaders struct {
+	RetryAfter int
+	XAPICommit OptString
+	Response   TooManyRequestsError
+}
+
+// GetRetryAfter returns the value of RetryAfter.
+func (s *TooManyRequestsErrorHeaders) GetRetryAfter() int {
+	return s.RetryAfter
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *TooManyRequestsErrorHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *TooManyRequestsErrorHeaders) GetResponse() TooManyRequestsError {
+	return s.Response
+}
+
+// SetRetryAfter sets the value of RetryAfter.
+func (s *TooManyRequestsErrorHeaders) SetRetryAfter(val int) {
+	s.RetryAfter = val
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *TooManyRequestsErrorHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *TooManyRequestsErrorHeaders) SetResponse(val TooManyRequestsError) {
+	s.Response = val
+}
+
+func (*TooManyRequestsErrorHeaders) postAuthLoginRes()     {}
+func (*TooManyRequestsErrorHeaders) postAuthLoginTotpRes() {}
+
 type UnauthorisedError struct {
 	Error UnauthorisedErrorError `json:"error"`
 }
@@ -3405,40 +7263,333 @@ func (s *UnauthorisedErrorHeaders) SetResponse(val UnauthorisedError) {
 	s.Response = val
 }
 
-func (*UnauthorisedErrorHeaders) deleteUserRes()             {}
-func (*UnauthorisedErrorHeaders) deleteWebsitesIDRes()       {}
-func (*UnauthorisedErrorHeaders) getTenantSettingsRes()      {}
-func (*UnauthorisedErrorHeaders) getUserRes()                {}
-func (*UnauthorisedErrorHeaders) getUserUsageRes()           {}
-func (*UnauthorisedErrorHeaders) getWebsiteIDBrowsersRes()   {}
-func (*UnauthorisedErrorHeaders) getWebsiteIDCampaignsRes()  {}
-func (*UnauthorisedErrorHeaders) getWebsiteIDCountryRes()    {}
-func (*UnauthorisedErrorHeaders) getWebsiteIDDeviceRes()     {}
-func (*UnauthorisedErrorHeaders) getWebsiteIDLanguageRes()   {}
-func (*UnauthorisedErrorHeaders) getWebsiteIDMediumsRes()    {}
-func (*UnauthorisedErrorHeaders) getWebsiteIDOsRes()         {}
-func (*UnauthorisedErrorHeaders) getWebsiteIDPagesRes()      {}
-func (*UnauthorisedErrorHeaders) getWebsiteIDPropertiesRes() {}
-func (*UnauthorisedErrorHeaders) getWebsiteIDReferrersRes()  {}
-func (*UnauthorisedErrorHeaders) getWebsiteIDSourcesRes()    {}
-func (*UnauthorisedErrorHeaders) getWebsiteIDSummaryRes()    {}
-func (*UnauthorisedErrorHeaders) getWebsiteIDTimeRes()       {}
-func (*UnauthorisedErrorHeaders) getWebsitesIDRes()          {}
-func (*UnauthorisedErrorHeaders) getWebsitesRes()            {}
-func (*UnauthorisedErrorHeaders) patchTenantSettingsRes()    {}
-func (*UnauthorisedErrorHeaders) patchUserRes()              {}
-func (*UnauthorisedErrorHeaders) patchWebsitesIDRes()        {}
-func (*UnauthorisedErrorHeaders) postAuthLoginRes()          {}
-func (*UnauthorisedErrorHeaders) postAuthLogoutRes()         {}
-func (*UnauthorisedErrorHeaders) postWebsitesRes()           {}
+func (*UnauthorisedErrorHeaders) deleteAPIKeysIDRes()                   {}
+func (*UnauthorisedErrorHeaders) deleteAuthLockoutsRes()                {}
+func (*UnauthorisedErrorHeaders) deleteReportsIDRes()                   {}
+func (*UnauthorisedErrorHeaders) deleteUserRes()                        {}
+func (*UnauthorisedErrorHeaders) deleteUserSessionsRes()                {}
+func (*UnauthorisedErrorHeaders) deleteUsersIDRes()                     {}
+func (*UnauthorisedErrorHeaders) deleteWebsitesIDGoalsIDRes()           {}
+func (*UnauthorisedErrorHeaders) deleteWebsitesIDRes()                  {}
+func (*UnauthorisedErrorHeaders) deleteWebsitesIDWebhooksIDRes()        {}
+func (*UnauthorisedErrorHeaders) getAPIKeysRes()                        {}
+func (*UnauthorisedErrorHeaders) getAuditRes()                          {}
+func (*UnauthorisedErrorHeaders) getAuthLockoutsRes()                   {}
+func (*UnauthorisedErrorHeaders) getAuthOidcCallbackRes()               {}
+func (*UnauthorisedErrorHeaders) getReportsRes()                        {}
+func (*UnauthorisedErrorHeaders) getTenantSettingsRes()                 {}
+func (*UnauthorisedErrorHeaders) getUserRes()                           {}
+func (*UnauthorisedErrorHeaders) getUserSessionsRes()                   {}
+func (*UnauthorisedErrorHeaders) getUserUsageRes()                      {}
+func (*UnauthorisedErrorHeaders) getUsersRes()                          {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDBrowsersRes()              {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDCampaignsRes()             {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDCountryRes()               {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDDeviceRes()                {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDFunnelRes()                {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDGoalsRes()                 {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDHostnamesRes()             {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDLanguageRes()              {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDMediumsRes()               {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDOsRes()                    {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDPagesEntryRes()            {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDPagesExitRes()             {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDPagesRes()                 {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDPropertiesRes()            {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDRealtimeRes()              {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDReferrersRes()             {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDSourcesRes()               {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDSummaryRes()               {}
+func (*UnauthorisedErrorHeaders) getWebsiteIDTimeRes()                  {}
+func (*UnauthorisedErrorHeaders) getWebsitesIDDeletionRes()             {}
+func (*UnauthorisedErrorHeaders) getWebsitesIDGoalsRes()                {}
+func (*UnauthorisedErrorHeaders) getWebsitesIDRes()                     {}
+func (*UnauthorisedErrorHeaders) getWebsitesIDWebhooksIDDeliveriesRes() {}
+func (*UnauthorisedErrorHeaders) getWebsitesIDWebhooksRes()             {}
+func (*UnauthorisedErrorHeaders) getWebsitesRes()                       {}
+func (*UnauthorisedErrorHeaders) patchReportsIDRes()                    {}
+func (*UnauthorisedErrorHeaders) patchTenantSettingsRes()               {}
+func (*UnauthorisedErrorHeaders) patchUserRes()                         {}
+func (*UnauthorisedErrorHeaders) patchUsersIDRes()                      {}
+func (*UnauthorisedErrorHeaders) patchWebsitesIDGoalsIDRes()            {}
+func (*UnauthorisedErrorHeaders) patchWebsitesIDRes()                   {}
+func (*UnauthorisedErrorHeaders) postAPIKeysRes()                       {}
+func (*UnauthorisedErrorHeaders) postAuthLoginRes()                     {}
+func (*UnauthorisedErrorHeaders) postAuthLoginTotpRes()                 {}
+func (*UnauthorisedErrorHeaders) postAuthLogoutRes()                    {}
+func (*UnauthorisedErrorHeaders) postReportsRes()                       {}
+func (*UnauthorisedErrorHeaders) postUserTotpConfirmRes()               {}
+func (*UnauthorisedErrorHeaders) postUserTotpDisableRes()               {}
+func (*UnauthorisedErrorHeaders) postUserTotpRes()                      {}
+func (*UnauthorisedErrorHeaders) postUsersRes()                         {}
+func (*UnauthorisedErrorHeaders) postWebsitesIDGoalsRes()               {}
+func (*UnauthorisedErrorHeaders) postWebsitesIDWebhooksRes()            {}
+func (*UnauthorisedErrorHeaders) postWebsitesRes()                      {}
+
+// Response body for getting a user account as an admin.
+// Ref: #/components/schemas/UserAccount
+type UserAccount struct {
+	ID       string   `json:"id"`
+	Username string   `json:"username"`
+	Role     UserRole `json:"role"`
+	// Hostnames of the websites the user is a member of.
+	Websites    []string `json:"websites"`
+	DateCreated int64    `json:"dateCreated"`
+}
+
+// GetID returns the value of ID.
+func (s *UserAccount) GetID() string {
+	return s.ID
+}
+
+// GetUsername returns the value of Username.
+func (s *UserAccount) GetUsername() string {
+	return s.Username
+}
+
+// GetRole returns the value of Role.
+func (s *UserAccount) GetRole() UserRole {
+	return s.Role
+}
+
+// GetWebsites returns the value of Websites.
+func (s *UserAccount) GetWebsites() []string {
+	return s.Websites
+}
+
+// GetDateCreated returns the value of DateCreated.
+func (s *UserAccount) GetDateCreated() int64 {
+	return s.DateCreated
+}
+
+// SetID sets the value of ID.
+func (s *UserAccount) SetID(val string) {
+	s.ID = val
+}
+
+// SetUsername sets the value of Username.
+func (s *UserAccount) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetRole sets the value of Role.
+func (s *UserAccount) SetRole(val UserRole) {
+	s.Role = val
+}
+
+// SetWebsites sets the value of Websites.
+func (s *UserAccount) SetWebsites(val []string) {
+	s.Websites = val
+}
+
+// SetDateCreated sets the value of DateCreated.
+func (s *UserAccount) SetDateCreated(val int64) {
+	s.DateCreated = val
+}
+
+// Request body for adding a user.
+// Ref: #/components/schemas/UserAccountCreate
+type UserAccountCreate struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	// Role of the user. There can only be one owner.
+	Role UserAccountCreateRole `json:"role"`
+	// Hostnames of the websites the user is a member of.
+	Websites []string `json:"websites"`
+}
+
+// GetUsername returns the value of Username.
+func (s *UserAccountCreate) GetUsername() string {
+	return s.Username
+}
+
+// GetPassword returns the value of Password.
+func (s *UserAccountCreate) GetPassword() string {
+	return s.Password
+}
+
+// GetRole returns the value of Role.
+func (s *UserAccountCreate) GetRole() UserAccountCreateRole {
+	return s.Role
+}
+
+// GetWebsites returns the value of Websites.
+func (s *UserAccountCreate) GetWebsites() []string {
+	return s.Websites
+}
+
+// SetUsername sets the value of Username.
+func (s *UserAccountCreate) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetPassword sets the value of Password.
+func (s *UserAccountCreate) SetPassword(val string) {
+	s.Password = val
+}
+
+// SetRole sets the value of Role.
+func (s *UserAccountCreate) SetRole(val UserAccountCreateRole) {
+	s.Role = val
+}
+
+// SetWebsites sets the value of Websites.
+func (s *UserAccountCreate) SetWebsites(val []string) {
+	s.Websites = val
+}
+
+// Role of the user. There can only be one owner.
+type UserAccountCreateRole string
+
+const (
+	UserAccountCreateRoleAdmin  UserAccountCreateRole = "admin"
+	UserAccountCreateRoleViewer UserAccountCreateRole = "viewer"
+)
+
+// AllValues returns all UserAccountCreateRole values.
+func (UserAccountCreateRole) AllValues() []UserAccountCreateRole {
+	return []UserAccountCreateRole{
+		UserAccountCreateRoleAdmin,
+		UserAccountCreateRoleViewer,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s UserAccountCreateRole) MarshalText() ([]byte, error) {
+	switch s {
+	case UserAccountCreateRoleAdmin:
+		return []byte(s), nil
+	case UserAccountCreateRoleViewer:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UserAccountCreateRole) UnmarshalText(data []byte) error {
+	switch UserAccountCreateRole(data) {
+	case UserAccountCreateRoleAdmin:
+		*s = UserAccountCreateRoleAdmin
+		return nil
+	case UserAccountCreateRoleViewer:
+		*s = UserAccountCreateRoleViewer
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// UserAccountHeaders wraps UserAccount with response headers.
+type UserAccountHeaders struct {
+	XAPICommit OptString
+	Response   UserAccount
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *UserAccountHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *UserAccountHeaders) GetResponse() UserAccount {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *UserAccountHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *UserAccountHeaders) SetResponse(val UserAccount) {
+	s.Response = val
+}
+
+func (*UserAccountHeaders) patchUsersIDRes() {}
+func (*UserAccountHeaders) postUsersRes()    {}
+
+// Request body for updating the role or website memberships of a user.
+// Ref: #/components/schemas/UserAccountPatch
+type UserAccountPatch struct {
+	// Role of the user. There can only be one owner.
+	Role OptUserAccountPatchRole `json:"role"`
+	// Hostnames of the websites the user is a member of, replacing all existing memberships.
+	Websites []string `json:"websites"`
+}
+
+// GetRole returns the value of Role.
+func (s *UserAccountPatch) GetRole() OptUserAccountPatchRole {
+	return s.Role
+}
+
+// GetWebsites returns the value of Websites.
+func (s *UserAccountPatch) GetWebsites() []string {
+	return s.Websites
+}
+
+// SetRole sets the value of Role.
+func (s *UserAccountPatch) SetRole(val OptUserAccountPatchRole) {
+	s.Role = val
+}
+
+// SetWebsites sets the value of Websites.
+func (s *UserAccountPatch) SetWebsites(val []string) {
+	s.Websites = val
+}
+
+// Role of the user. There can only be one owner.
+type UserAccountPatchRole string
+
+const (
+	UserAccountPatchRoleAdmin  UserAccountPatchRole = "admin"
+	UserAccountPatchRoleViewer UserAccountPatchRole = "viewer"
+)
+
+// AllValues returns all UserAccountPatchRole values.
+func (UserAccountPatchRole) AllValues() []UserAccountPatchRole {
+	return []UserAccountPatchRole{
+		UserAccountPatchRoleAdmin,
+		UserAccountPatchRoleViewer,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s UserAccountPatchRole) MarshalText() ([]byte, error) {
+	switch s {
+	case UserAccountPatchRoleAdmin:
+		return []byte(s), nil
+	case UserAccountPatchRoleViewer:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UserAccountPatchRole) UnmarshalText(data []byte) error {
+	switch UserAccountPatchRole(data) {
+	case UserAccountPatchRoleAdmin:
+		*s = UserAccountPatchRoleAdmin
+		return nil
+	case UserAccountPatchRoleViewer:
+		*s = UserAccountPatchRoleViewer
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
 // Response body for getting a user.
 // Ref: #/components/schemas/UserGet
 type UserGet struct {
-	Username    string       `json:"username"`
-	Settings    UserSettings `json:"settings"`
-	DateCreated int64        `json:"dateCreated"`
-	DateUpdated int64        `json:"dateUpdated"`
+	Username string       `json:"username"`
+	Role     UserRole     `json:"role"`
+	Settings UserSettings `json:"settings"`
+	// Whether two-factor authentication is enabled.
+	TotpEnabled bool  `json:"totpEnabled"`
+	DateCreated int64 `json:"dateCreated"`
+	DateUpdated int64 `json:"dateUpdated"`
 }
 
 // GetUsername returns the value of Username.
@@ -3446,11 +7597,21 @@ func (s *UserGet) GetUsername() string {
 	return s.Username
 }
 
+// GetRole returns the value of Role.
+func (s *UserGet) GetRole() UserRole {
+	return s.Role
+}
+
 // GetSettings returns the value of Settings.
 func (s *UserGet) GetSettings() UserSettings {
 	return s.Settings
 }
 
+// GetTotpEnabled returns the value of TotpEnabled.
+func (s *UserGet) GetTotpEnabled() bool {
+	return s.TotpEnabled
+}
+
 // GetDateCreated returns the value of DateCreated.
 func (s *UserGet) GetDateCreated() int64 {
 	return s.DateCreated
@@ -3466,11 +7627,21 @@ func (s *UserGet) SetUsername(val string) {
 	s.Username = val
 }
 
+// SetRole sets the value of Role.
+func (s *UserGet) SetRole(val UserRole) {
+	s.Role = val
+}
+
 // SetSettings sets the value of Settings.
 func (s *UserGet) SetSettings(val UserSettings) {
 	s.Settings = val
 }
 
+// SetTotpEnabled sets the value of TotpEnabled.
+func (s *UserGet) SetTotpEnabled(val bool) {
+	s.TotpEnabled = val
+}
+
 // SetDateCreated sets the value of DateCreated.
 func (s *UserGet) SetDateCreated(val int64) {
 	s.DateCreated = val
@@ -3533,19 +7704,121 @@ func (s *UserPatch) GetSettings() OptUserSettings {
 	return s.Settings
 }
 
-// SetUsername sets the value of Username.
-func (s *UserPatch) SetUsername(val OptString) {
-	s.Username = val
+// SetUsername sets the value of Username.
+func (s *UserPatch) SetUsername(val OptString) {
+	s.Username = val
+}
+
+// SetPassword sets the value of Password.
+func (s *UserPatch) SetPassword(val OptString) {
+	s.Password = val
+}
+
+// SetSettings sets the value of Settings.
+func (s *UserPatch) SetSettings(val OptUserSettings) {
+	s.Settings = val
+}
+
+// Role of a user. Owners and admins can access and manage all websites and users, while viewers can
+// only read the websites they are members of.
+// Ref: #/components/schemas/UserRole
+type UserRole string
+
+const (
+	UserRoleOwner  UserRole = "owner"
+	UserRoleAdmin  UserRole = "admin"
+	UserRoleViewer UserRole = "viewer"
+)
+
+// AllValues returns all UserRole values.
+func (UserRole) AllValues() []UserRole {
+	return []UserRole{
+		UserRoleOwner,
+		UserRoleAdmin,
+		UserRoleViewer,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s UserRole) MarshalText() ([]byte, error) {
+	switch s {
+	case UserRoleOwner:
+		return []byte(s), nil
+	case UserRoleAdmin:
+		return []byte(s), nil
+	case UserRoleViewer:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UserRole) UnmarshalText(data []byte) error {
+	switch UserRole(data) {
+	case UserRoleOwner:
+		*s = UserRoleOwner
+		return nil
+	case UserRoleAdmin:
+		*s = UserRoleAdmin
+		return nil
+	case UserRoleViewer:
+		*s = UserRoleViewer
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Response body for an active session of the user.
+// Ref: #/components/schemas/UserSession
+type UserSession struct {
+	// User agent of the browser the session was created with.
+	UserAgent string `json:"userAgent"`
+	// Whether the session is used for the request.
+	Current     bool  `json:"current"`
+	DateCreated int64 `json:"dateCreated"`
+	DateExpires int64 `json:"dateExpires"`
+}
+
+// GetUserAgent returns the value of UserAgent.
+func (s *UserSession) GetUserAgent() string {
+	return s.UserAgent
+}
+
+// GetCurrent returns the value of Current.
+func (s *UserSession) GetCurrent() bool {
+	return s.Current
+}
+
+// GetDateCreated returns the value of DateCreated.
+func (s *UserSession) GetDateCreated() int64 {
+	return s.DateCreated
+}
+
+// GetDateExpires returns the value of DateExpires.
+func (s *UserSession) GetDateExpires() int64 {
+	return s.DateExpires
+}
+
+// SetUserAgent sets the value of UserAgent.
+func (s *UserSession) SetUserAgent(val string) {
+	s.UserAgent = val
 }
 
-// SetPassword sets the value of Password.
-func (s *UserPatch) SetPassword(val OptString) {
-	s.Password = val
+// SetCurrent sets the value of Current.
+func (s *UserSession) SetCurrent(val bool) {
+	s.Current = val
 }
 
-// SetSettings sets the value of Settings.
-func (s *UserPatch) SetSettings(val OptUserSettings) {
-	s.Settings = val
+// SetDateCreated sets the value of DateCreated.
+func (s *UserSession) SetDateCreated(val int64) {
+	s.DateCreated = val
+}
+
+// SetDateExpires sets the value of DateExpires.
+func (s *UserSession) SetDateExpires(val int64) {
+	s.DateExpires = val
 }
 
 // Response body for getting user settings.
@@ -3598,12 +7871,15 @@ func (s *UserSettingsLanguage) UnmarshalText(data []byte) error {
 	}
 }
 
-// Response body for getting CPU, memory and disk usage of the server.
+// Response body for getting CPU, memory and disk usage of the server and the data retention of the
+// analytics database.
 // Ref: #/components/schemas/UserUsageGet
 type UserUsageGet struct {
 	CPU    UserUsageGetCPU    `json:"cpu"`
 	Memory UserUsageGetMemory `json:"memory"`
 	Disk   UserUsageGetDisk   `json:"disk"`
+	// Data retention of the analytics database.
+	Retention UserUsageGetRetention `json:"retention"`
 }
 
 // GetCPU returns the value of CPU.
@@ -3621,6 +7897,11 @@ func (s *UserUsageGet) GetDisk() UserUsageGetDisk {
 	return s.Disk
 }
 
+// GetRetention returns the value of Retention.
+func (s *UserUsageGet) GetRetention() UserUsageGetRetention {
+	return s.Retention
+}
+
 // SetCPU sets the value of CPU.
 func (s *UserUsageGet) SetCPU(val UserUsageGetCPU) {
 	s.CPU = val
@@ -3636,6 +7917,11 @@ func (s *UserUsageGet) SetDisk(val UserUsageGetDisk) {
 	s.Disk = val
 }
 
+// SetRetention sets the value of Retention.
+func (s *UserUsageGet) SetRetention(val UserUsageGetRetention) {
+	s.Retention = val
+}
+
 type UserUsageGetCPU struct {
 	Usage   float32 `json:"usage"`
 	Cores   int     `json:"cores"`
@@ -3750,6 +8036,395 @@ func (s *UserUsageGetMemory) SetTotal(val int64) {
 	s.Total = val
 }
 
+// Data retention of the analytics database.
+type UserUsageGetRetention struct {
+	// Tenant retention period in days. 0 keeps data forever.
+	Days int `json:"days"`
+	// Date of the oldest stored page view.
+	Oldest OptDateTime `json:"oldest"`
+}
+
+// GetDays returns the value of Days.
+func (s *UserUsageGetRetention) GetDays() int {
+	return s.Days
+}
+
+// GetOldest returns the value of Oldest.
+func (s *UserUsageGetRetention) GetOldest() OptDateTime {
+	return s.Oldest
+}
+
+// SetDays sets the value of Days.
+func (s *UserUsageGetRetention) SetDays(val int) {
+	s.Days = val
+}
+
+// SetOldest sets the value of Oldest.
+func (s *UserUsageGetRetention) SetOldest(val OptDateTime) {
+	s.Oldest = val
+}
+
+// Request body for creating a webhook.
+// Ref: #/components/schemas/WebhookCreate
+type WebhookCreate struct {
+	// Absolute http or https URL the payloads are posted to.
+	URL     url.URL        `json:"url"`
+	Trigger WebhookTrigger `json:"trigger"`
+	// Required for custom_event webhooks.
+	EventName OptString `json:"eventName"`
+	// Required for traffic_spike webhooks.
+	Threshold OptInt `json:"threshold"`
+}
+
+// GetURL returns the value of URL.
+func (s *WebhookCreate) GetURL() url.URL {
+	return s.URL
+}
+
+// GetTrigger returns the value of Trigger.
+func (s *WebhookCreate) GetTrigger() WebhookTrigger {
+	return s.Trigger
+}
+
+// GetEventName returns the value of EventName.
+func (s *WebhookCreate) GetEventName() OptString {
+	return s.EventName
+}
+
+// GetThreshold returns the value of Threshold.
+func (s *WebhookCreate) GetThreshold() OptInt {
+	return s.Threshold
+}
+
+// SetURL sets the value of URL.
+func (s *WebhookCreate) SetURL(val url.URL) {
+	s.URL = val
+}
+
+// SetTrigger sets the value of Trigger.
+func (s *WebhookCreate) SetTrigger(val WebhookTrigger) {
+	s.Trigger = val
+}
+
+// SetEventName sets the value of EventName.
+func (s *WebhookCreate) SetEventName(val OptString) {
+	s.EventName = val
+}
+
+// SetThreshold sets the value of Threshold.
+func (s *WebhookCreate) SetThreshold(val OptInt) {
+	s.Threshold = val
+}
+
+// Response body for a created webhook, including its signing secret.
+// Ref: #/components/schemas/WebhookCreated
+type WebhookCreated struct {
+	// Secret used to sign payloads with HMAC-SHA256. It can not be retrieved again.
+	Secret  string     `json:"secret"`
+	Webhook WebhookGet `json:"webhook"`
+}
+
+// GetSecret returns the value of Secret.
+func (s *WebhookCreated) GetSecret() string {
+	return s.Secret
+}
+
+// GetWebhook returns the value of Webhook.
+func (s *WebhookCreated) GetWebhook() WebhookGet {
+	return s.Webhook
+}
+
+// SetSecret sets the value of Secret.
+func (s *WebhookCreated) SetSecret(val string) {
+	s.Secret = val
+}
+
+// SetWebhook sets the value of Webhook.
+func (s *WebhookCreated) SetWebhook(val WebhookGet) {
+	s.Webhook = val
+}
+
+// WebhookCreatedHeaders wraps WebhookCreated with response headers.
+type WebhookCreatedHeaders struct {
+	XAPICommit OptString
+	Response   WebhookCreated
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *WebhookCreatedHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *WebhookCreatedHeaders) GetResponse() WebhookCreated {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *WebhookCreatedHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *WebhookCreatedHeaders) SetResponse(val WebhookCreated) {
+	s.Response = val
+}
+
+func (*WebhookCreatedHeaders) postWebsitesIDWebhooksRes() {}
+
+// Entry of the delivery log of a webhook.
+// Ref: #/components/schemas/WebhookDelivery
+type WebhookDelivery struct {
+	ID      string         `json:"id"`
+	Trigger WebhookTrigger `json:"trigger"`
+	// JSON payload sent to the webhook URL.
+	Payload string `json:"payload"`
+	// Response status of the last attempt. Omitted if no response was received.
+	StatusCode OptInt `json:"statusCode"`
+	Attempts   int    `json:"attempts"`
+	// Error of the last attempt. Omitted if the delivery succeeded.
+	Error       OptString `json:"error"`
+	CreatedAt   time.Time `json:"createdAt"`
+	CompletedAt time.Time `json:"completedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *WebhookDelivery) GetID() string {
+	return s.ID
+}
+
+// GetTrigger returns the value of Trigger.
+func (s *WebhookDelivery) GetTrigger() WebhookTrigger {
+	return s.Trigger
+}
+
+// GetPayload returns the value of Payload.
+func (s *WebhookDelivery) GetPayload() string {
+	return s.Payload
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *WebhookDelivery) GetStatusCode() OptInt {
+	return s.StatusCode
+}
+
+// GetAttempts returns the value of Attempts.
+func (s *WebhookDelivery) GetAttempts() int {
+	return s.Attempts
+}
+
+// GetError returns the value of Error.
+func (s *WebhookDelivery) GetError() OptString {
+	return s.Error
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *WebhookDelivery) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetCompletedAt returns the value of CompletedAt.
+func (s *WebhookDelivery) GetCompletedAt() time.Time {
+	return s.CompletedAt
+}
+
+// SetID sets the value of ID.
+func (s *WebhookDelivery) SetID(val string) {
+	s.ID = val
+}
+
+// SetTrigger sets the value of Trigger.
+func (s *WebhookDelivery) SetTrigger(val WebhookTrigger) {
+	s.Trigger = val
+}
+
+// SetPayload sets the value of Payload.
+func (s *WebhookDelivery) SetPayload(val string) {
+	s.Payload = val
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *WebhookDelivery) SetStatusCode(val OptInt) {
+	s.StatusCode = val
+}
+
+// SetAttempts sets the value of Attempts.
+func (s *WebhookDelivery) SetAttempts(val int) {
+	s.Attempts = val
+}
+
+// SetError sets the value of Error.
+func (s *WebhookDelivery) SetError(val OptString) {
+	s.Error = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *WebhookDelivery) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetCompletedAt sets the value of CompletedAt.
+func (s *WebhookDelivery) SetCompletedAt(val time.Time) {
+	s.CompletedAt = val
+}
+
+// Response body for getting a webhook.
+// Ref: #/components/schemas/WebhookGet
+type WebhookGet struct {
+	ID       string         `json:"id"`
+	Hostname string         `json:"hostname"`
+	URL      url.URL        `json:"url"`
+	Trigger  WebhookTrigger `json:"trigger"`
+	// Custom event property matched by custom_event webhooks.
+	EventName OptString `json:"eventName"`
+	// Visitors in the last hour firing traffic_spike webhooks.
+	Threshold OptInt `json:"threshold"`
+	// Date the webhook was last sent.
+	LastTriggeredAt OptDateTime `json:"lastTriggeredAt"`
+	CreatedAt       time.Time   `json:"createdAt"`
+}
+
+// GetID returns the value of ID.
+func (s *WebhookGet) GetID() string {
+	return s.ID
+}
+
+// GetHostname returns the value of Hostname.
+func (s *WebhookGet) GetHostname() string {
+	return s.Hostname
+}
+
+// GetURL returns the value of URL.
+func (s *WebhookGet) GetURL() url.URL {
+	return s.URL
+}
+
+// GetTrigger returns the value of Trigger.
+func (s *WebhookGet) GetTrigger() WebhookTrigger {
+	return s.Trigger
+}
+
+// GetEventName returns the value of EventName.
+func (s *WebhookGet) GetEventName() OptString {
+	return s.EventName
+}
+
+// GetThreshold returns the value of Threshold.
+func (s *WebhookGet) GetThreshold() OptInt {
+	return s.Threshold
+}
+
+// GetLastTriggeredAt returns the value of LastTriggeredAt.
+func (s *WebhookGet) GetLastTriggeredAt() OptDateTime {
+	return s.LastTriggeredAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *WebhookGet) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *WebhookGet) SetID(val string) {
+	s.ID = val
+}
+
+// SetHostname sets the value of Hostname.
+func (s *WebhookGet) SetHostname(val string) {
+	s.Hostname = val
+}
+
+// SetURL sets the value of URL.
+func (s *WebhookGet) SetURL(val url.URL) {
+	s.URL = val
+}
+
+// SetTrigger sets the value of Trigger.
+func (s *WebhookGet) SetTrigger(val WebhookTrigger) {
+	s.Trigger = val
+}
+
+// SetEventName sets the value of EventName.
+func (s *WebhookGet) SetEventName(val OptString) {
+	s.EventName = val
+}
+
+// SetThreshold sets the value of Threshold.
+func (s *WebhookGet) SetThreshold(val OptInt) {
+	s.Threshold = val
+}
+
+// SetLastTriggeredAt sets the value of LastTriggeredAt.
+func (s *WebhookGet) SetLastTriggeredAt(val OptDateTime) {
+	s.LastTriggeredAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *WebhookGet) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Event a webhook is sent for. traffic_spike fires when the visitors in the last hour reach the
+// threshold, new_referrer when a referrer sends visitors for the first time, custom_event when a
+// custom event property with the event name is received, at most once a minute with later events of
+// the minute sent together with their count, and anomaly when the visitors, pageviews, bounce rate
+// or duration of the last hour deviate from the same hour in previous weeks.
+// Ref: #/components/schemas/WebhookTrigger
+type WebhookTrigger string
+
+const (
+	WebhookTriggerTrafficSpike WebhookTrigger = "traffic_spike"
+	WebhookTriggerNewReferrer  WebhookTrigger = "new_referrer"
+	WebhookTriggerCustomEvent  WebhookTrigger = "custom_event"
+	WebhookTriggerAnomaly      WebhookTrigger = "anomaly"
+)
+
+// AllValues returns all WebhookTrigger values.
+func (WebhookTrigger) AllValues() []WebhookTrigger {
+	return []WebhookTrigger{
+		WebhookTriggerTrafficSpike,
+		WebhookTriggerNewReferrer,
+		WebhookTriggerCustomEvent,
+		WebhookTriggerAnomaly,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s WebhookTrigger) MarshalText() ([]byte, error) {
+	switch s {
+	case WebhookTriggerTrafficSpike:
+		return []byte(s), nil
+	case WebhookTriggerNewReferrer:
+		return []byte(s), nil
+	case WebhookTriggerCustomEvent:
+		return []byte(s), nil
+	case WebhookTriggerAnomaly:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WebhookTrigger) UnmarshalText(data []byte) error {
+	switch WebhookTrigger(data) {
+	case WebhookTriggerTrafficSpike:
+		*s = WebhookTriggerTrafficSpike
+		return nil
+	case WebhookTriggerNewReferrer:
+		*s = WebhookTriggerNewReferrer
+		return nil
+	case WebhookTriggerCustomEvent:
+		*s = WebhookTriggerCustomEvent
+		return nil
+	case WebhookTriggerAnomaly:
+		*s = WebhookTriggerAnomaly
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Request body for creating a website.
 // Ref: #/components/schemas/WebsiteCreate
 type WebsiteCreate struct {
@@ -3766,11 +8441,109 @@ func (s *WebsiteCreate) SetHostname(val string) {
 	s.Hostname = val
 }
 
+// Response body for the deletion of the page views and custom events of a website.
+// Ref: #/components/schemas/WebsiteDeletion
+type WebsiteDeletion struct {
+	Hostname string `json:"hostname"`
+	// Number of page views to delete.
+	Views int64 `json:"views"`
+	// Number of custom event properties to delete.
+	Events int64 `json:"events"`
+	// Number of page views deleted so far.
+	DeletedViews int64 `json:"deletedViews"`
+	// Number of custom event properties deleted so far.
+	DeletedEvents int64 `json:"deletedEvents"`
+}
+
+// GetHostname returns the value of Hostname.
+func (s *WebsiteDeletion) GetHostname() string {
+	return s.Hostname
+}
+
+// GetViews returns the value of Views.
+func (s *WebsiteDeletion) GetViews() int64 {
+	return s.Views
+}
+
+// GetEvents returns the value of Events.
+func (s *WebsiteDeletion) GetEvents() int64 {
+	return s.Events
+}
+
+// GetDeletedViews returns the value of DeletedViews.
+func (s *WebsiteDeletion) GetDeletedViews() int64 {
+	return s.DeletedViews
+}
+
+// GetDeletedEvents returns the value of DeletedEvents.
+func (s *WebsiteDeletion) GetDeletedEvents() int64 {
+	return s.DeletedEvents
+}
+
+// SetHostname sets the value of Hostname.
+func (s *WebsiteDeletion) SetHostname(val string) {
+	s.Hostname = val
+}
+
+// SetViews sets the value of Views.
+func (s *WebsiteDeletion) SetViews(val int64) {
+	s.Views = val
+}
+
+// SetEvents sets the value of Events.
+func (s *WebsiteDeletion) SetEvents(val int64) {
+	s.Events = val
+}
+
+// SetDeletedViews sets the value of DeletedViews.
+func (s *WebsiteDeletion) SetDeletedViews(val int64) {
+	s.DeletedViews = val
+}
+
+// SetDeletedEvents sets the value of DeletedEvents.
+func (s *WebsiteDeletion) SetDeletedEvents(val int64) {
+	s.DeletedEvents = val
+}
+
+// WebsiteDeletionHeaders wraps WebsiteDeletion with response headers.
+type WebsiteDeletionHeaders struct {
+	XAPICommit OptString
+	Response   WebsiteDeletion
+}
+
+// GetXAPICommit returns the value of XAPICommit.
+func (s *WebsiteDeletionHeaders) GetXAPICommit() OptString {
+	return s.XAPICommit
+}
+
+// GetResponse returns the value of Response.
+func (s *WebsiteDeletionHeaders) GetResponse() WebsiteDeletion {
+	return s.Response
+}
+
+// SetXAPICommit sets the value of XAPICommit.
+func (s *WebsiteDeletionHeaders) SetXAPICommit(val OptString) {
+	s.XAPICommit = val
+}
+
+// SetResponse sets the value of Response.
+func (s *WebsiteDeletionHeaders) SetResponse(val WebsiteDeletion) {
+	s.Response = val
+}
+
+func (*WebsiteDeletionHeaders) getWebsitesIDDeletionRes() {}
+
 // Response body for getting a website.
 // Ref: #/components/schemas/WebsiteGet
 type WebsiteGet struct {
 	Hostname string               `json:"hostname"`
 	Summary  OptWebsiteGetSummary `json:"summary"`
+	// Number of days analytics data of the website is kept for, overriding the tenant setting. 0 keeps
+	// data forever.
+	RetentionDays OptInt `json:"retentionDays"`
+	// Additional hostnames or wildcard patterns such as `*.vercel.app` whose page views and events are
+	// recorded under the website.
+	Aliases []string `json:"aliases"`
 }
 
 // GetHostname returns the value of Hostname.
@@ -3783,6 +8556,16 @@ func (s *WebsiteGet) GetSummary() OptWebsiteGetSummary {
 	return s.Summary
 }
 
+// GetRetentionDays returns the value of RetentionDays.
+func (s *WebsiteGet) GetRetentionDays() OptInt {
+	return s.RetentionDays
+}
+
+// GetAliases returns the value of Aliases.
+func (s *WebsiteGet) GetAliases() []string {
+	return s.Aliases
+}
+
 // SetHostname sets the value of Hostname.
 func (s *WebsiteGet) SetHostname(val string) {
 	s.Hostname = val
@@ -3793,6 +8576,16 @@ func (s *WebsiteGet) SetSummary(val OptWebsiteGetSummary) {
 	s.Summary = val
 }
 
+// SetRetentionDays sets the value of RetentionDays.
+func (s *WebsiteGet) SetRetentionDays(val OptInt) {
+	s.RetentionDays = val
+}
+
+// SetAliases sets the value of Aliases.
+func (s *WebsiteGet) SetAliases(val []string) {
+	s.Aliases = val
+}
+
 // WebsiteGetHeaders wraps WebsiteGet with response headers.
 type WebsiteGetHeaders struct {
 	XAPICommit OptString
@@ -3841,6 +8634,19 @@ func (s *WebsiteGetSummary) SetVisitors(val int) {
 // Ref: #/components/schemas/WebsitePatch
 type WebsitePatch struct {
 	Hostname OptString `json:"hostname"`
+	// Number of days analytics data of the website is kept for, overriding the tenant setting. 0 keeps
+	// data forever and null removes the override.
+	RetentionDays OptNilInt `json:"retentionDays"`
+	// Replaces the aliases of the website. An alias is either a hostname such as `www.example.com` or a
+	// wildcard pattern such as `*.example.com` matching any subdomain.
+	Aliases []string `json:"aliases"`
+	// Merge the website into the existing website with the new hostname, combining the page views and
+	// custom events of both. The members and scoped API keys of the merged website are removed with it
+	// unless mergeAccess is set.
+	Merge OptBool `json:"merge"`
+	// Give the members and scoped API keys of the merged website access to the existing website instead
+	// of removing them. The moved access is recorded in the audit log.
+	MergeAccess OptBool `json:"mergeAccess"`
 }
 
 // GetHostname returns the value of Hostname.
@@ -3848,7 +8654,47 @@ func (s *WebsitePatch) GetHostname() OptString {
 	return s.Hostname
 }
 
+// GetRetentionDays returns the value of RetentionDays.
+func (s *WebsitePatch) GetRetentionDays() OptNilInt {
+	return s.RetentionDays
+}
+
+// GetAliases returns the value of Aliases.
+func (s *WebsitePatch) GetAliases() []string {
+	return s.Aliases
+}
+
+// GetMerge returns the value of Merge.
+func (s *WebsitePatch) GetMerge() OptBool {
+	return s.Merge
+}
+
+// GetMergeAccess returns the value of MergeAccess.
+func (s *WebsitePatch) GetMergeAccess() OptBool {
+	return s.MergeAccess
+}
+
 // SetHostname sets the value of Hostname.
 func (s *WebsitePatch) SetHostname(val OptString) {
 	s.Hostname = val
 }
+
+// SetRetentionDays sets the value of RetentionDays.
+func (s *WebsitePatch) SetRetentionDays(val OptNilInt) {
+	s.RetentionDays = val
+}
+
+// SetAliases sets the value of Aliases.
+func (s *WebsitePatch) SetAliases(val []string) {
+	s.Aliases = val
+}
+
+// SetMerge sets the value of Merge.
+func (s *WebsitePatch) SetMerge(val OptBool) {
+	s.Merge = val
+}
+
+// SetMergeAccess sets the value of MergeAccess.
+func (s *WebsitePatch) SetMergeAccess(val OptBool) {
+	s.MergeAccess = val
+}
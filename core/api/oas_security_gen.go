@@ -13,6 +13,11 @@ import (
 
 // SecurityHandler is handler for security parameters.
 type SecurityHandler interface {
+	// HandleBearerAuth handles BearerAuth security.
+	// API key for programmatic access, sent in the `Authorization` header as a bearer token. Read-only
+	// keys can access the `read` operations, while admin keys can access all operations. Keys scoped to
+	// a website can only access that website.
+	HandleBearerAuth(ctx context.Context, operationName OperationName, t BearerAuth) (context.Context, error)
 	// HandleCookieAuth handles CookieAuth security.
 	// Session token for authentication.
 	HandleCookieAuth(ctx context.Context, operationName OperationName, t CookieAuth) (context.Context, error)
@@ -33,32 +38,190 @@ func findAuthorization(h http.Header, prefix string) (string, bool) {
 	return "", false
 }
 
+// operationRolesBearerAuth is a private map storing roles per operation.
+var operationRolesBearerAuth = map[string][]string{
+	DeleteWebsitesIDOperation: []string{
+		"admin",
+	},
+	DeleteWebsitesIDGoalsIDOperation: []string{
+		"admin",
+	},
+	DeleteWebsitesIDWebhooksIDOperation: []string{
+		"admin",
+	},
+	GetWebsiteIDBrowsersOperation: []string{
+		"read",
+	},
+	GetWebsiteIDCampaignsOperation: []string{
+		"read",
+	},
+	GetWebsiteIDCountryOperation: []string{
+		"read",
+	},
+	GetWebsiteIDDeviceOperation: []string{
+		"read",
+	},
+	GetWebsiteIDFunnelOperation: []string{
+		"read",
+	},
+	GetWebsiteIDGoalsOperation: []string{
+		"read",
+	},
+	GetWebsiteIDHostnamesOperation: []string{
+		"read",
+	},
+	GetWebsiteIDLanguageOperation: []string{
+		"read",
+	},
+	GetWebsiteIDMediumsOperation: []string{
+		"read",
+	},
+	GetWebsiteIDOsOperation: []string{
+		"read",
+	},
+	GetWebsiteIDPagesOperation: []string{
+		"read",
+	},
+	GetWebsiteIDPagesEntryOperation: []string{
+		"read",
+	},
+	GetWebsiteIDPagesExitOperation: []string{
+		"read",
+	},
+	GetWebsiteIDPropertiesOperation: []string{
+		"read",
+	},
+	GetWebsiteIDRealtimeOperation: []string{
+		"read",
+	},
+	GetWebsiteIDReferrersOperation: []string{
+		"read",
+	},
+	GetWebsiteIDSourcesOperation: []string{
+		"read",
+	},
+	GetWebsiteIDSummaryOperation: []string{
+		"read",
+	},
+	GetWebsiteIDTimeOperation: []string{
+		"read",
+	},
+	GetWebsitesOperation: []string{
+		"read",
+	},
+	GetWebsitesIDOperation: []string{
+		"read",
+	},
+	GetWebsitesIDDeletionOperation: []string{
+		"read",
+	},
+	GetWebsitesIDGoalsOperation: []string{
+		"read",
+	},
+	GetWebsitesIDWebhooksOperation: []string{
+		"admin",
+	},
+	GetWebsitesIDWebhooksIDDeliveriesOperation: []string{
+		"admin",
+	},
+	PatchWebsitesIDOperation: []string{
+		"admin",
+	},
+	PatchWebsitesIDGoalsIDOperation: []string{
+		"admin",
+	},
+	PostWebsitesOperation: []string{
+		"admin",
+	},
+	PostWebsitesIDGoalsOperation: []string{
+		"admin",
+	},
+	PostWebsitesIDWebhooksOperation: []string{
+		"admin",
+	},
+}
+
+// GetRolesForBearerAuth returns the required roles for the given operation.
+//
+// This is useful for authorization scenarios where you need to know which roles
+// are required for an operation.
+//
+// Example:
+//
+//	requiredRoles := GetRolesForBearerAuth(AddPetOperation)
+//
+// Returns nil if the operation has no role requirements or if the operation is unknown.
+func GetRolesForBearerAuth(operation string) []string {
+	roles, ok := operationRolesBearerAuth[operation]
+	if !ok {
+		return nil
+	}
+	// Return a copy to prevent external modification
+	result := make([]string, len(roles))
+	copy(result, roles)
+	return result
+}
+
 // operationRolesCookieAuth is a private map storing roles per operation.
 var operationRolesCookieAuth = map[string][]string{
-	DeleteUserOperation:             []string{},
-	DeleteWebsitesIDOperation:       []string{},
-	GetTenantSettingsOperation:      []string{},
-	GetUserOperation:                []string{},
-	GetUserUsageOperation:           []string{},
-	GetWebsiteIDBrowsersOperation:   []string{},
-	GetWebsiteIDCampaignsOperation:  []string{},
-	GetWebsiteIDCountryOperation:    []string{},
-	GetWebsiteIDDeviceOperation:     []string{},
-	GetWebsiteIDLanguageOperation:   []string{},
-	GetWebsiteIDMediumsOperation:    []string{},
-	GetWebsiteIDOsOperation:         []string{},
-	GetWebsiteIDPagesOperation:      []string{},
-	GetWebsiteIDPropertiesOperation: []string{},
-	GetWebsiteIDReferrersOperation:  []string{},
-	GetWebsiteIDSourcesOperation:    []string{},
-	GetWebsiteIDSummaryOperation:    []string{},
-	GetWebsiteIDTimeOperation:       []string{},
-	GetWebsitesOperation:            []string{},
-	GetWebsitesIDOperation:          []string{},
-	PatchTenantSettingsOperation:    []string{},
-	PatchUserOperation:              []string{},
-	PatchWebsitesIDOperation:        []string{},
-	PostWebsitesOperation:           []string{},
+	DeleteAPIKeysIDOperation:                   []string{},
+	DeleteAuthLockoutsOperation:                []string{},
+	DeleteReportsIDOperation:                   []string{},
+	DeleteUserOperation:                        []string{},
+	DeleteUserSessionsOperation:                []string{},
+	DeleteUsersIDOperation:                     []string{},
+	DeleteWebsitesIDOperation:                  []string{},
+	DeleteWebsitesIDGoalsIDOperation:           []string{},
+	DeleteWebsitesIDWebhooksIDOperation:        []string{},
+	GetAPIKeysOperation:                        []string{},
+	GetAuditOperation:                          []string{},
+	GetAuthLockoutsOperation:                   []string{},
+	GetReportsOperation:                        []string{},
+	GetTenantSettingsOperation:                 []string{},
+	GetUserOperation:                           []string{},
+	GetUserSessionsOperation:                   []string{},
+	GetUserUsageOperation:                      []string{},
+	GetUsersOperation:                          []string{},
+	GetWebsiteIDBrowsersOperation:              []string{},
+	GetWebsiteIDCampaignsOperation:             []string{},
+	GetWebsiteIDCountryOperation:               []string{},
+	GetWebsiteIDDeviceOperation:                []string{},
+	GetWebsiteIDFunnelOperation:                []string{},
+	GetWebsiteIDGoalsOperation:                 []string{},
+	GetWebsiteIDHostnamesOperation:             []string{},
+	GetWebsiteIDLanguageOperation:              []string{},
+	GetWebsiteIDMediumsOperation:               []string{},
+	GetWebsiteIDOsOperation:                    []string{},
+	GetWebsiteIDPagesOperation:                 []string{},
+	GetWebsiteIDPagesEntryOperation:            []string{},
+	GetWebsiteIDPagesExitOperation:             []string{},
+	GetWebsiteIDPropertiesOperation:            []string{},
+	GetWebsiteIDRealtimeOperation:              []string{},
+	GetWebsiteIDReferrersOperation:             []string{},
+	GetWebsiteIDSourcesOperation:               []string{},
+	GetWebsiteIDSummaryOperation:               []string{},
+	GetWebsiteIDTimeOperation:                  []string{},
+	GetWebsitesOperation:                       []string{},
+	GetWebsitesIDOperation:                     []string{},
+	GetWebsitesIDDeletionOperation:             []string{},
+	GetWebsitesIDGoalsOperation:                []string{},
+	GetWebsitesIDWebhooksOperation:             []string{},
+	GetWebsitesIDWebhooksIDDeliveriesOperation: []string{},
+	PatchReportsIDOperation:                    []string{},
+	PatchTenantSettingsOperation:               []string{},
+	PatchUserOperation:                         []string{},
+	PatchUsersIDOperation:                      []string{},
+	PatchWebsitesIDOperation:                   []string{},
+	PatchWebsitesIDGoalsIDOperation:            []string{},
+	PostAPIKeysOperation:                       []string{},
+	PostReportsOperation:                       []string{},
+	PostUserTotpOperation:                      []string{},
+	PostUserTotpConfirmOperation:               []string{},
+	PostUserTotpDisableOperation:               []string{},
+	PostUsersOperation:                         []string{},
+	PostWebsitesOperation:                      []string{},
+	PostWebsitesIDGoalsOperation:               []string{},
+	PostWebsitesIDWebhooksOperation:            []string{},
 }
 
 // GetRolesForCookieAuth returns the required roles for the given operation.
@@ -82,6 +245,23 @@ func GetRolesForCookieAuth(operation string) []string {
 	return result
 }
 
+func (s *Server) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t BearerAuth
+	token, ok := findAuthorization(req.Header, "Bearer")
+	if !ok {
+		return ctx, false, nil
+	}
+	t.Token = token
+	t.Roles = operationRolesBearerAuth[operationName]
+	rctx, err := s.sec.HandleBearerAuth(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
 func (s *Server) securityCookieAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
 	var t CookieAuth
 	const parameterName = "_me_sess"
@@ -8,24 +8,115 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// DeleteAPIKeysID implements delete-api-keys-id operation.
+	//
+	// Revoke an API key. Requests using the key are rejected immediately.
+	//
+	// DELETE /api-keys/{keyId}
+	DeleteAPIKeysID(ctx context.Context, params DeleteAPIKeysIDParams) (DeleteAPIKeysIDRes, error)
+	// DeleteAuthLockouts implements delete-auth-lockouts operation.
+	//
+	// Clear the lockout and failed logins of a username or IP address, e.g. for a user locked out of
+	// their account. Only owners and admins can clear lockouts.
+	//
+	// DELETE /auth/lockouts
+	DeleteAuthLockouts(ctx context.Context, params DeleteAuthLockoutsParams) (DeleteAuthLockoutsRes, error)
+	// DeleteReportsID implements delete-reports-id operation.
+	//
+	// Unsubscribe from an email report.
+	//
+	// DELETE /reports/{reportId}
+	DeleteReportsID(ctx context.Context, params DeleteReportsIDParams) (DeleteReportsIDRes, error)
 	// DeleteUser implements delete-user operation.
 	//
 	// Delete a user account.
 	//
 	// DELETE /user
 	DeleteUser(ctx context.Context, params DeleteUserParams) (DeleteUserRes, error)
+	// DeleteUserSessions implements delete-user-sessions operation.
+	//
+	// Revoke all sessions of the user, including the current session.
+	//
+	// DELETE /user/sessions
+	DeleteUserSessions(ctx context.Context, params DeleteUserSessionsParams) (DeleteUserSessionsRes, error)
+	// DeleteUsersID implements delete-users-id operation.
+	//
+	// Delete a user. Websites added by the user are transferred to the owner. The owner can not be
+	// deleted. Only owners and admins can delete users.
+	//
+	// DELETE /users/{userId}
+	DeleteUsersID(ctx context.Context, params DeleteUsersIDParams) (DeleteUsersIDRes, error)
 	// DeleteWebsitesID implements delete-websites-id operation.
 	//
-	// Delete a website.
+	// Delete a website. The website is removed immediately and its page views and custom events are
+	// purged in the background. The progress of the purge can be followed using the website deletion
+	// endpoint.
 	//
 	// DELETE /websites/{hostname}
 	DeleteWebsitesID(ctx context.Context, params DeleteWebsitesIDParams) (DeleteWebsitesIDRes, error)
+	// DeleteWebsitesIDGoalsID implements delete-websites-id-goals-id operation.
+	//
+	// Delete a conversion goal.
+	//
+	// DELETE /websites/{hostname}/goals/{goalId}
+	DeleteWebsitesIDGoalsID(ctx context.Context, params DeleteWebsitesIDGoalsIDParams) (DeleteWebsitesIDGoalsIDRes, error)
+	// DeleteWebsitesIDWebhooksID implements delete-websites-id-webhooks-id operation.
+	//
+	// Delete a webhook and its delivery log. Deliveries in progress are not retried.
+	//
+	// DELETE /websites/{hostname}/webhooks/{webhookId}
+	DeleteWebsitesIDWebhooksID(ctx context.Context, params DeleteWebsitesIDWebhooksIDParams) (DeleteWebsitesIDWebhooksIDRes, error)
+	// GetAPIKeys implements get-api-keys operation.
+	//
+	// Get a list of the API keys of the user. The keys themselves are only returned once when created.
+	//
+	// GET /api-keys
+	GetAPIKeys(ctx context.Context, params GetAPIKeysParams) (GetAPIKeysRes, error)
+	// GetAudit implements get-audit operation.
+	//
+	// List the administrative actions performed on the server, newest first. Only owners and admins can
+	// read the audit log.
+	//
+	// GET /audit
+	GetAudit(ctx context.Context, params GetAuditParams) (GetAuditRes, error)
+	// GetAuthLockouts implements get-auth-lockouts operation.
+	//
+	// List the usernames and IP prefixes locked out after too many failed logins or invalid API keys.
+	// Only owners and admins can list lockouts.
+	//
+	// GET /auth/lockouts
+	GetAuthLockouts(ctx context.Context, params GetAuthLockoutsParams) (GetAuthLockoutsRes, error)
+	// GetAuthMethods implements get-auth-methods operation.
+	//
+	// Get the login methods enabled on the server.
+	//
+	// GET /auth/methods
+	GetAuthMethods(ctx context.Context) (GetAuthMethodsRes, error)
+	// GetAuthOidcCallback implements get-auth-oidc-callback operation.
+	//
+	// Callback from the OpenID Connect identity provider. Users are created on their first login if
+	// auto-provisioning is enabled.
+	//
+	// GET /auth/oidc/callback
+	GetAuthOidcCallback(ctx context.Context, params GetAuthOidcCallbackParams) (GetAuthOidcCallbackRes, error)
+	// GetAuthOidcLogin implements get-auth-oidc-login operation.
+	//
+	// Redirect to the OpenID Connect identity provider to log in.
+	//
+	// GET /auth/oidc/login
+	GetAuthOidcLogin(ctx context.Context) (GetAuthOidcLoginRes, error)
 	// GetEventPing implements get-event-ping operation.
 	//
 	// Ping endpoint to determine if the user is unique or not.
 	//
 	// GET /event/ping
 	GetEventPing(ctx context.Context, params GetEventPingParams) (GetEventPingRes, error)
+	// GetReports implements get-reports operation.
+	//
+	// Get a list of the scheduled email reports the user is subscribed to.
+	//
+	// GET /reports
+	GetReports(ctx context.Context, params GetReportsParams) (GetReportsRes, error)
 	// GetTenantSettings implements get-tenant-settings operation.
 	//
 	// Get a list of all tenant settings.
@@ -38,12 +129,26 @@ type Handler interface {
 	//
 	// GET /user
 	GetUser(ctx context.Context, params GetUserParams) (GetUserRes, error)
+	// GetUserSessions implements get-user-sessions operation.
+	//
+	// List the active sessions of the user.
+	//
+	// GET /user/sessions
+	GetUserSessions(ctx context.Context, params GetUserSessionsParams) (GetUserSessionsRes, error)
 	// GetUserUsage implements get-user-usage operation.
 	//
-	// Get the current CPU, memory and disk usage of the server.
+	// Get the current CPU, memory and disk usage of the server and the data retention of the analytics
+	// database.
 	//
 	// GET /user/usage
 	GetUserUsage(ctx context.Context, params GetUserUsageParams) (GetUserUsageRes, error)
+	// GetUsers implements get-users operation.
+	//
+	// Get a list of all users and the websites they are members of. Only owners and admins can list
+	// users.
+	//
+	// GET /users
+	GetUsers(ctx context.Context, params GetUsersParams) (GetUsersRes, error)
 	// GetWebsiteIDBrowsers implements get-website-id-browsers operation.
 	//
 	// Get a list of browsers and their stats.
@@ -68,6 +173,27 @@ type Handler interface {
 	//
 	// GET /website/{hostname}/devices
 	GetWebsiteIDDevice(ctx context.Context, params GetWebsiteIDDeviceParams) (GetWebsiteIDDeviceRes, error)
+	// GetWebsiteIDFunnel implements get-website-id-funnel operation.
+	//
+	// Get the number of visits reaching each step of an ordered funnel and how many dropped off between
+	// steps. A visit only reaches a step if it completed every previous step in order. Visits are only
+	// tracked when enabled on the server with `VISIT_TRACKING`, otherwise every page view is its own
+	// visit.
+	//
+	// GET /website/{hostname}/funnel
+	GetWebsiteIDFunnel(ctx context.Context, params GetWebsiteIDFunnelParams) (GetWebsiteIDFunnelRes, error)
+	// GetWebsiteIDGoals implements get-website-id-goals operation.
+	//
+	// Get the conversions, unique converters and conversion rate for each goal of the website.
+	//
+	// GET /website/{hostname}/goals
+	GetWebsiteIDGoals(ctx context.Context, params GetWebsiteIDGoalsParams) (GetWebsiteIDGoalsRes, error)
+	// GetWebsiteIDHostnames implements get-website-id-hostnames operation.
+	//
+	// Get a list of the hostnames and aliases page views were received on and their stats.
+	//
+	// GET /website/{hostname}/hostnames
+	GetWebsiteIDHostnames(ctx context.Context, params GetWebsiteIDHostnamesParams) (GetWebsiteIDHostnamesRes, error)
 	// GetWebsiteIDLanguage implements get-website-id-language operation.
 	//
 	// Get a list of languages and their stats.
@@ -92,6 +218,22 @@ type Handler interface {
 	//
 	// GET /website/{hostname}/pages
 	GetWebsiteIDPages(ctx context.Context, params GetWebsiteIDPagesParams) (GetWebsiteIDPagesRes, error)
+	// GetWebsiteIDPagesEntry implements get-website-id-pages-entry operation.
+	//
+	// Get a list of pages that start visits and their stats. Consecutive page views from the same
+	// visitor are grouped into a visit until they are inactive for 30 minutes. Visits are only tracked
+	// when enabled on the server with `VISIT_TRACKING`, otherwise every page view is its own visit.
+	//
+	// GET /website/{hostname}/pages/entry
+	GetWebsiteIDPagesEntry(ctx context.Context, params GetWebsiteIDPagesEntryParams) (GetWebsiteIDPagesEntryRes, error)
+	// GetWebsiteIDPagesExit implements get-website-id-pages-exit operation.
+	//
+	// Get a list of pages that end visits and their stats. Consecutive page views from the same visitor
+	// are grouped into a visit until they are inactive for 30 minutes. Visits are only tracked when
+	// enabled on the server with `VISIT_TRACKING`, otherwise every page view is its own visit.
+	//
+	// GET /website/{hostname}/pages/exit
+	GetWebsiteIDPagesExit(ctx context.Context, params GetWebsiteIDPagesExitParams) (GetWebsiteIDPagesExitRes, error)
 	// GetWebsiteIDProperties implements get-website-id-properties operation.
 	//
 	// Get a list of custom properties and their stats. If a property name is provided, it will return
@@ -99,6 +241,16 @@ type Handler interface {
 	//
 	// GET /website/{hostname}/properties
 	GetWebsiteIDProperties(ctx context.Context, params GetWebsiteIDPropertiesParams) (GetWebsiteIDPropertiesRes, error)
+	// GetWebsiteIDRealtime implements get-website-id-realtime operation.
+	//
+	// Get the number of visitors active in the last few minutes and the top pages and referrers they are
+	// viewing. A visitor is active if any of their visits had a page view within the period. Visits are
+	// only tracked when enabled on the server with `VISIT_TRACKING`, otherwise every page view is its
+	// own visit. To receive updates as they happen, the same stats are streamed as Server-Sent Events
+	// from `/api/website/{hostname}/realtime/stream`.
+	//
+	// GET /website/{hostname}/realtime
+	GetWebsiteIDRealtime(ctx context.Context, params GetWebsiteIDRealtimeParams) (GetWebsiteIDRealtimeRes, error)
 	// GetWebsiteIDReferrers implements get-website-id-referrers operation.
 	//
 	// Get a list of referrers and their stats.
@@ -135,6 +287,37 @@ type Handler interface {
 	//
 	// GET /websites/{hostname}
 	GetWebsitesID(ctx context.Context, params GetWebsitesIDParams) (GetWebsitesIDRes, error)
+	// GetWebsitesIDDeletion implements get-websites-id-deletion operation.
+	//
+	// Get the progress of purging the data of a deleted website. Returns not found once the purge is
+	// complete.
+	//
+	// GET /websites/{hostname}/deletion
+	GetWebsitesIDDeletion(ctx context.Context, params GetWebsitesIDDeletionParams) (GetWebsitesIDDeletionRes, error)
+	// GetWebsitesIDGoals implements get-websites-id-goals operation.
+	//
+	// Get a list of all conversion goals for a website.
+	//
+	// GET /websites/{hostname}/goals
+	GetWebsitesIDGoals(ctx context.Context, params GetWebsitesIDGoalsParams) (GetWebsitesIDGoalsRes, error)
+	// GetWebsitesIDWebhooks implements get-websites-id-webhooks operation.
+	//
+	// Get a list of the webhooks of a website. Only owners and admins can manage webhooks.
+	//
+	// GET /websites/{hostname}/webhooks
+	GetWebsitesIDWebhooks(ctx context.Context, params GetWebsitesIDWebhooksParams) (GetWebsitesIDWebhooksRes, error)
+	// GetWebsitesIDWebhooksIDDeliveries implements get-websites-id-webhooks-id-deliveries operation.
+	//
+	// Get the delivery log of a webhook, newest first. Deliveries are kept for 30 days.
+	//
+	// GET /websites/{hostname}/webhooks/{webhookId}/deliveries
+	GetWebsitesIDWebhooksIDDeliveries(ctx context.Context, params GetWebsitesIDWebhooksIDDeliveriesParams) (GetWebsitesIDWebhooksIDDeliveriesRes, error)
+	// PatchReportsID implements patch-reports-id operation.
+	//
+	// Update the recipient, frequency or stats of an email report.
+	//
+	// PATCH /reports/{reportId}
+	PatchReportsID(ctx context.Context, req *ReportPatch, params PatchReportsIDParams) (PatchReportsIDRes, error)
 	// PatchTenantSettings implements patch-tenant-settings operation.
 	//
 	// Partial update of tenant settings.
@@ -147,18 +330,48 @@ type Handler interface {
 	//
 	// PATCH /user
 	PatchUser(ctx context.Context, req *UserPatch, params PatchUserParams) (PatchUserRes, error)
+	// PatchUsersID implements patch-users-id operation.
+	//
+	// Update the role or website memberships of a user. The role of the owner can not be changed. Only
+	// owners and admins can update users.
+	//
+	// PATCH /users/{userId}
+	PatchUsersID(ctx context.Context, req *UserAccountPatch, params PatchUsersIDParams) (PatchUsersIDRes, error)
 	// PatchWebsitesID implements patch-websites-id operation.
 	//
-	// Update a website's information.
+	// Update a website's information. Changing the hostname moves all page views and custom events of
+	// the website to the new hostname. If a website with the new hostname already exists, the website
+	// can be merged into it by setting merge. Aliases record the page views and custom events received
+	// on other hostnames under the website.
 	//
 	// PATCH /websites/{hostname}
 	PatchWebsitesID(ctx context.Context, req *WebsitePatch, params PatchWebsitesIDParams) (PatchWebsitesIDRes, error)
+	// PatchWebsitesIDGoalsID implements patch-websites-id-goals-id operation.
+	//
+	// Update a conversion goal.
+	//
+	// PATCH /websites/{hostname}/goals/{goalId}
+	PatchWebsitesIDGoalsID(ctx context.Context, req *GoalPatch, params PatchWebsitesIDGoalsIDParams) (PatchWebsitesIDGoalsIDRes, error)
+	// PostAPIKeys implements post-api-keys operation.
+	//
+	// Create a new API key for programmatic access to the website and stats endpoints. The key is only
+	// returned in this response and can not be retrieved again.
+	//
+	// POST /api-keys
+	PostAPIKeys(ctx context.Context, req *APIKeyCreate, params PostAPIKeysParams) (PostAPIKeysRes, error)
 	// PostAuthLogin implements post-auth-login operation.
 	//
 	// Login to the service and retrieve a session token for authentication.
 	//
 	// POST /auth/login
-	PostAuthLogin(ctx context.Context, req *AuthLogin) (PostAuthLoginRes, error)
+	PostAuthLogin(ctx context.Context, req *AuthLogin, params PostAuthLoginParams) (PostAuthLoginRes, error)
+	// PostAuthLoginTotp implements post-auth-login-totp operation.
+	//
+	// Complete a login of a user with two-factor authentication using a code from their authenticator
+	// app or an unused recovery code.
+	//
+	// POST /auth/login/totp
+	PostAuthLoginTotp(ctx context.Context, req *AuthLoginTOTP, params PostAuthLoginTotpParams) (PostAuthLoginTotpRes, error)
 	// PostAuthLogout implements post-auth-logout operation.
 	//
 	// Logout of the service and destroy the session token.
@@ -171,12 +384,61 @@ type Handler interface {
 	//
 	// POST /event/hit
 	PostEventHit(ctx context.Context, req EventHit, params PostEventHitParams) (PostEventHitRes, error)
+	// PostReports implements post-reports operation.
+	//
+	// Subscribe to a scheduled email report of the stats of a website. The first report is sent at the
+	// end of the current period.
+	//
+	// POST /reports
+	PostReports(ctx context.Context, req *ReportCreate, params PostReportsParams) (PostReportsRes, error)
+	// PostUserTotp implements post-user-totp operation.
+	//
+	// Generate a new TOTP secret for the user to add to their authenticator app. Two-factor
+	// authentication is only enabled once a code is confirmed, and is only available if the server is
+	// configured with a TOTP secret.
+	//
+	// POST /user/totp
+	PostUserTotp(ctx context.Context, params PostUserTotpParams) (PostUserTotpRes, error)
+	// PostUserTotpConfirm implements post-user-totp-confirm operation.
+	//
+	// Enable two-factor authentication with a code from the authenticator app. The returned recovery
+	// codes are only shown once.
+	//
+	// POST /user/totp/confirm
+	PostUserTotpConfirm(ctx context.Context, req *TOTPConfirm, params PostUserTotpConfirmParams) (PostUserTotpConfirmRes, error)
+	// PostUserTotpDisable implements post-user-totp-disable operation.
+	//
+	// Disable two-factor authentication for the user and remove their recovery codes.
+	//
+	// POST /user/totp/disable
+	PostUserTotpDisable(ctx context.Context, req *TOTPDisable, params PostUserTotpDisableParams) (PostUserTotpDisableRes, error)
+	// PostUsers implements post-users operation.
+	//
+	// Invite a new user with the given role. Viewers can only access the websites they are members of.
+	// Only owners and admins can add users.
+	//
+	// POST /users
+	PostUsers(ctx context.Context, req *UserAccountCreate, params PostUsersParams) (PostUsersRes, error)
 	// PostWebsites implements post-websites operation.
 	//
 	// Add a new website.
 	//
 	// POST /websites
 	PostWebsites(ctx context.Context, req *WebsiteCreate) (PostWebsitesRes, error)
+	// PostWebsitesIDGoals implements post-websites-id-goals operation.
+	//
+	// Add a new conversion goal to a website. Pathname goals match page views by a glob pattern, while
+	// event goals match custom event properties by name and optionally value.
+	//
+	// POST /websites/{hostname}/goals
+	PostWebsitesIDGoals(ctx context.Context, req *GoalCreate, params PostWebsitesIDGoalsParams) (PostWebsitesIDGoalsRes, error)
+	// PostWebsitesIDWebhooks implements post-websites-id-webhooks operation.
+	//
+	// Create a webhook posting a signed JSON payload to a URL when its trigger fires. The signing secret
+	// is only returned in this response.
+	//
+	// POST /websites/{hostname}/webhooks
+	PostWebsitesIDWebhooks(ctx context.Context, req *WebhookCreate, params PostWebsitesIDWebhooksParams) (PostWebsitesIDWebhooksRes, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
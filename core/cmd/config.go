@@ -5,6 +5,10 @@ import (
 
 	"github.com/caarlos0/env/v11"
 	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/mail"
+	"github.com/medama-io/medama/oidc"
+	"github.com/medama-io/medama/util"
 )
 
 type ServerConfig struct {
@@ -23,15 +27,46 @@ type ServerConfig struct {
 	// CORS Settings.
 	CORSAllowedOrigins []string `env:"CORS_ALLOWED_ORIGINS" envSeparator:","`
 
+	// Session settings.
+	// Store sessions in the app database so they survive restarts.
+	PersistentSessions bool `env:"PERSISTENT_SESSIONS"`
+	// Secret the session cookie key is derived from. If unset, a random key is
+	// generated and stored in the app database.
+	SessionSecret string `env:"SESSION_SECRET" json:"-"`
+	// Secret the key encrypting TOTP secrets is derived from. Two-factor
+	// authentication can not be enabled if unset.
+	TOTPSecret string `env:"TOTP_SECRET" json:"-"`
+
+	// Tracking settings.
+	// Group page views from the same visitor into visits for funnels and
+	// entry and exit pages. Visitors are told apart by a hash of their IP
+	// address and user agent with a daily key that is only kept in memory.
+	VisitTracking bool `env:"VISIT_TRACKING"`
+
+	// Login lockout settings.
+	// Failed logins to a username before it is locked out.
+	LoginMaxAttempts int `env:"LOGIN_MAX_ATTEMPTS"`
+	// Failed logins from an IP prefix before it is locked out.
+	LoginMaxIPAttempts int `env:"LOGIN_MAX_IP_ATTEMPTS"`
+	// Duration of the first lockout, doubling with each further lockout up
+	// to the maximum.
+	LoginLockout    time.Duration `env:"LOGIN_LOCKOUT"`
+	LoginMaxLockout time.Duration `env:"LOGIN_MAX_LOCKOUT"`
+
 	// Timeout settings.
 	TimeoutReadHeader time.Duration
 	TimeoutRead       time.Duration
 	TimeoutWrite      time.Duration
 	TimeoutIdle       time.Duration
+	// How long readiness reports the server as shutting down before it stops
+	// accepting connections, so load balancers can drain traffic.
+	ShutdownDelay time.Duration `env:"SHUTDOWN_DELAY"`
 
 	// Misc settings.
 	// Enable /debug/pprof endpoints.
-	Profiler       bool `env:"PROFILER"`
+	Profiler bool `env:"PROFILER"`
+	// Enable /metrics endpoint in the OpenMetrics format.
+	Metrics        bool `env:"METRICS"`
 	UseEnvironment bool
 	DemoMode       bool `env:"DEMO_MODE"`
 
@@ -41,12 +76,54 @@ type ServerConfig struct {
 	Version string
 }
 
+// OIDCConfig configures single sign-on with an OpenID Connect identity
+// provider. Single sign-on is enabled when an issuer is set.
+type OIDCConfig struct {
+	Issuer       string   `env:"OIDC_ISSUER"`
+	ClientID     string   `env:"OIDC_CLIENT_ID"`
+	ClientSecret string   `env:"OIDC_CLIENT_SECRET" json:"-"`
+	RedirectURL  string   `env:"OIDC_REDIRECT_URL"`
+	Scopes       []string `env:"OIDC_SCOPES"        envSeparator:","`
+
+	// Claim mapping settings.
+	UsernameClaim string   `env:"OIDC_USERNAME_CLAIM"`
+	RolesClaim    string   `env:"OIDC_ROLES_CLAIM"`
+	AdminRoles    []string `env:"OIDC_ADMIN_ROLES"    envSeparator:","`
+	ViewerRoles   []string `env:"OIDC_VIEWER_ROLES"   envSeparator:","`
+	// Create users on their first login.
+	AutoProvision bool `env:"OIDC_AUTO_PROVISION"`
+
+	// Only allow logging in with single sign-on.
+	DisablePasswordLogin bool `env:"DISABLE_PASSWORD_LOGIN"`
+}
+
+// SMTPConfig configures the SMTP server scheduled email reports are sent
+// through. Email reports are enabled when a host is set.
+type SMTPConfig struct {
+	Host     string `env:"SMTP_HOST"`
+	Port     int    `env:"SMTP_PORT"`
+	Username string `env:"SMTP_USERNAME"`
+	Password string `env:"SMTP_PASSWORD" json:"-"`
+	// Sender address of all emails, e.g. "Medama <reports@example.com>".
+	From string `env:"SMTP_FROM"`
+}
+
 type AppDBConfig struct {
 	Host string `env:"APP_DATABASE_HOST"`
 }
 
 type AnalyticsDBConfig struct {
 	Host string `env:"ANALYTICS_DATABASE_HOST"`
+
+	// Ingestion settings.
+	// Number of queued hits that triggers a bulk write.
+	IngestBatchSize int `env:"ANALYTICS_INGEST_BATCH_SIZE"`
+	// Maximum time a hit waits in the queue before it is written.
+	IngestFlushInterval time.Duration `env:"ANALYTICS_INGEST_FLUSH_INTERVAL"`
+
+	// Data retention settings.
+	// How often data older than the retention period is deleted.
+	RetentionInterval time.Duration `env:"ANALYTICS_RETENTION_INTERVAL"`
 }
 
 const (
@@ -61,17 +138,28 @@ const (
 	DefaultTimeoutRead       = 30 * time.Second
 	DefaultTimeoutWrite      = 2 * time.Minute
 	DefaultTimeoutIdle       = 5 * time.Minute
+	DefaultShutdownDelay     = 0
 
 	// Database constants.
 	DefaultSQLiteHost = "./me_meta.db"
 	DefaultDuckDBHost = "./me_analytics.db"
 
+	// Data retention constants.
+	DefaultRetentionInterval = 24 * time.Hour
+
 	// Logging constants.
 	DefaultLogger      = "json"
 	DefaultLoggerLevel = "info"
 
+	// Single sign-on constants.
+	DefaultOIDCAutoProvision = true
+
+	// Tracking constants.
+	DefaultVisitTracking = false
+
 	// Misc constants.
 	DefaultProfiler = false
+	DefaultMetrics  = false
 	DefaultDemoMode = false
 )
 
@@ -88,13 +176,20 @@ func NewServerConfig(useEnv bool, version string, commit string) (*ServerConfig,
 	config := &ServerConfig{
 		Port:                 DefaultPort,
 		CacheCleanupInterval: DefaultCacheCleanupInterval,
+		LoginMaxAttempts:     util.DefaultLoginUserAttempts,
+		LoginMaxIPAttempts:   util.DefaultLoginIPAttempts,
+		LoginLockout:         util.DefaultLoginLockout,
+		LoginMaxLockout:      util.DefaultLoginMaxLockout,
 		Logger:               DefaultLogger,
 		Level:                DefaultLoggerLevel,
 		TimeoutReadHeader:    DefaultTimeoutReadHeader,
 		TimeoutRead:          DefaultTimeoutRead,
 		TimeoutWrite:         DefaultTimeoutWrite,
 		TimeoutIdle:          DefaultTimeoutIdle,
+		ShutdownDelay:        DefaultShutdownDelay,
 		Profiler:             DefaultProfiler,
+		Metrics:              DefaultMetrics,
+		VisitTracking:        DefaultVisitTracking,
 		UseEnvironment:       useEnv,
 		DemoMode:             DefaultDemoMode,
 		Version:              version,
@@ -111,6 +206,41 @@ func NewServerConfig(useEnv bool, version string, commit string) (*ServerConfig,
 	return config, nil
 }
 
+// NewOIDCConfig creates a new single sign-on config.
+func NewOIDCConfig(useEnv bool) (*OIDCConfig, error) {
+	config := &OIDCConfig{
+		Scopes:        oidc.DefaultScopes,
+		UsernameClaim: oidc.DefaultUsernameClaim,
+		RolesClaim:    oidc.DefaultRolesClaim,
+		AutoProvision: DefaultOIDCAutoProvision,
+	}
+
+	// Load config from environment variables.
+	if useEnv {
+		if err := env.Parse(config); err != nil {
+			return nil, errors.Wrap(err, "config")
+		}
+	}
+
+	return config, nil
+}
+
+// NewSMTPConfig creates a new email report config.
+func NewSMTPConfig(useEnv bool) (*SMTPConfig, error) {
+	config := &SMTPConfig{
+		Port: mail.DefaultPort,
+	}
+
+	// Load config from environment variables.
+	if useEnv {
+		if err := env.Parse(config); err != nil {
+			return nil, errors.Wrap(err, "config")
+		}
+	}
+
+	return config, nil
+}
+
 // NewAppDBConfig creates a new app database config.
 func NewAppDBConfig(useEnv bool) (*AppDBConfig, error) {
 	config := &AppDBConfig{
@@ -130,7 +260,10 @@ func NewAppDBConfig(useEnv bool) (*AppDBConfig, error) {
 // NewAnalyticsDBConfig creates a new analytics database config.
 func NewAnalyticsDBConfig(useEnv bool) (*AnalyticsDBConfig, error) {
 	config := &AnalyticsDBConfig{
-		Host: DefaultDuckDBHost,
+		Host:                DefaultDuckDBHost,
+		IngestBatchSize:     duckdb.DefaultIngestBatchSize,
+		IngestFlushInterval: duckdb.DefaultIngestFlushInterval,
+		RetentionInterval:   DefaultRetentionInterval,
 	}
 
 	// Load config from environment variables.
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/model"
+)
+
+// exportDateFormat is the short date format accepted by the export command.
+const exportDateFormat = "2006-01-02"
+
+type ExportCommand struct {
+	AnalyticsDB AnalyticsDBConfig
+
+	Hostname string
+	Start    string
+	End      string
+	Format   string
+	Output   string
+}
+
+// NewExportCommand creates a new export command.
+func NewExportCommand(useEnv bool) (*ExportCommand, error) {
+	analyticsConfig, err := NewAnalyticsDBConfig(useEnv)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create analytics db config")
+	}
+
+	return &ExportCommand{
+		AnalyticsDB: *analyticsConfig,
+		Format:      string(duckdb.ExportFormatParquet),
+		Output:      ".",
+	}, nil
+}
+
+// ParseFlags parses the command line flags for the export command.
+func (e *ExportCommand) ParseFlags(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+
+	fs.StringVar(&e.Hostname, "hostname", e.Hostname, "Hostname of the website to export.")
+	fs.StringVar(
+		&e.Start,
+		"start",
+		e.Start,
+		"Export data from this date (YYYY-MM-DD or RFC3339). Defaults to the first recorded data.",
+	)
+	fs.StringVar(
+		&e.End,
+		"end",
+		e.End,
+		"Export data up to and including this date (YYYY-MM-DD or RFC3339). Defaults to the latest recorded data.",
+	)
+	fs.StringVar(&e.Format, "format", e.Format, "Export file format (parquet, csv, ndjson).")
+	fs.StringVar(&e.Output, "out", e.Output, "Directory to write the exported files to.")
+	fs.StringVar(
+		&e.AnalyticsDB.Host,
+		"analyticsdb",
+		e.AnalyticsDB.Host,
+		"Path to analytics database.",
+	)
+
+	// The env flag is read before parsing to load the configuration.
+	fs.Bool(
+		"env",
+		false,
+		"Opt-in to allow environment variables to be used for configuration. Flags will still override environment variables.",
+	)
+
+	err := fs.Parse(args)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse flags")
+	}
+
+	if e.Hostname == "" {
+		return errors.New("export: -hostname is required")
+	}
+
+	return nil
+}
+
+// Run writes the page views and custom events of the website to
+// <out>/<hostname>-views.<format> and <out>/<hostname>-events.<format>.
+func (e *ExportCommand) Run(ctx context.Context) error {
+	format := duckdb.ExportFormat(e.Format)
+	if !format.IsValid() {
+		return errors.Wrap(model.ErrInvalidExportFormat, "export")
+	}
+
+	filter := duckdb.ExportFilter{
+		Hostname: e.Hostname,
+	}
+
+	var err error
+
+	if e.Start != "" {
+		filter.Start, err = parseExportDate(e.Start, false)
+		if err != nil {
+			return errors.Wrap(err, "export: invalid -start")
+		}
+	}
+
+	if e.End != "" {
+		filter.End, err = parseExportDate(e.End, true)
+		if err != nil {
+			return errors.Wrap(err, "export: invalid -end")
+		}
+	}
+
+	err = os.MkdirAll(e.Output, 0o750)
+	if err != nil {
+		return errors.Wrap(err, "export: failed to create output directory")
+	}
+
+	// Open the database read-only so an export can never modify analytics data.
+	// This fails while the server is running, as it holds the database open.
+	client, err := duckdb.NewReadOnlyClient(e.AnalyticsDB.Host)
+	if err != nil {
+		return errors.Wrap(err, "export: failed to open analytics database")
+	}
+	defer client.Close()
+
+	tables := []struct {
+		name   string
+		export func(context.Context, string, duckdb.ExportFormat, duckdb.ExportFilter) (int64, error)
+	}{
+		{"views", client.ExportViews},
+		{"events", client.ExportEvents},
+	}
+
+	for _, table := range tables {
+		path := filepath.Join(e.Output, e.Hostname+"-"+table.name+"."+string(format))
+
+		rows, err := table.export(ctx, path, format, filter)
+		if err != nil {
+			return errors.Wrapf(err, "export: failed to export %s", table.name)
+		}
+
+		//nolint: forbidigo // This is a CLI tool, so it's fine to use fmt for this part.
+		fmt.Printf("Exported %d %s to %s\n", rows, table.name, path)
+	}
+
+	return nil
+}
+
+// parseExportDate parses a date or timestamp. Dates without a time cover the
+// whole day, so the end of the period is moved to the start of the next day.
+func parseExportDate(value string, isEnd bool) (time.Time, error) {
+	date, err := time.Parse(exportDateFormat, value)
+	if err == nil {
+		if isEnd {
+			date = date.AddDate(0, 0, 1)
+		}
+
+		return date, nil
+	}
+
+	date, err = time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "expected YYYY-MM-DD or RFC3339")
+	}
+
+	return date, nil
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/db/sqlite"
+	"github.com/medama-io/medama/importer"
+	"github.com/medama-io/medama/migrations"
+	"github.com/medama-io/medama/model"
+	"go.jetify.com/typeid"
+)
+
+type ImportCommand struct {
+	AppDB       AppDBConfig
+	AnalyticsDB AnalyticsDBConfig
+
+	Source   string
+	Hostname string
+	List     bool
+	Rollback string
+	Files    []string
+}
+
+// NewImportCommand creates a new import command.
+func NewImportCommand(useEnv bool) (*ImportCommand, error) {
+	appConfig, err := NewAppDBConfig(useEnv)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create app db config")
+	}
+
+	analyticsConfig, err := NewAnalyticsDBConfig(useEnv)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create analytics db config")
+	}
+
+	return &ImportCommand{
+		AppDB:       *appConfig,
+		AnalyticsDB: *analyticsConfig,
+	}, nil
+}
+
+// ParseFlags parses the command line flags for the import command. The
+// remaining arguments are the export files to import.
+func (i *ImportCommand) ParseFlags(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+
+	fs.StringVar(&i.Source, "source", i.Source, "Analytics tool the files were exported from (plausible, umami, ga).")
+	fs.StringVar(&i.Hostname, "hostname", i.Hostname, "Hostname of the website to import into.")
+	fs.BoolVar(&i.List, "list", i.List, "List previous imports.")
+	fs.StringVar(&i.Rollback, "rollback", i.Rollback, "Remove all data of the import with this ID.")
+	fs.StringVar(&i.AppDB.Host, "appdb", i.AppDB.Host, "Path to app database.")
+	fs.StringVar(
+		&i.AnalyticsDB.Host,
+		"analyticsdb",
+		i.AnalyticsDB.Host,
+		"Path to analytics database.",
+	)
+
+	// The env flag is read before parsing to load the configuration.
+	fs.Bool(
+		"env",
+		false,
+		"Opt-in to allow environment variables to be used for configuration. Flags will still override environment variables.",
+	)
+
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: medama import -source <source> -hostname <hostname> [flags] <files...>")
+		fmt.Fprintln(fs.Output(), "       medama import -list")
+		fmt.Fprintln(fs.Output(), "       medama import -rollback <id>")
+		fs.PrintDefaults()
+	}
+
+	err := fs.Parse(args)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse flags")
+	}
+
+	i.Files = fs.Args()
+
+	if i.List || i.Rollback != "" {
+		return nil
+	}
+
+	if !importer.Source(i.Source).IsValid() {
+		return errors.Wrap(model.ErrInvalidImportSource, "import")
+	}
+
+	if i.Hostname == "" {
+		return errors.New("import: -hostname is required")
+	}
+
+	if len(i.Files) == 0 {
+		return errors.New("import: no files to import")
+	}
+
+	return nil
+}
+
+// Run imports the files, or lists or rolls back previous imports.
+func (i *ImportCommand) Run(ctx context.Context) error {
+	sqliteClient, err := sqlite.NewClient(i.AppDB.Host)
+	if err != nil {
+		return errors.Wrap(err, "import: failed to open app database")
+	}
+	defer sqliteClient.Close()
+
+	duckdbClient, err := duckdb.NewClient(i.AnalyticsDB.Host)
+	if err != nil {
+		if strings.Contains(err.Error(), "lock") {
+			return errors.Wrap(err, "import: analytics database is in use, stop the server before importing")
+		}
+
+		return errors.Wrap(err, "import: failed to open analytics database")
+	}
+	defer duckdbClient.Close()
+
+	// Imported rows are tagged using a column added by a migration.
+	m, err := migrations.NewMigrationsService(ctx, sqliteClient, duckdbClient)
+	if err != nil {
+		return errors.Wrap(err, "import: failed to create migrations service")
+	}
+
+	err = m.AutoMigrate(ctx)
+	if err != nil {
+		return errors.Wrap(err, "import: could not run migrations")
+	}
+
+	switch {
+	case i.List:
+		return i.list(ctx, duckdbClient)
+	case i.Rollback != "":
+		return i.rollback(ctx, duckdbClient)
+	}
+
+	_, err = sqliteClient.GetWebsite(ctx, i.Hostname)
+	if err != nil {
+		return errors.Wrap(err, "import")
+	}
+
+	importID, err := typeid.WithPrefix("import")
+	if err != nil {
+		return errors.Wrap(err, "import: typeid")
+	}
+
+	imp, err := importer.New(i.Hostname, importID.String(), duckdbClient)
+	if err != nil {
+		return errors.Wrap(err, "import")
+	}
+
+	result, err := imp.Import(ctx, importer.Source(i.Source), i.Files)
+	if err != nil {
+		// Remove any batches written before the error.
+		_, _, rollbackErr := duckdbClient.DeleteImport(ctx, importID.String())
+
+		return errors.Wrap(errors.Join(err, rollbackErr), "import")
+	}
+
+	for _, skipped := range result.Skipped {
+		//nolint: forbidigo // This is a CLI tool, so it's fine to use fmt for this part.
+		fmt.Printf("Skipped %s\n", skipped)
+	}
+
+	//nolint: forbidigo // This is a CLI tool, so it's fine to use fmt for this part.
+	fmt.Printf(
+		"Imported %d views and %d events into %s with import ID %s\n"+
+			"Run 'medama import -rollback %s' to remove them.\n",
+		result.Views, result.Events, i.Hostname, importID.String(), importID.String(),
+	)
+
+	return nil
+}
+
+func (i *ImportCommand) list(ctx context.Context, client *duckdb.Client) error {
+	imports, err := client.ListImports(ctx)
+	if err != nil {
+		return errors.Wrap(err, "import: failed to list imports")
+	}
+
+	if len(imports) == 0 {
+		//nolint: forbidigo // This is a CLI tool, so it's fine to use fmt for this part.
+		fmt.Println("No imports found")
+		return nil
+	}
+
+	for _, imp := range imports {
+		//nolint: forbidigo // This is a CLI tool, so it's fine to use fmt for this part.
+		fmt.Printf("%s\t%s\t%d views\t%d events\t%s to %s\n",
+			imp.ID, imp.Hostname, imp.Views, imp.Events,
+			imp.Start.UTC().Format(time.DateOnly), imp.End.UTC().Format(time.DateOnly),
+		)
+	}
+
+	return nil
+}
+
+func (i *ImportCommand) rollback(ctx context.Context, client *duckdb.Client) error {
+	views, events, err := client.DeleteImport(ctx, i.Rollback)
+	if err != nil {
+		return errors.Wrap(err, "import: failed to roll back import")
+	}
+
+	if views == 0 && events == 0 {
+		return errors.Errorf("import: no data found for import ID %q", i.Rollback)
+	}
+
+	//nolint: forbidigo // This is a CLI tool, so it's fine to use fmt for this part.
+	fmt.Printf("Removed %d views and %d events of import %s\n", views, events, i.Rollback)
+
+	return nil
+}
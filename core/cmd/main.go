@@ -48,19 +48,8 @@ func run(ctx context.Context, args []string) error {
 
 	switch cmd {
 	case "start":
-		// Check for --env flag to set configuration to also scan
-		// for environment variables. Ignore all other flags.
-		useEnv := false
-
-		for _, arg := range args {
-			switch arg {
-			case "--env", "-env":
-				useEnv = true
-			}
-		}
-
 		// Create start command
-		s, err := NewStartCommand(useEnv, Version, Commit)
+		s, err := NewStartCommand(hasEnvFlag(args), Version, Commit)
 		if err != nil {
 			return err
 		}
@@ -72,6 +61,30 @@ func run(ctx context.Context, args []string) error {
 
 		return s.Run(ctx)
 
+	case "export":
+		e, err := NewExportCommand(hasEnvFlag(args))
+		if err != nil {
+			return err
+		}
+
+		if err := e.ParseFlags(args); err != nil {
+			return err
+		}
+
+		return e.Run(ctx)
+
+	case "import":
+		i, err := NewImportCommand(hasEnvFlag(args))
+		if err != nil {
+			return err
+		}
+
+		if err := i.ParseFlags(args); err != nil {
+			return err
+		}
+
+		return i.Run(ctx)
+
 	case "version":
 		//nolint: forbidigo // This is a CLI tool, so it's fine to use fmt for this part.
 		fmt.Println(GetVersion())
@@ -86,6 +99,19 @@ func run(ctx context.Context, args []string) error {
 	}
 }
 
+// hasEnvFlag checks for the --env flag to set configuration to also scan
+// for environment variables. Ignore all other flags.
+func hasEnvFlag(args []string) bool {
+	for _, arg := range args {
+		switch arg {
+		case "--env", "-env":
+			return true
+		}
+	}
+
+	return false
+}
+
 func GetVersion() string {
 	if Version != "" && Commit != "" {
 		return fmt.Sprintf("Medama Analytics %s, commit=%s", Version, Commit)
@@ -21,8 +21,13 @@ import (
 	"github.com/medama-io/medama/api"
 	"github.com/medama-io/medama/db/duckdb"
 	"github.com/medama-io/medama/db/sqlite"
+	"github.com/medama-io/medama/health"
+	"github.com/medama-io/medama/mail"
+	"github.com/medama-io/medama/metrics"
 	"github.com/medama-io/medama/middlewares"
 	"github.com/medama-io/medama/migrations"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/oidc"
 	"github.com/medama-io/medama/services"
 	"github.com/medama-io/medama/util"
 	"github.com/medama-io/medama/util/logger"
@@ -40,6 +45,8 @@ type StartCommand struct {
 	Server      ServerConfig
 	AppDB       AppDBConfig
 	AnalyticsDB AnalyticsDBConfig
+	OIDC        OIDCConfig
+	SMTP        SMTPConfig
 }
 
 // NewStartCommand creates a new start command.
@@ -59,10 +66,22 @@ func NewStartCommand(useEnv bool, version string, commit string) (*StartCommand,
 		return nil, errors.Wrap(err, "failed to create analytics db config")
 	}
 
+	oidcConfig, err := NewOIDCConfig(useEnv)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create oidc config")
+	}
+
+	smtpConfig, err := NewSMTPConfig(useEnv)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create smtp config")
+	}
+
 	return &StartCommand{
 		Server:      *serverConfig,
 		AppDB:       *appConfig,
 		AnalyticsDB: *analyticsConfig,
+		OIDC:        *oidcConfig,
+		SMTP:        *smtpConfig,
 	}, nil
 }
 
@@ -102,9 +121,140 @@ func (s *StartCommand) ParseFlags(args []string) error {
 		s.AnalyticsDB.Host,
 		"Path to analytics database.",
 	)
+	fs.IntVar(
+		&s.AnalyticsDB.IngestBatchSize,
+		"ingestbatchsize",
+		s.AnalyticsDB.IngestBatchSize,
+		"Number of queued hits that triggers a bulk write to the analytics database.",
+	)
+	fs.DurationVar(
+		&s.AnalyticsDB.IngestFlushInterval,
+		"ingestinterval",
+		s.AnalyticsDB.IngestFlushInterval,
+		"Maximum time a hit waits in the queue before it is written to the analytics database.",
+	)
+	fs.DurationVar(
+		&s.AnalyticsDB.RetentionInterval,
+		"retentioninterval",
+		s.AnalyticsDB.RetentionInterval,
+		"How often analytics data older than the retention period is deleted.",
+	)
+
+	// Tracking settings.
+	fs.BoolVar(
+		&s.Server.VisitTracking,
+		"visittracking",
+		s.Server.VisitTracking,
+		"Group page views into visits for funnels and entry and exit pages. Visitors are told apart by a hash of their IP address and user agent with a daily key kept in memory.",
+	)
+
+	// Session settings.
+	fs.BoolVar(
+		&s.Server.PersistentSessions,
+		"persistentsessions",
+		s.Server.PersistentSessions,
+		"Store login sessions in the app database so users stay logged in across restarts.",
+	)
+	fs.StringVar(
+		&s.Server.SessionSecret,
+		"sessionsecret",
+		s.Server.SessionSecret,
+		"Secret of at least 32 characters to derive the session cookie key from when sessions are persisted. If unset, a random key is stored in the app database.",
+	)
+	fs.StringVar(
+		&s.Server.TOTPSecret,
+		"totpsecret",
+		s.Server.TOTPSecret,
+		"Secret of at least 32 characters to derive the key encrypting two-factor authentication secrets from. Two-factor authentication can not be enabled if unset.",
+	)
+
+	// Login lockout settings.
+	fs.IntVar(
+		&s.Server.LoginMaxAttempts,
+		"loginmaxattempts",
+		s.Server.LoginMaxAttempts,
+		"Failed logins to a username before it is locked out. Set to 0 to disable.",
+	)
+	fs.IntVar(
+		&s.Server.LoginMaxIPAttempts,
+		"loginmaxipattempts",
+		s.Server.LoginMaxIPAttempts,
+		"Failed logins from an IP prefix before it is locked out. Set to 0 to disable.",
+	)
+	fs.DurationVar(
+		&s.Server.LoginLockout,
+		"loginlockout",
+		s.Server.LoginLockout,
+		"Duration of the first login lockout. Each further lockout doubles the duration.",
+	)
+	fs.DurationVar(
+		&s.Server.LoginMaxLockout,
+		"loginmaxlockout",
+		s.Server.LoginMaxLockout,
+		"Maximum duration of a login lockout.",
+	)
+
+	// Single sign-on settings.
+	fs.StringVar(
+		&s.OIDC.Issuer,
+		"oidcissuer",
+		s.OIDC.Issuer,
+		"Issuer URL of the OpenID Connect identity provider to enable single sign-on.",
+	)
+	fs.StringVar(&s.OIDC.ClientID, "oidcclientid", s.OIDC.ClientID, "Client ID registered with the identity provider.")
+	fs.StringVar(
+		&s.OIDC.ClientSecret,
+		"oidcclientsecret",
+		s.OIDC.ClientSecret,
+		"Client secret registered with the identity provider.",
+	)
+	fs.StringVar(
+		&s.OIDC.RedirectURL,
+		"oidcredirecturl",
+		s.OIDC.RedirectURL,
+		"Callback URL registered with the identity provider, e.g. https://example.com/api/auth/oidc/callback.",
+	)
+	fs.StringVar(
+		&s.OIDC.UsernameClaim,
+		"oidcusernameclaim",
+		s.OIDC.UsernameClaim,
+		"Claim used as the username of single sign-on users.",
+	)
+	fs.StringVar(
+		&s.OIDC.RolesClaim,
+		"oidcrolesclaim",
+		s.OIDC.RolesClaim,
+		"Claim holding the roles or groups of single sign-on users. Nested claims are separated by dots.",
+	)
+	fs.BoolVar(
+		&s.OIDC.AutoProvision,
+		"oidcautoprovision",
+		s.OIDC.AutoProvision,
+		"Create single sign-on users on their first login.",
+	)
+	fs.BoolVar(
+		&s.OIDC.DisablePasswordLogin,
+		"disablepasswordlogin",
+		s.OIDC.DisablePasswordLogin,
+		"Only allow logging in with single sign-on.",
+	)
+
+	// Email report settings.
+	fs.StringVar(&s.SMTP.Host, "smtphost", s.SMTP.Host, "SMTP server host to enable scheduled email reports.")
+	fs.IntVar(&s.SMTP.Port, "smtpport", s.SMTP.Port, "SMTP server port. Port 465 uses implicit TLS.")
+	fs.StringVar(&s.SMTP.Username, "smtpusername", s.SMTP.Username, "SMTP server username.")
+	fs.StringVar(&s.SMTP.Password, "smtppassword", s.SMTP.Password, "SMTP server password.")
+	fs.StringVar(&s.SMTP.From, "smtpfrom", s.SMTP.From, "Sender address of email reports.")
 
 	// Misc settings.
 	fs.BoolVar(&s.Server.Profiler, "profiler", s.Server.Profiler, "Enable debug profiling.")
+	fs.BoolVar(&s.Server.Metrics, "metrics", s.Server.Metrics, "Enable the /metrics endpoint in the OpenMetrics format.")
+	fs.DurationVar(
+		&s.Server.ShutdownDelay,
+		"shutdowndelay",
+		s.Server.ShutdownDelay,
+		"How long /readyz reports the server as shutting down before it stops accepting connections.",
+	)
 	fs.BoolVar(
 		&s.Server.UseEnvironment,
 		"env",
@@ -125,6 +275,22 @@ func (s *StartCommand) ParseFlags(args []string) error {
 		"Comma separated list of allowed CORS origins on API routes. Useful for external dashboards that may host the frontend on a different domain.",
 	)
 
+	oidcScopes := fs.String(
+		"oidcscopes",
+		strings.Join(s.OIDC.Scopes, ","),
+		"Comma separated list of scopes requested from the identity provider.",
+	)
+	oidcAdminRoles := fs.String(
+		"oidcadminroles",
+		strings.Join(s.OIDC.AdminRoles, ","),
+		"Comma separated list of roles or groups that grant single sign-on users the admin role.",
+	)
+	oidcViewerRoles := fs.String(
+		"oidcviewerroles",
+		strings.Join(s.OIDC.ViewerRoles, ","),
+		"Comma separated list of roles or groups that grant single sign-on users the viewer role. If unset, all other users are viewers.",
+	)
+
 	// Parse flags.
 	err := fs.Parse(args)
 	if err != nil {
@@ -135,6 +301,18 @@ func (s *StartCommand) ParseFlags(args []string) error {
 		s.Server.CORSAllowedOrigins = strings.Split(*corsAllowedOrigins, ",")
 	}
 
+	if *oidcScopes != "" {
+		s.OIDC.Scopes = strings.Split(*oidcScopes, ",")
+	}
+
+	if *oidcAdminRoles != "" {
+		s.OIDC.AdminRoles = strings.Split(*oidcAdminRoles, ",")
+	}
+
+	if *oidcViewerRoles != "" {
+		s.OIDC.ViewerRoles = strings.Split(*oidcViewerRoles, ",")
+	}
+
 	return nil
 }
 
@@ -155,14 +333,14 @@ func (s *StartCommand) Run(ctx context.Context) error {
 	}
 	defer sqlite.Close()
 
-	duckdb, err := duckdb.NewClient(s.AnalyticsDB.Host)
+	duckdbClient, err := duckdb.NewClient(s.AnalyticsDB.Host)
 	if err != nil {
 		return errors.Wrap(err, "failed to create duckdb client")
 	}
-	defer duckdb.Close()
+	defer duckdbClient.Close()
 
 	// Run migrations
-	m, err := migrations.NewMigrationsService(ctx, sqlite, duckdb)
+	m, err := migrations.NewMigrationsService(ctx, sqlite, duckdbClient)
 	if err != nil {
 		return errors.Wrap(err, "failed to create migrations service")
 	}
@@ -172,18 +350,96 @@ func (s *StartCommand) Run(ctx context.Context) error {
 		return errors.Wrap(err, "could not run migrations")
 	}
 
-	// Setup auth service
-	auth, err := util.NewAuthService(ctx, s.Server.DemoMode)
+	// Setup auth service.
+	authOpts := []util.AuthOption{
+		util.WithLoginLimits(util.LoginLimits{
+			UserAttempts: s.Server.LoginMaxAttempts,
+			IPAttempts:   s.Server.LoginMaxIPAttempts,
+			Lockout:      s.Server.LoginLockout,
+			MaxLockout:   s.Server.LoginMaxLockout,
+		}),
+	}
+	if s.Server.PersistentSessions {
+		key, err := s.sessionKey(ctx, sqlite)
+		if err != nil {
+			return errors.Wrap(err, "failed to setup session key")
+		}
+
+		authOpts = append(authOpts, util.WithSessionStore(sqlite, key))
+	}
+
+	// TOTP secrets are encrypted with a key derived from a configured secret
+	// that is not stored alongside them in the app database.
+	if s.Server.TOTPSecret != "" {
+		key, err := util.DeriveTOTPKey(s.Server.TOTPSecret)
+		if err != nil {
+			return errors.Wrap(err, "failed to setup totp key")
+		}
+
+		authOpts = append(authOpts, util.WithTOTPKey(key))
+	} else {
+		log.Info().Msg("two-factor authentication disabled as no totp secret is set")
+	}
+
+	oidcOpts, err := s.oidcOptions()
+	if err != nil {
+		return errors.Wrap(err, "failed to setup single sign-on")
+	}
+
+	authOpts = append(authOpts, oidcOpts...)
+
+	auth, err := util.NewAuthService(ctx, s.Server.DemoMode, authOpts...)
 	if err != nil {
 		return errors.Wrap(err, "failed to create auth service")
 	}
 
+	// Setup buffered ingestion. Closing drains any queued hits before the
+	// database connection is closed.
+	ingester := duckdb.NewIngester(duckdbClient, duckdb.IngestConfig{
+		BatchSize:     s.AnalyticsDB.IngestBatchSize,
+		FlushInterval: s.AnalyticsDB.IngestFlushInterval,
+	})
+	defer ingester.Close(context.Background()) //nolint:errcheck // Drained explicitly on graceful shutdown.
+
 	// Setup handlers
-	service, err := services.NewService(ctx, auth, sqlite, duckdb, s.Server.Commit)
+	var serviceOpts []services.ServiceOption
+	if s.Server.VisitTracking {
+		log.Info().Msg("Enabling visit tracking...")
+
+		serviceOpts = append(serviceOpts, services.WithVisitTracking())
+	}
+
+	service, err := services.NewService(ctx, auth, sqlite, duckdbClient, ingester, s.Server.Commit, serviceOpts...)
 	if err != nil {
 		return errors.Wrap(err, "failed to create handlers")
 	}
 
+	// Periodically delete analytics data older than the retention period.
+	retention := services.NewRetentionJob(sqlite, duckdbClient, s.AnalyticsDB.RetentionInterval)
+	retention.Start(ctx)
+	defer retention.Close()
+
+	// Periodically send scheduled email reports if an SMTP server is set.
+	if s.SMTP.Host != "" {
+		if s.SMTP.From == "" {
+			return errors.New("smtp from address is required to send email reports")
+		}
+
+		mailer := mail.NewClient(mail.Config{
+			Host:     s.SMTP.Host,
+			Port:     s.SMTP.Port,
+			Username: s.SMTP.Username,
+			Password: s.SMTP.Password,
+			From:     s.SMTP.From,
+		})
+
+		reports := services.NewReportJob(sqlite, duckdbClient, mailer, services.DefaultReportInterval)
+		reports.Start(ctx)
+		defer reports.Close()
+	} else {
+		log.Debug().Msg("email reports disabled as no smtp host is set")
+	}
+
 	mw := []middleware.Middleware{
 		middlewares.RequestLogger(),
 		middlewares.RequestContext(),
@@ -191,7 +447,7 @@ func (s *StartCommand) Run(ctx context.Context) error {
 	}
 
 	apiHandler, err := api.NewServer(service,
-		middlewares.NewAuthHandler(auth),
+		middlewares.NewAuthHandler(auth, sqlite),
 		api.WithMiddleware(mw...),
 		api.WithErrorHandler(middlewares.ErrorHandler),
 		api.WithNotFound(middlewares.NotFound()),
@@ -203,7 +459,18 @@ func (s *StartCommand) Run(ctx context.Context) error {
 	// We need to add additional static routes for the web app.
 	mux := http.NewServeMux()
 	mux.Handle("/openapi.yaml", http.FileServer(http.FS(generate.OpenAPIDocument)))
-	mux.Handle("/api/", http.StripPrefix("/api", apiHandler))
+	// Stats reports can be exported as CSV or NDJSON.
+	mux.Handle("/api/", http.StripPrefix("/api", middlewares.Export()(middlewares.ClientIP()(apiHandler))))
+	// Server-Sent Events are not supported by ogen, so the realtime stream is served separately.
+	mux.Handle("GET /api/website/{hostname}/realtime/stream", service.RealtimeStreamHandler())
+
+	// Liveness and readiness probes for load balancers and orchestrators.
+	checker := health.NewChecker()
+	checker.Add("sqlite", health.Ping(sqlite))
+	checker.Add("duckdb", health.Ping(duckdbClient))
+	checker.Add("migrations", health.Migrated(m.Completed))
+	mux.Handle("GET /healthz", checker.LivenessHandler())
+	mux.Handle("GET /readyz", checker.ReadinessHandler())
 
 	// Start CPU profiling if enabled.
 	if s.Server.Profiler {
@@ -215,6 +482,13 @@ func (s *StartCommand) Run(ctx context.Context) error {
 		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
 	}
 
+	// Expose metrics for scraping if enabled.
+	if s.Server.Metrics {
+		log.Info().Msg("Enabling metrics endpoint...")
+		metrics.Enable()
+		mux.Handle("GET /metrics", metrics.Handler())
+	}
+
 	// SPA client.
 	err = services.SetupAssetHandler(mux, service.RuntimeConfig)
 	if err != nil {
@@ -233,12 +507,70 @@ func (s *StartCommand) Run(ctx context.Context) error {
 	// RateLimiter middleware to limit requests with coarse IP prefixes. Ensure this is applied last to the handler chain.
 	handler = middlewares.NewRateLimiter(handler)
 
-	return s.serve(ctx, log, handler)
+	return s.serve(ctx, log, handler, checker, ingester, service.Close)
+}
+
+// sessionKey returns the key encrypting session cookies when sessions are
+// persisted. The key is derived from the configured session secret, or
+// otherwise generated once and stored in the app database.
+func (s *StartCommand) sessionKey(ctx context.Context, client *sqlite.Client) ([]byte, error) {
+	if s.Server.SessionSecret != "" {
+		return util.DeriveCipherKey(s.Server.SessionSecret)
+	}
+
+	key, err := util.GenerateCipherKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetOrCreateSecret(ctx, model.SessionKeySecret, key)
+}
+
+// oidcOptions returns the auth options enabling single sign-on when an
+// identity provider is configured.
+func (s *StartCommand) oidcOptions() ([]util.AuthOption, error) {
+	if s.OIDC.Issuer == "" {
+		// Disabling password login without single sign-on would lock out
+		// every user.
+		if s.OIDC.DisablePasswordLogin {
+			return nil, errors.New("password login can only be disabled when single sign-on is configured")
+		}
+
+		return nil, nil
+	}
+
+	provider, err := oidc.NewProvider(oidc.Config{
+		Issuer:        s.OIDC.Issuer,
+		ClientID:      s.OIDC.ClientID,
+		ClientSecret:  s.OIDC.ClientSecret,
+		RedirectURL:   s.OIDC.RedirectURL,
+		Scopes:        s.OIDC.Scopes,
+		UsernameClaim: s.OIDC.UsernameClaim,
+		RolesClaim:    s.OIDC.RolesClaim,
+		AdminRoles:    s.OIDC.AdminRoles,
+		ViewerRoles:   s.OIDC.ViewerRoles,
+		AutoProvision: s.OIDC.AutoProvision,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []util.AuthOption{
+		util.WithOIDC(provider),
+		util.WithPasswordLogin(!s.OIDC.DisablePasswordLogin),
+	}, nil
 }
 
 // serve starts the HTTP server with the given handler. If AutoSSL is enabled, it will also provision certificates
-// and redirect HTTP to HTTPS.
-func (s *StartCommand) serve(ctx context.Context, log zerolog.Logger, mux http.Handler) error {
+// and redirect HTTP to HTTPS. onShutdown is called when shutdown starts to end long-lived connections.
+func (s *StartCommand) serve(
+	ctx context.Context,
+	log zerolog.Logger,
+	mux http.Handler,
+	checker *health.Checker,
+	ingester *duckdb.Ingester,
+	onShutdown func(),
+) error {
 	var (
 		httpListener  net.Listener
 		httpsListener net.Listener
@@ -264,6 +596,8 @@ func (s *StartCommand) serve(ctx context.Context, log zerolog.Logger, mux http.H
 		if err != nil {
 			return errors.Wrap(err, "failed to provision ssl certificate")
 		}
+
+		checker.Add("certificate", health.Certificate(s.Server.AutoSSLDomain, cfg.GetCertificate, time.Now))
 	}
 
 	// Create HTTP/S listeners.
@@ -359,6 +693,18 @@ func (s *StartCommand) serve(ctx context.Context, log zerolog.Logger, mux http.H
 		<-stop
 		log.Info().Msg("Shutting down server...")
 
+		// Report as unavailable while still serving requests, so load
+		// balancers stop routing new traffic here before connections close.
+		checker.Drain()
+
+		if s.Server.ShutdownDelay > 0 {
+			log.Info().Dur("delay", s.Server.ShutdownDelay).Msg("Draining traffic before shutdown...")
+			time.Sleep(s.Server.ShutdownDelay)
+		}
+
+		// Shutdown does not close streaming connections, so end them first.
+		onShutdown()
+
 		shutdownCtx, cancel := context.WithTimeout(ctx, shutdownTimeout)
 		defer cancel()
 
@@ -372,6 +718,17 @@ func (s *StartCommand) serve(ctx context.Context, log zerolog.Logger, mux http.H
 			log.Error().Err(err).Msg("Could not gracefully shutdown the HTTP server")
 		}
 
+		// Write any remaining queued hits now that no new requests are accepted.
+		stats := ingester.Stats()
+		if err := ingester.Close(shutdownCtx); err != nil {
+			log.Error().
+				Err(err).
+				Int("queue_depth", stats.QueueDepth).
+				Msg("Could not drain ingestion queue")
+		} else {
+			log.Info().Int("queue_depth", stats.QueueDepth).Msg("Drained ingestion queue")
+		}
+
 		close(closed)
 	}()
 
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/medama-io/medama/api"
 	"github.com/medama-io/medama/model"
@@ -12,6 +13,7 @@ type UpdateTenantSettings struct {
 	BlockAbusiveIPs   *string
 	BlockTorExitNodes *string
 	BlockedIPs        *string
+	RetentionDays     *string
 }
 
 // AppClient is the interface that groups all database operations related to
@@ -24,34 +26,182 @@ type AppClient interface {
 	GetUser(ctx context.Context, id string) (*model.User, error)
 	// GetUserByUsername retrieves a user from the database by username.
 	GetUserByUsername(ctx context.Context, username string) (*model.User, error)
+	// ListUsers retrieves all users from the database.
+	ListUsers(ctx context.Context) ([]*model.User, error)
+	// UpdateUserRole updates a user's role in the database.
+	UpdateUserRole(ctx context.Context, id string, role model.UserRole) error
 	// UpdateUserUsername updates a user's username in the database.
 	UpdateUserUsername(ctx context.Context, id string, username string) error
 	// UpdateUserPassword updates a user's password in the database.
 	UpdateUserPassword(ctx context.Context, id string, password string) error
 	// UpdateUserSettings updates a user's settings in the database.
 	UpdateUserSettings(ctx context.Context, id string, settings *model.UserSettings) error
-	// DeleteUser deletes a user from the database.
+	// DeleteUser deletes a user from the database and transfers their
+	// websites to the owner.
 	DeleteUser(ctx context.Context, id string) error
 
+	// User identities
+	// CreateUserWithIdentity adds a new user linked to their account at an identity provider.
+	CreateUserWithIdentity(ctx context.Context, user *model.User, identity *model.UserIdentity) error
+	// GetUserByIdentity retrieves the user linked to an account at an identity provider.
+	GetUserByIdentity(ctx context.Context, issuer string, subject string) (*model.User, error)
+
+	// Website members
+	// HasWebsiteAccess reports whether a user can access a website.
+	HasWebsiteAccess(ctx context.Context, userID string, hostname string) (bool, error)
+	// ListUserWebsites retrieves the hostnames of the websites a user is a member of.
+	ListUserWebsites(ctx context.Context, userID string) ([]string, error)
+	// SetUserWebsites replaces the websites a user is a member of.
+	SetUserWebsites(ctx context.Context, userID string, hostnames []string) error
+
 	// Websites
 	// CreateWebsite adds a new website to the database.
 	CreateWebsite(ctx context.Context, website *model.Website) error
-	// ListWebsites retrieves a list of websites from the database.
+	// ListWebsites retrieves the websites a user can access from the database.
 	ListWebsites(ctx context.Context, userID string) ([]*model.Website, error)
 	// ListAllHostnames returns all hostnames from the database.
 	ListAllHostnames(ctx context.Context) ([]string, error)
-	// UpdateWebsite updates a website in the database.
-	UpdateWebsite(ctx context.Context, website *model.Website) error
+	// UpdateWebsite updates a website in the database by its current hostname.
+	UpdateWebsite(ctx context.Context, hostname string, website *model.Website) error
+	// MergeWebsite moves the goals, report subscriptions and webhooks of a
+	// website into another and deletes it. Members and scoped API keys are
+	// only moved if moveAccess is set and are otherwise removed.
+	MergeWebsite(
+		ctx context.Context,
+		hostname string,
+		into string,
+		moveAccess bool,
+	) (*model.WebsiteAccess, error)
 	// GetWebsite retrieves a website from the database by id.
 	GetWebsite(ctx context.Context, id string) (*model.Website, error)
-	// DeleteWebsite deletes a website from the database.
-	DeleteWebsite(ctx context.Context, id string) error
+	// DeleteWebsite deletes a website from the database and queues the
+	// deletion of its analytics data.
+	DeleteWebsite(ctx context.Context, deletion *model.WebsiteDeletion) error
+	// GetWebsiteDeletion retrieves a pending website deletion by hostname.
+	GetWebsiteDeletion(ctx context.Context, hostname string) (*model.WebsiteDeletion, error)
+	// ListWebsiteDeletions retrieves all pending website deletions.
+	ListWebsiteDeletions(ctx context.Context) ([]*model.WebsiteDeletion, error)
+	// UpdateWebsiteDeletion updates the progress of a website deletion.
+	UpdateWebsiteDeletion(ctx context.Context, deletion *model.WebsiteDeletion) error
+	// CompleteWebsiteDeletion removes a website deletion once its data is purged.
+	CompleteWebsiteDeletion(ctx context.Context, hostname string) error
+	// GetWebsiteSettings retrieves the settings of a website from the database.
+	GetWebsiteSettings(ctx context.Context, hostname string) (*model.WebsiteSettings, error)
+	// ListWebsiteSettings retrieves the settings of all websites keyed by hostname.
+	ListWebsiteSettings(ctx context.Context) (map[string]*model.WebsiteSettings, error)
+	// UpdateWebsiteSettings updates the settings of a website in the database.
+	UpdateWebsiteSettings(ctx context.Context, hostname string, settings *model.WebsiteSettings) error
+
+	// Goals
+	// CreateGoal adds a new goal to the database.
+	CreateGoal(ctx context.Context, goal *model.Goal) error
+	// ListGoals retrieves all goals for a website from the database.
+	ListGoals(ctx context.Context, hostname string) ([]*model.Goal, error)
+	// GetGoal retrieves a goal from the database by id.
+	GetGoal(ctx context.Context, id string) (*model.Goal, error)
+	// UpdateGoal updates a goal in the database.
+	UpdateGoal(ctx context.Context, goal *model.Goal) error
+	// DeleteGoal deletes a goal from the database.
+	DeleteGoal(ctx context.Context, id string) error
+
+	// API keys
+	// CreateAPIKey adds a new API key to the database.
+	CreateAPIKey(ctx context.Context, key *model.APIKey) error
+	// ListAPIKeys retrieves all API keys of a user from the database.
+	ListAPIKeys(ctx context.Context, userID string) ([]*model.APIKey, error)
+	// GetAPIKey retrieves an API key from the database by id.
+	GetAPIKey(ctx context.Context, id string) (*model.APIKey, error)
+	// UpdateAPIKeyLastUsed updates the last used date of an API key.
+	UpdateAPIKeyLastUsed(ctx context.Context, id string, dateLastUsed int64) error
+	// DeleteAPIKey deletes an API key of a user from the database.
+	DeleteAPIKey(ctx context.Context, userID string, id string) error
+
+	// Sessions
+	// CreateSession adds a new session to the database.
+	CreateSession(ctx context.Context, session *model.Session) error
+	// GetSession retrieves an unexpired session from the database by id.
+	GetSession(ctx context.Context, id string) (*model.Session, error)
+	// ListSessions retrieves all unexpired sessions of a user from the database.
+	ListSessions(ctx context.Context, userID string) ([]*model.Session, error)
+	// DeleteSession deletes a session from the database.
+	DeleteSession(ctx context.Context, id string) error
+	// DeleteUserSessions deletes all sessions of a user from the database.
+	DeleteUserSessions(ctx context.Context, userID string) error
+	// GetOrCreateSecret retrieves a persisted secret, storing the given value
+	// if it does not exist yet.
+	GetOrCreateSecret(ctx context.Context, name string, value []byte) ([]byte, error)
+
+	// Two-factor authentication
+	// SetUserTOTP starts two-factor enrolment of a user in the database.
+	SetUserTOTP(ctx context.Context, totp *model.UserTOTP) error
+	// GetUserTOTP retrieves the two-factor enrolment of a user from the database.
+	GetUserTOTP(ctx context.Context, userID string) (*model.UserTOTP, error)
+	// EnableUserTOTP confirms two-factor enrolment and replaces the recovery codes of a user.
+	EnableUserTOTP(
+		ctx context.Context,
+		userID string,
+		step int64,
+		recoveryCodeHashes []string,
+		dateUpdated int64,
+	) error
+	// UpdateUserTOTPLastStep records the time step of an accepted code.
+	UpdateUserTOTPLastStep(ctx context.Context, userID string, step int64) error
+	// UseRecoveryCode marks an unused recovery code of a user as used.
+	UseRecoveryCode(ctx context.Context, userID string, hash string, dateUsed int64) error
+	// CountRecoveryCodes returns the number of unused recovery codes of a user.
+	CountRecoveryCodes(ctx context.Context, userID string) (int, error)
+	// DeleteUserTOTP disables two-factor authentication of a user.
+	DeleteUserTOTP(ctx context.Context, userID string) error
 
 	// Tenant settings
 	// GetTenantSettings returns current tenant settings from the database.
 	GetTenantSettings(ctx context.Context) (*model.TenantSettings, error)
 	// UpdateTenantSettings insert or updates tenant settings in the database.
 	UpdateTenantSettings(ctx context.Context, settings *UpdateTenantSettings) error
+
+	// Report subscriptions
+	// CreateReportSubscription adds a new report subscription to the database.
+	CreateReportSubscription(ctx context.Context, sub *model.ReportSubscription) error
+	// ListReportSubscriptions retrieves all report subscriptions of a user from the database.
+	ListReportSubscriptions(ctx context.Context, userID string) ([]*model.ReportSubscription, error)
+	// ListAllReportSubscriptions retrieves the report subscriptions of all users from the database.
+	ListAllReportSubscriptions(ctx context.Context) ([]*model.ReportSubscription, error)
+	// GetReportSubscription retrieves a report subscription from the database by id.
+	GetReportSubscription(ctx context.Context, id string) (*model.ReportSubscription, error)
+	// UpdateReportSubscription updates a report subscription of a user in the database.
+	UpdateReportSubscription(ctx context.Context, sub *model.ReportSubscription) error
+	// UpdateReportLastSent records the end of the period of the last sent report.
+	UpdateReportLastSent(ctx context.Context, id string, dateLastSent int64) error
+	// DeleteReportSubscription deletes a report subscription of a user from the database.
+	DeleteReportSubscription(ctx context.Context, userID string, id string) error
+
+	// Webhooks
+	// CreateWebhook adds a new webhook to the database.
+	CreateWebhook(ctx context.Context, webhook *model.Webhook) error
+	// ListWebhooks retrieves all webhooks of a website from the database.
+	ListWebhooks(ctx context.Context, hostname string) ([]*model.Webhook, error)
+	// ListAllWebhooks retrieves the webhooks of all websites from the database.
+	ListAllWebhooks(ctx context.Context) ([]*model.Webhook, error)
+	// GetWebhook retrieves a webhook of a website from the database by id.
+	GetWebhook(ctx context.Context, hostname string, id string) (*model.Webhook, error)
+	// UpdateWebhookLastChecked records the end of the period last checked for new referrers.
+	UpdateWebhookLastChecked(ctx context.Context, id string, dateLastChecked int64) error
+	// UpdateWebhookLastTriggered records when a webhook was last sent.
+	UpdateWebhookLastTriggered(ctx context.Context, id string, dateLastTriggered int64) error
+	// DeleteWebhook deletes a webhook of a website from the database.
+	DeleteWebhook(ctx context.Context, hostname string, id string) error
+	// CreateWebhookDelivery appends an entry to the delivery log of a webhook.
+	CreateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error
+	// ListWebhookDeliveries retrieves the delivery log of a webhook, newest first.
+	ListWebhookDeliveries(ctx context.Context, webhookID string, limit int, offset int) ([]*model.WebhookDelivery, error)
+	// DeleteWebhookDeliveriesBefore deletes delivery log entries created before a date.
+	DeleteWebhookDeliveriesBefore(ctx context.Context, before int64) (int64, error)
+
+	// Audit log
+	// CreateAuditEntry appends an entry to the audit log in the database.
+	CreateAuditEntry(ctx context.Context, entry *model.AuditEntry) error
+	// ListAuditEntries retrieves the audit log entries matching a filter, newest first.
+	ListAuditEntries(ctx context.Context, filter model.AuditFilter) ([]*model.AuditEntry, error)
 }
 
 // AnalyticsClient is the interface that groups all database operations related
@@ -63,9 +213,43 @@ type AnalyticsClient interface {
 	UpdatePageView(ctx context.Context, event *model.PageViewDuration) error
 	// Pages
 	GetWebsitePages(ctx context.Context, filter *Filters) ([]*model.StatsPages, error)
+	StreamWebsitePages(ctx context.Context, filter *Filters, fn func(*model.StatsPages) error) error
 	GetWebsitePagesSummary(ctx context.Context, filter *Filters) ([]*model.StatsPagesSummary, error)
+	// Hostnames
+	GetWebsiteHostnames(ctx context.Context, filter *Filters) ([]*model.StatsHostnames, error)
+	StreamWebsiteHostnames(
+		ctx context.Context,
+		filter *Filters,
+		fn func(*model.StatsHostnames) error,
+	) error
+	GetWebsiteHostnamesSummary(
+		ctx context.Context,
+		filter *Filters,
+	) ([]*model.StatsHostnamesSummary, error)
+	// Entry and exit pages
+	GetWebsiteVisitPages(
+		ctx context.Context,
+		isExit bool,
+		filter *Filters,
+	) ([]*model.StatsVisitPages, error)
+	StreamWebsiteVisitPages(
+		ctx context.Context,
+		isExit bool,
+		filter *Filters,
+		fn func(*model.StatsVisitPages) error,
+	) error
+	GetWebsiteVisitPagesSummary(
+		ctx context.Context,
+		isExit bool,
+		filter *Filters,
+	) ([]*model.StatsVisitPagesSummary, error)
 	// Locales
 	GetWebsiteCountries(ctx context.Context, filter *Filters) ([]*model.StatsCountries, error)
+	StreamWebsiteCountries(
+		ctx context.Context,
+		filter *Filters,
+		fn func(*model.StatsCountries) error,
+	) error
 	GetWebsiteCountriesSummary(
 		ctx context.Context,
 		filter *Filters,
@@ -75,6 +259,12 @@ type AnalyticsClient interface {
 		isLocale bool,
 		filter *Filters,
 	) ([]*model.StatsLanguages, error)
+	StreamWebsiteLanguages(
+		ctx context.Context,
+		isLocale bool,
+		filter *Filters,
+		fn func(*model.StatsLanguages) error,
+	) error
 	GetWebsiteLanguagesSummary(
 		ctx context.Context,
 		isLocale bool,
@@ -86,11 +276,19 @@ type AnalyticsClient interface {
 		isGroup bool,
 		filter *Filters,
 	) ([]*model.StatsReferrers, error)
+	StreamWebsiteReferrers(
+		ctx context.Context,
+		isGroup bool,
+		filter *Filters,
+		fn func(*model.StatsReferrers) error,
+	) error
 	GetWebsiteReferrersSummary(
 		ctx context.Context,
 		isGroup bool,
 		filter *Filters,
 	) ([]*model.StatsReferrerSummary, error)
+	// GetNewReferrers returns the referrers first seen within a period.
+	GetNewReferrers(ctx context.Context, hostname string, since time.Time, until time.Time) ([]string, error)
 	// Summary
 	GetWebsiteSummary(ctx context.Context, filter *Filters) (*model.StatsSummarySingle, error)
 	GetWebsiteIntervals(
@@ -102,34 +300,78 @@ type AnalyticsClient interface {
 		ctx context.Context,
 		hostname string,
 	) (*model.StatsSummaryLast24Hours, error)
+	// Realtime
+	GetRealtimeHits(
+		ctx context.Context,
+		minutes int,
+	) (map[string][]model.RealtimeHit, error)
+	// Funnel
+	GetWebsiteFunnel(
+		ctx context.Context,
+		filter *Filters,
+		steps []model.FunnelStep,
+	) ([]*model.StatsFunnel, error)
+	// Goals
+	GetWebsiteGoals(
+		ctx context.Context,
+		filter *Filters,
+		goals []*model.Goal,
+	) ([]*model.StatsGoals, error)
 	// Time
 	GetWebsiteTime(ctx context.Context, filter *Filters) ([]*model.StatsTime, error)
+	StreamWebsiteTime(ctx context.Context, filter *Filters, fn func(*model.StatsTime) error) error
 	GetWebsiteTimeSummary(ctx context.Context, filter *Filters) ([]*model.StatsTimeSummary, error)
 	// Types
 	GetWebsiteBrowsers(ctx context.Context, filter *Filters) ([]*model.StatsBrowsers, error)
+	StreamWebsiteBrowsers(
+		ctx context.Context,
+		filter *Filters,
+		fn func(*model.StatsBrowsers) error,
+	) error
 	GetWebsiteBrowsersSummary(
 		ctx context.Context,
 		filter *Filters,
 	) ([]*model.StatsBrowsersSummary, error)
 	GetWebsiteOS(ctx context.Context, filter *Filters) ([]*model.StatsOS, error)
+	StreamWebsiteOS(ctx context.Context, filter *Filters, fn func(*model.StatsOS) error) error
 	GetWebsiteOSSummary(ctx context.Context, filter *Filters) ([]*model.StatsOSSummary, error)
 	GetWebsiteDevices(ctx context.Context, filter *Filters) ([]*model.StatsDevices, error)
+	StreamWebsiteDevices(
+		ctx context.Context,
+		filter *Filters,
+		fn func(*model.StatsDevices) error,
+	) error
 	GetWebsiteDevicesSummary(
 		ctx context.Context,
 		filter *Filters,
 	) ([]*model.StatsDevicesSummary, error)
 	// UTM
 	GetWebsiteUTMSources(ctx context.Context, filter *Filters) ([]*model.StatsUTMSources, error)
+	StreamWebsiteUTMSources(
+		ctx context.Context,
+		filter *Filters,
+		fn func(*model.StatsUTMSources) error,
+	) error
 	GetWebsiteUTMSourcesSummary(
 		ctx context.Context,
 		filter *Filters,
 	) ([]*model.StatsUTMSourcesSummary, error)
 	GetWebsiteUTMMediums(ctx context.Context, filter *Filters) ([]*model.StatsUTMMediums, error)
+	StreamWebsiteUTMMediums(
+		ctx context.Context,
+		filter *Filters,
+		fn func(*model.StatsUTMMediums) error,
+	) error
 	GetWebsiteUTMMediumsSummary(
 		ctx context.Context,
 		filter *Filters,
 	) ([]*model.StatsUTMMediumsSummary, error)
 	GetWebsiteUTMCampaigns(ctx context.Context, filter *Filters) ([]*model.StatsUTMCampaigns, error)
+	StreamWebsiteUTMCampaigns(
+		ctx context.Context,
+		filter *Filters,
+		fn func(*model.StatsUTMCampaigns) error,
+	) error
 	GetWebsiteUTMCampaignsSummary(
 		ctx context.Context,
 		filter *Filters,
@@ -139,4 +381,9 @@ type AnalyticsClient interface {
 		ctx context.Context,
 		filter *Filters,
 	) ([]*model.StatsCustomProperties, error)
+	StreamWebsiteCustomProperties(
+		ctx context.Context,
+		filter *Filters,
+		fn func(*model.StatsCustomProperties) error,
+	) error
 }
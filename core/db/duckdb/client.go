@@ -2,11 +2,13 @@ package duckdb
 
 import (
 	"context"
+	"strings"
 
 	"github.com/alphadose/haxmap"
 	"github.com/go-faster/errors"
 	"github.com/jmoiron/sqlx"
 	"github.com/medama-io/medama/db"
+	"github.com/medama-io/medama/model"
 )
 
 type Client struct {
@@ -46,6 +48,31 @@ func NewClient(host string) (*Client, error) {
 	}, nil
 }
 
+// NewReadOnlyClient opens the database file at path read-only.
+//
+// DuckDB only allows a single process to open a database file while it is
+// being written to, so model.ErrAnalyticsDatabaseInUse is returned while the
+// server is running instead of reading the live database files.
+func NewReadOnlyClient(path string) (*Client, error) {
+	client, err := NewClient(path + "?access_mode=READ_ONLY")
+	if err != nil {
+		if isLockError(err) {
+			return nil, errors.Wrap(model.ErrAnalyticsDatabaseInUse, "duckdb")
+		}
+
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// isLockError reports whether the database could not be opened because it is
+// already opened by another process, or by another client of this process.
+func isLockError(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "lock") || strings.Contains(msg, "different configuration")
+}
+
 // Close closes the database connection and any prepared statements.
 func (c *Client) Close() error {
 	// Close the statements.
@@ -1,6 +1,10 @@
 package duckdb
 
-import qb "github.com/medama-io/medama/db/duckdb/query"
+import (
+	"github.com/go-faster/errors"
+	"github.com/jmoiron/sqlx"
+	qb "github.com/medama-io/medama/db/duckdb/query"
+)
 
 // These are common query builder clauses.
 const (
@@ -23,6 +27,10 @@ const (
         , 4), 0)
 		ELSE 0 END AS bounce_rate`
 
+	// VisitIDStmt groups page views into visits. Page views recorded before visits
+	// were tracked are treated as single page visits.
+	VisitIDStmt = "COALESCE(views.visit_id, views.bid)"
+
 	// EventsJoinStmt is the join statement to join the events table.
 	EventsJoinStmt = "events USING (bid)"
 )
@@ -41,3 +49,45 @@ func TotalVisitorsCTE(whereClause string, isCustomEvent bool) qb.CTE {
 
 	return qb.NewCTE("total", query)
 }
+
+// scanRows scans each row of the query result and passes it to fn, stopping
+// at the first error. The rows are closed once done.
+func scanRows[T any](rows *sqlx.Rows, fn func(*T) error) error {
+	defer rows.Close()
+
+	for rows.Next() {
+		var row T
+
+		err := rows.StructScan(&row)
+		if err != nil {
+			return errors.Wrap(err, "db")
+		}
+
+		err = fn(&row)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := rows.Err()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
+
+// collectRows returns all rows passed to the callback of a streaming query.
+func collectRows[T any](stream func(fn func(*T) error) error) ([]*T, error) {
+	var rows []*T
+
+	err := stream(func(row *T) error {
+		rows = append(rows, row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
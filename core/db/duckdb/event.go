@@ -24,7 +24,9 @@ func (c *Client) AddEvents(ctx context.Context, events *[]model.EventHit) error
 const addPageViewStmt = `--sql
 		INSERT INTO views (
 			bid,
+			visit_id,
 			hostname,
+			original_hostname,
 			pathname,
 			is_unique_user,
 			is_unique_page,
@@ -42,7 +44,9 @@ const addPageViewStmt = `--sql
 			date_created
 		) VALUES (
 			?,
+			NULLIF(?, ''),
 			?,
+			NULLIF(?, ''),
 			?,
 			?,
 			?,
@@ -76,7 +80,9 @@ func (c *Client) AddPageView(
 
 		_, err = txStmt.ExecContext(ctx,
 			event.BID,
+			event.VisitID,
 			event.Hostname,
+			event.OriginalHostname,
 			event.Pathname,
 			event.IsUniqueUser,
 			event.IsUniquePage,
@@ -0,0 +1,132 @@
+package duckdb
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/model"
+)
+
+// ExportFormat is the file format of exported tables.
+type ExportFormat string
+
+const (
+	ExportFormatParquet ExportFormat = "parquet"
+	ExportFormatCSV     ExportFormat = "csv"
+	ExportFormatNDJSON  ExportFormat = "ndjson"
+)
+
+// ExportFilter restricts the exported rows to a website and period.
+type ExportFilter struct {
+	Hostname string
+	// Start is inclusive and ignored if zero.
+	Start time.Time
+	// End is exclusive and ignored if zero.
+	End time.Time
+}
+
+// IsValid reports whether the format is supported.
+func (f ExportFormat) IsValid() bool {
+	_, err := f.copyOptions()
+	return err == nil
+}
+
+// copyOptions returns the DuckDB COPY options for the format.
+func (f ExportFormat) copyOptions() (string, error) {
+	switch f {
+	case ExportFormatParquet:
+		return "FORMAT parquet, COMPRESSION zstd", nil
+	case ExportFormatCSV:
+		return "FORMAT csv, HEADER true", nil
+	case ExportFormatNDJSON:
+		// DuckDB writes JSON as newline delimited records by default.
+		return "FORMAT json", nil
+	default:
+		return "", model.ErrInvalidExportFormat
+	}
+}
+
+// ExportViews writes the page views matching the filter to the file at path.
+// It returns the number of exported rows.
+func (c *Client) ExportViews(
+	ctx context.Context,
+	path string,
+	format ExportFormat,
+	filter ExportFilter,
+) (int64, error) {
+	return c.exportTable(ctx, "views", "hostname", path, format, filter)
+}
+
+// ExportEvents writes the custom events matching the filter to the file at
+// path. It returns the number of exported rows.
+func (c *Client) ExportEvents(
+	ctx context.Context,
+	path string,
+	format ExportFormat,
+	filter ExportFilter,
+) (int64, error) {
+	return c.exportTable(ctx, "events", "group_name", path, format, filter)
+}
+
+// exportTable copies the rows of the table matching the filter to a file.
+//
+// COPY does not support prepared statement parameters, so values are inlined
+// as escaped string literals.
+func (c *Client) exportTable(
+	ctx context.Context,
+	table string,
+	hostnameColumn string,
+	path string,
+	format ExportFormat,
+	filter ExportFilter,
+) (int64, error) {
+	options, err := format.copyOptions()
+	if err != nil {
+		return 0, err
+	}
+
+	var query strings.Builder
+	query.WriteString("COPY (SELECT * FROM ")
+	query.WriteString(table)
+	query.WriteString(" WHERE ")
+	query.WriteString(hostnameColumn)
+	query.WriteString(" = ")
+	query.WriteString(quoteLiteral(filter.Hostname))
+
+	if !filter.Start.IsZero() {
+		query.WriteString(" AND date_created >= CAST(")
+		query.WriteString(quoteLiteral(filter.Start.UTC().Format(time.RFC3339)))
+		query.WriteString(" AS TIMESTAMPTZ)")
+	}
+
+	if !filter.End.IsZero() {
+		query.WriteString(" AND date_created < CAST(")
+		query.WriteString(quoteLiteral(filter.End.UTC().Format(time.RFC3339)))
+		query.WriteString(" AS TIMESTAMPTZ)")
+	}
+
+	query.WriteString(" ORDER BY date_created) TO ")
+	query.WriteString(quoteLiteral(path))
+	query.WriteString(" (")
+	query.WriteString(options)
+	query.WriteString(")")
+
+	res, err := c.ExecContext(ctx, query.String())
+	if err != nil {
+		return 0, errors.Wrap(err, "db")
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db")
+	}
+
+	return rows, nil
+}
+
+// quoteLiteral returns the value as a single quoted SQL string literal.
+func quoteLiteral(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
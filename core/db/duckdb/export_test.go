@@ -0,0 +1,182 @@
+package duckdb_test
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	assert, require, ctx, client := SetupDatabase(t)
+
+	views := []struct {
+		bid      string
+		hostname string
+		date     string
+	}{
+		{"bid_1", "1.example.com", "2024-01-01 12:00:00+00"},
+		{"bid_2", "1.example.com", "2024-01-02 12:00:00+00"},
+		{"bid_3", "1.example.com", "2024-01-03 12:00:00+00"},
+		{"bid_4", "2.example.com", "2024-01-02 12:00:00+00"},
+	}
+
+	for _, view := range views {
+		_, err := client.ExecContext(ctx, `--sql
+			INSERT INTO views (bid, hostname, pathname, is_unique_user, is_unique_page,
+				ua_browser, ua_os, ua_device_type, date_created)
+			VALUES (?, ?, '/', true, true, 'Chrome', 'Windows', 'Desktop', CAST(? AS TIMESTAMPTZ))`,
+			view.bid, view.hostname, view.date)
+		require.NoError(err)
+
+		_, err = client.ExecContext(ctx, `--sql
+			INSERT INTO events (bid, batch_id, group_name, name, value, date_created)
+			VALUES (?, ?, ?, 'signup', 'it''s free', CAST(? AS TIMESTAMPTZ))`,
+			view.bid, "batch_"+view.bid, view.hostname, view.date)
+		require.NoError(err)
+	}
+
+	filter := duckdb.ExportFilter{
+		Hostname: "1.example.com",
+		Start:    time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		End:      time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+	}
+
+	readers := map[duckdb.ExportFormat]string{
+		duckdb.ExportFormatParquet: "read_parquet",
+		duckdb.ExportFormatCSV:     "read_csv",
+		duckdb.ExportFormatNDJSON:  "read_json",
+	}
+
+	for format, reader := range readers {
+		// Paths with quotes are escaped.
+		dir := filepath.Join(t.TempDir(), "it's")
+		require.NoError(os.Mkdir(dir, 0o755))
+
+		viewsPath := filepath.Join(dir, "views."+string(format))
+		rows, err := client.ExportViews(ctx, viewsPath, format, filter)
+		require.NoError(err)
+		assert.Equal(int64(2), rows, format)
+
+		eventsPath := filepath.Join(dir, "events."+string(format))
+		rows, err = client.ExportEvents(ctx, eventsPath, format, filter)
+		require.NoError(err)
+		assert.Equal(int64(2), rows, format)
+
+		var bids []string
+		err = client.SelectContext(ctx, &bids, "SELECT bid FROM "+reader+"(?) ORDER BY bid", viewsPath)
+		require.NoError(err, format)
+		assert.Equal([]string{"bid_2", "bid_3"}, bids, format)
+
+		var values []string
+		err = client.SelectContext(ctx, &values, "SELECT value FROM "+reader+"(?)", eventsPath)
+		require.NoError(err, format)
+		assert.Equal([]string{"it's free", "it's free"}, values, format)
+	}
+
+	// All time export.
+	rows, err := client.ExportViews(ctx, filepath.Join(t.TempDir(), "views.csv"), duckdb.ExportFormatCSV,
+		duckdb.ExportFilter{Hostname: "1.example.com"})
+	require.NoError(err)
+	assert.Equal(int64(3), rows)
+
+	_, err = client.ExportViews(ctx, filepath.Join(t.TempDir(), "views.xml"), "xml", filter)
+	require.ErrorIs(err, model.ErrInvalidExportFormat)
+}
+
+// exportLockEnv is set to the database path to run TestExportLockHelper as a
+// separate process holding the database open.
+const exportLockEnv = "MEDAMA_TEST_EXPORT_LOCK_DB"
+
+// TestExportLockHelper opens the database read-write like a running server
+// and keeps it open until stdin is closed.
+func TestExportLockHelper(t *testing.T) {
+	path := os.Getenv(exportLockEnv)
+	if path == "" {
+		t.Skip("only run as a helper process")
+	}
+
+	client, err := duckdb.NewClient(path)
+	require.NoError(t, err)
+
+	defer client.Close()
+
+	// This page view is only written to the write-ahead log.
+	_, err = client.ExecContext(t.Context(), `--sql
+		INSERT INTO views VALUES ('bid_2', 'example.com', CAST('2024-01-02 12:00:00+00' AS TIMESTAMPTZ))`)
+	require.NoError(t, err)
+
+	//nolint: forbidigo // Signals the parent process.
+	println("ready")
+
+	_, _ = io.Copy(io.Discard, os.Stdin)
+}
+
+func TestExportWhileOpen(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := t.Context()
+
+	path := filepath.Join(t.TempDir(), "me_analytics.db")
+
+	client, err := duckdb.NewClient(path)
+	require.NoError(err)
+
+	_, err = client.ExecContext(ctx, `--sql
+		CREATE TABLE views (bid TEXT, hostname TEXT, date_created TIMESTAMPTZ);
+		CREATE TABLE events (bid TEXT, group_name TEXT, date_created TIMESTAMPTZ);
+		INSERT INTO views VALUES ('bid_1', 'example.com', CAST('2024-01-01 12:00:00+00' AS TIMESTAMPTZ));`)
+	require.NoError(err)
+	require.NoError(client.Close())
+
+	// Hold the database open in another process, as the server does.
+	cmd := exec.CommandContext(ctx, os.Args[0], "-test.run=^TestExportLockHelper$")
+	cmd.Env = append(os.Environ(), exportLockEnv+"="+path)
+
+	stdin, err := cmd.StdinPipe()
+	require.NoError(err)
+
+	stderr, err := cmd.StderrPipe()
+	require.NoError(err)
+	require.NoError(cmd.Start())
+
+	t.Cleanup(func() {
+		_ = stdin.Close()
+		_ = cmd.Wait()
+	})
+
+	line, err := bufio.NewReader(stderr).ReadString('\n')
+	require.NoError(err)
+	require.Equal("ready\n", line)
+
+	// The database is locked by the other process.
+	_, err = duckdb.NewClient(path + "?access_mode=READ_ONLY")
+	require.Error(err)
+
+	// The live database is never read while the server holds it open.
+	_, err = duckdb.NewReadOnlyClient(path)
+	require.ErrorIs(err, model.ErrAnalyticsDatabaseInUse)
+
+	// The database can be exported once the server is stopped, including the
+	// page views that were not checkpointed yet.
+	require.NoError(stdin.Close())
+	require.NoError(cmd.Wait())
+
+	readOnly, err := duckdb.NewReadOnlyClient(path)
+	require.NoError(err)
+
+	out := filepath.Join(t.TempDir(), "views.csv")
+	rows, err := readOnly.ExportViews(ctx, out, duckdb.ExportFormatCSV,
+		duckdb.ExportFilter{Hostname: "example.com"})
+	require.NoError(err)
+	assert.Equal(int64(2), rows)
+	require.NoError(readOnly.Close())
+}
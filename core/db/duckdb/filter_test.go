@@ -0,0 +1,112 @@
+package duckdb_test
+
+import (
+	"testing"
+
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db"
+	"github.com/medama-io/medama/model"
+)
+
+func TestFilterIn(t *testing.T) {
+	_, require, ctx, client := UseDatabaseFixture(t, SimpleFixture)
+
+	testCases := generateFilterIn(MediumHostname)
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			pages, err := client.GetWebsitePages(ctx, tc.Filters)
+			require.NoError(err)
+
+			snap := NewSnapRecords(pages)
+			snaps.MatchSnapshot(t, snap.Snapshot())
+		})
+	}
+}
+
+func TestFilterInProperties(t *testing.T) {
+	_, require, ctx, client := SetupDatabase(t)
+
+	hostname := "1.example.com"
+	properties := []struct {
+		bid   string
+		name  string
+		value string
+	}{
+		{"bid_1", "plan", "free"},
+		{"bid_2", "plan", "pro"},
+		{"bid_3", "plan", "enterprise"},
+		{"bid_4", "theme", "dark"},
+		{"bid_5", "theme", "light"},
+		{"bid_6", "signup", "true"},
+	}
+
+	for _, property := range properties {
+		err := client.AddPageView(ctx, &model.PageViewHit{
+			BID:          property.bid,
+			Hostname:     hostname,
+			Pathname:     "/",
+			IsUniqueUser: true,
+			IsUniquePage: true,
+		}, &[]model.EventHit{{
+			BID:     property.bid,
+			BatchID: "batch_" + property.bid,
+			Group:   hostname,
+			Name:    property.name,
+			Value:   property.value,
+		}})
+		require.NoError(err)
+	}
+
+	testCases := []struct {
+		Name          string
+		PropertyName  api.FilterString
+		PropertyValue api.FilterString
+	}{
+		{
+			Name:         "NameIn",
+			PropertyName: api.FilterString{In: api.NewOptString("Plan,Theme")},
+		},
+		{
+			Name:         "NameNotIn",
+			PropertyName: api.FilterString{NotIn: api.NewOptString("plan, theme")},
+		},
+		{
+			Name:          "ValueIn",
+			PropertyName:  api.FilterString{Eq: api.NewOptString("plan")},
+			PropertyValue: api.FilterString{In: api.NewOptString("free,pro")},
+		},
+		{
+			Name:          "ValueNotIn",
+			PropertyName:  api.FilterString{Eq: api.NewOptString("plan")},
+			PropertyValue: api.FilterString{NotIn: api.NewOptString("free,pro")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			filters := &db.Filters{
+				Hostname:         hostname,
+				PeriodStart:      TimeStart,
+				PeriodEnd:        TimeEnd,
+				PropertyName:     db.NewFilter(db.FilterPropertyName, api.NewOptFilterString(tc.PropertyName)),
+				SortByEventDates: true,
+				IsCustomEvent:    true,
+			}
+
+			if tc.PropertyValue.In.IsSet() || tc.PropertyValue.NotIn.IsSet() {
+				filters.PropertyValue = db.NewFilter(
+					db.FilterPropertyValue,
+					api.NewOptFilterString(tc.PropertyValue),
+				)
+			}
+
+			result, err := client.GetWebsiteCustomProperties(ctx, filters)
+			require.NoError(err)
+
+			snap := NewSnapRecords(result)
+			snaps.MatchSnapshot(t, snap.Snapshot())
+		})
+	}
+}
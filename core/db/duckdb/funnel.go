@@ -0,0 +1,162 @@
+package duckdb
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db"
+	"github.com/medama-io/medama/model"
+)
+
+// GetWebsiteFunnel returns the number of visits reaching each step of the
+// funnel in order and how many dropped off between steps.
+func (c *Client) GetWebsiteFunnel(
+	ctx context.Context,
+	filter *db.Filters,
+	steps []model.FunnelStep,
+) ([]*model.StatsFunnel, error) {
+	if len(steps) == 0 {
+		return nil, model.ErrInvalidParameter
+	}
+
+	query, args, err := funnelQuery(filter, steps)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.NamedQueryContext(ctx, query, filter.Args(&args))
+	if err != nil {
+		return nil, errors.Wrap(err, "db")
+	}
+	defer rows.Close()
+
+	var resp []*model.StatsFunnel
+
+	for rows.Next() {
+		var step model.StatsFunnel
+
+		err := rows.StructScan(&step)
+		if err != nil {
+			return nil, errors.Wrap(err, "db")
+		}
+
+		resp = append(resp, &step)
+	}
+
+	return resp, nil
+}
+
+// funnelQuery builds the funnel query for the given steps.
+//
+// Visits are the page views matching the filters grouped by visit ID.
+//
+// Matches are every page view or custom event within those visits matching any
+// of the steps, along with the step index and when it happened.
+//
+// Each step_N CTE then keeps the first match of step N per visit that happened
+// at or after the visit reached the previous step, so a visit only reaches a
+// step if it completed all previous steps in order.
+//
+// Finally, window functions compare each step to the first and previous steps
+// to calculate the conversion and drop off rates.
+func funnelQuery(filter *db.Filters, steps []model.FunnelStep) (string, map[string]any, error) {
+	var query strings.Builder
+
+	args := map[string]any{}
+
+	query.WriteString("WITH visits AS MATERIALIZED (SELECT DISTINCT views.bid, ")
+	query.WriteString(VisitIDStmt)
+	query.WriteString(" AS visit_id, views.pathname, views.date_created FROM views")
+
+	if filter.IsCustomEvent {
+		query.WriteString(" LEFT JOIN ")
+		query.WriteString(EventsJoinStmt)
+	}
+
+	query.WriteString(" WHERE ")
+	query.WriteString(filter.WhereString())
+	query.WriteString("), matches AS MATERIALIZED (")
+
+	for idx, step := range steps {
+		key := "step_" + strconv.Itoa(idx)
+
+		if idx > 0 {
+			query.WriteString(" UNION ALL ")
+		}
+
+		switch step.Type {
+		case model.GoalTypePathname:
+			args[key+"_pathname"] = step.Pathname
+
+			query.WriteString("SELECT visit_id, ")
+			query.WriteString(strconv.Itoa(idx))
+			query.WriteString(" AS step, date_created FROM visits WHERE pathname GLOB :")
+			query.WriteString(key + "_pathname")
+		case model.GoalTypeEvent:
+			args[key+"_event_name"] = step.EventName
+
+			query.WriteString("SELECT visits.visit_id, ")
+			query.WriteString(strconv.Itoa(idx))
+			query.WriteString(" AS step, step_events.date_created FROM events AS step_events")
+			query.WriteString(" JOIN visits ON step_events.bid = visits.bid")
+			query.WriteString(" WHERE step_events.group_name = :hostname AND step_events.name = :")
+			query.WriteString(key + "_event_name")
+
+			if step.EventValue != "" {
+				args[key+"_event_value"] = step.EventValue
+
+				query.WriteString(" AND step_events.value = :")
+				query.WriteString(key + "_event_value")
+			}
+		default:
+			return "", nil, model.ErrInvalidParameter
+		}
+	}
+
+	query.WriteString(")")
+
+	for idx := range steps {
+		stepCTE := "step_" + strconv.Itoa(idx)
+
+		query.WriteString(", ")
+		query.WriteString(stepCTE)
+		query.WriteString(" AS MATERIALIZED (SELECT matches.visit_id, MIN(matches.date_created) AS date_created FROM matches")
+
+		if idx > 0 {
+			prevCTE := "step_" + strconv.Itoa(idx-1)
+			query.WriteString(" JOIN " + prevCTE + " ON matches.visit_id = " + prevCTE + ".visit_id")
+			query.WriteString(" AND matches.date_created >= " + prevCTE + ".date_created")
+		}
+
+		query.WriteString(" WHERE matches.step = ")
+		query.WriteString(strconv.Itoa(idx))
+		query.WriteString(" GROUP BY matches.visit_id)")
+	}
+
+	query.WriteString(", counts AS (")
+
+	for idx := range steps {
+		if idx > 0 {
+			query.WriteString(" UNION ALL ")
+		}
+
+		query.WriteString("SELECT ")
+		query.WriteString(strconv.Itoa(idx + 1))
+		query.WriteString(" AS step, COUNT(*) AS visits FROM step_")
+		query.WriteString(strconv.Itoa(idx))
+	}
+
+	query.WriteString(`) SELECT
+		step,
+		visits,
+		ifnull(ROUND(visits / NULLIF(FIRST_VALUE(visits) OVER (ORDER BY step), 0), 4), 0) AS conversion_rate,
+		ifnull(LAG(visits) OVER (ORDER BY step) - visits, 0) AS dropoff,
+		ifnull(ROUND(
+			(LAG(visits) OVER (ORDER BY step) - visits) / NULLIF(LAG(visits) OVER (ORDER BY step), 0)
+		, 4), 0) AS dropoff_rate
+	FROM counts ORDER BY step`)
+
+	return query.String(), args, nil
+}
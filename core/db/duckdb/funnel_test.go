@@ -0,0 +1,109 @@
+package duckdb_test
+
+import (
+	"testing"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db"
+	"github.com/medama-io/medama/model"
+)
+
+func TestGetWebsiteFunnel(t *testing.T) {
+	assert, require, ctx, client := SetupDatabase(t)
+
+	hostname := "1.example.com"
+	views := []struct {
+		bid      string
+		visitID  any
+		pathname string
+		browser  string
+		minute   int
+	}{
+		// Completes every step.
+		{"bid_1", "visit_1", "/", "Chrome", 0},
+		{"bid_2", "visit_1", "/pricing", "Chrome", 1},
+		{"bid_3", "visit_1", "/signup", "Chrome", 2},
+		// Visits pricing before the landing page, so only reaches the first step.
+		{"bid_4", "visit_2", "/pricing", "Firefox", 0},
+		{"bid_5", "visit_2", "/", "Firefox", 1},
+		// Reaches pricing but never signs up.
+		{"bid_6", "visit_3", "/", "Firefox", 0},
+		{"bid_7", "visit_3", "/pricing/pro", "Firefox", 1},
+		// Page views from before visits were tracked are single page visits.
+		{"bid_8", nil, "/", "Chrome", 0},
+		{"bid_9", nil, "/pricing", "Chrome", 1},
+	}
+
+	for _, view := range views {
+		_, err := client.ExecContext(ctx, `--sql
+			INSERT INTO views (bid, visit_id, hostname, pathname, is_unique_user, is_unique_page,
+				ua_browser, ua_os, ua_device_type, date_created)
+			VALUES (?, ?, ?, ?, true, true, ?, 'Windows', 'Desktop',
+				TIMESTAMPTZ '2024-01-01 00:00:00+00' + to_minutes(?))`,
+			view.bid, view.visitID, hostname, view.pathname, view.browser, view.minute)
+		require.NoError(err)
+	}
+
+	_, err := client.ExecContext(ctx, `--sql
+		INSERT INTO events (bid, batch_id, group_name, name, value, date_created)
+		VALUES ('bid_3', 'batch_1', ?, 'signup', 'pro', TIMESTAMPTZ '2024-01-01 00:02:00+00')`,
+		hostname)
+	require.NoError(err)
+
+	steps := []model.FunnelStep{
+		{Type: model.GoalTypePathname, Pathname: "/"},
+		{Type: model.GoalTypePathname, Pathname: "/pricing*"},
+		{Type: model.GoalTypeEvent, EventName: "signup", EventValue: "pro"},
+	}
+
+	filters := &db.Filters{
+		Hostname:    hostname,
+		PeriodStart: TimeStart,
+		PeriodEnd:   TimeEnd,
+	}
+
+	funnel, err := client.GetWebsiteFunnel(ctx, filters, steps)
+	require.NoError(err)
+	require.Len(funnel, 3)
+
+	assert.Equal(model.StatsFunnel{Step: 1, Visits: 4, ConversionRate: 1}, *funnel[0])
+	assert.Equal(model.StatsFunnel{
+		Step: 2, Visits: 2, ConversionRate: 0.5, Dropoff: 2, DropoffRate: 0.5,
+	}, *funnel[1])
+	assert.Equal(model.StatsFunnel{
+		Step: 3, Visits: 1, ConversionRate: 0.25, Dropoff: 1, DropoffRate: 0.5,
+	}, *funnel[2])
+
+	// Dimension filters restrict the visits counted.
+	filters.Browser = db.NewFilter(
+		db.FilterBrowser,
+		api.NewOptFilterString(api.FilterString{Eq: api.NewOptString("Firefox")}),
+	)
+
+	funnel, err = client.GetWebsiteFunnel(ctx, filters, steps)
+	require.NoError(err)
+	require.Len(funnel, 3)
+
+	assert.Equal(2, funnel[0].Visits)
+	assert.Equal(1, funnel[1].Visits)
+	assert.Equal(0, funnel[2].Visits)
+	assert.InDelta(1, funnel[2].DropoffRate, 0.0001)
+}
+
+func TestGetWebsiteFunnelEmpty(t *testing.T) {
+	assert, require, ctx, client := SetupDatabase(t)
+
+	filters := &db.Filters{Hostname: "1.example.com"}
+
+	funnel, err := client.GetWebsiteFunnel(ctx, filters, []model.FunnelStep{
+		{Type: model.GoalTypePathname, Pathname: "/"},
+		{Type: model.GoalTypeEvent, EventName: "signup"},
+	})
+	require.NoError(err)
+	require.Len(funnel, 2)
+	assert.Equal(model.StatsFunnel{Step: 1}, *funnel[0])
+	assert.Equal(model.StatsFunnel{Step: 2}, *funnel[1])
+
+	_, err = client.GetWebsiteFunnel(ctx, filters, nil)
+	require.ErrorIs(err, model.ErrInvalidParameter)
+}
@@ -0,0 +1,170 @@
+package duckdb
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db"
+	qb "github.com/medama-io/medama/db/duckdb/query"
+	"github.com/medama-io/medama/model"
+)
+
+// ConversionRateStmt is the share of unique visitors that converted.
+// This expects a CTE named total with a total_visitors column to be present.
+const ConversionRateStmt = "ifnull(ROUND(converters / (SELECT total_visitors FROM total), 4), 0) AS conversion_rate"
+
+// convertersStmt counts the distinct visits in the given column that are in
+// the unique_visits CTE.
+func convertersStmt(visitID string) string {
+	return "COUNT(DISTINCT " + visitID + ") FILTER (WHERE " + visitID +
+		" IN (SELECT visit_id FROM unique_visits)) AS converters"
+}
+
+// uniqueVisitsCTE declares a materialized CTE named unique_visits of the
+// visits matching the filters that contain a page view counted as a unique
+// visitor.
+//
+// Each of these page views belongs to a single visit, so counting converting
+// visits out of these counts each converting visitor once and the conversion
+// rate can never exceed 1. Without visit tracking each page view is its own
+// visit, so only the page view counted as the unique visitor can convert.
+func uniqueVisitsCTE(filter *db.Filters) qb.CTE {
+	query := qb.New().
+		Select("DISTINCT " + VisitIDStmt + " AS visit_id").
+		From("views").
+		Where(filter.WhereString() + " AND views.is_unique_user = true")
+
+	if filter.IsCustomEvent {
+		query = query.
+			LeftJoin(EventsJoinStmt)
+	}
+
+	return qb.NewCTE("unique_visits", query)
+}
+
+// GetWebsiteGoals returns the conversion stats for each of the given goals.
+func (c *Client) GetWebsiteGoals(
+	ctx context.Context,
+	filter *db.Filters,
+	goals []*model.Goal,
+) ([]*model.StatsGoals, error) {
+	resp := make([]*model.StatsGoals, 0, len(goals))
+
+	for _, goal := range goals {
+		var query *qb.QueryBuilder
+
+		args := map[string]any{}
+
+		switch goal.Type {
+		case model.GoalTypePathname:
+			query = pathnameGoalQuery(filter)
+			args["goal_pathname"] = goal.Pathname
+		case model.GoalTypeEvent:
+			query = eventGoalQuery(filter, goal.EventValue != "")
+			args["goal_event_name"] = goal.EventName
+			args["goal_event_value"] = goal.EventValue
+		default:
+			return nil, model.ErrInvalidGoal
+		}
+
+		stats := model.StatsGoals{
+			ID:   goal.ID,
+			Name: goal.Name,
+		}
+
+		rows, err := c.NamedQueryContext(ctx, query.Build(), filter.Args(&args))
+		if err != nil {
+			return nil, errors.Wrap(err, "db")
+		}
+
+		if rows.Next() {
+			err = rows.StructScan(&stats)
+			if err != nil {
+				rows.Close()
+				return nil, errors.Wrap(err, "db")
+			}
+		}
+
+		rows.Close()
+
+		resp = append(resp, &stats)
+	}
+
+	return resp, nil
+}
+
+// pathnameGoalQuery counts the page views with a pathname matching the goal
+// glob pattern.
+//
+// Conversions are the number of matching page views.
+//
+// Converters are the number of unique visitors with a matching page view in
+// the visit they were counted in, see uniqueVisitsCTE.
+//
+// Page views are counted by distinct bid as joining custom events can return a
+// row per event property.
+func pathnameGoalQuery(filter *db.Filters) *qb.QueryBuilder {
+	query := qb.New().
+		WithMaterialized(TotalVisitorsCTE(filter.WhereString(), filter.IsCustomEvent)).
+		WithMaterialized(uniqueVisitsCTE(filter)).
+		Select(
+			"COUNT(DISTINCT bid) AS conversions",
+			convertersStmt(VisitIDStmt),
+			ConversionRateStmt,
+		).
+		From("views").
+		Where(filter.WhereString() + " AND pathname GLOB :goal_pathname")
+
+	if filter.IsCustomEvent {
+		query = query.
+			LeftJoin(EventsJoinStmt)
+	}
+
+	return query
+}
+
+// eventGoalQuery counts the custom events with a property matching the goal
+// name and optionally its value.
+//
+// Conversions are the number of distinct event batches.
+//
+// Converters are the number of unique visitors that sent a matching event in
+// the visit they were counted in, the same as for pathname goals.
+//
+// Only events linked to a page view matching the filters are counted so that
+// every page view dimension can break down the results.
+func eventGoalQuery(filter *db.Filters, matchValue bool) *qb.QueryBuilder {
+	where := "goal_events.group_name = :hostname AND goal_events.name = :goal_event_name"
+	if matchValue {
+		where += " AND goal_events.value = :goal_event_value"
+	}
+
+	return qb.New().
+		WithMaterialized(TotalVisitorsCTE(filter.WhereString(), filter.IsCustomEvent)).
+		WithMaterialized(uniqueVisitsCTE(filter)).
+		WithMaterialized(goalViewsCTE(filter)).
+		Select(
+			"COUNT(DISTINCT goal_events.batch_id) AS conversions",
+			convertersStmt("goal_views.visit_id"),
+			ConversionRateStmt,
+		).
+		From("events AS goal_events").
+		Join("goal_views USING (bid)").
+		Where(where)
+}
+
+// goalViewsCTE declares a materialized CTE named goal_views of the beacon IDs
+// of page views matching the filters and the visits they belong to.
+func goalViewsCTE(filter *db.Filters) qb.CTE {
+	query := qb.New().
+		Select("DISTINCT views.bid", VisitIDStmt+" AS visit_id").
+		From("views").
+		Where(filter.WhereString())
+
+	if filter.IsCustomEvent {
+		query = query.
+			LeftJoin(EventsJoinStmt)
+	}
+
+	return qb.NewCTE("goal_views", query)
+}
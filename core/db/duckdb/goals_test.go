@@ -0,0 +1,151 @@
+package duckdb_test
+
+import (
+	"testing"
+
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db"
+	"github.com/medama-io/medama/model"
+)
+
+func TestGetWebsiteGoals(t *testing.T) {
+	assert, require, ctx, client := SetupDatabase(t)
+
+	hostname := "1.example.com"
+	// bid_7 was recorded without visit tracking and is not the page view
+	// counted as the unique visitor, so it is not a converter.
+	views := []struct {
+		bid        string
+		visitID    string
+		pathname   string
+		browser    string
+		uniqueUser bool
+		uniquePage bool
+	}{
+		{"bid_1", "visit_1", "/", "Chrome", true, true},
+		{"bid_2", "visit_2", "/", "Firefox", true, true},
+		{"bid_3", "visit_1", "/pricing", "Chrome", false, true},
+		{"bid_4", "visit_1", "/thank-you", "Chrome", false, true},
+		{"bid_5", "visit_1", "/thank-you", "Chrome", false, false},
+		{"bid_6", "visit_3", "/thank-you/pro", "Firefox", true, true},
+		{"bid_7", "", "/thank-you", "Chrome", false, true},
+	}
+
+	for _, view := range views {
+		err := client.AddPageView(ctx, &model.PageViewHit{
+			BID:          view.bid,
+			VisitID:      view.visitID,
+			Hostname:     hostname,
+			Pathname:     view.pathname,
+			IsUniqueUser: view.uniqueUser,
+			IsUniquePage: view.uniquePage,
+			BrowserName:  view.browser,
+		}, nil)
+		require.NoError(err)
+	}
+
+	// Two signup events on the same page view and one on another page view.
+	// Signing up again later in the same visit is not a new converter.
+	err := client.AddEvents(ctx, &[]model.EventHit{
+		{BID: "bid_3", BatchID: "batch_1", Group: hostname, Name: "signup", Value: "free"},
+		{BID: "bid_5", BatchID: "batch_4", Group: hostname, Name: "signup", Value: "free"},
+		{BID: "bid_3", BatchID: "batch_2", Group: hostname, Name: "signup", Value: "pro"},
+		{BID: "bid_6", BatchID: "batch_3", Group: hostname, Name: "signup", Value: "pro"},
+		{BID: "bid_6", BatchID: "batch_3", Group: hostname, Name: "plan", Value: "pro"},
+	})
+	require.NoError(err)
+
+	goals := []*model.Goal{
+		model.NewGoal("goal_1", hostname, "Thank you", model.GoalTypePathname, "/thank-you*", "", "", 1, 1),
+		model.NewGoal("goal_2", hostname, "Signup", model.GoalTypeEvent, "", "signup", "", 1, 1),
+		model.NewGoal("goal_3", hostname, "Pro signup", model.GoalTypeEvent, "", "signup", "pro", 1, 1),
+		model.NewGoal("goal_4", hostname, "Missing", model.GoalTypePathname, "/missing", "", "", 1, 1),
+	}
+
+	filters := &db.Filters{
+		Hostname:    hostname,
+		PeriodStart: TimeStart,
+		PeriodEnd:   TimeEnd,
+	}
+
+	stats, err := client.GetWebsiteGoals(ctx, filters, goals)
+	require.NoError(err)
+	require.Len(stats, 4)
+
+	// 3 unique visitors in total.
+	assert.Equal(model.StatsGoals{
+		ID: "goal_1", Name: "Thank you", Conversions: 4, Converters: 2, ConversionRate: 0.6667,
+	}, *stats[0])
+	assert.Equal(model.StatsGoals{
+		ID: "goal_2", Name: "Signup", Conversions: 4, Converters: 2, ConversionRate: 0.6667,
+	}, *stats[1])
+	assert.Equal(model.StatsGoals{
+		ID: "goal_3", Name: "Pro signup", Conversions: 2, Converters: 2, ConversionRate: 0.6667,
+	}, *stats[2])
+	assert.Equal(model.StatsGoals{
+		ID: "goal_4", Name: "Missing",
+	}, *stats[3])
+
+	// Page view dimensions break down both goal types.
+	filters.Browser = db.NewFilter(
+		db.FilterBrowser,
+		api.NewOptFilterString(api.FilterString{Eq: api.NewOptString("Firefox")}),
+	)
+
+	stats, err = client.GetWebsiteGoals(ctx, filters, goals)
+	require.NoError(err)
+	require.Len(stats, 4)
+
+	// 2 unique visitors using Firefox.
+	assert.Equal(1, stats[0].Conversions)
+	assert.Equal(1, stats[0].Converters)
+	assert.InDelta(0.5, stats[0].ConversionRate, 0.0001)
+	assert.Equal(1, stats[1].Conversions)
+	assert.Equal(1, stats[1].Converters)
+	assert.Equal(1, stats[2].Conversions)
+
+	// Custom property filters only count page views with matching events.
+	filters.Browser = nil
+	filters.PropertyName = db.NewFilter(
+		db.FilterPropertyName,
+		api.NewOptFilterString(api.FilterString{Eq: api.NewOptString("plan")}),
+	)
+	filters.IsCustomEvent = true
+
+	stats, err = client.GetWebsiteGoals(ctx, filters, goals)
+	require.NoError(err)
+	require.Len(stats, 4)
+
+	assert.Equal(1, stats[0].Conversions)
+	assert.Equal(1, stats[1].Conversions)
+	assert.Equal(1, stats[1].Converters)
+}
+
+func TestGetWebsiteGoalsEmpty(t *testing.T) {
+	assert, require, ctx, client := SetupDatabase(t)
+
+	stats, err := client.GetWebsiteGoals(ctx, &db.Filters{Hostname: "1.example.com"}, nil)
+	require.NoError(err)
+	assert.NotNil(stats)
+	assert.Empty(stats)
+}
+
+func TestGetWebsiteGoalsFilterAll(t *testing.T) {
+	_, require, ctx, client := UseDatabaseFixture(t, SimpleFixture)
+
+	goals := []*model.Goal{
+		model.NewGoal("goal_about", MediumHostname, "About", model.GoalTypePathname, "/about", "", "", 1, 1),
+		model.NewGoal("goal_all", MediumHostname, "All", model.GoalTypePathname, "/*", "", "", 1, 1),
+	}
+
+	for _, filter := range generateFilterAll(MediumHostname) {
+		t.Run(filter.Name, func(t *testing.T) {
+			stats, err := client.GetWebsiteGoals(ctx, filter.Filters, goals)
+			require.NoError(err)
+
+			snap := NewSnapRecords(stats)
+			snaps.MatchSnapshot(t, snap.Snapshot())
+		})
+	}
+}
@@ -125,6 +125,7 @@ func SetupDatabase(
 		"duckdb",                       // userID
 		"duckdb@example.com",           // email
 		"testtest",                     // password
+		model.UserRoleOwner,            // role
 		model.NewDefaultUserSettings(), // settings
 		1,                              // dateCreated
 		2,                              // dateUpdated
@@ -286,6 +287,80 @@ func generateFilterAll(hostname string) []TestCase {
 	return filters
 }
 
+// Generate IN and NOT IN filters for each dimension individually.
+func generateFilterIn(hostname string) []TestCase {
+	filterSteps := []struct {
+		fieldName string
+		field     db.FilterField
+		values    string
+	}{
+		{"Browser", db.FilterBrowser, "Chrome,Firefox"},
+		{"Country", db.FilterCountry, "United Kingdom, Japan"},
+		{"Device", db.FilterDevice, "Desktop,Tablet"},
+		{"Language", db.FilterLanguage, "Japanese,British English"},
+		{"OS", db.FilterOS, "Windows,iOS"},
+		{"Pathname", db.FilterPathname, "/,/about"},
+		{"Referrer", db.FilterReferrer, "medama.io,google.com"},
+		{"UTMCampaign", db.FilterUTMCampaign, "summer,winter"},
+		{"UTMMedium", db.FilterUTMMedium, "cpc,organic"},
+		{"UTMSource", db.FilterUTMSource, "bing,twitter"},
+	}
+
+	filters := make([]TestCase, 0, 2*len(filterSteps))
+
+	for _, step := range filterSteps {
+		for _, operation := range []db.FilterOperation{db.FilterIn, db.FilterNotIn} {
+			filterString := api.FilterString{}
+			if operation == db.FilterIn {
+				filterString.In = api.NewOptString(step.values)
+			} else {
+				filterString.NotIn = api.NewOptString(step.values)
+			}
+
+			param := api.NewOptFilterString(filterString)
+			tempFilter := &db.Filters{
+				Hostname:    hostname,
+				PeriodStart: TimeStart,
+				PeriodEnd:   TimeEnd,
+			}
+
+			switch step.fieldName {
+			case "Pathname":
+				tempFilter.Pathname = db.NewFilter(step.field, param)
+			case "Referrer":
+				tempFilter.Referrer = db.NewFilter(step.field, param)
+				tempFilter.ReferrerGroup = db.NewFilter(db.FilterReferrerGroup, param)
+			case "UTMSource":
+				tempFilter.UTMSource = db.NewFilter(step.field, param)
+			case "UTMMedium":
+				tempFilter.UTMMedium = db.NewFilter(step.field, param)
+			case "UTMCampaign":
+				tempFilter.UTMCampaign = db.NewFilter(step.field, param)
+			case "Browser":
+				tempFilter.Browser = db.NewFilter(step.field, param)
+			case "OS":
+				tempFilter.OS = db.NewFilter(step.field, param)
+			case "Device":
+				tempFilter.Device = db.NewFilter(step.field, param)
+			case "Country":
+				tempFilter.Country = db.NewFilter(step.field, param)
+			case "Language":
+				tempFilter.Language = db.NewFilter(step.field, param)
+				tempFilter.LanguageDialect = db.NewFilter(db.FilterLanguageDialect, param)
+			}
+
+			name := step.fieldName + "In"
+			if operation == db.FilterNotIn {
+				name = step.fieldName + "NotIn"
+			}
+
+			filters = append(filters, TestCase{Name: name, Filters: tempFilter})
+		}
+	}
+
+	return filters
+}
+
 func getBaseTestCases(_ string) []TestCase {
 	hostname := MediumHostname // For now we only have one hostname.
 	filterCases := generateFilterAll(hostname)
@@ -0,0 +1,121 @@
+package duckdb
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db"
+	qb "github.com/medama-io/medama/db/duckdb/query"
+	"github.com/medama-io/medama/model"
+)
+
+// originalHostnameStmt is the hostname or alias a page view was received on.
+//
+// The expression is used instead of an alias as hostname is also a column.
+const originalHostnameStmt = "COALESCE(original_hostname, hostname)"
+
+// GetWebsiteHostnamesSummary returns the hostnames and aliases page views of
+// the given hostname were received on.
+func (c *Client) GetWebsiteHostnamesSummary(
+	ctx context.Context,
+	filter *db.Filters,
+) ([]*model.StatsHostnamesSummary, error) {
+	var hostnames []*model.StatsHostnamesSummary
+
+	// Array of hostnames
+	//
+	// Hostname is the hostname or alias the page view was received on.
+	//
+	// Visitors is the number of unique visitors on the hostname.
+	//
+	// VisitorsPercentage is the percentage the hostname contributes to the total unique visits.
+	query := qb.New().
+		WithMaterialized(TotalVisitorsCTE(filter.WhereString(), filter.IsCustomEvent)).
+		Select(
+			originalHostnameStmt+" AS hostname",
+			VisitorsStmt,
+			VisitorsPercentageStmt,
+		).
+		From("views").
+		Where(filter.WhereString()).
+		GroupBy(originalHostnameStmt).
+		OrderBy("visitors DESC", originalHostnameStmt+" ASC").
+		Pagination(filter.PaginationString())
+
+	if filter.IsCustomEvent {
+		query = query.
+			LeftJoin(EventsJoinStmt)
+	}
+
+	rows, err := c.NamedQueryContext(ctx, query.Build(), filter.Args(nil))
+	if err != nil {
+		return nil, errors.Wrap(err, "db")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var hostname model.StatsHostnamesSummary
+
+		err := rows.StructScan(&hostname)
+		if err != nil {
+			return nil, errors.Wrap(err, "db")
+		}
+
+		hostnames = append(hostnames, &hostname)
+	}
+
+	return hostnames, nil
+}
+
+// GetWebsiteHostnames returns the hostnames and aliases page views of the
+// given hostname were received on.
+func (c *Client) GetWebsiteHostnames(
+	ctx context.Context,
+	filter *db.Filters,
+) ([]*model.StatsHostnames, error) {
+	return collectRows(func(fn func(*model.StatsHostnames) error) error {
+		return c.StreamWebsiteHostnames(ctx, filter, fn)
+	})
+}
+
+// StreamWebsiteHostnames calls fn with each row of the hostnames and aliases
+// page views of the given hostname were received on.
+func (c *Client) StreamWebsiteHostnames(
+	ctx context.Context,
+	filter *db.Filters,
+	fn func(*model.StatsHostnames) error,
+) error {
+	// Array of hostnames
+	//
+	// Hostname is the hostname or alias the page view was received on.
+	//
+	// Visitors is the number of unique visitors on the hostname.
+	//
+	// VisitorsPercentage is the percentage the hostname contributes to the total unique visits.
+	query := qb.New().
+		WithMaterialized(TotalVisitorsCTE(filter.WhereString(), filter.IsCustomEvent)).
+		Select(
+			originalHostnameStmt+" AS hostname",
+			VisitorsStmt,
+			VisitorsPercentageStmt,
+			BounceRateStmt,
+			DurationStmt,
+		).
+		From("views").
+		Where(filter.WhereString()).
+		GroupBy(originalHostnameStmt).
+		OrderBy("visitors DESC", originalHostnameStmt+" ASC").
+		Pagination(filter.PaginationString())
+
+	if filter.IsCustomEvent {
+		query = query.
+			LeftJoin(EventsJoinStmt)
+	}
+
+	rows, err := c.NamedQueryContext(ctx, query.Build(), filter.Args(nil))
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	return scanRows(rows, fn)
+}
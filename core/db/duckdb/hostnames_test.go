@@ -0,0 +1,57 @@
+package duckdb_test
+
+import (
+	"testing"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db"
+	"github.com/medama-io/medama/model"
+)
+
+func TestGetWebsiteHostnames(t *testing.T) {
+	assert, require, ctx, client := SetupDatabase(t)
+
+	hostname := "1.example.com"
+
+	for bid, original := range map[string]string{
+		"bid_1": "",
+		"bid_2": "",
+		"bid_3": "www.1.example.com",
+		"bid_4": "www.1.example.com",
+		"bid_5": "preview.vercel.app",
+	} {
+		err := client.AddPageView(ctx, &model.PageViewHit{
+			BID:              bid,
+			Hostname:         hostname,
+			OriginalHostname: original,
+			Pathname:         "/",
+			IsUniqueUser:     true,
+			IsUniquePage:     true,
+		}, nil)
+		require.NoError(err)
+	}
+
+	hostnames, err := client.GetWebsiteHostnamesSummary(ctx, &db.Filters{Hostname: hostname})
+	require.NoError(err)
+
+	// Page views received on the website hostname are reported under it.
+	require.Len(hostnames, 3)
+	assert.Equal("1.example.com", hostnames[0].Hostname)
+	assert.Equal(2, hostnames[0].Visitors)
+	assert.Equal("www.1.example.com", hostnames[1].Hostname)
+	assert.Equal(2, hostnames[1].Visitors)
+	assert.Equal("preview.vercel.app", hostnames[2].Hostname)
+	assert.Equal(1, hostnames[2].Visitors)
+
+	filters := db.CreateFilters(api.GetWebsiteIDPagesParams{
+		OriginalHostname: api.NewOptFilterString(api.FilterString{
+			Neq: api.NewOptString("www.1.example.com"),
+		}),
+	}, hostname)
+
+	detailed, err := client.GetWebsiteHostnames(ctx, filters)
+	require.NoError(err)
+	require.Len(detailed, 2)
+	assert.Equal("1.example.com", detailed[0].Hostname)
+	assert.Equal("preview.vercel.app", detailed[1].Hostname)
+}
@@ -0,0 +1,174 @@
+package duckdb
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/jmoiron/sqlx"
+	"github.com/medama-io/medama/model"
+)
+
+// InsertImportedViews writes page views imported from another analytics tool
+// tagged with the import ID in a single transaction.
+func (c *Client) InsertImportedViews(
+	ctx context.Context,
+	importID string,
+	views []model.ImportedPageView,
+) error {
+	queued := make([]queuedPageView, len(views))
+	for idx, view := range views {
+		queued[idx] = queuedPageView{
+			hit:         view.PageViewHit,
+			dateCreated: view.DateCreated,
+			durationMs:  view.DurationMs,
+		}
+	}
+
+	return c.executeInTransaction(ctx, func(tx *sqlx.Tx) error {
+		return insertPageViews(ctx, tx, queued, importID)
+	})
+}
+
+const bulkImportEventStmt = `--sql
+		INSERT INTO events (
+			bid,
+			batch_id,
+			group_name,
+			name,
+			value,
+			date_created,
+			import_id
+		) SELECT
+			NULLIF(UNNEST(?::TEXT[]), ''),
+			UNNEST(?::TEXT[]),
+			UNNEST(?::TEXT[]),
+			UNNEST(?::TEXT[]),
+			UNNEST(?::TEXT[]),
+			UNNEST(?::TIMESTAMPTZ[]),
+			?`
+
+// InsertImportedEvents writes custom event properties imported from another
+// analytics tool tagged with the import ID in a single transaction.
+func (c *Client) InsertImportedEvents(
+	ctx context.Context,
+	importID string,
+	events []model.ImportedEvent,
+) error {
+	return c.executeInTransaction(ctx, func(tx *sqlx.Tx) error {
+		for chunk := range slices.Chunk(events, ingestChunkSize) {
+			var (
+				bids         = make([]string, len(chunk))
+				batchIDs     = make([]string, len(chunk))
+				groups       = make([]string, len(chunk))
+				names        = make([]string, len(chunk))
+				values       = make([]string, len(chunk))
+				datesCreated = make([]time.Time, len(chunk))
+			)
+
+			for idx, event := range chunk {
+				bids[idx] = event.BID
+				batchIDs[idx] = event.BatchID
+				groups[idx] = event.Group
+				names[idx] = event.Name
+				values[idx] = event.Value
+				datesCreated[idx] = event.DateCreated
+			}
+
+			_, err := tx.ExecContext(ctx, bulkImportEventStmt,
+				bids,
+				batchIDs,
+				groups,
+				names,
+				values,
+				datesCreated,
+				importID,
+			)
+			if err != nil {
+				return errors.Wrap(err, "duckdb: bulk import events")
+			}
+		}
+
+		return nil
+	})
+}
+
+// ListImports returns a summary of each import, ordered by the date of the
+// earliest imported page view.
+func (c *Client) ListImports(ctx context.Context) ([]*model.Import, error) {
+	var imports []*model.Import
+
+	query := `--sql
+		WITH imported_views AS (
+			SELECT
+				import_id,
+				ANY_VALUE(hostname) AS hostname,
+				COUNT(*) AS views,
+				MIN(date_created) AS start,
+				MAX(date_created) AS "end"
+			FROM views
+			WHERE import_id IS NOT NULL
+			GROUP BY import_id
+		), imported_events AS (
+			SELECT import_id, COUNT(*) AS events
+			FROM events
+			WHERE import_id IS NOT NULL
+			GROUP BY import_id
+		)
+		SELECT
+			imported_views.import_id,
+			imported_views.hostname,
+			imported_views.views,
+			COALESCE(imported_events.events, 0) AS events,
+			imported_views.start,
+			imported_views."end"
+		FROM imported_views
+		LEFT JOIN imported_events USING (import_id)
+		ORDER BY imported_views.start, imported_views.import_id`
+
+	err := c.SelectContext(ctx, &imports, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return imports, nil
+}
+
+// DeleteImport removes all page views and custom events tagged with the
+// import ID in a single transaction. It returns the number of deleted page
+// views and events.
+func (c *Client) DeleteImport(ctx context.Context, importID string) (int64, int64, error) {
+	var views, events int64
+
+	err := c.executeInTransaction(ctx, func(tx *sqlx.Tx) error {
+		res, err := tx.ExecContext(ctx, `--sql
+			DELETE FROM events WHERE import_id = ?`, importID)
+		if err != nil {
+			return errors.Wrap(err, "db")
+		}
+
+		events, err = res.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "db")
+		}
+
+		res, err = tx.ExecContext(ctx, `--sql
+			DELETE FROM views WHERE import_id = ?`, importID)
+		if err != nil {
+			return errors.Wrap(err, "db")
+		}
+
+		views, err = res.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "db")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return views, events, nil
+}
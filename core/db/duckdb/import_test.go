@@ -0,0 +1,119 @@
+package duckdb_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/model"
+)
+
+func TestImport(t *testing.T) {
+	assert, require, ctx, client := SetupDatabase(t)
+
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	views := make([]model.ImportedPageView, 0, 1500)
+	for idx := range 1500 {
+		views = append(views, model.ImportedPageView{
+			PageViewHit: model.PageViewHit{
+				BID:          "import_a_" + strconv.Itoa(idx),
+				Hostname:     "1.example.com",
+				Pathname:     "/",
+				IsUniqueUser: idx%2 == 0,
+				IsUniquePage: true,
+				Country:      "Germany",
+				BrowserName:  "Chrome",
+				OS:           "Windows",
+				DeviceType:   "Desktop",
+			},
+			DurationMs:  idx % 3 * 1000,
+			DateCreated: start.Add(time.Duration(idx) * time.Minute),
+		})
+	}
+
+	events := []model.ImportedEvent{
+		{
+			EventHit: model.EventHit{
+				BID:     views[0].BID,
+				BatchID: "batch_1",
+				Group:   "1.example.com",
+				Name:    "event",
+				Value:   "signup",
+			},
+			DateCreated: start,
+		},
+		{
+			EventHit: model.EventHit{
+				BatchID: "batch_2",
+				Group:   "1.example.com",
+				Name:    "event",
+				Value:   "signup",
+			},
+			DateCreated: start,
+		},
+	}
+
+	require.NoError(client.InsertImportedViews(ctx, "import_a", views))
+	require.NoError(client.InsertImportedEvents(ctx, "import_a", events))
+
+	// A second import of another website.
+	require.NoError(client.InsertImportedViews(ctx, "import_b", []model.ImportedPageView{{
+		PageViewHit: model.PageViewHit{
+			BID:         "import_b_1",
+			Hostname:    "2.example.com",
+			Pathname:    "/",
+			BrowserName: "Chrome",
+			OS:          "Windows",
+			DeviceType:  "Desktop",
+		},
+		DateCreated: start.AddDate(0, 0, 1),
+	}}))
+
+	// Imported rows are part of the stats and unknown durations are NULL.
+	var total, durations int
+
+	row := client.QueryRowxContext(ctx, `--sql
+		SELECT COUNT(*), COUNT(duration_ms) FROM views WHERE hostname = '1.example.com'`)
+	require.NoError(row.Scan(&total, &durations))
+	assert.Equal(1500, total)
+	assert.Equal(1000, durations)
+
+	var eventsWithoutBID int
+
+	row = client.QueryRowxContext(ctx, `--sql
+		SELECT COUNT(*) FROM events WHERE import_id = 'import_a' AND bid IS NULL`)
+	require.NoError(row.Scan(&eventsWithoutBID))
+	assert.Equal(1, eventsWithoutBID)
+
+	imports, err := client.ListImports(ctx)
+	require.NoError(err)
+	require.Len(imports, 2)
+
+	assert.Equal("import_a", imports[0].ID)
+	assert.Equal("1.example.com", imports[0].Hostname)
+	assert.Equal(1500, imports[0].Views)
+	assert.Equal(2, imports[0].Events)
+	assert.True(start.Equal(imports[0].Start))
+	assert.True(start.Add(1499 * time.Minute).Equal(imports[0].End))
+
+	assert.Equal("import_b", imports[1].ID)
+	assert.Equal(1, imports[1].Views)
+	assert.Zero(imports[1].Events)
+
+	// Rolling back only removes the rows of the import.
+	deletedViews, deletedEvents, err := client.DeleteImport(ctx, "import_a")
+	require.NoError(err)
+	assert.Equal(int64(1500), deletedViews)
+	assert.Equal(int64(2), deletedEvents)
+
+	imports, err = client.ListImports(ctx)
+	require.NoError(err)
+	require.Len(imports, 1)
+	assert.Equal("import_b", imports[0].ID)
+
+	deletedViews, deletedEvents, err = client.DeleteImport(ctx, "import_a")
+	require.NoError(err)
+	assert.Zero(deletedViews)
+	assert.Zero(deletedEvents)
+}
@@ -0,0 +1,623 @@
+package duckdb
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/jmoiron/sqlx"
+	"github.com/medama-io/medama/metrics"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+)
+
+const (
+	// DefaultIngestBatchSize is the number of queued records that triggers a flush.
+	DefaultIngestBatchSize = 1000
+	// DefaultIngestFlushInterval is the maximum time a record waits in the queue.
+	DefaultIngestFlushInterval = time.Second
+
+	// ingestChunkSize is the maximum number of rows written in a single bulk
+	// statement. The number of parameters is fixed, so this only bounds the
+	// size of the lists bound to each statement.
+	ingestChunkSize = 1000
+
+	// ingestRetries is the number of times a failed flush is retried before
+	// its records are requeued.
+	ingestRetries = 3
+	// ingestRetryBackoff is the delay before the first retry of a failed
+	// flush, which doubles after each attempt.
+	ingestRetryBackoff = 100 * time.Millisecond
+	// ingestMaxQueuedBatches bounds the queue as a multiple of the batch size
+	// when requeuing failed records, so a database outage drops records
+	// instead of growing the queue without limit.
+	ingestMaxQueuedBatches = 10
+)
+
+// ErrIngesterClosed is returned when records are queued after the ingester
+// has been closed.
+var ErrIngesterClosed = errors.New("ingester is closed")
+
+// IngestConfig configures when the ingester flushes its queue.
+type IngestConfig struct {
+	// BatchSize is the number of queued records that triggers a flush.
+	BatchSize int
+	// FlushInterval is the maximum time a record waits in the queue before
+	// it is flushed.
+	FlushInterval time.Duration
+}
+
+// IngestStats is a point in time snapshot of the ingester queue.
+type IngestStats struct {
+	// QueueDepth is the number of records waiting to be flushed.
+	QueueDepth int
+	// LastFlushSize is the number of records written by the last flush.
+	LastFlushSize int
+	// LastFlushDuration is how long the last flush took.
+	LastFlushDuration time.Duration
+	// LastFlushAt is when the last flush completed.
+	LastFlushAt time.Time
+	// Flushes is the total number of flushes that wrote at least one record.
+	Flushes uint64
+	// FailedFlushes is the total number of flushes that returned an error.
+	FailedFlushes uint64
+	// RequeuedRecords is the total number of records put back in the queue by
+	// failed flushes to be written by the next flush.
+	RequeuedRecords uint64
+	// DroppedRecords is the total number of records discarded by failed
+	// flushes when the queue is full or the ingester is closed.
+	DroppedRecords uint64
+}
+
+// queuedPageView is a page view with the time it was received, so the row
+// keeps its original timestamp regardless of when it is flushed.
+type queuedPageView struct {
+	hit         model.PageViewHit
+	dateCreated time.Time
+	// durationMs is only known up front for imported page views. Ingested
+	// page views have their duration updated once the page is unloaded.
+	durationMs int
+}
+
+// queuedEvent is a custom event property with the time it was received.
+type queuedEvent struct {
+	hit         model.EventHit
+	dateCreated time.Time
+}
+
+// ingestBatch holds all records that are written in a single flush.
+type ingestBatch struct {
+	views  []queuedPageView
+	events []queuedEvent
+	// durations is keyed by beacon ID so only the latest duration for each
+	// page view is written.
+	durations map[string]int
+}
+
+func (b *ingestBatch) size() int {
+	return len(b.views) + len(b.events) + len(b.durations)
+}
+
+// Ingester buffers incoming hits in memory and writes them to DuckDB in bulk
+// when either the batch size or flush interval is reached.
+type Ingester struct {
+	client *Client
+	config IngestConfig
+
+	mu     sync.Mutex
+	batch  ingestBatch
+	stats  IngestStats
+	closed bool
+
+	// flushMu ensures only one flush writes to the database at a time so
+	// page views are always inserted before their duration updates.
+	flushMu sync.Mutex
+
+	trigger chan struct{}
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewIngester creates a new ingester and starts the background flush loop.
+func NewIngester(client *Client, config IngestConfig) *Ingester {
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultIngestBatchSize
+	}
+
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = DefaultIngestFlushInterval
+	}
+
+	ingester := &Ingester{
+		client:  client,
+		config:  config,
+		batch:   ingestBatch{durations: make(map[string]int)},
+		trigger: make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+
+	ingester.wg.Add(1)
+
+	go ingester.run()
+
+	return ingester
+}
+
+// AddPageView queues a page view and any custom properties attached to it.
+func (i *Ingester) AddPageView(event *model.PageViewHit, events *[]model.EventHit) error {
+	now := time.Now()
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.closed {
+		return ErrIngesterClosed
+	}
+
+	i.batch.views = append(i.batch.views, queuedPageView{hit: *event, dateCreated: now})
+	i.queueEventsLocked(events, now)
+	i.notifyLocked()
+
+	return nil
+}
+
+// AddEvents queues custom event properties.
+func (i *Ingester) AddEvents(events *[]model.EventHit) error {
+	now := time.Now()
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.closed {
+		return ErrIngesterClosed
+	}
+
+	i.queueEventsLocked(events, now)
+	i.notifyLocked()
+
+	return nil
+}
+
+// UpdatePageView queues a duration update for a page view.
+func (i *Ingester) UpdatePageView(event *model.PageViewDuration) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.closed {
+		return ErrIngesterClosed
+	}
+
+	i.batch.durations[event.BID] = event.DurationMs
+	i.notifyLocked()
+
+	return nil
+}
+
+// Stats returns the current queue depth and the results of the last flush.
+func (i *Ingester) Stats() IngestStats {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	stats := i.stats
+	stats.QueueDepth = i.batch.size()
+
+	return stats
+}
+
+// Flush writes all queued records to the database. Failed writes are retried
+// with backoff, after which the records are put back in the queue for the next
+// flush unless the queue is full or the ingester is closed.
+func (i *Ingester) Flush(ctx context.Context) error {
+	i.flushMu.Lock()
+	defer i.flushMu.Unlock()
+
+	i.mu.Lock()
+	batch := i.batch
+	i.batch = ingestBatch{durations: make(map[string]int)}
+	i.mu.Unlock()
+
+	size := batch.size()
+	if size == 0 {
+		return nil
+	}
+
+	start := time.Now()
+	err := i.writeWithRetry(ctx, &batch)
+	elapsed := time.Since(start)
+
+	metrics.IngestFlushDuration.Observe(elapsed.Seconds())
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if err != nil {
+		i.stats.FailedFlushes++
+
+		if i.requeueLocked(&batch) {
+			i.stats.RequeuedRecords += uint64(size)
+			metrics.IngestRequeuedRecords.Add(uint64(size))
+		} else {
+			i.stats.DroppedRecords += uint64(size)
+			metrics.IngestRecords.Add(uint64(size), metrics.IngestDropped)
+		}
+
+		return err
+	}
+
+	// Records are only counted once the transaction writing them commits, as
+	// requeued records are flushed again.
+	metrics.IngestRecords.Add(uint64(size), metrics.IngestWritten)
+
+	i.stats.Flushes++
+	i.stats.LastFlushSize = size
+	i.stats.LastFlushDuration = elapsed
+	i.stats.LastFlushAt = time.Now()
+
+	return nil
+}
+
+// Close stops accepting new records and drains the queue to the database.
+func (i *Ingester) Close(ctx context.Context) error {
+	i.mu.Lock()
+	if i.closed {
+		i.mu.Unlock()
+		return nil
+	}
+
+	i.closed = true
+	i.mu.Unlock()
+
+	close(i.done)
+	i.wg.Wait()
+
+	return i.Flush(ctx)
+}
+
+// run flushes the queue on every interval tick or when the batch size is reached.
+func (i *Ingester) run() {
+	defer i.wg.Done()
+
+	log := logger.Get()
+
+	ticker := time.NewTicker(i.config.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-i.trigger:
+		case <-i.done:
+			return
+		}
+
+		if err := i.Flush(context.Background()); err != nil {
+			log.Error().Err(err).Msg("ingest: failed to flush queue")
+		}
+	}
+}
+
+// queueEventsLocked appends events to the batch. The caller must hold i.mu.
+func (i *Ingester) queueEventsLocked(events *[]model.EventHit, now time.Time) {
+	if events == nil {
+		return
+	}
+
+	for _, event := range *events {
+		i.batch.events = append(i.batch.events, queuedEvent{hit: event, dateCreated: now})
+	}
+}
+
+// notifyLocked wakes the flush loop if the batch size has been reached. The
+// caller must hold i.mu.
+func (i *Ingester) notifyLocked() {
+	if i.batch.size() < i.config.BatchSize {
+		return
+	}
+
+	select {
+	case i.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// requeueLocked puts the records of a failed batch back at the front of the
+// queue and reports whether it did. Durations queued since the batch was taken
+// are newer and take precedence. The caller must hold i.mu.
+func (i *Ingester) requeueLocked(batch *ingestBatch) bool {
+	if i.closed || i.batch.size()+batch.size() > i.config.BatchSize*ingestMaxQueuedBatches {
+		return false
+	}
+
+	i.batch.views = append(batch.views, i.batch.views...)
+	i.batch.events = append(batch.events, i.batch.events...)
+
+	for bid, durationMs := range batch.durations {
+		if _, ok := i.batch.durations[bid]; !ok {
+			i.batch.durations[bid] = durationMs
+		}
+	}
+
+	return true
+}
+
+// writeWithRetry writes the batch, retrying with exponential backoff if the
+// write fails. Each attempt is its own transaction, so a failed attempt leaves
+// nothing behind.
+func (i *Ingester) writeWithRetry(ctx context.Context, batch *ingestBatch) error {
+	backoff := ingestRetryBackoff
+
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		err = i.write(ctx, batch)
+		if err == nil || attempt == ingestRetries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+	}
+}
+
+// write inserts all records of the batch within a single transaction.
+func (i *Ingester) write(ctx context.Context, batch *ingestBatch) error {
+	return i.client.executeInTransaction(ctx, func(tx *sqlx.Tx) error {
+		if err := insertPageViews(ctx, tx, batch.views, ""); err != nil {
+			return err
+		}
+
+		if err := insertEvents(ctx, tx, batch.events); err != nil {
+			return err
+		}
+
+		return updatePageViewDurations(ctx, tx, batch.durations)
+	})
+}
+
+// The bulk statements bind each column as a list and unnest them into rows.
+// This keeps the number of parameters fixed regardless of the batch size and
+// is significantly faster than binding each row individually.
+//
+// Page views are written by both ingestion and imports, which only differ in
+// the import ID that tags every imported row.
+var (
+	bulkPageViewStmt       = pageViewInsertStmt(false)
+	bulkImportPageViewStmt = pageViewInsertStmt(true)
+)
+
+// pageViewColumns are the columns written for each page view and the
+// expressions the bound lists are read from. Empty optional values are stored
+// as NULL.
+var pageViewColumns = []struct {
+	name  string
+	value string
+}{
+	{"bid", "UNNEST(?::TEXT[])"},
+	{"visit_id", "NULLIF(UNNEST(?::TEXT[]), '')"},
+	{"hostname", "UNNEST(?::TEXT[])"},
+	{"original_hostname", "NULLIF(UNNEST(?::TEXT[]), '')"},
+	{"pathname", "UNNEST(?::TEXT[])"},
+	{"is_unique_user", "UNNEST(?::BOOLEAN[])"},
+	{"is_unique_page", "UNNEST(?::BOOLEAN[])"},
+	{"referrer_host", "UNNEST(?::TEXT[])"},
+	{"referrer_group", "UNNEST(?::TEXT[])"},
+	{"country", "UNNEST(?::TEXT[])"},
+	{"language_base", "UNNEST(?::TEXT[])"},
+	{"language_dialect", "UNNEST(?::TEXT[])"},
+	{"ua_browser", "UNNEST(?::TEXT[])"},
+	{"ua_os", "UNNEST(?::TEXT[])"},
+	{"ua_device_type", "UNNEST(?::TEXT[])"},
+	{"utm_source", "UNNEST(?::TEXT[])"},
+	{"utm_medium", "UNNEST(?::TEXT[])"},
+	{"utm_campaign", "UNNEST(?::TEXT[])"},
+	{"duration_ms", "NULLIF(UNNEST(?::UINTEGER[]), 0)"},
+	{"date_created", "UNNEST(?::TIMESTAMPTZ[])"},
+}
+
+// pageViewInsertStmt returns the statement to write page views in bulk. The
+// import ID is bound as a single value for all rows if imported is set.
+func pageViewInsertStmt(imported bool) string {
+	names := make([]string, 0, len(pageViewColumns)+1)
+	values := make([]string, 0, len(pageViewColumns)+1)
+
+	for _, column := range pageViewColumns {
+		names = append(names, column.name)
+		values = append(values, column.value)
+	}
+
+	if imported {
+		names = append(names, "import_id")
+		values = append(values, "?")
+	}
+
+	return "--sql\n\t\tINSERT INTO views (\n\t\t\t" + strings.Join(names, ",\n\t\t\t") +
+		"\n\t\t) SELECT\n\t\t\t" + strings.Join(values, ",\n\t\t\t")
+}
+
+// insertPageViews writes page views in bulk. Page views are tagged with the
+// import ID unless it is empty.
+func insertPageViews(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	views []queuedPageView,
+	importID string,
+) error {
+	stmt := bulkPageViewStmt
+	if importID != "" {
+		stmt = bulkImportPageViewStmt
+	}
+
+	for chunk := range slices.Chunk(views, ingestChunkSize) {
+		var (
+			bids             = make([]string, len(chunk))
+			visitIDs         = make([]string, len(chunk))
+			hostnames        = make([]string, len(chunk))
+			originals        = make([]string, len(chunk))
+			pathnames        = make([]string, len(chunk))
+			isUniqueUsers    = make([]bool, len(chunk))
+			isUniquePages    = make([]bool, len(chunk))
+			referrerHosts    = make([]string, len(chunk))
+			referrerGroups   = make([]string, len(chunk))
+			countries        = make([]string, len(chunk))
+			languageBases    = make([]string, len(chunk))
+			languageDialects = make([]string, len(chunk))
+			browsers         = make([]string, len(chunk))
+			oses             = make([]string, len(chunk))
+			deviceTypes      = make([]string, len(chunk))
+			utmSources       = make([]string, len(chunk))
+			utmMediums       = make([]string, len(chunk))
+			utmCampaigns     = make([]string, len(chunk))
+			// The list element types must match the casts in the statement exactly.
+			durationsMs  = make([]uint32, len(chunk))
+			datesCreated = make([]time.Time, len(chunk))
+		)
+
+		for idx, view := range chunk {
+			bids[idx] = view.hit.BID
+			visitIDs[idx] = view.hit.VisitID
+			hostnames[idx] = view.hit.Hostname
+			originals[idx] = view.hit.OriginalHostname
+			pathnames[idx] = view.hit.Pathname
+			isUniqueUsers[idx] = view.hit.IsUniqueUser
+			isUniquePages[idx] = view.hit.IsUniquePage
+			referrerHosts[idx] = view.hit.ReferrerHost
+			referrerGroups[idx] = view.hit.ReferrerGroup
+			countries[idx] = view.hit.Country
+			languageBases[idx] = view.hit.LanguageBase
+			languageDialects[idx] = view.hit.LanguageDialect
+			browsers[idx] = view.hit.BrowserName
+			oses[idx] = view.hit.OS
+			deviceTypes[idx] = view.hit.DeviceType
+			utmSources[idx] = view.hit.UTMSource
+			utmMediums[idx] = view.hit.UTMMedium
+			utmCampaigns[idx] = view.hit.UTMCampaign
+			durationsMs[idx] = uint32(max(view.durationMs, 0)) //nolint:gosec // Clamped to zero.
+			datesCreated[idx] = view.dateCreated
+		}
+
+		args := []any{
+			bids,
+			visitIDs,
+			hostnames,
+			originals,
+			pathnames,
+			isUniqueUsers,
+			isUniquePages,
+			referrerHosts,
+			referrerGroups,
+			countries,
+			languageBases,
+			languageDialects,
+			browsers,
+			oses,
+			deviceTypes,
+			utmSources,
+			utmMediums,
+			utmCampaigns,
+			durationsMs,
+			datesCreated,
+		}
+		if importID != "" {
+			args = append(args, importID)
+		}
+
+		_, err := tx.ExecContext(ctx, stmt, args...)
+		if err != nil {
+			return errors.Wrap(err, "duckdb: bulk insert page views")
+		}
+	}
+
+	return nil
+}
+
+const bulkEventStmt = `--sql
+		INSERT INTO events (
+			bid,
+			batch_id,
+			group_name,
+			name,
+			value,
+			date_created
+		) SELECT
+			UNNEST(?::TEXT[]),
+			UNNEST(?::TEXT[]),
+			UNNEST(?::TEXT[]),
+			UNNEST(?::TEXT[]),
+			UNNEST(?::TEXT[]),
+			UNNEST(?::TIMESTAMPTZ[])`
+
+// insertEvents writes custom event properties in bulk.
+func insertEvents(ctx context.Context, tx *sqlx.Tx, events []queuedEvent) error {
+	for chunk := range slices.Chunk(events, ingestChunkSize) {
+		var (
+			bids         = make([]string, len(chunk))
+			batchIDs     = make([]string, len(chunk))
+			groups       = make([]string, len(chunk))
+			names        = make([]string, len(chunk))
+			values       = make([]string, len(chunk))
+			datesCreated = make([]time.Time, len(chunk))
+		)
+
+		for idx, event := range chunk {
+			bids[idx] = event.hit.BID
+			batchIDs[idx] = event.hit.BatchID
+			groups[idx] = event.hit.Group
+			names[idx] = event.hit.Name
+			values[idx] = event.hit.Value
+			datesCreated[idx] = event.dateCreated
+		}
+
+		_, err := tx.ExecContext(ctx, bulkEventStmt,
+			bids,
+			batchIDs,
+			groups,
+			names,
+			values,
+			datesCreated,
+		)
+		if err != nil {
+			return errors.Wrap(err, "duckdb: bulk insert events")
+		}
+	}
+
+	return nil
+}
+
+const bulkUpdateDurationStmt = `--sql
+		UPDATE views SET duration_ms = updates.duration_ms
+		FROM (
+			SELECT
+				UNNEST(?::TEXT[]) AS bid,
+				UNNEST(?::UINTEGER[]) AS duration_ms
+		) AS updates
+		WHERE views.bid = updates.bid`
+
+// updatePageViewDurations updates page view durations in bulk.
+func updatePageViewDurations(ctx context.Context, tx *sqlx.Tx, durations map[string]int) error {
+	if len(durations) == 0 {
+		return nil
+	}
+
+	bids := make([]string, 0, len(durations))
+	// The list element types must match the casts in the statement exactly.
+	durationsMs := make([]uint32, 0, len(durations))
+
+	for bid, durationMs := range durations {
+		bids = append(bids, bid)
+		durationsMs = append(durationsMs, uint32(max(durationMs, 0))) //nolint:gosec // Clamped to zero.
+	}
+
+	if _, err := tx.ExecContext(ctx, bulkUpdateDurationStmt, bids, durationsMs); err != nil {
+		return errors.Wrap(err, "duckdb: bulk update page views")
+	}
+
+	return nil
+}
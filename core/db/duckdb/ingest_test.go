@@ -0,0 +1,190 @@
+package duckdb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/metrics"
+	"github.com/medama-io/medama/model"
+)
+
+func newIngestPageView(bid string, hostname string) *model.PageViewHit {
+	return &model.PageViewHit{
+		BID:          bid,
+		Hostname:     hostname,
+		Pathname:     "/",
+		IsUniqueUser: true,
+		IsUniquePage: true,
+		ReferrerHost: "medama.io",
+		Country:      "United Kingdom",
+		LanguageBase: "English",
+		BrowserName:  "Firefox",
+		OS:           "Windows",
+		DeviceType:   "Desktop",
+	}
+}
+
+func TestIngesterFlushOnClose(t *testing.T) {
+	assert, require, ctx, client := SetupDatabase(t)
+
+	// Large batch size and interval so nothing is written until closed.
+	ingester := duckdb.NewIngester(client, duckdb.IngestConfig{
+		BatchSize:     10000,
+		FlushInterval: time.Hour,
+	})
+
+	hostname := "ingest-close-test.io"
+	for _, bid := range []string{"bid_1", "bid_2", "bid_3"} {
+		err := ingester.AddPageView(newIngestPageView(bid, hostname), &[]model.EventHit{
+			{BID: bid, BatchID: "batch_" + bid, Group: hostname, Name: "name", Value: "value"},
+		})
+		require.NoError(err)
+	}
+
+	err := ingester.AddEvents(&[]model.EventHit{
+		{BatchID: "batch_custom", Group: hostname, Name: "custom", Value: "value"},
+	})
+	require.NoError(err)
+
+	err = ingester.UpdatePageView(&model.PageViewDuration{BID: "bid_1", DurationMs: 100})
+	require.NoError(err)
+	// Only the latest duration for a beacon should be written.
+	err = ingester.UpdatePageView(&model.PageViewDuration{BID: "bid_1", DurationMs: 250})
+	require.NoError(err)
+
+	stats := ingester.Stats()
+	assert.Equal(8, stats.QueueDepth)
+	assert.Equal(uint64(0), stats.Flushes)
+
+	var count int
+
+	err = client.QueryRow("SELECT COUNT(*) FROM views WHERE hostname = ?", hostname).Scan(&count)
+	require.NoError(err)
+	assert.Equal(0, count)
+
+	require.NoError(ingester.Close(ctx))
+
+	err = client.QueryRow("SELECT COUNT(*) FROM views WHERE hostname = ?", hostname).Scan(&count)
+	require.NoError(err)
+	assert.Equal(3, count)
+
+	err = client.QueryRow("SELECT COUNT(*) FROM events WHERE group_name = ?", hostname).Scan(&count)
+	require.NoError(err)
+	assert.Equal(4, count)
+
+	var durationMs int
+
+	err = client.QueryRow("SELECT duration_ms FROM views WHERE bid = 'bid_1'").Scan(&durationMs)
+	require.NoError(err)
+	assert.Equal(250, durationMs)
+
+	stats = ingester.Stats()
+	assert.Equal(0, stats.QueueDepth)
+	assert.Equal(uint64(1), stats.Flushes)
+	assert.Equal(8, stats.LastFlushSize)
+	assert.False(stats.LastFlushAt.IsZero())
+
+	// The ingester no longer accepts records once closed.
+	err = ingester.AddPageView(newIngestPageView("bid_4", hostname), nil)
+	require.ErrorIs(err, duckdb.ErrIngesterClosed)
+}
+
+func TestIngesterFlushOnBatchSize(t *testing.T) {
+	assert, require, ctx, client := SetupDatabase(t)
+
+	ingester := duckdb.NewIngester(client, duckdb.IngestConfig{
+		BatchSize:     2,
+		FlushInterval: time.Hour,
+	})
+	defer ingester.Close(ctx) //nolint:errcheck // Test cleanup.
+
+	hostname := "ingest-batch-test.io"
+	require.NoError(ingester.AddPageView(newIngestPageView("batch_bid_1", hostname), nil))
+	require.NoError(ingester.AddPageView(newIngestPageView("batch_bid_2", hostname), nil))
+
+	assert.Eventually(func() bool {
+		var count int
+
+		err := client.QueryRow("SELECT COUNT(*) FROM views WHERE hostname = ?", hostname).
+			Scan(&count)
+
+		return err == nil && count == 2
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestIngesterFlushOnInterval(t *testing.T) {
+	assert, require, ctx, client := SetupDatabase(t)
+
+	ingester := duckdb.NewIngester(client, duckdb.IngestConfig{
+		BatchSize:     10000,
+		FlushInterval: 10 * time.Millisecond,
+	})
+	defer ingester.Close(ctx) //nolint:errcheck // Test cleanup.
+
+	hostname := "ingest-interval-test.io"
+	require.NoError(ingester.AddPageView(newIngestPageView("interval_bid", hostname), nil))
+
+	assert.Eventually(func() bool {
+		return ingester.Stats().Flushes == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Duration updates for page views written in an earlier flush are applied.
+	require.NoError(
+		ingester.UpdatePageView(&model.PageViewDuration{BID: "interval_bid", DurationMs: 500}),
+	)
+	require.NoError(ingester.Flush(ctx))
+
+	var durationMs int
+
+	err := client.QueryRow("SELECT duration_ms FROM views WHERE bid = 'interval_bid'").
+		Scan(&durationMs)
+	require.NoError(err)
+	assert.Equal(500, durationMs)
+}
+
+func TestIngesterRequeueOnFailure(t *testing.T) {
+	assert, require, ctx, client := SetupDatabase(t)
+
+	ingester := duckdb.NewIngester(client, duckdb.IngestConfig{
+		BatchSize:     10000,
+		FlushInterval: time.Hour,
+	})
+	defer ingester.Close(ctx) //nolint:errcheck // Test cleanup.
+
+	metrics.Enable()
+
+	written := metrics.IngestRecords.Value(metrics.IngestWritten)
+
+	hostname := "ingest-requeue-test.io"
+	require.NoError(ingester.AddPageView(newIngestPageView("requeue_bid", hostname), nil))
+
+	// Every attempt fails while the table is missing.
+	_, err := client.ExecContext(ctx, "ALTER TABLE views RENAME TO views_unavailable")
+	require.NoError(err)
+
+	require.Error(ingester.Flush(ctx))
+
+	stats := ingester.Stats()
+	assert.Equal(1, stats.QueueDepth)
+	assert.Equal(uint64(1), stats.FailedFlushes)
+	assert.Equal(uint64(1), stats.RequeuedRecords)
+	assert.Equal(uint64(0), stats.DroppedRecords)
+	assert.Equal(written, metrics.IngestRecords.Value(metrics.IngestWritten))
+
+	_, err = client.ExecContext(ctx, "ALTER TABLE views_unavailable RENAME TO views")
+	require.NoError(err)
+
+	// The requeued page view is written by the next flush.
+	require.NoError(ingester.Flush(ctx))
+
+	var count int
+
+	err = client.QueryRow("SELECT COUNT(*) FROM views WHERE hostname = ?", hostname).Scan(&count)
+	require.NoError(err)
+	assert.Equal(1, count)
+	assert.Equal(0, ingester.Stats().QueueDepth)
+
+	// The requeued page view is only counted as written once.
+	assert.Equal(written+1, metrics.IngestRecords.Value(metrics.IngestWritten))
+}
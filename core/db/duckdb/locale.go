@@ -66,8 +66,17 @@ func (c *Client) GetWebsiteCountries(
 	ctx context.Context,
 	filter *db.Filters,
 ) ([]*model.StatsCountries, error) {
-	var countries []*model.StatsCountries
+	return collectRows(func(fn func(*model.StatsCountries) error) error {
+		return c.StreamWebsiteCountries(ctx, filter, fn)
+	})
+}
 
+// StreamWebsiteCountries calls fn with each row of the countries for the given hostname.
+func (c *Client) StreamWebsiteCountries(
+	ctx context.Context,
+	filter *db.Filters,
+	fn func(*model.StatsCountries) error,
+) error {
 	// Array of countries
 	//
 	// Country is the country code of the visitor.
@@ -97,22 +106,10 @@ func (c *Client) GetWebsiteCountries(
 
 	rows, err := c.NamedQueryContext(ctx, query.Build(), filter.Args(nil))
 	if err != nil {
-		return nil, errors.Wrap(err, "db")
+		return errors.Wrap(err, "db")
 	}
-	defer rows.Close()
 
-	for rows.Next() {
-		var country model.StatsCountries
-
-		err := rows.StructScan(&country)
-		if err != nil {
-			return nil, errors.Wrap(err, "db")
-		}
-
-		countries = append(countries, &country)
-	}
-
-	return countries, nil
+	return scanRows(rows, fn)
 }
 
 // GetWebsiteLanguages returns the languages for the given hostname.
@@ -179,8 +176,18 @@ func (c *Client) GetWebsiteLanguages(
 	isLocale bool,
 	filter *db.Filters,
 ) ([]*model.StatsLanguages, error) {
-	var languages []*model.StatsLanguages
+	return collectRows(func(fn func(*model.StatsLanguages) error) error {
+		return c.StreamWebsiteLanguages(ctx, isLocale, filter, fn)
+	})
+}
 
+// StreamWebsiteLanguages calls fn with each row of the languages for the given hostname.
+func (c *Client) StreamWebsiteLanguages(
+	ctx context.Context,
+	isLocale bool,
+	filter *db.Filters,
+	fn func(*model.StatsLanguages) error,
+) error {
 	languageSelect := "language_base AS language"
 	if isLocale {
 		languageSelect = "language_dialect AS language"
@@ -215,20 +222,8 @@ func (c *Client) GetWebsiteLanguages(
 
 	rows, err := c.NamedQueryContext(ctx, query.Build(), filter.Args(nil))
 	if err != nil {
-		return nil, errors.Wrap(err, "db")
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var language model.StatsLanguages
-
-		err := rows.StructScan(&language)
-		if err != nil {
-			return nil, errors.Wrap(err, "db")
-		}
-
-		languages = append(languages, &language)
+		return errors.Wrap(err, "db")
 	}
 
-	return languages, nil
+	return scanRows(rows, fn)
 }
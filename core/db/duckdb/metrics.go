@@ -0,0 +1,49 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/medama-io/medama/metrics"
+)
+
+// The query methods of sqlx.DB are wrapped to record the duration of each
+// query, labelled by the client method making it. Queries made within a
+// transaction are not recorded.
+
+func (c *Client) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	defer metrics.ObserveQuery(metrics.DatabaseDuckDB, time.Now())
+	return c.DB.ExecContext(ctx, query, args...)
+}
+
+func (c *Client) NamedExecContext(ctx context.Context, query string, arg any) (sql.Result, error) {
+	defer metrics.ObserveQuery(metrics.DatabaseDuckDB, time.Now())
+	return c.DB.NamedExecContext(ctx, query, arg)
+}
+
+func (c *Client) NamedQueryContext(ctx context.Context, query string, arg any) (*sqlx.Rows, error) {
+	defer metrics.ObserveQuery(metrics.DatabaseDuckDB, time.Now())
+	return c.DB.NamedQueryContext(ctx, query, arg)
+}
+
+func (c *Client) QueryxContext(ctx context.Context, query string, args ...any) (*sqlx.Rows, error) {
+	defer metrics.ObserveQuery(metrics.DatabaseDuckDB, time.Now())
+	return c.DB.QueryxContext(ctx, query, args...)
+}
+
+func (c *Client) QueryRowxContext(ctx context.Context, query string, args ...any) *sqlx.Row {
+	defer metrics.ObserveQuery(metrics.DatabaseDuckDB, time.Now())
+	return c.DB.QueryRowxContext(ctx, query, args...)
+}
+
+func (c *Client) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	defer metrics.ObserveQuery(metrics.DatabaseDuckDB, time.Now())
+	return c.DB.SelectContext(ctx, dest, query, args...)
+}
+
+func (c *Client) GetContext(ctx context.Context, dest any, query string, args ...any) error {
+	defer metrics.ObserveQuery(metrics.DatabaseDuckDB, time.Now())
+	return c.DB.GetContext(ctx, dest, query, args...)
+}
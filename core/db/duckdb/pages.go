@@ -95,8 +95,17 @@ func (c *Client) GetWebsitePages(
 	ctx context.Context,
 	filter *db.Filters,
 ) ([]*model.StatsPages, error) {
-	var pages []*model.StatsPages
+	return collectRows(func(fn func(*model.StatsPages) error) error {
+		return c.StreamWebsitePages(ctx, filter, fn)
+	})
+}
 
+// StreamWebsitePages calls fn with each row of the pages statistics for the given hostname.
+func (c *Client) StreamWebsitePages(
+	ctx context.Context,
+	filter *db.Filters,
+	fn func(*model.StatsPages) error,
+) error {
 	// Array of page paths and their relevant counts
 	//
 	// Pathname is the path of the page. If it is empty, it is the homepage and defaults to "/".
@@ -143,20 +152,8 @@ func (c *Client) GetWebsitePages(
 
 	rows, err := c.NamedQueryContext(ctx, query.Build(), filter.Args(nil))
 	if err != nil {
-		return nil, errors.Wrap(err, "db")
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var page model.StatsPages
-
-		err := rows.StructScan(&page)
-		if err != nil {
-			return nil, errors.Wrap(err, "db")
-		}
-
-		pages = append(pages, &page)
+		return errors.Wrap(err, "db")
 	}
 
-	return pages, nil
+	return scanRows(rows, fn)
 }
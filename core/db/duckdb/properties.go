@@ -14,13 +14,23 @@ const (
 	EventsPercentageStmt = "ifnull(ROUND(COUNT(*) / (SELECT total_events FROM total), 4), 0) AS events_percentage"
 )
 
-// GetWebsiteReferrersSummary returns a summary of the referrers for the given filters.
+// GetWebsiteCustomProperties returns the custom properties for the given filters.
 func (c *Client) GetWebsiteCustomProperties(
 	ctx context.Context,
 	filter *db.Filters,
 ) ([]*model.StatsCustomProperties, error) {
-	var properties []*model.StatsCustomProperties
+	return collectRows(func(fn func(*model.StatsCustomProperties) error) error {
+		return c.StreamWebsiteCustomProperties(ctx, filter, fn)
+	})
+}
 
+// StreamWebsiteCustomProperties calls fn with each row of the custom properties
+// for the given filters.
+func (c *Client) StreamWebsiteCustomProperties(
+	ctx context.Context,
+	filter *db.Filters,
+	fn func(*model.StatsCustomProperties) error,
+) error {
 	// Array of custom properties
 	//
 	// Name is the event key name
@@ -54,9 +64,15 @@ func (c *Client) GetWebsiteCustomProperties(
 			Pagination(filter.PaginationString())
 	} else {
 		// If the property name is not empty, return the property name with its
-		// values, events and visitors.
+		// values, events and visitors. The name is only included when
+		// filtering by multiple property names to tell the values apart.
+		nameColumn := "'' AS name"
+		if filter.PropertyName.Values != nil {
+			nameColumn = "name"
+		}
+
 		query = query.Select(
-			"'' AS name",
+			nameColumn,
 			"value",
 			EventsCountStmt,
 			EventsPercentageStmt,
@@ -71,20 +87,8 @@ func (c *Client) GetWebsiteCustomProperties(
 
 	rows, err := c.NamedQueryContext(ctx, query.Build(), filter.Args(nil))
 	if err != nil {
-		return nil, errors.Wrap(err, "db")
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var property model.StatsCustomProperties
-
-		err := rows.StructScan(&property)
-		if err != nil {
-			return nil, errors.Wrap(err, "db")
-		}
-
-		properties = append(properties, &property)
+		return errors.Wrap(err, "db")
 	}
 
-	return properties, nil
+	return scanRows(rows, fn)
 }
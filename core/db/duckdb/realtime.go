@@ -0,0 +1,64 @@
+package duckdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-faster/errors"
+	qb "github.com/medama-io/medama/db/duckdb/query"
+	"github.com/medama-io/medama/model"
+)
+
+// GetRealtimeHits returns the page views of every website in the last given
+// minutes ordered by time, to restore the realtime stats after a restart.
+//
+// Page views from before visits were tracked count as their own visit and
+// the referrer is the grouped referrer if known, the same as recorded hits.
+func (c *Client) GetRealtimeHits(
+	ctx context.Context,
+	minutes int,
+) (map[string][]model.RealtimeHit, error) {
+	query := qb.New().
+		Select(
+			"hostname",
+			VisitIDStmt+" AS visit_id",
+			"pathname",
+			"COALESCE(NULLIF(referrer_group, ''), referrer_host, '') AS referrer",
+			"date_created",
+		).
+		From("views").
+		Where("date_created >= now() - to_minutes(CAST(:minutes AS BIGINT))").
+		OrderBy("date_created ASC")
+
+	rows, err := c.NamedQueryContext(ctx, query.Build(), map[string]any{"minutes": minutes})
+	if err != nil {
+		return nil, errors.Wrap(err, "db")
+	}
+
+	hits := make(map[string][]model.RealtimeHit)
+
+	err = scanRows(rows, func(row *realtimeHitRow) error {
+		hits[row.Hostname] = append(hits[row.Hostname], model.RealtimeHit{
+			VisitID:  row.VisitID,
+			Pathname: row.Pathname,
+			Referrer: row.Referrer,
+			Time:     row.DateCreated,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hits, nil
+}
+
+// realtimeHitRow is a page view of a website as returned by GetRealtimeHits.
+type realtimeHitRow struct {
+	Hostname    string    `db:"hostname"`
+	VisitID     string    `db:"visit_id"`
+	Pathname    string    `db:"pathname"`
+	Referrer    string    `db:"referrer"`
+	DateCreated time.Time `db:"date_created"`
+}
@@ -0,0 +1,79 @@
+package duckdb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/model"
+)
+
+func TestGetRealtimeHits(t *testing.T) {
+	assert, require, ctx, client := SetupDatabase(t)
+
+	hostname := "1.example.com"
+	views := []struct {
+		bid           string
+		visitID       any
+		pathname      string
+		referrerHost  string
+		referrerGroup string
+		minutesAgo    int
+	}{
+		{"bid_1", "visit_1", "/", "www.google.com", "Google", 1},
+		{"bid_2", "visit_1", "/pricing", "", "", 0},
+		{"bid_3", "visit_2", "/pricing", "news.ycombinator.com", "", 2},
+		// Page views from before visits were tracked count as their own visit.
+		{"bid_4", nil, "/", "", "", 3},
+		// Outside of the default window.
+		{"bid_5", "visit_3", "/about", "www.bing.com", "Bing", 10},
+	}
+
+	for _, view := range views {
+		_, err := client.ExecContext(ctx, `--sql
+			INSERT INTO views (bid, visit_id, hostname, pathname, referrer_host, referrer_group,
+				is_unique_user, is_unique_page, ua_browser, ua_os, ua_device_type, date_created)
+			VALUES (?, ?, ?, ?, ?, ?, true, true, 'Chrome', 'Windows', 'Desktop',
+				now() - to_minutes(?) + INTERVAL '1 SECOND')`,
+			view.bid, view.visitID, hostname, view.pathname, view.referrerHost, view.referrerGroup, view.minutesAgo)
+		require.NoError(err)
+	}
+
+	// Other websites are returned separately.
+	_, err := client.ExecContext(ctx, `--sql
+		INSERT INTO views (bid, visit_id, hostname, pathname, is_unique_user, is_unique_page,
+			ua_browser, ua_os, ua_device_type, date_created)
+		VALUES ('bid_6', 'visit_4', '2.example.com', '/', true, true, 'Chrome', 'Windows', 'Desktop', now())`)
+	require.NoError(err)
+
+	hits, err := client.GetRealtimeHits(ctx, 5)
+	require.NoError(err)
+	require.Len(hits, 2)
+	require.Len(hits[hostname], 4)
+
+	got := make([]model.RealtimeHit, 0, len(hits[hostname]))
+	for i, hit := range hits[hostname] {
+		if i > 0 {
+			assert.False(hit.Time.Before(hits[hostname][i-1].Time), "hits should be ordered by time")
+		}
+
+		assert.WithinDuration(time.Now(), hit.Time, 5*time.Minute)
+
+		hit.Time = time.Time{}
+		got = append(got, hit)
+	}
+
+	assert.Equal([]model.RealtimeHit{
+		{VisitID: "bid_4", Pathname: "/", Referrer: ""},
+		{VisitID: "visit_2", Pathname: "/pricing", Referrer: "news.ycombinator.com"},
+		{VisitID: "visit_1", Pathname: "/", Referrer: "Google"},
+		{VisitID: "visit_1", Pathname: "/pricing", Referrer: ""},
+	}, got)
+
+	assert.Len(hits["2.example.com"], 1)
+
+	hits, err = client.GetRealtimeHits(ctx, 30)
+	require.NoError(err)
+	require.Len(hits[hostname], 5)
+	assert.Equal("visit_3", hits[hostname][0].VisitID)
+	assert.Equal("Bing", hits[hostname][0].Referrer)
+}
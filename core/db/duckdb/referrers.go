@@ -2,6 +2,7 @@ package duckdb
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/medama-io/medama/db"
@@ -74,8 +75,18 @@ func (c *Client) GetWebsiteReferrers(
 	isGroup bool,
 	filter *db.Filters,
 ) ([]*model.StatsReferrers, error) {
-	var referrers []*model.StatsReferrers
+	return collectRows(func(fn func(*model.StatsReferrers) error) error {
+		return c.StreamWebsiteReferrers(ctx, isGroup, filter, fn)
+	})
+}
 
+// StreamWebsiteReferrers calls fn with each row of the referrers for the given hostname.
+func (c *Client) StreamWebsiteReferrers(
+	ctx context.Context,
+	isGroup bool,
+	filter *db.Filters,
+	fn func(*model.StatsReferrers) error,
+) error {
 	referrerStmt := "referrer_host AS referrer"
 	if isGroup {
 		referrerStmt = "IF(referrer_group == '', referrer_host, referrer_group) AS referrer"
@@ -115,19 +126,31 @@ func (c *Client) GetWebsiteReferrers(
 
 	rows, err := c.NamedQueryContext(ctx, query.Build(), filter.Args(nil))
 	if err != nil {
-		return nil, errors.Wrap(err, "db")
+		return errors.Wrap(err, "db")
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var referrer model.StatsReferrers
 
-		err := rows.StructScan(&referrer)
-		if err != nil {
-			return nil, errors.Wrap(err, "db")
-		}
+	return scanRows(rows, fn)
+}
 
-		referrers = append(referrers, &referrer)
+// GetNewReferrers returns the referrer hostnames of the website whose first
+// page view was received between since and until, ordered by hostname.
+func (c *Client) GetNewReferrers(
+	ctx context.Context,
+	hostname string,
+	since time.Time,
+	until time.Time,
+) ([]string, error) {
+	referrers := []string{}
+
+	err := c.SelectContext(ctx, &referrers, `--sql
+		SELECT referrer_host
+		FROM views
+		WHERE hostname = ? AND referrer_host IS NOT NULL AND referrer_host != ''
+		GROUP BY referrer_host
+		HAVING min(date_created) >= ? AND min(date_created) < ?
+		ORDER BY referrer_host ASC`, hostname, since, until)
+	if err != nil {
+		return nil, errors.Wrap(err, "db")
 	}
 
 	return referrers, nil
@@ -0,0 +1,79 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/jmoiron/sqlx"
+)
+
+// DeleteWebsiteDataBefore removes all page views and custom events of the
+// website created before the cutoff in a single transaction. It returns the
+// number of deleted page views and events.
+func (c *Client) DeleteWebsiteDataBefore(
+	ctx context.Context,
+	hostname string,
+	cutoff time.Time,
+) (int64, int64, error) {
+	var views, events int64
+
+	err := c.executeInTransaction(ctx, func(tx *sqlx.Tx) error {
+		res, err := tx.ExecContext(ctx, `--sql
+			DELETE FROM events WHERE group_name = ? AND date_created < ?`, hostname, cutoff)
+		if err != nil {
+			return errors.Wrap(err, "db")
+		}
+
+		events, err = res.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "db")
+		}
+
+		res, err = tx.ExecContext(ctx, `--sql
+			DELETE FROM views WHERE hostname = ? AND date_created < ?`, hostname, cutoff)
+		if err != nil {
+			return errors.Wrap(err, "db")
+		}
+
+		views, err = res.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "db")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return views, events, nil
+}
+
+// Compact writes all changes to the database file so the blocks of deleted
+// rows can be reused, and reclaims the space where possible.
+func (c *Client) Compact(ctx context.Context) error {
+	for _, query := range []string{"CHECKPOINT;", "VACUUM;"} {
+		_, err := c.ExecContext(ctx, query)
+		if err != nil {
+			return errors.Wrap(err, "db")
+		}
+	}
+
+	return nil
+}
+
+// GetOldestPageView returns the date of the oldest page view across all
+// websites. The zero time is returned if there are no page views.
+func (c *Client) GetOldestPageView(ctx context.Context) (time.Time, error) {
+	var oldest sql.NullTime
+
+	err := c.QueryRowxContext(ctx, `--sql
+		SELECT MIN(date_created) FROM views`).Scan(&oldest)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "db")
+	}
+
+	return oldest.Time, nil
+}
@@ -0,0 +1,91 @@
+package duckdb_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/model"
+)
+
+func TestDeleteWebsiteDataBefore(t *testing.T) {
+	assert, require, ctx, client := SetupDatabase(t)
+
+	oldest, err := client.GetOldestPageView(ctx)
+	require.NoError(err)
+	assert.True(oldest.IsZero())
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	views := make([]model.ImportedPageView, 0, 6)
+	events := make([]model.ImportedEvent, 0, 6)
+
+	for idx, hostname := range []string{"1.example.com", "2.example.com"} {
+		for days := range 3 {
+			bid := hostname + "_" + strconv.Itoa(days)
+			date := now.AddDate(0, 0, -days*30)
+
+			views = append(views, model.ImportedPageView{
+				PageViewHit: model.PageViewHit{
+					BID:          bid,
+					Hostname:     hostname,
+					Pathname:     "/",
+					IsUniqueUser: true,
+					IsUniquePage: true,
+				},
+				DateCreated: date.Add(time.Duration(idx) * time.Minute),
+			})
+
+			events = append(events, model.ImportedEvent{
+				EventHit: model.EventHit{
+					BID:     bid,
+					BatchID: bid,
+					Group:   hostname,
+					Name:    "event",
+					Value:   "signup",
+				},
+				DateCreated: date,
+			})
+		}
+	}
+
+	require.NoError(client.InsertImportedViews(ctx, "import_retention", views))
+	require.NoError(client.InsertImportedEvents(ctx, "import_retention", events))
+
+	oldest, err = client.GetOldestPageView(ctx)
+	require.NoError(err)
+	assert.True(now.AddDate(0, 0, -60).Equal(oldest))
+
+	// Only rows of the website older than the cutoff are deleted.
+	deletedViews, deletedEvents, err := client.DeleteWebsiteDataBefore(
+		ctx, "1.example.com", now.AddDate(0, 0, -45),
+	)
+	require.NoError(err)
+	assert.Equal(int64(1), deletedViews)
+	assert.Equal(int64(1), deletedEvents)
+
+	deletedViews, deletedEvents, err = client.DeleteWebsiteDataBefore(
+		ctx, "2.example.com", now.AddDate(0, 0, -1),
+	)
+	require.NoError(err)
+	assert.Equal(int64(2), deletedViews)
+	assert.Equal(int64(2), deletedEvents)
+
+	var remaining int
+
+	row := client.QueryRowxContext(ctx, `--sql
+		SELECT COUNT(*) FROM views WHERE hostname = '1.example.com'`)
+	require.NoError(row.Scan(&remaining))
+	assert.Equal(2, remaining)
+
+	row = client.QueryRowxContext(ctx, `--sql
+		SELECT COUNT(*) FROM events WHERE group_name = '2.example.com'`)
+	require.NoError(row.Scan(&remaining))
+	assert.Equal(1, remaining)
+
+	require.NoError(client.Compact(ctx))
+
+	oldest, err = client.GetOldestPageView(ctx)
+	require.NoError(err)
+	assert.True(now.AddDate(0, 0, -30).Equal(oldest))
+}
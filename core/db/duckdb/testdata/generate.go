@@ -74,6 +74,7 @@ func main() {
 		"duckdb",             // userID
 		"duckdb@example.com", // email
 		"testtest",           // password
+		model.UserRoleOwner,  // role
 		"en",                 // language
 		1,                    // dateCreated
 		2,                    // dateUpdated
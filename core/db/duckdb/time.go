@@ -79,8 +79,17 @@ func (c *Client) GetWebsiteTime(
 	ctx context.Context,
 	filter *db.Filters,
 ) ([]*model.StatsTime, error) {
-	var times []*model.StatsTime
+	return collectRows(func(fn func(*model.StatsTime) error) error {
+		return c.StreamWebsiteTime(ctx, filter, fn)
+	})
+}
 
+// StreamWebsiteTime calls fn with each row of the time for the given hostname.
+func (c *Client) StreamWebsiteTime(
+	ctx context.Context,
+	filter *db.Filters,
+	fn func(*model.StatsTime) error,
+) error {
 	// Array of time summaries
 	//
 	// Pathname is the pathname of the page.
@@ -128,20 +137,8 @@ func (c *Client) GetWebsiteTime(
 
 	rows, err := c.NamedQueryContext(ctx, query.Build(), filter.Args(nil))
 	if err != nil {
-		return nil, errors.Wrap(err, "db")
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var time model.StatsTime
-
-		err := rows.StructScan(&time)
-		if err != nil {
-			return nil, errors.Wrap(err, "db")
-		}
-
-		times = append(times, &time)
+		return errors.Wrap(err, "db")
 	}
 
-	return times, nil
+	return scanRows(rows, fn)
 }
@@ -61,12 +61,22 @@ func (c *Client) GetWebsiteBrowsersSummary(
 	return browsers, nil
 }
 
+// GetWebsiteBrowsers returns the browsers for the given hostname.
 func (c *Client) GetWebsiteBrowsers(
 	ctx context.Context,
 	filter *db.Filters,
 ) ([]*model.StatsBrowsers, error) {
-	var browsers []*model.StatsBrowsers
+	return collectRows(func(fn func(*model.StatsBrowsers) error) error {
+		return c.StreamWebsiteBrowsers(ctx, filter, fn)
+	})
+}
 
+// StreamWebsiteBrowsers calls fn with each row of the browsers for the given hostname.
+func (c *Client) StreamWebsiteBrowsers(
+	ctx context.Context,
+	filter *db.Filters,
+	fn func(*model.StatsBrowsers) error,
+) error {
 	// Array of browsers
 	//
 	// Browser is the browser name associated with the page.
@@ -100,22 +110,10 @@ func (c *Client) GetWebsiteBrowsers(
 
 	rows, err := c.NamedQueryContext(ctx, query.Build(), filter.Args(nil))
 	if err != nil {
-		return nil, errors.Wrap(err, "db")
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var browser model.StatsBrowsers
-
-		err := rows.StructScan(&browser)
-		if err != nil {
-			return nil, errors.Wrap(err, "db")
-		}
-
-		browsers = append(browsers, &browser)
+		return errors.Wrap(err, "db")
 	}
 
-	return browsers, nil
+	return scanRows(rows, fn)
 }
 
 func (c *Client) GetWebsiteOSSummary(
@@ -171,8 +169,17 @@ func (c *Client) GetWebsiteOSSummary(
 
 // GetWebsiteOS returns the operating systems for the given hostname.
 func (c *Client) GetWebsiteOS(ctx context.Context, filter *db.Filters) ([]*model.StatsOS, error) {
-	var os []*model.StatsOS
+	return collectRows(func(fn func(*model.StatsOS) error) error {
+		return c.StreamWebsiteOS(ctx, filter, fn)
+	})
+}
 
+// StreamWebsiteOS calls fn with each row of the operating systems for the given hostname.
+func (c *Client) StreamWebsiteOS(
+	ctx context.Context,
+	filter *db.Filters,
+	fn func(*model.StatsOS) error,
+) error {
 	// Array of operating systems
 	//
 	// OS is the operating system associated with the page.
@@ -202,22 +209,10 @@ func (c *Client) GetWebsiteOS(ctx context.Context, filter *db.Filters) ([]*model
 
 	rows, err := c.NamedQueryContext(ctx, query.Build(), filter.Args(nil))
 	if err != nil {
-		return nil, errors.Wrap(err, "db")
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var o model.StatsOS
-
-		err := rows.StructScan(&o)
-		if err != nil {
-			return nil, errors.Wrap(err, "db")
-		}
-
-		os = append(os, &o)
+		return errors.Wrap(err, "db")
 	}
 
-	return os, nil
+	return scanRows(rows, fn)
 }
 
 func (c *Client) GetWebsiteDevicesSummary(
@@ -276,8 +271,17 @@ func (c *Client) GetWebsiteDevices(
 	ctx context.Context,
 	filter *db.Filters,
 ) ([]*model.StatsDevices, error) {
-	var devices []*model.StatsDevices
+	return collectRows(func(fn func(*model.StatsDevices) error) error {
+		return c.StreamWebsiteDevices(ctx, filter, fn)
+	})
+}
 
+// StreamWebsiteDevices calls fn with each row of the devices for the given hostname.
+func (c *Client) StreamWebsiteDevices(
+	ctx context.Context,
+	filter *db.Filters,
+	fn func(*model.StatsDevices) error,
+) error {
 	// Array of devices
 	//
 	// Device is the device type associated with the page.
@@ -307,20 +311,8 @@ func (c *Client) GetWebsiteDevices(
 
 	rows, err := c.NamedQueryContext(ctx, query.Build(), filter.Args(nil))
 	if err != nil {
-		return nil, errors.Wrap(err, "db")
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var device model.StatsDevices
-
-		err := rows.StructScan(&device)
-		if err != nil {
-			return nil, errors.Wrap(err, "db")
-		}
-
-		devices = append(devices, &device)
+		return errors.Wrap(err, "db")
 	}
 
-	return devices, nil
+	return scanRows(rows, fn)
 }
@@ -65,8 +65,17 @@ func (c *Client) GetWebsiteUTMSources(
 	ctx context.Context,
 	filter *db.Filters,
 ) ([]*model.StatsUTMSources, error) {
-	var utms []*model.StatsUTMSources
+	return collectRows(func(fn func(*model.StatsUTMSources) error) error {
+		return c.StreamWebsiteUTMSources(ctx, filter, fn)
+	})
+}
 
+// StreamWebsiteUTMSources calls fn with each row of the utm sources for the given hostname.
+func (c *Client) StreamWebsiteUTMSources(
+	ctx context.Context,
+	filter *db.Filters,
+	fn func(*model.StatsUTMSources) error,
+) error {
 	// Array of utm sources
 	//
 	// Source is the utm source. Ignore if empty.
@@ -100,22 +109,10 @@ func (c *Client) GetWebsiteUTMSources(
 
 	rows, err := c.NamedQueryContext(ctx, query.Build(), filter.Args(nil))
 	if err != nil {
-		return nil, errors.Wrap(err, "db")
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var utm model.StatsUTMSources
-
-		err := rows.StructScan(&utm)
-		if err != nil {
-			return nil, errors.Wrap(err, "db")
-		}
-
-		utms = append(utms, &utm)
+		return errors.Wrap(err, "db")
 	}
 
-	return utms, nil
+	return scanRows(rows, fn)
 }
 
 func (c *Client) GetWebsiteUTMMediumsSummary(
@@ -174,8 +171,17 @@ func (c *Client) GetWebsiteUTMMediums(
 	ctx context.Context,
 	filter *db.Filters,
 ) ([]*model.StatsUTMMediums, error) {
-	var utms []*model.StatsUTMMediums
+	return collectRows(func(fn func(*model.StatsUTMMediums) error) error {
+		return c.StreamWebsiteUTMMediums(ctx, filter, fn)
+	})
+}
 
+// StreamWebsiteUTMMediums calls fn with each row of the utm mediums for the given hostname.
+func (c *Client) StreamWebsiteUTMMediums(
+	ctx context.Context,
+	filter *db.Filters,
+	fn func(*model.StatsUTMMediums) error,
+) error {
 	// Array of utm mediums
 	//
 	// Medium is the utm medium.
@@ -209,22 +215,10 @@ func (c *Client) GetWebsiteUTMMediums(
 
 	rows, err := c.NamedQueryContext(ctx, query.Build(), filter.Args(nil))
 	if err != nil {
-		return nil, errors.Wrap(err, "db")
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var utm model.StatsUTMMediums
-
-		err := rows.StructScan(&utm)
-		if err != nil {
-			return nil, errors.Wrap(err, "db")
-		}
-
-		utms = append(utms, &utm)
+		return errors.Wrap(err, "db")
 	}
 
-	return utms, nil
+	return scanRows(rows, fn)
 }
 
 func (c *Client) GetWebsiteUTMCampaignsSummary(
@@ -283,8 +277,17 @@ func (c *Client) GetWebsiteUTMCampaigns(
 	ctx context.Context,
 	filter *db.Filters,
 ) ([]*model.StatsUTMCampaigns, error) {
-	var utms []*model.StatsUTMCampaigns
+	return collectRows(func(fn func(*model.StatsUTMCampaigns) error) error {
+		return c.StreamWebsiteUTMCampaigns(ctx, filter, fn)
+	})
+}
 
+// StreamWebsiteUTMCampaigns calls fn with each row of the utm campaigns for the given hostname.
+func (c *Client) StreamWebsiteUTMCampaigns(
+	ctx context.Context,
+	filter *db.Filters,
+	fn func(*model.StatsUTMCampaigns) error,
+) error {
 	// Array of utm campaigns
 	//
 	// Campaign is the utm campaign.
@@ -318,20 +321,8 @@ func (c *Client) GetWebsiteUTMCampaigns(
 
 	rows, err := c.NamedQueryContext(ctx, query.Build(), filter.Args(nil))
 	if err != nil {
-		return nil, errors.Wrap(err, "db")
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var utm model.StatsUTMCampaigns
-
-		err := rows.StructScan(&utm)
-		if err != nil {
-			return nil, errors.Wrap(err, "db")
-		}
-
-		utms = append(utms, &utm)
+		return errors.Wrap(err, "db")
 	}
 
-	return utms, nil
+	return scanRows(rows, fn)
 }
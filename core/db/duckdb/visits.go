@@ -0,0 +1,181 @@
+package duckdb
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db"
+	qb "github.com/medama-io/medama/db/duckdb/query"
+	"github.com/medama-io/medama/model"
+)
+
+const (
+	// VisitBounceRateStmt is the share of visits that only viewed a single page.
+	// This expects the visit_pageviews column from visitPagesCTE.
+	VisitBounceRateStmt = "ifnull(ROUND(COUNT(*) FILTER (WHERE visit_pageviews = 1) / COUNT(*), 4), 0) AS bounce_rate"
+	// VisitDurationStmt is the median total duration of the visits.
+	// This expects the visit_duration column from visitPagesCTE.
+	VisitDurationStmt = "CAST(ifnull(median(visit_duration), 0) AS INTEGER) AS duration"
+)
+
+// visitPagesCTE declares a materialized CTE of the page views matching the
+// filters ranked by their position within each visit. The first page view of a
+// visit has an entry_rank of 1 and the last page view has an exit_rank of 1.
+func visitPagesCTE(whereClause string, isCustomEvent bool) qb.CTE {
+	// Custom event joins can return a row per event property, so page views
+	// are deduplicated before ranking.
+	viewsQuery := qb.New().
+		Select(
+			"DISTINCT views.bid",
+			VisitIDStmt+" AS visit_id",
+			"views.pathname",
+			"views.duration_ms",
+			"views.date_created",
+		).
+		From("views").
+		Where(whereClause)
+
+	if isCustomEvent {
+		viewsQuery = viewsQuery.
+			LeftJoin(EventsJoinStmt)
+	}
+
+	query := qb.New().
+		Select(
+			"visit_id",
+			"pathname",
+			"ROW_NUMBER() OVER (PARTITION BY visit_id ORDER BY date_created ASC, bid ASC) AS entry_rank",
+			"ROW_NUMBER() OVER (PARTITION BY visit_id ORDER BY date_created DESC, bid DESC) AS exit_rank",
+			"COUNT(*) OVER (PARTITION BY visit_id) AS visit_pageviews",
+			"SUM(duration_ms) OVER (PARTITION BY visit_id) AS visit_duration",
+		).
+		From("(" + viewsQuery.Build() + ")")
+
+	return qb.NewCTE("visit_pages", query)
+}
+
+// totalVisitsCTE declares a materialized CTE to calculate the total number of
+// visits. This expects the visit_pages CTE to be present.
+func totalVisitsCTE() qb.CTE {
+	return qb.NewCTE("total", qb.New().
+		Select("COUNT(*) AS total_visitors").
+		From("visit_pages").
+		Where("entry_rank = 1"))
+}
+
+// visitPagesWhere returns the condition selecting the entry or exit page of
+// each visit.
+func visitPagesWhere(isExit bool) string {
+	if isExit {
+		return "exit_rank = 1"
+	}
+
+	return "entry_rank = 1"
+}
+
+// GetWebsiteVisitPagesSummary returns a summary of the entry or exit pages for
+// the given filters.
+func (c *Client) GetWebsiteVisitPagesSummary(
+	ctx context.Context,
+	isExit bool,
+	filter *db.Filters,
+) ([]*model.StatsVisitPagesSummary, error) {
+	var pages []*model.StatsVisitPagesSummary
+
+	// Array of entry or exit pages
+	//
+	// Pathname is the path of the first page of a visit, or the last page if
+	// isExit is true.
+	//
+	// Visitors is the number of visits that started or ended on the pathname.
+	//
+	// VisitorsPercentage is the percentage of all visits that started or ended
+	// on the pathname.
+	query := qb.New().
+		WithMaterialized(visitPagesCTE(filter.WhereString(), filter.IsCustomEvent)).
+		WithMaterialized(totalVisitsCTE()).
+		Select(
+			"pathname",
+			"COUNT(*) AS visitors",
+			VisitorsPercentageStmt,
+		).
+		From("visit_pages").
+		Where(visitPagesWhere(isExit)).
+		GroupBy("pathname").
+		OrderBy("visitors DESC", "pathname ASC").
+		Pagination(filter.PaginationString())
+
+	rows, err := c.NamedQueryContext(ctx, query.Build(), filter.Args(nil))
+	if err != nil {
+		return nil, errors.Wrap(err, "db")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var page model.StatsVisitPagesSummary
+
+		err := rows.StructScan(&page)
+		if err != nil {
+			return nil, errors.Wrap(err, "db")
+		}
+
+		pages = append(pages, &page)
+	}
+
+	return pages, nil
+}
+
+// GetWebsiteVisitPages returns the entry or exit pages for the given filters.
+func (c *Client) GetWebsiteVisitPages(
+	ctx context.Context,
+	isExit bool,
+	filter *db.Filters,
+) ([]*model.StatsVisitPages, error) {
+	return collectRows(func(fn func(*model.StatsVisitPages) error) error {
+		return c.StreamWebsiteVisitPages(ctx, isExit, filter, fn)
+	})
+}
+
+// StreamWebsiteVisitPages calls fn with each row of the entry or exit pages for the given filters.
+func (c *Client) StreamWebsiteVisitPages(
+	ctx context.Context,
+	isExit bool,
+	filter *db.Filters,
+	fn func(*model.StatsVisitPages) error,
+) error {
+	// Array of entry or exit pages
+	//
+	// Pathname is the path of the first page of a visit, or the last page if
+	// isExit is true.
+	//
+	// Visitors is the number of visits that started or ended on the pathname.
+	//
+	// VisitorsPercentage is the percentage of all visits that started or ended
+	// on the pathname.
+	//
+	// BounceRate is the percentage of those visits that only viewed a single page.
+	//
+	// Duration is the median total duration of those visits in milliseconds.
+	query := qb.New().
+		WithMaterialized(visitPagesCTE(filter.WhereString(), filter.IsCustomEvent)).
+		WithMaterialized(totalVisitsCTE()).
+		Select(
+			"pathname",
+			"COUNT(*) AS visitors",
+			VisitorsPercentageStmt,
+			VisitBounceRateStmt,
+			VisitDurationStmt,
+		).
+		From("visit_pages").
+		Where(visitPagesWhere(isExit)).
+		GroupBy("pathname").
+		OrderBy("visitors DESC", "pathname ASC").
+		Pagination(filter.PaginationString())
+
+	rows, err := c.NamedQueryContext(ctx, query.Build(), filter.Args(nil))
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	return scanRows(rows, fn)
+}
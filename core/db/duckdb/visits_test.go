@@ -0,0 +1,100 @@
+package duckdb_test
+
+import (
+	"testing"
+
+	"github.com/gkampitakis/go-snaps/snaps"
+	"github.com/medama-io/medama/db"
+	"github.com/medama-io/medama/model"
+)
+
+func TestGetWebsiteVisitPages(t *testing.T) {
+	assert, require, ctx, client := SetupDatabase(t)
+
+	hostname := "1.example.com"
+	views := []struct {
+		bid        string
+		visitID    any
+		pathname   string
+		durationMs int
+		minute     int
+	}{
+		{"bid_1", "visit_1", "/", 1000, 0},
+		{"bid_2", "visit_1", "/pricing", 2000, 1},
+		{"bid_3", "visit_1", "/signup", 3000, 2},
+		{"bid_4", "visit_2", "/", 4000, 0},
+		{"bid_5", "visit_2", "/about", 1000, 1},
+		{"bid_6", "visit_3", "/pricing", 500, 0},
+		// Page views from before visits were tracked are single page visits.
+		{"bid_7", nil, "/", 100, 0},
+	}
+
+	for _, view := range views {
+		_, err := client.ExecContext(ctx, `--sql
+			INSERT INTO views (bid, visit_id, hostname, pathname, is_unique_user, is_unique_page,
+				ua_browser, ua_os, ua_device_type, duration_ms, date_created)
+			VALUES (?, ?, ?, ?, true, true, 'Chrome', 'Windows', 'Desktop', ?,
+				TIMESTAMPTZ '2024-01-01 00:00:00+00' + to_minutes(?))`,
+			view.bid, view.visitID, hostname, view.pathname, view.durationMs, view.minute)
+		require.NoError(err)
+	}
+
+	filters := &db.Filters{
+		Hostname:    hostname,
+		PeriodStart: TimeStart,
+		PeriodEnd:   TimeEnd,
+	}
+
+	entries, err := client.GetWebsiteVisitPages(ctx, false, filters)
+	require.NoError(err)
+	require.Len(entries, 2)
+	assert.Equal(model.StatsVisitPages{
+		StatsVisitPagesSummary: model.StatsVisitPagesSummary{
+			Pathname: "/", Visitors: 3, VisitorsPercentage: 0.75,
+		},
+		BounceRate: 0.3333,
+		Duration:   5000,
+	}, *entries[0])
+	assert.Equal(model.StatsVisitPages{
+		StatsVisitPagesSummary: model.StatsVisitPagesSummary{
+			Pathname: "/pricing", Visitors: 1, VisitorsPercentage: 0.25,
+		},
+		BounceRate: 1,
+		Duration:   500,
+	}, *entries[1])
+
+	exits, err := client.GetWebsiteVisitPages(ctx, true, filters)
+	require.NoError(err)
+	require.Len(exits, 4)
+
+	pathnames := make([]string, 0, len(exits))
+	for _, exit := range exits {
+		assert.Equal(1, exit.Visitors)
+		pathnames = append(pathnames, exit.Pathname)
+	}
+
+	assert.Equal([]string{"/", "/about", "/pricing", "/signup"}, pathnames)
+
+	summary, err := client.GetWebsiteVisitPagesSummary(ctx, true, filters)
+	require.NoError(err)
+	require.Len(summary, 4)
+	assert.Equal(model.StatsVisitPagesSummary{
+		Pathname: "/signup", Visitors: 1, VisitorsPercentage: 0.25,
+	}, *summary[3])
+}
+
+func TestGetWebsiteVisitPagesFixture(t *testing.T) {
+	_, require, ctx, client := UseDatabaseFixture(t, SimpleFixture)
+
+	testCases := getBaseTestCases(MediumHostname)
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			pages, err := client.GetWebsiteVisitPages(ctx, false, tc.Filters)
+			require.NoError(err)
+
+			snap := NewSnapRecords(pages)
+			snaps.MatchSnapshot(t, snap.Snapshot())
+		})
+	}
+}
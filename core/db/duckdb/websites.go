@@ -2,19 +2,196 @@ package duckdb
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/go-faster/errors"
+	"github.com/jmoiron/sqlx"
 )
 
-// DeleteWebsite deletes all rows associated with the given hostname.
-func (c *Client) DeleteWebsite(ctx context.Context, hostname string) error {
+// CountWebsiteData returns the number of page views and custom event
+// properties stored for the given hostname.
+func (c *Client) CountWebsiteData(ctx context.Context, hostname string) (int64, int64, error) {
+	var views, events int64
+
 	query := `--sql
-		DELETE FROM views WHERE hostname = ?;`
+		SELECT
+			(SELECT COUNT(*) FROM views WHERE hostname = ?) AS views,
+			(SELECT COUNT(*) FROM events WHERE group_name = ?) AS events`
+
+	err := c.QueryRowxContext(ctx, query, hostname, hostname).Scan(&views, &events)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "db")
+	}
+
+	return views, events, nil
+}
+
+// RenameWebsite moves all page views and custom events of the hostname to the
+// new hostname in a single transaction. If the new hostname already has data,
+// the data of both hostnames is merged. It returns the number of moved page
+// views and events.
+//
+// If update is set, it is called after the data is moved but before the
+// transaction is committed, so the website is updated in the same step. An
+// error from update rolls back the move.
+func (c *Client) RenameWebsite(
+	ctx context.Context,
+	hostname string,
+	newHostname string,
+	update func() error,
+) (int64, int64, error) {
+	var views, events int64
+
+	err := c.executeInTransaction(ctx, func(tx *sqlx.Tx) error {
+		res, err := tx.ExecContext(ctx, `--sql
+			UPDATE events SET group_name = ? WHERE group_name = ?`, newHostname, hostname)
+		if err != nil {
+			return errors.Wrap(err, "db")
+		}
+
+		events, err = res.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "db")
+		}
+
+		res, err = tx.ExecContext(ctx, `--sql
+			UPDATE views SET hostname = ? WHERE hostname = ?`, newHostname, hostname)
+		if err != nil {
+			return errors.Wrap(err, "db")
+		}
+
+		views, err = res.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "db")
+		}
+
+		if update != nil {
+			return update()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return views, events, nil
+}
+
+// DeleteWebsite deletes all page views and custom events associated with the
+// given hostname.
+//
+// Rows are deleted one month at a time starting with the oldest, where each
+// month is removed from both tables in a single transaction. The progress
+// callback is called after each month with the total number of rows deleted
+// so far. As every completed month is committed, an interrupted deletion is
+// resumed by calling DeleteWebsite again.
+func (c *Client) DeleteWebsite(
+	ctx context.Context,
+	hostname string,
+	progress func(views int64, events int64) error,
+) (int64, int64, error) {
+	var first, last sql.NullTime
+
+	query := `--sql
+		SELECT MIN(date_created), MAX(date_created) FROM (
+			SELECT date_created FROM views WHERE hostname = ?
+			UNION ALL
+			SELECT date_created FROM events WHERE group_name = ?
+		)`
+
+	err := c.QueryRowxContext(ctx, query, hostname, hostname).Scan(&first, &last)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "db")
+	}
+
+	if !first.Valid {
+		return 0, 0, nil
+	}
+
+	var views, events int64
+
+	start := first.Time.UTC()
+	cutoff := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	for {
+		cutoff = cutoff.AddDate(0, 1, 0)
+		// The last chunk has no upper bound to remove any rows written since
+		// the range was read.
+		isLast := cutoff.After(last.Time)
+
+		deletedViews, deletedEvents, err := c.deleteWebsiteChunk(ctx, hostname, cutoff, isLast)
+		if err != nil {
+			return views, events, err
+		}
+
+		views += deletedViews
+		events += deletedEvents
+
+		if progress != nil {
+			err = progress(views, events)
+			if err != nil {
+				return views, events, err
+			}
+		}
+
+		if isLast {
+			return views, events, nil
+		}
+	}
+}
+
+// deleteWebsiteChunk deletes all rows of the hostname created before the
+// cutoff, or all remaining rows if isLast is set.
+func (c *Client) deleteWebsiteChunk(
+	ctx context.Context,
+	hostname string,
+	cutoff time.Time,
+	isLast bool,
+) (int64, int64, error) {
+	var views, events int64
+
+	deleteEvents := `--sql
+		DELETE FROM events WHERE group_name = ? AND date_created < ?`
+	deleteViews := `--sql
+		DELETE FROM views WHERE hostname = ? AND date_created < ?`
+	args := []any{hostname, cutoff}
+
+	if isLast {
+		deleteEvents = `--sql
+			DELETE FROM events WHERE group_name = ?`
+		deleteViews = `--sql
+			DELETE FROM views WHERE hostname = ?`
+		args = []any{hostname}
+	}
+
+	err := c.executeInTransaction(ctx, func(tx *sqlx.Tx) error {
+		res, err := tx.ExecContext(ctx, deleteEvents, args...)
+		if err != nil {
+			return errors.Wrap(err, "db")
+		}
+
+		events, err = res.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "db")
+		}
+
+		res, err = tx.ExecContext(ctx, deleteViews, args...)
+		if err != nil {
+			return errors.Wrap(err, "db")
+		}
+
+		views, err = res.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "db")
+		}
 
-	_, err := c.ExecContext(ctx, query, hostname)
+		return nil
+	})
 	if err != nil {
-		return errors.Wrap(err, "db")
+		return 0, 0, err
 	}
 
-	return nil
+	return views, events, nil
 }
@@ -0,0 +1,154 @@
+package duckdb_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/model"
+)
+
+func TestDeleteWebsite(t *testing.T) {
+	assert, require, ctx, client := SetupDatabase(t)
+
+	start := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	var (
+		views  []model.ImportedPageView
+		events []model.ImportedEvent
+	)
+
+	// One page view and event per month for three months on both websites.
+	for _, hostname := range []string{"1.example.com", "2.example.com"} {
+		for month := range 3 {
+			bid := hostname + "_" + strconv.Itoa(month)
+			date := start.AddDate(0, month, 0)
+
+			views = append(views, model.ImportedPageView{
+				PageViewHit: model.PageViewHit{
+					BID:      bid,
+					Hostname: hostname,
+					Pathname: "/",
+				},
+				DateCreated: date,
+			})
+
+			events = append(events, model.ImportedEvent{
+				EventHit: model.EventHit{
+					BID:     bid,
+					BatchID: bid,
+					Group:   hostname,
+					Name:    "event",
+					Value:   "signup",
+				},
+				DateCreated: date,
+			})
+		}
+	}
+
+	require.NoError(client.InsertImportedViews(ctx, "import_delete", views))
+	require.NoError(client.InsertImportedEvents(ctx, "import_delete", events))
+
+	countViews, countEvents, err := client.CountWebsiteData(ctx, "1.example.com")
+	require.NoError(err)
+	assert.Equal(int64(3), countViews)
+	assert.Equal(int64(3), countEvents)
+
+	// Each month is deleted and reported separately.
+	var progress [][2]int64
+
+	deletedViews, deletedEvents, err := client.DeleteWebsite(ctx, "1.example.com",
+		func(views int64, events int64) error {
+			progress = append(progress, [2]int64{views, events})
+			return nil
+		})
+	require.NoError(err)
+	assert.Equal(int64(3), deletedViews)
+	assert.Equal(int64(3), deletedEvents)
+	assert.Equal([][2]int64{{1, 1}, {2, 2}, {3, 3}}, progress)
+
+	countViews, countEvents, err = client.CountWebsiteData(ctx, "1.example.com")
+	require.NoError(err)
+	assert.Zero(countViews)
+	assert.Zero(countEvents)
+
+	// Other websites are untouched.
+	countViews, countEvents, err = client.CountWebsiteData(ctx, "2.example.com")
+	require.NoError(err)
+	assert.Equal(int64(3), countViews)
+	assert.Equal(int64(3), countEvents)
+
+	// Deleting again is a no-op, so interrupted deletions can be resumed.
+	deletedViews, deletedEvents, err = client.DeleteWebsite(ctx, "1.example.com", nil)
+	require.NoError(err)
+	assert.Zero(deletedViews)
+	assert.Zero(deletedEvents)
+}
+
+func TestRenameWebsite(t *testing.T) {
+	assert, require, ctx, client := SetupDatabase(t)
+
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var views []model.ImportedPageView
+	for idx, hostname := range []string{"1.example.com", "1.example.com", "2.example.com"} {
+		views = append(views, model.ImportedPageView{
+			PageViewHit: model.PageViewHit{
+				BID:      "rename_" + strconv.Itoa(idx),
+				Hostname: hostname,
+				Pathname: "/",
+			},
+			DateCreated: date,
+		})
+	}
+
+	require.NoError(client.InsertImportedViews(ctx, "import_rename", views))
+	require.NoError(client.InsertImportedEvents(ctx, "import_rename", []model.ImportedEvent{{
+		EventHit: model.EventHit{
+			BID:     "rename_0",
+			BatchID: "rename_0",
+			Group:   "1.example.com",
+			Name:    "event",
+			Value:   "signup",
+		},
+		DateCreated: date,
+	}}))
+
+	movedViews, movedEvents, err := client.RenameWebsite(ctx, "1.example.com", "new.example.com", nil)
+	require.NoError(err)
+	assert.Equal(int64(2), movedViews)
+	assert.Equal(int64(1), movedEvents)
+
+	countViews, countEvents, err := client.CountWebsiteData(ctx, "new.example.com")
+	require.NoError(err)
+	assert.Equal(int64(2), countViews)
+	assert.Equal(int64(1), countEvents)
+
+	// A failed update of the website rolls back the move.
+	errUpdate := errors.New("update failed")
+	_, _, err = client.RenameWebsite(ctx, "new.example.com", "2.example.com", func() error {
+		return errUpdate
+	})
+	require.ErrorIs(err, errUpdate)
+
+	countViews, _, err = client.CountWebsiteData(ctx, "new.example.com")
+	require.NoError(err)
+	assert.Equal(int64(2), countViews)
+
+	// Renaming into a hostname with data merges both.
+	movedViews, _, err = client.RenameWebsite(ctx, "new.example.com", "2.example.com", func() error {
+		return nil
+	})
+	require.NoError(err)
+	assert.Equal(int64(2), movedViews)
+
+	countViews, countEvents, err = client.CountWebsiteData(ctx, "2.example.com")
+	require.NoError(err)
+	assert.Equal(int64(3), countViews)
+	assert.Equal(int64(1), countEvents)
+
+	countViews, _, err = client.CountWebsiteData(ctx, "1.example.com")
+	require.NoError(err)
+	assert.Zero(countViews)
+}
@@ -13,19 +13,20 @@ type FilterField string
 
 const (
 	// Views Table.
-	FilterHostname        FilterField = "hostname"
-	FilterPathname        FilterField = "pathname"
-	FilterReferrer        FilterField = "referrer_host"
-	FilterReferrerGroup   FilterField = "referrer_group"
-	FilterUTMSource       FilterField = "utm_source"
-	FilterUTMMedium       FilterField = "utm_medium"
-	FilterUTMCampaign     FilterField = "utm_campaign"
-	FilterBrowser         FilterField = "ua_browser"
-	FilterOS              FilterField = "ua_os"
-	FilterDevice          FilterField = "ua_device_type"
-	FilterCountry         FilterField = "country"
-	FilterLanguage        FilterField = "language_base"
-	FilterLanguageDialect FilterField = "language_dialect"
+	FilterHostname         FilterField = "hostname"
+	FilterOriginalHostname FilterField = "original_hostname"
+	FilterPathname         FilterField = "pathname"
+	FilterReferrer         FilterField = "referrer_host"
+	FilterReferrerGroup    FilterField = "referrer_group"
+	FilterUTMSource        FilterField = "utm_source"
+	FilterUTMMedium        FilterField = "utm_medium"
+	FilterUTMCampaign      FilterField = "utm_campaign"
+	FilterBrowser          FilterField = "ua_browser"
+	FilterOS               FilterField = "ua_os"
+	FilterDevice           FilterField = "ua_device_type"
+	FilterCountry          FilterField = "country"
+	FilterLanguage         FilterField = "language_base"
+	FilterLanguageDialect  FilterField = "language_dialect"
 
 	// Events Table.
 	FilterPropertyName  FilterField = "events.name"
@@ -55,6 +56,18 @@ const (
 	FilterNotIn         FilterOperation = "not_in"
 )
 
+// IsNegated returns true if the operation excludes matching values.
+func (o FilterOperation) IsNegated() bool {
+	switch o {
+	case FilterNotEquals, FilterNotContains, FilterNotStartsWith, FilterNotEndsWith, FilterNotIn:
+		return true
+	case FilterEquals, FilterContains, FilterStartsWith, FilterEndsWith, FilterIn:
+		return false
+	default:
+		return false
+	}
+}
+
 // FilterStringToValues converts an api.FilterString to a value and FilterOperation.
 func FilterStringToValues(filterString api.FilterString) (string, FilterOperation) {
 	switch {
@@ -88,6 +101,9 @@ type Filter struct {
 	Field     FilterField
 	Value     string
 	Operation FilterOperation
+	// Values is the list of values for the IN and NOT IN operations, split
+	// from the comma separated Value.
+	Values []string
 }
 
 // NewFilter creates a new filter.
@@ -103,17 +119,35 @@ func NewFilter(field FilterField, param api.OptFilterString) *Filter {
 		return nil
 	}
 
-	return &Filter{
+	filter := &Filter{
 		Field: field,
 		// Convert the value to lowercase to make the filter case-insensitive
 		Value:     strings.ToLower(value),
 		Operation: operation,
 	}
+
+	if operation == FilterIn || operation == FilterNotIn {
+		filter.Values = splitFilterValues(filter.Value)
+	}
+
+	return filter
+}
+
+// splitFilterValues splits a comma separated list of values, trimming any
+// surrounding whitespace. Empty values are kept as they represent an empty
+// field (e.g. direct referrers).
+func splitFilterValues(value string) []string {
+	values := strings.Split(value, ",")
+	for i, v := range values {
+		values[i] = strings.TrimSpace(v)
+	}
+
+	return values
 }
 
 // filterOperationMap maps FilterOperation to their string representations.
 //
-//nolint:exhaustive,gochecknoglobals // TODO: Implement IN and NOT IN
+//nolint:gochecknoglobals // Read-only lookup table.
 var filterOperationMap = map[FilterOperation]string{
 	FilterEquals:        "ILIKE",
 	FilterNotEquals:     "NOT ILIKE",
@@ -123,15 +157,26 @@ var filterOperationMap = map[FilterOperation]string{
 	FilterNotStartsWith: "NOT starts_with",
 	FilterEndsWith:      "ends_with",
 	FilterNotEndsWith:   "NOT ends_with",
+	FilterIn:            "list_contains",
+	FilterNotIn:         "NOT list_contains",
+}
+
+// column returns the column expression the filter is applied to.
+func (f Filter) column() string {
+	if f.Field == FilterOriginalHostname {
+		// Page views received on the website hostname have no original hostname.
+		return "COALESCE(original_hostname, hostname)"
+	}
+
+	return string(f.Field)
 }
 
 // String returns the string representation of the filter combined with the operation.
 func (f Filter) String() string {
-	//nolint:exhaustive // TODO: Implement IN and NOT IN
 	switch f.Operation {
 	case FilterEquals, FilterNotEquals:
 		// e.g. "lower(hostname) = :hostname"
-		return string(f.Field) + " " + filterOperationMap[f.Operation] + " :" + string(f.Field)
+		return f.column() + " " + filterOperationMap[f.Operation] + " :" + string(f.Field)
 	case FilterContains,
 		FilterNotContains,
 		FilterStartsWith,
@@ -139,11 +184,15 @@ func (f Filter) String() string {
 		FilterEndsWith,
 		FilterNotEndsWith:
 		// e.g. "contains(hostname, :hostname)"
-		return filterOperationMap[f.Operation] + "(LOWER(" + string(
-			f.Field,
-		) + "), LOWER(:" + string(
+		return filterOperationMap[f.Operation] + "(LOWER(" + f.column() + "), LOWER(:" + string(
 			f.Field,
 		) + "))"
+	case FilterIn, FilterNotIn:
+		// The values are bound as a list parameter in Args.
+		// e.g. "list_contains(:hostname, LOWER(hostname))"
+		return filterOperationMap[f.Operation] + "(:" + string(
+			f.Field,
+		) + ", LOWER(" + f.column() + "))"
 	default:
 		return ""
 	}
@@ -151,21 +200,22 @@ func (f Filter) String() string {
 
 // Filters is a struct that contains all the possible filters that can be applied to a query.
 type Filters struct {
-	Hostname        string
-	Pathname        *Filter
-	Referrer        *Filter
-	ReferrerGroup   *Filter
-	UTMSource       *Filter
-	UTMMedium       *Filter
-	UTMCampaign     *Filter
-	Browser         *Filter
-	OS              *Filter
-	Device          *Filter
-	Country         *Filter
-	Language        *Filter
-	LanguageDialect *Filter
-	PropertyName    *Filter
-	PropertyValue   *Filter
+	Hostname         string
+	OriginalHostname *Filter
+	Pathname         *Filter
+	Referrer         *Filter
+	ReferrerGroup    *Filter
+	UTMSource        *Filter
+	UTMMedium        *Filter
+	UTMCampaign      *Filter
+	Browser          *Filter
+	OS               *Filter
+	Device           *Filter
+	Country          *Filter
+	Language         *Filter
+	LanguageDialect  *Filter
+	PropertyName     *Filter
+	PropertyValue    *Filter
 
 	// Time Periods (in RFC3339 format 2017-07-21T17:32:28Z)
 	PeriodStart string
@@ -194,6 +244,13 @@ func CreateFilters(params any, hostname string) *Filters {
 		fieldName := t.Field(i).Name
 
 		switch fieldName {
+		case "OriginalHostname":
+			if field.IsValid() && !field.IsZero() {
+				filters.OriginalHostname = NewFilter(
+					FilterOriginalHostname,
+					field.Interface().(api.OptFilterString),
+				)
+			}
 		case "Path":
 			if field.IsValid() && !field.IsZero() {
 				filters.Pathname = NewFilter(
@@ -308,10 +365,18 @@ func addCondition(query *strings.Builder, filter *Filter) {
 }
 
 // orCondition appends a condition to the query if both filters have non-empty values.
+//
+// Negated operations must hold for both columns, otherwise a row is matched
+// as long as either column differs, so they are joined with AND instead.
 func orCondition(query *strings.Builder, filter *Filter, filter2 *Filter) {
 	switch {
 	case filter != nil && filter2 != nil:
-		query.WriteString(" AND (" + filter.String() + " OR " + filter2.String() + ")")
+		operator := " OR "
+		if filter.Operation.IsNegated() {
+			operator = " AND "
+		}
+
+		query.WriteString(" AND (" + filter.String() + operator + filter2.String() + ")")
 	case filter != nil:
 		addCondition(query, filter)
 	case filter2 != nil:
@@ -325,6 +390,7 @@ func (f Filters) WhereString() string {
 
 	// Build the query string
 	query.WriteString("hostname = :hostname")
+	addCondition(&query, f.OriginalHostname)
 	addCondition(&query, f.Pathname)
 	// If referrer = Direct/None (""), then we need to skip any referrer group
 	// filters.
@@ -401,18 +467,19 @@ func (f Filters) Args(customMap *map[string]any) map[string]any {
 
 	//nolint:exhaustive // No other fields use filter structs
 	filterValues := map[FilterField]*Filter{
-		FilterPathname:        f.Pathname,
-		FilterReferrer:        f.Referrer,
-		FilterReferrerGroup:   f.ReferrerGroup,
-		FilterUTMSource:       f.UTMSource,
-		FilterUTMMedium:       f.UTMMedium,
-		FilterUTMCampaign:     f.UTMCampaign,
-		FilterBrowser:         f.Browser,
-		FilterOS:              f.OS,
-		FilterDevice:          f.Device,
-		FilterCountry:         f.Country,
-		FilterLanguage:        f.Language,
-		FilterLanguageDialect: f.LanguageDialect,
+		FilterOriginalHostname: f.OriginalHostname,
+		FilterPathname:         f.Pathname,
+		FilterReferrer:         f.Referrer,
+		FilterReferrerGroup:    f.ReferrerGroup,
+		FilterUTMSource:        f.UTMSource,
+		FilterUTMMedium:        f.UTMMedium,
+		FilterUTMCampaign:      f.UTMCampaign,
+		FilterBrowser:          f.Browser,
+		FilterOS:               f.OS,
+		FilterDevice:           f.Device,
+		FilterCountry:          f.Country,
+		FilterLanguage:         f.Language,
+		FilterLanguageDialect:  f.LanguageDialect,
 
 		FilterPropertyName:  f.PropertyName,
 		FilterPropertyValue: f.PropertyValue,
@@ -421,7 +488,11 @@ func (f Filters) Args(customMap *map[string]any) map[string]any {
 	// Add non-empty filter values to args
 	for field, filter := range filterValues {
 		if filter != nil {
-			args[string(field)] = filter.Value
+			if filter.Values != nil {
+				args[string(field)] = filter.Values
+			} else {
+				args[string(field)] = filter.Value
+			}
 		}
 	}
 
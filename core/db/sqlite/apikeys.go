@@ -0,0 +1,194 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+	"github.com/ncruces/go-sqlite3"
+)
+
+// CreateAPIKey adds a new API key. Keys scoped to a website that does not
+// exist return model.ErrWebsiteNotFound.
+func (c *Client) CreateAPIKey(ctx context.Context, key *model.APIKey) error {
+	exec := `--sql
+	INSERT INTO api_keys (
+		id,
+		user_id,
+		name,
+		hash,
+		role,
+		hostname,
+		date_expires,
+		date_created,
+		date_updated
+	) VALUES (
+		:id,
+		:user_id,
+		:name,
+		:hash,
+		:role,
+		NULLIF(:hostname, ''),
+		NULLIF(:date_expires, 0),
+		:date_created,
+		:date_updated
+	)`
+
+	paramMap := map[string]any{
+		"id":           key.ID,
+		"user_id":      key.UserID,
+		"name":         key.Name,
+		"hash":         key.Hash,
+		"role":         key.Role,
+		"hostname":     key.Hostname,
+		"date_expires": key.DateExpires,
+		"date_created": key.DateCreated,
+		dateUpdatedKey: key.DateUpdated,
+	}
+
+	_, err := c.NamedExecContext(ctx, exec, paramMap)
+	if err != nil {
+		if errors.Is(err, sqlite3.CONSTRAINT_FOREIGNKEY) {
+			return model.ErrWebsiteNotFound
+		}
+
+		log := logger.Get()
+		log.Error().
+			Str("id", key.ID).
+			Str("user_id", key.UserID).
+			Str("hostname", key.Hostname).
+			Err(err).
+			Msg("failed to create api key")
+
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
+
+// ListAPIKeys returns all API keys of the user ordered by creation date.
+func (c *Client) ListAPIKeys(ctx context.Context, userID string) ([]*model.APIKey, error) {
+	var keys []*model.APIKey
+
+	query := `--sql
+	SELECT
+		id,
+		user_id,
+		name,
+		hash,
+		role,
+		COALESCE(hostname, '') AS hostname,
+		COALESCE(date_expires, 0) AS date_expires,
+		COALESCE(date_last_used, 0) AS date_last_used,
+		date_created,
+		date_updated
+	FROM api_keys WHERE user_id = ? ORDER BY date_created ASC, id ASC`
+
+	err := c.SelectContext(ctx, &keys, query, userID)
+	if err != nil {
+		log := logger.Get()
+		log.Error().
+			Str("user_id", userID).
+			Err(err).
+			Msg("failed to list api keys")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	if len(keys) == 0 {
+		// Return empty slice instead of nil
+		return []*model.APIKey{}, nil
+	}
+
+	return keys, nil
+}
+
+func (c *Client) GetAPIKey(ctx context.Context, id string) (*model.APIKey, error) {
+	var key model.APIKey
+
+	log := logger.Get()
+
+	query := `--sql
+	SELECT
+		id,
+		user_id,
+		name,
+		hash,
+		role,
+		COALESCE(hostname, '') AS hostname,
+		COALESCE(date_expires, 0) AS date_expires,
+		COALESCE(date_last_used, 0) AS date_last_used,
+		date_created,
+		date_updated
+	FROM api_keys WHERE id = ?`
+
+	err := c.QueryRowxContext(ctx, query, id).StructScan(&key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Str("id", id).Msg("api key not found")
+			return nil, model.ErrAPIKeyNotFound
+		}
+
+		log.Error().Str("id", id).Err(err).Msg("failed to get api key")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return &key, nil
+}
+
+func (c *Client) UpdateAPIKeyLastUsed(ctx context.Context, id string, dateLastUsed int64) error {
+	exec := `--sql
+	UPDATE api_keys SET date_last_used = ? WHERE id = ?`
+
+	_, err := c.ExecContext(ctx, exec, dateLastUsed, id)
+	if err != nil {
+		log := logger.Get()
+		log.Error().
+			Str("id", id).
+			Int64("date_last_used", dateLastUsed).
+			Err(err).
+			Msg("failed to update api key last used")
+
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
+
+// DeleteAPIKey deletes the API key if it belongs to the user.
+func (c *Client) DeleteAPIKey(ctx context.Context, userID string, id string) error {
+	log := logger.Get()
+	exec := `--sql
+	DELETE FROM api_keys WHERE id = ? AND user_id = ?`
+
+	res, err := c.ExecContext(ctx, exec, id, userID)
+	if err != nil {
+		log.Error().
+			Str("id", id).
+			Str("user_id", userID).
+			Err(err).
+			Msg("failed to delete api key")
+
+		return errors.Wrap(err, "db")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.Error().
+			Str("id", id).
+			Err(err).
+			Msg("failed to get rows affected")
+
+		return errors.Wrap(err, "db")
+	}
+
+	if rowsAffected == 0 {
+		log.Debug().Str("id", id).Msg("api key not found")
+		return model.ErrAPIKeyNotFound
+	}
+
+	return nil
+}
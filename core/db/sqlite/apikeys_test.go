@@ -0,0 +1,124 @@
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/medama-io/medama/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	err := client.CreateAPIKey(ctx, model.NewAPIKey(
+		"key1", "test1", "CI", "hash", model.APIKeyRoleRead, "website1-test1.com", 10, 1, 2,
+	))
+	require.NoError(t, err)
+
+	key, err := client.GetAPIKey(ctx, "key1")
+	require.NoError(t, err)
+	assert.Equal("test1", key.UserID)
+	assert.Equal("CI", key.Name)
+	assert.Equal("hash", key.Hash)
+	assert.Equal(model.APIKeyRoleRead, key.Role)
+	assert.Equal("website1-test1.com", key.Hostname)
+	assert.Equal(int64(10), key.DateExpires)
+	assert.Zero(key.DateLastUsed)
+
+	// Unscoped keys without expiry are stored as NULL.
+	err = client.CreateAPIKey(ctx, model.NewAPIKey(
+		"key2", "test1", "Admin", "hash", model.APIKeyRoleAdmin, "", 0, 3, 3,
+	))
+	require.NoError(t, err)
+
+	key, err = client.GetAPIKey(ctx, "key2")
+	require.NoError(t, err)
+	assert.Empty(key.Hostname)
+	assert.Zero(key.DateExpires)
+
+	err = client.CreateAPIKey(ctx, model.NewAPIKey(
+		"key3", "test1", "Missing", "hash", model.APIKeyRoleRead, "doesnotexist.com", 0, 1, 1,
+	))
+	require.ErrorIs(t, err, model.ErrWebsiteNotFound)
+}
+
+func TestListAPIKeys(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	for _, key := range []*model.APIKey{
+		model.NewAPIKey("key2", "test1", "Second", "hash", model.APIKeyRoleRead, "", 0, 2, 2),
+		model.NewAPIKey("key1", "test1", "First", "hash", model.APIKeyRoleAdmin, "", 0, 1, 1),
+		model.NewAPIKey("key3", "test2", "Other", "hash", model.APIKeyRoleRead, "", 0, 1, 1),
+	} {
+		require.NoError(t, client.CreateAPIKey(ctx, key))
+	}
+
+	keys, err := client.ListAPIKeys(ctx, "test1")
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal("key1", keys[0].ID)
+	assert.Equal("key2", keys[1].ID)
+
+	keys, err = client.ListAPIKeys(ctx, "test3")
+	require.NoError(t, err)
+	assert.Empty(keys)
+	assert.NotNil(keys)
+}
+
+func TestUpdateAPIKeyLastUsed(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	require.NoError(t, client.CreateAPIKey(ctx, model.NewAPIKey(
+		"key1", "test1", "CI", "hash", model.APIKeyRoleRead, "", 0, 1, 1,
+	)))
+
+	err := client.UpdateAPIKeyLastUsed(ctx, "key1", 5)
+	require.NoError(t, err)
+
+	key, err := client.GetAPIKey(ctx, "key1")
+	require.NoError(t, err)
+	assert.Equal(int64(5), key.DateLastUsed)
+}
+
+func TestDeleteAPIKey(t *testing.T) {
+	_, ctx, client := SetupDatabaseWithWebsites(t)
+
+	require.NoError(t, client.CreateAPIKey(ctx, model.NewAPIKey(
+		"key1", "test1", "CI", "hash", model.APIKeyRoleRead, "", 0, 1, 1,
+	)))
+
+	// Keys of other users cannot be deleted.
+	err := client.DeleteAPIKey(ctx, "test2", "key1")
+	require.ErrorIs(t, err, model.ErrAPIKeyNotFound)
+
+	err = client.DeleteAPIKey(ctx, "test1", "key1")
+	require.NoError(t, err)
+
+	_, err = client.GetAPIKey(ctx, "key1")
+	require.ErrorIs(t, err, model.ErrAPIKeyNotFound)
+}
+
+func TestAPIKeyFollowsWebsite(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	require.NoError(t, client.CreateAPIKey(ctx, model.NewAPIKey(
+		"key1", "test1", "CI", "hash", model.APIKeyRoleRead, "website1-test1.com", 0, 1, 1,
+	)))
+
+	// Merging a website only moves its scoped keys if its access is moved.
+	access, err := client.MergeWebsite(ctx, "website1-test1.com", "website2-test1.com", true)
+	require.NoError(t, err)
+	assert.Equal([]string{"key1"}, access.APIKeys)
+
+	key, err := client.GetAPIKey(ctx, "key1")
+	require.NoError(t, err)
+	assert.Equal("website2-test1.com", key.Hostname)
+
+	// Renaming a website updates its scoped keys.
+	err = client.UpdateWebsite(ctx, "website2-test1.com", model.NewWebsite("test1", "renamed.com", 1, 3))
+	require.NoError(t, err)
+
+	key, err = client.GetAPIKey(ctx, "key1")
+	require.NoError(t, err)
+	assert.Equal("renamed.com", key.Hostname)
+}
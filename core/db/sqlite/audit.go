@@ -0,0 +1,174 @@
+package sqlite
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+)
+
+// auditRow is an audit log entry with its changes serialised as JSON.
+type auditRow struct {
+	model.AuditEntry
+
+	Changes string `db:"changes"`
+}
+
+// CreateAuditEntry appends an entry to the audit log.
+func (c *Client) CreateAuditEntry(ctx context.Context, entry *model.AuditEntry) error {
+	exec := `--sql
+	INSERT INTO audit_log (
+		id,
+		actor_id,
+		actor_name,
+		api_key_id,
+		action,
+		target,
+		changes,
+		ip,
+		date_created
+	) VALUES (
+		:id,
+		:actor_id,
+		:actor_name,
+		NULLIF(:api_key_id, ''),
+		:action,
+		:target,
+		:changes,
+		NULLIF(:ip, ''),
+		:date_created
+	)`
+
+	changes := entry.Changes
+	if changes == nil {
+		changes = []model.AuditChange{}
+	}
+
+	serializedChanges, err := json.Marshal(changes)
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize audit changes")
+	}
+
+	paramMap := map[string]any{
+		"id":           entry.ID,
+		"actor_id":     entry.ActorID,
+		"actor_name":   entry.ActorName,
+		"api_key_id":   entry.APIKeyID,
+		"action":       entry.Action,
+		"target":       entry.Target,
+		"changes":      string(serializedChanges),
+		"ip":           entry.IP,
+		"date_created": entry.DateCreated,
+	}
+
+	_, err = c.NamedExecContext(ctx, exec, paramMap)
+	if err != nil {
+		log := logger.Get()
+		log.Error().
+			Str("actor_id", entry.ActorID).
+			Str("action", string(entry.Action)).
+			Str("target", entry.Target).
+			Err(err).
+			Msg("failed to create audit entry")
+
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
+
+// ListAuditEntries returns the audit log entries matching the filter, newest
+// first.
+func (c *Client) ListAuditEntries(ctx context.Context, filter model.AuditFilter) ([]*model.AuditEntry, error) {
+	var query strings.Builder
+
+	query.WriteString(`--sql
+	SELECT
+		id,
+		actor_id,
+		actor_name,
+		COALESCE(api_key_id, '') AS api_key_id,
+		action,
+		target,
+		changes,
+		COALESCE(ip, '') AS ip,
+		date_created
+	FROM audit_log WHERE 1 = 1`)
+
+	if filter.ActorID != "" {
+		query.WriteString(" AND actor_id = :actor_id")
+	}
+
+	if filter.Action != "" {
+		query.WriteString(" AND action = :action")
+	}
+
+	if filter.Target != "" {
+		query.WriteString(" AND target = :target")
+	}
+
+	if filter.DateStart > 0 {
+		query.WriteString(" AND date_created >= :date_start")
+	}
+
+	if filter.DateEnd > 0 {
+		query.WriteString(" AND date_created < :date_end")
+	}
+
+	// Entries created in the same second are ordered by insertion.
+	query.WriteString(" ORDER BY date_created DESC, rowid DESC")
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = model.AuditDefaultLimit
+	}
+
+	query.WriteString(" LIMIT :limit OFFSET :offset")
+
+	paramMap := map[string]any{
+		"actor_id":   filter.ActorID,
+		"action":     filter.Action,
+		"target":     filter.Target,
+		"date_start": filter.DateStart,
+		"date_end":   filter.DateEnd,
+		"limit":      limit,
+		"offset":     max(filter.Offset, 0),
+	}
+
+	rows, err := c.NamedQueryContext(ctx, query.String(), paramMap)
+	if err != nil {
+		log := logger.Get()
+		log.Error().Err(err).Msg("failed to list audit entries")
+
+		return nil, errors.Wrap(err, "db")
+	}
+	defer rows.Close()
+
+	entries := []*model.AuditEntry{}
+
+	for rows.Next() {
+		var row auditRow
+
+		err = rows.StructScan(&row)
+		if err != nil {
+			return nil, errors.Wrap(err, "db")
+		}
+
+		err = json.Unmarshal([]byte(row.Changes), &row.AuditEntry.Changes)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal audit changes")
+		}
+
+		entries = append(entries, &row.AuditEntry)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,95 @@
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/medama-io/medama/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLog(t *testing.T) {
+	assert, ctx, client := SetupDatabase(t)
+
+	entries := []*model.AuditEntry{
+		{
+			ID:          "audit1",
+			ActorID:     "user1",
+			ActorName:   "admin",
+			Action:      model.AuditWebsiteCreate,
+			Target:      "example.com",
+			DateCreated: 100,
+		},
+		{
+			ID:        "audit2",
+			ActorID:   "user1",
+			ActorName: "admin",
+			Action:    model.AuditSettingsUpdate,
+			Target:    model.AuditTargetTenant,
+			Changes: []model.AuditChange{
+				{Field: "blocked_ips", Before: "", After: "203.0.113.1"},
+			},
+			IP:          "192.168.1.10",
+			DateCreated: 200,
+		},
+		{
+			ID:          "audit3",
+			ActorID:     "user2",
+			ActorName:   "bob",
+			APIKeyID:    "key1",
+			Action:      model.AuditWebsiteDelete,
+			Target:      "example.com",
+			DateCreated: 300,
+		},
+	}
+
+	for _, entry := range entries {
+		require.NoError(t, client.CreateAuditEntry(ctx, entry))
+	}
+
+	got, err := client.ListAuditEntries(ctx, model.AuditFilter{})
+	require.NoError(t, err)
+
+	if assert.Len(got, 3) {
+		// Newest entries are listed first.
+		assert.Equal("audit3", got[0].ID)
+		assert.Equal("key1", got[0].APIKeyID)
+		assert.Equal(entries[1], got[1])
+		assert.Empty(got[2].Changes)
+	}
+
+	got, err = client.ListAuditEntries(ctx, model.AuditFilter{Target: "example.com"})
+	require.NoError(t, err)
+	assert.Len(got, 2)
+
+	got, err = client.ListAuditEntries(ctx, model.AuditFilter{ActorID: "user1", Action: model.AuditWebsiteCreate})
+	require.NoError(t, err)
+
+	if assert.Len(got, 1) {
+		assert.Equal("audit1", got[0].ID)
+	}
+
+	got, err = client.ListAuditEntries(ctx, model.AuditFilter{DateStart: 200, DateEnd: 300})
+	require.NoError(t, err)
+
+	if assert.Len(got, 1) {
+		assert.Equal("audit2", got[0].ID)
+	}
+
+	got, err = client.ListAuditEntries(ctx, model.AuditFilter{Limit: 1, Offset: 1})
+	require.NoError(t, err)
+
+	if assert.Len(got, 1) {
+		assert.Equal("audit2", got[0].ID)
+	}
+
+	// Entries can not be changed or removed.
+	_, err = client.ExecContext(ctx, `UPDATE audit_log SET actor_name = 'mallory'`)
+	require.Error(t, err)
+
+	_, err = client.ExecContext(ctx, `DELETE FROM audit_log`)
+	require.Error(t, err)
+
+	got, err = client.ListAuditEntries(ctx, model.AuditFilter{})
+	require.NoError(t, err)
+	assert.Len(got, 3)
+}
@@ -0,0 +1,202 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+	"github.com/ncruces/go-sqlite3"
+)
+
+func (c *Client) CreateGoal(ctx context.Context, goal *model.Goal) error {
+	exec := `--sql
+	INSERT INTO goals (
+		id,
+		hostname,
+		name,
+		type,
+		pathname,
+		event_name,
+		event_value,
+		date_created,
+		date_updated
+	) VALUES (
+		:id,
+		:hostname,
+		:name,
+		:type,
+		:pathname,
+		:event_name,
+		:event_value,
+		:date_created,
+		:date_updated
+	)`
+
+	paramMap := map[string]any{
+		"id":           goal.ID,
+		"hostname":     goal.Hostname,
+		"name":         goal.Name,
+		"type":         goal.Type,
+		"pathname":     goal.Pathname,
+		"event_name":   goal.EventName,
+		"event_value":  goal.EventValue,
+		"date_created": goal.DateCreated,
+		dateUpdatedKey: goal.DateUpdated,
+	}
+
+	_, err := c.NamedExecContext(ctx, exec, paramMap)
+	if err != nil {
+		if errors.Is(err, sqlite3.CONSTRAINT_FOREIGNKEY) {
+			return model.ErrWebsiteNotFound
+		}
+
+		log := logger.Get()
+		log.Error().
+			Str("id", goal.ID).
+			Str("hostname", goal.Hostname).
+			Str("type", string(goal.Type)).
+			Err(err).
+			Msg("failed to create goal")
+
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
+
+func (c *Client) ListGoals(ctx context.Context, hostname string) ([]*model.Goal, error) {
+	var goals []*model.Goal
+
+	query := `--sql
+	SELECT id, hostname, name, type, pathname, event_name, event_value, date_created, date_updated
+	FROM goals WHERE hostname = ? ORDER BY date_created ASC, id ASC`
+
+	err := c.SelectContext(ctx, &goals, query, hostname)
+	if err != nil {
+		log := logger.Get()
+		log.Error().
+			Str("hostname", hostname).
+			Err(err).
+			Msg("failed to list goals")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	if len(goals) == 0 {
+		// Return empty slice instead of nil
+		return []*model.Goal{}, nil
+	}
+
+	return goals, nil
+}
+
+func (c *Client) GetGoal(ctx context.Context, id string) (*model.Goal, error) {
+	var goal model.Goal
+
+	log := logger.Get()
+
+	query := `--sql
+	SELECT id, hostname, name, type, pathname, event_name, event_value, date_created, date_updated
+	FROM goals WHERE id = ?`
+
+	err := c.QueryRowxContext(ctx, query, id).StructScan(&goal)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Str("id", id).Msg("goal not found")
+			return nil, model.ErrGoalNotFound
+		}
+
+		log.Error().Str("id", id).Err(err).Msg("failed to get goal")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return &goal, nil
+}
+
+func (c *Client) UpdateGoal(ctx context.Context, goal *model.Goal) error {
+	log := logger.Get()
+	// Update all values except id, hostname and date_created
+	exec := `--sql
+	UPDATE goals SET
+		name = :name,
+		type = :type,
+		pathname = :pathname,
+		event_name = :event_name,
+		event_value = :event_value,
+		date_updated = :date_updated
+	WHERE id = :id`
+
+	paramMap := map[string]any{
+		"id":           goal.ID,
+		"name":         goal.Name,
+		"type":         goal.Type,
+		"pathname":     goal.Pathname,
+		"event_name":   goal.EventName,
+		"event_value":  goal.EventValue,
+		dateUpdatedKey: goal.DateUpdated,
+	}
+
+	res, err := c.NamedExecContext(ctx, exec, paramMap)
+	if err != nil {
+		log.Error().
+			Str("id", goal.ID).
+			Int64("date_updated", goal.DateUpdated).
+			Err(err).
+			Msg("failed to update goal")
+
+		return errors.Wrap(err, "db")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.Error().
+			Str("id", goal.ID).
+			Err(err).
+			Msg("failed to get rows affected")
+
+		return errors.Wrap(err, "db")
+	}
+
+	if rowsAffected == 0 {
+		log.Debug().Str("id", goal.ID).Msg("goal not found")
+		return model.ErrGoalNotFound
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteGoal(ctx context.Context, id string) error {
+	log := logger.Get()
+	exec := `--sql
+	DELETE FROM goals WHERE id = ?`
+
+	res, err := c.ExecContext(ctx, exec, id)
+	if err != nil {
+		log.Error().
+			Str("id", id).
+			Err(err).
+			Msg("failed to delete goal")
+
+		return errors.Wrap(err, "db")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.Error().
+			Str("id", id).
+			Err(err).
+			Msg("failed to get rows affected")
+
+		return errors.Wrap(err, "db")
+	}
+
+	if rowsAffected == 0 {
+		log.Debug().Str("id", id).Msg("goal not found")
+		return model.ErrGoalNotFound
+	}
+
+	return nil
+}
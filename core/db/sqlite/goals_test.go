@@ -0,0 +1,184 @@
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/medama-io/medama/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateGoal(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	goalCreate := model.NewGoal(
+		"goal1",
+		"website1-test1.com",
+		"Signup",
+		model.GoalTypeEvent,
+		"",
+		"signup",
+		"",
+		1,
+		2,
+	)
+
+	err := client.CreateGoal(ctx, goalCreate)
+	require.NoError(t, err)
+
+	goal, err := client.GetGoal(ctx, "goal1")
+	require.NoError(t, err)
+	assert.Equal("website1-test1.com", goal.Hostname)
+	assert.Equal("Signup", goal.Name)
+	assert.Equal(model.GoalTypeEvent, goal.Type)
+	assert.Equal("signup", goal.EventName)
+	assert.Empty(goal.EventValue)
+	assert.Equal(int64(1), goal.DateCreated)
+	assert.Equal(int64(2), goal.DateUpdated)
+}
+
+func TestCreateGoalMissingWebsite(t *testing.T) {
+	_, ctx, client := SetupDatabaseWithWebsites(t)
+
+	goalCreate := model.NewGoal(
+		"goal1",
+		"doesnotexist.com",
+		"Thank you",
+		model.GoalTypePathname,
+		"/thank-you",
+		"",
+		"",
+		1,
+		2,
+	)
+
+	err := client.CreateGoal(ctx, goalCreate)
+	require.ErrorIs(t, err, model.ErrWebsiteNotFound)
+}
+
+func TestListGoals(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	for i, id := range []string{"goal1", "goal2"} {
+		err := client.CreateGoal(ctx, model.NewGoal(
+			id,
+			"website1-test1.com",
+			id,
+			model.GoalTypePathname,
+			"/thank-you",
+			"",
+			"",
+			int64(i),
+			int64(i),
+		))
+		require.NoError(t, err)
+	}
+
+	goals, err := client.ListGoals(ctx, "website1-test1.com")
+	require.NoError(t, err)
+	assert.Len(goals, 2)
+	assert.Equal("goal1", goals[0].ID)
+	assert.Equal("goal2", goals[1].ID)
+
+	goals, err = client.ListGoals(ctx, "website2-test1.com")
+	require.NoError(t, err)
+	assert.NotNil(goals)
+	assert.Empty(goals)
+}
+
+func TestUpdateGoal(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	goal := model.NewGoal(
+		"goal1",
+		"website1-test1.com",
+		"Thank you",
+		model.GoalTypePathname,
+		"/thank-you",
+		"",
+		"",
+		1,
+		2,
+	)
+	require.NoError(t, client.CreateGoal(ctx, goal))
+
+	goal.Name = "Purchase"
+	goal.Type = model.GoalTypeEvent
+	goal.Pathname = ""
+	goal.EventName = "purchase"
+	goal.EventValue = "pro"
+	goal.DateUpdated = 3
+
+	err := client.UpdateGoal(ctx, goal)
+	require.NoError(t, err)
+
+	goal, err = client.GetGoal(ctx, "goal1")
+	require.NoError(t, err)
+	assert.Equal("Purchase", goal.Name)
+	assert.Equal(model.GoalTypeEvent, goal.Type)
+	assert.Empty(goal.Pathname)
+	assert.Equal("purchase", goal.EventName)
+	assert.Equal("pro", goal.EventValue)
+	assert.Equal(int64(1), goal.DateCreated)
+	assert.Equal(int64(3), goal.DateUpdated)
+}
+
+func TestUpdateGoalNotFound(t *testing.T) {
+	_, ctx, client := SetupDatabase(t)
+
+	err := client.UpdateGoal(ctx, &model.Goal{ID: "doesnotexist"})
+	require.ErrorIs(t, err, model.ErrGoalNotFound)
+}
+
+func TestDeleteGoal(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	goal := model.NewGoal(
+		"goal1",
+		"website1-test1.com",
+		"Thank you",
+		model.GoalTypePathname,
+		"/thank-you",
+		"",
+		"",
+		1,
+		2,
+	)
+	require.NoError(t, client.CreateGoal(ctx, goal))
+
+	err := client.DeleteGoal(ctx, "goal1")
+	require.NoError(t, err)
+
+	goal, err = client.GetGoal(ctx, "goal1")
+	require.ErrorIs(t, err, model.ErrGoalNotFound)
+	assert.Nil(goal)
+
+	err = client.DeleteGoal(ctx, "goal1")
+	require.ErrorIs(t, err, model.ErrGoalNotFound)
+}
+
+func TestDeleteWebsiteCascadesGoals(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	goal := model.NewGoal(
+		"goal1",
+		"website1-test1.com",
+		"Thank you",
+		model.GoalTypePathname,
+		"/thank-you",
+		"",
+		"",
+		1,
+		2,
+	)
+	require.NoError(t, client.CreateGoal(ctx, goal))
+
+	err := client.DeleteWebsite(ctx, &model.WebsiteDeletion{
+		Hostname: "website1-test1.com",
+		UserID:   "test1",
+	})
+	require.NoError(t, err)
+
+	goal, err = client.GetGoal(ctx, "goal1")
+	require.ErrorIs(t, err, model.ErrGoalNotFound)
+	assert.Nil(goal)
+}
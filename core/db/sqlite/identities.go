@@ -0,0 +1,85 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+	"github.com/ncruces/go-sqlite3"
+)
+
+// CreateUserWithIdentity creates a user linked to their account at an identity
+// provider in a single transaction, so a failed link does not leave behind a
+// user that can not log in.
+func (c *Client) CreateUserWithIdentity(
+	ctx context.Context,
+	user *model.User,
+	identity *model.UserIdentity,
+) error {
+	tx, err := c.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+	defer tx.Rollback() //nolint: errcheck // Called on defer
+
+	err = createUser(ctx, tx, user)
+	if err != nil {
+		return err
+	}
+
+	identity.UserID = user.ID
+
+	_, err = tx.NamedExecContext(ctx, `--sql
+	INSERT INTO user_identities (
+		issuer,
+		subject,
+		user_id,
+		date_created
+	) VALUES (
+		:issuer,
+		:subject,
+		:user_id,
+		:date_created
+	)`, identity)
+	if err != nil {
+		if errors.Is(err, sqlite3.CONSTRAINT_PRIMARYKEY) {
+			return model.ErrUserExists
+		}
+
+		log := logger.Get()
+		log.Error().
+			Str("issuer", identity.Issuer).
+			Str("subject", identity.Subject).
+			Err(err).
+			Msg("failed to create user identity")
+
+		return errors.Wrap(err, "db")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
+
+// GetUserByIdentity retrieves the user linked to an account at an identity
+// provider.
+func (c *Client) GetUserByIdentity(ctx context.Context, issuer string, subject string) (*model.User, error) {
+	var userID string
+
+	err := c.GetContext(ctx, &userID, `--sql
+	SELECT user_id FROM user_identities WHERE issuer = ? AND subject = ?`, issuer, subject)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrUserNotFound
+		}
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return c.GetUser(ctx, userID)
+}
@@ -0,0 +1,56 @@
+package sqlite_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserIdentity(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithUsers(t)
+
+	now := time.Now().Unix()
+
+	_, err := client.GetUserByIdentity(ctx, "https://idp.example.com", "sub1")
+	require.ErrorIs(t, err, model.ErrUserNotFound)
+
+	user := model.NewUser("sso1", "alice", "hash", model.UserRoleViewer, model.NewDefaultUserSettings(), now, now)
+	identity := model.NewUserIdentity("https://idp.example.com", "sub1", "", now)
+
+	require.NoError(t, client.CreateUserWithIdentity(ctx, user, identity))
+
+	got, err := client.GetUserByIdentity(ctx, "https://idp.example.com", "sub1")
+	require.NoError(t, err)
+	assert.Equal("alice", got.Username)
+
+	// The same subject of another issuer is a different identity.
+	_, err = client.GetUserByIdentity(ctx, "https://other.example.com", "sub1")
+	require.ErrorIs(t, err, model.ErrUserNotFound)
+
+	// Nothing is created when the username or identity already exists.
+	err = client.CreateUserWithIdentity(ctx,
+		model.NewUser("sso2", "alice", "hash", model.UserRoleViewer, model.NewDefaultUserSettings(), now, now),
+		model.NewUserIdentity("https://idp.example.com", "sub2", "", now),
+	)
+	require.ErrorIs(t, err, model.ErrUserExists)
+
+	_, err = client.GetUserByIdentity(ctx, "https://idp.example.com", "sub2")
+	require.ErrorIs(t, err, model.ErrUserNotFound)
+
+	err = client.CreateUserWithIdentity(ctx,
+		model.NewUser("sso3", "bob", "hash", model.UserRoleViewer, model.NewDefaultUserSettings(), now, now),
+		model.NewUserIdentity("https://idp.example.com", "sub1", "", now),
+	)
+	require.ErrorIs(t, err, model.ErrUserExists)
+
+	_, err = client.GetUser(ctx, "sso3")
+	require.ErrorIs(t, err, model.ErrUserNotFound)
+
+	// Identities are removed with the user.
+	require.NoError(t, client.DeleteUser(ctx, "sso1"))
+
+	_, err = client.GetUserByIdentity(ctx, "https://idp.example.com", "sub1")
+	require.ErrorIs(t, err, model.ErrUserNotFound)
+}
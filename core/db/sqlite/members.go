@@ -0,0 +1,109 @@
+package sqlite
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+	"github.com/ncruces/go-sqlite3"
+)
+
+// HasWebsiteAccess reports whether the user can access the website. Owners
+// and admins can access all websites, while viewers can only access the
+// websites they are a member of.
+func (c *Client) HasWebsiteAccess(ctx context.Context, userID string, hostname string) (bool, error) {
+	var access bool
+
+	query := `--sql
+	SELECT EXISTS (
+		SELECT 1 FROM websites WHERE hostname = ? AND (
+			EXISTS (SELECT 1 FROM users WHERE id = ? AND role IN ('owner', 'admin'))
+			OR EXISTS (SELECT 1 FROM website_members WHERE hostname = websites.hostname AND user_id = ?)
+		)
+	)`
+
+	err := c.QueryRowxContext(ctx, query, hostname, userID, userID).Scan(&access)
+	if err != nil {
+		log := logger.Get()
+		log.Error().
+			Str("user_id", userID).
+			Str("hostname", hostname).
+			Err(err).
+			Msg("failed to check website access")
+
+		return false, errors.Wrap(err, "db")
+	}
+
+	return access, nil
+}
+
+// ListUserWebsites returns the hostnames of the websites the user is a member
+// of.
+func (c *Client) ListUserWebsites(ctx context.Context, userID string) ([]string, error) {
+	hostnames := []string{}
+
+	query := `--sql
+	SELECT hostname FROM website_members WHERE user_id = ? ORDER BY hostname ASC`
+
+	err := c.SelectContext(ctx, &hostnames, query, userID)
+	if err != nil {
+		log := logger.Get()
+		log.Error().
+			Str("user_id", userID).
+			Err(err).
+			Msg("failed to list user websites")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return hostnames, nil
+}
+
+// SetUserWebsites replaces the website memberships of the user in a single
+// transaction. Websites that do not exist return model.ErrWebsiteNotFound.
+func (c *Client) SetUserWebsites(ctx context.Context, userID string, hostnames []string) error {
+	log := logger.Get()
+
+	tx, err := c.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+	defer tx.Rollback() //nolint: errcheck // Called on defer
+
+	_, err = tx.ExecContext(ctx, `--sql
+	DELETE FROM website_members WHERE user_id = ?`, userID)
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	dateCreated := time.Now().Unix()
+
+	for _, hostname := range hostnames {
+		_, err = tx.ExecContext(ctx, `--sql
+		INSERT OR IGNORE INTO website_members (hostname, user_id, date_created) VALUES (?, ?, ?)`,
+			hostname, userID, dateCreated)
+		if err != nil {
+			if errors.Is(err, sqlite3.CONSTRAINT_FOREIGNKEY) {
+				log.Debug().Str("hostname", hostname).Msg("website not found")
+				return model.ErrWebsiteNotFound
+			}
+
+			log.Error().
+				Str("user_id", userID).
+				Str("hostname", hostname).
+				Err(err).
+				Msg("failed to add website member")
+
+			return errors.Wrap(err, "db")
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
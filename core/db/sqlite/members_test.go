@@ -0,0 +1,86 @@
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/medama-io/medama/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasWebsiteAccess(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	// Viewers can access the websites they added.
+	access, err := client.HasWebsiteAccess(ctx, "test1", "website1-test1.com")
+	require.NoError(t, err)
+	assert.True(access)
+
+	access, err = client.HasWebsiteAccess(ctx, "test1", "website1-test2.com")
+	require.NoError(t, err)
+	assert.False(access)
+
+	// Admins can access all websites.
+	require.NoError(t, client.UpdateUserRole(ctx, "test1", model.UserRoleAdmin))
+
+	access, err = client.HasWebsiteAccess(ctx, "test1", "website1-test2.com")
+	require.NoError(t, err)
+	assert.True(access)
+
+	access, err = client.HasWebsiteAccess(ctx, "test1", "doesnotexist.com")
+	require.NoError(t, err)
+	assert.False(access)
+}
+
+func TestSetUserWebsites(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	err := client.SetUserWebsites(ctx, "test1", []string{"website2-test2.com", "website1-test1.com"})
+	require.NoError(t, err)
+
+	hostnames, err := client.ListUserWebsites(ctx, "test1")
+	require.NoError(t, err)
+	assert.Equal([]string{"website1-test1.com", "website2-test2.com"}, hostnames)
+
+	websites, err := client.ListWebsites(ctx, "test1")
+	require.NoError(t, err)
+	assert.Len(websites, 2)
+
+	// Missing websites leave the memberships unchanged.
+	err = client.SetUserWebsites(ctx, "test1", []string{"doesnotexist.com"})
+	require.ErrorIs(t, err, model.ErrWebsiteNotFound)
+
+	hostnames, err = client.ListUserWebsites(ctx, "test1")
+	require.NoError(t, err)
+	assert.Len(hostnames, 2)
+
+	err = client.SetUserWebsites(ctx, "test1", []string{})
+	require.NoError(t, err)
+
+	hostnames, err = client.ListUserWebsites(ctx, "test1")
+	require.NoError(t, err)
+	assert.Empty(hostnames)
+	assert.NotNil(hostnames)
+}
+
+func TestMembersFollowWebsite(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	require.NoError(t, client.SetUserWebsites(ctx, "test2", []string{"website1-test1.com"}))
+
+	// Merging a website only moves its members if its access is moved.
+	access, err := client.MergeWebsite(ctx, "website1-test1.com", "website2-test1.com", true)
+	require.NoError(t, err)
+	assert.Equal([]string{"test2"}, access.Members)
+
+	hostnames, err := client.ListUserWebsites(ctx, "test2")
+	require.NoError(t, err)
+	assert.Equal([]string{"website2-test1.com"}, hostnames)
+
+	// Renaming a website updates its members.
+	err = client.UpdateWebsite(ctx, "website2-test1.com", model.NewWebsite("test1", "renamed.com", 1, 3))
+	require.NoError(t, err)
+
+	hostnames, err = client.ListUserWebsites(ctx, "test2")
+	require.NoError(t, err)
+	assert.Equal([]string{"renamed.com"}, hostnames)
+}
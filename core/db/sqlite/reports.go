@@ -0,0 +1,294 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+	"github.com/ncruces/go-sqlite3"
+)
+
+const reportColumns = `--sql
+	id,
+	user_id,
+	hostname,
+	email,
+	frequency,
+	stats,
+	date_last_sent,
+	date_created,
+	date_updated`
+
+// reportRow is a report subscription with its stats stored as a comma
+// separated list.
+type reportRow struct {
+	model.ReportSubscription
+
+	Stats string `db:"stats"`
+}
+
+func (r *reportRow) subscription() *model.ReportSubscription {
+	sub := r.ReportSubscription
+	sub.Stats = []model.ReportStat{}
+
+	for stat := range strings.SplitSeq(r.Stats, ",") {
+		if stat != "" {
+			sub.Stats = append(sub.Stats, model.ReportStat(stat))
+		}
+	}
+
+	return &sub
+}
+
+func joinReportStats(stats []model.ReportStat) string {
+	values := make([]string, 0, len(stats))
+	for _, stat := range stats {
+		values = append(values, string(stat))
+	}
+
+	return strings.Join(values, ",")
+}
+
+// CreateReportSubscription adds a new report subscription. Subscriptions to a
+// website that does not exist return model.ErrWebsiteNotFound.
+func (c *Client) CreateReportSubscription(ctx context.Context, sub *model.ReportSubscription) error {
+	exec := `--sql
+	INSERT INTO report_subscriptions (
+		id,
+		user_id,
+		hostname,
+		email,
+		frequency,
+		stats,
+		date_last_sent,
+		date_created,
+		date_updated
+	) VALUES (
+		:id,
+		:user_id,
+		:hostname,
+		:email,
+		:frequency,
+		:stats,
+		:date_last_sent,
+		:date_created,
+		:date_updated
+	)`
+
+	paramMap := map[string]any{
+		"id":             sub.ID,
+		"user_id":        sub.UserID,
+		"hostname":       sub.Hostname,
+		"email":          sub.Email,
+		"frequency":      sub.Frequency,
+		"stats":          joinReportStats(sub.Stats),
+		"date_last_sent": sub.DateLastSent,
+		"date_created":   sub.DateCreated,
+		dateUpdatedKey:   sub.DateUpdated,
+	}
+
+	_, err := c.NamedExecContext(ctx, exec, paramMap)
+	if err != nil {
+		if errors.Is(err, sqlite3.CONSTRAINT_FOREIGNKEY) {
+			return model.ErrWebsiteNotFound
+		}
+
+		log := logger.Get()
+		log.Error().
+			Str("id", sub.ID).
+			Str("user_id", sub.UserID).
+			Str("hostname", sub.Hostname).
+			Err(err).
+			Msg("failed to create report subscription")
+
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
+
+// ListReportSubscriptions returns all report subscriptions of the user ordered
+// by creation date.
+func (c *Client) ListReportSubscriptions(ctx context.Context, userID string) ([]*model.ReportSubscription, error) {
+	query := `--sql
+	SELECT` + reportColumns + `
+	FROM report_subscriptions WHERE user_id = ? ORDER BY date_created ASC, id ASC`
+
+	subs, err := c.selectReportSubscriptions(ctx, query, userID)
+	if err != nil {
+		log := logger.Get()
+		log.Error().
+			Str("user_id", userID).
+			Err(err).
+			Msg("failed to list report subscriptions")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return subs, nil
+}
+
+// ListAllReportSubscriptions returns the report subscriptions of all users.
+func (c *Client) ListAllReportSubscriptions(ctx context.Context) ([]*model.ReportSubscription, error) {
+	query := `--sql
+	SELECT` + reportColumns + `
+	FROM report_subscriptions ORDER BY date_created ASC, id ASC`
+
+	subs, err := c.selectReportSubscriptions(ctx, query)
+	if err != nil {
+		log := logger.Get()
+		log.Error().Err(err).Msg("failed to list all report subscriptions")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return subs, nil
+}
+
+func (c *Client) selectReportSubscriptions(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]*model.ReportSubscription, error) {
+	var rows []*reportRow
+
+	err := c.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	subs := make([]*model.ReportSubscription, 0, len(rows))
+	for _, row := range rows {
+		subs = append(subs, row.subscription())
+	}
+
+	return subs, nil
+}
+
+// GetReportSubscription returns a report subscription by id.
+func (c *Client) GetReportSubscription(ctx context.Context, id string) (*model.ReportSubscription, error) {
+	var row reportRow
+
+	log := logger.Get()
+
+	query := `--sql
+	SELECT` + reportColumns + `
+	FROM report_subscriptions WHERE id = ?`
+
+	err := c.QueryRowxContext(ctx, query, id).StructScan(&row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Str("id", id).Msg("report subscription not found")
+			return nil, model.ErrReportNotFound
+		}
+
+		log.Error().Str("id", id).Err(err).Msg("failed to get report subscription")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return row.subscription(), nil
+}
+
+// UpdateReportSubscription updates the recipient, frequency and stats of a
+// report subscription if it belongs to its user.
+func (c *Client) UpdateReportSubscription(ctx context.Context, sub *model.ReportSubscription) error {
+	log := logger.Get()
+	exec := `--sql
+	UPDATE report_subscriptions SET
+		email = :email,
+		frequency = :frequency,
+		stats = :stats,
+		date_updated = :date_updated
+	WHERE id = :id AND user_id = :user_id`
+
+	paramMap := map[string]any{
+		"id":           sub.ID,
+		"user_id":      sub.UserID,
+		"email":        sub.Email,
+		"frequency":    sub.Frequency,
+		"stats":        joinReportStats(sub.Stats),
+		dateUpdatedKey: sub.DateUpdated,
+	}
+
+	res, err := c.NamedExecContext(ctx, exec, paramMap)
+	if err != nil {
+		log.Error().
+			Str("id", sub.ID).
+			Err(err).
+			Msg("failed to update report subscription")
+
+		return errors.Wrap(err, "db")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	if rowsAffected == 0 {
+		log.Debug().Str("id", sub.ID).Msg("report subscription not found")
+		return model.ErrReportNotFound
+	}
+
+	return nil
+}
+
+// UpdateReportLastSent records the end of the period of the last sent report.
+func (c *Client) UpdateReportLastSent(ctx context.Context, id string, dateLastSent int64) error {
+	exec := `--sql
+	UPDATE report_subscriptions SET date_last_sent = ? WHERE id = ?`
+
+	_, err := c.ExecContext(ctx, exec, dateLastSent, id)
+	if err != nil {
+		log := logger.Get()
+		log.Error().
+			Str("id", id).
+			Int64("date_last_sent", dateLastSent).
+			Err(err).
+			Msg("failed to update report last sent")
+
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
+
+// DeleteReportSubscription deletes the report subscription if it belongs to
+// the user.
+func (c *Client) DeleteReportSubscription(ctx context.Context, userID string, id string) error {
+	log := logger.Get()
+	exec := `--sql
+	DELETE FROM report_subscriptions WHERE id = ? AND user_id = ?`
+
+	res, err := c.ExecContext(ctx, exec, id, userID)
+	if err != nil {
+		log.Error().
+			Str("id", id).
+			Str("user_id", userID).
+			Err(err).
+			Msg("failed to delete report subscription")
+
+		return errors.Wrap(err, "db")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.Error().
+			Str("id", id).
+			Err(err).
+			Msg("failed to get rows affected")
+
+		return errors.Wrap(err, "db")
+	}
+
+	if rowsAffected == 0 {
+		log.Debug().Str("id", id).Msg("report subscription not found")
+		return model.ErrReportNotFound
+	}
+
+	return nil
+}
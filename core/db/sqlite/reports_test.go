@@ -0,0 +1,153 @@
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/medama-io/medama/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateReportSubscription(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	err := client.CreateReportSubscription(ctx, model.NewReportSubscription(
+		"report1", "test1", "website1-test1.com", "owner@example.com", model.ReportFrequencyWeekly,
+		[]model.ReportStat{model.ReportStatSummary, model.ReportStatPages}, 1, 2,
+	))
+	require.NoError(t, err)
+
+	sub, err := client.GetReportSubscription(ctx, "report1")
+	require.NoError(t, err)
+	assert.Equal("test1", sub.UserID)
+	assert.Equal("website1-test1.com", sub.Hostname)
+	assert.Equal("owner@example.com", sub.Email)
+	assert.Equal(model.ReportFrequencyWeekly, sub.Frequency)
+	assert.Equal([]model.ReportStat{model.ReportStatSummary, model.ReportStatPages}, sub.Stats)
+	assert.Equal(int64(1), sub.DateLastSent)
+
+	err = client.CreateReportSubscription(ctx, model.NewReportSubscription(
+		"report2", "test1", "doesnotexist.com", "owner@example.com", model.ReportFrequencyDaily,
+		[]model.ReportStat{model.ReportStatSummary}, 1, 1,
+	))
+	require.ErrorIs(t, err, model.ErrWebsiteNotFound)
+
+	_, err = client.GetReportSubscription(ctx, "report2")
+	require.ErrorIs(t, err, model.ErrReportNotFound)
+}
+
+func TestListReportSubscriptions(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	for _, sub := range []*model.ReportSubscription{
+		model.NewReportSubscription("report2", "test1", "website1-test1.com", "a@example.com",
+			model.ReportFrequencyDaily, []model.ReportStat{model.ReportStatSummary}, 2, 2),
+		model.NewReportSubscription("report1", "test1", "website2-test1.com", "b@example.com",
+			model.ReportFrequencyWeekly, []model.ReportStat{model.ReportStatReferrers}, 1, 1),
+		model.NewReportSubscription("report3", "test2", "website1-test2.com", "c@example.com",
+			model.ReportFrequencyMonthly, []model.ReportStat{model.ReportStatPages}, 1, 1),
+	} {
+		require.NoError(t, client.CreateReportSubscription(ctx, sub))
+	}
+
+	subs, err := client.ListReportSubscriptions(ctx, "test1")
+	require.NoError(t, err)
+	require.Len(t, subs, 2)
+	assert.Equal("report1", subs[0].ID)
+	assert.Equal("report2", subs[1].ID)
+
+	subs, err = client.ListAllReportSubscriptions(ctx)
+	require.NoError(t, err)
+	assert.Len(subs, 3)
+
+	subs, err = client.ListReportSubscriptions(ctx, "test3")
+	require.NoError(t, err)
+	assert.Empty(subs)
+	assert.NotNil(subs)
+}
+
+func TestUpdateReportSubscription(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	require.NoError(t, client.CreateReportSubscription(ctx, model.NewReportSubscription(
+		"report1", "test1", "website1-test1.com", "owner@example.com", model.ReportFrequencyWeekly,
+		[]model.ReportStat{model.ReportStatSummary}, 1, 1,
+	)))
+
+	sub, err := client.GetReportSubscription(ctx, "report1")
+	require.NoError(t, err)
+
+	sub.Email = "team@example.com"
+	sub.Frequency = model.ReportFrequencyMonthly
+	sub.Stats = []model.ReportStat{model.ReportStatPages, model.ReportStatReferrers}
+	sub.DateUpdated = 5
+
+	require.NoError(t, client.UpdateReportSubscription(ctx, sub))
+	require.NoError(t, client.UpdateReportLastSent(ctx, "report1", 10))
+
+	sub, err = client.GetReportSubscription(ctx, "report1")
+	require.NoError(t, err)
+	assert.Equal("team@example.com", sub.Email)
+	assert.Equal(model.ReportFrequencyMonthly, sub.Frequency)
+	assert.Equal([]model.ReportStat{model.ReportStatPages, model.ReportStatReferrers}, sub.Stats)
+	assert.Equal(int64(10), sub.DateLastSent)
+	assert.Equal(int64(5), sub.DateUpdated)
+
+	// Subscriptions of other users cannot be updated.
+	sub.UserID = "test2"
+	err = client.UpdateReportSubscription(ctx, sub)
+	require.ErrorIs(t, err, model.ErrReportNotFound)
+}
+
+func TestDeleteReportSubscription(t *testing.T) {
+	_, ctx, client := SetupDatabaseWithWebsites(t)
+
+	require.NoError(t, client.CreateReportSubscription(ctx, model.NewReportSubscription(
+		"report1", "test1", "website1-test1.com", "owner@example.com", model.ReportFrequencyDaily,
+		[]model.ReportStat{model.ReportStatSummary}, 1, 1,
+	)))
+
+	// Subscriptions of other users cannot be deleted.
+	err := client.DeleteReportSubscription(ctx, "test2", "report1")
+	require.ErrorIs(t, err, model.ErrReportNotFound)
+
+	err = client.DeleteReportSubscription(ctx, "test1", "report1")
+	require.NoError(t, err)
+
+	_, err = client.GetReportSubscription(ctx, "report1")
+	require.ErrorIs(t, err, model.ErrReportNotFound)
+}
+
+func TestReportSubscriptionFollowsWebsite(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	require.NoError(t, client.CreateReportSubscription(ctx, model.NewReportSubscription(
+		"report1", "test1", "website1-test1.com", "owner@example.com", model.ReportFrequencyDaily,
+		[]model.ReportStat{model.ReportStatSummary}, 1, 1,
+	)))
+
+	// Merging a website moves its subscriptions.
+	_, err := client.MergeWebsite(ctx, "website1-test1.com", "website2-test1.com", false)
+	require.NoError(t, err)
+
+	sub, err := client.GetReportSubscription(ctx, "report1")
+	require.NoError(t, err)
+	assert.Equal("website2-test1.com", sub.Hostname)
+
+	// Renaming a website updates its subscriptions.
+	err = client.UpdateWebsite(ctx, "website2-test1.com", model.NewWebsite("test1", "renamed.com", 1, 3))
+	require.NoError(t, err)
+
+	sub, err = client.GetReportSubscription(ctx, "report1")
+	require.NoError(t, err)
+	assert.Equal("renamed.com", sub.Hostname)
+
+	// Deleting a website removes its subscriptions.
+	err = client.DeleteWebsite(ctx, &model.WebsiteDeletion{
+		Hostname: "renamed.com",
+		UserID:   "test1",
+	})
+	require.NoError(t, err)
+
+	_, err = client.GetReportSubscription(ctx, "report1")
+	require.ErrorIs(t, err, model.ErrReportNotFound)
+}
@@ -0,0 +1,164 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+)
+
+// CreateSession adds a new session. Expired sessions are removed at the same
+// time, as they are otherwise never read again.
+func (c *Client) CreateSession(ctx context.Context, session *model.Session) error {
+	log := logger.Get()
+
+	tx, err := c.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+	defer tx.Rollback() //nolint: errcheck // Called on defer
+
+	_, err = tx.ExecContext(ctx, `--sql
+	DELETE FROM sessions WHERE date_expires <= ?`, session.DateCreated)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to delete expired sessions")
+		return errors.Wrap(err, "db")
+	}
+
+	_, err = tx.NamedExecContext(ctx, `--sql
+	INSERT INTO sessions (
+		id,
+		user_id,
+		user_agent,
+		date_expires,
+		date_created
+	) VALUES (
+		:id,
+		:user_id,
+		:user_agent,
+		:date_expires,
+		:date_created
+	)`, session)
+	if err != nil {
+		log.Error().
+			Str("user_id", session.UserID).
+			Err(err).
+			Msg("failed to create session")
+
+		return errors.Wrap(err, "db")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
+
+// GetSession returns the session if it exists and has not expired.
+func (c *Client) GetSession(ctx context.Context, id string) (*model.Session, error) {
+	var session model.Session
+
+	query := `--sql
+	SELECT id, user_id, user_agent, date_expires, date_created
+	FROM sessions WHERE id = ? AND date_expires > ?`
+
+	err := c.QueryRowxContext(ctx, query, id, time.Now().Unix()).StructScan(&session)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrSessionNotFound
+		}
+
+		log := logger.Get()
+		log.Error().Err(err).Msg("failed to get session")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return &session, nil
+}
+
+// ListSessions returns the sessions of the user that have not expired, ordered
+// by creation date.
+func (c *Client) ListSessions(ctx context.Context, userID string) ([]*model.Session, error) {
+	sessions := []*model.Session{}
+
+	query := `--sql
+	SELECT id, user_id, user_agent, date_expires, date_created
+	FROM sessions WHERE user_id = ? AND date_expires > ?
+	ORDER BY date_created ASC, id ASC`
+
+	err := c.SelectContext(ctx, &sessions, query, userID, time.Now().Unix())
+	if err != nil {
+		log := logger.Get()
+		log.Error().
+			Str("user_id", userID).
+			Err(err).
+			Msg("failed to list sessions")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return sessions, nil
+}
+
+// DeleteSession deletes a session. Deleting a session that does not exist is
+// not an error.
+func (c *Client) DeleteSession(ctx context.Context, id string) error {
+	_, err := c.ExecContext(ctx, `--sql
+	DELETE FROM sessions WHERE id = ?`, id)
+	if err != nil {
+		log := logger.Get()
+		log.Error().Err(err).Msg("failed to delete session")
+
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
+
+// DeleteUserSessions deletes all sessions of the user.
+func (c *Client) DeleteUserSessions(ctx context.Context, userID string) error {
+	_, err := c.ExecContext(ctx, `--sql
+	DELETE FROM sessions WHERE user_id = ?`, userID)
+	if err != nil {
+		log := logger.Get()
+		log.Error().
+			Str("user_id", userID).
+			Err(err).
+			Msg("failed to delete user sessions")
+
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
+
+// GetOrCreateSecret returns the persisted secret with the given name. If it
+// does not exist yet, value is stored and returned instead.
+func (c *Client) GetOrCreateSecret(ctx context.Context, name string, value []byte) ([]byte, error) {
+	log := logger.Get()
+
+	_, err := c.ExecContext(ctx, `--sql
+	INSERT OR IGNORE INTO secrets (name, value, date_created) VALUES (?, ?, ?)`,
+		name, value, time.Now().Unix())
+	if err != nil {
+		log.Error().Str("name", name).Err(err).Msg("failed to create secret")
+		return nil, errors.Wrap(err, "db")
+	}
+
+	var secret []byte
+
+	err = c.QueryRowxContext(ctx, `--sql
+	SELECT value FROM secrets WHERE name = ?`, name).Scan(&secret)
+	if err != nil {
+		log.Error().Str("name", name).Err(err).Msg("failed to get secret")
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return secret, nil
+}
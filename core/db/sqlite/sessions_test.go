@@ -0,0 +1,119 @@
+package sqlite_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSession(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithUsers(t)
+
+	now := time.Now().Unix()
+
+	err := client.CreateSession(ctx, model.NewSession("sess1", "test1", "Firefox", now+60, now))
+	require.NoError(t, err)
+
+	session, err := client.GetSession(ctx, "sess1")
+	require.NoError(t, err)
+	assert.Equal("test1", session.UserID)
+	assert.Equal("Firefox", session.UserAgent)
+	assert.Equal(now+60, session.DateExpires)
+
+	_, err = client.GetSession(ctx, "doesnotexist")
+	require.ErrorIs(t, err, model.ErrSessionNotFound)
+}
+
+func TestSessionExpiry(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithUsers(t)
+
+	now := time.Now().Unix()
+
+	require.NoError(t, client.CreateSession(ctx, model.NewSession("sess1", "test1", "", now-1, now-60)))
+
+	// Expired sessions are not returned.
+	_, err := client.GetSession(ctx, "sess1")
+	require.ErrorIs(t, err, model.ErrSessionNotFound)
+
+	sessions, err := client.ListSessions(ctx, "test1")
+	require.NoError(t, err)
+	assert.Empty(sessions)
+	assert.NotNil(sessions)
+
+	// Expired sessions are removed when a new session is created.
+	require.NoError(t, client.CreateSession(ctx, model.NewSession("sess2", "test2", "", now+60, now)))
+
+	var count int
+	require.NoError(t, client.GetContext(ctx, &count, "SELECT COUNT(*) FROM sessions"))
+	assert.Equal(1, count)
+}
+
+func TestListSessions(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithUsers(t)
+
+	now := time.Now().Unix()
+
+	for _, session := range []*model.Session{
+		model.NewSession("sess2", "test1", "Chrome", now+60, now),
+		model.NewSession("sess1", "test1", "Firefox", now+60, now-10),
+		model.NewSession("sess3", "test2", "Safari", now+60, now),
+	} {
+		require.NoError(t, client.CreateSession(ctx, session))
+	}
+
+	sessions, err := client.ListSessions(ctx, "test1")
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal("sess1", sessions[0].ID)
+	assert.Equal("sess2", sessions[1].ID)
+}
+
+func TestDeleteSessions(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithUsers(t)
+
+	now := time.Now().Unix()
+
+	for _, session := range []*model.Session{
+		model.NewSession("sess1", "test1", "", now+60, now),
+		model.NewSession("sess2", "test1", "", now+60, now),
+		model.NewSession("sess3", "test2", "", now+60, now),
+		model.NewSession("sess4", "test3", "", now+60, now),
+	} {
+		require.NoError(t, client.CreateSession(ctx, session))
+	}
+
+	require.NoError(t, client.DeleteSession(ctx, "sess1"))
+
+	_, err := client.GetSession(ctx, "sess1")
+	require.ErrorIs(t, err, model.ErrSessionNotFound)
+
+	require.NoError(t, client.DeleteUserSessions(ctx, "test1"))
+
+	sessions, err := client.ListSessions(ctx, "test1")
+	require.NoError(t, err)
+	assert.Empty(sessions)
+
+	// Sessions are removed alongside their user.
+	require.NoError(t, client.DeleteUser(ctx, "test2"))
+
+	_, err = client.GetSession(ctx, "sess3")
+	require.ErrorIs(t, err, model.ErrSessionNotFound)
+
+	_, err = client.GetSession(ctx, "sess4")
+	require.NoError(t, err)
+}
+
+func TestGetOrCreateSecret(t *testing.T) {
+	assert, ctx, client := SetupDatabase(t)
+
+	secret, err := client.GetOrCreateSecret(ctx, "key", []byte("first"))
+	require.NoError(t, err)
+	assert.Equal([]byte("first"), secret)
+
+	// The first stored value is kept.
+	secret, err = client.GetOrCreateSecret(ctx, "key", []byte("second"))
+	require.NoError(t, err)
+	assert.Equal([]byte("first"), secret)
+}
@@ -35,6 +35,8 @@ func (c *Client) GetTenantSettings(ctx context.Context) (*model.TenantSettings,
 			tenantSettings.BlockTorExitNodes = setting.Value
 		case model.SettingsKeyBlockedIPs:
 			tenantSettings.BlockedIPs = setting.Value
+		case model.SettingsKeyRetentionDays:
+			tenantSettings.RetentionDays = setting.Value
 		case model.SettingsKeyLanguage:
 			// exhaustive:ignore
 		}
@@ -55,6 +57,7 @@ func (c *Client) UpdateTenantSettings(
 		model.SettingsKeyBlockAbusiveIPs:   settings.BlockAbusiveIPs,
 		model.SettingsKeyBlockTorExitNodes: settings.BlockTorExitNodes,
 		model.SettingsKeyBlockedIPs:        settings.BlockedIPs,
+		model.SettingsKeyRetentionDays:     settings.RetentionDays,
 	}
 
 	for key, value := range propertiesToUpdate {
@@ -20,6 +20,7 @@ func TestGetTenantSettingsDefaults(t *testing.T) {
 	assert.Equal(defaults.BlockAbusiveIPs, settings.BlockAbusiveIPs)
 	assert.Equal(defaults.BlockTorExitNodes, settings.BlockTorExitNodes)
 	assert.Equal(defaults.BlockedIPs, settings.BlockedIPs)
+	assert.Equal(defaults.RetentionDays, settings.RetentionDays)
 }
 
 func TestGetTenantSettingsCustomValues(t *testing.T) {
@@ -78,12 +79,14 @@ func TestUpdateTenantSettingsAll(t *testing.T) {
 	blockAbusive := "false"
 	blockTor := "false"
 	blockedIPs := "10.0.0.1,10.0.0.2"
+	retentionDays := "365"
 
 	err := client.UpdateTenantSettings(ctx, &db.UpdateTenantSettings{
 		ScriptType:        &scriptType,
 		BlockAbusiveIPs:   &blockAbusive,
 		BlockTorExitNodes: &blockTor,
 		BlockedIPs:        &blockedIPs,
+		RetentionDays:     &retentionDays,
 	})
 	require.NoError(t, err)
 
@@ -95,6 +98,7 @@ func TestUpdateTenantSettingsAll(t *testing.T) {
 	assert.Equal("false", settings.BlockAbusiveIPs)
 	assert.Equal("false", settings.BlockTorExitNodes)
 	assert.Equal("10.0.0.1,10.0.0.2", settings.BlockedIPs)
+	assert.Equal("365", settings.RetentionDays)
 }
 
 func TestUpdateTenantSettingsPartial(t *testing.T) {
@@ -146,4 +150,5 @@ func TestUpdateTenantSettingsEmpty(t *testing.T) {
 	assert.Equal(defaults.BlockAbusiveIPs, settings.BlockAbusiveIPs)
 	assert.Equal(defaults.BlockTorExitNodes, settings.BlockTorExitNodes)
 	assert.Equal(defaults.BlockedIPs, settings.BlockedIPs)
+	assert.Equal(defaults.RetentionDays, settings.RetentionDays)
 }
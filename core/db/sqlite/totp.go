@@ -0,0 +1,246 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+	"github.com/ncruces/go-sqlite3"
+)
+
+// SetUserTOTP starts two-factor enrolment of a user, replacing a previous
+// enrolment that was never confirmed. If two-factor authentication is already
+// enabled, this returns model.ErrTOTPEnabled.
+func (c *Client) SetUserTOTP(ctx context.Context, totp *model.UserTOTP) error {
+	exec := `--sql
+	INSERT INTO user_totp (
+		user_id,
+		secret,
+		enabled,
+		last_step,
+		date_created,
+		date_updated
+	) VALUES (
+		:user_id,
+		:secret,
+		0,
+		0,
+		:date_created,
+		:date_updated
+	) ON CONFLICT(user_id) DO UPDATE SET
+		secret = excluded.secret,
+		last_step = 0,
+		date_created = excluded.date_created,
+		date_updated = excluded.date_updated
+	WHERE enabled = 0`
+
+	res, err := c.NamedExecContext(ctx, exec, totp)
+	if err != nil {
+		if errors.Is(err, sqlite3.CONSTRAINT_FOREIGNKEY) {
+			return model.ErrUserNotFound
+		}
+
+		log := logger.Get()
+		log.Error().Str("user_id", totp.UserID).Err(err).Msg("failed to set user totp")
+
+		return errors.Wrap(err, "db")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	if rowsAffected == 0 {
+		return model.ErrTOTPEnabled
+	}
+
+	return nil
+}
+
+func (c *Client) GetUserTOTP(ctx context.Context, userID string) (*model.UserTOTP, error) {
+	var totp model.UserTOTP
+
+	query := `--sql
+	SELECT user_id, secret, enabled, last_step, date_created, date_updated
+	FROM user_totp WHERE user_id = ?`
+
+	err := c.QueryRowxContext(ctx, query, userID).StructScan(&totp)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrTOTPNotFound
+		}
+
+		log := logger.Get()
+		log.Error().Str("user_id", userID).Err(err).Msg("failed to get user totp")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return &totp, nil
+}
+
+// EnableUserTOTP confirms two-factor enrolment of a user with the time step of
+// the confirmed code, replacing their recovery codes in a single transaction.
+func (c *Client) EnableUserTOTP(
+	ctx context.Context,
+	userID string,
+	step int64,
+	recoveryCodeHashes []string,
+	dateUpdated int64,
+) error {
+	log := logger.Get()
+
+	tx, err := c.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+	defer tx.Rollback() //nolint: errcheck // Called on defer
+
+	res, err := tx.ExecContext(ctx, `--sql
+	UPDATE user_totp SET enabled = 1, last_step = ?, date_updated = ?
+	WHERE user_id = ? AND enabled = 0`, step, dateUpdated, userID)
+	if err != nil {
+		log.Error().Str("user_id", userID).Err(err).Msg("failed to enable user totp")
+		return errors.Wrap(err, "db")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	if rowsAffected == 0 {
+		return model.ErrTOTPEnabled
+	}
+
+	_, err = tx.ExecContext(ctx, `--sql
+	DELETE FROM recovery_codes WHERE user_id = ?`, userID)
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	for _, hash := range recoveryCodeHashes {
+		_, err = tx.ExecContext(ctx, `--sql
+		INSERT INTO recovery_codes (user_id, hash, date_created) VALUES (?, ?, ?)`,
+			userID, hash, dateUpdated)
+		if err != nil {
+			log.Error().Str("user_id", userID).Err(err).Msg("failed to add recovery code")
+			return errors.Wrap(err, "db")
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
+
+// UpdateUserTOTPLastStep records the time step of an accepted code. Codes of
+// a step that is not after the last accepted step return
+// model.ErrInvalidTOTPCode, so a code can not be used twice even by
+// concurrent requests.
+func (c *Client) UpdateUserTOTPLastStep(ctx context.Context, userID string, step int64) error {
+	res, err := c.ExecContext(ctx, `--sql
+	UPDATE user_totp SET last_step = ? WHERE user_id = ? AND last_step < ?`, step, userID, step)
+	if err != nil {
+		log := logger.Get()
+		log.Error().Str("user_id", userID).Err(err).Msg("failed to update user totp last step")
+
+		return errors.Wrap(err, "db")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	if rowsAffected == 0 {
+		return model.ErrInvalidTOTPCode
+	}
+
+	return nil
+}
+
+// UseRecoveryCode marks an unused recovery code of the user as used. Unknown
+// or used codes return model.ErrInvalidTOTPCode.
+func (c *Client) UseRecoveryCode(ctx context.Context, userID string, hash string, dateUsed int64) error {
+	res, err := c.ExecContext(ctx, `--sql
+	UPDATE recovery_codes SET date_used = ?
+	WHERE user_id = ? AND hash = ? AND date_used IS NULL`, dateUsed, userID, hash)
+	if err != nil {
+		log := logger.Get()
+		log.Error().Str("user_id", userID).Err(err).Msg("failed to use recovery code")
+
+		return errors.Wrap(err, "db")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	if rowsAffected == 0 {
+		return model.ErrInvalidTOTPCode
+	}
+
+	return nil
+}
+
+// CountRecoveryCodes returns the number of unused recovery codes of the user.
+func (c *Client) CountRecoveryCodes(ctx context.Context, userID string) (int, error) {
+	var count int
+
+	err := c.GetContext(ctx, &count, `--sql
+	SELECT COUNT(*) FROM recovery_codes WHERE user_id = ? AND date_used IS NULL`, userID)
+	if err != nil {
+		return 0, errors.Wrap(err, "db")
+	}
+
+	return count, nil
+}
+
+// DeleteUserTOTP disables two-factor authentication of the user and removes
+// their recovery codes.
+func (c *Client) DeleteUserTOTP(ctx context.Context, userID string) error {
+	tx, err := c.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+	defer tx.Rollback() //nolint: errcheck // Called on defer
+
+	_, err = tx.ExecContext(ctx, `--sql
+	DELETE FROM recovery_codes WHERE user_id = ?`, userID)
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	res, err := tx.ExecContext(ctx, `--sql
+	DELETE FROM user_totp WHERE user_id = ?`, userID)
+	if err != nil {
+		log := logger.Get()
+		log.Error().Str("user_id", userID).Err(err).Msg("failed to delete user totp")
+
+		return errors.Wrap(err, "db")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	if rowsAffected == 0 {
+		return model.ErrTOTPNotFound
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
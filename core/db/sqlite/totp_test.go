@@ -0,0 +1,69 @@
+package sqlite_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserTOTP(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithUsers(t)
+
+	now := time.Now().Unix()
+
+	_, err := client.GetUserTOTP(ctx, "test1")
+	require.ErrorIs(t, err, model.ErrTOTPNotFound)
+
+	err = client.SetUserTOTP(ctx, model.NewUserTOTP("doesnotexist", []byte("secret"), now, now))
+	require.ErrorIs(t, err, model.ErrUserNotFound)
+
+	require.NoError(t, client.SetUserTOTP(ctx, model.NewUserTOTP("test1", []byte("first"), now, now)))
+
+	// Unconfirmed enrolments are replaced.
+	require.NoError(t, client.SetUserTOTP(ctx, model.NewUserTOTP("test1", []byte("second"), now, now)))
+
+	totp, err := client.GetUserTOTP(ctx, "test1")
+	require.NoError(t, err)
+	assert.Equal([]byte("second"), totp.Secret)
+	assert.False(totp.Enabled)
+
+	require.NoError(t, client.EnableUserTOTP(ctx, "test1", 100, []string{"hash1", "hash2"}, now))
+
+	totp, err = client.GetUserTOTP(ctx, "test1")
+	require.NoError(t, err)
+	assert.True(totp.Enabled)
+	assert.Equal(int64(100), totp.LastStep)
+
+	err = client.EnableUserTOTP(ctx, "test1", 101, []string{"hash3"}, now)
+	require.ErrorIs(t, err, model.ErrTOTPEnabled)
+
+	// Enabled secrets can not be replaced without disabling first.
+	err = client.SetUserTOTP(ctx, model.NewUserTOTP("test1", []byte("third"), now, now))
+	require.ErrorIs(t, err, model.ErrTOTPEnabled)
+
+	// Steps can only move forward.
+	require.ErrorIs(t, client.UpdateUserTOTPLastStep(ctx, "test1", 100), model.ErrInvalidTOTPCode)
+	require.NoError(t, client.UpdateUserTOTPLastStep(ctx, "test1", 101))
+
+	count, err := client.CountRecoveryCodes(ctx, "test1")
+	require.NoError(t, err)
+	assert.Equal(2, count)
+
+	// Recovery codes can only be used once.
+	require.NoError(t, client.UseRecoveryCode(ctx, "test1", "hash1", now))
+	require.ErrorIs(t, client.UseRecoveryCode(ctx, "test1", "hash1", now), model.ErrInvalidTOTPCode)
+	require.ErrorIs(t, client.UseRecoveryCode(ctx, "test2", "hash2", now), model.ErrInvalidTOTPCode)
+
+	count, err = client.CountRecoveryCodes(ctx, "test1")
+	require.NoError(t, err)
+	assert.Equal(1, count)
+
+	require.NoError(t, client.DeleteUserTOTP(ctx, "test1"))
+	require.ErrorIs(t, client.DeleteUserTOTP(ctx, "test1"), model.ErrTOTPNotFound)
+
+	count, err = client.CountRecoveryCodes(ctx, "test1")
+	require.NoError(t, err)
+	assert.Zero(count)
+}
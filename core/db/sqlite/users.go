@@ -7,16 +7,24 @@ import (
 	"time"
 
 	"github.com/go-faster/errors"
+	"github.com/jmoiron/sqlx"
 	"github.com/medama-io/medama/model"
 	"github.com/ncruces/go-sqlite3"
 )
 
 func (c *Client) CreateUser(ctx context.Context, user *model.User) error {
+	return createUser(ctx, c.DB, user)
+}
+
+// createUser inserts a user with the given executor, so users can also be
+// created as part of a transaction.
+func createUser(ctx context.Context, e sqlx.ExtContext, user *model.User) error {
 	exec := `--sql
 	INSERT INTO users (
 		id,
 		username,
 		password,
+		role,
 		settings,
 		date_created,
 		date_updated
@@ -24,6 +32,7 @@ func (c *Client) CreateUser(ctx context.Context, user *model.User) error {
 		:id,
 		:username,
 		:password,
+		:role,
 		:settings,
 		:date_created,
 		:date_updated
@@ -39,12 +48,13 @@ func (c *Client) CreateUser(ctx context.Context, user *model.User) error {
 		"id":           user.ID,
 		"username":     user.Username,
 		"password":     user.Password,
+		"role":         user.Role,
 		"settings":     string(settingsJSON),
 		"date_created": user.DateCreated,
 		dateUpdatedKey: user.DateUpdated,
 	}
 
-	_, err = c.NamedExecContext(ctx, exec, paramMap)
+	_, err = sqlx.NamedExecContext(ctx, e, exec, paramMap)
 	if err != nil {
 		if errors.Is(err, sqlite3.CONSTRAINT_UNIQUE) ||
 			errors.Is(err, sqlite3.CONSTRAINT_PRIMARYKEY) {
@@ -59,17 +69,18 @@ func (c *Client) CreateUser(ctx context.Context, user *model.User) error {
 
 func (c *Client) GetUser(ctx context.Context, id string) (*model.User, error) {
 	query := `--sql
-	SELECT id, username, password, settings, date_created, date_updated FROM users WHERE id = ?`
+	SELECT id, username, password, role, settings, date_created, date_updated FROM users WHERE id = ?`
 
 	var (
 		user         model.User
 		settingsJSON string
 	)
 
-	err := c.DB.QueryRowxContext(ctx, query, id).Scan(
+	err := c.QueryRowxContext(ctx, query, id).Scan(
 		&user.ID,
 		&user.Username,
 		&user.Password,
+		&user.Role,
 		&settingsJSON,
 		&user.DateCreated,
 		&user.DateUpdated,
@@ -97,17 +108,18 @@ func (c *Client) GetUser(ctx context.Context, id string) (*model.User, error) {
 
 func (c *Client) GetUserByUsername(ctx context.Context, username string) (*model.User, error) {
 	query := `--sql
-	SELECT id, username, password, settings, date_created, date_updated FROM users WHERE username = ?`
+	SELECT id, username, password, role, settings, date_created, date_updated FROM users WHERE username = ?`
 
 	var (
 		user         model.User
 		settingsJSON string
 	)
 
-	err := c.DB.QueryRowxContext(ctx, query, username).Scan(
+	err := c.QueryRowxContext(ctx, query, username).Scan(
 		&user.ID,
 		&user.Username,
 		&user.Password,
+		&user.Role,
 		&settingsJSON,
 		&user.DateCreated,
 		&user.DateUpdated,
@@ -133,6 +145,85 @@ func (c *Client) GetUserByUsername(ctx context.Context, username string) (*model
 	return &user, nil
 }
 
+// ListUsers returns all users ordered by creation date.
+func (c *Client) ListUsers(ctx context.Context) ([]*model.User, error) {
+	query := `--sql
+	SELECT id, username, password, role, settings, date_created, date_updated
+	FROM users ORDER BY date_created ASC, id ASC`
+
+	rows, err := c.QueryxContext(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "db")
+	}
+	defer rows.Close()
+
+	users := []*model.User{}
+
+	for rows.Next() {
+		var (
+			user         model.User
+			settingsJSON string
+		)
+
+		err = rows.Scan(
+			&user.ID,
+			&user.Username,
+			&user.Password,
+			&user.Role,
+			&settingsJSON,
+			&user.DateCreated,
+			&user.DateUpdated,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "db")
+		}
+
+		user.Settings = model.NewDefaultUserSettings()
+		if settingsJSON != "" {
+			err = json.Unmarshal([]byte(settingsJSON), user.Settings)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to unmarshal settings")
+			}
+		}
+
+		users = append(users, &user)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return users, nil
+}
+
+func (c *Client) UpdateUserRole(ctx context.Context, id string, role model.UserRole) error {
+	exec := `--sql
+	UPDATE users SET role = :role, date_updated = :date_updated WHERE id = :id`
+
+	paramMap := map[string]any{
+		"id":           id,
+		"role":         role,
+		dateUpdatedKey: time.Now().Unix(),
+	}
+
+	res, err := c.NamedExecContext(ctx, exec, paramMap)
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	if count == 0 {
+		return model.ErrUserNotFound
+	}
+
+	return nil
+}
+
 func (c *Client) UpdateUserUsername(ctx context.Context, id string, username string) error {
 	exec := `--sql
 	UPDATE users SET username = :username, date_updated = :date_updated WHERE id = :id`
@@ -208,12 +299,31 @@ func (c *Client) UpdateUserSettings(
 	return nil
 }
 
+// DeleteUser deletes a user in a single transaction. Websites added by the
+// user are transferred to the owner, while their memberships and API keys are
+// removed alongside the user.
 func (c *Client) DeleteUser(ctx context.Context, id string) error {
-	exec := `--sql
-	DELETE FROM users WHERE id = ?`
+	tx, err := c.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+	defer tx.Rollback() //nolint: errcheck // Called on defer
+
+	_, err = tx.ExecContext(ctx, `--sql
+	UPDATE websites SET user_id = (
+		SELECT id FROM users WHERE role = 'owner' AND id != ? ORDER BY date_created ASC LIMIT 1
+	) WHERE user_id = ? AND EXISTS (SELECT 1 FROM users WHERE role = 'owner' AND id != ?)`, id, id, id)
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
 
-	res, err := c.ExecContext(ctx, exec, id)
+	res, err := tx.ExecContext(ctx, `--sql
+	DELETE FROM users WHERE id = ?`, id)
 	if err != nil {
+		if errors.Is(err, sqlite3.CONSTRAINT_FOREIGNKEY) {
+			return model.ErrUserHasWebsites
+		}
+
 		return errors.Wrap(err, "db")
 	}
 
@@ -227,5 +337,10 @@ func (c *Client) DeleteUser(ctx context.Context, id string) error {
 		return model.ErrUserNotFound
 	}
 
+	err = tx.Commit()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
 	return nil
 }
@@ -14,6 +14,7 @@ func TestCreateUser(t *testing.T) {
 		"test",
 		"username",
 		"password",
+		model.UserRoleAdmin,
 		model.NewDefaultUserSettings(),
 		1,
 		2,
@@ -149,3 +150,50 @@ func TestDeleteUserNotFound(t *testing.T) {
 	err := client.DeleteUser(ctx, "doesnotexist")
 	require.ErrorIs(t, err, model.ErrUserNotFound)
 }
+
+func TestListUsers(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithUsers(t)
+
+	users, err := client.ListUsers(ctx)
+	require.NoError(t, err)
+	require.Len(t, users, 4)
+
+	// Users are ordered by creation date, so the default admin user created by
+	// the migrations comes after the test users.
+	assert.Equal("username1", users[0].Username)
+	assert.Equal(model.UserRoleViewer, users[0].Role)
+	assert.Equal("admin", users[3].Username)
+	assert.Equal(model.UserRoleOwner, users[3].Role)
+}
+
+func TestUpdateUserRole(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithUsers(t)
+
+	err := client.UpdateUserRole(ctx, "test1", model.UserRoleAdmin)
+	require.NoError(t, err)
+
+	user, err := client.GetUser(ctx, "test1")
+	require.NoError(t, err)
+	assert.Equal(model.UserRoleAdmin, user.Role)
+
+	err = client.UpdateUserRole(ctx, "doesnotexist", model.UserRoleAdmin)
+	require.ErrorIs(t, err, model.ErrUserNotFound)
+}
+
+func TestDeleteUserTransfersWebsites(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	owner, err := client.GetUserByUsername(ctx, "admin")
+	require.NoError(t, err)
+
+	err = client.DeleteUser(ctx, "test1")
+	require.NoError(t, err)
+
+	website, err := client.GetWebsite(ctx, "website1-test1.com")
+	require.NoError(t, err)
+	assert.Equal(owner.ID, website.UserID)
+
+	hostnames, err := client.ListUserWebsites(ctx, "test1")
+	require.NoError(t, err)
+	assert.Empty(hostnames)
+}
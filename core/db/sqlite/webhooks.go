@@ -0,0 +1,327 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+	"github.com/ncruces/go-sqlite3"
+)
+
+const webhookColumns = `--sql
+	id,
+	user_id,
+	hostname,
+	url,
+	secret,
+	trigger_type,
+	event_name,
+	threshold,
+	date_last_checked,
+	date_last_triggered,
+	date_created,
+	date_updated`
+
+// CreateWebhook adds a new webhook. Webhooks of a website that does not exist
+// return model.ErrWebsiteNotFound.
+func (c *Client) CreateWebhook(ctx context.Context, webhook *model.Webhook) error {
+	exec := `--sql
+	INSERT INTO webhooks (
+		id,
+		user_id,
+		hostname,
+		url,
+		secret,
+		trigger_type,
+		event_name,
+		threshold,
+		date_last_checked,
+		date_last_triggered,
+		date_created,
+		date_updated
+	) VALUES (
+		:id,
+		:user_id,
+		:hostname,
+		:url,
+		:secret,
+		:trigger_type,
+		:event_name,
+		:threshold,
+		:date_last_checked,
+		:date_last_triggered,
+		:date_created,
+		:date_updated
+	)`
+
+	_, err := c.NamedExecContext(ctx, exec, webhook)
+	if err != nil {
+		if errors.Is(err, sqlite3.CONSTRAINT_FOREIGNKEY) {
+			return model.ErrWebsiteNotFound
+		}
+
+		log := logger.Get()
+		log.Error().
+			Str("id", webhook.ID).
+			Str("hostname", webhook.Hostname).
+			Str("trigger", string(webhook.Trigger)).
+			Err(err).
+			Msg("failed to create webhook")
+
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
+
+// ListWebhooks returns the webhooks of the website ordered by creation date.
+func (c *Client) ListWebhooks(ctx context.Context, hostname string) ([]*model.Webhook, error) {
+	webhooks := []*model.Webhook{}
+
+	query := `--sql
+	SELECT` + webhookColumns + `
+	FROM webhooks WHERE hostname = ? ORDER BY date_created ASC, id ASC`
+
+	err := c.SelectContext(ctx, &webhooks, query, hostname)
+	if err != nil {
+		log := logger.Get()
+		log.Error().
+			Str("hostname", hostname).
+			Err(err).
+			Msg("failed to list webhooks")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return webhooks, nil
+}
+
+// ListAllWebhooks returns the webhooks of all websites.
+func (c *Client) ListAllWebhooks(ctx context.Context) ([]*model.Webhook, error) {
+	webhooks := []*model.Webhook{}
+
+	query := `--sql
+	SELECT` + webhookColumns + `
+	FROM webhooks ORDER BY date_created ASC, id ASC`
+
+	err := c.SelectContext(ctx, &webhooks, query)
+	if err != nil {
+		log := logger.Get()
+		log.Error().Err(err).Msg("failed to list all webhooks")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return webhooks, nil
+}
+
+// GetWebhook returns a webhook of the website by id.
+func (c *Client) GetWebhook(ctx context.Context, hostname string, id string) (*model.Webhook, error) {
+	var webhook model.Webhook
+
+	log := logger.Get()
+
+	query := `--sql
+	SELECT` + webhookColumns + `
+	FROM webhooks WHERE id = ? AND hostname = ?`
+
+	err := c.QueryRowxContext(ctx, query, id, hostname).StructScan(&webhook)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Str("id", id).Msg("webhook not found")
+			return nil, model.ErrWebhookNotFound
+		}
+
+		log.Error().Str("id", id).Err(err).Msg("failed to get webhook")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return &webhook, nil
+}
+
+// UpdateWebhookLastChecked records the end of the period last checked for new
+// referrers or anomalies.
+func (c *Client) UpdateWebhookLastChecked(ctx context.Context, id string, dateLastChecked int64) error {
+	exec := `--sql
+	UPDATE webhooks SET date_last_checked = ? WHERE id = ?`
+
+	_, err := c.ExecContext(ctx, exec, dateLastChecked, id)
+	if err != nil {
+		log := logger.Get()
+		log.Error().
+			Str("id", id).
+			Int64("date_last_checked", dateLastChecked).
+			Err(err).
+			Msg("failed to update webhook last checked")
+
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
+
+// UpdateWebhookLastTriggered records when the webhook was last sent.
+func (c *Client) UpdateWebhookLastTriggered(ctx context.Context, id string, dateLastTriggered int64) error {
+	exec := `--sql
+	UPDATE webhooks SET date_last_triggered = ? WHERE id = ?`
+
+	_, err := c.ExecContext(ctx, exec, dateLastTriggered, id)
+	if err != nil {
+		log := logger.Get()
+		log.Error().
+			Str("id", id).
+			Int64("date_last_triggered", dateLastTriggered).
+			Err(err).
+			Msg("failed to update webhook last triggered")
+
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
+
+// DeleteWebhook deletes a webhook of the website and its delivery log.
+func (c *Client) DeleteWebhook(ctx context.Context, hostname string, id string) error {
+	log := logger.Get()
+	exec := `--sql
+	DELETE FROM webhooks WHERE id = ? AND hostname = ?`
+
+	res, err := c.ExecContext(ctx, exec, id, hostname)
+	if err != nil {
+		log.Error().
+			Str("id", id).
+			Str("hostname", hostname).
+			Err(err).
+			Msg("failed to delete webhook")
+
+		return errors.Wrap(err, "db")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		log.Error().
+			Str("id", id).
+			Err(err).
+			Msg("failed to get rows affected")
+
+		return errors.Wrap(err, "db")
+	}
+
+	if rowsAffected == 0 {
+		log.Debug().Str("id", id).Msg("webhook not found")
+		return model.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+// CreateWebhookDelivery appends an entry to the delivery log of a webhook.
+func (c *Client) CreateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	exec := `--sql
+	INSERT INTO webhook_deliveries (
+		id,
+		webhook_id,
+		trigger_type,
+		payload,
+		status_code,
+		attempts,
+		error,
+		date_created,
+		date_completed
+	) VALUES (
+		:id,
+		:webhook_id,
+		:trigger_type,
+		:payload,
+		:status_code,
+		:attempts,
+		:error,
+		:date_created,
+		:date_completed
+	)`
+
+	_, err := c.NamedExecContext(ctx, exec, delivery)
+	if err != nil {
+		// The webhook was deleted while the delivery was in progress.
+		if errors.Is(err, sqlite3.CONSTRAINT_FOREIGNKEY) {
+			return model.ErrWebhookNotFound
+		}
+
+		log := logger.Get()
+		log.Error().
+			Str("id", delivery.ID).
+			Str("webhook_id", delivery.WebhookID).
+			Err(err).
+			Msg("failed to create webhook delivery")
+
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveries returns the delivery log of a webhook, newest first.
+func (c *Client) ListWebhookDeliveries(
+	ctx context.Context,
+	webhookID string,
+	limit int,
+	offset int,
+) ([]*model.WebhookDelivery, error) {
+	deliveries := []*model.WebhookDelivery{}
+
+	query := `--sql
+	SELECT
+		id,
+		webhook_id,
+		trigger_type,
+		payload,
+		status_code,
+		attempts,
+		error,
+		date_created,
+		date_completed
+	FROM webhook_deliveries WHERE webhook_id = ?
+	ORDER BY date_created DESC, id DESC
+	LIMIT ? OFFSET ?`
+
+	err := c.SelectContext(ctx, &deliveries, query, webhookID, limit, offset)
+	if err != nil {
+		log := logger.Get()
+		log.Error().
+			Str("webhook_id", webhookID).
+			Err(err).
+			Msg("failed to list webhook deliveries")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return deliveries, nil
+}
+
+// DeleteWebhookDeliveriesBefore deletes the delivery log entries created
+// before the given date and returns the number of deleted entries.
+func (c *Client) DeleteWebhookDeliveriesBefore(ctx context.Context, before int64) (int64, error) {
+	exec := `--sql
+	DELETE FROM webhook_deliveries WHERE date_created < ?`
+
+	res, err := c.ExecContext(ctx, exec, before)
+	if err != nil {
+		log := logger.Get()
+		log.Error().
+			Int64("before", before).
+			Err(err).
+			Msg("failed to delete webhook deliveries")
+
+		return 0, errors.Wrap(err, "db")
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db")
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,139 @@
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/medama-io/medama/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	err := client.CreateWebhook(ctx, model.NewWebhook(
+		"webhook1", "test1", "website1-test1.com", "https://hooks.example.com/a", "secret",
+		model.WebhookTriggerCustomEvent, "signup", 0, 1, 2,
+	))
+	require.NoError(t, err)
+
+	webhook, err := client.GetWebhook(ctx, "website1-test1.com", "webhook1")
+	require.NoError(t, err)
+	assert.Equal("test1", webhook.UserID)
+	assert.Equal("https://hooks.example.com/a", webhook.URL)
+	assert.Equal("secret", webhook.Secret)
+	assert.Equal(model.WebhookTriggerCustomEvent, webhook.Trigger)
+	assert.Equal("signup", webhook.EventName)
+	assert.Equal(int64(1), webhook.DateLastChecked)
+	assert.Zero(webhook.DateLastTriggered)
+
+	// Webhooks are only found through their own website.
+	_, err = client.GetWebhook(ctx, "website2-test1.com", "webhook1")
+	require.ErrorIs(t, err, model.ErrWebhookNotFound)
+
+	err = client.CreateWebhook(ctx, model.NewWebhook(
+		"webhook2", "test1", "doesnotexist.com", "https://hooks.example.com/a", "secret",
+		model.WebhookTriggerNewReferrer, "", 0, 1, 1,
+	))
+	require.ErrorIs(t, err, model.ErrWebsiteNotFound)
+}
+
+func TestListWebhooks(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	for _, webhook := range []*model.Webhook{
+		model.NewWebhook("webhook2", "test1", "website1-test1.com", "https://example.com/2", "s",
+			model.WebhookTriggerTrafficSpike, "", 100, 2, 2),
+		model.NewWebhook("webhook1", "test1", "website1-test1.com", "https://example.com/1", "s",
+			model.WebhookTriggerNewReferrer, "", 0, 1, 1),
+		model.NewWebhook("webhook3", "test2", "website1-test2.com", "https://example.com/3", "s",
+			model.WebhookTriggerNewReferrer, "", 0, 1, 1),
+	} {
+		require.NoError(t, client.CreateWebhook(ctx, webhook))
+	}
+
+	webhooks, err := client.ListWebhooks(ctx, "website1-test1.com")
+	require.NoError(t, err)
+	require.Len(t, webhooks, 2)
+	assert.Equal("webhook1", webhooks[0].ID)
+	assert.Equal("webhook2", webhooks[1].ID)
+	assert.Equal(100, webhooks[1].Threshold)
+
+	webhooks, err = client.ListAllWebhooks(ctx)
+	require.NoError(t, err)
+	assert.Len(webhooks, 3)
+
+	webhooks, err = client.ListWebhooks(ctx, "website2-test1.com")
+	require.NoError(t, err)
+	assert.Empty(webhooks)
+	assert.NotNil(webhooks)
+}
+
+func TestUpdateWebhookDates(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	require.NoError(t, client.CreateWebhook(ctx, model.NewWebhook(
+		"webhook1", "test1", "website1-test1.com", "https://example.com", "s",
+		model.WebhookTriggerNewReferrer, "", 0, 1, 1,
+	)))
+
+	require.NoError(t, client.UpdateWebhookLastChecked(ctx, "webhook1", 10))
+	require.NoError(t, client.UpdateWebhookLastTriggered(ctx, "webhook1", 20))
+
+	webhook, err := client.GetWebhook(ctx, "website1-test1.com", "webhook1")
+	require.NoError(t, err)
+	assert.Equal(int64(10), webhook.DateLastChecked)
+	assert.Equal(int64(20), webhook.DateLastTriggered)
+}
+
+func TestWebhookDeliveries(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	require.NoError(t, client.CreateWebhook(ctx, model.NewWebhook(
+		"webhook1", "test1", "website1-test1.com", "https://example.com", "s",
+		model.WebhookTriggerNewReferrer, "", 0, 1, 1,
+	)))
+
+	for i, id := range []string{"delivery1", "delivery2", "delivery3"} {
+		require.NoError(t, client.CreateWebhookDelivery(ctx, &model.WebhookDelivery{
+			ID:            id,
+			WebhookID:     "webhook1",
+			Trigger:       model.WebhookTriggerNewReferrer,
+			Payload:       "{}",
+			StatusCode:    200,
+			Attempts:      1,
+			DateCreated:   int64(i + 1),
+			DateCompleted: int64(i + 1),
+		}))
+	}
+
+	err := client.CreateWebhookDelivery(ctx, &model.WebhookDelivery{
+		ID:        "delivery4",
+		WebhookID: "doesnotexist",
+		Trigger:   model.WebhookTriggerNewReferrer,
+	})
+	require.ErrorIs(t, err, model.ErrWebhookNotFound)
+
+	deliveries, err := client.ListWebhookDeliveries(ctx, "webhook1", 2, 0)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	assert.Equal("delivery3", deliveries[0].ID)
+	assert.Equal("delivery2", deliveries[1].ID)
+	assert.Equal(200, deliveries[0].StatusCode)
+
+	deliveries, err = client.ListWebhookDeliveries(ctx, "webhook1", 2, 2)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal("delivery1", deliveries[0].ID)
+
+	deleted, err := client.DeleteWebhookDeliveriesBefore(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(int64(2), deleted)
+
+	// Deleting a webhook deletes its delivery log.
+	require.ErrorIs(t, client.DeleteWebhook(ctx, "website2-test1.com", "webhook1"), model.ErrWebhookNotFound)
+	require.NoError(t, client.DeleteWebhook(ctx, "website1-test1.com", "webhook1"))
+
+	deliveries, err = client.ListWebhookDeliveries(ctx, "webhook1", 10, 0)
+	require.NoError(t, err)
+	assert.Empty(deliveries)
+}
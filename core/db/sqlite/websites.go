@@ -3,6 +3,8 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/medama-io/medama/model"
@@ -10,19 +12,29 @@ import (
 	"github.com/ncruces/go-sqlite3"
 )
 
+// CreateWebsite adds a new website and makes the user who added it a member
+// in a single transaction.
 func (c *Client) CreateWebsite(ctx context.Context, website *model.Website) error {
+	tx, err := c.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+	defer tx.Rollback() //nolint: errcheck // Called on defer
+
+	// The hostname can not be reused until the data of a previously deleted
+	// website with the same hostname is purged.
 	exec := `--sql
 	INSERT INTO websites (
 		user_id,
 		hostname,
 		date_created,
 		date_updated
-	) VALUES (
+	) SELECT
 		:user_id,
 		:hostname,
 		:date_created,
 		:date_updated
-	)`
+	WHERE NOT EXISTS (SELECT 1 FROM website_deletions WHERE hostname = :hostname)`
 
 	paramMap := map[string]any{
 		"user_id":      website.UserID,
@@ -31,7 +43,7 @@ func (c *Client) CreateWebsite(ctx context.Context, website *model.Website) erro
 		dateUpdatedKey: website.DateUpdated,
 	}
 
-	_, err := c.NamedExecContext(ctx, exec, paramMap)
+	res, err := tx.NamedExecContext(ctx, exec, paramMap)
 	if err != nil {
 		switch {
 		case errors.Is(err, sqlite3.CONSTRAINT_PRIMARYKEY):
@@ -52,18 +64,45 @@ func (c *Client) CreateWebsite(ctx context.Context, website *model.Website) erro
 		return errors.Wrap(err, "db")
 	}
 
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	if rowsAffected == 0 {
+		return model.ErrWebsiteDeletionPending
+	}
+
+	_, err = tx.NamedExecContext(ctx, `--sql
+	INSERT INTO website_members (hostname, user_id, date_created)
+	VALUES (:hostname, :user_id, :date_created)`, paramMap)
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
 	return nil
 }
 
+// ListWebsites returns the websites the user can access. Owners and admins
+// can access all websites, while viewers can only access the websites they
+// are a member of.
 func (c *Client) ListWebsites(ctx context.Context, userID string) ([]*model.Website, error) {
 	var websites []*model.Website
 
 	log := logger.Get()
 
 	query := `--sql
-	SELECT user_id, hostname, date_created, date_updated FROM websites WHERE user_id = ?`
+	SELECT user_id, hostname, date_created, date_updated FROM websites
+	WHERE EXISTS (SELECT 1 FROM users WHERE id = ? AND role IN ('owner', 'admin'))
+		OR hostname IN (SELECT hostname FROM website_members WHERE user_id = ?)
+	ORDER BY date_created ASC, hostname ASC`
 
-	err := c.SelectContext(ctx, &websites, query, userID)
+	err := c.SelectContext(ctx, &websites, query, userID, userID)
 	if err != nil {
 		log.Error().
 			Str("user_id", userID).
@@ -99,14 +138,18 @@ func (c *Client) ListAllHostnames(ctx context.Context) ([]string, error) {
 	return hostnames, nil
 }
 
-func (c *Client) UpdateWebsite(ctx context.Context, website *model.Website) error {
+// UpdateWebsite updates the website with the given hostname. The website is
+// renamed if the hostname of the website differs, where goals follow the new
+// hostname.
+func (c *Client) UpdateWebsite(ctx context.Context, hostname string, website *model.Website) error {
 	log := logger.Get()
 	// Update all values except user_id
 	exec := `--sql
-	UPDATE websites SET hostname = :hostname, date_updated = :date_updated WHERE hostname = :hostname`
+	UPDATE websites SET hostname = :new_hostname, date_updated = :date_updated WHERE hostname = :hostname`
 
 	paramMap := map[string]any{
-		"hostname":     website.Hostname,
+		"hostname":     hostname,
+		"new_hostname": website.Hostname,
 		dateUpdatedKey: website.DateUpdated,
 	}
 
@@ -117,7 +160,8 @@ func (c *Client) UpdateWebsite(ctx context.Context, website *model.Website) erro
 		}
 
 		log.Error().
-			Str("hostname", website.Hostname).
+			Str("hostname", hostname).
+			Str("new_hostname", website.Hostname).
 			Int64("date_updated", website.DateUpdated).
 			Err(err).
 			Msg("failed to update website")
@@ -128,7 +172,7 @@ func (c *Client) UpdateWebsite(ctx context.Context, website *model.Website) erro
 	rowsAffected, err := res.RowsAffected()
 	if err != nil {
 		log.Error().
-			Str("hostname", website.Hostname).
+			Str("hostname", hostname).
 			Int64("date_updated", website.DateUpdated).
 			Err(err).
 			Msg("failed to get rows affected")
@@ -137,13 +181,157 @@ func (c *Client) UpdateWebsite(ctx context.Context, website *model.Website) erro
 	}
 
 	if rowsAffected == 0 {
-		log.Debug().Str("hostname", website.Hostname).Msg("website not found")
+		log.Debug().Str("hostname", hostname).Msg("website not found")
 		return model.ErrWebsiteNotFound
 	}
 
 	return nil
 }
 
+// MergeWebsite moves the goals, report subscriptions and webhooks of the
+// website with the hostname into the target website and deletes the website
+// in a single transaction.
+//
+// The members and scoped API keys of the website are removed with it, unless
+// moveAccess is set, in which case they are given access to the target
+// website instead. The access of the website before the merge is returned
+// either way.
+func (c *Client) MergeWebsite(
+	ctx context.Context,
+	hostname string,
+	into string,
+	moveAccess bool,
+) (*model.WebsiteAccess, error) {
+	log := logger.Get()
+
+	tx, err := c.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "db")
+	}
+	defer tx.Rollback() //nolint: errcheck // Called on defer
+
+	var exists bool
+
+	err = tx.QueryRowxContext(ctx, `--sql
+	SELECT EXISTS (SELECT 1 FROM websites WHERE hostname = ?)`, into).Scan(&exists)
+	if err != nil {
+		return nil, errors.Wrap(err, "db")
+	}
+
+	if !exists {
+		return nil, model.ErrWebsiteNotFound
+	}
+
+	access := &model.WebsiteAccess{Members: []string{}, APIKeys: []string{}}
+
+	// The owner of both websites keeps access through the target website.
+	err = tx.SelectContext(ctx, &access.Members, `--sql
+	SELECT user_id FROM website_members
+	WHERE hostname = ? AND user_id != (SELECT user_id FROM websites WHERE hostname = ?)
+	ORDER BY user_id`, hostname, hostname)
+	if err != nil {
+		return nil, errors.Wrap(err, "db")
+	}
+
+	err = tx.SelectContext(ctx, &access.APIKeys, `--sql
+	SELECT id FROM api_keys WHERE hostname = ? ORDER BY id`, hostname)
+	if err != nil {
+		return nil, errors.Wrap(err, "db")
+	}
+
+	_, err = tx.ExecContext(ctx, `--sql
+	UPDATE goals SET hostname = ? WHERE hostname = ?`, into, hostname)
+	if err != nil {
+		log.Error().
+			Str("hostname", hostname).
+			Str("into", into).
+			Err(err).
+			Msg("failed to move goals")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	if moveAccess {
+		_, err = tx.ExecContext(ctx, `--sql
+		INSERT OR IGNORE INTO website_members (hostname, user_id, date_created)
+		SELECT ?, user_id, date_created FROM website_members WHERE hostname = ?`, into, hostname)
+		if err != nil {
+			log.Error().
+				Str("hostname", hostname).
+				Str("into", into).
+				Err(err).
+				Msg("failed to move website members")
+
+			return nil, errors.Wrap(err, "db")
+		}
+
+		_, err = tx.ExecContext(ctx, `--sql
+		UPDATE api_keys SET hostname = ? WHERE hostname = ?`, into, hostname)
+		if err != nil {
+			log.Error().
+				Str("hostname", hostname).
+				Str("into", into).
+				Err(err).
+				Msg("failed to move api keys")
+
+			return nil, errors.Wrap(err, "db")
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `--sql
+	UPDATE report_subscriptions SET hostname = ? WHERE hostname = ?`, into, hostname)
+	if err != nil {
+		log.Error().
+			Str("hostname", hostname).
+			Str("into", into).
+			Err(err).
+			Msg("failed to move report subscriptions")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	_, err = tx.ExecContext(ctx, `--sql
+	UPDATE webhooks SET hostname = ? WHERE hostname = ?`, into, hostname)
+	if err != nil {
+		log.Error().
+			Str("hostname", hostname).
+			Str("into", into).
+			Err(err).
+			Msg("failed to move webhooks")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	res, err := tx.ExecContext(ctx, `--sql
+	DELETE FROM websites WHERE hostname = ?`, hostname)
+	if err != nil {
+		log.Error().
+			Str("hostname", hostname).
+			Str("into", into).
+			Err(err).
+			Msg("failed to merge website")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "db")
+	}
+
+	if rowsAffected == 0 {
+		log.Debug().Str("hostname", hostname).Msg("website not found")
+		return nil, model.ErrWebsiteNotFound
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return access, nil
+}
+
 func (c *Client) GetWebsite(ctx context.Context, hostname string) (*model.Website, error) {
 	var website model.Website
 
@@ -167,15 +355,22 @@ func (c *Client) GetWebsite(ctx context.Context, hostname string) (*model.Websit
 	return &website, nil
 }
 
-func (c *Client) DeleteWebsite(ctx context.Context, hostname string) error {
+// DeleteWebsite deletes a website and queues the deletion of its analytics
+// data in a single transaction.
+func (c *Client) DeleteWebsite(ctx context.Context, deletion *model.WebsiteDeletion) error {
 	log := logger.Get()
-	exec := `--sql
-	DELETE FROM websites WHERE hostname = ?`
 
-	res, err := c.ExecContext(ctx, exec, hostname)
+	tx, err := c.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+	defer tx.Rollback() //nolint: errcheck // Called on defer
+
+	res, err := tx.ExecContext(ctx, `--sql
+	DELETE FROM websites WHERE hostname = ?`, deletion.Hostname)
 	if err != nil {
 		log.Error().
-			Str("hostname", hostname).
+			Str("hostname", deletion.Hostname).
 			Err(err).
 			Msg("failed to delete website")
 
@@ -185,7 +380,7 @@ func (c *Client) DeleteWebsite(ctx context.Context, hostname string) error {
 	rowsAffected, err := res.RowsAffected()
 	if err != nil {
 		log.Error().
-			Str("hostname", hostname).
+			Str("hostname", deletion.Hostname).
 			Err(err).
 			Msg("failed to get rows affected")
 
@@ -193,9 +388,227 @@ func (c *Client) DeleteWebsite(ctx context.Context, hostname string) error {
 	}
 
 	if rowsAffected == 0 {
-		log.Debug().Str("hostname", hostname).Msg("website not found")
+		log.Debug().Str("hostname", deletion.Hostname).Msg("website not found")
+		return model.ErrWebsiteNotFound
+	}
+
+	_, err = tx.NamedExecContext(ctx, `--sql
+	INSERT INTO website_deletions (
+		hostname,
+		user_id,
+		views,
+		events,
+		deleted_views,
+		deleted_events,
+		date_created,
+		date_updated
+	) VALUES (
+		:hostname,
+		:user_id,
+		:views,
+		:events,
+		:deleted_views,
+		:deleted_events,
+		:date_created,
+		:date_updated
+	)`, deletion)
+	if err != nil {
+		log.Error().
+			Str("hostname", deletion.Hostname).
+			Err(err).
+			Msg("failed to queue website deletion")
+
+		return errors.Wrap(err, "db")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
+
+func (c *Client) GetWebsiteDeletion(ctx context.Context, hostname string) (*model.WebsiteDeletion, error) {
+	var deletion model.WebsiteDeletion
+
+	query := `--sql
+	SELECT hostname, user_id, views, events, deleted_views, deleted_events, date_created, date_updated
+	FROM website_deletions WHERE hostname = ?`
+
+	err := c.QueryRowxContext(ctx, query, hostname).StructScan(&deletion)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrWebsiteDeletionNotFound
+		}
+
+		log := logger.Get()
+		log.Error().Str("hostname", hostname).Err(err).Msg("failed to get website deletion")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return &deletion, nil
+}
+
+func (c *Client) ListWebsiteDeletions(ctx context.Context) ([]*model.WebsiteDeletion, error) {
+	deletions := []*model.WebsiteDeletion{}
+
+	query := `--sql
+	SELECT hostname, user_id, views, events, deleted_views, deleted_events, date_created, date_updated
+	FROM website_deletions ORDER BY date_created`
+
+	err := c.SelectContext(ctx, &deletions, query)
+	if err != nil {
+		log := logger.Get()
+		log.Error().Err(err).Msg("failed to list website deletions")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return deletions, nil
+}
+
+// UpdateWebsiteDeletion persists the number of rows deleted so far.
+func (c *Client) UpdateWebsiteDeletion(ctx context.Context, deletion *model.WebsiteDeletion) error {
+	exec := `--sql
+	UPDATE website_deletions SET
+		deleted_views = :deleted_views,
+		deleted_events = :deleted_events,
+		date_updated = :date_updated
+	WHERE hostname = :hostname`
+
+	res, err := c.NamedExecContext(ctx, exec, deletion)
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	if rowsAffected == 0 {
+		return model.ErrWebsiteDeletionNotFound
+	}
+
+	return nil
+}
+
+// CompleteWebsiteDeletion removes a website deletion once all of its
+// analytics data is purged, which allows the hostname to be reused.
+func (c *Client) CompleteWebsiteDeletion(ctx context.Context, hostname string) error {
+	_, err := c.ExecContext(ctx, `--sql
+	DELETE FROM website_deletions WHERE hostname = ?`, hostname)
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	return nil
+}
+
+func (c *Client) GetWebsiteSettings(ctx context.Context, hostname string) (*model.WebsiteSettings, error) {
+	var settingsJSON string
+
+	log := logger.Get()
+
+	query := `--sql
+	SELECT settings FROM websites WHERE hostname = ?`
+
+	err := c.QueryRowxContext(ctx, query, hostname).Scan(&settingsJSON)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Str("hostname", hostname).Msg("website not found")
+			return nil, model.ErrWebsiteNotFound
+		}
+
+		log.Error().Str("hostname", hostname).Err(err).Msg("failed to get website settings")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	return parseWebsiteSettings(settingsJSON)
+}
+
+func (c *Client) ListWebsiteSettings(ctx context.Context) (map[string]*model.WebsiteSettings, error) {
+	var rows []struct {
+		Hostname string `db:"hostname"`
+		Settings string `db:"settings"`
+	}
+
+	query := `--sql
+	SELECT hostname, settings FROM websites`
+
+	err := c.SelectContext(ctx, &rows, query)
+	if err != nil {
+		log := logger.Get()
+		log.Error().Err(err).Msg("failed to list website settings")
+
+		return nil, errors.Wrap(err, "db")
+	}
+
+	settings := make(map[string]*model.WebsiteSettings, len(rows))
+
+	for _, row := range rows {
+		websiteSettings, err := parseWebsiteSettings(row.Settings)
+		if err != nil {
+			return nil, err
+		}
+
+		settings[row.Hostname] = websiteSettings
+	}
+
+	return settings, nil
+}
+
+func (c *Client) UpdateWebsiteSettings(
+	ctx context.Context,
+	hostname string,
+	settings *model.WebsiteSettings,
+) error {
+	exec := `--sql
+	UPDATE websites SET settings = :settings, date_updated = :date_updated WHERE hostname = :hostname`
+
+	serializedSettings, err := json.Marshal(settings)
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize website settings")
+	}
+
+	paramMap := map[string]any{
+		"hostname":     hostname,
+		"settings":     string(serializedSettings),
+		dateUpdatedKey: time.Now().Unix(),
+	}
+
+	res, err := c.NamedExecContext(ctx, exec, paramMap)
+	if err != nil {
+		return errors.Wrap(err, "failed to persist website settings")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "db")
+	}
+
+	if rowsAffected == 0 {
 		return model.ErrWebsiteNotFound
 	}
 
 	return nil
 }
+
+// parseWebsiteSettings unmarshals the settings JSON column of a website.
+func parseWebsiteSettings(settingsJSON string) (*model.WebsiteSettings, error) {
+	settings := model.NewDefaultWebsiteSettings()
+
+	if settingsJSON == "" {
+		return settings, nil
+	}
+
+	err := json.Unmarshal([]byte(settingsJSON), settings)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal website settings")
+	}
+
+	return settings, nil
+}
@@ -115,6 +115,81 @@ func TestListAllHostnames(t *testing.T) {
 	assert.Len(hostnames, 9)
 }
 
+func TestUpdateWebsiteRename(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	goal := model.NewGoal("goal1", "website1-test1.com", "Signup", model.GoalTypeEvent, "", "signup", "", 1, 1)
+	require.NoError(t, client.CreateGoal(ctx, goal))
+
+	err := client.UpdateWebsite(ctx, "website1-test1.com", &model.Website{
+		Hostname:    "renamed-test1.com",
+		DateUpdated: 5,
+	})
+	require.NoError(t, err)
+
+	_, err = client.GetWebsite(ctx, "website1-test1.com")
+	require.ErrorIs(t, err, model.ErrWebsiteNotFound)
+
+	website, err := client.GetWebsite(ctx, "renamed-test1.com")
+	require.NoError(t, err)
+	assert.Equal("test1", website.UserID)
+	assert.Equal(int64(5), website.DateUpdated)
+
+	// Goals follow the renamed website.
+	goal, err = client.GetGoal(ctx, "goal1")
+	require.NoError(t, err)
+	assert.Equal("renamed-test1.com", goal.Hostname)
+}
+
+func TestUpdateWebsiteErrors(t *testing.T) {
+	_, ctx, client := SetupDatabaseWithWebsites(t)
+
+	err := client.UpdateWebsite(ctx, "website1-test1.com", &model.Website{Hostname: "website2-test1.com"})
+	require.ErrorIs(t, err, model.ErrWebsiteExists)
+
+	err = client.UpdateWebsite(ctx, "doesnotexist.com", &model.Website{Hostname: "new.com"})
+	require.ErrorIs(t, err, model.ErrWebsiteNotFound)
+}
+
+func TestMergeWebsite(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	goal := model.NewGoal("goal1", "website1-test1.com", "Signup", model.GoalTypeEvent, "", "signup", "", 1, 1)
+	require.NoError(t, client.CreateGoal(ctx, goal))
+
+	require.NoError(t, client.SetUserWebsites(ctx, "test2", []string{"website1-test1.com"}))
+	require.NoError(t, client.CreateAPIKey(ctx, model.NewAPIKey(
+		"key1", "test1", "CI", "hash", model.APIKeyRoleRead, "website1-test1.com", 0, 1, 1,
+	)))
+
+	access, err := client.MergeWebsite(ctx, "website1-test1.com", "website2-test1.com", false)
+	require.NoError(t, err)
+	assert.Equal([]string{"test2"}, access.Members)
+	assert.Equal([]string{"key1"}, access.APIKeys)
+
+	_, err = client.GetWebsite(ctx, "website1-test1.com")
+	require.ErrorIs(t, err, model.ErrWebsiteNotFound)
+
+	// Members and scoped keys do not gain access to the target website.
+	hostnames, err := client.ListUserWebsites(ctx, "test2")
+	require.NoError(t, err)
+	assert.Empty(hostnames)
+
+	_, err = client.GetAPIKey(ctx, "key1")
+	require.ErrorIs(t, err, model.ErrAPIKeyNotFound)
+
+	// Goals are moved instead of deleted with the website.
+	goal, err = client.GetGoal(ctx, "goal1")
+	require.NoError(t, err)
+	assert.Equal("website2-test1.com", goal.Hostname)
+
+	_, err = client.MergeWebsite(ctx, "website1-test1.com", "website2-test1.com", false)
+	require.ErrorIs(t, err, model.ErrWebsiteNotFound)
+
+	_, err = client.MergeWebsite(ctx, "website3-test1.com", "doesnotexist.com", false)
+	require.ErrorIs(t, err, model.ErrWebsiteNotFound)
+}
+
 func TestDeleteWebsite(t *testing.T) {
 	assert, ctx, client := SetupDatabaseWithWebsites(t)
 
@@ -122,17 +197,97 @@ func TestDeleteWebsite(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(website)
 
-	err = client.DeleteWebsite(ctx, "website1-test1.com")
+	err = client.DeleteWebsite(ctx, &model.WebsiteDeletion{
+		Hostname:    "website1-test1.com",
+		UserID:      "test1",
+		Views:       10,
+		Events:      2,
+		DateCreated: 3,
+		DateUpdated: 3,
+	})
 	require.NoError(t, err)
 
 	website, err = client.GetWebsite(ctx, "website1-test1.com")
 	require.ErrorIs(t, err, model.ErrWebsiteNotFound)
 	assert.Nil(website)
+
+	// The deletion of the website data is queued.
+	deletion, err := client.GetWebsiteDeletion(ctx, "website1-test1.com")
+	require.NoError(t, err)
+	assert.Equal("test1", deletion.UserID)
+	assert.Equal(int64(10), deletion.Views)
+	assert.Equal(int64(2), deletion.Events)
+	assert.Zero(deletion.DeletedViews)
+
+	// The hostname can not be reused until the data is purged.
+	err = client.CreateWebsite(ctx, model.NewWebsite("test2", "website1-test1.com", 4, 4))
+	require.ErrorIs(t, err, model.ErrWebsiteDeletionPending)
+
+	deletion.DeletedViews = 5
+	deletion.DateUpdated = 4
+	require.NoError(t, client.UpdateWebsiteDeletion(ctx, deletion))
+
+	deletions, err := client.ListWebsiteDeletions(ctx)
+	require.NoError(t, err)
+	require.Len(t, deletions, 1)
+	assert.Equal(int64(5), deletions[0].DeletedViews)
+	assert.Equal(int64(4), deletions[0].DateUpdated)
+
+	require.NoError(t, client.CompleteWebsiteDeletion(ctx, "website1-test1.com"))
+
+	_, err = client.GetWebsiteDeletion(ctx, "website1-test1.com")
+	require.ErrorIs(t, err, model.ErrWebsiteDeletionNotFound)
+
+	err = client.UpdateWebsiteDeletion(ctx, deletion)
+	require.ErrorIs(t, err, model.ErrWebsiteDeletionNotFound)
+
+	err = client.CreateWebsite(ctx, model.NewWebsite("test2", "website1-test1.com", 4, 4))
+	require.NoError(t, err)
 }
 
 func TestDeleteWebsiteNotFound(t *testing.T) {
+	assert, ctx, client := SetupDatabase(t)
+
+	err := client.DeleteWebsite(ctx, &model.WebsiteDeletion{Hostname: "doesnotexist"})
+	require.ErrorIs(t, err, model.ErrWebsiteNotFound)
+
+	// Nothing is queued if the website does not exist.
+	deletions, err := client.ListWebsiteDeletions(ctx)
+	require.NoError(t, err)
+	assert.Empty(deletions)
+}
+
+func TestWebsiteSettings(t *testing.T) {
+	assert, ctx, client := SetupDatabaseWithWebsites(t)
+
+	settings, err := client.GetWebsiteSettings(ctx, "website1-test1.com")
+	require.NoError(t, err)
+	assert.Nil(settings.RetentionDays)
+	assert.Equal(90, settings.Retention(90))
+
+	retentionDays := 30
+	err = client.UpdateWebsiteSettings(ctx, "website1-test1.com", &model.WebsiteSettings{
+		RetentionDays: &retentionDays,
+	})
+	require.NoError(t, err)
+
+	settings, err = client.GetWebsiteSettings(ctx, "website1-test1.com")
+	require.NoError(t, err)
+	assert.Equal(30, settings.Retention(90))
+
+	all, err := client.ListWebsiteSettings(ctx)
+	require.NoError(t, err)
+	assert.Len(all, 9)
+	assert.Equal(30, all["website1-test1.com"].Retention(0))
+	assert.Equal(0, all["website2-test1.com"].Retention(0))
+}
+
+func TestWebsiteSettingsNotFound(t *testing.T) {
 	_, ctx, client := SetupDatabase(t)
 
-	err := client.DeleteWebsite(ctx, "doesnotexist")
+	_, err := client.GetWebsiteSettings(ctx, "doesnotexist.com")
+	require.ErrorIs(t, err, model.ErrWebsiteNotFound)
+
+	err = client.UpdateWebsiteSettings(ctx, "doesnotexist.com", model.NewDefaultWebsiteSettings())
 	require.ErrorIs(t, err, model.ErrWebsiteNotFound)
 }
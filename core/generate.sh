@@ -13,5 +13,5 @@ go tool github.com/ogen-go/ogen/cmd/ogen --target api --clean openapi.yaml
 # in case the line number changes.
 #
 # perl is more portable across different systems compared to sed.
-# Line 280
-perl -i -pe 's/^.*$// if $. == 280; $. == 280 and print "    case ct == \"application/json\", ct == \"text/plain\":"' ./api/oas_request_decoders_gen.go
+# Line 754
+perl -i -pe 's/^.*$// if $. == 754; $. == 754 and print "    case ct == \"application/json\", ct == \"text/plain\":"' ./api/oas_request_decoders_gen.go
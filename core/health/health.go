@@ -0,0 +1,200 @@
+// Package health serves the liveness and readiness probes of the server.
+package health
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+const (
+	StatusOK           = "ok"
+	StatusUnavailable  = "unavailable"
+	StatusShuttingDown = "shutting down"
+
+	// checkTimeout limits how long a single readiness check may take.
+	checkTimeout = 2 * time.Second
+)
+
+var (
+	// ErrNotMigrated is returned while database migrations have not completed.
+	ErrNotMigrated = errors.New("health: migrations have not completed")
+	// ErrCertificateExpired is returned when the served certificate is not
+	// valid at the current time.
+	ErrCertificateExpired = errors.New("health: certificate is expired or not yet valid")
+)
+
+// Check returns an error if a dependency of the server is not ready.
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Response is the body of the liveness and readiness probes. Checks maps the
+// name of each readiness check to "ok" or the error it returned.
+type Response struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// Checker runs the readiness checks of the server.
+type Checker struct {
+	mu     sync.RWMutex
+	checks []namedCheck
+
+	// draining is set once graceful shutdown starts, so load balancers stop
+	// sending new traffic before the server closes.
+	draining atomic.Bool
+}
+
+// NewChecker returns a checker without any readiness checks.
+func NewChecker() *Checker {
+	return &Checker{}
+}
+
+// Add registers a readiness check under the given name.
+func (c *Checker) Add(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// Drain marks the server as unavailable for the rest of its lifetime.
+func (c *Checker) Drain() {
+	c.draining.Store(true)
+}
+
+// Ready runs all readiness checks and reports whether all of them passed.
+func (c *Checker) Ready(ctx context.Context) (Response, bool) {
+	if c.draining.Load() {
+		return Response{Status: StatusShuttingDown}, false
+	}
+
+	c.mu.RLock()
+	checks := c.checks
+	c.mu.RUnlock()
+
+	resp := Response{Status: StatusOK, Checks: make(map[string]string, len(checks))}
+
+	for _, check := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+		err := check.check(checkCtx)
+		cancel()
+
+		if err != nil {
+			resp.Status = StatusUnavailable
+			resp.Checks[check.name] = err.Error()
+
+			continue
+		}
+
+		resp.Checks[check.name] = StatusOK
+	}
+
+	return resp, resp.Status == StatusOK
+}
+
+// LivenessHandler reports that the server is running and able to respond.
+func (c *Checker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeResponse(w, http.StatusOK, Response{Status: StatusOK})
+	})
+}
+
+// ReadinessHandler reports whether the server is ready to receive traffic,
+// responding with 503 Service Unavailable if any check fails or the server
+// is shutting down.
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp, ok := c.Ready(r.Context())
+
+		code := http.StatusOK
+		if !ok {
+			code = http.StatusServiceUnavailable
+		}
+
+		writeResponse(w, code, resp)
+	})
+}
+
+func writeResponse(w http.ResponseWriter, code int, resp Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// Pinger is a database connection that can be pinged.
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// Ping returns a check verifying the database is reachable.
+func Ping(db Pinger) Check {
+	return func(ctx context.Context) error {
+		return db.PingContext(ctx)
+	}
+}
+
+// Migrated returns a check verifying database migrations have completed.
+func Migrated(completed func() bool) Check {
+	return func(_ context.Context) error {
+		if !completed() {
+			return ErrNotMigrated
+		}
+
+		return nil
+	}
+}
+
+// Certificate returns a check verifying the certificate served for the
+// domain is currently valid. getCertificate is usually the GetCertificate
+// function of the TLS config of the server.
+func Certificate(
+	domain string,
+	getCertificate func(*tls.ClientHelloInfo) (*tls.Certificate, error),
+	now func() time.Time,
+) Check {
+	return func(_ context.Context) error {
+		// Certificate managers may log the remote address of the handshake,
+		// so the hello needs a connection even though nothing is sent.
+		client, server := net.Pipe()
+		defer client.Close()
+		defer server.Close()
+
+		cert, err := getCertificate(&tls.ClientHelloInfo{ServerName: domain, Conn: server})
+		if err != nil {
+			return errors.Wrap(err, "health: certificate")
+		}
+
+		if cert == nil || len(cert.Certificate) == 0 {
+			return errors.New("health: no certificate for " + domain)
+		}
+
+		leaf := cert.Leaf
+		if leaf == nil {
+			leaf, err = x509.ParseCertificate(cert.Certificate[0])
+			if err != nil {
+				return errors.Wrap(err, "health: certificate")
+			}
+		}
+
+		t := now()
+		if t.Before(leaf.NotBefore) || t.After(leaf.NotAfter) {
+			return ErrCertificateExpired
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,119 @@
+package health_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func probe(t *testing.T, handler http.Handler) (int, health.Response) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	var resp health.Response
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+
+	return rec.Code, resp
+}
+
+func TestChecker(t *testing.T) {
+	assert := assert.New(t)
+
+	var dbErr error
+
+	migrated := false
+
+	checker := health.NewChecker()
+	checker.Add("db", func(_ context.Context) error { return dbErr })
+	checker.Add("migrations", health.Migrated(func() bool { return migrated }))
+
+	code, resp := probe(t, checker.ReadinessHandler())
+	assert.Equal(http.StatusServiceUnavailable, code)
+	assert.Equal(health.Response{
+		Status: health.StatusUnavailable,
+		Checks: map[string]string{"db": "ok", "migrations": health.ErrNotMigrated.Error()},
+	}, resp)
+
+	migrated = true
+
+	code, resp = probe(t, checker.ReadinessHandler())
+	assert.Equal(http.StatusOK, code)
+	assert.Equal(health.StatusOK, resp.Status)
+
+	dbErr = errors.New("database is closed")
+
+	code, resp = probe(t, checker.ReadinessHandler())
+	assert.Equal(http.StatusServiceUnavailable, code)
+	assert.Equal("database is closed", resp.Checks["db"])
+
+	// Readiness fails once shutdown starts, while the server is still live.
+	dbErr = nil
+
+	checker.Drain()
+
+	code, resp = probe(t, checker.ReadinessHandler())
+	assert.Equal(http.StatusServiceUnavailable, code)
+	assert.Equal(health.Response{Status: health.StatusShuttingDown}, resp)
+
+	code, resp = probe(t, checker.LivenessHandler())
+	assert.Equal(http.StatusOK, code)
+	assert.Equal(health.StatusOK, resp.Status)
+}
+
+func TestCertificate(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+
+	notBefore := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.Add(90 * 24 * time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(err)
+
+	getCertificate := func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+		if hello.ServerName != "example.com" {
+			return nil, errors.New("no certificate")
+		}
+
+		return &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+	}
+
+	at := func(t time.Time) func() time.Time {
+		return func() time.Time { return t }
+	}
+
+	check := health.Certificate("example.com", getCertificate, at(notBefore.Add(time.Hour)))
+	require.NoError(check(t.Context()))
+
+	check = health.Certificate("example.com", getCertificate, at(notBefore.Add(91*24*time.Hour)))
+	require.ErrorIs(check(t.Context()), health.ErrCertificateExpired)
+
+	check = health.Certificate("other.example.com", getCertificate, at(notBefore.Add(time.Hour)))
+	assert.Error(check(t.Context()))
+}
@@ -0,0 +1,446 @@
+package importer
+
+import (
+	"context"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/medama-io/medama/model"
+)
+
+const (
+	// bounceDurationMs is the duration given to synthesised bounced visits,
+	// within the bounce range used by the stats queries.
+	bounceDurationMs = 1000
+	// minEngagedDurationMs is the minimum duration given to synthesised visits
+	// that did not bounce, just outside of the bounce range.
+	minEngagedDurationMs = 5001
+)
+
+// strides are used to interleave synthesised rows. A different stride is used
+// for each dimension so the most common values of different dimensions are
+// not always assigned to the same rows.
+var strides = []int{7919, 104729, 1299709, 15485863, 32452843, 49979687, 67867967, 86028121}
+
+// metrics are the aggregated counts of a report row.
+type metrics struct {
+	visitors  int
+	pageviews int
+	visits    int
+	bounces   int
+	// duration is the total duration of all visits in seconds.
+	duration float64
+}
+
+func (m *metrics) add(other metrics) {
+	m.visitors += other.visitors
+	m.pageviews += other.pageviews
+	m.visits += other.visits
+	m.bounces += other.bounces
+	m.duration += other.duration
+}
+
+// page is the daily metrics of a single pathname.
+type page struct {
+	pathname string
+	metrics
+}
+
+// breakdown is the daily metrics of a single dimension value, e.g. a country.
+type breakdown struct {
+	// apply sets the dimension value on a page view.
+	apply func(view *model.ImportedPageView)
+	metrics
+}
+
+// eventCount is the daily count of a custom event name.
+type eventCount struct {
+	name     string
+	visitors int
+	count    int
+}
+
+// aggregate collects the daily reports of an export before page views are
+// synthesised from them.
+type aggregate struct {
+	totals     map[time.Time]*metrics
+	pages      map[time.Time][]page
+	dimensions map[string]map[time.Time][]breakdown
+	events     map[time.Time][]eventCount
+}
+
+func newAggregate() *aggregate {
+	return &aggregate{
+		totals:     make(map[time.Time]*metrics),
+		pages:      make(map[time.Time][]page),
+		dimensions: make(map[string]map[time.Time][]breakdown),
+		events:     make(map[time.Time][]eventCount),
+	}
+}
+
+func (a *aggregate) addTotals(day time.Time, m metrics) {
+	totals, ok := a.totals[day]
+	if !ok {
+		totals = &metrics{}
+		a.totals[day] = totals
+	}
+
+	totals.add(m)
+}
+
+func (a *aggregate) addPage(day time.Time, pathname string, m metrics) {
+	a.pages[day] = append(a.pages[day], page{pathname: pathname, metrics: m})
+}
+
+func (a *aggregate) addBreakdown(dimension string, day time.Time, b breakdown) {
+	days, ok := a.dimensions[dimension]
+	if !ok {
+		days = make(map[time.Time][]breakdown)
+		a.dimensions[dimension] = days
+	}
+
+	days[day] = append(days[day], b)
+}
+
+func (a *aggregate) addEvent(day time.Time, event eventCount) {
+	a.events[day] = append(a.events[day], event)
+}
+
+// writeAggregate synthesises and writes the page views and custom events of
+// each day.
+//
+// Every page view of the pages report becomes a row, where the first page
+// views of each page up to its visitor count are unique page views. The
+// unique visitors of the day are spread across the unique page views. Each
+// dimension is then distributed separately over unique visitors and the
+// remaining page views, so both the visitor and page view counts of each
+// dimension value match the reports as closely as integer rounding allows.
+func (i *Importer) writeAggregate(ctx context.Context, agg *aggregate) error {
+	days := sortedDays(agg.pages)
+
+	// Page views can only be synthesised for days with a pages report.
+	for _, day := range sortedDays(agg.totals) {
+		if _, ok := agg.pages[day]; !ok {
+			i.skip("totals of " + day.Format(time.DateOnly) + " without pages")
+		}
+	}
+
+	for _, day := range sortedDays(agg.events) {
+		if _, ok := agg.pages[day]; !ok {
+			i.skip("events of " + day.Format(time.DateOnly) + " without pages")
+		}
+	}
+
+	dimensions := slices.Sorted(maps.Keys(agg.dimensions))
+
+	for _, day := range days {
+		views := i.synthesiseDay(day, agg, dimensions)
+
+		for _, view := range views {
+			err := i.addView(ctx, view)
+			if err != nil {
+				return err
+			}
+		}
+
+		err := i.synthesiseEvents(ctx, views, agg.events[day])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// synthesiseDay returns the page views of a single day.
+func (i *Importer) synthesiseDay(
+	day time.Time,
+	agg *aggregate,
+	dimensions []string,
+) []model.ImportedPageView {
+	pages := agg.pages[day]
+
+	var (
+		views         []model.ImportedPageView
+		uniquePages   int
+		pageVisitors  = make([]float64, len(pages))
+		firstPageView = make([]int, len(pages))
+	)
+
+	for idx, p := range pages {
+		firstPageView[idx] = len(views)
+		pageVisitors[idx] = float64(p.visitors)
+
+		for n := range max(p.pageviews, p.visitors) {
+			view := i.newView(day)
+			view.BID = i.nextID()
+			view.Pathname = p.pathname
+			view.IsUniquePage = n < p.visitors
+			views = append(views, view)
+		}
+
+		uniquePages += p.visitors
+	}
+
+	if len(views) == 0 {
+		return nil
+	}
+
+	// Use the visitors of the day if known, otherwise assume each page was
+	// visited by different visitors.
+	visitors := uniquePages
+	if totals, ok := agg.totals[day]; ok && totals.visitors > 0 {
+		visitors = min(totals.visitors, uniquePages)
+	}
+
+	// The first page view of each unique visitor is also a unique page view.
+	var unique, other []int
+
+	for idx, count := range allocate(visitors, pageVisitors) {
+		for n := range count {
+			row := firstPageView[idx] + n
+			views[row].IsUniqueUser = true
+			unique = append(unique, row)
+		}
+	}
+
+	for row := range views {
+		if !views[row].IsUniqueUser {
+			other = append(other, row)
+		}
+	}
+
+	for idx, dimension := range dimensions {
+		breakdowns := agg.dimensions[dimension][day]
+		if len(breakdowns) == 0 {
+			continue
+		}
+
+		stride := strides[(idx+1)%len(strides)]
+
+		visitorWeights := make([]float64, len(breakdowns))
+		otherWeights := make([]float64, len(breakdowns))
+
+		for n, b := range breakdowns {
+			visitorWeights[n] = float64(b.visitors)
+			otherWeights[n] = float64(max(b.pageviews-b.visitors, 0))
+		}
+
+		assign(views, unique, breakdowns, visitorWeights, stride)
+		assign(views, other, breakdowns, otherWeights, stride)
+	}
+
+	if totals, ok := agg.totals[day]; ok {
+		setDurations(views, unique, totals)
+	}
+
+	// Spread the page views over the day.
+	interval := 24 * time.Hour / time.Duration(len(views))
+	for n, pos := range spread(len(views), strides[0]) {
+		views[pos].DateCreated = day.Add(time.Duration(n) * interval)
+	}
+
+	return views
+}
+
+// synthesiseEvents writes the custom events of a day, attached to the unique
+// visitors of the day so conversions can be counted.
+func (i *Importer) synthesiseEvents(
+	ctx context.Context,
+	views []model.ImportedPageView,
+	events []eventCount,
+) error {
+	if len(views) == 0 {
+		return nil
+	}
+
+	var visitors []int
+
+	for idx, view := range views {
+		if view.IsUniqueUser {
+			visitors = append(visitors, idx)
+		}
+	}
+
+	if len(visitors) == 0 {
+		visitors = []int{0}
+	}
+
+	for _, event := range events {
+		converters := min(max(event.visitors, 1), len(visitors))
+
+		for n := range max(event.count, event.visitors) {
+			view := views[visitors[n%converters]]
+
+			err := i.addEvent(ctx, model.ImportedEvent{
+				EventHit: model.EventHit{
+					BID:     view.BID,
+					BatchID: i.nextID(),
+					Group:   i.hostname,
+					Name:    EventPropertyName,
+					Value:   event.name,
+				},
+				DateCreated: view.DateCreated,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// assign distributes the dimension values over the rows proportionally to
+// the weights. If all weights are zero, the values are distributed
+// proportionally to their visitors instead.
+func assign(
+	views []model.ImportedPageView,
+	rows []int,
+	breakdowns []breakdown,
+	weights []float64,
+	stride int,
+) {
+	if len(rows) == 0 {
+		return
+	}
+
+	if sum(weights) == 0 {
+		weights = make([]float64, len(breakdowns))
+		for n, b := range breakdowns {
+			weights[n] = float64(b.visitors + b.pageviews)
+		}
+
+		if sum(weights) == 0 {
+			return
+		}
+	}
+
+	positions := spread(len(rows), stride)
+	n := 0
+
+	for idx, count := range allocate(len(rows), weights) {
+		for range count {
+			breakdowns[idx].apply(&views[rows[positions[n]]])
+			n++
+		}
+	}
+}
+
+// setDurations sets the page view durations so the median duration and
+// bounce rate approximate the totals of the day.
+func setDurations(views []model.ImportedPageView, unique []int, totals *metrics) {
+	if totals.duration <= 0 && totals.bounces == 0 {
+		return
+	}
+
+	durationMs := int(totals.duration * 1000 / float64(len(views)))
+
+	bounces := 0
+	if totals.visits > 0 {
+		rate := math.Min(float64(totals.bounces)/float64(totals.visits), 1)
+		bounces = int(math.Round(rate * float64(len(unique))))
+	}
+
+	bounced := make(map[int]struct{}, bounces)
+	for n, pos := range spread(len(unique), strides[0]) {
+		if n >= bounces {
+			break
+		}
+
+		bounced[unique[pos]] = struct{}{}
+	}
+
+	for idx := range views {
+		switch _, ok := bounced[idx]; {
+		case ok:
+			views[idx].DurationMs = bounceDurationMs
+		case views[idx].IsUniqueUser:
+			views[idx].DurationMs = max(durationMs, minEngagedDurationMs)
+		default:
+			views[idx].DurationMs = durationMs
+		}
+	}
+}
+
+// allocate splits n into integer counts proportional to the weights using the
+// largest remainder method, so the counts always sum to n.
+func allocate(n int, weights []float64) []int {
+	counts := make([]int, len(weights))
+
+	total := sum(weights)
+	if n <= 0 || total <= 0 {
+		return counts
+	}
+
+	type remainder struct {
+		idx   int
+		value float64
+	}
+
+	remainders := make([]remainder, len(weights))
+	allocated := 0
+
+	for idx, weight := range weights {
+		share := float64(n) * weight / total
+		counts[idx] = int(share)
+		allocated += counts[idx]
+		remainders[idx] = remainder{idx: idx, value: share - float64(counts[idx])}
+	}
+
+	sort.SliceStable(remainders, func(a, b int) bool {
+		return remainders[a].value > remainders[b].value
+	})
+
+	for n := range n - allocated {
+		counts[remainders[n%len(remainders)].idx]++
+	}
+
+	return counts
+}
+
+// spread returns a permutation of 0..n-1 where consecutive values are far
+// apart, so consecutive rows of the same value are interleaved with others.
+func spread(n int, stride int) []int {
+	if n <= 1 {
+		return make([]int, n)
+	}
+
+	for gcd(stride, n) != 1 {
+		stride++
+	}
+
+	positions := make([]int, n)
+	for idx := range positions {
+		positions[idx] = (idx * stride) % n
+	}
+
+	return positions
+}
+
+// sortedDays returns the days of the map in chronological order.
+func sortedDays[V any](days map[time.Time]V) []time.Time {
+	return slices.SortedFunc(maps.Keys(days), func(a, b time.Time) int {
+		return a.Compare(b)
+	})
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
+
+func sum(values []float64) float64 {
+	var total float64
+	for _, value := range values {
+		total += value
+	}
+
+	return total
+}
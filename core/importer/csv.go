@@ -0,0 +1,245 @@
+package importer
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+// dateLayouts are the date and timestamp formats used by the supported
+// exports. Timestamps without a zone are in UTC.
+var dateLayouts = []string{
+	"2006-01-02",
+	"20060102",
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+}
+
+// eachFile calls fn with the base name and contents of each file. CSV files
+// inside zip archives are passed individually.
+func eachFile(paths []string, fn func(name string, r io.Reader) error) error {
+	for _, path := range paths {
+		if strings.EqualFold(filepath.Ext(path), ".zip") {
+			err := eachZipFile(path, fn)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		err := eachPlainFile(path, fn)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func eachPlainFile(path string, fn func(name string, r io.Reader) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "importer")
+	}
+	defer f.Close()
+
+	err = fn(filepath.Base(path), f)
+	if err != nil {
+		return errors.Wrapf(err, "%s", path)
+	}
+
+	return nil
+}
+
+func eachZipFile(path string, fn func(name string, r io.Reader) error) error {
+	archive, err := zip.OpenReader(path)
+	if err != nil {
+		return errors.Wrap(err, "importer")
+	}
+	defer archive.Close()
+
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() || !strings.EqualFold(filepath.Ext(file.Name), ".csv") {
+			continue
+		}
+
+		r, err := file.Open()
+		if err != nil {
+			return errors.Wrapf(err, "%s: %s", path, file.Name)
+		}
+
+		err = fn(filepath.Base(file.Name), r)
+		r.Close()
+
+		if err != nil {
+			return errors.Wrapf(err, "%s: %s", path, file.Name)
+		}
+	}
+
+	return nil
+}
+
+// row is a single CSV record with its columns accessed by header name.
+type row struct {
+	header map[string]int
+	record []string
+	line   int
+}
+
+// readCSV calls fn for each record of the CSV file. Header names are matched
+// case insensitively and lines starting with # are ignored.
+func readCSV(r io.Reader, fn func(row *row) error) error {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+
+	names, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return errors.New("file is empty")
+		}
+
+		return errors.Wrap(err, "read header")
+	}
+
+	header := make(map[string]int, len(names))
+	for idx, name := range names {
+		// Strip the byte order mark added by spreadsheet tools.
+		name = strings.TrimPrefix(name, "\ufeff")
+		header[strings.ToLower(strings.TrimSpace(name))] = idx
+	}
+
+	current := &row{header: header}
+
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return errors.Wrap(err, "read record")
+		}
+
+		current.record = record
+		current.line, _ = cr.FieldPos(0)
+
+		err = fn(current)
+		if err != nil {
+			return errors.Wrapf(err, "line %d", current.line)
+		}
+	}
+}
+
+// column returns the first of the named columns present in the header.
+func (r *row) column(names ...string) (string, bool) {
+	for _, name := range names {
+		if _, ok := r.header[name]; ok {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+// has reports whether any of the named columns are present in the header.
+func (r *row) has(names ...string) bool {
+	_, ok := r.column(names...)
+	return ok
+}
+
+// str returns the trimmed value of the first named column present.
+func (r *row) str(names ...string) string {
+	for _, name := range names {
+		idx, ok := r.header[name]
+		if ok && idx < len(r.record) {
+			return strings.TrimSpace(r.record[idx])
+		}
+	}
+
+	return ""
+}
+
+// float returns the numeric value of the first named column present. Empty
+// values are zero and thousands separators and percent signs are ignored.
+func (r *row) float(names ...string) (float64, error) {
+	value := strings.ReplaceAll(r.str(names...), ",", "")
+	if value == "" {
+		return 0, nil
+	}
+
+	percent := strings.HasSuffix(value, "%")
+	value = strings.TrimSuffix(value, "%")
+
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+		return 0, errors.Errorf("invalid number %q", r.str(names...))
+	}
+
+	if percent {
+		n /= 100
+	}
+
+	return n, nil
+}
+
+// int returns the rounded numeric value of the first named column present.
+func (r *row) int(names ...string) (int, error) {
+	n, err := r.float(names...)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(math.Round(max(n, 0))), nil
+}
+
+// seconds returns a duration in seconds of the first named column present.
+// Durations may be a number of seconds or formatted as HH:MM:SS.
+func (r *row) seconds(names ...string) (float64, error) {
+	value := r.str(names...)
+
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return r.float(names...)
+	}
+
+	var total float64
+
+	for _, part := range parts {
+		n, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return 0, errors.Errorf("invalid duration %q", value)
+		}
+
+		total = total*60 + n
+	}
+
+	return total, nil
+}
+
+// date returns the parsed date or timestamp of the first named column present.
+func (r *row) date(names ...string) (time.Time, error) {
+	value := r.str(names...)
+
+	for _, layout := range dateLayouts {
+		date, err := time.Parse(layout, value)
+		if err == nil {
+			return date.UTC(), nil
+		}
+	}
+
+	return time.Time{}, errors.Errorf("invalid date %q", value)
+}
@@ -0,0 +1,306 @@
+package importer
+
+import (
+	"net/url"
+	"strings"
+
+	tz "github.com/medama-io/go-timezone-country"
+	"github.com/medama-io/go-useragent/agents"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+)
+
+// countryAliases maps alternative country names used by other tools to the
+// names produced by go-timezone-country. Keys are normalised with
+// normaliseName.
+var countryAliases = map[string]string{
+	"turkey":                           "Türkiye",
+	"swaziland":                        "Eswatini",
+	"macedonia":                        "North Macedonia",
+	"macau sar china":                  "Macao SAR China",
+	"macau":                            "Macao SAR China",
+	"macao":                            "Macao SAR China",
+	"hong kong":                        "Hong Kong SAR China",
+	"myanmar":                          "Myanmar (Burma)",
+	"czech republic":                   "Czechia",
+	"united states of america":         "United States",
+	"russian federation":               "Russia",
+	"republic of korea":                "South Korea",
+	"cote d'ivoire":                    "Côte d’Ivoire",
+	"ivory coast":                      "Côte d’Ivoire",
+	"republic of the congo":            "Congo - Brazzaville",
+	"democratic republic of the congo": "Congo - Kinshasa",
+	"east timor":                       "Timor-Leste",
+}
+
+// countries resolves ISO 3166-1 country codes and English country names to
+// the country names produced by go-timezone-country, so imported page views
+// are grouped with collected ones.
+type countries struct {
+	// names maps normalised names to their go-timezone-country name.
+	names map[string]string
+}
+
+func newCountries(tzMap tz.TimezoneCountryMap) *countries {
+	names := make(map[string]string, len(tzMap)+len(countryAliases))
+	for _, name := range tzMap {
+		names[normaliseName(name)] = name
+	}
+
+	for alias, name := range countryAliases {
+		// Only add aliases of countries that can be collected.
+		if _, ok := names[normaliseName(name)]; ok {
+			names[alias] = name
+		}
+	}
+
+	return &countries{names: names}
+}
+
+// Resolve returns the country name of the ISO code or name, or Unknown if it
+// is not a known country.
+func (c *countries) Resolve(value string) string {
+	if isNotSet(value) {
+		return Unknown
+	}
+
+	if len(value) == 2 {
+		region, err := language.ParseRegion(value)
+		if err == nil && region.IsCountry() {
+			value = display.English.Regions().Name(region)
+		}
+	}
+
+	name, ok := c.names[normaliseName(value)]
+	if !ok {
+		return Unknown
+	}
+
+	return name
+}
+
+// normaliseName returns a lowercase name with punctuation differences
+// between tools removed.
+func normaliseName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.ReplaceAll(name, "&", "and")
+	name = strings.ReplaceAll(name, "’", "'")
+
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// browsers maps lowercase browser names and identifiers used by other tools
+// to the names produced by go-useragent.
+var browsers = map[string]agents.Browser{
+	"chrome":            agents.BrowserChrome,
+	"chrome mobile":     agents.BrowserChrome,
+	"chrome mobile ios": agents.BrowserChrome,
+	"chromium":          agents.BrowserChrome,
+	"crios":             agents.BrowserChrome,
+	"safari":            agents.BrowserSafari,
+	"mobile safari":     agents.BrowserSafari,
+	"safari (in-app)":   agents.BrowserSafari,
+	"ios":               agents.BrowserSafari,
+	"ios-webview":       agents.BrowserSafari,
+	"firefox":           agents.BrowserFirefox,
+	"firefox mobile":    agents.BrowserFirefox,
+	"fxios":             agents.BrowserFirefox,
+	"edge":              agents.BrowserEdge,
+	"edge-chromium":     agents.BrowserEdge,
+	"edge-ios":          agents.BrowserEdge,
+	"microsoft edge":    agents.BrowserEdge,
+	"ie":                agents.BrowserIE,
+	"internet explorer": agents.BrowserIE,
+	"opera":             agents.BrowserOpera,
+	"opera mini":        agents.BrowserOperaMini,
+	"opera-mini":        agents.BrowserOperaMini,
+	"samsung":           agents.BrowserSamsung,
+	"samsung internet":  agents.BrowserSamsung,
+	"samsung browser":   agents.BrowserSamsung,
+	"vivaldi":           agents.BrowserVivaldi,
+	"silk":              agents.BrowserSilk,
+	"amazon silk":       agents.BrowserSilk,
+	"yandex":            agents.BrowserYandex,
+	"yandexbrowser":     agents.BrowserYandex,
+	"yandex browser":    agents.BrowserYandex,
+	"android":           agents.BrowserAndroid,
+	"android browser":   agents.BrowserAndroid,
+	"android webview":   agents.BrowserAndroid,
+	"falkon":            agents.BrowserFalkon,
+	"nintendo browser":  agents.BrowserNintendo,
+}
+
+// operatingSystems maps lowercase operating system names used by other tools
+// to the names produced by go-useragent. Windows versions are handled
+// separately.
+var operatingSystems = map[string]agents.OS{
+	"mac":         agents.OSMacOS,
+	"mac os":      agents.OSMacOS,
+	"mac os x":    agents.OSMacOS,
+	"macos":       agents.OSMacOS,
+	"macintosh":   agents.OSMacOS,
+	"os x":        agents.OSMacOS,
+	"ios":         agents.OSIOS,
+	"ipados":      agents.OSIOS,
+	"android":     agents.OSAndroid,
+	"android os":  agents.OSAndroid,
+	"linux":       agents.OSLinux,
+	"gnu/linux":   agents.OSLinux,
+	"ubuntu":      agents.OSLinux,
+	"chrome os":   agents.OSChromeOS,
+	"chromeos":    agents.OSChromeOS,
+	"chromium os": agents.OSChromeOS,
+	"freebsd":     agents.OSFreeBSD,
+	"openbsd":     agents.OSOpenBSD,
+}
+
+// devices maps lowercase device categories used by other tools to the
+// device types produced by go-useragent.
+var devices = map[string]agents.Device{
+	"desktop":    agents.DeviceDesktop,
+	"laptop":     agents.DeviceDesktop,
+	"mobile":     agents.DeviceMobile,
+	"smartphone": agents.DeviceMobile,
+	"tablet":     agents.DeviceTablet,
+	"tv":         agents.DeviceTV,
+	"smart tv":   agents.DeviceTV,
+}
+
+// browserName returns the browser name matching collected page views.
+func browserName(value string) string {
+	browser, ok := browsers[strings.ToLower(strings.TrimSpace(value))]
+	if !ok {
+		return Unknown
+	}
+
+	return string(browser)
+}
+
+// osName returns the operating system name matching collected page views.
+func osName(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if strings.HasPrefix(value, "windows") {
+		return string(agents.OSWindows)
+	}
+
+	os, ok := operatingSystems[value]
+	if !ok {
+		return Unknown
+	}
+
+	return string(os)
+}
+
+// deviceType returns the device type matching collected page views.
+func deviceType(value string) string {
+	device, ok := devices[strings.ToLower(strings.TrimSpace(value))]
+	if !ok {
+		return Unknown
+	}
+
+	return string(device)
+}
+
+// languageNames returns the base language and dialect names of a language
+// tag in the same format as the Accept-Language header during ingestion.
+// Exports that already contain language names use the name for both.
+func languageNames(value string) (string, string) {
+	if isNotSet(value) {
+		return Unknown, Unknown
+	}
+
+	tag, err := language.Parse(value)
+	if err != nil {
+		return value, value
+	}
+
+	base, _ := tag.Base()
+
+	return display.English.Tags().Name(language.Make(base.String())), display.English.Tags().Name(tag)
+}
+
+// referrerOf returns the referrer host and group of a referrer URL, domain or
+// source name. Referrers from the website itself, IP addresses and spam are
+// treated as direct traffic.
+func (i *Importer) referrerOf(value string) (string, string) {
+	if isNotSet(value) || isDirect(value) {
+		return "", ""
+	}
+
+	rawURL := value
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+
+	ref, err := i.referrer.Parse(rawURL, i.hostname)
+	if err != nil {
+		// Source names that are not URLs are kept as the referrer host.
+		return value, ""
+	}
+
+	if ref.IsSpam {
+		return "", ""
+	}
+
+	return ref.Host, ref.Group
+}
+
+// pathname returns the pathname of a URL or path in the same format as
+// collected page views, without the query string or a trailing slash.
+func pathname(value string) string {
+	u, err := url.Parse(value)
+	if err == nil {
+		value = u.Path
+	}
+
+	if !strings.HasPrefix(value, "/") {
+		value = "/" + value
+	}
+
+	if value != "/" {
+		value = strings.TrimSuffix(value, "/")
+	}
+
+	return value
+}
+
+// utmValue returns the UTM parameter value or an empty string if it is not
+// set. Placeholders such as (organic) used by Google Analytics are not set.
+func utmValue(value string) string {
+	if isNotSet(value) || isDirect(value) ||
+		(strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")")) {
+		return ""
+	}
+
+	return value
+}
+
+// utmFromQuery returns the UTM parameters of a URL query string.
+func utmFromQuery(query string) (string, string, string) {
+	values, err := url.ParseQuery(strings.TrimPrefix(query, "?"))
+	if err != nil {
+		return "", "", ""
+	}
+
+	return values.Get("utm_source"), values.Get("utm_medium"), values.Get("utm_campaign")
+}
+
+// isNotSet reports whether the value is a placeholder for a missing value.
+func isNotSet(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "(not set)", "(other)", "unknown", "null":
+		return true
+	default:
+		return false
+	}
+}
+
+// isDirect reports whether the value is a placeholder for direct traffic.
+func isDirect(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "direct / none", "(direct)", "direct", "(none)", "none":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,325 @@
+package importer
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/model"
+)
+
+// gaReport describes a Google Analytics report dimension that maps onto a
+// page view dimension.
+type gaReport struct {
+	dimension string
+	columns   []string
+	value     func(i *Importer, value string) func(*model.ImportedPageView)
+}
+
+var (
+	// gaPageColumns are the column names of the page report dimension.
+	gaPageColumns = []string{"page path and screen class", "page path", "page", "page path + query string"}
+	// gaEventColumns are the column names of the events report dimension.
+	gaEventColumns = []string{"event name"}
+
+	// gaReports are the supported breakdown reports.
+	gaReports = []gaReport{
+		{
+			dimension: "referrer",
+			columns: []string{
+				"session source / medium", "source / medium", "session source",
+				"first user source", "source",
+			},
+			value: func(i *Importer, value string) func(*model.ImportedPageView) {
+				// Only the source of source / medium columns is a referrer.
+				source, _, _ := strings.Cut(value, " / ")
+				host, group := i.referrerOf(source)
+
+				return func(view *model.ImportedPageView) {
+					view.ReferrerHost = host
+					view.ReferrerGroup = group
+				}
+			},
+		},
+		{
+			dimension: "utm_medium",
+			columns:   []string{"session medium", "first user medium", "medium"},
+			value: func(_ *Importer, value string) func(*model.ImportedPageView) {
+				medium := utmValue(value)
+
+				return func(view *model.ImportedPageView) {
+					view.UTMMedium = medium
+				}
+			},
+		},
+		{
+			dimension: "utm_campaign",
+			columns:   []string{"session campaign", "first user campaign", "campaign"},
+			value: func(_ *Importer, value string) func(*model.ImportedPageView) {
+				campaign := utmValue(value)
+
+				return func(view *model.ImportedPageView) {
+					view.UTMCampaign = campaign
+				}
+			},
+		},
+		{
+			dimension: "country",
+			columns:   []string{"country", "country id"},
+			value: func(i *Importer, value string) func(*model.ImportedPageView) {
+				country := i.countries.Resolve(value)
+
+				return func(view *model.ImportedPageView) {
+					view.Country = country
+				}
+			},
+		},
+		{
+			dimension: "language",
+			columns:   []string{"language", "language code"},
+			value: func(_ *Importer, value string) func(*model.ImportedPageView) {
+				base, dialect := languageNames(value)
+
+				return func(view *model.ImportedPageView) {
+					view.LanguageBase = base
+					view.LanguageDialect = dialect
+				}
+			},
+		},
+		{
+			dimension: "browser",
+			columns:   []string{"browser"},
+			value: func(_ *Importer, value string) func(*model.ImportedPageView) {
+				browser := browserName(value)
+
+				return func(view *model.ImportedPageView) {
+					view.BrowserName = browser
+				}
+			},
+		},
+		{
+			dimension: "os",
+			columns:   []string{"operating system"},
+			value: func(_ *Importer, value string) func(*model.ImportedPageView) {
+				os := osName(value)
+
+				return func(view *model.ImportedPageView) {
+					view.OS = os
+				}
+			},
+		},
+		{
+			dimension: "device",
+			columns:   []string{"device category"},
+			value: func(_ *Importer, value string) func(*model.ImportedPageView) {
+				device := deviceType(value)
+
+				return func(view *model.ImportedPageView) {
+					view.DeviceType = device
+				}
+			},
+		},
+	}
+
+	// gaMetricColumns are the metric column names of a totals report. Other
+	// metrics are ignored.
+	gaMetricColumns = map[string]struct{}{
+		"total users":                         {},
+		"users":                               {},
+		"active users":                        {},
+		"new users":                           {},
+		"returning users":                     {},
+		"views":                               {},
+		"screen page views":                   {},
+		"pageviews":                           {},
+		"page views":                          {},
+		"sessions":                            {},
+		"engaged sessions":                    {},
+		"engagement rate":                     {},
+		"bounces":                             {},
+		"bounce rate":                         {},
+		"user engagement":                     {},
+		"user engagement duration":            {},
+		"average session duration":            {},
+		"average engagement time":             {},
+		"average engagement time per session": {},
+		"views per session":                   {},
+		"views per user":                      {},
+		"sessions per user":                   {},
+		"event count":                         {},
+		"event count per user":                {},
+		"key events":                          {},
+		"conversions":                         {},
+	}
+
+	// gaAutomaticEvents are collected by Google Analytics for every page view
+	// or session and are not imported as custom events.
+	gaAutomaticEvents = map[string]struct{}{
+		"page_view":       {},
+		"session_start":   {},
+		"first_visit":     {},
+		"user_engagement": {},
+	}
+)
+
+// importGoogleAnalytics imports reports downloaded as CSV from Google
+// Analytics. Each file must be a report with the Date dimension and at most
+// one other dimension. A report without another dimension is used for the
+// daily totals, and a pages report is required to synthesise page views.
+func (i *Importer) importGoogleAnalytics(ctx context.Context, paths []string) error {
+	agg := newAggregate()
+
+	err := eachFile(paths, func(name string, r io.Reader) error {
+		var add func(day time.Time, m metrics, row *row) error
+
+		err := readCSV(r, func(row *row) error {
+			if add == nil {
+				if !row.has("date") {
+					return errors.New("report is missing the Date dimension")
+				}
+
+				add = i.gaReportRow(agg, row)
+				if add == nil {
+					return errSkipFile
+				}
+			}
+
+			// Skip total rows.
+			if row.str("date") == "" {
+				return nil
+			}
+
+			day, err := row.date("date")
+			if err != nil {
+				return err
+			}
+
+			m, err := gaMetrics(row)
+			if err != nil {
+				return err
+			}
+
+			return add(day, m, row)
+		})
+		if errors.Is(err, errSkipFile) {
+			i.skip(name)
+			return nil
+		}
+
+		return err
+	})
+	if err != nil {
+		return errors.Wrap(err, "google analytics")
+	}
+
+	return i.writeAggregate(ctx, agg)
+}
+
+// gaReportRow returns the function adding a row of the report to the
+// aggregate based on the columns of the report, or nil if the report has a
+// dimension that is not supported.
+func (i *Importer) gaReportRow(agg *aggregate, header *row) func(time.Time, metrics, *row) error {
+	if column, ok := header.column(gaPageColumns...); ok {
+		return func(day time.Time, m metrics, row *row) error {
+			agg.addPage(day, pathname(row.str(column)), m)
+			return nil
+		}
+	}
+
+	if column, ok := header.column(gaEventColumns...); ok {
+		return func(day time.Time, m metrics, row *row) error {
+			event := row.str(column)
+			if _, automatic := gaAutomaticEvents[event]; automatic || event == "" {
+				return nil
+			}
+
+			count, err := row.int("event count")
+			if err != nil {
+				return err
+			}
+
+			agg.addEvent(day, eventCount{name: event, visitors: m.visitors, count: count})
+
+			return nil
+		}
+	}
+
+	for _, report := range gaReports {
+		if column, ok := header.column(report.columns...); ok {
+			return func(day time.Time, m metrics, row *row) error {
+				agg.addBreakdown(report.dimension, day, breakdown{
+					apply:   report.value(i, row.str(column)),
+					metrics: m,
+				})
+
+				return nil
+			}
+		}
+	}
+
+	// Reports with an unsupported dimension would otherwise be counted as
+	// totals, so only reports consisting of metrics are used for the totals.
+	for column := range header.header {
+		if _, ok := gaMetricColumns[column]; !ok && column != "date" {
+			return nil
+		}
+	}
+
+	return func(day time.Time, m metrics, _ *row) error {
+		agg.addTotals(day, m)
+		return nil
+	}
+}
+
+// gaMetrics returns the metrics of a report row. Averages are converted to
+// totals using the number of sessions.
+func gaMetrics(row *row) (metrics, error) {
+	var (
+		m   metrics
+		err error
+	)
+
+	if m.visitors, err = row.int("total users", "users", "active users"); err != nil {
+		return m, err
+	}
+
+	if m.pageviews, err = row.int("views", "screen page views", "pageviews", "page views"); err != nil {
+		return m, err
+	}
+
+	if m.visits, err = row.int("sessions"); err != nil {
+		return m, err
+	}
+
+	switch {
+	case row.has("bounces"):
+		if m.bounces, err = row.int("bounces"); err != nil {
+			return m, err
+		}
+	case row.has("bounce rate"):
+		rate, err := row.float("bounce rate")
+		if err != nil {
+			return m, err
+		}
+
+		m.bounces = int(rate*float64(m.visits) + 0.5)
+	}
+
+	switch {
+	case row.has("user engagement", "user engagement duration"):
+		if m.duration, err = row.seconds("user engagement", "user engagement duration"); err != nil {
+			return m, err
+		}
+	case row.has("average session duration", "average engagement time per session"):
+		average, err := row.seconds("average session duration", "average engagement time per session")
+		if err != nil {
+			return m, err
+		}
+
+		m.duration = average * float64(m.visits)
+	}
+
+	return m, nil
+}
@@ -0,0 +1,209 @@
+// Package importer maps the CSV exports of other analytics tools onto the
+// views and events tables.
+//
+// Umami exports raw events, so each page view is imported as-is. Plausible and
+// Google Analytics only export daily aggregates, so page views are synthesised
+// from the aggregates such that the visitor and page view counts of each
+// dimension match the original reports.
+package importer
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-faster/errors"
+	tz "github.com/medama-io/go-timezone-country"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/referrer"
+)
+
+// errSkipFile is returned while reading a file that can not be imported.
+var errSkipFile = errors.New("skip file")
+
+// Source is an analytics tool that data can be imported from.
+type Source string
+
+const (
+	SourcePlausible       Source = "plausible"
+	SourceUmami           Source = "umami"
+	SourceGoogleAnalytics Source = "ga"
+)
+
+const (
+	// Unknown is used for dimensions that are not available in the export,
+	// matching the value used during ingestion.
+	Unknown = "Unknown"
+	// EventPropertyName is the custom event property name imported event
+	// names are stored under, so they can be used in event goals.
+	EventPropertyName = "event"
+
+	// batchSize is the number of rows buffered before they are written.
+	batchSize = 10000
+)
+
+// IsValid reports whether the source is supported.
+func (s Source) IsValid() bool {
+	switch s {
+	case SourcePlausible, SourceUmami, SourceGoogleAnalytics:
+		return true
+	default:
+		return false
+	}
+}
+
+// Writer stores imported rows tagged with the import ID.
+type Writer interface {
+	InsertImportedViews(ctx context.Context, importID string, views []model.ImportedPageView) error
+	InsertImportedEvents(ctx context.Context, importID string, events []model.ImportedEvent) error
+}
+
+// Result summarises a completed import.
+type Result struct {
+	// Views is the number of imported page views.
+	Views int
+	// Events is the number of imported custom event properties.
+	Events int
+	// Skipped lists the files or reports that were not imported as they do
+	// not map onto any dimension.
+	Skipped []string
+}
+
+// Importer imports the exports of a single website.
+type Importer struct {
+	hostname string
+	importID string
+	writer   Writer
+
+	referrer  *referrer.Parser
+	countries *countries
+
+	views  []model.ImportedPageView
+	events []model.ImportedEvent
+	result Result
+	// seq is used to generate unique IDs for synthesised rows.
+	seq int
+}
+
+// New creates an importer writing rows for the website to the writer. All
+// rows are tagged with the import ID.
+func New(hostname string, importID string, writer Writer) (*Importer, error) {
+	referrerParser, err := referrer.NewParser()
+	if err != nil {
+		return nil, errors.Wrap(err, "importer")
+	}
+
+	tzMap, err := tz.NewTimezoneCountryMap()
+	if err != nil {
+		return nil, errors.Wrap(err, "importer")
+	}
+
+	return &Importer{
+		hostname:  hostname,
+		importID:  importID,
+		writer:    writer,
+		referrer:  referrerParser,
+		countries: newCountries(tzMap),
+	}, nil
+}
+
+// Import reads the export files of the source and writes the mapped rows.
+// Zip archives are read as if each CSV file inside them was passed.
+//
+// Rows are written in batches, so the caller should roll back the import ID
+// if an error is returned.
+func (i *Importer) Import(ctx context.Context, source Source, paths []string) (Result, error) {
+	var err error
+
+	switch source {
+	case SourcePlausible:
+		err = i.importPlausible(ctx, paths)
+	case SourceUmami:
+		err = i.importUmami(ctx, paths)
+	case SourceGoogleAnalytics:
+		err = i.importGoogleAnalytics(ctx, paths)
+	default:
+		return Result{}, model.ErrInvalidImportSource
+	}
+
+	if err != nil {
+		return i.result, err
+	}
+
+	err = i.flush(ctx)
+	if err != nil {
+		return i.result, err
+	}
+
+	return i.result, nil
+}
+
+// newView returns a page view of the website with all dimensions unknown.
+func (i *Importer) newView(date time.Time) model.ImportedPageView {
+	return model.ImportedPageView{
+		PageViewHit: model.PageViewHit{
+			Hostname:        i.hostname,
+			Country:         Unknown,
+			LanguageBase:    Unknown,
+			LanguageDialect: Unknown,
+			BrowserName:     Unknown,
+			OS:              Unknown,
+			DeviceType:      Unknown,
+		},
+		DateCreated: date,
+	}
+}
+
+// nextID returns a unique ID within the import for synthesised rows.
+func (i *Importer) nextID() string {
+	i.seq++
+	return i.importID + "_" + strconv.Itoa(i.seq)
+}
+
+func (i *Importer) addView(ctx context.Context, view model.ImportedPageView) error {
+	i.views = append(i.views, view)
+	if len(i.views) >= batchSize {
+		return i.flush(ctx)
+	}
+
+	return nil
+}
+
+func (i *Importer) addEvent(ctx context.Context, event model.ImportedEvent) error {
+	i.events = append(i.events, event)
+	if len(i.events) >= batchSize {
+		return i.flush(ctx)
+	}
+
+	return nil
+}
+
+// flush writes all buffered rows.
+func (i *Importer) flush(ctx context.Context) error {
+	if len(i.views) > 0 {
+		err := i.writer.InsertImportedViews(ctx, i.importID, i.views)
+		if err != nil {
+			return errors.Wrap(err, "importer: write page views")
+		}
+
+		i.result.Views += len(i.views)
+		i.views = i.views[:0]
+	}
+
+	if len(i.events) > 0 {
+		err := i.writer.InsertImportedEvents(ctx, i.importID, i.events)
+		if err != nil {
+			return errors.Wrap(err, "importer: write events")
+		}
+
+		i.result.Events += len(i.events)
+		i.events = i.events[:0]
+	}
+
+	return nil
+}
+
+// skip records a file or report that could not be imported.
+func (i *Importer) skip(name string) {
+	i.result.Skipped = append(i.result.Skipped, name)
+}
@@ -0,0 +1,331 @@
+package importer_test
+
+import (
+	"archive/zip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/importer"
+	"github.com/medama-io/medama/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testHostname = "example.com"
+	testImportID = "import_test"
+)
+
+// memoryWriter stores imported rows in memory.
+type memoryWriter struct {
+	views  []model.ImportedPageView
+	events []model.ImportedEvent
+}
+
+func (w *memoryWriter) InsertImportedViews(_ context.Context, importID string, views []model.ImportedPageView) error {
+	if importID != testImportID {
+		return io.ErrUnexpectedEOF
+	}
+
+	w.views = append(w.views, views...)
+
+	return nil
+}
+
+func (w *memoryWriter) InsertImportedEvents(_ context.Context, importID string, events []model.ImportedEvent) error {
+	if importID != testImportID {
+		return io.ErrUnexpectedEOF
+	}
+
+	w.events = append(w.events, events...)
+
+	return nil
+}
+
+// count returns the number of page views matching the predicate.
+func (w *memoryWriter) count(fn func(view model.ImportedPageView) bool) int {
+	n := 0
+
+	for _, view := range w.views {
+		if fn(view) {
+			n++
+		}
+	}
+
+	return n
+}
+
+func runImport(t *testing.T, source importer.Source, paths []string) (*memoryWriter, importer.Result) {
+	t.Helper()
+
+	writer := &memoryWriter{}
+
+	imp, err := importer.New(testHostname, testImportID, writer)
+	require.NoError(t, err)
+
+	result, err := imp.Import(t.Context(), source, paths)
+	require.NoError(t, err)
+
+	return writer, result
+}
+
+func testdataFiles(t *testing.T, dir string) []string {
+	t.Helper()
+
+	paths, err := filepath.Glob(filepath.Join("testdata", dir, "*.csv"))
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	return paths
+}
+
+func assertPlausible(t *testing.T, writer *memoryWriter, result importer.Result) {
+	t.Helper()
+	assert := assert.New(t)
+
+	day1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	onDay1 := func(view model.ImportedPageView) bool {
+		return view.DateCreated.Before(day1.AddDate(0, 0, 1))
+	}
+
+	assert.Equal(7, result.Views)
+	assert.Len(writer.views, 7)
+	assert.Equal([]string{"imported_entry_pages_20240101_20240102.csv"}, result.Skipped)
+
+	for _, view := range writer.views {
+		assert.Equal(testHostname, view.Hostname)
+		assert.NotEmpty(view.BID)
+	}
+
+	// Totals.
+	assert.Equal(5, writer.count(onDay1))
+	assert.Equal(3, writer.count(func(v model.ImportedPageView) bool { return onDay1(v) && v.IsUniqueUser }))
+	assert.Equal(2, writer.count(func(v model.ImportedPageView) bool { return !onDay1(v) && v.IsUniqueUser }))
+
+	// Pages, where the trailing slash is removed.
+	assert.Equal(2, writer.count(func(v model.ImportedPageView) bool { return v.Pathname == "/blog" }))
+	assert.Equal(1, writer.count(func(v model.ImportedPageView) bool {
+		return v.Pathname == "/blog" && v.IsUniquePage
+	}))
+
+	// Referrers are grouped and direct traffic has no referrer.
+	assert.Equal(2, writer.count(func(v model.ImportedPageView) bool {
+		return v.ReferrerHost == "google.com" && v.ReferrerGroup == "Google" && v.IsUniqueUser
+	}))
+	assert.Equal(3, writer.count(func(v model.ImportedPageView) bool { return v.ReferrerGroup == "Google" }))
+	assert.Equal(2, writer.count(func(v model.ImportedPageView) bool {
+		return v.UTMSource == "newsletter" && v.UTMMedium == "email" && v.UTMCampaign == "launch"
+	}))
+
+	// Countries use the go-timezone-country names.
+	assert.Equal(2, writer.count(func(v model.ImportedPageView) bool {
+		return v.Country == "United States" && v.IsUniqueUser
+	}))
+	assert.Equal(3, writer.count(func(v model.ImportedPageView) bool { return v.Country == "United States" }))
+	assert.Equal(2, writer.count(func(v model.ImportedPageView) bool { return v.Country == "Türkiye" }))
+	assert.Equal(2, writer.count(func(v model.ImportedPageView) bool { return v.Country == "United Kingdom" }))
+
+	// User agent dimensions use the go-useragent names.
+	assert.Equal(4, writer.count(func(v model.ImportedPageView) bool { return v.BrowserName == "Chrome" }))
+	assert.Equal(1, writer.count(func(v model.ImportedPageView) bool { return v.BrowserName == "Edge" }))
+	assert.Equal(5, writer.count(func(v model.ImportedPageView) bool { return v.OS == "MacOS" }))
+	assert.Equal(5, writer.count(func(v model.ImportedPageView) bool { return v.DeviceType == "Desktop" }))
+	assert.Equal(2, writer.count(func(v model.ImportedPageView) bool { return v.DeviceType == "Mobile" }))
+	assert.Equal(7, writer.count(func(v model.ImportedPageView) bool { return v.LanguageBase == importer.Unknown }))
+
+	// One of three visitors bounced on the first day and all on the second.
+	assert.Equal(1, writer.count(func(v model.ImportedPageView) bool {
+		return onDay1(v) && v.IsUniqueUser && v.DurationMs > 0 && v.DurationMs <= 5000
+	}))
+	assert.Equal(2, writer.count(func(v model.ImportedPageView) bool {
+		return !onDay1(v) && v.IsUniqueUser && v.DurationMs > 0 && v.DurationMs <= 5000
+	}))
+	assert.Equal(2, writer.count(func(v model.ImportedPageView) bool {
+		return v.IsUniqueUser && v.DurationMs > 5000
+	}))
+
+	// Page views are spread over the day.
+	dates := make(map[time.Time]struct{})
+	for _, view := range writer.views {
+		dates[view.DateCreated] = struct{}{}
+	}
+
+	assert.Len(dates, 7)
+
+	// Custom events are attached to visitors of the day.
+	assert.Equal(2, result.Events)
+
+	bids := make(map[string]model.ImportedPageView)
+	for _, view := range writer.views {
+		bids[view.BID] = view
+	}
+
+	for _, event := range writer.events {
+		assert.Equal(importer.EventPropertyName, event.Name)
+		assert.Equal("Signup", event.Value)
+		assert.Equal(testHostname, event.Group)
+
+		view, ok := bids[event.BID]
+		assert.True(ok)
+		assert.True(view.IsUniqueUser)
+		assert.Equal(view.DateCreated, event.DateCreated)
+	}
+
+	assert.NotEqual(writer.events[0].BatchID, writer.events[1].BatchID)
+}
+
+func TestImportPlausible(t *testing.T) {
+	writer, result := runImport(t, importer.SourcePlausible, testdataFiles(t, "plausible"))
+	assertPlausible(t, writer, result)
+}
+
+func TestImportPlausibleZip(t *testing.T) {
+	require := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "example.com_20240101_20240102.zip")
+
+	f, err := os.Create(path)
+	require.NoError(err)
+
+	archive := zip.NewWriter(f)
+
+	for _, file := range testdataFiles(t, "plausible") {
+		w, err := archive.Create(filepath.Base(file))
+		require.NoError(err)
+
+		content, err := os.ReadFile(file)
+		require.NoError(err)
+
+		_, err = w.Write(content)
+		require.NoError(err)
+	}
+
+	require.NoError(archive.Close())
+	require.NoError(f.Close())
+
+	writer, result := runImport(t, importer.SourcePlausible, []string{path})
+	assertPlausible(t, writer, result)
+}
+
+func TestImportUmami(t *testing.T) {
+	assert := assert.New(t)
+
+	writer, result := runImport(t, importer.SourceUmami, testdataFiles(t, "umami"))
+
+	assert.Equal(4, result.Views)
+	assert.Equal(1, result.Events)
+	assert.Empty(result.Skipped)
+
+	views := make(map[string]model.ImportedPageView)
+	for _, view := range writer.views {
+		views[view.BID] = view
+	}
+
+	require.Len(t, views, 4)
+
+	// Rows are imported in chronological order.
+	assert.Equal("e1", writer.views[0].BID)
+
+	first := views["e1"]
+	assert.Equal("v1", first.VisitID)
+	assert.Equal("/", first.Pathname)
+	assert.True(first.IsUniqueUser)
+	assert.True(first.IsUniquePage)
+	assert.Equal("www.google.com", first.ReferrerHost)
+	assert.Equal("Google", first.ReferrerGroup)
+	assert.Equal("newsletter", first.UTMSource)
+	assert.Equal("email", first.UTMMedium)
+	assert.Equal("Germany", first.Country)
+	assert.Equal("English", first.LanguageBase)
+	assert.Equal("American English", first.LanguageDialect)
+	assert.Equal("Chrome", first.BrowserName)
+	assert.Equal("MacOS", first.OS)
+	assert.Equal("Desktop", first.DeviceType)
+	assert.Equal(30000, first.DurationMs)
+	assert.Equal(time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), first.DateCreated)
+
+	// Self referrals are removed and the last page view of a visit has no
+	// known duration.
+	second := views["e2"]
+	assert.Equal("/pricing", second.Pathname)
+	assert.False(second.IsUniqueUser)
+	assert.True(second.IsUniquePage)
+	assert.Empty(second.ReferrerHost)
+	assert.Zero(second.DurationMs)
+
+	// A later visit of the same session on the same day is not unique.
+	third := views["e4"]
+	assert.Equal("v2", third.VisitID)
+	assert.False(third.IsUniqueUser)
+	assert.False(third.IsUniquePage)
+
+	other := views["e5"]
+	assert.True(other.IsUniqueUser)
+	assert.Equal("Hacker News", other.ReferrerGroup)
+	assert.Equal("Japan", other.Country)
+	assert.Equal("Japanese", other.LanguageBase)
+	assert.Equal("Safari", other.BrowserName)
+	assert.Equal("iOS", other.OS)
+	assert.Equal("Mobile", other.DeviceType)
+
+	// Custom events are linked to the latest page view of the visit.
+	require.Len(t, writer.events, 1)
+	assert.Equal("e2", writer.events[0].BID)
+	assert.Equal("e3", writer.events[0].BatchID)
+	assert.Equal(importer.EventPropertyName, writer.events[0].Name)
+	assert.Equal("signup", writer.events[0].Value)
+}
+
+func TestImportGoogleAnalytics(t *testing.T) {
+	assert := assert.New(t)
+
+	writer, result := runImport(t, importer.SourceGoogleAnalytics, testdataFiles(t, "ga"))
+
+	assert.Equal(5, result.Views)
+	assert.Equal([]string{"titles.csv"}, result.Skipped)
+
+	assert.Equal(3, writer.count(func(v model.ImportedPageView) bool { return v.IsUniqueUser }))
+	assert.Equal(1, writer.count(func(v model.ImportedPageView) bool { return v.Pathname == "/docs" }))
+	assert.Equal(2, writer.count(func(v model.ImportedPageView) bool {
+		return v.Country == "United States" && v.IsUniqueUser
+	}))
+	assert.Equal(2, writer.count(func(v model.ImportedPageView) bool { return v.Country == "Türkiye" }))
+
+	// One of three visitors bounced and the average visit lasted a minute.
+	assert.Equal(1, writer.count(func(v model.ImportedPageView) bool {
+		return v.IsUniqueUser && v.DurationMs > 0 && v.DurationMs <= 5000
+	}))
+	assert.Equal(2, writer.count(func(v model.ImportedPageView) bool {
+		return !v.IsUniqueUser && v.DurationMs == 36000
+	}))
+
+	// Automatically collected events are not imported.
+	require.Len(t, writer.events, 2)
+
+	for _, event := range writer.events {
+		assert.Equal("sign_up", event.Value)
+	}
+}
+
+func TestImportInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	imp, err := importer.New(testHostname, testImportID, &memoryWriter{})
+	require.NoError(t, err)
+
+	_, err = imp.Import(t.Context(), importer.Source("matomo"), nil)
+	assert.ErrorIs(err, model.ErrInvalidImportSource)
+
+	_, err = imp.Import(t.Context(), importer.SourceUmami, []string{"testdata/missing.csv"})
+	assert.Error(err)
+
+	path := filepath.Join(t.TempDir(), "report.csv")
+	require.NoError(t, os.WriteFile(path, []byte("Page path,Views\n/,1\n"), 0o600))
+
+	_, err = imp.Import(t.Context(), importer.SourceGoogleAnalytics, []string{path})
+	assert.ErrorContains(err, "Date dimension")
+}
@@ -0,0 +1,201 @@
+package importer
+
+import (
+	"context"
+	"io"
+	"regexp"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/model"
+)
+
+// plausibleFilename matches the files of a Plausible CSV export, e.g.
+// imported_visitors_20240101_20241231.csv.
+var plausibleFilename = regexp.MustCompile(`^imported_([a-z_]+?)(?:_\d{8}_\d{8})?\.csv$`)
+
+// importPlausible imports a Plausible CSV export, either as the zip archive or
+// the extracted files. Entry and exit pages are derived from page views, so
+// those reports are not needed.
+func (i *Importer) importPlausible(ctx context.Context, paths []string) error {
+	agg := newAggregate()
+
+	err := eachFile(paths, func(name string, r io.Reader) error {
+		match := plausibleFilename.FindStringSubmatch(name)
+		if match == nil {
+			i.skip(name)
+			return nil
+		}
+
+		switch table := match[1]; table {
+		case "visitors":
+			return readCSV(r, func(row *row) error {
+				day, m, err := plausibleRow(row)
+				if err != nil {
+					return err
+				}
+
+				agg.addTotals(day, m)
+
+				return nil
+			})
+
+		case "pages":
+			return readCSV(r, func(row *row) error {
+				day, m, err := plausibleRow(row)
+				if err != nil {
+					return err
+				}
+
+				agg.addPage(day, pathname(row.str("page")), m)
+
+				return nil
+			})
+
+		case "sources":
+			return i.readPlausibleBreakdown(r, agg, "referrer", func(row *row) func(*model.ImportedPageView) {
+				referrer := row.str("referrer")
+				if isNotSet(referrer) {
+					referrer = row.str("source")
+				}
+
+				host, group := i.referrerOf(referrer)
+				source := utmValue(row.str("utm_source"))
+				medium := utmValue(row.str("utm_medium"))
+				campaign := utmValue(row.str("utm_campaign"))
+
+				return func(view *model.ImportedPageView) {
+					view.ReferrerHost = host
+					view.ReferrerGroup = group
+					view.UTMSource = source
+					view.UTMMedium = medium
+					view.UTMCampaign = campaign
+				}
+			})
+
+		case "locations":
+			return i.readPlausibleBreakdown(r, agg, "country", func(row *row) func(*model.ImportedPageView) {
+				country := i.countries.Resolve(row.str("country"))
+
+				return func(view *model.ImportedPageView) {
+					view.Country = country
+				}
+			})
+
+		case "browsers":
+			return i.readPlausibleBreakdown(r, agg, "browser", func(row *row) func(*model.ImportedPageView) {
+				browser := browserName(row.str("browser"))
+
+				return func(view *model.ImportedPageView) {
+					view.BrowserName = browser
+				}
+			})
+
+		case "operating_systems":
+			return i.readPlausibleBreakdown(r, agg, "os", func(row *row) func(*model.ImportedPageView) {
+				os := osName(row.str("operating_system"))
+
+				return func(view *model.ImportedPageView) {
+					view.OS = os
+				}
+			})
+
+		case "devices":
+			return i.readPlausibleBreakdown(r, agg, "device", func(row *row) func(*model.ImportedPageView) {
+				device := deviceType(row.str("device"))
+
+				return func(view *model.ImportedPageView) {
+					view.DeviceType = device
+				}
+			})
+
+		case "custom_events":
+			return readCSV(r, func(row *row) error {
+				day, err := row.date("date")
+				if err != nil {
+					return err
+				}
+
+				visitors, err := row.int("visitors")
+				if err != nil {
+					return err
+				}
+
+				count, err := row.int("events")
+				if err != nil {
+					return err
+				}
+
+				name := row.str("name")
+				if name == "" {
+					return nil
+				}
+
+				agg.addEvent(day, eventCount{name: name, visitors: visitors, count: count})
+
+				return nil
+			})
+
+		default:
+			i.skip(name)
+			return nil
+		}
+	})
+	if err != nil {
+		return errors.Wrap(err, "plausible")
+	}
+
+	return i.writeAggregate(ctx, agg)
+}
+
+// readPlausibleBreakdown reads a report of a single dimension, where value
+// returns the function setting the dimension value of the row.
+func (i *Importer) readPlausibleBreakdown(
+	r io.Reader,
+	agg *aggregate,
+	dimension string,
+	value func(row *row) func(*model.ImportedPageView),
+) error {
+	return readCSV(r, func(row *row) error {
+		day, m, err := plausibleRow(row)
+		if err != nil {
+			return err
+		}
+
+		agg.addBreakdown(dimension, day, breakdown{apply: value(row), metrics: m})
+
+		return nil
+	})
+}
+
+// plausibleRow returns the date and metrics of a report row.
+func plausibleRow(row *row) (time.Time, metrics, error) {
+	day, err := row.date("date")
+	if err != nil {
+		return time.Time{}, metrics{}, err
+	}
+
+	var m metrics
+
+	for _, field := range []struct {
+		column string
+		value  *int
+	}{
+		{"visitors", &m.visitors},
+		{"pageviews", &m.pageviews},
+		{"visits", &m.visits},
+		{"bounces", &m.bounces},
+	} {
+		*field.value, err = row.int(field.column)
+		if err != nil {
+			return time.Time{}, metrics{}, err
+		}
+	}
+
+	m.duration, err = row.seconds("visit_duration")
+	if err != nil {
+		return time.Time{}, metrics{}, err
+	}
+
+	return day, m, nil
+}
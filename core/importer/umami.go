@@ -0,0 +1,229 @@
+package importer
+
+import (
+	"context"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/model"
+)
+
+const (
+	// umamiPageView and umamiCustomEvent are the Umami event types.
+	umamiPageView    = "1"
+	umamiCustomEvent = "2"
+
+	// umamiMaxDuration is the longest gap between two page views of a visit
+	// that is counted as the duration of the first page view.
+	umamiMaxDuration = 30 * time.Minute
+)
+
+// umamiEvent is a single row of an Umami website events export.
+type umamiEvent struct {
+	id        string
+	visit     string
+	session   string
+	eventType string
+	eventName string
+	date      time.Time
+
+	pathname string
+	referrer string
+	country  string
+	language string
+	browser  string
+	os       string
+	device   string
+
+	utmSource   string
+	utmMedium   string
+	utmCampaign string
+}
+
+func (e *umamiEvent) isPageView() bool {
+	return e.eventType == umamiPageView || (e.eventType == "" && e.eventName == "")
+}
+
+// importUmami imports the website events export of Umami, where each row is a
+// page view or custom event joined with its session.
+//
+// Page views are unique users on the first page view of the session each day
+// and unique pages on the first view of each page, the same as collected page
+// views. The duration of a page view is the time until the next page view of
+// the visit.
+func (i *Importer) importUmami(ctx context.Context, paths []string) error {
+	var events []umamiEvent
+
+	err := eachFile(paths, func(_ string, r io.Reader) error {
+		return readCSV(r, func(row *row) error {
+			if !row.has("created_at") || !row.has("url_path") {
+				return errors.New("export is missing the created_at or url_path columns")
+			}
+
+			date, err := row.date("created_at")
+			if err != nil {
+				return err
+			}
+
+			event := umamiEvent{
+				id:          row.str("event_id"),
+				visit:       row.str("visit_id"),
+				session:     row.str("session_id"),
+				eventType:   row.str("event_type"),
+				eventName:   row.str("event_name"),
+				date:        date,
+				pathname:    pathname(row.str("url_path")),
+				referrer:    row.str("referrer_domain") + row.str("referrer_path"),
+				country:     row.str("country"),
+				language:    row.str("language"),
+				browser:     row.str("browser"),
+				os:          row.str("os"),
+				device:      row.str("device"),
+				utmSource:   row.str("utm_source"),
+				utmMedium:   row.str("utm_medium"),
+				utmCampaign: row.str("utm_campaign"),
+			}
+
+			if !row.has("utm_source", "utm_medium", "utm_campaign") {
+				event.utmSource, event.utmMedium, event.utmCampaign = utmFromQuery(row.str("url_query"))
+			}
+
+			events = append(events, event)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return errors.Wrap(err, "umami")
+	}
+
+	slices.SortStableFunc(events, func(a, b umamiEvent) int {
+		return a.date.Compare(b.date)
+	})
+
+	durations := umamiDurations(events)
+
+	var (
+		users = make(map[string]struct{})
+		pages = make(map[string]struct{})
+		// lastView is the beacon ID of the latest page view of each visit, so
+		// custom events can be linked to it.
+		lastView = make(map[string]string)
+	)
+
+	for idx, event := range events {
+		visit := umamiVisit(&event)
+
+		switch {
+		case event.isPageView():
+			view := i.newView(event.date)
+
+			view.BID = event.id
+			if view.BID == "" {
+				view.BID = i.nextID()
+			}
+
+			view.VisitID = visit
+			view.Pathname = event.pathname
+			view.DurationMs = int(durations[idx].Milliseconds())
+
+			day := event.date.Format(time.DateOnly)
+			session := event.session
+			if session == "" {
+				session = visit
+			}
+
+			if _, ok := users[day+session]; !ok {
+				users[day+session] = struct{}{}
+				view.IsUniqueUser = true
+			}
+
+			if _, ok := pages[day+session+event.pathname]; !ok {
+				pages[day+session+event.pathname] = struct{}{}
+				view.IsUniquePage = true
+			}
+
+			view.ReferrerHost, view.ReferrerGroup = i.referrerOf(event.referrer)
+			view.Country = i.countries.Resolve(event.country)
+			view.LanguageBase, view.LanguageDialect = languageNames(event.language)
+			view.BrowserName = browserName(event.browser)
+			view.OS = osName(event.os)
+			view.DeviceType = deviceType(event.device)
+			view.UTMSource = utmValue(event.utmSource)
+			view.UTMMedium = utmValue(event.utmMedium)
+			view.UTMCampaign = utmValue(event.utmCampaign)
+
+			lastView[visit] = view.BID
+
+			err := i.addView(ctx, view)
+			if err != nil {
+				return err
+			}
+
+		case event.eventType == umamiCustomEvent && event.eventName != "":
+			batchID := event.id
+			if batchID == "" {
+				batchID = i.nextID()
+			}
+
+			err := i.addEvent(ctx, model.ImportedEvent{
+				EventHit: model.EventHit{
+					BID:     lastView[visit],
+					BatchID: batchID,
+					Group:   i.hostname,
+					Name:    EventPropertyName,
+					Value:   event.eventName,
+				},
+				DateCreated: event.date,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// umamiDurations returns the duration of each page view, which is the time
+// until the next page view of the same visit. The last page view of a visit
+// has no known duration.
+func umamiDurations(events []umamiEvent) []time.Duration {
+	durations := make([]time.Duration, len(events))
+	last := make(map[string]int)
+
+	for idx := range events {
+		event := &events[idx]
+		if !event.isPageView() {
+			continue
+		}
+
+		visit := umamiVisit(event)
+
+		if prev, ok := last[visit]; ok {
+			duration := event.date.Sub(events[prev].date)
+			if duration <= umamiMaxDuration {
+				durations[prev] = duration
+			}
+		}
+
+		last[visit] = idx
+	}
+
+	return durations
+}
+
+// umamiVisit returns the visit ID of the event, falling back to the session.
+func umamiVisit(event *umamiEvent) string {
+	if event.visit != "" {
+		return event.visit
+	}
+
+	if event.session != "" {
+		return event.session
+	}
+
+	return event.id
+}
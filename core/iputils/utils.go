@@ -7,6 +7,15 @@ import (
 	"strings"
 )
 
+const (
+	// IPv4DefaultPrefix is the default prefix length used to group IPv4
+	// clients, as a single client usually controls a whole /24 network.
+	IPv4DefaultPrefix = 24
+	// IPv6DefaultPrefix is the default prefix length used to group IPv6
+	// clients, as a single site is usually assigned a whole /48 network.
+	IPv6DefaultPrefix = 48
+)
+
 var ErrInvalidIP = errors.New("no valid ip found in request")
 
 // GetIP extracts the client IP address from an HTTP request.
@@ -113,3 +122,22 @@ func GetAddrListString(addrs []netip.Addr) string {
 
 	return sb.String()
 }
+
+// GetPrefix returns the masked network prefix of an IP address, using
+// ipv4Bits or ipv6Bits depending on the address family. IPv4-mapped IPv6
+// addresses are treated as IPv4 addresses.
+func GetPrefix(addr netip.Addr, ipv4Bits int, ipv6Bits int) (netip.Prefix, error) {
+	addr = addr.Unmap()
+
+	bits := ipv6Bits
+	if addr.Is4() {
+		bits = ipv4Bits
+	}
+
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return prefix.Masked(), nil
+}
@@ -2,6 +2,7 @@ package iputils_test
 
 import (
 	"net/http"
+	"net/netip"
 	"testing"
 
 	"github.com/medama-io/medama/iputils"
@@ -124,3 +125,26 @@ func TestGetIP(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPrefix(t *testing.T) {
+	tests := []struct {
+		addr     string
+		expected string
+	}{
+		{addr: "192.168.1.100", expected: "192.168.1.0/24"},
+		{addr: "::ffff:192.168.1.100", expected: "192.168.1.0/24"},
+		{addr: "2001:db8:abcd:12::1", expected: "2001:db8:abcd::/48"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			prefix, err := iputils.GetPrefix(
+				netip.MustParseAddr(tt.addr),
+				iputils.IPv4DefaultPrefix,
+				iputils.IPv6DefaultPrefix,
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, prefix.String())
+		})
+	}
+}
@@ -0,0 +1,230 @@
+// Package mail sends multipart emails with a plain text and HTML body over
+// SMTP.
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+const (
+	// DefaultPort is the SMTP submission port using STARTTLS.
+	DefaultPort = 587
+	// implicitTLSPort is the SMTP submission port using implicit TLS.
+	implicitTLSPort = 465
+
+	// sendTimeout limits how long sending a single email may take.
+	sendTimeout = 30 * time.Second
+)
+
+// ErrInvalidAddress is returned when a sender or recipient is not a valid
+// email address.
+var ErrInvalidAddress = errors.New("mail: invalid email address")
+
+// Config configures the SMTP server emails are sent through.
+type Config struct {
+	Host string
+	Port int
+	// Username and Password authenticate with the server if set.
+	Username string
+	Password string
+	// From is the sender address of all emails.
+	From string
+}
+
+// Message is an email with a plain text and an HTML body.
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Client sends emails through an SMTP server. Port 465 uses implicit TLS,
+// while other ports upgrade the connection with STARTTLS if the server
+// supports it.
+type Client struct {
+	config Config
+}
+
+// NewClient returns a new client sending emails with the given config.
+func NewClient(config Config) *Client {
+	if config.Port == 0 {
+		config.Port = DefaultPort
+	}
+
+	return &Client{config: config}
+}
+
+// Send delivers the message to its recipient.
+func (c *Client) Send(ctx context.Context, msg *Message) error {
+	from, err := mail.ParseAddress(c.config.From)
+	if err != nil {
+		return errors.Wrap(ErrInvalidAddress, "from")
+	}
+
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return errors.Wrap(ErrInvalidAddress, "to")
+	}
+
+	body, err := msg.encode(from, to, time.Now())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return errors.Wrap(err, "mail: dial")
+	}
+
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return errors.Wrap(err, "mail")
+	}
+
+	client, err := smtp.NewClient(conn, c.config.Host)
+	if err != nil {
+		conn.Close()
+		return errors.Wrap(err, "mail")
+	}
+	defer client.Close()
+
+	if c.config.Port != implicitTLSPort {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			err = client.StartTLS(&tls.Config{ServerName: c.config.Host, MinVersion: tls.VersionTLS12})
+			if err != nil {
+				return errors.Wrap(err, "mail: starttls")
+			}
+		}
+	}
+
+	if c.config.Username != "" {
+		err = client.Auth(smtp.PlainAuth("", c.config.Username, c.config.Password, c.config.Host))
+		if err != nil {
+			return errors.Wrap(err, "mail: auth")
+		}
+	}
+
+	if err := client.Mail(from.Address); err != nil {
+		return errors.Wrap(err, "mail: from")
+	}
+
+	if err := client.Rcpt(to.Address); err != nil {
+		return errors.Wrap(err, "mail: to")
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return errors.Wrap(err, "mail: data")
+	}
+
+	if _, err := w.Write(body); err != nil {
+		return errors.Wrap(err, "mail: data")
+	}
+
+	if err := w.Close(); err != nil {
+		return errors.Wrap(err, "mail: data")
+	}
+
+	return client.Quit()
+}
+
+func (c *Client) dial(ctx context.Context) (net.Conn, error) {
+	addr := net.JoinHostPort(c.config.Host, strconv.Itoa(c.config.Port))
+
+	if c.config.Port == implicitTLSPort {
+		dialer := &tls.Dialer{
+			Config: &tls.Config{ServerName: c.config.Host, MinVersion: tls.VersionTLS12},
+		}
+
+		return dialer.DialContext(ctx, "tcp", addr)
+	}
+
+	var dialer net.Dialer
+
+	return dialer.DialContext(ctx, "tcp", addr)
+}
+
+// encode returns the message in the internet message format with a
+// multipart/alternative body, so clients show the HTML body if supported and
+// the plain text body otherwise.
+func (m *Message) encode(from *mail.Address, to *mail.Address, date time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, errors.Wrap(err, "mail: message id")
+	}
+
+	_, domain, _ := strings.Cut(from.Address, "@")
+	writer := multipart.NewWriter(&buf)
+
+	// The subject is stripped of line breaks to prevent header injection.
+	subject := strings.NewReplacer("\r", "", "\n", " ").Replace(m.Subject)
+
+	headers := []struct{ key, value string }{
+		{"From", from.String()},
+		{"To", to.String()},
+		{"Subject", mime.QEncoding.Encode("utf-8", subject)},
+		{"Date", date.Format(time.RFC1123Z)},
+		{"Message-ID", "<" + hex.EncodeToString(id) + "@" + domain + ">"},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "multipart/alternative; boundary=" + writer.Boundary()},
+	}
+
+	for _, header := range headers {
+		buf.WriteString(header.key + ": " + header.value + "\r\n")
+	}
+
+	buf.WriteString("\r\n")
+
+	parts := []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", m.Text},
+		{"text/html; charset=utf-8", m.HTML},
+	}
+
+	for _, part := range parts {
+		w, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "mail: encode")
+		}
+
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(part.body)); err != nil {
+			return nil, errors.Wrap(err, "mail: encode")
+		}
+
+		if err := qp.Close(); err != nil {
+			return nil, errors.Wrap(err, "mail: encode")
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, errors.Wrap(err, "mail: encode")
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,92 @@
+package mail_test
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	netmail "net/mail"
+	"strings"
+	"testing"
+
+	"github.com/medama-io/medama/mail"
+	"github.com/medama-io/medama/metest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSend(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	server := metest.NewSMTPServer(t)
+	client := mail.NewClient(mail.Config{
+		Host:     server.Host,
+		Port:     server.Port,
+		Username: "user",
+		Password: "password",
+		From:     "Medama <reports@example.com>",
+	})
+
+	err := client.Send(t.Context(), &mail.Message{
+		To:      "owner@example.com",
+		Subject: "Weekly report for example.com\r\nBcc: evil@example.com",
+		Text:    "Visitors: 10\n.hidden line",
+		HTML:    "<p>Visitors: <strong>10</strong></p>",
+	})
+	require.NoError(err)
+
+	messages := server.Messages()
+	require.Len(messages, 1)
+	assert.Equal("reports@example.com", messages[0].From)
+	assert.Equal([]string{"owner@example.com"}, messages[0].To)
+
+	msg, err := netmail.ReadMessage(strings.NewReader(messages[0].Data))
+	require.NoError(err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(err)
+	assert.Equal("Weekly report for example.com Bcc: evil@example.com", subject)
+	assert.Empty(msg.Header.Get("Bcc"))
+	assert.Equal(`"Medama" <reports@example.com>`, msg.Header.Get("From"))
+	assert.NotEmpty(msg.Header.Get("Message-ID"))
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(err)
+	assert.Equal("multipart/alternative", mediaType)
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	bodies := map[string]string{}
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+
+		require.NoError(err)
+
+		body, err := io.ReadAll(part)
+		require.NoError(err)
+
+		contentType, _, err := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		require.NoError(err)
+
+		bodies[contentType] = string(body)
+	}
+
+	assert.Equal("Visitors: 10\r\n.hidden line", bodies["text/plain"])
+	assert.Equal("<p>Visitors: <strong>10</strong></p>", bodies["text/html"])
+}
+
+func TestSendInvalidAddress(t *testing.T) {
+	server := metest.NewSMTPServer(t)
+	client := mail.NewClient(mail.Config{
+		Host: server.Host,
+		Port: server.Port,
+		From: "reports@example.com",
+	})
+
+	err := client.Send(t.Context(), &mail.Message{To: "not an address", Subject: "Report"})
+	require.ErrorIs(t, err, mail.ErrInvalidAddress)
+	assert.Empty(t, server.Messages())
+}
@@ -34,6 +34,35 @@ func newTestHandler(
 	t.Helper()
 
 	assert := assert.New(t)
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient := NewTestDatabases(t)
+
+	auth, err := util.NewAuthService(ctx, isDemoMode)
+	require.NoError(err)
+
+	ingester := duckdb.NewIngester(duckdbClient, duckdb.IngestConfig{})
+	t.Cleanup(func() {
+		ingester.Close(context.Background()) //nolint:errcheck // Test cleanup.
+	})
+
+	handler, err := services.NewService(
+		ctx,
+		auth,
+		sqliteClient,
+		duckdbClient,
+		ingester,
+		"test-commit",
+	)
+	require.NoError(err)
+	assert.NotNil(handler)
+
+	return assert, ctx, handler, sqliteClient
+}
+
+// NewTestDatabases returns migrated in memory app and analytics databases.
+func NewTestDatabases(t *testing.T) (context.Context, *sqlite.Client, *duckdb.Client) {
+	t.Helper()
+
 	require := require.New(t)
 	ctx := t.Context()
 
@@ -46,23 +75,16 @@ func newTestHandler(
 
 	sqliteClient, err := sqlite.NewClient(host)
 	require.NoError(err)
-	assert.NotNil(sqliteClient)
+	require.NotNil(sqliteClient)
 
 	duckdbClient, err := duckdb.NewClient(":memory:")
 	require.NoError(err)
-	assert.NotNil(duckdbClient)
+	require.NotNil(duckdbClient)
 
 	m, err := migrations.NewMigrationsService(ctx, sqliteClient, duckdbClient)
 	require.NoError(err)
 	err = m.AutoMigrate(ctx)
 	require.NoError(err)
 
-	auth, err := util.NewAuthService(ctx, isDemoMode)
-	require.NoError(err)
-
-	handler, err := services.NewService(ctx, auth, sqliteClient, duckdbClient, "test-commit")
-	require.NoError(err)
-	assert.NotNil(handler)
-
-	return assert, ctx, handler, sqliteClient
+	return ctx, sqliteClient, duckdbClient
 }
@@ -0,0 +1,308 @@
+package metest
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	_ "crypto/sha512" // Register SHA-384 and SHA-512 for EC signatures.
+	"encoding/base64"
+	"encoding/json"
+	"maps"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// OIDCClientID is the client registered with the mock identity provider.
+	OIDCClientID = "medama"
+	// OIDCClientSecret is the secret of the registered client.
+	OIDCClientSecret = "secret"
+
+	oidcKeyID   = "test-key"
+	oidcECKeyID = "test-ec-key"
+)
+
+// OIDCProvider is a mock OpenID Connect identity provider supporting the
+// authorization code flow with PKCE. Users are logged in without a prompt
+// with the claims set by SetClaims.
+type OIDCProvider struct {
+	*httptest.Server
+
+	key *rsa.PrivateKey
+
+	mu     sync.Mutex
+	claims map[string]any
+	codes  map[string]oidcCode
+
+	ecKey        *ecdsa.PrivateKey
+	ecAlg        string
+	jwksRequests int
+}
+
+type oidcCode struct {
+	nonce       string
+	challenge   string
+	redirectURI string
+	claims      map[string]any
+}
+
+// NewOIDCProvider starts a mock identity provider that is closed when the
+// test ends.
+func NewOIDCProvider(t *testing.T) *OIDCProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	p := &OIDCProvider{
+		key:   key,
+		codes: make(map[string]oidcCode),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", p.handleDiscovery)
+	mux.HandleFunc("GET /authorize", p.handleAuthorize)
+	mux.HandleFunc("POST /token", p.handleToken)
+	mux.HandleFunc("GET /jwks", p.handleJWKS)
+
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+
+	return p
+}
+
+// SetClaims sets the claims of the user logged in by the next authorizations.
+func (p *OIDCProvider) SetClaims(claims map[string]any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.claims = claims
+}
+
+// SetECKey replaces the signing key with a new elliptic curve key with its own
+// key ID. Tokens are signed with the given algorithm, which does not have to
+// match the curve.
+func (p *OIDCProvider) SetECKey(t *testing.T, curve elliptic.Curve, alg string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	require.NoError(t, err)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.ecKey = key
+	p.ecAlg = alg
+}
+
+// JWKSRequests returns the number of times the signing keys were fetched.
+func (p *OIDCProvider) JWKSRequests() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.jwksRequests
+}
+
+func (p *OIDCProvider) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                           p.URL,
+		"authorization_endpoint":           p.URL + "/authorize",
+		"token_endpoint":                   p.URL + "/token",
+		"jwks_uri":                         p.URL + "/jwks",
+		"code_challenge_methods_supported": []string{"S256"},
+	})
+}
+
+func (p *OIDCProvider) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	if query.Get("response_type") != "code" ||
+		query.Get("client_id") != OIDCClientID ||
+		query.Get("code_challenge_method") != "S256" ||
+		query.Get("code_challenge") == "" {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+
+	redirect, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil {
+		http.Error(w, "invalid redirect uri", http.StatusBadRequest)
+		return
+	}
+
+	code := rand.Text()
+
+	p.mu.Lock()
+	p.codes[code] = oidcCode{
+		nonce:       query.Get("nonce"),
+		challenge:   query.Get("code_challenge"),
+		redirectURI: redirect.String(),
+		claims:      maps.Clone(p.claims),
+	}
+	p.mu.Unlock()
+
+	values := redirect.Query()
+	values.Set("code", code)
+	values.Set("state", query.Get("state"))
+	redirect.RawQuery = values.Encode()
+
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (p *OIDCProvider) handleToken(w http.ResponseWriter, r *http.Request) {
+	clientID, secret, ok := r.BasicAuth()
+	if !ok || clientID != OIDCClientID || secret != OIDCClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	p.mu.Lock()
+	code, ok := p.codes[r.PostFormValue("code")]
+	delete(p.codes, r.PostFormValue("code"))
+	p.mu.Unlock()
+
+	verifier := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+
+	if !ok ||
+		r.PostFormValue("grant_type") != "authorization_code" ||
+		r.PostFormValue("redirect_uri") != code.redirectURI ||
+		base64.RawURLEncoding.EncodeToString(verifier[:]) != code.challenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := time.Now()
+	claims := map[string]any{
+		"iss":   p.URL,
+		"aud":   OIDCClientID,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+		"nonce": code.nonce,
+	}
+	maps.Copy(claims, code.claims)
+
+	token, err := p.sign(claims)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": rand.Text(),
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     token,
+	})
+}
+
+func (p *OIDCProvider) handleJWKS(w http.ResponseWriter, _ *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.jwksRequests++
+
+	if p.ecKey != nil {
+		size := (p.ecKey.Curve.Params().BitSize + 7) / 8
+
+		writeJSON(w, http.StatusOK, map[string]any{
+			"keys": []map[string]string{{
+				"kty": "EC",
+				"kid": oidcECKeyID,
+				"use": "sig",
+				"crv": p.ecKey.Curve.Params().Name,
+				"x":   base64.RawURLEncoding.EncodeToString(p.ecKey.X.FillBytes(make([]byte, size))),
+				"y":   base64.RawURLEncoding.EncodeToString(p.ecKey.Y.FillBytes(make([]byte, size))),
+			}},
+		})
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": oidcKeyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+		}},
+	})
+}
+
+// sign returns a JWT with the given claims, signed with RS256 unless an
+// elliptic curve key is set.
+func (p *OIDCProvider) sign(claims map[string]any) (string, error) {
+	p.mu.Lock()
+	ecKey, ecAlg := p.ecKey, p.ecAlg
+	p.mu.Unlock()
+
+	alg, kid := "RS256", oidcKeyID
+	if ecKey != nil {
+		alg, kid = ecAlg, oidcECKeyID
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	if ecKey != nil {
+		return signEC(ecKey, ecAlg, signed)
+	}
+
+	digest := sha256.Sum256([]byte(signed))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, p.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// signEC signs a JWT with an elliptic curve key using the hash of the given
+// algorithm.
+func signEC(key *ecdsa.PrivateKey, alg string, signed string) (string, error) {
+	hash := map[string]crypto.Hash{
+		"ES256": crypto.SHA256,
+		"ES384": crypto.SHA384,
+		"ES512": crypto.SHA512,
+	}[alg]
+
+	h := hash.New()
+	h.Write([]byte(signed))
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, h.Sum(nil))
+	if err != nil {
+		return "", err
+	}
+
+	size := (key.Curve.Params().BitSize + 7) / 8
+	signature := append(r.FillBytes(make([]byte, size)), s.FillBytes(make([]byte, size))...)
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v) //nolint:errcheck // Test server.
+}
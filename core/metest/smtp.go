@@ -0,0 +1,159 @@
+package metest
+
+import (
+	"bufio"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// SMTPMessage is an email received by the mock SMTP server.
+type SMTPMessage struct {
+	From string
+	To   []string
+	Data string
+}
+
+// SMTPServer is a mock SMTP server accepting all emails without TLS. Any
+// credentials are accepted with AUTH PLAIN.
+type SMTPServer struct {
+	Host string
+	Port int
+
+	listener net.Listener
+	wg       sync.WaitGroup
+
+	mu       sync.Mutex
+	messages []SMTPMessage
+}
+
+// NewSMTPServer starts a mock SMTP server that is closed when the test ends.
+func NewSMTPServer(t *testing.T) *SMTPServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	addr, ok := listener.Addr().(*net.TCPAddr)
+	require.True(t, ok)
+
+	s := &SMTPServer{
+		Host:     addr.IP.String(),
+		Port:     addr.Port,
+		listener: listener,
+	}
+
+	s.wg.Go(s.serve)
+
+	t.Cleanup(func() {
+		s.listener.Close()
+		s.wg.Wait()
+	})
+
+	return s
+}
+
+// Messages returns the emails received so far.
+func (s *SMTPServer) Messages() []SMTPMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]SMTPMessage(nil), s.messages...)
+}
+
+func (s *SMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.wg.Go(func() {
+			defer conn.Close()
+			s.handle(textproto.NewConn(conn))
+		})
+	}
+}
+
+//nolint:errcheck // The client sees a closed connection if a reply fails.
+func (s *SMTPServer) handle(conn *textproto.Conn) {
+	conn.PrintfLine("220 localhost ESMTP")
+
+	var msg SMTPMessage
+
+	for {
+		line, err := conn.ReadLine()
+		if err != nil {
+			return
+		}
+
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			conn.PrintfLine("250-localhost")
+			conn.PrintfLine("250 AUTH PLAIN")
+		case "AUTH":
+			conn.PrintfLine("235 2.7.0 Authentication successful")
+		case "MAIL":
+			msg = SMTPMessage{From: trimPath(arg)}
+			conn.PrintfLine("250 2.1.0 OK")
+		case "RCPT":
+			msg.To = append(msg.To, trimPath(arg))
+			conn.PrintfLine("250 2.1.5 OK")
+		case "DATA":
+			conn.PrintfLine("354 Start mail input; end with <CRLF>.<CRLF>")
+
+			data, err := readData(conn.Reader.R)
+			if err != nil {
+				return
+			}
+
+			msg.Data = data
+
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+
+			conn.PrintfLine("250 2.0.0 OK")
+		case "QUIT":
+			conn.PrintfLine("221 2.0.0 Bye")
+			return
+		case "HELO", "RSET", "NOOP":
+			conn.PrintfLine("250 OK")
+		default:
+			conn.PrintfLine("502 5.5.2 Command not recognized")
+		}
+	}
+}
+
+// readData reads the message content up to the terminating line containing a
+// single dot, removing the dot-stuffing of lines starting with a dot.
+func readData(r *bufio.Reader) (string, error) {
+	var b strings.Builder
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+
+		if line == ".\r\n" {
+			return b.String(), nil
+		}
+
+		b.WriteString(strings.TrimPrefix(line, "."))
+	}
+}
+
+// trimPath returns the address of a MAIL FROM or RCPT TO argument.
+func trimPath(arg string) string {
+	_, path, _ := strings.Cut(arg, ":")
+	path, _, _ = strings.Cut(path, " ")
+
+	return strings.Trim(path, "<>")
+}
@@ -0,0 +1,137 @@
+package metrics
+
+import (
+	"runtime"
+	"strings"
+	"time"
+)
+
+// Outcomes of received event hits.
+const (
+	OutcomeAccepted        = "accepted"
+	OutcomeBot             = "bot"
+	OutcomeSpam            = "spam"
+	OutcomeBlockedIP       = "blocked_ip"
+	OutcomeUnknownHostname = "unknown_hostname"
+)
+
+// Rate limiters rejecting requests.
+const (
+	LimiterEvent  = "event"
+	LimiterLogin  = "login"
+	LimiterAPIKey = "api_key"
+)
+
+// Results of flushing ingested records.
+const (
+	IngestWritten = "written"
+	IngestDropped = "dropped"
+)
+
+// Databases queries are made to.
+const (
+	DatabaseSQLite = "sqlite"
+	DatabaseDuckDB = "duckdb"
+)
+
+//nolint:gochecknoglobals // Metrics are recorded from anywhere in the server.
+var (
+	// Hits counts event hits by their type and whether they were accepted or
+	// why they were discarded.
+	Hits = NewCounterVec(
+		"medama_event_hits",
+		"Event hits received by type and outcome.",
+		"type", "outcome",
+	)
+
+	// RequestDuration observes the latency of API requests by operation.
+	RequestDuration = NewHistogramVec(
+		"medama_http_request_duration_seconds",
+		"Latency of API requests by operation.",
+		DefaultBuckets,
+		"operation",
+	)
+
+	// RateLimited counts requests rejected by a rate limiter.
+	RateLimited = NewCounterVec(
+		"medama_rate_limited_requests",
+		"Requests rejected by a rate limiter.",
+		"limiter",
+	)
+
+	// QueryDuration observes the duration of database queries by database
+	// and the client method making them.
+	QueryDuration = NewHistogramVec(
+		"medama_db_query_duration_seconds",
+		"Duration of database queries by database and client method.",
+		DefaultBuckets,
+		"database", "query",
+	)
+
+	// IngestRecords counts records flushed by the ingester by whether they
+	// were written or dropped by a failed flush. Requeued records are only
+	// counted once a later flush writes or drops them.
+	IngestRecords = NewCounterVec(
+		"medama_ingest_records",
+		"Records flushed by the ingester by result.",
+		"result",
+	)
+
+	// IngestRequeuedRecords counts records put back in the queue by failed
+	// flushes. A record is counted for every failed flush it was part of.
+	IngestRequeuedRecords = NewCounterVec(
+		"medama_ingest_requeued_records",
+		"Records requeued by failed ingester flushes.",
+	)
+
+	// IngestFlushDuration observes how long writing a batch of records takes.
+	IngestFlushDuration = NewHistogramVec(
+		"medama_ingest_flush_duration_seconds",
+		"Duration of ingester flushes.",
+		DefaultBuckets,
+	)
+)
+
+// ObserveQuery records the time since start as the duration of a query made
+// by the caller of the function deferring it. This is intended to be deferred
+// by the query methods of the database clients, so the query label is the
+// client method that made the query.
+func ObserveQuery(database string, start time.Time) {
+	if !Enabled() {
+		return
+	}
+
+	QueryDuration.Observe(time.Since(start).Seconds(), database, callerName(3))
+}
+
+// callerName returns the method or function name of the caller skip frames
+// above callerName, without its package, receiver or closure suffixes.
+func callerName(skip int) string {
+	pc := make([]uintptr, 1)
+	if runtime.Callers(skip+1, pc) == 0 {
+		return "unknown"
+	}
+
+	frame, _ := runtime.CallersFrames(pc).Next()
+	name := frame.Function
+
+	if i := strings.LastIndexByte(name, '/'); i >= 0 {
+		name = name[i+1:]
+	}
+
+	if i := strings.LastIndex(name, ")."); i >= 0 {
+		name = name[i+2:]
+	} else if i := strings.IndexByte(name, '.'); i >= 0 {
+		name = name[i+1:]
+	}
+
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		name = name[:i]
+	}
+
+	if name == "" {
+		return "unknown"
+	}
+
+	return name
+}
@@ -0,0 +1,388 @@
+// Package metrics records server and ingestion metrics and exposes them in
+// the OpenMetrics text format.
+//
+// Metrics are only recorded once Enable has been called, so instrumented code
+// paths cost a single atomic load when the metrics endpoint is disabled.
+package metrics
+
+import (
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/medama-io/medama/util/logger"
+)
+
+// ContentType is the media type of the OpenMetrics text format.
+const ContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+var (
+	//nolint:gochecknoglobals // Metrics are recorded from anywhere in the server.
+	enabled atomic.Bool
+
+	//nolint:gochecknoglobals // Global registry collects all package level metrics.
+	registryMu sync.Mutex
+	//nolint:gochecknoglobals // Global registry collects all package level metrics.
+	registry []family
+)
+
+// family is a metric family that can be written in the exposition format.
+type family interface {
+	write(b *strings.Builder)
+}
+
+// Enable starts recording metrics.
+func Enable() {
+	enabled.Store(true)
+}
+
+// Enabled reports whether metrics are being recorded.
+func Enabled() bool {
+	return enabled.Load()
+}
+
+func register(f family) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	registry = append(registry, f)
+}
+
+// Handler serves all registered metrics in the OpenMetrics text format.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", ContentType)
+		_, _ = w.Write([]byte(Expose()))
+	})
+}
+
+// Expose returns all registered metrics in the OpenMetrics text format.
+func Expose() string {
+	registryMu.Lock()
+	families := slices.Clone(registry)
+	registryMu.Unlock()
+
+	var b strings.Builder
+	for _, f := range families {
+		f.write(&b)
+	}
+
+	b.WriteString("# EOF\n")
+
+	return b.String()
+}
+
+// series holds the value of a metric for one set of label values, stored in
+// order of the label names of the metric.
+type series[T any] struct {
+	labels []string
+	value  *T
+}
+
+// vec maps label values to the series of a metric.
+type vec[T any] struct {
+	name   string
+	help   string
+	labels []string
+
+	mu     sync.RWMutex
+	series map[string]series[T]
+	create func() *T
+
+	// mismatched is set once a label count mismatch has been logged.
+	mismatched atomic.Bool
+}
+
+func newVec[T any](name string, help string, labels []string, create func() *T) vec[T] {
+	return vec[T]{
+		name:   name,
+		help:   help,
+		labels: labels,
+		series: make(map[string]series[T]),
+		create: create,
+	}
+}
+
+// get returns the value for the label values, creating it if needed. The
+// second return value is false if the number of label values does not match
+// the metric, in which case the sample is dropped rather than failing the
+// request that records it.
+func (v *vec[T]) get(values []string) (*T, bool) {
+	if len(values) != len(v.labels) {
+		// Only log the first mismatch as metrics are recorded on hot paths.
+		if v.mismatched.CompareAndSwap(false, true) {
+			log := logger.Get()
+			log.Error().
+				Str("metric", v.name).
+				Int("expected", len(v.labels)).
+				Int("got", len(values)).
+				Msg("metrics: dropping samples with wrong number of label values")
+		}
+
+		return nil, false
+	}
+
+	// Label values can not contain the separator as they are valid UTF-8.
+	key := strings.Join(values, "\xff")
+
+	v.mu.RLock()
+	s, ok := v.series[key]
+	v.mu.RUnlock()
+
+	if ok {
+		return s.value, true
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if s, ok = v.series[key]; ok {
+		return s.value, true
+	}
+
+	s = series[T]{labels: slices.Clone(values), value: v.create()}
+	v.series[key] = s
+
+	return s.value, true
+}
+
+// each calls fn for every series ordered by label values.
+func (v *vec[T]) each(fn func(labels []string, value *T)) {
+	v.mu.RLock()
+	keys := make([]string, 0, len(v.series))
+	for key := range v.series {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	all := make([]series[T], 0, len(keys))
+	for _, key := range keys {
+		all = append(all, v.series[key])
+	}
+	v.mu.RUnlock()
+
+	for _, s := range all {
+		fn(s.labels, s.value)
+	}
+}
+
+// CounterVec is a monotonically increasing counter partitioned by labels.
+type CounterVec struct {
+	vec[atomic.Uint64]
+}
+
+// NewCounterVec registers a counter. The name must not include the _total
+// suffix, which is added to its samples.
+func NewCounterVec(name string, help string, labels ...string) *CounterVec {
+	c := &CounterVec{
+		vec: newVec(name, help, labels, func() *atomic.Uint64 { return &atomic.Uint64{} }),
+	}
+	register(c)
+
+	return c
+}
+
+// Inc increments the counter for the label values.
+func (c *CounterVec) Inc(values ...string) {
+	c.Add(1, values...)
+}
+
+// Add adds n to the counter for the label values.
+func (c *CounterVec) Add(n uint64, values ...string) {
+	if !Enabled() {
+		return
+	}
+
+	s, ok := c.get(values)
+	if !ok {
+		return
+	}
+
+	s.Add(n)
+}
+
+// Value returns the current count for the label values.
+func (c *CounterVec) Value(values ...string) uint64 {
+	s, ok := c.get(values)
+	if !ok {
+		return 0
+	}
+
+	return s.Load()
+}
+
+func (c *CounterVec) write(b *strings.Builder) {
+	writeHeader(b, c.name, "counter", "", c.help)
+
+	c.each(func(values []string, s *atomic.Uint64) {
+		writeSample(b, c.name+"_total", c.labels, values, "", "", strconv.FormatUint(s.Load(), 10))
+	})
+}
+
+// DefaultBuckets are the upper bounds in seconds of latency histograms.
+//
+//nolint:gochecknoglobals // Slices can not be constants.
+var DefaultBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// histogram holds the bucket counts, sum and count of one series.
+type histogram struct {
+	mu      sync.Mutex
+	buckets []uint64
+	count   uint64
+	sum     float64
+}
+
+// HistogramVec samples observations into buckets partitioned by labels.
+type HistogramVec struct {
+	vec[histogram]
+
+	unit    string
+	buckets []float64
+}
+
+// NewHistogramVec registers a histogram of durations in seconds. The name
+// must end with the _seconds unit suffix.
+func NewHistogramVec(name string, help string, buckets []float64, labels ...string) *HistogramVec {
+	h := &HistogramVec{
+		unit:    "seconds",
+		buckets: buckets,
+	}
+	h.vec = newVec(name, help, labels, func() *histogram {
+		return &histogram{buckets: make([]uint64, len(buckets))}
+	})
+	register(h)
+
+	return h
+}
+
+// Observe adds an observation for the label values.
+func (h *HistogramVec) Observe(v float64, values ...string) {
+	if !Enabled() {
+		return
+	}
+
+	s, ok := h.get(values)
+	if !ok {
+		return
+	}
+
+	i, _ := slices.BinarySearch(h.buckets, v)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if i < len(s.buckets) {
+		s.buckets[i]++
+	}
+
+	s.count++
+	s.sum += v
+}
+
+// Count returns the number of observations for the label values.
+func (h *HistogramVec) Count(values ...string) uint64 {
+	s, ok := h.get(values)
+	if !ok {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.count
+}
+
+func (h *HistogramVec) write(b *strings.Builder) {
+	writeHeader(b, h.name, "histogram", h.unit, h.help)
+
+	h.each(func(values []string, s *histogram) {
+		s.mu.Lock()
+		buckets := slices.Clone(s.buckets)
+		count, sum := s.count, s.sum
+		s.mu.Unlock()
+
+		// Buckets are stored individually and exposed cumulatively.
+		var cumulative uint64
+		for i, bound := range h.buckets {
+			cumulative += buckets[i]
+			writeSample(b, h.name+"_bucket", h.labels, values, "le", formatFloat(bound), strconv.FormatUint(cumulative, 10))
+		}
+
+		writeSample(b, h.name+"_bucket", h.labels, values, "le", "+Inf", strconv.FormatUint(count, 10))
+		writeSample(b, h.name+"_sum", h.labels, values, "", "", formatFloat(sum))
+		writeSample(b, h.name+"_count", h.labels, values, "", "", strconv.FormatUint(count, 10))
+	})
+}
+
+func writeHeader(b *strings.Builder, name string, typ string, unit string, help string) {
+	b.WriteString("# TYPE " + name + " " + typ + "\n")
+
+	if unit != "" {
+		b.WriteString("# UNIT " + name + " " + unit + "\n")
+	}
+
+	b.WriteString("# HELP " + name + " " + escape(help) + "\n")
+}
+
+// writeSample writes a single sample line. An extra label, such as the bucket
+// bound of histograms, is appended after the labels of the metric.
+func writeSample(
+	b *strings.Builder,
+	name string,
+	labels []string,
+	values []string,
+	extraLabel string,
+	extraValue string,
+	value string,
+) {
+	b.WriteString(name)
+
+	if len(labels) > 0 || extraLabel != "" {
+		b.WriteByte('{')
+
+		for i, label := range labels {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+
+			b.WriteString(label + `="` + escape(values[i]) + `"`)
+		}
+
+		if extraLabel != "" {
+			if len(labels) > 0 {
+				b.WriteByte(',')
+			}
+
+			b.WriteString(extraLabel + `="` + extraValue + `"`)
+		}
+
+		b.WriteByte('}')
+	}
+
+	b.WriteString(" " + value + "\n")
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escape(s string) string {
+	return escaper.Replace(s)
+}
+
+func formatFloat(v float64) string {
+	if math.IsInf(v, 1) {
+		return "+Inf"
+	}
+
+	s := strconv.FormatFloat(v, 'g', -1, 64)
+	// OpenMetrics recommends floats always have a decimal point.
+	if !strings.ContainsAny(s, ".eN") {
+		s += ".0"
+	}
+
+	return s
+}
@@ -0,0 +1,77 @@
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClient struct{}
+
+func (c *fakeClient) query() {
+	defer metrics.ObserveQuery(metrics.DatabaseSQLite, time.Now())
+}
+
+func (c *fakeClient) GetThing() {
+	c.query()
+}
+
+func TestExpose(t *testing.T) {
+	assert := assert.New(t)
+
+	counter := metrics.NewCounterVec("test_things", "Things \"counted\".", "kind")
+	histogram := metrics.NewHistogramVec("test_wait_seconds", "Time waited.", []float64{0.1, 1}, "queue")
+
+	// Nothing is recorded until metrics are enabled.
+	counter.Inc("a")
+	assert.Zero(counter.Value("a"))
+
+	metrics.Enable()
+
+	counter.Inc("b")
+	counter.Inc("a")
+	counter.Inc("a")
+	histogram.Observe(0.05, "x")
+	histogram.Observe(0.5, "x")
+	histogram.Observe(5, "x")
+
+	// Samples with the wrong number of label values are dropped.
+	assert.NotPanics(func() {
+		counter.Inc()
+		counter.Inc("a", "extra")
+		histogram.Observe(1, "x", "extra")
+	})
+	assert.Zero(counter.Value("a", "extra"))
+
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(metrics.ContentType, rec.Header().Get("Content-Type"))
+
+	body := rec.Body.String()
+	assert.Contains(body, `# TYPE test_things counter
+# HELP test_things Things \"counted\".
+test_things_total{kind="a"} 2
+test_things_total{kind="b"} 1
+`)
+	assert.Contains(body, `# TYPE test_wait_seconds histogram
+# UNIT test_wait_seconds seconds
+# HELP test_wait_seconds Time waited.
+test_wait_seconds_bucket{queue="x",le="0.1"} 1
+test_wait_seconds_bucket{queue="x",le="1.0"} 2
+test_wait_seconds_bucket{queue="x",le="+Inf"} 3
+test_wait_seconds_sum{queue="x"} 5.55
+test_wait_seconds_count{queue="x"} 3
+`)
+	assert.True(strings.HasSuffix(body, "\n# EOF\n"))
+
+	// Queries are labelled by the client method making them.
+	client := &fakeClient{}
+	client.GetThing()
+	assert.Equal(uint64(1), metrics.QueryDuration.Count(metrics.DatabaseSQLite, "GetThing"))
+}
@@ -2,23 +2,32 @@ package middlewares
 
 import (
 	"context"
+	"net/netip"
+	"time"
 
+	"github.com/go-faster/errors"
 	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db/sqlite"
+	"github.com/medama-io/medama/iputils"
+	"github.com/medama-io/medama/metrics"
 	"github.com/medama-io/medama/model"
 	"github.com/medama-io/medama/util"
+	"github.com/medama-io/medama/util/logger"
 )
 
 type Handler struct {
 	auth *util.AuthService
+	db   *sqlite.Client
 }
 
 // Compile time check for Handler.
 var _ api.SecurityHandler = (*Handler)(nil)
 
 // NewAuthHandler returns a new instance of the auth service handler.
-func NewAuthHandler(auth *util.AuthService) *Handler {
+func NewAuthHandler(auth *util.AuthService, sqlite *sqlite.Client) *Handler {
 	return &Handler{
 		auth: auth,
+		db:   sqlite,
 	}
 }
 
@@ -29,14 +38,91 @@ func (h *Handler) HandleCookieAuth(
 	t api.CookieAuth,
 ) (context.Context, error) {
 	// Decrypt and read session cookie
-	userID, err := h.auth.ReadSession(ctx, t.APIKey)
+	session, err := h.auth.LoadSession(ctx, t.APIKey)
 	// If session does not exist, return error
 	if err != nil {
 		return nil, model.ErrUnauthorised
 	}
 
 	// We want to pass the validated user ID to the next handler
-	ctx = context.WithValue(ctx, model.ContextKeyUserID, userID)
+	ctx = context.WithValue(ctx, model.ContextKeyUserID, session.UserID)
+	ctx = context.WithValue(ctx, model.ContextKeySessionID, session.ID)
 
 	return ctx, nil
 }
+
+// HandleBearerAuth handles API key based authentication.
+func (h *Handler) HandleBearerAuth(
+	ctx context.Context,
+	operationName string,
+	t api.BearerAuth,
+) (context.Context, error) {
+	// Invalid tokens lock out the network they are sent from, sharing the
+	// lockouts of failed logins so they can be cleared the same way.
+	limiter := h.auth.LoginLimiter()
+	prefix := clientPrefix(ctx)
+
+	if retryAfter := limiter.Check("", prefix); retryAfter > 0 {
+		metrics.RateLimited.Inc(metrics.LimiterAPIKey)
+
+		return nil, &LockedError{RetryAfter: retryAfter}
+	}
+
+	key, err := h.auth.ReadAPIKey(ctx, h.db, t.Token)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidAPIKey) {
+			limiter.Fail("", prefix)
+			return nil, model.ErrUnauthorised
+		}
+
+		return nil, err
+	}
+
+	if !key.HasRoles(t.Roles) {
+		log := logger.Get()
+		log.Warn().
+			Str("id", key.ID).
+			Str("role", string(key.Role)).
+			Str("operation", operationName).
+			Msg("api key forbidden")
+
+		return nil, model.ErrAPIKeyForbidden
+	}
+
+	// The key acts on behalf of its user, with the key itself passed on so
+	// handlers can enforce its website scope.
+	ctx = context.WithValue(ctx, model.ContextKeyUserID, key.UserID)
+	ctx = context.WithValue(ctx, model.ContextKeyAPIKey, key)
+
+	return ctx, nil
+}
+
+// LockedError is returned when authenticating from a network that is locked
+// out after too many failed attempts.
+type LockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockedError) Error() string {
+	return model.ErrLoginLocked.Error()
+}
+
+func (e *LockedError) Unwrap() error {
+	return model.ErrLoginLocked
+}
+
+// clientPrefix returns the IP prefix of the client that failed attempts are
+// counted by, or an invalid prefix if the client IP is unknown.
+func clientPrefix(ctx context.Context) netip.Prefix {
+	ip, ok := ctx.Value(model.RequestKeyClientIP).(netip.Addr)
+	if !ok {
+		return netip.Prefix{}
+	}
+
+	prefix, err := iputils.GetPrefix(ip, iputils.IPv4DefaultPrefix, iputils.IPv6DefaultPrefix)
+	if err != nil {
+		return netip.Prefix{}
+	}
+
+	return prefix
+}
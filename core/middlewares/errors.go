@@ -3,7 +3,9 @@ package middlewares
 import (
 	"context"
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/go-faster/jx"
@@ -15,6 +17,18 @@ import (
 // ErrorHandler is a middleware that handles any unhandled errors by ogen.
 func ErrorHandler(_ctx context.Context, w http.ResponseWriter, req *http.Request, err error) {
 	code := ogenerrors.ErrorCode(err)
+	// API keys that lack the rights for the operation are authenticated, but
+	// not authorised.
+	if errors.Is(err, model.ErrAPIKeyForbidden) {
+		code = http.StatusForbidden
+	}
+
+	var locked *LockedError
+	if errors.As(err, &locked) {
+		code = http.StatusTooManyRequests
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(locked.RetryAfter.Seconds()))))
+	}
+
 	errMessage := strings.ReplaceAll(err.Error(), "\"", "'")
 
 	log := logger.Get().With().
@@ -27,7 +41,8 @@ func ErrorHandler(_ctx context.Context, w http.ResponseWriter, req *http.Request
 		Str("Content-Length", req.Header.Get("Content-Length")).
 		Str("User-Agent", req.Header.Get("User-Agent")).Logger()
 
-	if errors.Is(err, model.ErrUnauthorised) || code == http.StatusUnauthorized {
+	if errors.Is(err, model.ErrUnauthorised) || code == http.StatusUnauthorized ||
+		code == http.StatusForbidden || code == http.StatusTooManyRequests {
 		log.Warn().Msg("unauthorised")
 	} else {
 		log.Error().Msg(err.Error())
@@ -0,0 +1,72 @@
+package middlewares
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+)
+
+const (
+	exportFormatCSV    = "csv"
+	exportFormatNDJSON = "ndjson"
+
+	contentTypeCSV    = "text/csv"
+	contentTypeNDJSON = "application/x-ndjson"
+)
+
+// Export lets stats reports be exported by sending an Accept header of
+// text/csv or application/x-ndjson instead of the format query parameter.
+//
+// The API handlers stream the export themselves, so this only translates the
+// header to the query parameter. An explicit format query parameter takes
+// precedence over the Accept header.
+func Export() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet || !strings.HasPrefix(r.URL.Path, "/website/") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			query := r.URL.Query()
+			if query.Has("format") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			format := acceptedExportFormat(r.Header.Get("Accept"))
+			if format == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			query.Set("format", format)
+
+			req := r.Clone(r.Context())
+			req.URL.RawQuery = query.Encode()
+			req.RequestURI = req.URL.RequestURI()
+
+			next.ServeHTTP(w, req)
+		})
+	}
+}
+
+// acceptedExportFormat returns the export format requested by the Accept
+// header or an empty string if the report should be returned as JSON.
+func acceptedExportFormat(accept string) string {
+	for value := range strings.SplitSeq(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(value))
+		if err != nil {
+			continue
+		}
+
+		switch mediaType {
+		case contentTypeCSV:
+			return exportFormatCSV
+		case contentTypeNDJSON:
+			return exportFormatNDJSON
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,53 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/medama-io/medama/middlewares"
+	"github.com/stretchr/testify/assert"
+)
+
+// exportHandler echoes the requested format and the Accept header.
+func exportHandler() http.Handler {
+	return middlewares.Export()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.URL.Query().Get("format")))
+	}))
+}
+
+func TestExportAccept(t *testing.T) {
+	assert := assert.New(t)
+	handler := exportHandler()
+
+	testCases := []struct {
+		path     string
+		accept   string
+		expected string
+	}{
+		{"/website/example.com/pages", "text/csv", "csv"},
+		{"/website/example.com/pages", "application/x-ndjson", "ndjson"},
+		{"/website/example.com/pages", "text/html, text/csv;q=0.9", "csv"},
+		{"/website/example.com/pages?limit=5", "text/csv", "csv"},
+		// JSON is returned unless an export format is accepted.
+		{"/website/example.com/pages", "application/json", ""},
+		{"/website/example.com/pages", "", ""},
+		// The format query parameter takes precedence.
+		{"/website/example.com/pages?format=ndjson", "text/csv", "ndjson"},
+		// Only website reports can be exported.
+		{"/user", "text/csv", ""},
+	}
+
+	for _, tc := range testCases {
+		req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		if tc.accept != "" {
+			req.Header.Set("Accept", tc.accept)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(http.StatusOK, rec.Code, tc.path)
+		assert.Equal(tc.expected, rec.Body.String(), tc.path+" "+tc.accept)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/metrics"
 	"github.com/medama-io/medama/util/logger"
 	"github.com/ogen-go/ogen/middleware"
 )
@@ -47,6 +48,8 @@ func RequestLogger() middleware.Middleware {
 		resp, err := next(req)
 		duration := time.Since(startTime)
 
+		metrics.RequestDuration.Observe(duration.Seconds(), req.OperationName)
+
 		if err == nil {
 			log = log.With().
 				Str("operation", req.OperationName).
@@ -9,16 +9,15 @@ import (
 
 	"github.com/hashicorp/golang-lru/v2/expirable"
 	"github.com/medama-io/medama/iputils"
+	"github.com/medama-io/medama/metrics"
 	"github.com/medama-io/medama/util/logger"
 )
 
 const (
 	// Total number of unique IP prefixes to track.
-	cacheSize         = 65536
-	defaultLimit      = 150
-	defaultWindow     = 1 * time.Minute
-	ipv4DefaultPrefix = 24
-	ipv6DefaultPrefix = 48
+	cacheSize     = 65536
+	defaultLimit  = 150
+	defaultWindow = 1 * time.Minute
 )
 
 type RateLimiter struct {
@@ -35,8 +34,8 @@ func NewRateLimiter(next http.Handler) http.Handler {
 	rl := &RateLimiter{
 		visitors:   expirable.NewLRU[netip.Prefix, *atomic.Int64](cacheSize, nil, defaultWindow),
 		limit:      defaultLimit,
-		ipv4Prefix: ipv4DefaultPrefix,
-		ipv6Prefix: ipv6DefaultPrefix,
+		ipv4Prefix: iputils.IPv4DefaultPrefix,
+		ipv6Prefix: iputils.IPv6DefaultPrefix,
 	}
 
 	return rl.middleware(next)
@@ -60,12 +59,7 @@ func (rl *RateLimiter) middleware(next http.Handler) http.Handler {
 			return
 		}
 
-		bits := rl.ipv6Prefix
-		if ip.Is4() {
-			bits = rl.ipv4Prefix
-		}
-
-		prefix, err := ip.Prefix(bits)
+		prefix, err := iputils.GetPrefix(ip, rl.ipv4Prefix, rl.ipv6Prefix)
 		if err != nil {
 			log.Warn().Err(err).Msg("rate limiter: could not get prefix for client address")
 			http.Error(w, "invalid client address", http.StatusBadRequest)
@@ -73,8 +67,6 @@ func (rl *RateLimiter) middleware(next http.Handler) http.Handler {
 			return
 		}
 
-		prefix = prefix.Masked()
-
 		// Get the current counter for this prefix, if it exists.
 		counter, ok := rl.visitors.Get(prefix)
 		if !ok {
@@ -86,6 +78,7 @@ func (rl *RateLimiter) middleware(next http.Handler) http.Handler {
 		// Atomically increment the counter and check if it exceeds the limit.
 		if counter.Add(1) > rl.limit {
 			log.Warn().Str("prefix", prefix.String()).Msg("rate limit exceeded for ip prefix")
+			metrics.RateLimited.Inc(metrics.LimiterEvent)
 			http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
 
 			return
@@ -2,12 +2,15 @@ package middlewares
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/medama-io/medama/iputils"
 	"github.com/medama-io/medama/model"
 	"github.com/ogen-go/ogen/middleware"
 )
 
-// RequestContext adds the request object to the context for use in handlers.
+// RequestContext adds the request object to the context for use in handlers,
+// along with the client IP address for lockouts and the audit log.
 func RequestContext() middleware.Middleware {
 	return func(
 		req middleware.Request,
@@ -20,6 +23,28 @@ func RequestContext() middleware.Middleware {
 			req.Context = context.WithValue(req.Context, model.RequestKeyBody, req.Raw)
 		}
 
+		req.Context = withClientIP(req.Context, req.Raw)
+
 		return next(req)
 	}
 }
+
+// ClientIP adds the client IP address to the request context before the API
+// handler runs, as API keys are authenticated before RequestContext is called
+// and failed attempts are locked out by network.
+func ClientIP() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(withClientIP(r.Context(), r)))
+		})
+	}
+}
+
+func withClientIP(ctx context.Context, r *http.Request) context.Context {
+	ip, err := iputils.GetIP(r)
+	if err != nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, model.RequestKeyClientIP, ip)
+}
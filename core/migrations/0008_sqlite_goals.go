@@ -0,0 +1,78 @@
+package migrations
+
+import (
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db/sqlite"
+)
+
+func Up0008(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration")
+	}
+
+	// Create goals table. Goals are removed alongside their website.
+	_, err = tx.Exec(`--sql
+	CREATE TABLE IF NOT EXISTS goals (
+		id TEXT PRIMARY KEY,
+		hostname TEXT NOT NULL,
+		name TEXT NOT NULL,
+		type TEXT NOT NULL,
+		pathname TEXT NOT NULL DEFAULT '',
+		event_name TEXT NOT NULL DEFAULT '',
+		event_value TEXT NOT NULL DEFAULT '',
+		date_created INTEGER NOT NULL,
+		date_updated INTEGER NOT NULL,
+		FOREIGN KEY(hostname) REFERENCES websites(hostname) ON UPDATE CASCADE ON DELETE CASCADE
+	)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create goals table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create goals table")
+	}
+
+	_, err = tx.Exec(`--sql
+	CREATE INDEX IF NOT EXISTS idx_goals_hostname ON goals(hostname)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create goals hostname index",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create goals hostname index")
+	}
+
+	return tx.Commit()
+}
+
+func Down0008(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration rollback")
+	}
+
+	_, err = tx.Exec(`--sql
+	DROP TABLE IF EXISTS goals`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to remove goals table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to remove goals table")
+	}
+
+	return tx.Commit()
+}
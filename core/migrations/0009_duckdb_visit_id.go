@@ -0,0 +1,54 @@
+package migrations
+
+import (
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db/duckdb"
+)
+
+func Up0009(c *duckdb.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration")
+	}
+
+	// Add optional visit_id column to group page views into visits. Existing
+	// page views are left as NULL and are treated as single page visits.
+	_, err = tx.Exec(`--sql
+	ALTER TABLE views ADD COLUMN visit_id TEXT`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to add visit_id column",
+			)
+		}
+
+		return errors.Wrap(err, "failed to add visit_id column")
+	}
+
+	return tx.Commit()
+}
+
+func Down0009(c *duckdb.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration rollback")
+	}
+
+	_, err = tx.Exec(`--sql
+	ALTER TABLE views DROP visit_id`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to drop visit_id column",
+			)
+		}
+
+		return errors.Wrap(err, "failed to drop visit_id column")
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,83 @@
+package migrations
+
+import (
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db/duckdb"
+)
+
+func Up0010(c *duckdb.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration")
+	}
+
+	// Add optional import_id columns to tag rows imported from other analytics
+	// tools so they can be identified and rolled back. Collected rows are
+	// left as NULL.
+	_, err = tx.Exec(`--sql
+	ALTER TABLE views ADD COLUMN import_id TEXT`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to add views import_id column",
+			)
+		}
+
+		return errors.Wrap(err, "failed to add views import_id column")
+	}
+
+	_, err = tx.Exec(`--sql
+	ALTER TABLE events ADD COLUMN import_id TEXT`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to add events import_id column",
+			)
+		}
+
+		return errors.Wrap(err, "failed to add events import_id column")
+	}
+
+	return tx.Commit()
+}
+
+func Down0010(c *duckdb.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration rollback")
+	}
+
+	_, err = tx.Exec(`--sql
+	ALTER TABLE events DROP import_id`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to drop events import_id column",
+			)
+		}
+
+		return errors.Wrap(err, "failed to drop events import_id column")
+	}
+
+	_, err = tx.Exec(`--sql
+	ALTER TABLE views DROP import_id`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to drop views import_id column",
+			)
+		}
+
+		return errors.Wrap(err, "failed to drop views import_id column")
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,63 @@
+package migrations
+
+import (
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db/sqlite"
+)
+
+func Up0011(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration")
+	}
+
+	// Create website deletions table. Rows are kept until the analytics data
+	// of the deleted website is purged, so interrupted purges are resumed.
+	_, err = tx.Exec(`--sql
+	CREATE TABLE IF NOT EXISTS website_deletions (
+		hostname TEXT PRIMARY KEY,
+		user_id TEXT NOT NULL,
+		views INTEGER NOT NULL DEFAULT 0,
+		events INTEGER NOT NULL DEFAULT 0,
+		deleted_views INTEGER NOT NULL DEFAULT 0,
+		deleted_events INTEGER NOT NULL DEFAULT 0,
+		date_created INTEGER NOT NULL,
+		date_updated INTEGER NOT NULL
+	)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create website deletions table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create website deletions table")
+	}
+
+	return tx.Commit()
+}
+
+func Down0011(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration rollback")
+	}
+
+	_, err = tx.Exec(`--sql
+	DROP TABLE IF EXISTS website_deletions`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to remove website deletions table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to remove website deletions table")
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db/duckdb"
+)
+
+func Up0012(c *duckdb.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration")
+	}
+
+	// Add optional original_hostname column to record the alias a page view
+	// was received on. It is left as NULL when the hit was received on the
+	// website hostname itself.
+	_, err = tx.Exec(`--sql
+	ALTER TABLE views ADD COLUMN original_hostname TEXT`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to add original_hostname column",
+			)
+		}
+
+		return errors.Wrap(err, "failed to add original_hostname column")
+	}
+
+	return tx.Commit()
+}
+
+func Down0012(c *duckdb.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration rollback")
+	}
+
+	_, err = tx.Exec(`--sql
+	ALTER TABLE views DROP original_hostname`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to drop original_hostname column",
+			)
+		}
+
+		return errors.Wrap(err, "failed to drop original_hostname column")
+	}
+
+	return tx.Commit()
+}
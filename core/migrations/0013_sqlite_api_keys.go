@@ -0,0 +1,81 @@
+package migrations
+
+import (
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db/sqlite"
+)
+
+func Up0013(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration")
+	}
+
+	// Create API keys table. Keys are removed alongside their user, and keys
+	// scoped to a website are removed alongside the website.
+	_, err = tx.Exec(`--sql
+	CREATE TABLE IF NOT EXISTS api_keys (
+		id TEXT PRIMARY KEY,
+		user_id TEXT NOT NULL,
+		name TEXT NOT NULL,
+		hash TEXT NOT NULL,
+		role TEXT NOT NULL,
+		hostname TEXT,
+		date_expires INTEGER,
+		date_last_used INTEGER,
+		date_created INTEGER NOT NULL,
+		date_updated INTEGER NOT NULL,
+		FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE,
+		FOREIGN KEY(hostname) REFERENCES websites(hostname) ON UPDATE CASCADE ON DELETE CASCADE
+	)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create api keys table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create api keys table")
+	}
+
+	_, err = tx.Exec(`--sql
+	CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys(user_id)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create api keys user index",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create api keys user index")
+	}
+
+	return tx.Commit()
+}
+
+func Down0013(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration rollback")
+	}
+
+	_, err = tx.Exec(`--sql
+	DROP TABLE IF EXISTS api_keys`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to remove api keys table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to remove api keys table")
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,84 @@
+package migrations
+
+import (
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db/sqlite"
+)
+
+func Up0014(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration")
+	}
+	defer tx.Rollback() //nolint: errcheck // Called on defer
+
+	// Add user roles. The first user becomes the owner of the instance, while
+	// any other existing users keep access to all websites as admins.
+	_, err = tx.Exec(`--sql
+	ALTER TABLE users ADD COLUMN role TEXT NOT NULL DEFAULT 'viewer'`)
+	if err != nil {
+		return errors.Wrap(err, "failed to add user role column")
+	}
+
+	_, err = tx.Exec(`--sql
+	UPDATE users SET role = CASE
+		WHEN id = (SELECT id FROM users ORDER BY date_created ASC, id ASC LIMIT 1) THEN 'owner'
+		ELSE 'admin'
+	END`)
+	if err != nil {
+		return errors.Wrap(err, "failed to set user roles")
+	}
+
+	// Create website members table granting viewers access to websites.
+	// Memberships are removed alongside their user or website.
+	_, err = tx.Exec(`--sql
+	CREATE TABLE IF NOT EXISTS website_members (
+		hostname TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		date_created INTEGER NOT NULL,
+		PRIMARY KEY (hostname, user_id),
+		FOREIGN KEY(hostname) REFERENCES websites(hostname) ON UPDATE CASCADE ON DELETE CASCADE,
+		FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+	)`)
+	if err != nil {
+		return errors.Wrap(err, "failed to create website members table")
+	}
+
+	_, err = tx.Exec(`--sql
+	CREATE INDEX IF NOT EXISTS idx_website_members_user_id ON website_members(user_id)`)
+	if err != nil {
+		return errors.Wrap(err, "failed to create website members user index")
+	}
+
+	// Users are members of the websites they added.
+	_, err = tx.Exec(`--sql
+	INSERT OR IGNORE INTO website_members (hostname, user_id, date_created)
+	SELECT hostname, user_id, date_created FROM websites`)
+	if err != nil {
+		return errors.Wrap(err, "failed to add website members")
+	}
+
+	return tx.Commit()
+}
+
+func Down0014(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration rollback")
+	}
+	defer tx.Rollback() //nolint: errcheck // Called on defer
+
+	_, err = tx.Exec(`--sql
+	DROP TABLE IF EXISTS website_members`)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove website members table")
+	}
+
+	_, err = tx.Exec(`--sql
+	ALTER TABLE users DROP COLUMN role`)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove user role column")
+	}
+
+	return tx.Commit()
+}
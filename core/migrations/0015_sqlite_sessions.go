@@ -0,0 +1,123 @@
+package migrations
+
+import (
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db/sqlite"
+)
+
+func Up0015(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration")
+	}
+
+	// Create sessions table used when sessions are persisted across restarts.
+	// Sessions are removed alongside their user.
+	_, err = tx.Exec(`--sql
+	CREATE TABLE IF NOT EXISTS sessions (
+		id TEXT PRIMARY KEY,
+		user_id TEXT NOT NULL,
+		user_agent TEXT NOT NULL DEFAULT '',
+		date_expires INTEGER NOT NULL,
+		date_created INTEGER NOT NULL,
+		FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+	)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create sessions table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create sessions table")
+	}
+
+	_, err = tx.Exec(`--sql
+	CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions(user_id)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create sessions user index",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create sessions user index")
+	}
+
+	_, err = tx.Exec(`--sql
+	CREATE INDEX IF NOT EXISTS idx_sessions_date_expires ON sessions(date_expires)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create sessions expiry index",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create sessions expiry index")
+	}
+
+	// Create secrets table storing generated key material, such as the key
+	// encrypting session cookies, so it survives restarts.
+	_, err = tx.Exec(`--sql
+	CREATE TABLE IF NOT EXISTS secrets (
+		name TEXT PRIMARY KEY,
+		value BLOB NOT NULL,
+		date_created INTEGER NOT NULL
+	)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create secrets table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create secrets table")
+	}
+
+	return tx.Commit()
+}
+
+func Down0015(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration rollback")
+	}
+
+	_, err = tx.Exec(`--sql
+	DROP TABLE IF EXISTS secrets`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to remove secrets table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to remove secrets table")
+	}
+
+	_, err = tx.Exec(`--sql
+	DROP TABLE IF EXISTS sessions`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to remove sessions table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to remove sessions table")
+	}
+
+	return tx.Commit()
+}
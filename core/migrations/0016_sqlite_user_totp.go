@@ -0,0 +1,98 @@
+package migrations
+
+import (
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db/sqlite"
+)
+
+func Up0016(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration")
+	}
+
+	// Create two-factor authentication table. The TOTP secret is stored
+	// encrypted and removed alongside the user.
+	_, err = tx.Exec(`--sql
+	CREATE TABLE IF NOT EXISTS user_totp (
+		user_id TEXT PRIMARY KEY,
+		secret BLOB NOT NULL,
+		enabled INTEGER NOT NULL DEFAULT 0,
+		last_step INTEGER NOT NULL DEFAULT 0,
+		date_created INTEGER NOT NULL,
+		date_updated INTEGER NOT NULL,
+		FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+	)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create user totp table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create user totp table")
+	}
+
+	// Create recovery codes table. Only the hash of each code is stored.
+	_, err = tx.Exec(`--sql
+	CREATE TABLE IF NOT EXISTS recovery_codes (
+		user_id TEXT NOT NULL,
+		hash TEXT NOT NULL,
+		date_used INTEGER,
+		date_created INTEGER NOT NULL,
+		PRIMARY KEY (user_id, hash),
+		FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+	)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create recovery codes table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create recovery codes table")
+	}
+
+	return tx.Commit()
+}
+
+func Down0016(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration rollback")
+	}
+
+	_, err = tx.Exec(`--sql
+	DROP TABLE IF EXISTS recovery_codes`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to remove recovery codes table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to remove recovery codes table")
+	}
+
+	_, err = tx.Exec(`--sql
+	DROP TABLE IF EXISTS user_totp`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to remove user totp table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to remove user totp table")
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,75 @@
+package migrations
+
+import (
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db/sqlite"
+)
+
+func Up0017(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration")
+	}
+
+	// Create user identities table linking users to their account at an
+	// OpenID Connect identity provider.
+	_, err = tx.Exec(`--sql
+	CREATE TABLE IF NOT EXISTS user_identities (
+		issuer TEXT NOT NULL,
+		subject TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		date_created INTEGER NOT NULL,
+		PRIMARY KEY (issuer, subject),
+		FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+	)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create user identities table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create user identities table")
+	}
+
+	_, err = tx.Exec(`--sql
+	CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON user_identities(user_id)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create user identities index",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create user identities index")
+	}
+
+	return tx.Commit()
+}
+
+func Down0017(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration rollback")
+	}
+
+	_, err = tx.Exec(`--sql
+	DROP TABLE IF EXISTS user_identities`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to remove user identities table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to remove user identities table")
+	}
+
+	return tx.Commit()
+}
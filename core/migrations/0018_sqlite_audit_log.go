@@ -0,0 +1,104 @@
+package migrations
+
+import (
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db/sqlite"
+)
+
+func Up0018(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration")
+	}
+
+	// Create audit log table. Entries keep the actor ID and username without
+	// a foreign key, so they outlive deleted users.
+	_, err = tx.Exec(`--sql
+	CREATE TABLE IF NOT EXISTS audit_log (
+		id TEXT PRIMARY KEY,
+		actor_id TEXT NOT NULL,
+		actor_name TEXT NOT NULL,
+		api_key_id TEXT,
+		action TEXT NOT NULL,
+		target TEXT NOT NULL,
+		changes TEXT NOT NULL,
+		ip TEXT,
+		date_created INTEGER NOT NULL
+	)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create audit log table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create audit log table")
+	}
+
+	for _, index := range []string{
+		`CREATE INDEX IF NOT EXISTS idx_audit_log_date_created ON audit_log(date_created)`,
+		`CREATE INDEX IF NOT EXISTS idx_audit_log_actor_id ON audit_log(actor_id)`,
+	} {
+		_, err = tx.Exec(index)
+		if err != nil {
+			rollbackErr := tx.Rollback()
+			if rollbackErr != nil {
+				return errors.Wrap(
+					errors.Join(err, rollbackErr),
+					"failed to create audit log index",
+				)
+			}
+
+			return errors.Wrap(err, "failed to create audit log index")
+		}
+	}
+
+	// The audit log is append-only, so reject any changes to entries.
+	for _, trigger := range []string{
+		`CREATE TRIGGER IF NOT EXISTS audit_log_no_update BEFORE UPDATE ON audit_log
+		BEGIN SELECT RAISE(ABORT, 'audit log is append-only'); END`,
+		`CREATE TRIGGER IF NOT EXISTS audit_log_no_delete BEFORE DELETE ON audit_log
+		BEGIN SELECT RAISE(ABORT, 'audit log is append-only'); END`,
+	} {
+		_, err = tx.Exec(trigger)
+		if err != nil {
+			rollbackErr := tx.Rollback()
+			if rollbackErr != nil {
+				return errors.Wrap(
+					errors.Join(err, rollbackErr),
+					"failed to create audit log trigger",
+				)
+			}
+
+			return errors.Wrap(err, "failed to create audit log trigger")
+		}
+	}
+
+	return tx.Commit()
+}
+
+func Down0018(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration rollback")
+	}
+
+	// Dropping the table also drops its indexes and triggers.
+	_, err = tx.Exec(`--sql
+	DROP TABLE IF EXISTS audit_log`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to remove audit log table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to remove audit log table")
+	}
+
+	return tx.Commit()
+}
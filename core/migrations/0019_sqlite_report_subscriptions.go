@@ -0,0 +1,80 @@
+package migrations
+
+import (
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db/sqlite"
+)
+
+func Up0019(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration")
+	}
+
+	// Create report subscriptions table. Subscriptions are removed alongside
+	// their user or website.
+	_, err = tx.Exec(`--sql
+	CREATE TABLE IF NOT EXISTS report_subscriptions (
+		id TEXT PRIMARY KEY,
+		user_id TEXT NOT NULL,
+		hostname TEXT NOT NULL,
+		email TEXT NOT NULL,
+		frequency TEXT NOT NULL,
+		stats TEXT NOT NULL,
+		date_last_sent INTEGER NOT NULL,
+		date_created INTEGER NOT NULL,
+		date_updated INTEGER NOT NULL,
+		FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE,
+		FOREIGN KEY(hostname) REFERENCES websites(hostname) ON UPDATE CASCADE ON DELETE CASCADE
+	)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create report subscriptions table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create report subscriptions table")
+	}
+
+	_, err = tx.Exec(`--sql
+	CREATE INDEX IF NOT EXISTS idx_report_subscriptions_user_id ON report_subscriptions(user_id)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create report subscriptions user index",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create report subscriptions user index")
+	}
+
+	return tx.Commit()
+}
+
+func Down0019(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration rollback")
+	}
+
+	_, err = tx.Exec(`--sql
+	DROP TABLE IF EXISTS report_subscriptions`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to remove report subscriptions table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to remove report subscriptions table")
+	}
+
+	return tx.Commit()
+}
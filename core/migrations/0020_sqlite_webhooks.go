@@ -0,0 +1,137 @@
+package migrations
+
+import (
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db/sqlite"
+)
+
+func Up0020(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration")
+	}
+
+	// Create webhooks table. Webhooks are removed alongside their user or
+	// website.
+	_, err = tx.Exec(`--sql
+	CREATE TABLE IF NOT EXISTS webhooks (
+		id TEXT PRIMARY KEY,
+		user_id TEXT NOT NULL,
+		hostname TEXT NOT NULL,
+		url TEXT NOT NULL,
+		secret TEXT NOT NULL,
+		trigger_type TEXT NOT NULL,
+		event_name TEXT NOT NULL DEFAULT '',
+		threshold INTEGER NOT NULL DEFAULT 0,
+		date_last_checked INTEGER NOT NULL,
+		date_last_triggered INTEGER NOT NULL DEFAULT 0,
+		date_created INTEGER NOT NULL,
+		date_updated INTEGER NOT NULL,
+		FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE,
+		FOREIGN KEY(hostname) REFERENCES websites(hostname) ON UPDATE CASCADE ON DELETE CASCADE
+	)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create webhooks table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create webhooks table")
+	}
+
+	_, err = tx.Exec(`--sql
+	CREATE INDEX IF NOT EXISTS idx_webhooks_hostname ON webhooks(hostname)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create webhooks hostname index",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create webhooks hostname index")
+	}
+
+	// Create webhook delivery log table.
+	_, err = tx.Exec(`--sql
+	CREATE TABLE IF NOT EXISTS webhook_deliveries (
+		id TEXT PRIMARY KEY,
+		webhook_id TEXT NOT NULL,
+		trigger_type TEXT NOT NULL,
+		payload TEXT NOT NULL,
+		status_code INTEGER NOT NULL,
+		attempts INTEGER NOT NULL,
+		error TEXT NOT NULL DEFAULT '',
+		date_created INTEGER NOT NULL,
+		date_completed INTEGER NOT NULL,
+		FOREIGN KEY(webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE
+	)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create webhook deliveries table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create webhook deliveries table")
+	}
+
+	_, err = tx.Exec(`--sql
+	CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook_id ON webhook_deliveries(webhook_id, date_created)`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to create webhook deliveries index",
+			)
+		}
+
+		return errors.Wrap(err, "failed to create webhook deliveries index")
+	}
+
+	return tx.Commit()
+}
+
+func Down0020(c *sqlite.Client) error {
+	tx, err := c.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin migration rollback")
+	}
+
+	_, err = tx.Exec(`--sql
+	DROP TABLE IF EXISTS webhook_deliveries`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to remove webhook deliveries table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to remove webhook deliveries table")
+	}
+
+	_, err = tx.Exec(`--sql
+	DROP TABLE IF EXISTS webhooks`)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil {
+			return errors.Wrap(
+				errors.Join(err, rollbackErr),
+				"failed to remove webhooks table",
+			)
+		}
+
+		return errors.Wrap(err, "failed to remove webhooks table")
+	}
+
+	return tx.Commit()
+}
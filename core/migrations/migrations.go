@@ -3,6 +3,7 @@ package migrations
 import (
 	"context"
 	"database/sql"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-faster/errors"
@@ -34,6 +35,9 @@ type Service struct {
 	duckdbMigrations []*Migration[duckdb.Client]
 	sqlite           *sqlite.Client
 	sqliteMigrations []*Migration[sqlite.Client]
+
+	// completed is set once AutoMigrate has succeeded.
+	completed atomic.Bool
 }
 
 // CreateMigrationsTable creates the migrations table.
@@ -55,6 +59,16 @@ func NewMigrationsService(ctx context.Context, sqliteC *sqlite.Client, duckdbC *
 		{ID: 1, Name: "0001_sqlite_schema.go", Type: SQLite, Up: Up0001, Down: Down0001},
 		{ID: 6, Name: "0006_sqlite_settings.go", Type: SQLite, Up: Up0006, Down: Down0006},
 		{ID: 7, Name: "0007_sqlite_tenant_settings.go", Type: SQLite, Up: Up0007, Down: Down0007},
+		{ID: 8, Name: "0008_sqlite_goals.go", Type: SQLite, Up: Up0008, Down: Down0008},
+		{ID: 11, Name: "0011_sqlite_website_deletions.go", Type: SQLite, Up: Up0011, Down: Down0011},
+		{ID: 13, Name: "0013_sqlite_api_keys.go", Type: SQLite, Up: Up0013, Down: Down0013},
+		{ID: 14, Name: "0014_sqlite_website_members.go", Type: SQLite, Up: Up0014, Down: Down0014},
+		{ID: 15, Name: "0015_sqlite_sessions.go", Type: SQLite, Up: Up0015, Down: Down0015},
+		{ID: 16, Name: "0016_sqlite_user_totp.go", Type: SQLite, Up: Up0016, Down: Down0016},
+		{ID: 17, Name: "0017_sqlite_user_identities.go", Type: SQLite, Up: Up0017, Down: Down0017},
+		{ID: 18, Name: "0018_sqlite_audit_log.go", Type: SQLite, Up: Up0018, Down: Down0018},
+		{ID: 19, Name: "0019_sqlite_report_subscriptions.go", Type: SQLite, Up: Up0019, Down: Down0019},
+		{ID: 20, Name: "0020_sqlite_webhooks.go", Type: SQLite, Up: Up0020, Down: Down0020},
 	}
 
 	duckdbMigrations := []*Migration[duckdb.Client]{
@@ -62,6 +76,9 @@ func NewMigrationsService(ctx context.Context, sqliteC *sqlite.Client, duckdbC *
 		{ID: 3, Name: "0003_duckdb_referrer.go", Type: DuckDB, Up: Up0003, Down: Down0003},
 		{ID: 4, Name: "0004_duckdb_events.go", Type: DuckDB, Up: Up0004, Down: Down0004},
 		{ID: 5, Name: "0005_duckdb_event_bid.go", Type: DuckDB, Up: Up0005, Down: Down0005},
+		{ID: 9, Name: "0009_duckdb_visit_id.go", Type: DuckDB, Up: Up0009, Down: Down0009},
+		{ID: 10, Name: "0010_duckdb_import_id.go", Type: DuckDB, Up: Up0010, Down: Down0010},
+		{ID: 12, Name: "0012_duckdb_original_hostname.go", Type: DuckDB, Up: Up0012, Down: Down0012},
 	}
 
 	log := logger.Get()
@@ -140,6 +157,24 @@ func runMigrator[Client sqlite.Client | duckdb.Client](ctx context.Context, sqli
 
 // AutoMigrate automatically migrates the schema, to keep your schema update to date.
 func (s *Service) AutoMigrate(ctx context.Context) error {
+	err := s.migrate(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.completed.Store(true)
+
+	return nil
+}
+
+// Completed reports whether AutoMigrate has succeeded.
+func (s *Service) Completed() bool {
+	return s.completed.Load()
+}
+
+// migrate runs all pending migrations and creates the default admin user if
+// there are no users.
+func (s *Service) migrate(ctx context.Context) error {
 	// SQLite
 	err := runMigrator(ctx, s.sqlite, s.sqlite, s.sqliteMigrations)
 	if err != nil {
@@ -194,7 +229,15 @@ func (s *Service) AutoMigrate(ctx context.Context) error {
 
 	dateCreated := time.Now().Unix()
 	dateUpdated := dateCreated
-	err = s.sqlite.CreateUser(ctx, model.NewUser(id, "admin", pwdHash, model.NewDefaultUserSettings(), dateCreated, dateUpdated))
+	err = s.sqlite.CreateUser(ctx, model.NewUser(
+		id,
+		"admin",
+		pwdHash,
+		model.UserRoleOwner,
+		model.NewDefaultUserSettings(),
+		dateCreated,
+		dateUpdated,
+	))
 	if err != nil {
 		return err
 	}
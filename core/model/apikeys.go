@@ -0,0 +1,92 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// APIKeyRole is the rights granted to an API key.
+type APIKeyRole string
+
+const (
+	// APIKeyRoleRead allows reading websites and stats.
+	APIKeyRoleRead APIKeyRole = "read"
+	// APIKeyRoleAdmin additionally allows managing websites and goals.
+	APIKeyRoleAdmin APIKeyRole = "admin"
+
+	// APIKeyPrefix is the typeid prefix of API key IDs.
+	APIKeyPrefix = "key"
+	// APIKeyVerifiedDuration is how long a verified API key is cached before
+	// its secret is hashed again.
+	APIKeyVerifiedDuration = 5 * time.Minute
+	// APIKeyLastUsedInterval is the minimum interval between updates of the
+	// last used date of an API key.
+	APIKeyLastUsedInterval = time.Minute
+)
+
+// APIKey grants programmatic access to the API on behalf of a user. Only the
+// hash of the secret is stored.
+type APIKey struct {
+	ID     string     `db:"id"      json:"id"`
+	UserID string     `db:"user_id" json:"user_id"`
+	Name   string     `db:"name"    json:"name"`
+	Hash   string     `db:"hash"    json:"-"`
+	Role   APIKeyRole `db:"role"    json:"role"`
+	// Hostname restricts the key to a single website if set.
+	Hostname string `db:"hostname" json:"hostname"`
+
+	// DateExpires and DateLastUsed are zero if the key never expires or has
+	// not been used yet.
+	DateExpires  int64 `db:"date_expires"   json:"date_expires"`
+	DateLastUsed int64 `db:"date_last_used" json:"date_last_used"`
+	DateCreated  int64 `db:"date_created"   json:"date_created"`
+	DateUpdated  int64 `db:"date_updated"   json:"date_updated"`
+}
+
+// NewAPIKey returns a new instance of APIKey with the given values.
+func NewAPIKey(
+	id string,
+	userID string,
+	name string,
+	hash string,
+	role APIKeyRole,
+	hostname string,
+	dateExpires int64,
+	dateCreated int64,
+	dateUpdated int64,
+) *APIKey {
+	return &APIKey{
+		ID:       id,
+		UserID:   userID,
+		Name:     name,
+		Hash:     hash,
+		Role:     role,
+		Hostname: hostname,
+
+		DateExpires: dateExpires,
+		DateCreated: dateCreated,
+		DateUpdated: dateUpdated,
+	}
+}
+
+// IsExpired reports whether the key has expired at the given time.
+func (k *APIKey) IsExpired(now time.Time) bool {
+	return k.DateExpires != 0 && now.Unix() >= k.DateExpires
+}
+
+// HasRoles reports whether the key is granted all of the given roles. Admin
+// keys are granted every role.
+func (k *APIKey) HasRoles(roles []string) bool {
+	if k.Role == APIKeyRoleAdmin {
+		return true
+	}
+
+	return !slices.ContainsFunc(roles, func(role string) bool {
+		return APIKeyRole(role) != k.Role
+	})
+}
+
+// CanAccessWebsite reports whether the key is allowed to access the website.
+func (k *APIKey) CanAccessWebsite(hostname string) bool {
+	return k.Hostname == "" || k.Hostname == hostname
+}
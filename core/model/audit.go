@@ -0,0 +1,78 @@
+package model
+
+// AuditAction is an administrative action recorded in the audit log.
+type AuditAction string
+
+const (
+	AuditWebsiteCreate AuditAction = "website.create"
+	AuditWebsiteUpdate AuditAction = "website.update"
+	AuditWebsiteDelete AuditAction = "website.delete"
+
+	AuditUserCreate AuditAction = "user.create"
+	AuditUserUpdate AuditAction = "user.update"
+	AuditUserDelete AuditAction = "user.delete"
+
+	AuditTOTPEnable  AuditAction = "totp.enable"
+	AuditTOTPDisable AuditAction = "totp.disable"
+
+	AuditAPIKeyCreate AuditAction = "api_key.create"
+	AuditAPIKeyDelete AuditAction = "api_key.delete"
+
+	AuditWebhookCreate AuditAction = "webhook.create"
+	AuditWebhookDelete AuditAction = "webhook.delete"
+
+	AuditSettingsUpdate AuditAction = "settings.update"
+	AuditLockoutClear   AuditAction = "lockout.clear"
+
+	// AuditTargetTenant is the target of actions on the tenant settings.
+	AuditTargetTenant = "tenant"
+	// AuditPrefix is the typeid prefix of audit log entry IDs.
+	AuditPrefix = "audit"
+	// AuditRedacted replaces secret values, such as passwords, in changes.
+	AuditRedacted = "[redacted]"
+	// AuditDefaultLimit is the number of entries listed when no limit is
+	// given.
+	AuditDefaultLimit = 50
+)
+
+// AuditChange is the value of a field before and after an action. Before is
+// empty for created fields and after is empty for removed fields.
+type AuditChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// AuditEntry records who performed an administrative action, on what and
+// from where. Entries are never updated or deleted.
+type AuditEntry struct {
+	ID string `db:"id"`
+	// ActorID is the user who performed the action, and ActorName their
+	// username at the time, so the entry stays readable after the user is
+	// deleted. APIKeyID is set if the action was performed with an API key.
+	ActorID   string      `db:"actor_id"`
+	ActorName string      `db:"actor_name"`
+	APIKeyID  string      `db:"api_key_id"`
+	Action    AuditAction `db:"action"`
+	// Target is the website, user or other resource the action was
+	// performed on.
+	Target  string        `db:"target"`
+	Changes []AuditChange `db:"-"`
+	IP      string        `db:"ip"`
+
+	DateCreated int64 `db:"date_created"`
+}
+
+// AuditFilter filters and paginates the audit log. Empty fields match all
+// entries.
+type AuditFilter struct {
+	ActorID string
+	Action  AuditAction
+	Target  string
+	// DateStart and DateEnd are unix timestamps.
+	DateStart int64
+	DateEnd   int64
+
+	Limit  int
+	Offset int
+}
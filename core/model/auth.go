@@ -7,6 +7,12 @@ type ContextKey string
 const (
 	// ContextKeyUserID is the key used to store the user ID in the context.
 	ContextKeyUserID ContextKey = "userID"
+	// ContextKeyAPIKey is the key used to store the API key of requests
+	// authenticated with a bearer token in the context.
+	ContextKeyAPIKey ContextKey = "apiKey"
+	// ContextKeySessionID is the key used to store the session ID of requests
+	// authenticated with a session cookie in the context.
+	ContextKeySessionID ContextKey = "sessionID"
 	// SessionCookieName is the name of the session cookie.
 	SessionCookieName = "_me_sess"
 	// SessionDuration is the duration of a session.
@@ -18,6 +18,38 @@ var (
 	ErrInvalidSession = errors.New("invalid session")
 	// ErrSessionNotFound is returned when a session is not found.
 	ErrSessionNotFound = errors.New("session not found")
+	// ErrAPIKeyNotFound is returned when an API key is not found.
+	ErrAPIKeyNotFound = errors.New("api key not found")
+	// ErrInvalidAPIKey is returned when an API key is malformed, revoked or expired.
+	ErrInvalidAPIKey = errors.New("invalid api key")
+	// ErrAPIKeyForbidden is returned when an API key does not have the role required by an operation.
+	ErrAPIKeyForbidden = errors.New("api key does not have permission for this operation")
+	// ErrInvalidAPIKeyExpiry is returned when an API key expires in the past.
+	ErrInvalidAPIKeyExpiry = errors.New("api key expiry date must be in the future")
+	// ErrInvalidPassword is returned when a password does not match.
+	ErrInvalidPassword = errors.New("invalid password")
+	// ErrInvalidLoginChallenge is returned when a two-factor login challenge is invalid or expired.
+	ErrInvalidLoginChallenge = errors.New("invalid or expired login challenge")
+	// ErrInvalidTOTPCode is returned when a two-factor authentication code is invalid or already used.
+	ErrInvalidTOTPCode = errors.New("invalid two-factor authentication code")
+	// ErrTOTPNotFound is returned when a user has not started two-factor enrolment.
+	ErrTOTPNotFound = errors.New("two-factor authentication is not set up")
+	// ErrTOTPEnabled is returned when two-factor authentication is already enabled.
+	ErrTOTPEnabled = errors.New("two-factor authentication is already enabled")
+	// ErrTOTPNotConfigured is returned when using two-factor authentication without a configured TOTP secret.
+	ErrTOTPNotConfigured = errors.New("two-factor authentication is not configured")
+	// ErrPasswordLoginDisabled is returned when logging in with a password while only single sign-on is allowed.
+	ErrPasswordLoginDisabled = errors.New("password login is disabled")
+	// ErrSSONotConfigured is returned when single sign-on is used without an identity provider.
+	ErrSSONotConfigured = errors.New("single sign-on is not configured")
+	// ErrInvalidSSOLogin is returned when a single sign-on login is invalid or expired.
+	ErrInvalidSSOLogin = errors.New("invalid or expired single sign-on login")
+	// ErrLoginLocked is returned when logging in after too many failed logins.
+	ErrLoginLocked = errors.New("too many failed logins, try again later")
+	// ErrLockoutNotFound is returned when clearing a username or ip address that is not locked out.
+	ErrLockoutNotFound = errors.New("login lockout not found")
+	// ErrInvalidLockout is returned when clearing a lockout without a valid username or ip address.
+	ErrInvalidLockout = errors.New("a username or valid ip address is required")
 
 	// Events
 	// ErrInvalidProperties is returned when a given custom property is invalid.
@@ -35,6 +67,51 @@ var (
 	// ErrInvalidFilterOperation is returned when a filter operation is invalid.
 	ErrInvalidFilterOperation = errors.New("invalid filter operation")
 
+	// Export
+	// ErrInvalidExportFormat is returned when an export format is not supported.
+	ErrInvalidExportFormat = errors.New("invalid export format, must be one of parquet, csv or ndjson")
+	// ErrAnalyticsDatabaseInUse is returned when the analytics database is held
+	// open by a running server.
+	ErrAnalyticsDatabaseInUse = errors.New("analytics database is in use, stop the server before exporting")
+
+	// Import
+	// ErrInvalidImportSource is returned when an import source is not supported.
+	ErrInvalidImportSource = errors.New("invalid import source, must be one of plausible, umami or ga")
+
+	// Funnels
+	// ErrInvalidFunnelStep is returned when a funnel step is not a valid pathname or event step.
+	ErrInvalidFunnelStep = errors.New("invalid funnel step")
+
+	// Goals
+	// ErrGoalNotFound is returned when a goal is not found.
+	ErrGoalNotFound = errors.New("goal not found")
+	// ErrInvalidGoal is returned when a goal is missing the fields required by its type.
+	ErrInvalidGoal = errors.New("invalid goal")
+
+	// Realtime
+	// ErrInvalidRealtimeWindow is returned when the realtime period is out of range.
+	ErrInvalidRealtimeWindow = errors.New("invalid realtime minutes, must be between 1 and 30")
+
+	// Anomalies
+	// ErrInvalidAnomalyInterval is returned when anomalies are requested for an interval without a seasonal baseline.
+	ErrInvalidAnomalyInterval = errors.New("anomalies are not supported for monthly intervals")
+
+	// Reports
+	// ErrReportNotFound is returned when a report subscription is not found.
+	ErrReportNotFound = errors.New("report subscription not found")
+	// ErrInvalidReportEmail is returned when the recipient of a report is not a valid email address.
+	ErrInvalidReportEmail = errors.New("invalid report email address")
+	// ErrInvalidReportStats is returned when a report includes no or unknown stats.
+	ErrInvalidReportStats = errors.New("invalid report stats")
+
+	// Webhooks
+	// ErrWebhookNotFound is returned when a webhook is not found.
+	ErrWebhookNotFound = errors.New("webhook not found")
+	// ErrInvalidWebhookURL is returned when a webhook URL is not an absolute http or https URL.
+	ErrInvalidWebhookURL = errors.New("invalid webhook url")
+	// ErrInvalidWebhook is returned when a webhook is missing the fields required by its trigger.
+	ErrInvalidWebhook = errors.New("invalid webhook trigger")
+
 	// Users
 	// ErrSettingNotFound is returned when a setting is not found.
 	ErrSettingNotFound = errors.New("setting not found")
@@ -42,14 +119,26 @@ var (
 	ErrUserExists = errors.New("user already exists")
 	// ErrUserInvalidLanguage is returned when a user has an invalid language.
 	ErrUserInvalidLanguage = errors.New("invalid user language selection")
-	// ErrUserMax is returned when the maximum number of users has been reached.
-	ErrUserMax = errors.New("maximum number of users reached (1)")
 	// ErrUserNotFound is returned when a user is not found.
 	ErrUserNotFound = errors.New("user not found")
+	// ErrUserForbidden is returned when the role of a user does not allow an operation.
+	ErrUserForbidden = errors.New("user does not have permission for this operation")
+	// ErrUserOwner is returned when trying to delete the owner or change their role.
+	ErrUserOwner = errors.New("the owner can not be deleted or change role")
+	// ErrUserHasWebsites is returned when deleting a user whose websites can not be transferred.
+	ErrUserHasWebsites = errors.New("user can not be deleted while they own websites")
 
 	// Websites
 	// ErrWebsiteExists is returned when a website already exists.
 	ErrWebsiteExists = errors.New("website already exists")
 	// ErrWebsiteNotFound is returned when a website is not found.
 	ErrWebsiteNotFound = errors.New("website not found")
+	// ErrWebsiteDeletionNotFound is returned when there is no pending deletion for a website.
+	ErrWebsiteDeletionNotFound = errors.New("website deletion not found")
+	// ErrWebsiteDeletionPending is returned when the data of a deleted website is still being purged.
+	ErrWebsiteDeletionPending = errors.New("website data is still being deleted")
+	// ErrInvalidAlias is returned when a website alias is not a valid hostname or wildcard pattern.
+	ErrInvalidAlias = errors.New("invalid website alias")
+	// ErrAliasExists is returned when an alias is already a website or an alias of another website.
+	ErrAliasExists = errors.New("website alias already exists")
 )
@@ -1,10 +1,15 @@
 package model
 
+import "time"
+
 type RequestKey string
 
 const (
 	// RequestKeyBody is the key used to store the request in the context.
 	RequestKeyBody RequestKey = "request"
+	// RequestKeyClientIP is the key used to store the client IP address of
+	// the request in the context.
+	RequestKeyClientIP RequestKey = "clientIP"
 )
 
 type EventHit struct {
@@ -25,9 +30,15 @@ type PageViewHit struct {
 	// Beacon ID - Used to determine if multiple event types are
 	// associated with a single page view.
 	BID string `db:"bid"`
+	// VisitID - Groups consecutive page views from the same visitor into a
+	// single visit. Empty if the visit could not be determined.
+	VisitID string `db:"visit_id"`
 
 	// Hostname - The hostname of the page view.
 	Hostname string `db:"hostname"`
+	// OriginalHostname - The alias the page view was received on if it
+	// differs from the website hostname, e.g. www.example.com.
+	OriginalHostname string `db:"original_hostname"`
 	// Pathname - The pathname of the associated URL linked to the page view.
 	Pathname string `db:"pathname"`
 
@@ -61,6 +72,20 @@ type PageViewHit struct {
 	UTMCampaign string `db:"utm_campaign"`
 }
 
+// RealtimeHit is a page view published to realtime subscribers of a website.
+type RealtimeHit struct {
+	// VisitID - The visit the page view belongs to. Falls back to the beacon ID
+	// if the visit could not be determined.
+	VisitID string
+	// Pathname - The pathname of the page view.
+	Pathname string
+	// Referrer - The referrer group of the page view, or the referrer host if
+	// it does not belong to a group.
+	Referrer string
+	// Time - When the page view was received.
+	Time time.Time
+}
+
 type PageViewDuration struct {
 	// Beacon ID - Used to determine if multiple event types are
 	// associated with a single page view.
@@ -0,0 +1,14 @@
+package model
+
+// FunnelStep is a single step of a funnel. Steps match page views and custom
+// events the same way as goals.
+type FunnelStep struct {
+	Type GoalType
+
+	// Pathname is a glob pattern used by pathname steps.
+	Pathname string
+	// EventName and EventValue match custom event properties. An empty
+	// EventValue matches any value.
+	EventName  string
+	EventValue string
+}
@@ -0,0 +1,73 @@
+package model
+
+// GoalType is the matching strategy of a conversion goal.
+type GoalType string
+
+const (
+	// GoalTypePathname matches page views against a pathname glob pattern.
+	GoalTypePathname GoalType = "pathname"
+	// GoalTypeEvent matches custom event properties by name and optional value.
+	GoalTypeEvent GoalType = "event"
+)
+
+type Goal struct {
+	ID       string   `db:"id"       json:"id"`
+	Hostname string   `db:"hostname" json:"hostname"`
+	Name     string   `db:"name"     json:"name"`
+	Type     GoalType `db:"type"     json:"type"`
+
+	// Pathname is a glob pattern (e.g. /checkout/*) used by pathname goals.
+	Pathname string `db:"pathname" json:"pathname"`
+	// EventName and EventValue match custom event properties. An empty
+	// EventValue matches any value.
+	EventName  string `db:"event_name"  json:"event_name"`
+	EventValue string `db:"event_value" json:"event_value"`
+
+	DateCreated int64 `db:"date_created" json:"date_created"`
+	DateUpdated int64 `db:"date_updated" json:"date_updated"`
+}
+
+// NewGoal returns a new instance of Goal with the given values.
+func NewGoal(
+	id string,
+	hostname string,
+	name string,
+	goalType GoalType,
+	pathname string,
+	eventName string,
+	eventValue string,
+	dateCreated int64,
+	dateUpdated int64,
+) *Goal {
+	return &Goal{
+		ID:       id,
+		Hostname: hostname,
+		Name:     name,
+		Type:     goalType,
+
+		Pathname:   pathname,
+		EventName:  eventName,
+		EventValue: eventValue,
+
+		DateCreated: dateCreated,
+		DateUpdated: dateUpdated,
+	}
+}
+
+// Validate checks the goal has the fields required by its type.
+func (g *Goal) Validate() error {
+	switch g.Type {
+	case GoalTypePathname:
+		if g.Pathname == "" {
+			return ErrInvalidGoal
+		}
+	case GoalTypeEvent:
+		if g.EventName == "" {
+			return ErrInvalidGoal
+		}
+	default:
+		return ErrInvalidGoal
+	}
+
+	return nil
+}
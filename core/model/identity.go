@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+const (
+	// OIDCStateCookieName is the name of the cookie binding a single sign-on
+	// login to the browser that started it.
+	OIDCStateCookieName = "_me_oidc"
+	// OIDCLoginDuration is how long a user has to log in at the identity
+	// provider.
+	OIDCLoginDuration = 10 * time.Minute
+)
+
+// UserIdentity links a user to their account at an OpenID Connect identity
+// provider.
+type UserIdentity struct {
+	Issuer      string `db:"issuer"`
+	Subject     string `db:"subject"`
+	UserID      string `db:"user_id"`
+	DateCreated int64  `db:"date_created"`
+}
+
+// NewUserIdentity returns a new instance of UserIdentity with the given values.
+func NewUserIdentity(issuer string, subject string, userID string, dateCreated int64) *UserIdentity {
+	return &UserIdentity{
+		Issuer:      issuer,
+		Subject:     subject,
+		UserID:      userID,
+		DateCreated: dateCreated,
+	}
+}
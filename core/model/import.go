@@ -0,0 +1,39 @@
+package model
+
+import "time"
+
+// ImportedPageView is a page view imported from another analytics tool.
+type ImportedPageView struct {
+	PageViewHit
+
+	// DurationMs - How long the user was on the page in milliseconds. Zero if
+	// the duration is not known.
+	DurationMs int `db:"duration_ms"`
+	// DateCreated - When the page view was recorded by the original tool.
+	DateCreated time.Time `db:"date_created"`
+}
+
+// ImportedEvent is a custom event property imported from another analytics
+// tool.
+type ImportedEvent struct {
+	EventHit
+
+	// DateCreated - When the event was recorded by the original tool.
+	DateCreated time.Time `db:"date_created"`
+}
+
+// Import summarises the rows tagged with the same import ID.
+type Import struct {
+	// ID - The import ID the rows are tagged with.
+	ID string `db:"import_id"`
+	// Hostname - The website the rows were imported into.
+	Hostname string `db:"hostname"`
+	// Views - The number of imported page views.
+	Views int `db:"views"`
+	// Events - The number of imported custom event properties.
+	Events int `db:"events"`
+	// Start - The date of the earliest imported page view.
+	Start time.Time `db:"start"`
+	// End - The date of the latest imported page view.
+	End time.Time `db:"end"`
+}
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// ReportFrequency is how often an email report is sent.
+type ReportFrequency string
+
+const (
+	ReportFrequencyDaily   ReportFrequency = "daily"
+	ReportFrequencyWeekly  ReportFrequency = "weekly"
+	ReportFrequencyMonthly ReportFrequency = "monthly"
+)
+
+// ReportStat is a section of stats included in an email report.
+type ReportStat string
+
+const (
+	ReportStatSummary   ReportStat = "summary"
+	ReportStatPages     ReportStat = "pages"
+	ReportStatReferrers ReportStat = "referrers"
+
+	// ReportPrefix is the typeid prefix of report subscription IDs.
+	ReportPrefix = "report"
+	// ReportTopLimit is the number of rows listed for top pages and
+	// referrers.
+	ReportTopLimit = 10
+)
+
+// Period returns the period covered by a report sent at the given time. This
+// is the last full day, week starting on Monday or month in UTC.
+func (f ReportFrequency) Period(now time.Time) (time.Time, time.Time) {
+	now = now.UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch f {
+	case ReportFrequencyWeekly:
+		// Weekdays start on Sunday, so Monday is 1.
+		end := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		return end.AddDate(0, 0, -7), end
+	case ReportFrequencyMonthly:
+		end := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return end.AddDate(0, -1, 0), end
+	default:
+		return day.AddDate(0, 0, -1), day
+	}
+}
+
+// ReportSubscription is a report of website stats a user receives by email.
+type ReportSubscription struct {
+	ID        string          `db:"id"`
+	UserID    string          `db:"user_id"`
+	Hostname  string          `db:"hostname"`
+	Email     string          `db:"email"`
+	Frequency ReportFrequency `db:"frequency"`
+	Stats     []ReportStat    `db:"-"`
+
+	// DateLastSent is the end of the period of the last sent report, or the
+	// creation date if no report has been sent yet.
+	DateLastSent int64 `db:"date_last_sent"`
+	DateCreated  int64 `db:"date_created"`
+	DateUpdated  int64 `db:"date_updated"`
+}
+
+// NewReportSubscription returns a new instance of ReportSubscription with the
+// given values. The first report is sent at the end of the current period.
+func NewReportSubscription(
+	id string,
+	userID string,
+	hostname string,
+	email string,
+	frequency ReportFrequency,
+	stats []ReportStat,
+	dateCreated int64,
+	dateUpdated int64,
+) *ReportSubscription {
+	return &ReportSubscription{
+		ID:        id,
+		UserID:    userID,
+		Hostname:  hostname,
+		Email:     email,
+		Frequency: frequency,
+		Stats:     stats,
+
+		DateLastSent: dateCreated,
+		DateCreated:  dateCreated,
+		DateUpdated:  dateUpdated,
+	}
+}
+
+// IsDue reports whether a report has not been sent for the last full period
+// at the given time.
+func (s *ReportSubscription) IsDue(now time.Time) bool {
+	_, end := s.Frequency.Period(now)
+
+	return s.DateLastSent < end.Unix()
+}
+
+// HasStat reports whether the report includes the stat.
+func (s *ReportSubscription) HasStat(stat ReportStat) bool {
+	return slices.Contains(s.Stats, stat)
+}
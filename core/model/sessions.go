@@ -0,0 +1,45 @@
+package model
+
+import "time"
+
+const (
+	// SessionPrefix is the typeid prefix of session IDs.
+	SessionPrefix = "sess"
+	// SessionKeySecret is the name of the persisted secret used to encrypt
+	// session cookies when no session secret is configured.
+	SessionKeySecret = "session_key"
+)
+
+// Session is a login session of a user. The session ID is only given to the
+// browser encrypted inside the session cookie.
+type Session struct {
+	ID        string `db:"id"         json:"id"`
+	UserID    string `db:"user_id"    json:"user_id"`
+	UserAgent string `db:"user_agent" json:"user_agent"`
+
+	DateExpires int64 `db:"date_expires" json:"date_expires"`
+	DateCreated int64 `db:"date_created" json:"date_created"`
+}
+
+// NewSession returns a new instance of Session with the given values.
+func NewSession(
+	id string,
+	userID string,
+	userAgent string,
+	dateExpires int64,
+	dateCreated int64,
+) *Session {
+	return &Session{
+		ID:        id,
+		UserID:    userID,
+		UserAgent: userAgent,
+
+		DateExpires: dateExpires,
+		DateCreated: dateCreated,
+	}
+}
+
+// IsExpired reports whether the session has expired at the given time.
+func (s *Session) IsExpired(now time.Time) bool {
+	return now.Unix() >= s.DateExpires
+}
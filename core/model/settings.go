@@ -13,6 +13,8 @@ const (
 	SettingsKeyBlockTorExitNodes SettingsKey = "block_tor_exit_nodes"
 	// SettingsKeyBlockedIPs is the key for the manually blocked IPs setting.
 	SettingsKeyBlockedIPs SettingsKey = "blocked_ips"
+	// SettingsKeyRetentionDays is the key for the data retention period setting.
+	SettingsKeyRetentionDays SettingsKey = "retention_days"
 )
 
 type UserSettings struct {
@@ -28,6 +30,21 @@ type TenantSettings struct {
 	BlockAbusiveIPs   string `db:"block_abusive_ips"    json:"block_abusive_ips"`
 	BlockTorExitNodes string `db:"block_tor_exit_nodes" json:"block_tor_exit_nodes"`
 	BlockedIPs        string `db:"blocked_ips"          json:"blocked_ips"`
+
+	// Data Retention
+	// Number of days analytics data is kept for, where 0 keeps data forever.
+	RetentionDays string `db:"retention_days" json:"retention_days"`
+}
+
+type WebsiteSettings struct {
+	// Data Retention
+	// Overrides the tenant retention period for the website when set.
+	RetentionDays *int `db:"retention_days" json:"retention_days,omitempty"`
+
+	// Hostname Aliases
+	// Additional hostnames or wildcard patterns (e.g. *.vercel.app) whose
+	// hits are recorded under the website.
+	Aliases []string `db:"aliases" json:"aliases,omitempty"`
 }
 
 // NewDefaultUserSettings returns a new instance of UserSettings with default values.
@@ -44,5 +61,21 @@ func NewDefaultTenantSettings() *TenantSettings {
 		BlockAbusiveIPs:   "true",
 		BlockTorExitNodes: "true",
 		BlockedIPs:        "",
+		RetentionDays:     "0",
+	}
+}
+
+// NewDefaultWebsiteSettings returns a new instance of WebsiteSettings with default values.
+func NewDefaultWebsiteSettings() *WebsiteSettings {
+	return &WebsiteSettings{}
+}
+
+// Retention returns the number of days analytics data of the website is kept
+// for, falling back to the tenant retention period if there is no override.
+func (s *WebsiteSettings) Retention(tenantDays int) int {
+	if s == nil || s.RetentionDays == nil {
+		return tenantDays
 	}
+
+	return *s.RetentionDays
 }
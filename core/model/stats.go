@@ -31,6 +31,27 @@ type StatsIntervals struct {
 	Duration   int     `db:"duration"`
 }
 
+// AnomalyMetric is a metric of an interval checked for anomalies.
+type AnomalyMetric string
+
+const (
+	AnomalyMetricVisitors   AnomalyMetric = "visitors"
+	AnomalyMetricPageviews  AnomalyMetric = "pageviews"
+	AnomalyMetricBounceRate AnomalyMetric = "bounce_percentage"
+	AnomalyMetricDuration   AnomalyMetric = "duration"
+)
+
+// StatsAnomaly flags a metric of an interval that deviates from its baseline.
+type StatsAnomaly struct {
+	Metric AnomalyMetric
+	Value  float64
+	// Expected is the baseline value of the metric.
+	Expected float64
+	// Score is the number of deviations the value is away from the baseline.
+	// It is negative if the value is below the baseline.
+	Score float64
+}
+
 type StatsPagesSummary struct {
 	Pathname           string  `db:"pathname"`
 	Visitors           int     `db:"visitors"`
@@ -143,6 +164,18 @@ type StatsDevices struct {
 	Duration   int     `db:"duration"`
 }
 
+type StatsHostnamesSummary struct {
+	Hostname           string  `db:"hostname"`
+	Visitors           int     `db:"visitors"`
+	VisitorsPercentage float32 `db:"visitors_percentage"`
+}
+
+type StatsHostnames struct {
+	StatsHostnamesSummary
+	BounceRate float32 `db:"bounce_rate"`
+	Duration   int     `db:"duration"`
+}
+
 type StatsCountriesSummary struct {
 	Country            string  `db:"country"`
 	Visitors           int     `db:"visitors"`
@@ -173,3 +206,47 @@ type StatsCustomProperties struct {
 	Events           int     `db:"events"`
 	EventsPercentage float32 `db:"events_percentage"`
 }
+
+type StatsGoals struct {
+	ID             string  `db:"id"`
+	Name           string  `db:"name"`
+	Conversions    int     `db:"conversions"`
+	Converters     int     `db:"converters"`
+	ConversionRate float32 `db:"conversion_rate"`
+}
+
+type StatsFunnel struct {
+	Step           int     `db:"step"`
+	Visits         int     `db:"visits"`
+	ConversionRate float32 `db:"conversion_rate"`
+	Dropoff        int     `db:"dropoff"`
+	DropoffRate    float32 `db:"dropoff_rate"`
+}
+
+type StatsVisitPagesSummary struct {
+	Pathname           string  `db:"pathname"`
+	Visitors           int     `db:"visitors"`
+	VisitorsPercentage float32 `db:"visitors_percentage"`
+}
+
+type StatsVisitPages struct {
+	StatsVisitPagesSummary
+	BounceRate float32 `db:"bounce_rate"`
+	Duration   int     `db:"duration"`
+}
+
+type StatsRealtimePage struct {
+	Pathname string `db:"pathname"`
+	Visitors int    `db:"visitors"`
+}
+
+type StatsRealtimeReferrer struct {
+	Referrer string `db:"referrer"`
+	Visitors int    `db:"visitors"`
+}
+
+type StatsRealtime struct {
+	Visitors  int `db:"visitors"`
+	Pages     []StatsRealtimePage
+	Referrers []StatsRealtimeReferrer
+}
@@ -0,0 +1,43 @@
+package model
+
+import "time"
+
+const (
+	// LoginChallengeDuration is how long a user has to complete a login with
+	// two-factor authentication after entering their password.
+	LoginChallengeDuration = 5 * time.Minute
+	// LoginChallengeAttempts is the number of codes that can be tried for a
+	// login challenge before the password has to be entered again.
+	LoginChallengeAttempts = 5
+)
+
+// UserTOTP is the two-factor authentication enrolment of a user. The secret
+// is stored encrypted and two-factor authentication is only enforced once
+// enrolment has been confirmed with a code.
+type UserTOTP struct {
+	UserID  string `db:"user_id" json:"user_id"`
+	Secret  []byte `db:"secret"  json:"-"`
+	Enabled bool   `db:"enabled" json:"enabled"`
+	// LastStep is the time step of the last accepted code, so codes can not
+	// be used twice.
+	LastStep int64 `db:"last_step" json:"last_step"`
+
+	DateCreated int64 `db:"date_created" json:"date_created"`
+	DateUpdated int64 `db:"date_updated" json:"date_updated"`
+}
+
+// NewUserTOTP returns a new instance of UserTOTP with the given values.
+func NewUserTOTP(
+	userID string,
+	secret []byte,
+	dateCreated int64,
+	dateUpdated int64,
+) *UserTOTP {
+	return &UserTOTP{
+		UserID: userID,
+		Secret: secret,
+
+		DateCreated: dateCreated,
+		DateUpdated: dateUpdated,
+	}
+}
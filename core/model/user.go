@@ -1,9 +1,29 @@
 package model
 
+// UserRole is the role of a user.
+type UserRole string
+
+const (
+	// UserRoleOwner is the role of the first user of an instance, who can not
+	// be deleted.
+	UserRoleOwner UserRole = "owner"
+	// UserRoleAdmin can access and manage all websites and users.
+	UserRoleAdmin UserRole = "admin"
+	// UserRoleViewer can only read the websites they are a member of.
+	UserRoleViewer UserRole = "viewer"
+)
+
+// IsAdmin reports whether the role can access and manage all websites and
+// users.
+func (r UserRole) IsAdmin() bool {
+	return r == UserRoleOwner || r == UserRoleAdmin
+}
+
 type User struct {
-	ID       string `db:"id"       json:"id"`
-	Username string `db:"username" json:"username"`
-	Password string `db:"password" json:"password"`
+	ID       string   `db:"id"       json:"id"`
+	Username string   `db:"username" json:"username"`
+	Password string   `db:"password" json:"password"`
+	Role     UserRole `db:"role"     json:"role"`
 
 	Settings    *UserSettings `db:"settings"     json:"settings"`
 	DateCreated int64         `db:"date_created" json:"date_created"`
@@ -15,6 +35,7 @@ func NewUser(
 	id string,
 	username string,
 	password string,
+	role UserRole,
 	settings *UserSettings,
 	dateCreated int64,
 	dateUpdated int64,
@@ -23,6 +44,7 @@ func NewUser(
 		ID:       id,
 		Username: username,
 		Password: password,
+		Role:     role,
 
 		Settings:    settings,
 		DateCreated: dateCreated,
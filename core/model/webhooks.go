@@ -0,0 +1,115 @@
+package model
+
+// WebhookTrigger is the event a webhook is sent for.
+type WebhookTrigger string
+
+const (
+	// WebhookTriggerTrafficSpike fires when the visitors in the last hour
+	// reach the threshold of the webhook.
+	WebhookTriggerTrafficSpike WebhookTrigger = "traffic_spike"
+	// WebhookTriggerNewReferrer fires when a referrer sends visitors for the
+	// first time.
+	WebhookTriggerNewReferrer WebhookTrigger = "new_referrer"
+	// WebhookTriggerCustomEvent fires when a custom event property with the
+	// event name of the webhook is received.
+	WebhookTriggerCustomEvent WebhookTrigger = "custom_event"
+	// WebhookTriggerAnomaly fires when the last hour deviates from the same
+	// hour in previous weeks.
+	WebhookTriggerAnomaly WebhookTrigger = "anomaly"
+
+	// WebhookPrefix is the typeid prefix of webhook IDs.
+	WebhookPrefix = "webhook"
+	// WebhookDeliveryPrefix is the typeid prefix of webhook delivery IDs.
+	WebhookDeliveryPrefix = "delivery"
+)
+
+type Webhook struct {
+	ID       string         `db:"id"`
+	UserID   string         `db:"user_id"`
+	Hostname string         `db:"hostname"`
+	URL      string         `db:"url"`
+	Secret   string         `db:"secret"`
+	Trigger  WebhookTrigger `db:"trigger_type"`
+
+	// EventName is the custom event property matched by custom event
+	// webhooks.
+	EventName string `db:"event_name"`
+	// Threshold is the number of visitors in the last hour that fires traffic
+	// spike webhooks.
+	Threshold int `db:"threshold"`
+
+	// DateLastChecked is the end of the period last checked for new
+	// referrers or anomalies.
+	DateLastChecked int64 `db:"date_last_checked"`
+	// DateLastTriggered is when the webhook was last sent, or 0 if never.
+	DateLastTriggered int64 `db:"date_last_triggered"`
+	DateCreated       int64 `db:"date_created"`
+	DateUpdated       int64 `db:"date_updated"`
+}
+
+// NewWebhook returns a new instance of Webhook with the given values.
+func NewWebhook(
+	id string,
+	userID string,
+	hostname string,
+	url string,
+	secret string,
+	trigger WebhookTrigger,
+	eventName string,
+	threshold int,
+	dateCreated int64,
+	dateUpdated int64,
+) *Webhook {
+	return &Webhook{
+		ID:       id,
+		UserID:   userID,
+		Hostname: hostname,
+		URL:      url,
+		Secret:   secret,
+		Trigger:  trigger,
+
+		EventName: eventName,
+		Threshold: threshold,
+
+		DateLastChecked: dateCreated,
+		DateCreated:     dateCreated,
+		DateUpdated:     dateUpdated,
+	}
+}
+
+// Validate checks the webhook has the fields required by its trigger.
+func (w *Webhook) Validate() error {
+	switch w.Trigger {
+	case WebhookTriggerTrafficSpike:
+		if w.Threshold <= 0 {
+			return ErrInvalidWebhook
+		}
+	case WebhookTriggerCustomEvent:
+		if w.EventName == "" {
+			return ErrInvalidWebhook
+		}
+	case WebhookTriggerNewReferrer, WebhookTriggerAnomaly:
+	default:
+		return ErrInvalidWebhook
+	}
+
+	return nil
+}
+
+// WebhookDelivery is an entry of the delivery log of a webhook.
+type WebhookDelivery struct {
+	ID        string         `db:"id"`
+	WebhookID string         `db:"webhook_id"`
+	Trigger   WebhookTrigger `db:"trigger_type"`
+	Payload   string         `db:"payload"`
+
+	// StatusCode is the response status of the last attempt, or 0 if no
+	// response was received.
+	StatusCode int `db:"status_code"`
+	Attempts   int `db:"attempts"`
+	// Error is empty if the delivery succeeded.
+	Error string `db:"error"`
+
+	DateCreated   int64 `db:"date_created"`
+	DateCompleted int64 `db:"date_completed"`
+}
@@ -18,3 +18,29 @@ func NewWebsite(userID string, hostname string, dateCreated int64, dateUpdated i
 		DateUpdated: dateUpdated,
 	}
 }
+
+// WebsiteDeletion tracks the purge of the analytics data of a deleted website.
+type WebsiteDeletion struct {
+	Hostname string `db:"hostname" json:"hostname"`
+	UserID   string `db:"user_id"  json:"user_id"`
+
+	// Views and Events are the number of rows to delete when the deletion
+	// was queued.
+	Views  int64 `db:"views"  json:"views"`
+	Events int64 `db:"events" json:"events"`
+	// DeletedViews and DeletedEvents are the number of rows deleted so far.
+	DeletedViews  int64 `db:"deleted_views"  json:"deleted_views"`
+	DeletedEvents int64 `db:"deleted_events" json:"deleted_events"`
+
+	DateCreated int64 `db:"date_created" json:"date_created"`
+	DateUpdated int64 `db:"date_updated" json:"date_updated"`
+}
+
+// WebsiteAccess is the access to a website granted to other users and scoped
+// API keys, which is recorded when a website is merged into another.
+type WebsiteAccess struct {
+	// Members are the IDs of the users that are members of the website.
+	Members []string
+	// APIKeys are the IDs of the API keys scoped to the website.
+	APIKeys []string
+}
@@ -0,0 +1,353 @@
+// Package oidc implements single sign-on with an OpenID Connect identity
+// provider using the authorization code flow with PKCE.
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/model"
+)
+
+const (
+	// DefaultUsernameClaim is the claim used as the username of provisioned
+	// users.
+	DefaultUsernameClaim = "preferred_username"
+	// DefaultRolesClaim is the claim holding the roles or groups of a user.
+	DefaultRolesClaim = "groups"
+
+	discoveryPath = "/.well-known/openid-configuration"
+	// requestTimeout limits requests made to the identity provider.
+	requestTimeout = 10 * time.Second
+	// maxResponseSize limits responses read from the identity provider.
+	maxResponseSize = 1 << 20
+)
+
+// DefaultScopes are the scopes requested from the identity provider.
+var DefaultScopes = []string{"openid", "profile", "email"}
+
+var (
+	// ErrInvalidToken is returned when an ID token fails verification.
+	ErrInvalidToken = errors.New("oidc: invalid id token")
+	// ErrNoRole is returned when the claims of a user match no role.
+	ErrNoRole = errors.New("oidc: claims do not match a role")
+)
+
+// Config configures the identity provider and how its claims map to users.
+type Config struct {
+	// Issuer URL of the identity provider, used for discovery.
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is the callback URL registered with the identity provider.
+	RedirectURL string
+	Scopes      []string
+
+	// UsernameClaim is the claim used as the username of provisioned users.
+	UsernameClaim string
+	// RolesClaim is the claim holding the roles or groups of a user. Nested
+	// claims are separated by dots, e.g. "realm_access.roles".
+	RolesClaim string
+	// AdminRoles grant the admin role to users with any of them.
+	AdminRoles []string
+	// ViewerRoles grant the viewer role to users with any of them. If empty,
+	// all other users are viewers.
+	ViewerRoles []string
+	// AutoProvision creates users on their first login.
+	AutoProvision bool
+}
+
+// Identity is a user authenticated by the identity provider.
+type Identity struct {
+	Issuer   string
+	Subject  string
+	Username string
+	Role     model.UserRole
+}
+
+// Provider is an OpenID Connect identity provider. The provider metadata and
+// signing keys are fetched on first use, so an unreachable identity provider
+// does not stop the server from starting.
+type Provider struct {
+	config Config
+	client *http.Client
+
+	mu          sync.Mutex
+	metadata    *metadata
+	keys        map[string]any
+	keysFetched time.Time
+
+	// refreshMu serializes fetches of the signing keys.
+	refreshMu sync.Mutex
+}
+
+// metadata is the discovery document of the identity provider.
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// NewProvider returns a new identity provider with the given config.
+func NewProvider(config Config) (*Provider, error) {
+	if config.Issuer == "" || config.ClientID == "" || config.RedirectURL == "" {
+		return nil, errors.New("oidc: issuer, client id and redirect url are required")
+	}
+
+	if _, err := url.Parse(config.RedirectURL); err != nil {
+		return nil, errors.Wrap(err, "oidc: redirect url")
+	}
+
+	if len(config.Scopes) == 0 {
+		config.Scopes = DefaultScopes
+	}
+
+	if config.UsernameClaim == "" {
+		config.UsernameClaim = DefaultUsernameClaim
+	}
+
+	if config.RolesClaim == "" {
+		config.RolesClaim = DefaultRolesClaim
+	}
+
+	return &Provider{
+		config: config,
+		client: &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+// AutoProvision reports whether users are created on their first login.
+func (p *Provider) AutoProvision() bool {
+	return p.config.AutoProvision
+}
+
+// AuthCodeURL returns the URL of the identity provider to redirect the user
+// to. The state and nonce bind the callback to this login, and the verifier
+// is sent as a PKCE challenge.
+func (p *Provider) AuthCodeURL(ctx context.Context, state string, nonce string, verifier string) (string, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := url.Parse(meta.AuthorizationEndpoint)
+	if err != nil {
+		return "", errors.Wrap(err, "oidc: authorization endpoint")
+	}
+
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.config.ClientID)
+	query.Set("redirect_uri", p.config.RedirectURL)
+	query.Set("scope", strings.Join(p.config.Scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", CodeChallenge(verifier))
+	query.Set("code_challenge_method", "S256")
+	authURL.RawQuery = query.Encode()
+
+	return authURL.String(), nil
+}
+
+// Exchange redeems an authorization code and returns the identity from the
+// verified ID token.
+func (p *Provider) Exchange(ctx context.Context, code string, nonce string, verifier string) (*Identity, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.config.RedirectURL)
+	form.Set("client_id", p.config.ClientID)
+	form.Set("code_verifier", verifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, errors.Wrap(err, "oidc: token request")
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	if p.config.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+	}
+
+	var token struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+
+	status, err := p.do(req, &token)
+	if err != nil {
+		return nil, errors.Wrap(err, "oidc: token request")
+	}
+
+	if status != http.StatusOK || token.Error != "" {
+		return nil, errors.Errorf("oidc: token request: %d %s %s", status, token.Error, token.ErrorDescription)
+	}
+
+	claims, err := p.verify(ctx, token.IDToken, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.identity(meta.Issuer, claims)
+}
+
+// identity maps the claims of an ID token to a user.
+func (p *Provider) identity(issuer string, claims map[string]any) (*Identity, error) {
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, errors.Wrap(ErrInvalidToken, "missing subject")
+	}
+
+	username, _ := lookupClaim(claims, p.config.UsernameClaim).(string)
+	if username == "" {
+		return nil, errors.Errorf("oidc: missing %s claim", p.config.UsernameClaim)
+	}
+
+	role, err := p.role(claimStrings(lookupClaim(claims, p.config.RolesClaim)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Identity{
+		Issuer:   issuer,
+		Subject:  subject,
+		Username: username,
+		Role:     role,
+	}, nil
+}
+
+// role returns the role granted by the roles of a user.
+func (p *Provider) role(roles []string) (model.UserRole, error) {
+	if containsAny(roles, p.config.AdminRoles) {
+		return model.UserRoleAdmin, nil
+	}
+
+	if len(p.config.ViewerRoles) == 0 || containsAny(roles, p.config.ViewerRoles) {
+		return model.UserRoleViewer, nil
+	}
+
+	return "", ErrNoRole
+}
+
+// discover fetches the discovery document of the identity provider, caching
+// it once successful.
+func (p *Provider) discover(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(p.config.Issuer, "/")+discoveryPath, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "oidc: discovery")
+	}
+
+	var meta metadata
+
+	status, err := p.do(req, &meta)
+	if err != nil {
+		return nil, errors.Wrap(err, "oidc: discovery")
+	}
+
+	if status != http.StatusOK {
+		return nil, errors.Errorf("oidc: discovery: unexpected status %d", status)
+	}
+
+	// The issuer must match exactly to prevent mixing up identity providers.
+	if meta.Issuer != p.config.Issuer {
+		return nil, errors.Errorf("oidc: discovery: issuer %q does not match %q", meta.Issuer, p.config.Issuer)
+	}
+
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return nil, errors.New("oidc: discovery: missing endpoints")
+	}
+
+	p.metadata = &meta
+
+	return p.metadata, nil
+}
+
+// do sends a request to the identity provider and decodes the JSON response.
+func (p *Provider) do(req *http.Request, v any) (int, error) {
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	err = json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(v)
+	if err != nil && resp.StatusCode == http.StatusOK {
+		return resp.StatusCode, err
+	}
+
+	return resp.StatusCode, nil
+}
+
+// lookupClaim returns a claim, following dots into nested objects.
+func lookupClaim(claims map[string]any, name string) any {
+	if value, ok := claims[name]; ok {
+		return value
+	}
+
+	var value any = claims
+
+	for part := range strings.SplitSeq(name, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		value = object[part]
+	}
+
+	return value
+}
+
+// claimStrings returns the values of a claim holding a string or a list of
+// strings.
+func claimStrings(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+
+		return values
+	default:
+		return nil
+	}
+}
+
+func containsAny(values []string, targets []string) bool {
+	for _, value := range values {
+		if slices.Contains(targets, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,292 @@
+package oidc_test
+
+import (
+	"crypto/elliptic"
+	"maps"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newProvider(t *testing.T, idp *metest.OIDCProvider, config oidc.Config) *oidc.Provider {
+	t.Helper()
+
+	config.Issuer = idp.URL
+	config.ClientID = metest.OIDCClientID
+	config.ClientSecret = metest.OIDCClientSecret
+	config.RedirectURL = "http://localhost:8080/api/auth/oidc/callback"
+
+	provider, err := oidc.NewProvider(config)
+	require.NoError(t, err)
+
+	return provider
+}
+
+// authorize logs in at the identity provider and returns the authorization
+// code sent to the callback.
+func authorize(t *testing.T, provider *oidc.Provider, state string, nonce string, verifier string) string {
+	t.Helper()
+
+	authURL, err := provider.AuthCodeURL(t.Context(), state, nonce, verifier)
+	require.NoError(t, err)
+
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := client.Get(authURL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	callback, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+	require.Equal(t, state, callback.Query().Get("state"))
+
+	return callback.Query().Get("code")
+}
+
+func TestExchange(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	idp := metest.NewOIDCProvider(t)
+	idp.SetClaims(map[string]any{
+		"sub":                "user-1",
+		"preferred_username": "alice",
+		"groups":             []string{"staff", "medama-admins"},
+	})
+
+	provider := newProvider(t, idp, oidc.Config{AdminRoles: []string{"medama-admins"}})
+
+	verifier, err := oidc.GenerateToken()
+	require.NoError(err)
+
+	code := authorize(t, provider, "state", "nonce", verifier)
+
+	identity, err := provider.Exchange(t.Context(), code, "nonce", verifier)
+	require.NoError(err)
+	assert.Equal(&oidc.Identity{
+		Issuer:   idp.URL,
+		Subject:  "user-1",
+		Username: "alice",
+		Role:     model.UserRoleAdmin,
+	}, identity)
+
+	// Codes can only be redeemed once.
+	_, err = provider.Exchange(t.Context(), code, "nonce", verifier)
+	require.Error(err)
+
+	// The code is bound to the PKCE verifier.
+	code = authorize(t, provider, "state", "nonce", verifier)
+
+	_, err = provider.Exchange(t.Context(), code, "nonce", "wrong-verifier")
+	require.Error(err)
+
+	// The ID token is bound to the nonce of the login.
+	code = authorize(t, provider, "state", "nonce", verifier)
+
+	_, err = provider.Exchange(t.Context(), code, "other-nonce", verifier)
+	require.ErrorIs(err, oidc.ErrInvalidToken)
+}
+
+func TestExchangeRoles(t *testing.T) {
+	idp := metest.NewOIDCProvider(t)
+
+	tests := []struct {
+		name     string
+		config   oidc.Config
+		claims   map[string]any
+		expected model.UserRole
+		err      error
+	}{
+		{
+			name:     "default viewer",
+			claims:   map[string]any{},
+			expected: model.UserRoleViewer,
+		},
+		{
+			name: "nested roles claim",
+			config: oidc.Config{
+				RolesClaim: "realm_access.roles",
+				AdminRoles: []string{"admin"},
+			},
+			claims: map[string]any{
+				"realm_access": map[string]any{"roles": []string{"admin"}},
+			},
+			expected: model.UserRoleAdmin,
+		},
+		{
+			name:     "single role string",
+			config:   oidc.Config{RolesClaim: "role", ViewerRoles: []string{"analyst"}},
+			claims:   map[string]any{"role": "analyst"},
+			expected: model.UserRoleViewer,
+		},
+		{
+			name:   "no matching role",
+			config: oidc.Config{ViewerRoles: []string{"analyst"}},
+			claims: map[string]any{"groups": []string{"sales"}},
+			err:    oidc.ErrNoRole,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			claims := map[string]any{"sub": "user-1", "preferred_username": "alice"}
+			maps.Copy(claims, tc.claims)
+
+			idp.SetClaims(claims)
+			provider := newProvider(t, idp, tc.config)
+
+			code := authorize(t, provider, "state", "nonce", "verifier")
+
+			identity, err := provider.Exchange(t.Context(), code, "nonce", "verifier")
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, identity.Role)
+		})
+	}
+}
+
+func TestExchangeTimeClaims(t *testing.T) {
+	future := time.Now().Add(time.Hour).Unix()
+
+	testCases := []struct {
+		name   string
+		claims map[string]any
+		valid  bool
+	}{
+		{"not before within skew", map[string]any{"nbf": time.Now().Add(30 * time.Second).Unix()}, true},
+		{"not before", map[string]any{"nbf": future}, false},
+		{"issued in the future", map[string]any{"iat": future}, false},
+		{"missing issued at", map[string]any{"iat": nil}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			idp := metest.NewOIDCProvider(t)
+
+			claims := map[string]any{"sub": "user-1", "preferred_username": "alice"}
+			maps.Copy(claims, tc.claims)
+			idp.SetClaims(claims)
+
+			provider := newProvider(t, idp, oidc.Config{})
+
+			verifier, err := oidc.GenerateToken()
+			require.NoError(t, err)
+
+			code := authorize(t, provider, "state", "nonce", verifier)
+
+			_, err = provider.Exchange(t.Context(), code, "nonce", verifier)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, oidc.ErrInvalidToken)
+			}
+		})
+	}
+}
+
+func TestExchangeECDSA(t *testing.T) {
+	testCases := []struct {
+		name  string
+		curve elliptic.Curve
+		alg   string
+		valid bool
+	}{
+		{"ES256", elliptic.P256(), "ES256", true},
+		{"ES384", elliptic.P384(), "ES384", true},
+		{"ES512", elliptic.P521(), "ES512", true},
+		// The algorithm must match the curve of the key.
+		{"ES384 with P-256", elliptic.P256(), "ES384", false},
+		{"ES256 with P-384", elliptic.P384(), "ES256", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			idp := metest.NewOIDCProvider(t)
+			idp.SetClaims(map[string]any{"sub": "user-1", "preferred_username": "alice"})
+			idp.SetECKey(t, tc.curve, tc.alg)
+
+			provider := newProvider(t, idp, oidc.Config{})
+
+			verifier, err := oidc.GenerateToken()
+			require.NoError(t, err)
+
+			code := authorize(t, provider, "state", "nonce", verifier)
+
+			_, err = provider.Exchange(t.Context(), code, "nonce", verifier)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, oidc.ErrInvalidToken)
+			}
+		})
+	}
+}
+
+func TestKeysRefreshLimited(t *testing.T) {
+	require := require.New(t)
+
+	idp := metest.NewOIDCProvider(t)
+	idp.SetClaims(map[string]any{"sub": "user-1", "preferred_username": "alice"})
+
+	provider := newProvider(t, idp, oidc.Config{})
+
+	verifier, err := oidc.GenerateToken()
+	require.NoError(err)
+
+	code := authorize(t, provider, "state", "nonce", verifier)
+
+	_, err = provider.Exchange(t.Context(), code, "nonce", verifier)
+	require.NoError(err)
+	require.Equal(1, idp.JWKSRequests())
+
+	// Tokens signed with unknown keys do not fetch the keys again until the
+	// refresh interval has passed.
+	idp.SetECKey(t, elliptic.P256(), "ES256")
+
+	for range 3 {
+		code = authorize(t, provider, "state", "nonce", verifier)
+
+		_, err = provider.Exchange(t.Context(), code, "nonce", verifier)
+		require.ErrorIs(err, oidc.ErrInvalidToken)
+	}
+
+	require.Equal(1, idp.JWKSRequests())
+}
+
+func TestDiscoveryIssuerMismatch(t *testing.T) {
+	idp := metest.NewOIDCProvider(t)
+
+	provider, err := oidc.NewProvider(oidc.Config{
+		Issuer:      idp.URL + "/",
+		ClientID:    metest.OIDCClientID,
+		RedirectURL: "http://localhost:8080/api/auth/oidc/callback",
+	})
+	require.NoError(t, err)
+
+	_, err = provider.AuthCodeURL(t.Context(), "state", "nonce", "verifier")
+	require.Error(t, err)
+}
+
+func TestCodeChallenge(t *testing.T) {
+	// RFC 7636 Appendix B.
+	assert.Equal(t,
+		"E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		oidc.CodeChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"),
+	)
+}
@@ -0,0 +1,374 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	_ "crypto/sha512" // Register SHA-384 and SHA-512 for token signatures.
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+const (
+	// clockSkew is the tolerance for the time claims of ID tokens.
+	clockSkew = time.Minute
+	// keysRefreshInterval is the minimum time between fetches of the signing
+	// keys, so tokens with unknown key IDs can not flood the identity provider.
+	keysRefreshInterval = time.Minute
+)
+
+// jwk is a public signing key of the identity provider.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// GenerateToken returns a random URL-safe token, used for the state, nonce
+// and PKCE verifier of a login.
+func GenerateToken() (string, error) {
+	b := make([]byte, 32)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", errors.Wrap(err, "oidc: generate token")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// CodeChallenge returns the S256 PKCE challenge of a verifier.
+func CodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// verify checks the signature and claims of an ID token and returns its
+// claims.
+func (p *Provider) verify(ctx context.Context, raw string, nonce string) (map[string]any, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, errors.Wrap(ErrInvalidToken, "malformed")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+
+	err := decodeSegment(parts[0], &header)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidToken, "header")
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidToken, "signature")
+	}
+
+	key, err := p.key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifySignature(header.Alg, key, []byte(parts[0]+"."+parts[1]), signature)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims map[string]any
+
+	err = decodeSegment(parts[1], &claims)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidToken, "claims")
+	}
+
+	err = p.validateClaims(claims, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// validateClaims checks the token was issued by the identity provider to this
+// client for the current login.
+func (p *Provider) validateClaims(claims map[string]any, nonce string) error {
+	if iss, _ := claims["iss"].(string); iss != p.config.Issuer {
+		return errors.Wrap(ErrInvalidToken, "issuer")
+	}
+
+	audience := claimStrings(claims["aud"])
+	if !slices.Contains(audience, p.config.ClientID) {
+		return errors.Wrap(ErrInvalidToken, "audience")
+	}
+
+	if azp, ok := claims["azp"].(string); ok && azp != p.config.ClientID {
+		return errors.Wrap(ErrInvalidToken, "authorized party")
+	}
+
+	now := time.Now()
+
+	exp, ok := claims["exp"].(float64)
+	if !ok || now.Add(-clockSkew).After(time.Unix(int64(exp), 0)) {
+		return errors.Wrap(ErrInvalidToken, "expired")
+	}
+
+	iat, ok := claims["iat"].(float64)
+	if !ok || now.Add(clockSkew).Before(time.Unix(int64(iat), 0)) {
+		return errors.Wrap(ErrInvalidToken, "issued at")
+	}
+
+	if _, ok := claims["nbf"]; ok {
+		nbf, ok := claims["nbf"].(float64)
+		if !ok || now.Add(clockSkew).Before(time.Unix(int64(nbf), 0)) {
+			return errors.Wrap(ErrInvalidToken, "not before")
+		}
+	}
+
+	tokenNonce, _ := claims["nonce"].(string)
+	if subtle.ConstantTimeCompare([]byte(tokenNonce), []byte(nonce)) != 1 {
+		return errors.Wrap(ErrInvalidToken, "nonce")
+	}
+
+	return nil
+}
+
+// key returns the signing key with the given ID. The keys are fetched again
+// when the ID is unknown, as identity providers rotate their keys, but at most
+// once per refresh interval.
+func (p *Provider) key(ctx context.Context, kid string) (any, error) {
+	p.mu.Lock()
+	key, ok := p.keys[kid]
+	p.mu.Unlock()
+
+	if ok {
+		return key, nil
+	}
+
+	// Concurrent logins wait for a single fetch rather than each fetching.
+	p.refreshMu.Lock()
+	defer p.refreshMu.Unlock()
+
+	p.mu.Lock()
+	_, ok = p.keys[kid]
+	fetched := p.keysFetched
+	p.mu.Unlock()
+
+	if !ok && time.Since(fetched) >= keysRefreshInterval {
+		p.mu.Lock()
+		p.keysFetched = time.Now()
+		p.mu.Unlock()
+
+		err := p.fetchKeys(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key, ok = p.keys[kid]
+	if !ok {
+		// Tokens may omit the key ID if the provider only has a single key.
+		if kid == "" && len(p.keys) == 1 {
+			for _, key := range p.keys {
+				return key, nil
+			}
+		}
+
+		return nil, errors.Wrap(ErrInvalidToken, "unknown signing key")
+	}
+
+	return key, nil
+}
+
+// fetchKeys replaces the cached signing keys with the JSON Web Key Set of the
+// identity provider.
+func (p *Provider) fetchKeys(ctx context.Context) error {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, meta.JWKSURI, nil)
+	if err != nil {
+		return errors.Wrap(err, "oidc: jwks")
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+
+	status, err := p.do(req, &set)
+	if err != nil {
+		return errors.Wrap(err, "oidc: jwks")
+	}
+
+	if status != http.StatusOK {
+		return errors.Errorf("oidc: jwks: unexpected status %d", status)
+	}
+
+	keys := make(map[string]any, len(set.Keys))
+
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		key, err := k.publicKey()
+		if err != nil {
+			// Skip key types we do not support rather than failing all logins.
+			continue
+		}
+
+		keys[k.Kid] = key
+	}
+
+	p.mu.Lock()
+	p.keys = keys
+	p.mu.Unlock()
+
+	return nil
+}
+
+func (k jwk) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Errorf("unsupported curve %s", k.Crv)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, nil
+
+	default:
+		return nil, errors.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+// verifySignature checks the signature of an ID token. Only asymmetric
+// algorithms are accepted.
+func verifySignature(alg string, key any, signed []byte, signature []byte) error {
+	var hash crypto.Hash
+
+	switch alg {
+	case "RS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384", "ES384":
+		hash = crypto.SHA384
+	case "RS512", "ES512":
+		hash = crypto.SHA512
+	default:
+		return errors.Wrapf(ErrInvalidToken, "unsupported algorithm %s", alg)
+	}
+
+	h := hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(alg, "RS") || rsa.VerifyPKCS1v15(key, hash, digest, signature) != nil {
+			return errors.Wrap(ErrInvalidToken, "signature")
+		}
+
+	case *ecdsa.PublicKey:
+		// Each algorithm is bound to a single curve.
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if alg != ecdsaAlgorithm(key.Curve) || len(signature) != 2*size {
+			return errors.Wrap(ErrInvalidToken, "signature")
+		}
+
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+
+		if !ecdsa.Verify(key, digest, r, s) {
+			return errors.Wrap(ErrInvalidToken, "signature")
+		}
+
+	default:
+		return errors.Wrap(ErrInvalidToken, "unsupported key")
+	}
+
+	return nil
+}
+
+// ecdsaAlgorithm returns the signature algorithm of an elliptic curve.
+func ecdsaAlgorithm(curve elliptic.Curve) string {
+	switch curve {
+	case elliptic.P256():
+		return "ES256"
+	case elliptic.P384():
+		return "ES384"
+	case elliptic.P521():
+		return "ES512"
+	default:
+		return ""
+	}
+}
+
+func decodeSegment(segment string, v any) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/model"
+)
+
+// canAccessWebsite reports whether the user of the request can access the
+// website. Owners and admins can access all websites, while viewers can only
+// access the websites they are a member of. API keys scoped to a website can
+// only access that website.
+func (h *Handler) canAccessWebsite(ctx context.Context, hostname string) (bool, error) {
+	userID, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok || !h.hostnames.Has(hostname) || !keyCanAccessWebsite(ctx, hostname) {
+		return false, nil
+	}
+
+	return h.db.HasWebsiteAccess(ctx, userID, hostname)
+}
+
+// isAdmin reports whether the user of the request is an owner or admin, who
+// can manage websites and users.
+func (h *Handler) isAdmin(ctx context.Context) (bool, error) {
+	userID, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return false, nil
+	}
+
+	user, err := h.db.GetUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrUserNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return user.Role.IsAdmin(), nil
+}
+
+// authoriseWebsite returns model.ErrWebsiteNotFound if the user of the request
+// can not access the website, or model.ErrUserForbidden if manage is set and
+// the user can not manage websites.
+func (h *Handler) authoriseWebsite(ctx context.Context, hostname string, manage bool) error {
+	access, err := h.canAccessWebsite(ctx, hostname)
+	if err != nil {
+		return err
+	}
+
+	if !access {
+		return model.ErrWebsiteNotFound
+	}
+
+	if manage {
+		admin, err := h.isAdmin(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !admin {
+			return model.ErrUserForbidden
+		}
+	}
+
+	return nil
+}
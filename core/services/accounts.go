@@ -0,0 +1,296 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+	"go.jetify.com/typeid"
+)
+
+func (h *Handler) GetUsers(ctx context.Context, _params api.GetUsersParams) (api.GetUsersRes, error) {
+	admin, err := h.isAdmin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if !admin {
+		return ErrForbidden(model.ErrUserForbidden), nil
+	}
+
+	users, err := h.db.ListUsers(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	resp := make([]api.UserAccount, 0, len(users))
+	for _, user := range users {
+		account, err := h.userAccountToAPI(ctx, user)
+		if err != nil {
+			return nil, errors.Wrap(err, "services")
+		}
+
+		resp = append(resp, account)
+	}
+
+	return &api.GetUsersOKHeaders{
+		Response: resp,
+	}, nil
+}
+
+func (h *Handler) PostUsers(
+	ctx context.Context,
+	req *api.UserAccountCreate,
+	_params api.PostUsersParams,
+) (api.PostUsersRes, error) {
+	log := logger.Get()
+	if h.auth.IsDemoMode {
+		log.Debug().Msg("post users rejected in demo mode")
+		return ErrForbidden(model.ErrDemoMode), nil
+	}
+
+	admin, err := h.isAdmin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if !admin {
+		return ErrForbidden(model.ErrUserForbidden), nil
+	}
+
+	// Check the websites before creating the user to avoid a partial account.
+	for _, hostname := range req.Websites {
+		if !h.hostnames.Has(hostname) {
+			return ErrNotFound(model.ErrWebsiteNotFound), nil
+		}
+	}
+
+	typeID, err := typeid.WithPrefix("user")
+	if err != nil {
+		return nil, errors.Wrap(err, "typeid user")
+	}
+
+	pwdHash, err := h.auth.HashPassword(req.Password)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to hash password")
+		return nil, errors.Wrap(err, "services")
+	}
+
+	dateNow := time.Now().Unix()
+	user := model.NewUser(
+		typeID.String(),
+		req.Username,
+		pwdHash,
+		model.UserRole(req.Role),
+		model.NewDefaultUserSettings(),
+		dateNow,
+		dateNow,
+	)
+
+	err = h.db.CreateUser(ctx, user)
+	if err != nil {
+		if errors.Is(err, model.ErrUserExists) {
+			log.Debug().Str("username", user.Username).Msg("username already exists")
+			return ErrConflict(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if len(req.Websites) > 0 {
+		err = h.db.SetUserWebsites(ctx, user.ID, req.Websites)
+		if err != nil {
+			if errors.Is(err, model.ErrWebsiteNotFound) {
+				return ErrNotFound(err), nil
+			}
+
+			return nil, errors.Wrap(err, "services")
+		}
+	}
+
+	log.Info().
+		Str("id", user.ID).
+		Str("username", user.Username).
+		Str("role", string(user.Role)).
+		Msg("created user")
+
+	changes := auditChanges{}
+	changes.add("username", "", user.Username)
+	changes.add("role", "", string(user.Role))
+	changes.addList("websites", nil, req.Websites)
+	h.audit(ctx, model.AuditUserCreate, user.ID, changes)
+
+	account, err := h.userAccountToAPI(ctx, user)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	return &api.UserAccountHeaders{
+		Response: account,
+	}, nil
+}
+
+func (h *Handler) PatchUsersID(
+	ctx context.Context,
+	req *api.UserAccountPatch,
+	params api.PatchUsersIDParams,
+) (api.PatchUsersIDRes, error) {
+	log := logger.Get()
+	if h.auth.IsDemoMode {
+		log.Debug().Msg("patch users rejected in demo mode")
+		return ErrForbidden(model.ErrDemoMode), nil
+	}
+
+	admin, err := h.isAdmin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if !admin {
+		return ErrForbidden(model.ErrUserForbidden), nil
+	}
+
+	user, err := h.db.GetUser(ctx, params.UserId)
+	if err != nil {
+		if errors.Is(err, model.ErrUserNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	changes := auditChanges{}
+
+	if v, ok := req.Role.Get(); ok {
+		if user.Role == model.UserRoleOwner {
+			return ErrForbidden(model.ErrUserOwner), nil
+		}
+
+		changes.add("role", string(user.Role), string(v))
+		user.Role = model.UserRole(v)
+
+		err = h.db.UpdateUserRole(ctx, user.ID, user.Role)
+		if err != nil {
+			if errors.Is(err, model.ErrUserNotFound) {
+				return ErrNotFound(err), nil
+			}
+
+			return nil, errors.Wrap(err, "services")
+		}
+	}
+
+	if req.Websites != nil {
+		websites, err := h.db.ListUserWebsites(ctx, user.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "services")
+		}
+
+		err = h.db.SetUserWebsites(ctx, user.ID, req.Websites)
+		if err != nil {
+			if errors.Is(err, model.ErrWebsiteNotFound) {
+				return ErrNotFound(err), nil
+			}
+
+			return nil, errors.Wrap(err, "services")
+		}
+
+		changes.addList("websites", websites, req.Websites)
+	}
+
+	log.Info().
+		Str("id", user.ID).
+		Str("role", string(user.Role)).
+		Msg("updated user")
+
+	if len(changes) > 0 {
+		h.audit(ctx, model.AuditUserUpdate, user.ID, changes)
+	}
+
+	account, err := h.userAccountToAPI(ctx, user)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	return &api.UserAccountHeaders{
+		Response: account,
+	}, nil
+}
+
+func (h *Handler) DeleteUsersID(
+	ctx context.Context,
+	params api.DeleteUsersIDParams,
+) (api.DeleteUsersIDRes, error) {
+	log := logger.Get()
+	if h.auth.IsDemoMode {
+		log.Debug().Msg("delete users rejected in demo mode")
+		return ErrForbidden(model.ErrDemoMode), nil
+	}
+
+	admin, err := h.isAdmin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if !admin {
+		return ErrForbidden(model.ErrUserForbidden), nil
+	}
+
+	user, err := h.db.GetUser(ctx, params.UserId)
+	if err != nil {
+		if errors.Is(err, model.ErrUserNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if user.Role == model.UserRoleOwner {
+		return ErrForbidden(model.ErrUserOwner), nil
+	}
+
+	err = h.db.DeleteUser(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, model.ErrUserNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	// Persisted sessions are removed alongside the user, but in-memory
+	// sessions have to be revoked explicitly.
+	err = h.auth.RevokeUserSessions(ctx, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	log.Info().
+		Str("id", user.ID).
+		Str("username", user.Username).
+		Msg("deleted user")
+
+	changes := auditChanges{}
+	changes.add("username", user.Username, "")
+	changes.add("role", string(user.Role), "")
+	h.audit(ctx, model.AuditUserDelete, user.ID, changes)
+
+	return &api.DeleteUsersIDNoContent{}, nil
+}
+
+func (h *Handler) userAccountToAPI(ctx context.Context, user *model.User) (api.UserAccount, error) {
+	websites, err := h.db.ListUserWebsites(ctx, user.ID)
+	if err != nil {
+		return api.UserAccount{}, err
+	}
+
+	return api.UserAccount{
+		ID:          user.ID,
+		Username:    user.Username,
+		Role:        api.UserRole(user.Role),
+		Websites:    websites,
+		DateCreated: user.DateCreated,
+	}, nil
+}
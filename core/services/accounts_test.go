@@ -0,0 +1,171 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserRoles(t *testing.T) {
+	assert, ctx, handler, sqliteClient := metest.NewTestHandler(t)
+	require := require.New(t)
+
+	owner, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	ownerCtx := context.WithValue(ctx, model.ContextKeyUserID, owner.ID)
+
+	for _, hostname := range []string{"example.com", "other.example.com"} {
+		_, err = handler.PostWebsites(ownerCtx, &api.WebsiteCreate{Hostname: hostname})
+		require.NoError(err)
+	}
+
+	createUser := func(req *api.UserAccountCreate) api.UserAccount {
+		resp, err := handler.PostUsers(ownerCtx, req, api.PostUsersParams{})
+		require.NoError(err)
+
+		created, ok := resp.(*api.UserAccountHeaders)
+		require.True(ok)
+
+		return created.Response
+	}
+
+	viewer := createUser(&api.UserAccountCreate{
+		Username: "viewer",
+		Password: "password",
+		Role:     api.UserAccountCreateRoleViewer,
+		Websites: []string{"example.com"},
+	})
+	assert.Equal(api.UserRoleViewer, viewer.Role)
+	assert.Equal([]string{"example.com"}, viewer.Websites)
+
+	admin := createUser(&api.UserAccountCreate{
+		Username: "manager",
+		Password: "password",
+		Role:     api.UserAccountCreateRoleAdmin,
+	})
+
+	// Usernames are unique and websites must exist.
+	resp, err := handler.PostUsers(ownerCtx, &api.UserAccountCreate{
+		Username: "viewer",
+		Password: "password",
+		Role:     api.UserAccountCreateRoleViewer,
+	}, api.PostUsersParams{})
+	require.NoError(err)
+
+	_, ok := resp.(*api.ConflictErrorHeaders)
+	assert.True(ok)
+
+	resp, err = handler.PostUsers(ownerCtx, &api.UserAccountCreate{
+		Username: "missing",
+		Password: "password",
+		Role:     api.UserAccountCreateRoleViewer,
+		Websites: []string{"missing.example.com"},
+	}, api.PostUsersParams{})
+	require.NoError(err)
+
+	_, ok = resp.(*api.NotFoundErrorHeaders)
+	assert.True(ok)
+
+	// Viewers only see the websites they are a member of.
+	viewerCtx := context.WithValue(ctx, model.ContextKeyUserID, viewer.ID)
+
+	websites, err := handler.GetWebsites(viewerCtx, api.GetWebsitesParams{})
+	require.NoError(err)
+
+	list, ok := websites.(*api.GetWebsitesOKHeaders)
+	require.True(ok)
+	require.Len(list.Response, 1)
+	assert.Equal("example.com", list.Response[0].Hostname)
+
+	summary, err := handler.GetWebsiteIDSummary(viewerCtx, api.GetWebsiteIDSummaryParams{
+		Hostname: "other.example.com",
+	})
+	require.NoError(err)
+
+	_, ok = summary.(*api.NotFoundErrorHeaders)
+	assert.True(ok)
+
+	// Viewers can not manage websites, users or tenant settings.
+	post, err := handler.PostWebsites(viewerCtx, &api.WebsiteCreate{Hostname: "new.example.com"})
+	require.NoError(err)
+
+	_, ok = post.(*api.ForbiddenErrorHeaders)
+	assert.True(ok)
+
+	patch, err := handler.PatchWebsitesID(viewerCtx, &api.WebsitePatch{
+		Hostname: api.NewOptString("renamed.example.com"),
+	}, api.PatchWebsitesIDParams{Hostname: "example.com"})
+	require.NoError(err)
+
+	_, ok = patch.(*api.ForbiddenErrorHeaders)
+	assert.True(ok)
+
+	users, err := handler.GetUsers(viewerCtx, api.GetUsersParams{})
+	require.NoError(err)
+
+	_, ok = users.(*api.ForbiddenErrorHeaders)
+	assert.True(ok)
+
+	settings, err := handler.PatchTenantSettings(viewerCtx, &api.TenantSettings{}, api.PatchTenantSettingsParams{})
+	require.NoError(err)
+
+	_, ok = settings.(*api.ForbiddenErrorHeaders)
+	assert.True(ok)
+
+	// Admins can manage other users, but not the owner.
+	adminCtx := context.WithValue(ctx, model.ContextKeyUserID, admin.ID)
+
+	updated, err := handler.PatchUsersID(adminCtx, &api.UserAccountPatch{
+		Websites: []string{"example.com", "other.example.com"},
+	}, api.PatchUsersIDParams{UserId: viewer.ID})
+	require.NoError(err)
+
+	account, ok := updated.(*api.UserAccountHeaders)
+	require.True(ok)
+	assert.Equal(api.UserRoleViewer, account.Response.Role)
+	assert.Equal([]string{"example.com", "other.example.com"}, account.Response.Websites)
+
+	updated, err = handler.PatchUsersID(adminCtx, &api.UserAccountPatch{
+		Role: api.NewOptUserAccountPatchRole(api.UserAccountPatchRoleViewer),
+	}, api.PatchUsersIDParams{UserId: owner.ID})
+	require.NoError(err)
+
+	_, ok = updated.(*api.ForbiddenErrorHeaders)
+	assert.True(ok)
+
+	del, err := handler.DeleteUsersID(adminCtx, api.DeleteUsersIDParams{UserId: owner.ID})
+	require.NoError(err)
+
+	_, ok = del.(*api.ForbiddenErrorHeaders)
+	assert.True(ok)
+
+	selfDel, err := handler.DeleteUser(ownerCtx, api.DeleteUserParams{})
+	require.NoError(err)
+
+	_, ok = selfDel.(*api.ForbiddenErrorHeaders)
+	assert.True(ok)
+
+	users, err = handler.GetUsers(adminCtx, api.GetUsersParams{})
+	require.NoError(err)
+
+	accounts, ok := users.(*api.GetUsersOKHeaders)
+	require.True(ok)
+	assert.Len(accounts.Response, 3)
+
+	del, err = handler.DeleteUsersID(adminCtx, api.DeleteUsersIDParams{UserId: viewer.ID})
+	require.NoError(err)
+
+	_, ok = del.(*api.DeleteUsersIDNoContent)
+	assert.True(ok)
+
+	del, err = handler.DeleteUsersID(adminCtx, api.DeleteUsersIDParams{UserId: viewer.ID})
+	require.NoError(err)
+
+	_, ok = del.(*api.NotFoundErrorHeaders)
+	assert.True(ok)
+}
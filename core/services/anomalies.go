@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/medama-io/medama/anomaly"
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db"
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/model"
+)
+
+const (
+	// AnomalySeasons is the number of previous weeks the baseline of an
+	// interval is built from.
+	AnomalySeasons = 4
+	// anomalySeason is the period the traffic of a website repeats over, so
+	// intervals are compared with the same weekday and time of day.
+	anomalySeason = 7 * 24 * time.Hour
+)
+
+// intervalAnomalies returns the anomalies of each interval compared with the
+// same interval in the weeks before the period. The baseline is never taken
+// from the period itself, so periods longer than a week are not compared with
+// their own traffic. Weeks without any page views, such as before the website
+// was tracked, are left out of the baseline.
+func intervalAnomalies(
+	ctx context.Context,
+	analyticsDB *duckdb.Client,
+	filters *db.Filters,
+	interval api.GetWebsiteIDSummaryInterval,
+	current []*model.StatsIntervals,
+) ([][]model.StatsAnomaly, error) {
+	if interval == api.GetWebsiteIDSummaryIntervalMonth {
+		return nil, model.ErrInvalidAnomalyInterval
+	}
+
+	anomalies := make([][]model.StatsAnomaly, len(current))
+	if len(current) == 0 {
+		return anomalies, nil
+	}
+
+	start, err := time.Parse(model.DateFormat, filters.PeriodStart)
+	if err != nil {
+		return nil, model.ErrInvalidParameter
+	}
+
+	// Make a copy of filters to avoid modifying the original.
+	previous := *filters
+	previous.PeriodStart = start.Add(-AnomalySeasons * anomalySeason).Format(model.DateFormat)
+	previous.PeriodEnd = start.Add(-time.Second).Format(model.DateFormat)
+
+	history, err := analyticsDB.GetWebsiteIntervals(ctx, &previous, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every interval divides a season, so the intervals before the period
+	// split into whole seasons that start at the same time of the week as
+	// the period.
+	size := len(history) / AnomalySeasons
+	if size == 0 {
+		return anomalies, nil
+	}
+
+	seasons := make([][]*model.StatsIntervals, 0, AnomalySeasons)
+
+	for i := range AnomalySeasons {
+		season := history[i*size : (i+1)*size]
+		if !hasPageviews(season) {
+			continue
+		}
+
+		seasons = append(seasons, season)
+	}
+
+	baseline := make([]*model.StatsIntervals, len(seasons))
+
+	for i, bucket := range current {
+		// Intervals more than a season after the start of the period are
+		// compared with the same time of the week.
+		for j, season := range seasons {
+			baseline[j] = season[i%size]
+		}
+
+		anomalies[i] = anomaly.Detect(bucket, baseline, anomaly.DefaultThreshold)
+	}
+
+	return anomalies, nil
+}
+
+func hasPageviews(intervals []*model.StatsIntervals) bool {
+	for _, interval := range intervals {
+		if interval.Pageviews > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func anomaliesToAPI(anomalies []model.StatsAnomaly) []api.StatsAnomaly {
+	resp := make([]api.StatsAnomaly, 0, len(anomalies))
+	for _, a := range anomalies {
+		resp = append(resp, api.StatsAnomaly{
+			Metric:   api.StatsAnomalyMetric(a.Metric),
+			Value:    a.Value,
+			Expected: a.Expected,
+			Score:    a.Score,
+		})
+	}
+
+	return resp
+}
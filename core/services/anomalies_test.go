@@ -0,0 +1,261 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/db/sqlite"
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/services"
+	"github.com/medama-io/medama/util"
+	"github.com/medama-io/medama/webhooks"
+	"github.com/stretchr/testify/require"
+)
+
+// anomalyStart is the first hour of the traffic inserted by
+// setupAnomalyViews.
+var anomalyStart = time.Date(2026, 10, 12, 10, 0, 0, 0, time.UTC)
+
+// setupAnomalyViews creates example.com with 20 visitors in each of the three
+// hours from anomalyStart and the same hours in the previous four weeks,
+// except for the second hour of the latest week, which has none.
+func setupAnomalyViews(t *testing.T) (context.Context, *sqlite.Client, *duckdb.Client, *model.User) {
+	t.Helper()
+
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient := metest.NewTestDatabases(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	require.NoError(sqliteClient.CreateWebsite(ctx, model.NewWebsite(user.ID, "example.com", 1, 1)))
+
+	var views []model.ImportedPageView
+
+	for week := range services.AnomalySeasons + 1 {
+		for hour := range 3 {
+			if week == 0 && hour == 1 {
+				continue
+			}
+
+			date := anomalyStart.AddDate(0, 0, -7*week).Add(time.Duration(hour) * time.Hour)
+			for i := range 20 {
+				views = append(views, model.ImportedPageView{
+					PageViewHit: model.PageViewHit{
+						BID:          "anomaly_" + strconv.Itoa(week) + "_" + strconv.Itoa(hour) + "_" + strconv.Itoa(i),
+						Hostname:     "example.com",
+						Pathname:     "/",
+						IsUniqueUser: true,
+						IsUniquePage: true,
+					},
+					DurationMs:  30000,
+					DateCreated: date.Add(time.Duration(i) * time.Minute),
+				})
+			}
+		}
+	}
+
+	require.NoError(duckdbClient.InsertImportedViews(ctx, "import_anomalies", views))
+
+	return ctx, sqliteClient, duckdbClient, user
+}
+
+func TestSummaryAnomalies(t *testing.T) {
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient, user := setupAnomalyViews(t)
+
+	auth, err := util.NewAuthService(ctx, false)
+	require.NoError(err)
+
+	ingester := duckdb.NewIngester(duckdbClient, duckdb.IngestConfig{})
+	t.Cleanup(func() {
+		ingester.Close(t.Context()) //nolint:errcheck // Test cleanup.
+	})
+
+	handler, err := services.NewService(ctx, auth, sqliteClient, duckdbClient, ingester, "test-commit")
+	require.NoError(err)
+
+	ctx = context.WithValue(ctx, model.ContextKeyUserID, user.ID)
+
+	params := api.GetWebsiteIDSummaryParams{
+		Hostname:  "example.com",
+		Start:     api.NewOptDateTime(anomalyStart),
+		End:       api.NewOptDateTime(anomalyStart.Add(3*time.Hour - time.Second)),
+		Interval:  api.NewOptGetWebsiteIDSummaryInterval(api.GetWebsiteIDSummaryIntervalHour),
+		Anomalies: api.NewOptBool(true),
+	}
+
+	resp, err := handler.GetWebsiteIDSummary(ctx, params)
+	require.NoError(err)
+
+	summary, ok := resp.(*api.StatsSummaryHeaders)
+	require.True(ok)
+	require.Len(summary.Response.Interval, 3)
+
+	// Only the hour without traffic is flagged.
+	require.NotNil(summary.Response.Interval[0].Anomalies)
+	require.Empty(summary.Response.Interval[0].Anomalies)
+	require.Empty(summary.Response.Interval[2].Anomalies)
+
+	anomalies := summary.Response.Interval[1].Anomalies
+	require.Len(anomalies, 2)
+	require.Equal(api.StatsAnomalyMetricVisitors, anomalies[0].Metric)
+	require.Zero(anomalies[0].Value)
+	require.Equal(20.0, anomalies[0].Expected)
+	require.Less(anomalies[0].Score, -3.0)
+	require.Equal(api.StatsAnomalyMetricPageviews, anomalies[1].Metric)
+
+	// Anomalies are only returned if requested.
+	params.Anomalies = api.OptBool{}
+
+	resp, err = handler.GetWebsiteIDSummary(ctx, params)
+	require.NoError(err)
+
+	summary, ok = resp.(*api.StatsSummaryHeaders)
+	require.True(ok)
+	require.Nil(summary.Response.Interval[1].Anomalies)
+
+	// Months do not repeat weekly.
+	params.Anomalies = api.NewOptBool(true)
+	params.Interval = api.NewOptGetWebsiteIDSummaryInterval(api.GetWebsiteIDSummaryIntervalMonth)
+
+	resp, err = handler.GetWebsiteIDSummary(ctx, params)
+	require.NoError(err)
+	require.IsType(&api.BadRequestErrorHeaders{}, resp)
+}
+
+func TestSummaryAnomaliesLongPeriod(t *testing.T) {
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient := metest.NewTestDatabases(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	require.NoError(sqliteClient.CreateWebsite(ctx, model.NewWebsite(user.ID, "example.com", 1, 1)))
+
+	// 20 visitors a day in the four weeks before a 30 day period with 40
+	// visitors a day, except for its fourth week, which has none.
+	start := time.Date(2026, 8, 3, 0, 0, 0, 0, time.UTC)
+
+	var views []model.ImportedPageView
+
+	for day := -services.AnomalySeasons * 7; day < 30; day++ {
+		visitors := 20
+		if day >= 0 {
+			visitors = 40
+		}
+
+		if day >= 21 && day < 28 {
+			continue
+		}
+
+		for i := range visitors {
+			views = append(views, model.ImportedPageView{
+				PageViewHit: model.PageViewHit{
+					BID:          "anomaly_" + strconv.Itoa(day) + "_" + strconv.Itoa(i),
+					Hostname:     "example.com",
+					Pathname:     "/",
+					IsUniqueUser: true,
+					IsUniquePage: true,
+				},
+				DurationMs:  30000,
+				DateCreated: start.AddDate(0, 0, day).Add(time.Duration(i) * time.Minute),
+			})
+		}
+	}
+
+	require.NoError(duckdbClient.InsertImportedViews(ctx, "import_anomalies", views))
+
+	auth, err := util.NewAuthService(ctx, false)
+	require.NoError(err)
+
+	ingester := duckdb.NewIngester(duckdbClient, duckdb.IngestConfig{})
+	t.Cleanup(func() {
+		ingester.Close(t.Context()) //nolint:errcheck // Test cleanup.
+	})
+
+	handler, err := services.NewService(ctx, auth, sqliteClient, duckdbClient, ingester, "test-commit")
+	require.NoError(err)
+
+	ctx = context.WithValue(ctx, model.ContextKeyUserID, user.ID)
+
+	resp, err := handler.GetWebsiteIDSummary(ctx, api.GetWebsiteIDSummaryParams{
+		Hostname:  "example.com",
+		Start:     api.NewOptDateTime(start),
+		End:       api.NewOptDateTime(start.AddDate(0, 0, 30).Add(-time.Second)),
+		Interval:  api.NewOptGetWebsiteIDSummaryInterval(api.GetWebsiteIDSummaryIntervalDay),
+		Anomalies: api.NewOptBool(true),
+	})
+	require.NoError(err)
+
+	summary, ok := resp.(*api.StatsSummaryHeaders)
+	require.True(ok)
+	require.Len(summary.Response.Interval, 30)
+
+	// Every day is compared with the weeks before the period, not with the
+	// earlier weeks of the period itself.
+	for day, interval := range summary.Response.Interval {
+		anomalies := interval.Anomalies
+		require.NotEmpty(anomalies, "day %d", day)
+		require.Equal(api.StatsAnomalyMetricVisitors, anomalies[0].Metric, "day %d", day)
+		require.Equal(20.0, anomalies[0].Expected, "day %d", day)
+
+		if day >= 21 && day < 28 {
+			require.Zero(anomalies[0].Value, "day %d", day)
+		} else {
+			require.Equal(40.0, anomalies[0].Value, "day %d", day)
+		}
+	}
+}
+
+func TestAnomalyWebhook(t *testing.T) {
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient, user := setupAnomalyViews(t)
+
+	receiver := newWebhookReceiver(t, nil)
+
+	created := anomalyStart.AddDate(0, 0, -1).Unix()
+	require.NoError(sqliteClient.CreateWebhook(ctx, model.NewWebhook("webhook_anomaly", user.ID, "example.com",
+		receiver.URL, "secret", model.WebhookTriggerAnomaly, "", 0, created, created)))
+
+	dispatcher := services.NewWebhookDispatcher(sqliteClient, duckdbClient, webhooks.NewClient(1, time.Millisecond), time.Hour)
+
+	// The first hour is as usual.
+	sent, err := dispatcher.Check(ctx, anomalyStart.Add(time.Hour+2*time.Minute))
+	require.NoError(err)
+	require.Zero(sent)
+
+	// The second hour has no traffic.
+	now := anomalyStart.Add(2*time.Hour + 2*time.Minute)
+
+	sent, err = dispatcher.Check(ctx, now)
+	require.NoError(err)
+	require.Equal(1, sent)
+
+	requests := receiver.Requests()
+	require.Len(requests, 1)
+	require.Equal("anomaly", requests[0].payload["event"])
+	require.Equal("Unusual traffic on example.com between 11:00 and 12:00 UTC: "+
+		"visitors 0 (expected 20), pageviews 0 (expected 20).", requests[0].payload["text"])
+
+	data, ok := requests[0].payload["data"].(map[string]any)
+	require.True(ok)
+	require.Equal("2026-10-12T11:00:00Z", data["start"])
+	require.Len(data["anomalies"], 2)
+
+	deliveries, err := sqliteClient.ListWebhookDeliveries(ctx, "webhook_anomaly", 10, 0)
+	require.NoError(err)
+	require.Len(deliveries, 1)
+	require.Equal(http.StatusNoContent, deliveries[0].StatusCode)
+
+	// Each hour is only checked once.
+	sent, err = dispatcher.Check(ctx, now.Add(5*time.Minute))
+	require.NoError(err)
+	require.Zero(sent)
+}
@@ -0,0 +1,225 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+)
+
+func (h *Handler) GetAPIKeys(
+	ctx context.Context,
+	_params api.GetAPIKeysParams,
+) (api.GetAPIKeysRes, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	keys, err := h.db.ListAPIKeys(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	resp := make([]api.APIKeyGet, 0, len(keys))
+	for _, key := range keys {
+		resp = append(resp, apiKeyToAPI(key))
+	}
+
+	return &api.GetAPIKeysOKHeaders{
+		Response: resp,
+	}, nil
+}
+
+func (h *Handler) PostAPIKeys(
+	ctx context.Context,
+	req *api.APIKeyCreate,
+	_params api.PostAPIKeysParams,
+) (api.PostAPIKeysRes, error) {
+	log := logger.Get()
+	if h.auth.IsDemoMode {
+		log.Debug().Msg("post api key rejected in demo mode")
+		return ErrForbidden(model.ErrDemoMode), nil
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	now := time.Now()
+
+	var dateExpires int64
+	if req.ExpiresAt.Set {
+		if !req.ExpiresAt.Value.After(now) {
+			return ErrBadRequest(model.ErrInvalidAPIKeyExpiry), nil
+		}
+
+		dateExpires = req.ExpiresAt.Value.Unix()
+	}
+
+	// Keys can only be scoped to websites the user can access.
+	if req.Hostname.Value != "" {
+		access, err := h.canAccessWebsite(ctx, req.Hostname.Value)
+		if err != nil {
+			return nil, errors.Wrap(err, "services")
+		}
+
+		if !access {
+			return ErrNotFound(model.ErrWebsiteNotFound), nil
+		}
+	}
+
+	id, token, hash, err := h.auth.CreateAPIKey()
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	key := model.NewAPIKey(
+		id,
+		userID,
+		req.Name,
+		hash,
+		model.APIKeyRole(req.Role),
+		req.Hostname.Value,
+		dateExpires,
+		now.Unix(),
+		now.Unix(),
+	)
+
+	err = h.db.CreateAPIKey(ctx, key)
+	if err != nil {
+		if errors.Is(err, model.ErrWebsiteNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	log.Info().
+		Str("id", key.ID).
+		Str("role", string(key.Role)).
+		Str("hostname", key.Hostname).
+		Msg("created api key")
+
+	changes := auditChanges{}
+	changes.add("name", "", key.Name)
+	changes.add("role", "", string(key.Role))
+	changes.add("hostname", "", key.Hostname)
+	h.audit(ctx, model.AuditAPIKeyCreate, key.ID, changes)
+
+	return &api.APIKeyCreatedHeaders{
+		Response: api.APIKeyCreated{
+			Key:    token,
+			ApiKey: apiKeyToAPI(key),
+		},
+	}, nil
+}
+
+func (h *Handler) DeleteAPIKeysID(
+	ctx context.Context,
+	params api.DeleteAPIKeysIDParams,
+) (api.DeleteAPIKeysIDRes, error) {
+	log := logger.Get()
+	if h.auth.IsDemoMode {
+		log.Debug().Msg("delete api key rejected in demo mode")
+		return ErrForbidden(model.ErrDemoMode), nil
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	err := h.db.DeleteAPIKey(ctx, userID, params.KeyId)
+	if err != nil {
+		if errors.Is(err, model.ErrAPIKeyNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	log.Info().Str("id", params.KeyId).Msg("deleted api key")
+
+	h.audit(ctx, model.AuditAPIKeyDelete, params.KeyId, nil)
+
+	return &api.DeleteAPIKeysIDNoContent{}, nil
+}
+
+// keyCanAccessWebsite reports whether the API key used for the request, if
+// any, is allowed to access the website. Sessions are not restricted.
+func keyCanAccessWebsite(ctx context.Context, hostname string) bool {
+	key, ok := ctx.Value(model.ContextKeyAPIKey).(*model.APIKey)
+
+	return !ok || key.CanAccessWebsite(hostname)
+}
+
+// isScopedAPIKey reports whether the request uses an API key restricted to a
+// single website.
+func isScopedAPIKey(ctx context.Context) bool {
+	key, ok := ctx.Value(model.ContextKeyAPIKey).(*model.APIKey)
+
+	return ok && key.Hostname != ""
+}
+
+// authenticateRequest authenticates requests served outside of the generated
+// API server with either an API key or a session cookie. The returned context
+// holds the same values as set by the security handlers.
+func (h *Handler) authenticateRequest(r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		key, err := h.auth.ReadAPIKey(ctx, h.db, token)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx = context.WithValue(ctx, model.ContextKeyUserID, key.UserID)
+
+		return context.WithValue(ctx, model.ContextKeyAPIKey, key), nil
+	}
+
+	cookie, err := r.Cookie(model.SessionCookieName)
+	if err != nil {
+		return nil, model.ErrUnauthorised
+	}
+
+	userID, err := h.auth.ReadSession(ctx, cookie.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return context.WithValue(ctx, model.ContextKeyUserID, userID), nil
+}
+
+func apiKeyToAPI(key *model.APIKey) api.APIKeyGet {
+	resp := api.APIKeyGet{
+		ID:        key.ID,
+		Name:      key.Name,
+		Role:      api.APIKeyRole(key.Role),
+		CreatedAt: time.Unix(key.DateCreated, 0).UTC(),
+	}
+
+	if key.Hostname != "" {
+		resp.Hostname = api.NewOptString(key.Hostname)
+	}
+
+	if key.DateExpires != 0 {
+		resp.ExpiresAt = api.NewOptDateTime(time.Unix(key.DateExpires, 0).UTC())
+	}
+
+	if key.DateLastUsed != 0 {
+		resp.LastUsedAt = api.NewOptDateTime(time.Unix(key.DateLastUsed, 0).UTC())
+	}
+
+	return resp
+}
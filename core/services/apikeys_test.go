@@ -0,0 +1,258 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/middlewares"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeys(t *testing.T) {
+	assert, ctx, handler, sqliteClient := metest.NewTestHandler(t)
+	require := require.New(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	ctx = context.WithValue(ctx, model.ContextKeyUserID, user.ID)
+
+	for _, hostname := range []string{"example.com", "other.example.com"} {
+		_, err = handler.PostWebsites(ctx, &api.WebsiteCreate{Hostname: hostname})
+		require.NoError(err)
+	}
+
+	resp, err := handler.PostAPIKeys(ctx, &api.APIKeyCreate{
+		Name:     "Dashboard",
+		Role:     api.APIKeyRoleRead,
+		Hostname: api.NewOptString("example.com"),
+	}, api.PostAPIKeysParams{})
+	require.NoError(err)
+
+	created, ok := resp.(*api.APIKeyCreatedHeaders)
+	require.True(ok)
+	assert.Equal("Dashboard", created.Response.ApiKey.Name)
+	assert.Equal("example.com", created.Response.ApiKey.Hostname.Value)
+	assert.True(strings.HasPrefix(created.Response.Key, created.Response.ApiKey.ID+"."))
+
+	// Only the hash of the secret is stored.
+	key, err := sqliteClient.GetAPIKey(ctx, created.Response.ApiKey.ID)
+	require.NoError(err)
+	assert.NotContains(key.Hash, strings.TrimPrefix(created.Response.Key, key.ID+"."))
+
+	// Keys can only be scoped to existing websites and expire in the future.
+	resp, err = handler.PostAPIKeys(ctx, &api.APIKeyCreate{
+		Name:     "Missing",
+		Role:     api.APIKeyRoleRead,
+		Hostname: api.NewOptString("missing.example.com"),
+	}, api.PostAPIKeysParams{})
+	require.NoError(err)
+
+	_, ok = resp.(*api.NotFoundErrorHeaders)
+	assert.True(ok)
+
+	resp, err = handler.PostAPIKeys(ctx, &api.APIKeyCreate{
+		Name:      "Expired",
+		Role:      api.APIKeyRoleRead,
+		ExpiresAt: api.NewOptDateTime(time.Now().Add(-time.Hour)),
+	}, api.PostAPIKeysParams{})
+	require.NoError(err)
+
+	_, ok = resp.(*api.BadRequestErrorHeaders)
+	assert.True(ok)
+
+	list, err := handler.GetAPIKeys(ctx, api.GetAPIKeysParams{})
+	require.NoError(err)
+
+	keys, ok := list.(*api.GetAPIKeysOKHeaders)
+	require.True(ok)
+	require.Len(keys.Response, 1)
+	assert.Equal(created.Response.ApiKey.ID, keys.Response[0].ID)
+
+	del, err := handler.DeleteAPIKeysID(ctx, api.DeleteAPIKeysIDParams{KeyId: "key_missing"})
+	require.NoError(err)
+
+	_, ok = del.(*api.NotFoundErrorHeaders)
+	assert.True(ok)
+
+	del, err = handler.DeleteAPIKeysID(ctx, api.DeleteAPIKeysIDParams{KeyId: created.Response.ApiKey.ID})
+	require.NoError(err)
+
+	_, ok = del.(*api.DeleteAPIKeysIDNoContent)
+	assert.True(ok)
+}
+
+func TestBearerAuth(t *testing.T) {
+	assert, ctx, handler, sqliteClient := metest.NewTestHandler(t)
+	require := require.New(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	userCtx := context.WithValue(ctx, model.ContextKeyUserID, user.ID)
+
+	for _, hostname := range []string{"example.com", "other.example.com"} {
+		_, err = handler.PostWebsites(userCtx, &api.WebsiteCreate{Hostname: hostname})
+		require.NoError(err)
+	}
+
+	auth, err := util.NewAuthService(ctx, false)
+	require.NoError(err)
+
+	server, err := api.NewServer(handler,
+		middlewares.NewAuthHandler(auth, sqliteClient),
+		api.WithErrorHandler(middlewares.ErrorHandler),
+	)
+	require.NoError(err)
+
+	createKey := func(req *api.APIKeyCreate) (string, string) {
+		resp, err := handler.PostAPIKeys(userCtx, req, api.PostAPIKeysParams{})
+		require.NoError(err)
+
+		created, ok := resp.(*api.APIKeyCreatedHeaders)
+		require.True(ok)
+
+		return created.Response.ApiKey.ID, created.Response.Key
+	}
+
+	_, readKey := createKey(&api.APIKeyCreate{
+		Name:     "Read",
+		Role:     api.APIKeyRoleRead,
+		Hostname: api.NewOptString("example.com"),
+	})
+	adminID, adminKey := createKey(&api.APIKeyCreate{Name: "Admin", Role: api.APIKeyRoleAdmin})
+
+	do := func(method string, path string, body string, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	// Scoped keys only see their website.
+	rec := do(http.MethodGet, "/websites", "", readKey)
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.JSONEq(`[{"hostname":"example.com"}]`, rec.Body.String())
+
+	rec = do(http.MethodGet, "/website/example.com/summary", "", readKey)
+	assert.Equal(http.StatusOK, rec.Code)
+
+	rec = do(http.MethodGet, "/website/other.example.com/summary", "", readKey)
+	assert.Equal(http.StatusNotFound, rec.Code)
+
+	// Read keys can not manage websites.
+	rec = do(http.MethodPost, "/websites", `{"hostname":"new.example.com"}`, readKey)
+	assert.Equal(http.StatusForbidden, rec.Code)
+
+	rec = do(http.MethodPost, "/websites", `{"hostname":"new.example.com"}`, adminKey)
+	assert.Equal(http.StatusCreated, rec.Code)
+
+	rec = do(http.MethodGet, "/websites", "", adminKey)
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Contains(rec.Body.String(), "other.example.com")
+
+	// API keys can not manage API keys.
+	rec = do(http.MethodGet, "/api-keys", "", adminKey)
+	assert.Equal(http.StatusUnauthorized, rec.Code)
+
+	rec = do(http.MethodGet, "/websites", "", "")
+	assert.Equal(http.StatusUnauthorized, rec.Code)
+
+	rec = do(http.MethodGet, "/websites", "", adminID+".invalid")
+	assert.Equal(http.StatusUnauthorized, rec.Code)
+
+	// Usage is recorded.
+	key, err := sqliteClient.GetAPIKey(ctx, adminID)
+	require.NoError(err)
+	assert.NotZero(key.DateLastUsed)
+
+	// Expired keys are rejected.
+	expiredID, expiredKey, hash, err := auth.CreateAPIKey()
+	require.NoError(err)
+	require.NoError(sqliteClient.CreateAPIKey(ctx, model.NewAPIKey(
+		expiredID, user.ID, "Expired", hash, model.APIKeyRoleAdmin, "", 1, 1, 1,
+	)))
+
+	rec = do(http.MethodGet, "/websites", "", expiredKey)
+	assert.Equal(http.StatusUnauthorized, rec.Code)
+
+	// Revoked keys are rejected immediately, even when recently verified.
+	_, err = handler.DeleteAPIKeysID(userCtx, api.DeleteAPIKeysIDParams{KeyId: adminID})
+	require.NoError(err)
+
+	rec = do(http.MethodGet, "/websites", "", adminKey)
+	assert.Equal(http.StatusUnauthorized, rec.Code)
+}
+
+func TestBearerAuthLockout(t *testing.T) {
+	assert, ctx, handler, sqliteClient := metest.NewTestHandler(t)
+	require := require.New(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	auth, err := util.NewAuthService(ctx, false, util.WithLoginLimits(util.LoginLimits{
+		IPAttempts: 2,
+		Lockout:    time.Minute,
+	}))
+	require.NoError(err)
+
+	id, token, hash, err := auth.CreateAPIKey()
+	require.NoError(err)
+	require.NoError(sqliteClient.CreateAPIKey(ctx, model.NewAPIKey(
+		id, user.ID, "CI", hash, model.APIKeyRoleAdmin, "", 0, 1, 1,
+	)))
+
+	server, err := api.NewServer(handler,
+		middlewares.NewAuthHandler(auth, sqliteClient),
+		api.WithErrorHandler(middlewares.ErrorHandler),
+	)
+	require.NoError(err)
+
+	do := func(token string, ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/websites", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("X-Forwarded-For", ip)
+
+		rec := httptest.NewRecorder()
+		middlewares.ClientIP()(server).ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	for range 2 {
+		rec := do(id+".invalid", "203.0.113.1")
+		assert.Equal(http.StatusUnauthorized, rec.Code)
+	}
+
+	// The network is locked out, even with a valid key.
+	rec := do(token, "203.0.113.2")
+	assert.Equal(http.StatusTooManyRequests, rec.Code)
+	assert.Equal("60", rec.Header().Get("Retry-After"))
+
+	rec = do(token, "198.51.100.1")
+	assert.Equal(http.StatusOK, rec.Code)
+
+	// Lockouts are shared with logins and cleared the same way.
+	assert.True(auth.LoginLimiter().Clear(util.LockoutIP, "203.0.113.0/24"))
+
+	rec = do(token, "203.0.113.2")
+	assert.Equal(http.StatusOK, rec.Code)
+}
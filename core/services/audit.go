@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+	"go.jetify.com/typeid"
+)
+
+// auditChanges collects the fields changed by an audited action.
+type auditChanges []model.AuditChange
+
+// add records a field if its value changed.
+func (c *auditChanges) add(field string, before string, after string) {
+	if before == after {
+		return
+	}
+
+	*c = append(*c, model.AuditChange{Field: field, Before: before, After: after})
+}
+
+// addList records a list field if its values changed.
+func (c *auditChanges) addList(field string, before []string, after []string) {
+	c.add(field, strings.Join(before, ","), strings.Join(after, ","))
+}
+
+// redact records a change to a secret field without its values.
+func (c *auditChanges) redact(field string) {
+	*c = append(*c, model.AuditChange{Field: field, Before: model.AuditRedacted, After: model.AuditRedacted})
+}
+
+func (h *Handler) GetAudit(ctx context.Context, params api.GetAuditParams) (api.GetAuditRes, error) {
+	admin, err := h.isAdmin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if !admin {
+		return ErrForbidden(model.ErrUserForbidden), nil
+	}
+
+	filter := model.AuditFilter{
+		ActorID: params.Actor.Value,
+		Action:  model.AuditAction(params.Action.Value),
+		Target:  params.Target.Value,
+		Limit:   params.Limit.Value,
+		Offset:  params.Offset.Value,
+	}
+
+	if v, ok := params.Start.Get(); ok {
+		filter.DateStart = v.Unix()
+	}
+
+	if v, ok := params.End.Get(); ok {
+		filter.DateEnd = v.Unix()
+	}
+
+	entries, err := h.db.ListAuditEntries(ctx, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	resp := make([]api.AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		changes := make([]api.AuditChange, 0, len(entry.Changes))
+		for _, change := range entry.Changes {
+			changes = append(changes, api.AuditChange{
+				Field:  change.Field,
+				Before: optString(change.Before),
+				After:  optString(change.After),
+			})
+		}
+
+		resp = append(resp, api.AuditEntry{
+			ID:          entry.ID,
+			ActorId:     entry.ActorID,
+			ActorName:   entry.ActorName,
+			ApiKeyId:    optString(entry.APIKeyID),
+			Action:      string(entry.Action),
+			Target:      entry.Target,
+			Changes:     changes,
+			IP:          optString(entry.IP),
+			DateCreated: entry.DateCreated,
+		})
+	}
+
+	return &api.GetAuditOKHeaders{
+		Response: resp,
+	}, nil
+}
+
+// audit appends an action performed by the user of the request to the audit
+// log. The action has already been performed by the time it is recorded, so
+// failing to write the entry is logged instead of failing the request.
+func (h *Handler) audit(ctx context.Context, action model.AuditAction, target string, changes auditChanges) {
+	log := logger.Get()
+
+	userID, _ := ctx.Value(model.ContextKeyUserID).(string)
+
+	entry := &model.AuditEntry{
+		ActorID:     userID,
+		Action:      action,
+		Target:      target,
+		Changes:     changes,
+		DateCreated: h.auth.Now().Unix(),
+	}
+
+	typeID, err := typeid.WithPrefix(model.AuditPrefix)
+	if err != nil {
+		log.Error().Err(err).Str("action", string(action)).Msg("failed to create audit entry id")
+		return
+	}
+
+	entry.ID = typeID.String()
+
+	// Users deleting themselves are already gone, but their username is
+	// kept in the changes of the entry.
+	user, err := h.db.GetUser(ctx, userID)
+	if err == nil {
+		entry.ActorName = user.Username
+	} else if !errors.Is(err, model.ErrUserNotFound) {
+		log.Error().Err(err).Str("action", string(action)).Msg("failed to get audit actor")
+	}
+
+	if key, ok := ctx.Value(model.ContextKeyAPIKey).(*model.APIKey); ok {
+		entry.APIKeyID = key.ID
+	}
+
+	if ip, ok := ctx.Value(model.RequestKeyClientIP).(netip.Addr); ok {
+		entry.IP = ip.String()
+	}
+
+	err = h.db.CreateAuditEntry(ctx, entry)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("actor_id", userID).
+			Str("action", string(action)).
+			Str("target", target).
+			Msg("failed to write audit entry")
+	}
+}
+
+// optString returns an unset optional string for empty values.
+func optString(v string) api.OptString {
+	if v == "" {
+		return api.OptString{}
+	}
+
+	return api.NewOptString(v)
+}
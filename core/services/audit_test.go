@@ -0,0 +1,141 @@
+package services_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/middlewares"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/services"
+	"github.com/medama-io/medama/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLog(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient := metest.NewTestDatabases(t)
+
+	auth, err := util.NewAuthService(ctx, false)
+	require.NoError(err)
+
+	ingester := duckdb.NewIngester(duckdbClient, duckdb.IngestConfig{})
+	t.Cleanup(func() {
+		ingester.Close(t.Context()) //nolint:errcheck // Test cleanup.
+	})
+
+	handler, err := services.NewService(ctx, auth, sqliteClient, duckdbClient, ingester, "test-commit")
+	require.NoError(err)
+
+	server, err := api.NewServer(handler,
+		middlewares.NewAuthHandler(auth, sqliteClient),
+		api.WithErrorHandler(middlewares.ErrorHandler),
+		api.WithMiddleware(middlewares.RequestContext()),
+	)
+	require.NoError(err)
+
+	do := func(method string, path string, body string, cookie string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("X-Forwarded-For", "192.168.1.10")
+
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		if cookie != "" {
+			req.Header.Set("Cookie", cookie)
+		}
+
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	login := func(username string, password string) string {
+		rec := do(http.MethodPost, "/auth/login",
+			`{"username":"`+username+`","password":"`+password+`"}`, "")
+		require.Equal(http.StatusOK, rec.Code)
+
+		cookie, _, _ := strings.Cut(rec.Header().Get("Set-Cookie"), ";")
+
+		return cookie
+	}
+
+	listAudit := func(query string, cookie string) []api.AuditEntry {
+		rec := do(http.MethodGet, "/audit"+query, "", cookie)
+		require.Equal(http.StatusOK, rec.Code)
+
+		var entries []api.AuditEntry
+		require.NoError(json.Unmarshal(rec.Body.Bytes(), &entries))
+
+		return entries
+	}
+
+	admin, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	session := login("admin", "CHANGE_ME_ON_FIRST_LOGIN")
+
+	rec := do(http.MethodPost, "/websites", `{"hostname":"audit.example.com"}`, session)
+	require.Equal(http.StatusCreated, rec.Code)
+
+	rec = do(http.MethodPatch, "/tenant/settings", `{"blockedIPs":["203.0.113.1"]}`, session)
+	require.Equal(http.StatusOK, rec.Code)
+
+	rec = do(http.MethodPatch, "/user", `{"password":"new-password"}`, session)
+	require.Equal(http.StatusOK, rec.Code)
+
+	rec = do(http.MethodPost, "/users",
+		`{"username":"viewer","password":"viewer-password","role":"viewer"}`, session)
+	require.Equal(http.StatusCreated, rec.Code)
+
+	entries := listAudit("", session)
+	require.Len(entries, 4)
+
+	// Newest entries are listed first.
+	assert.Equal(string(model.AuditUserCreate), entries[0].Action)
+	assert.Equal(string(model.AuditWebsiteCreate), entries[3].Action)
+	assert.Equal("audit.example.com", entries[3].Target)
+	assert.Equal(admin.ID, entries[3].ActorId)
+	assert.Equal("admin", entries[3].ActorName)
+	assert.Equal(api.NewOptString("192.168.1.10"), entries[3].IP)
+
+	assert.Equal(string(model.AuditSettingsUpdate), entries[2].Action)
+	assert.Equal([]api.AuditChange{{
+		Field: "blocked_ips",
+		After: api.NewOptString("203.0.113.1"),
+	}}, entries[2].Changes)
+
+	// Secrets are never written to the audit log.
+	assert.Equal(string(model.AuditUserUpdate), entries[1].Action)
+	assert.Equal([]api.AuditChange{{
+		Field:  "password",
+		Before: api.NewOptString(model.AuditRedacted),
+		After:  api.NewOptString(model.AuditRedacted),
+	}}, entries[1].Changes)
+
+	rec = do(http.MethodGet, "/audit", "", session)
+	assert.NotContains(rec.Body.String(), "new-password")
+	assert.NotContains(rec.Body.String(), "viewer-password")
+
+	// Entries can be filtered and paginated.
+	entries = listAudit("?action=website.create&target=audit.example.com", session)
+	assert.Len(entries, 1)
+
+	entries = listAudit("?actor="+admin.ID+"&limit=2&offset=1", session)
+	if assert.Len(entries, 2) {
+		assert.Equal(string(model.AuditUserUpdate), entries[0].Action)
+	}
+
+	// Only admins can read the audit log.
+	rec = do(http.MethodGet, "/audit", "", login("viewer", "viewer-password"))
+	assert.Equal(http.StatusForbidden, rec.Code)
+}
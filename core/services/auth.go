@@ -7,17 +7,35 @@ import (
 	"time"
 
 	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/metrics"
 	"github.com/medama-io/medama/model"
 )
 
 func (h *Handler) PostAuthLogin(
 	ctx context.Context,
 	req *api.AuthLogin,
+	params api.PostAuthLoginParams,
 ) (api.PostAuthLoginRes, error) {
+	if !h.auth.PasswordLoginEnabled() {
+		return ErrForbidden(model.ErrPasswordLoginDisabled), nil
+	}
+
+	// Reject logins to locked out usernames or from locked out networks
+	// before doing any work.
+	limiter := h.auth.LoginLimiter()
+	prefix := loginPrefix(ctx)
+
+	if retryAfter := limiter.Check(req.Username, prefix); retryAfter > 0 {
+		metrics.RateLimited.Inc(metrics.LimiterLogin)
+
+		return ErrTooManyRequests(model.ErrLoginLocked, retryAfter), nil
+	}
+
 	// Check email and password.
 	user, err := h.db.GetUserByUsername(ctx, req.Username)
 	if err != nil {
 		if errors.Is(err, model.ErrUserNotFound) {
+			limiter.Fail(req.Username, prefix)
 			return ErrUnauthorised(model.ErrUserNotFound), nil
 		}
 
@@ -31,11 +49,33 @@ func (h *Handler) PostAuthLogin(
 	}
 
 	if !match {
+		limiter.Fail(req.Username, prefix)
 		return ErrUnauthorised(model.ErrUserNotFound), nil
 	}
 
+	limiter.Succeed(req.Username)
+
+	// Users with two-factor authentication complete the login with a code.
+	totpEnabled, err := h.isTOTPEnabled(ctx, user.ID)
+	if err != nil {
+		return ErrInternalServerError(err), nil
+	}
+
+	if totpEnabled {
+		challenge, err := h.auth.CreateLoginChallenge(ctx, user.ID)
+		if err != nil {
+			return ErrInternalServerError(err), nil
+		}
+
+		return &api.AuthLoginChallengeHeaders{
+			Response: api.AuthLoginChallenge{
+				Challenge: challenge,
+			},
+		}, nil
+	}
+
 	// Create session.
-	cookie, err := h.auth.CreateSession(ctx, user.ID)
+	cookie, err := h.auth.CreateSession(ctx, user.ID, params.UserAgent.Value)
 	if err != nil {
 		return ErrInternalServerError(err), nil
 	}
@@ -49,10 +89,24 @@ func (h *Handler) PostAuthLogout(
 	ctx context.Context,
 	params api.PostAuthLogoutParams,
 ) (api.PostAuthLogoutRes, error) {
-	h.auth.RevokeSession(ctx, params.MeSess)
+	// Invalid or expired sessions only need their cookie expired.
+	session, err := h.auth.LoadSession(ctx, params.MeSess)
+	if err == nil {
+		err = h.auth.RevokeSession(ctx, session.ID)
+		if err != nil {
+			return ErrInternalServerError(err), nil
+		}
+	}
+
+	return &api.PostAuthLogoutNoContent{
+		SetCookie: expiredSessionCookie().String(),
+	}, nil
+}
 
-	// Expire cookie.
-	cookie := &http.Cookie{
+// expiredSessionCookie returns a session cookie that is removed by the
+// browser.
+func expiredSessionCookie() *http.Cookie {
+	return &http.Cookie{
 		Name:     model.SessionCookieName,
 		Path:     "/",
 		HttpOnly: true,
@@ -60,8 +114,4 @@ func (h *Handler) PostAuthLogout(
 		SameSite: http.SameSiteLaxMode,
 		Expires:  time.Unix(0, 0),
 	}
-
-	return &api.PostAuthLogoutNoContent{
-		SetCookie: cookie.String(),
-	}, nil
 }
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/db/sqlite"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+)
+
+// DefaultDeletionRetryInterval is how often website deletions that failed are
+// retried.
+const DefaultDeletionRetryInterval = time.Minute
+
+// DeletionJob purges the page views and custom events of deleted websites in
+// the background. Pending deletions are stored in the app database, so a purge
+// interrupted by a restart is resumed when the job is started again.
+type DeletionJob struct {
+	db          *sqlite.Client
+	analyticsDB *duckdb.Client
+	ingester    *duckdb.Ingester
+
+	// notify wakes the job up when a deletion is queued.
+	notify chan struct{}
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewDeletionJob returns a new website deletion job. Queued hits of the
+// ingester are written before a website is purged, so no rows are left behind.
+func NewDeletionJob(
+	db *sqlite.Client,
+	analyticsDB *duckdb.Client,
+	ingester *duckdb.Ingester,
+) *DeletionJob {
+	return &DeletionJob{
+		db:          db,
+		analyticsDB: analyticsDB,
+		ingester:    ingester,
+		notify:      make(chan struct{}, 1),
+	}
+}
+
+// Start resumes any pending deletions and then purges newly queued deletions
+// in the background until the job is closed or the context is cancelled.
+func (d *DeletionJob) Start(ctx context.Context) {
+	ctx, d.cancel = context.WithCancel(ctx)
+
+	d.wg.Add(1)
+
+	go func() {
+		defer d.wg.Done()
+
+		log := logger.Get()
+		ticker := time.NewTicker(DefaultDeletionRetryInterval)
+		defer ticker.Stop()
+
+		for {
+			err := d.Run(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Error().Err(err).Msg("failed to purge deleted website data")
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-d.notify:
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Notify wakes up the job after a deletion is queued.
+func (d *DeletionJob) Notify() {
+	select {
+	case d.notify <- struct{}{}:
+	default:
+		// A run is already pending.
+	}
+}
+
+// Close stops the background job and waits for a running purge to finish its
+// current chunk.
+func (d *DeletionJob) Close() {
+	if d.cancel != nil {
+		d.cancel()
+	}
+
+	d.wg.Wait()
+}
+
+// Run purges all pending website deletions once.
+func (d *DeletionJob) Run(ctx context.Context) error {
+	deletions, err := d.db.ListWebsiteDeletions(ctx)
+	if err != nil {
+		return errors.Wrap(err, "deletion")
+	}
+
+	if len(deletions) == 0 {
+		return nil
+	}
+
+	if d.ingester != nil {
+		err = d.ingester.Flush(ctx)
+		if err != nil {
+			return errors.Wrap(err, "deletion: flush ingester")
+		}
+	}
+
+	var errs []error
+
+	for _, deletion := range deletions {
+		err = d.purge(ctx, deletion)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			errs = append(errs, errors.Wrap(err, deletion.Hostname))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Wrap(errors.Join(errs...), "deletion")
+	}
+
+	// Reclaim the space of the deleted rows.
+	err = d.analyticsDB.Compact(ctx)
+	if err != nil {
+		return errors.Wrap(err, "deletion: compact")
+	}
+
+	return nil
+}
+
+// purge deletes all data of the website and removes the deletion once done.
+func (d *DeletionJob) purge(ctx context.Context, deletion *model.WebsiteDeletion) error {
+	log := logger.Get().With().Str("hostname", deletion.Hostname).Logger()
+
+	// Rows deleted before an interrupted purge are no longer counted.
+	deletedViews, deletedEvents := deletion.DeletedViews, deletion.DeletedEvents
+
+	log.Info().
+		Int64("views", deletion.Views).
+		Int64("events", deletion.Events).
+		Int64("deleted_views", deletedViews).
+		Int64("deleted_events", deletedEvents).
+		Msg("purging deleted website data")
+
+	_, _, err := d.analyticsDB.DeleteWebsite(ctx, deletion.Hostname, func(views int64, events int64) error {
+		deletion.DeletedViews = deletedViews + views
+		deletion.DeletedEvents = deletedEvents + events
+		deletion.DateUpdated = time.Now().Unix()
+
+		log.Debug().
+			Int64("deleted_views", deletion.DeletedViews).
+			Int64("deleted_events", deletion.DeletedEvents).
+			Msg("purged deleted website data chunk")
+
+		return d.db.UpdateWebsiteDeletion(ctx, deletion)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = d.db.CompleteWebsiteDeletion(ctx, deletion.Hostname)
+	if err != nil {
+		return err
+	}
+
+	log.Info().
+		Int64("deleted_views", deletion.DeletedViews).
+		Int64("deleted_events", deletion.DeletedEvents).
+		Msg("purged deleted website data")
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package services_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeletionJob(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient := metest.NewTestDatabases(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	for _, hostname := range []string{"deleted.example.com", "kept.example.com"} {
+		require.NoError(sqliteClient.CreateWebsite(ctx, model.NewWebsite(user.ID, hostname, 1, 1)))
+
+		var views []model.ImportedPageView
+		for month := range 3 {
+			views = append(views, model.ImportedPageView{
+				PageViewHit: model.PageViewHit{
+					BID:      hostname + "_" + strconv.Itoa(month),
+					Hostname: hostname,
+					Pathname: "/",
+				},
+				DateCreated: time.Date(2024, time.Month(month+1), 1, 0, 0, 0, 0, time.UTC),
+			})
+		}
+
+		require.NoError(duckdbClient.InsertImportedViews(ctx, "import_deletion", views))
+	}
+
+	require.NoError(duckdbClient.InsertImportedEvents(ctx, "import_deletion", []model.ImportedEvent{{
+		EventHit: model.EventHit{
+			BatchID: "batch",
+			Group:   "deleted.example.com",
+			Name:    "event",
+			Value:   "signup",
+		},
+		DateCreated: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+	}}))
+
+	// Simulate a purge that was interrupted after deleting two page views.
+	require.NoError(sqliteClient.DeleteWebsite(ctx, &model.WebsiteDeletion{
+		Hostname:     "deleted.example.com",
+		UserID:       user.ID,
+		Views:        5,
+		Events:       1,
+		DeletedViews: 2,
+		DateCreated:  1,
+		DateUpdated:  1,
+	}))
+
+	job := services.NewDeletionJob(sqliteClient, duckdbClient, nil)
+	require.NoError(job.Run(ctx))
+
+	views, events, err := duckdbClient.CountWebsiteData(ctx, "deleted.example.com")
+	require.NoError(err)
+	assert.Zero(views)
+	assert.Zero(events)
+
+	views, _, err = duckdbClient.CountWebsiteData(ctx, "kept.example.com")
+	require.NoError(err)
+	assert.Equal(int64(3), views)
+
+	// The deletion is removed once complete, so the hostname can be reused.
+	_, err = sqliteClient.GetWebsiteDeletion(ctx, "deleted.example.com")
+	require.ErrorIs(err, model.ErrWebsiteDeletionNotFound)
+
+	require.NoError(sqliteClient.CreateWebsite(ctx, model.NewWebsite(user.ID, "deleted.example.com", 2, 2)))
+}
+
+func TestDeleteWebsitesID(t *testing.T) {
+	assert, ctx, handler, sqliteClient := metest.NewTestHandler(t)
+	require := require.New(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	ctx = context.WithValue(ctx, model.ContextKeyUserID, user.ID)
+
+	_, err = handler.PostWebsites(ctx, &api.WebsiteCreate{Hostname: "example.com"})
+	require.NoError(err)
+
+	// A dry run does not delete the website.
+	resp, err := handler.DeleteWebsitesID(ctx, api.DeleteWebsitesIDParams{
+		Hostname: "example.com",
+		DryRun:   api.NewOptBool(true),
+	})
+	require.NoError(err)
+
+	dryRun, ok := resp.(*api.DeleteWebsitesIDOK)
+	require.True(ok)
+	assert.Equal("example.com", dryRun.Response.Hostname)
+	assert.Zero(dryRun.Response.Views)
+
+	_, err = sqliteClient.GetWebsite(ctx, "example.com")
+	require.NoError(err)
+
+	resp, err = handler.DeleteWebsitesID(ctx, api.DeleteWebsitesIDParams{Hostname: "example.com"})
+	require.NoError(err)
+
+	accepted, ok := resp.(*api.DeleteWebsitesIDAccepted)
+	require.True(ok)
+	assert.Equal("example.com", accepted.Response.Hostname)
+
+	_, err = sqliteClient.GetWebsite(ctx, "example.com")
+	require.ErrorIs(err, model.ErrWebsiteNotFound)
+
+	// The deletion is reported until the background purge completes.
+	require.Eventually(func() bool {
+		res, err := handler.GetWebsitesIDDeletion(ctx, api.GetWebsitesIDDeletionParams{
+			Hostname: "example.com",
+		})
+		require.NoError(err)
+
+		_, pending := res.(*api.WebsiteDeletionHeaders)
+
+		return !pending
+	}, 5*time.Second, 10*time.Millisecond)
+
+	resp, err = handler.DeleteWebsitesID(ctx, api.DeleteWebsitesIDParams{Hostname: "example.com"})
+	require.NoError(err)
+
+	_, ok = resp.(*api.NotFoundErrorHeaders)
+	assert.True(ok)
+}
@@ -0,0 +1,708 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db"
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/db/sqlite"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+	"github.com/medama-io/medama/webhooks"
+	"go.jetify.com/typeid"
+)
+
+const (
+	// DefaultWebhookCheckInterval is how often traffic spike, new referrer and
+	// anomaly webhooks are checked.
+	DefaultWebhookCheckInterval = 5 * time.Minute
+
+	// webhookWorkers is the number of concurrent deliveries of custom event
+	// webhooks.
+	webhookWorkers = 4
+	// webhookQueueSize is the number of custom event webhooks waiting for a
+	// worker before new ones are dropped.
+	webhookQueueSize = 256
+	// webhookEventWindow is the aggregation window of custom event webhooks.
+	// The first event of a window is sent immediately, while later events are
+	// sent together once the window ends, so a flood of events sent to the
+	// public event endpoint results in at most one delivery per window.
+	webhookEventWindow = time.Minute
+	// webhookEventFlushInterval is how often ended aggregation windows are
+	// sent.
+	webhookEventFlushInterval = 10 * time.Second
+	// webhookSpikePeriod is the period visitors are counted over for traffic
+	// spike webhooks, which also fire at most once per period.
+	webhookSpikePeriod = time.Hour
+	// webhookIngestDelay is subtracted from the end of the period checked for
+	// new referrers and anomalies, so page views still queued in the ingester are not
+	// skipped.
+	webhookIngestDelay = time.Minute
+	// webhookDeliveryRetention is how long delivery log entries are kept.
+	webhookDeliveryRetention = 30 * 24 * time.Hour
+	// webhookMaxReferrers limits the referrers listed in the message text.
+	webhookMaxReferrers = 10
+)
+
+// WebhookPayload is the JSON body sent to webhook URLs. Text and Content hold
+// the same message, so the payload can be posted directly to Slack and
+// Discord incoming webhooks.
+type WebhookPayload struct {
+	ID        string               `json:"id"`
+	Event     model.WebhookTrigger `json:"event"`
+	Hostname  string               `json:"hostname"`
+	Timestamp time.Time            `json:"timestamp"`
+	Text      string               `json:"text"`
+	Content   string               `json:"content"`
+	Data      any                  `json:"data"`
+}
+
+// TrafficSpikeData is the data of traffic spike payloads.
+type TrafficSpikeData struct {
+	Visitors  int `json:"visitors"`
+	Threshold int `json:"threshold"`
+}
+
+// NewReferrerData is the data of new referrer payloads.
+type NewReferrerData struct {
+	Referrers []string `json:"referrers"`
+}
+
+// AnomalyData is the data of anomaly payloads.
+type AnomalyData struct {
+	Start     time.Time       `json:"start"`
+	End       time.Time       `json:"end"`
+	Anomalies []AnomalyMetric `json:"anomalies"`
+}
+
+// AnomalyMetric is a metric of an anomaly payload that deviates from the
+// baseline.
+type AnomalyMetric struct {
+	Metric   model.AnomalyMetric `json:"metric"`
+	Value    float64             `json:"value"`
+	Expected float64             `json:"expected"`
+	Score    float64             `json:"score"`
+}
+
+// CustomEventData is the data of custom event payloads. Count is the number
+// of events the payload is sent for, in which case the properties are those
+// of the last event.
+type CustomEventData struct {
+	Name       string            `json:"name"`
+	Properties map[string]string `json:"properties"`
+	Count      int               `json:"count"`
+}
+
+// webhookDelivery is a custom event webhook waiting for a worker.
+type webhookDelivery struct {
+	webhook *model.Webhook
+	payload *WebhookPayload
+}
+
+// eventWindow counts the events of a custom event webhook received since its
+// last delivery.
+type eventWindow struct {
+	webhook    *model.Webhook
+	start      time.Time
+	count      int
+	properties map[string]string
+}
+
+// WebhookDispatcher sends webhooks when their trigger fires. Custom event
+// webhooks are matched against ingested events, aggregated per webhook and
+// delivered by a pool of workers from their own queue. Traffic spike, new
+// referrer and anomaly webhooks are checked and delivered periodically by a
+// separate goroutine, so a flood of custom events can not delay them. Every
+// delivery is recorded in the delivery log of the webhook.
+type WebhookDispatcher struct {
+	db          *sqlite.Client
+	analyticsDB *duckdb.Client
+	client      *webhooks.Client
+	interval    time.Duration
+
+	// events caches the custom event webhooks by hostname, as they are
+	// matched on every custom event hit.
+	mu     sync.RWMutex
+	events map[string][]*model.Webhook
+
+	// windows holds the open aggregation windows by webhook ID.
+	windowMu sync.Mutex
+	windows  map[string]*eventWindow
+
+	// eventQueue only holds custom event webhooks.
+	eventQueue chan webhookDelivery
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+// NewWebhookDispatcher returns a new webhook dispatcher checking periodic
+// webhooks every interval.
+func NewWebhookDispatcher(
+	db *sqlite.Client,
+	analyticsDB *duckdb.Client,
+	client *webhooks.Client,
+	interval time.Duration,
+) *WebhookDispatcher {
+	if interval <= 0 {
+		interval = DefaultWebhookCheckInterval
+	}
+
+	return &WebhookDispatcher{
+		db:          db,
+		analyticsDB: analyticsDB,
+		client:      client,
+		interval:    interval,
+		events:      map[string][]*model.Webhook{},
+		windows:     map[string]*eventWindow{},
+		eventQueue:  make(chan webhookDelivery, webhookQueueSize),
+	}
+}
+
+// Start loads the custom event webhooks, starts the delivery workers and
+// checks the periodic webhooks and aggregation windows until the dispatcher
+// is closed or the context is cancelled.
+func (d *WebhookDispatcher) Start(ctx context.Context) {
+	ctx, d.cancel = context.WithCancel(ctx)
+
+	d.Reload(ctx)
+
+	for range webhookWorkers {
+		d.wg.Add(1)
+
+		go func() {
+			defer d.wg.Done()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case delivery := <-d.eventQueue:
+					d.deliver(ctx, delivery.webhook, delivery.payload)
+				}
+			}
+		}()
+	}
+
+	d.wg.Add(1)
+
+	go func() {
+		defer d.wg.Done()
+
+		ticker := time.NewTicker(webhookEventFlushInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				d.FlushEvents(now)
+			}
+		}
+	}()
+
+	d.wg.Add(1)
+
+	go func() {
+		defer d.wg.Done()
+
+		log := logger.Get()
+		ticker := time.NewTicker(d.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				_, err := d.Check(ctx, now)
+				if err != nil && ctx.Err() == nil {
+					log.Error().Err(err).Msg("failed to check webhooks")
+				}
+			}
+		}
+	}()
+}
+
+// Close stops the dispatcher and waits for running deliveries to be
+// cancelled. Queued deliveries are dropped.
+func (d *WebhookDispatcher) Close() {
+	if d.cancel != nil {
+		d.cancel()
+	}
+
+	d.wg.Wait()
+}
+
+// Reload refreshes the cached custom event webhooks after webhooks or
+// websites change.
+func (d *WebhookDispatcher) Reload(ctx context.Context) {
+	all, err := d.db.ListAllWebhooks(ctx)
+	if err != nil {
+		log := logger.Get()
+		log.Error().Err(err).Msg("failed to reload webhooks")
+
+		return
+	}
+
+	events := map[string][]*model.Webhook{}
+	byID := map[string]*model.Webhook{}
+
+	for _, webhook := range all {
+		if webhook.Trigger == model.WebhookTriggerCustomEvent {
+			events[webhook.Hostname] = append(events[webhook.Hostname], webhook)
+			byID[webhook.ID] = webhook
+		}
+	}
+
+	d.mu.Lock()
+	d.events = events
+	d.mu.Unlock()
+
+	// Close the windows of deleted webhooks and send the others to the
+	// updated webhook.
+	d.windowMu.Lock()
+	defer d.windowMu.Unlock()
+
+	for id, window := range d.windows {
+		webhook, ok := byID[id]
+		if !ok {
+			delete(d.windows, id)
+			continue
+		}
+
+		window.webhook = webhook
+	}
+}
+
+// EventHit queues the custom event webhooks of the website matching one of
+// the properties of an ingested event. Each webhook is sent at most once per
+// event and at most once per aggregation window, with later events counted
+// until the window ends. Webhooks are dropped if the queue is full, so
+// ingestion is never blocked.
+func (d *WebhookDispatcher) EventHit(hostname string, events []model.EventHit) {
+	d.mu.RLock()
+	hooks := d.events[hostname]
+	d.mu.RUnlock()
+
+	if len(hooks) == 0 || len(events) == 0 {
+		return
+	}
+
+	var properties map[string]string
+
+	now := time.Now()
+
+	for _, webhook := range hooks {
+		if !hasEventName(events, webhook.EventName) {
+			continue
+		}
+
+		if properties == nil {
+			properties = make(map[string]string, len(events))
+			for _, event := range events {
+				properties[event.Name] = event.Value
+			}
+		}
+
+		d.windowMu.Lock()
+		window, ok := d.windows[webhook.ID]
+
+		if ok {
+			window.count++
+			window.properties = properties
+			d.windowMu.Unlock()
+
+			continue
+		}
+
+		d.windows[webhook.ID] = &eventWindow{webhook: webhook, start: now}
+		d.windowMu.Unlock()
+
+		d.queueEvent(webhook, customEventPayload(webhook, properties, 1, now))
+	}
+}
+
+// FlushEvents queues the events counted in the aggregation windows that ended
+// at the given time as a single delivery per webhook and returns the number of
+// webhooks queued. Windows without events are closed, so the next event is
+// sent immediately again.
+func (d *WebhookDispatcher) FlushEvents(now time.Time) int {
+	var ended []eventWindow
+
+	d.windowMu.Lock()
+
+	for id, window := range d.windows {
+		if now.Sub(window.start) < webhookEventWindow {
+			continue
+		}
+
+		if window.count == 0 {
+			delete(d.windows, id)
+			continue
+		}
+
+		ended = append(ended, *window)
+
+		window.start = now
+		window.count = 0
+		window.properties = nil
+	}
+
+	d.windowMu.Unlock()
+
+	for _, window := range ended {
+		d.queueEvent(window.webhook, customEventPayload(window.webhook, window.properties, window.count, now))
+	}
+
+	return len(ended)
+}
+
+// queueEvent queues a custom event webhook for the workers, dropping it if
+// the queue is full.
+func (d *WebhookDispatcher) queueEvent(webhook *model.Webhook, payload *WebhookPayload) {
+	select {
+	case d.eventQueue <- webhookDelivery{webhook: webhook, payload: payload}:
+	default:
+		log := logger.Get()
+		log.Warn().
+			Str("id", webhook.ID).
+			Str("hostname", webhook.Hostname).
+			Msg("webhook queue full, dropping custom event webhook")
+	}
+}
+
+// customEventPayload returns the payload of a custom event webhook sent for
+// count events.
+func customEventPayload(
+	webhook *model.Webhook,
+	properties map[string]string,
+	count int,
+	now time.Time,
+) *WebhookPayload {
+	text := "Custom event " + strconv.Quote(webhook.EventName) + " received on " + webhook.Hostname + "."
+	if count > 1 {
+		text = "Custom event " + strconv.Quote(webhook.EventName) + " received " + strconv.Itoa(count) +
+			" times on " + webhook.Hostname + "."
+	}
+
+	return &WebhookPayload{
+		Event:     model.WebhookTriggerCustomEvent,
+		Hostname:  webhook.Hostname,
+		Timestamp: now.UTC(),
+		Text:      text,
+		Data: CustomEventData{
+			Name:       webhook.EventName,
+			Properties: properties,
+			Count:      count,
+		},
+	}
+}
+
+// Check sends the traffic spike, new referrer and anomaly webhooks whose
+// trigger fired at the given time and prunes old delivery log entries. It
+// returns the number of webhooks sent.
+func (d *WebhookDispatcher) Check(ctx context.Context, now time.Time) (int, error) {
+	all, err := d.db.ListAllWebhooks(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "webhooks")
+	}
+
+	var (
+		sent int
+		errs []error
+	)
+
+	for _, webhook := range all {
+		var payload *WebhookPayload
+
+		switch webhook.Trigger {
+		case model.WebhookTriggerTrafficSpike:
+			payload, err = d.checkTrafficSpike(ctx, webhook, now)
+		case model.WebhookTriggerNewReferrer:
+			payload, err = d.checkNewReferrer(ctx, webhook, now)
+		case model.WebhookTriggerAnomaly:
+			payload, err = d.checkAnomaly(ctx, webhook, now)
+		case model.WebhookTriggerCustomEvent:
+			continue
+		}
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return sent, ctx.Err()
+			}
+
+			errs = append(errs, errors.Wrap(err, webhook.ID))
+
+			continue
+		}
+
+		if payload != nil {
+			d.deliver(ctx, webhook, payload)
+			sent++
+		}
+	}
+
+	_, err = d.db.DeleteWebhookDeliveriesBefore(ctx, now.Add(-webhookDeliveryRetention).Unix())
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return sent, errors.Wrap(errors.Join(errs...), "webhooks")
+	}
+
+	return sent, nil
+}
+
+// checkTrafficSpike returns the payload of a traffic spike webhook if the
+// visitors in the last hour reached its threshold and it was not sent within
+// the last hour.
+func (d *WebhookDispatcher) checkTrafficSpike(
+	ctx context.Context,
+	webhook *model.Webhook,
+	now time.Time,
+) (*WebhookPayload, error) {
+	start := now.Add(-webhookSpikePeriod)
+	if webhook.DateLastTriggered > start.Unix() {
+		return nil, nil
+	}
+
+	summary, err := d.analyticsDB.GetWebsiteSummary(ctx, &db.Filters{
+		Hostname:    webhook.Hostname,
+		PeriodStart: start.UTC().Format(model.DateFormat),
+		PeriodEnd:   now.UTC().Format(model.DateFormat),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if summary.Visitors < webhook.Threshold {
+		return nil, nil
+	}
+
+	return &WebhookPayload{
+		Event:     model.WebhookTriggerTrafficSpike,
+		Hostname:  webhook.Hostname,
+		Timestamp: now.UTC(),
+		Text: webhook.Hostname + " had " + strconv.Itoa(summary.Visitors) +
+			" visitors in the last hour, reaching the threshold of " + strconv.Itoa(webhook.Threshold) + ".",
+		Data: TrafficSpikeData{
+			Visitors:  summary.Visitors,
+			Threshold: webhook.Threshold,
+		},
+	}, nil
+}
+
+// checkNewReferrer returns the payload of a new referrer webhook if referrers
+// sent their first visitors since the last check. Referrers seen before the
+// webhook was created are not new.
+func (d *WebhookDispatcher) checkNewReferrer(
+	ctx context.Context,
+	webhook *model.Webhook,
+	now time.Time,
+) (*WebhookPayload, error) {
+	since := time.Unix(webhook.DateLastChecked, 0)
+	until := now.Add(-webhookIngestDelay).Truncate(time.Second)
+
+	if !until.After(since) {
+		return nil, nil
+	}
+
+	referrers, err := d.analyticsDB.GetNewReferrers(ctx, webhook.Hostname, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.db.UpdateWebhookLastChecked(ctx, webhook.ID, until.Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(referrers) == 0 {
+		return nil, nil
+	}
+
+	listed := referrers
+	if len(listed) > webhookMaxReferrers {
+		listed = listed[:webhookMaxReferrers]
+	}
+
+	text := "New referrers for " + webhook.Hostname + ": " + strings.Join(listed, ", ")
+	if len(referrers) > len(listed) {
+		text += " and " + strconv.Itoa(len(referrers)-len(listed)) + " more"
+	}
+
+	return &WebhookPayload{
+		Event:     model.WebhookTriggerNewReferrer,
+		Hostname:  webhook.Hostname,
+		Timestamp: now.UTC(),
+		Text:      text + ".",
+		Data: NewReferrerData{
+			Referrers: referrers,
+		},
+	}, nil
+}
+
+// checkAnomaly returns the payload of an anomaly webhook if the last full
+// hour, which was not checked before, deviates from the same hour in previous
+// weeks.
+func (d *WebhookDispatcher) checkAnomaly(
+	ctx context.Context,
+	webhook *model.Webhook,
+	now time.Time,
+) (*WebhookPayload, error) {
+	end := now.Add(-webhookIngestDelay).Truncate(time.Hour)
+	start := end.Add(-time.Hour)
+
+	if webhook.DateLastChecked >= end.Unix() {
+		return nil, nil
+	}
+
+	// The period end is inclusive, so stop before the next hour starts.
+	filters := &db.Filters{
+		Hostname:    webhook.Hostname,
+		PeriodStart: start.UTC().Format(model.DateFormat),
+		PeriodEnd:   end.Add(-time.Second).UTC().Format(model.DateFormat),
+	}
+
+	interval := api.GetWebsiteIDSummaryIntervalHour
+
+	current, err := d.analyticsDB.GetWebsiteIntervals(ctx, filters, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	anomalies, err := intervalAnomalies(ctx, d.analyticsDB, filters, interval, current)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.db.UpdateWebhookLastChecked(ctx, webhook.ID, end.Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(anomalies) == 0 || len(anomalies[0]) == 0 {
+		return nil, nil
+	}
+
+	data := AnomalyData{
+		Start:     start.UTC(),
+		End:       end.UTC(),
+		Anomalies: make([]AnomalyMetric, 0, len(anomalies[0])),
+	}
+
+	metrics := make([]string, 0, len(anomalies[0]))
+
+	for _, a := range anomalies[0] {
+		data.Anomalies = append(data.Anomalies, AnomalyMetric(a))
+		metrics = append(metrics, string(a.Metric)+" "+formatAnomalyValue(a.Value)+
+			" (expected "+formatAnomalyValue(a.Expected)+")")
+	}
+
+	return &WebhookPayload{
+		Event:     model.WebhookTriggerAnomaly,
+		Hostname:  webhook.Hostname,
+		Timestamp: now.UTC(),
+		Text: "Unusual traffic on " + webhook.Hostname + " between " + start.UTC().Format("15:04") +
+			" and " + end.UTC().Format("15:04") + " UTC: " + strings.Join(metrics, ", ") + ".",
+		Data: data,
+	}, nil
+}
+
+// deliver sends the payload to the webhook and records the outcome in its
+// delivery log.
+func (d *WebhookDispatcher) deliver(ctx context.Context, webhook *model.Webhook, payload *WebhookPayload) {
+	log := logger.Get().With().
+		Str("id", webhook.ID).
+		Str("hostname", webhook.Hostname).
+		Str("trigger", string(webhook.Trigger)).
+		Logger()
+
+	typeID, err := typeid.WithPrefix(model.WebhookDeliveryPrefix)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create webhook delivery id")
+		return
+	}
+
+	payload.ID = typeID.String()
+	payload.Content = payload.Text
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to encode webhook payload")
+		return
+	}
+
+	dateCreated := time.Now().Unix()
+	result := d.client.Deliver(ctx, &webhooks.Request{
+		URL:        webhook.URL,
+		Secret:     webhook.Secret,
+		Event:      string(webhook.Trigger),
+		DeliveryID: payload.ID,
+		Body:       body,
+	})
+
+	delivery := &model.WebhookDelivery{
+		ID:            payload.ID,
+		WebhookID:     webhook.ID,
+		Trigger:       webhook.Trigger,
+		Payload:       string(body),
+		StatusCode:    result.StatusCode,
+		Attempts:      result.Attempts,
+		DateCreated:   dateCreated,
+		DateCompleted: time.Now().Unix(),
+	}
+
+	if result.Err != nil {
+		delivery.Error = result.Err.Error()
+
+		log.Warn().
+			Err(result.Err).
+			Int("attempts", result.Attempts).
+			Int("status_code", result.StatusCode).
+			Msg("failed to deliver webhook")
+	}
+
+	// Record the delivery even if the request was cancelled by a shutdown.
+	ctx = context.WithoutCancel(ctx)
+
+	err = d.db.CreateWebhookDelivery(ctx, delivery)
+	if err != nil {
+		if !errors.Is(err, model.ErrWebhookNotFound) {
+			log.Error().Err(err).Msg("failed to record webhook delivery")
+		}
+
+		return
+	}
+
+	err = d.db.UpdateWebhookLastTriggered(ctx, webhook.ID, delivery.DateCompleted)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to update webhook last triggered")
+	}
+}
+
+// formatAnomalyValue formats a metric value with up to two decimals.
+func formatAnomalyValue(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+}
+
+// hasEventName reports whether one of the events has the property name.
+func hasEventName(events []model.EventHit, name string) bool {
+	for _, event := range events {
+		if event.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,7 +1,9 @@
 package services
 
 import (
+	"math"
 	"net/http"
+	"time"
 
 	"github.com/medama-io/medama/api"
 )
@@ -66,6 +68,20 @@ func ErrInternalServerError(err error) *api.InternalServerErrorHeaders {
 	}
 }
 
+// ErrTooManyRequests returns an API specific TooManyRequestsError pointer,
+// asking the client to retry after the given duration.
+func ErrTooManyRequests(err error, retryAfter time.Duration) *api.TooManyRequestsErrorHeaders {
+	return &api.TooManyRequestsErrorHeaders{
+		RetryAfter: int(math.Ceil(retryAfter.Seconds())),
+		Response: api.TooManyRequestsError{
+			Error: api.TooManyRequestsErrorError{
+				Code:    http.StatusTooManyRequests,
+				Message: err.Error(),
+			},
+		},
+	}
+}
+
 // ErrUnauthorised returns an API specific UnauthorisedError pointer.
 func ErrUnauthorised(err error) *api.UnauthorisedErrorHeaders {
 	return &api.UnauthorisedErrorHeaders{
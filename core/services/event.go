@@ -11,6 +11,7 @@ import (
 	"github.com/go-faster/errors"
 	"github.com/medama-io/medama/api"
 	"github.com/medama-io/medama/iputils"
+	"github.com/medama-io/medama/metrics"
 	"github.com/medama-io/medama/model"
 	"github.com/medama-io/medama/util/logger"
 	"go.jetify.com/typeid"
@@ -154,6 +155,8 @@ func (h *Handler) PostEventHit(
 	// Check if IP is blocked
 	if h.RuntimeConfig.IPFilter.HasIP(clientIP) {
 		log.Debug().Msg("hit: client IP is blocked")
+		metrics.Hits.Inc(string(req.Type), metrics.OutcomeBlockedIP)
+
 		return &api.PostEventHitNoContent{}, nil
 	}
 
@@ -166,12 +169,15 @@ func (h *Handler) PostEventHit(
 
 	switch req.Type {
 	case api.EventLoadEventHit:
-		hostname := req.EventLoad.U.Hostname()
-		log = log.With().Str("hostname", hostname).Logger()
+		originalHostname := req.EventLoad.U.Hostname()
+		log = log.With().Str("hostname", originalHostname).Logger()
 
-		// Verify hostname exists
-		if !h.hostnames.Has(hostname) {
+		// Verify hostname exists, resolving aliases to their website
+		hostname, ok := h.resolveHostname(originalHostname)
+		if !ok {
 			log.Warn().Msg("hit: website not found")
+			metrics.Hits.Inc(string(req.Type), metrics.OutcomeUnknownHostname)
+
 			return ErrNotFound(model.ErrWebsiteNotFound), nil
 		}
 
@@ -188,6 +194,8 @@ func (h *Handler) PostEventHit(
 		// If the user agent is a bot, we want to ignore it.
 		if ua.IsBot() {
 			log.Debug().Str("user_agent", rawUserAgent).Msg("hit: user agent is a bot")
+			metrics.Hits.Inc(string(req.Type), metrics.OutcomeBot)
+
 			return &api.PostEventHitNoContent{}, nil
 		}
 
@@ -213,6 +221,7 @@ func (h *Handler) PostEventHit(
 			log.Debug().Str("user_agent", rawUserAgent).Msg("hit: unknown user agent")
 
 			if unknownCounter >= IsBotThreshold {
+				metrics.Hits.Inc(string(req.Type), metrics.OutcomeBot)
 				return &api.PostEventHitNoContent{}, nil
 			}
 		}
@@ -226,6 +235,7 @@ func (h *Handler) PostEventHit(
 
 			unknownCounter++
 			if unknownCounter >= IsBotThreshold {
+				metrics.Hits.Inc(string(req.Type), metrics.OutcomeBot)
 				return &api.PostEventHitNoContent{}, nil
 			}
 
@@ -239,6 +249,7 @@ func (h *Handler) PostEventHit(
 
 			unknownCounter++
 			if unknownCounter >= IsBotThreshold {
+				metrics.Hits.Inc(string(req.Type), metrics.OutcomeBot)
 				return &api.PostEventHitNoContent{}, nil
 			}
 		}
@@ -255,7 +266,7 @@ func (h *Handler) PostEventHit(
 		}
 
 		// Parse referrer URL and extract the host and group name.
-		referrer, err := h.referrer.Parse(req.EventLoad.R.Value, hostname)
+		referrer, err := h.referrer.Parse(req.EventLoad.R.Value, originalHostname)
 		if err != nil {
 			log.Warn().Err(err).Msg("hit: failed to parse referrer URL")
 			return ErrBadRequest(err), nil
@@ -264,6 +275,8 @@ func (h *Handler) PostEventHit(
 		// If the referrer is spam, we want to ignore it.
 		if referrer.IsSpam {
 			log.Debug().Str("referrer_host", referrer.Host).Msg("hit: referrer is spam")
+			metrics.Hits.Inc(string(req.Type), metrics.OutcomeSpam)
+
 			return &api.PostEventHitNoContent{}, nil
 		}
 
@@ -273,9 +286,20 @@ func (h *Handler) PostEventHit(
 		utmMedium := queries.Get("utm_medium")
 		utmCampaign := queries.Get("utm_campaign")
 
+		// Group page views from the same visitor into a visit if enabled.
+		// Otherwise, or if this fails, the page view is a single page visit.
+		var visitID string
+		if h.visits != nil {
+			visitID, err = h.visits.VisitID(ctx, clientIP.String(), rawUserAgent, hostname)
+			if err != nil {
+				log.Error().Err(err).Msg("hit: failed to get visit id")
+			}
+		}
+
 		event := &model.PageViewHit{
 			// Required
 			BID:          req.EventLoad.B,
+			VisitID:      visitID,
 			Hostname:     hostname,
 			Pathname:     pathname,
 			IsUniqueUser: req.EventLoad.P,
@@ -296,8 +320,15 @@ func (h *Handler) PostEventHit(
 			UTMCampaign: utmCampaign,
 		}
 
+		// Keep the alias the hit was received on as a reportable dimension.
+		if hostname != originalHostname {
+			event.OriginalHostname = originalHostname
+			log = log.With().Str("website", hostname).Logger()
+		}
+
 		log = log.With().
 			Str("bid", event.BID).
+			Str("visit_id", event.VisitID).
 			Str("event_type", string(req.Type)).
 			Str("pathname", event.Pathname).
 			Bool("is_unique_user", event.IsUniqueUser).
@@ -353,21 +384,25 @@ func (h *Handler) PostEventHit(
 				Int("event_count", len(events)).
 				Logger()
 
-			err = h.analyticsDB.AddPageView(ctx, event, &events)
+			err = h.ingester.AddPageView(event, &events)
 			if err != nil {
 				log.Error().Err(err).Msg("hit: failed to add page view")
 				return ErrInternalServerError(err), nil
 			}
+
+			h.webhooks.EventHit(hostname, events)
 		} else {
-			err = h.analyticsDB.AddPageView(ctx, event, nil)
+			err = h.ingester.AddPageView(event, nil)
 			if err != nil {
 				log.Error().Err(err).Msg("hit: failed to add page view")
 				return ErrInternalServerError(err), nil
 			}
 		}
 
+		h.recordRealtimeHit(event)
+
 		// Log success
-		log.Debug().Msg("hit: added page view")
+		log.Debug().Msg("hit: queued page view")
 	case api.EventUnloadEventHit:
 		event := &model.PageViewDuration{
 			BID:        req.EventUnload.B,
@@ -380,26 +415,28 @@ func (h *Handler) PostEventHit(
 			Int("duration_ms", event.DurationMs).
 			Logger()
 
-		err := h.analyticsDB.UpdatePageView(ctx, event)
+		err := h.ingester.UpdatePageView(event)
 		if err != nil {
 			log.Error().Err(err).Msg("hit: failed to update page view")
 			return ErrInternalServerError(err), nil
 		}
 
 		// Log success
-		log.Debug().Msg("hit: updated page view")
+		log.Debug().Msg("hit: queued page view update")
 
 	case api.EventCustomEventHit:
 		if (len(req.EventCustom.D)) == 0 {
 			return ErrBadRequest(model.ErrInvalidProperties), nil
 		}
 
-		group := req.EventCustom.G
-		log = log.With().Str("group_name", group).Logger()
+		log = log.With().Str("group_name", req.EventCustom.G).Logger()
 
 		// Verify hostname exists as hostname is used as the group name.
-		if !h.hostnames.Has(group) {
+		group, ok := h.resolveHostname(req.EventCustom.G)
+		if !ok {
 			log.Warn().Msg("hit: website not found")
+			metrics.Hits.Inc(string(req.Type), metrics.OutcomeUnknownHostname)
+
 			return ErrNotFound(model.ErrWebsiteNotFound), nil
 		}
 
@@ -442,17 +479,31 @@ func (h *Handler) PostEventHit(
 			Int("event_count", len(events)).
 			Logger()
 
-		err = h.analyticsDB.AddEvents(ctx, &events)
+		err = h.ingester.AddEvents(&events)
 		if err != nil {
 			log.Error().Err(err).Msg("hit: failed to add event")
 			return ErrInternalServerError(err), nil
 		}
 
-		log.Debug().Msg("hit: added custom events")
+		h.webhooks.EventHit(group, events)
+
+		log.Debug().Msg("hit: queued custom events")
 	default:
 		log.Error().Str("type", string(req.Type)).Msg("hit: invalid event hit type")
 		return ErrBadRequest(model.ErrInvalidTrackerEvent), nil
 	}
 
+	metrics.Hits.Inc(string(req.Type), metrics.OutcomeAccepted)
+
 	return &api.PostEventHitNoContent{}, nil
 }
+
+// resolveHostname returns the hostname of the website a hit received on the
+// given hostname belongs to. Websites take precedence over aliases.
+func (h *Handler) resolveHostname(hostname string) (string, bool) {
+	if h.hostnames.Has(hostname) {
+		return hostname, true
+	}
+
+	return h.aliases.Resolve(hostname)
+}
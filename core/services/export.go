@@ -0,0 +1,329 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"io"
+	"mime"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db"
+	"github.com/medama-io/medama/util/logger"
+)
+
+// exportRow is a report row of the API, which is exported using the same
+// field names as the JSON response.
+type exportRow interface {
+	Encode(e *jx.Encoder)
+}
+
+// exportEncoder writes report rows in an export format.
+type exportEncoder interface {
+	Encode(row exportRow) error
+	// Close flushes any buffered rows.
+	Close() error
+}
+
+// exportFilters returns a copy of the filters without pagination, as exports
+// contain the full report.
+func exportFilters(filters *db.Filters) *db.Filters {
+	export := *filters
+	export.Limit = 0
+	export.Offset = 0
+
+	return &export
+}
+
+// exportReport streams the rows of a report to the returned reader in the
+// given format as they are read from the database, so the full report is
+// never held in memory.
+//
+// The query is started before returning, so errors before the first row can
+// still be returned as an error response. Later errors end the export early.
+func exportReport[T exportRow](
+	ctx context.Context,
+	format api.Format,
+	stream func(emit func(T) error) error,
+) (io.ReadCloser, error) {
+	pr, pw := io.Pipe()
+
+	var enc exportEncoder
+	switch format {
+	case api.FormatCsv:
+		enc = newCSVEncoder(pw, exportColumns(reflect.TypeFor[T]()))
+	case api.FormatNdjson:
+		enc = newNDJSONEncoder(pw)
+	default:
+		return nil, errors.Errorf("unknown export format: %s", format)
+	}
+
+	started := make(chan error, 1)
+
+	go func() {
+		// Unblock the writer if the response is never read, e.g. when the
+		// request is cancelled before the body is sent.
+		stop := context.AfterFunc(ctx, func() {
+			pr.CloseWithError(ctx.Err())
+		})
+		defer stop()
+
+		first := true
+		err := stream(func(row T) error {
+			if first {
+				first = false
+				started <- nil
+			}
+
+			return enc.Encode(row)
+		})
+
+		if first {
+			started <- err
+		}
+
+		if err == nil {
+			err = enc.Close()
+		} else if !first {
+			log := logger.Get()
+			log.Error().Err(err).Msg("failed to export report")
+		}
+
+		pw.CloseWithError(err)
+	}()
+
+	err := <-started
+	if err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+// exportList exports a report that has already been read, for reports whose
+// size is bounded by their configuration such as goals and funnels.
+func exportList[T any, PT interface {
+	*T
+	exportRow
+}](ctx context.Context, format api.Format, items []T) (io.ReadCloser, error) {
+	return exportReport(ctx, format, func(emit func(PT) error) error {
+		for i := range items {
+			err := emit(&items[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// exportResponse returns the response headers of an exported report, which are
+// shared by all reports. The filename is made up of the hostname and report,
+// e.g. example.com-pages-entry.csv.
+func exportResponse(
+	hostname string,
+	report string,
+	format api.Format,
+	data io.Reader,
+) *api.GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders {
+	return &api.GetWebsiteIDBrowsersOKApplicationXNdjsonHeaders{
+		ContentDisposition: api.NewOptString(exportDisposition(hostname, report, format)),
+		Response:           api.GetWebsiteIDBrowsersOKApplicationXNdjson{Data: data},
+	}
+}
+
+func exportDisposition(hostname string, report string, format api.Format) string {
+	return mime.FormatMediaType("attachment", map[string]string{
+		"filename": hostname + "-" + report + "." + string(format),
+	})
+}
+
+// exportColumns returns the JSON field names of a report row in order.
+func exportColumns(typ reflect.Type) []string {
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	columns := make([]string, 0, typ.NumField())
+
+	for i := range typ.NumField() {
+		name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			columns = append(columns, name)
+		}
+	}
+
+	return columns
+}
+
+// csvEncoder writes report rows as CSV with a header row of the columns.
+// Fields that are not set are left empty.
+type csvEncoder struct {
+	w       *csv.Writer
+	columns []string
+	index   map[string]int
+	record  []string
+	header  bool
+	e       *jx.Encoder
+}
+
+func newCSVEncoder(w io.Writer, columns []string) *csvEncoder {
+	index := make(map[string]int, len(columns))
+	for i, column := range columns {
+		index[column] = i
+	}
+
+	return &csvEncoder{
+		w:       csv.NewWriter(w),
+		columns: columns,
+		index:   index,
+		record:  make([]string, len(columns)),
+		e:       jx.GetEncoder(),
+	}
+}
+
+func (c *csvEncoder) writeHeader() error {
+	if c.header {
+		return nil
+	}
+
+	c.header = true
+
+	err := c.w.Write(c.columns)
+	if err != nil {
+		return errors.Wrap(err, "export")
+	}
+
+	return nil
+}
+
+func (c *csvEncoder) Encode(row exportRow) error {
+	err := c.writeHeader()
+	if err != nil {
+		return err
+	}
+
+	c.e.Reset()
+	row.Encode(c.e)
+	clear(c.record)
+
+	err = jx.DecodeBytes(c.e.Bytes()).ObjBytes(func(d *jx.Decoder, key []byte) error {
+		i, ok := c.index[string(key)]
+		if !ok {
+			return d.Skip()
+		}
+
+		value, err := csvValue(d)
+		c.record[i] = value
+
+		return err
+	})
+	if err != nil {
+		return errors.Wrap(err, "export")
+	}
+
+	err = c.w.Write(c.record)
+	if err != nil {
+		return errors.Wrap(err, "export")
+	}
+
+	return nil
+}
+
+func (c *csvEncoder) Close() error {
+	// Empty reports still have a header row.
+	err := c.writeHeader()
+	if err != nil {
+		return err
+	}
+
+	c.w.Flush()
+	jx.PutEncoder(c.e)
+
+	err = c.w.Error()
+	if err != nil {
+		return errors.Wrap(err, "export")
+	}
+
+	return nil
+}
+
+// csvValue returns the JSON value as a CSV field.
+func csvValue(d *jx.Decoder) (string, error) {
+	switch d.Next() {
+	case jx.String:
+		str, err := d.Str()
+		return escapeFormula(str), err
+	case jx.Number:
+		n, err := d.Num()
+		return n.String(), err
+	case jx.Bool:
+		b, err := d.Bool()
+		return strconv.FormatBool(b), err
+	case jx.Null:
+		return "", d.Null()
+	default:
+		raw, err := d.Raw()
+		return raw.String(), err
+	}
+}
+
+// escapeFormula prefixes text that spreadsheet applications would evaluate as
+// a formula with a single quote. Visitors control values such as pathnames, so
+// opening an export could otherwise run arbitrary formulas.
+func escapeFormula(value string) string {
+	if value == "" {
+		return value
+	}
+
+	switch value[0] {
+	case '=', '+', '-', '@', '\t', '\r':
+		return "'" + value
+	default:
+		return value
+	}
+}
+
+// ndjsonEncoder writes each report row as a JSON object on its own line.
+type ndjsonEncoder struct {
+	w *bufio.Writer
+	e *jx.Encoder
+}
+
+func newNDJSONEncoder(w io.Writer) *ndjsonEncoder {
+	return &ndjsonEncoder{w: bufio.NewWriter(w), e: jx.GetEncoder()}
+}
+
+func (n *ndjsonEncoder) Encode(row exportRow) error {
+	n.e.Reset()
+	row.Encode(n.e)
+
+	_, err := n.w.Write(n.e.Bytes())
+	if err != nil {
+		return errors.Wrap(err, "export")
+	}
+
+	err = n.w.WriteByte('\n')
+	if err != nil {
+		return errors.Wrap(err, "export")
+	}
+
+	return nil
+}
+
+func (n *ndjsonEncoder) Close() error {
+	jx.PutEncoder(n.e)
+
+	err := n.w.Flush()
+	if err != nil {
+		return errors.Wrap(err, "export")
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package services_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/services"
+	"github.com/medama-io/medama/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient := metest.NewTestDatabases(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	require.NoError(sqliteClient.CreateWebsite(ctx, model.NewWebsite(user.ID, "example.com", 1, 1)))
+	require.NoError(sqliteClient.CreateGoal(ctx, model.NewGoal(
+		"goal_pricing", "example.com", "Pricing, \"annual\"", model.GoalTypePathname, "/pricing", "", "", 1, 1,
+	)))
+
+	auth, err := util.NewAuthService(ctx, false)
+	require.NoError(err)
+
+	ingester := duckdb.NewIngester(duckdbClient, duckdb.IngestConfig{})
+	t.Cleanup(func() {
+		ingester.Close(t.Context()) //nolint:errcheck // Test cleanup.
+	})
+
+	handler, err := services.NewService(ctx, auth, sqliteClient, duckdbClient, ingester, "test-commit")
+	require.NoError(err)
+
+	ctx = context.WithValue(ctx, model.ContextKeyUserID, user.ID)
+
+	date := time.Date(2026, 10, 12, 10, 0, 0, 0, time.UTC)
+	views := make([]model.ImportedPageView, 0, 3)
+
+	for i, pathname := range []string{"/", "/", "/pricing"} {
+		views = append(views, model.ImportedPageView{
+			PageViewHit: model.PageViewHit{
+				BID:          "bid_" + string(rune('a'+i)),
+				Hostname:     "example.com",
+				Pathname:     pathname,
+				IsUniqueUser: true,
+				IsUniquePage: true,
+			},
+			DateCreated: date,
+		})
+	}
+
+	require.NoError(duckdbClient.InsertImportedViews(ctx, "import_export", views))
+
+	read := func(data io.Reader) string {
+		t.Helper()
+
+		body, err := io.ReadAll(data)
+		require.NoError(err)
+
+		return string(body)
+	}
+
+	params := api.GetWebsiteIDPagesParams{
+		Hostname: "example.com",
+		Format:   api.NewOptFormat(api.FormatCsv),
+		Start:    api.NewOptDateTime(date.Add(-time.Hour)),
+		End:      api.NewOptDateTime(date.Add(time.Hour)),
+		// Exports contain every row and column.
+		Limit:   api.NewOptInt(1),
+		Summary: api.NewOptBool(true),
+	}
+
+	resp, err := handler.GetWebsiteIDPages(ctx, params)
+	require.NoError(err)
+	require.IsType(&api.GetWebsiteIDPagesTextCsvOK{}, resp)
+
+	csv := resp.(*api.GetWebsiteIDPagesTextCsvOK)
+	assert.Equal("attachment; filename=example.com-pages.csv", csv.ContentDisposition.Value)
+	assert.Equal("path,visitors,visitors_percentage,pageviews,pageviews_percentage,bounce_percentage,duration\n"+
+		"/,2,0.6667,2,0.6667,0,0\n"+
+		"/pricing,1,0.3333,1,0.3333,0,0\n", read(csv.Response))
+
+	params.Format = api.NewOptFormat(api.FormatNdjson)
+
+	resp, err = handler.GetWebsiteIDPages(ctx, params)
+	require.NoError(err)
+	require.IsType(&api.GetWebsiteIDPagesApplicationXNdjsonOK{}, resp)
+
+	ndjson := resp.(*api.GetWebsiteIDPagesApplicationXNdjsonOK)
+	assert.Equal("attachment; filename=example.com-pages.ndjson", ndjson.ContentDisposition.Value)
+	assert.Equal(`{"path":"/","visitors":2,"visitors_percentage":0.6667,"pageviews":2,`+
+		`"pageviews_percentage":0.6667,"bounce_percentage":0,"duration":0}`+"\n"+
+		`{"path":"/pricing","visitors":1,"visitors_percentage":0.3333,"pageviews":1,`+
+		`"pageviews_percentage":0.3333,"bounce_percentage":0,"duration":0}`+"\n", read(ndjson.Response))
+
+	// Empty reports only have a header row.
+	exit, err := handler.GetWebsiteIDPagesExit(ctx, api.GetWebsiteIDPagesExitParams{
+		Hostname: "example.com",
+		Format:   api.NewOptFormat(api.FormatCsv),
+		Start:    api.NewOptDateTime(date.Add(time.Hour)),
+		End:      api.NewOptDateTime(date.Add(2 * time.Hour)),
+	})
+	require.NoError(err)
+	require.IsType(&api.GetWebsiteIDPagesExitTextCsvOK{}, exit)
+	assert.Equal("attachment; filename=example.com-pages-exit.csv",
+		exit.(*api.GetWebsiteIDPagesExitTextCsvOK).ContentDisposition.Value)
+	assert.Equal("path,visitors,visitors_percentage,bounce_percentage,duration\n",
+		read(exit.(*api.GetWebsiteIDPagesExitTextCsvOK).Response))
+
+	// Reports read up front are exported the same way.
+	goals, err := handler.GetWebsiteIDGoals(ctx, api.GetWebsiteIDGoalsParams{
+		Hostname: "example.com",
+		Format:   api.NewOptFormat(api.FormatCsv),
+		Start:    api.NewOptDateTime(date.Add(-time.Hour)),
+		End:      api.NewOptDateTime(date.Add(time.Hour)),
+	})
+	require.NoError(err)
+	require.IsType(&api.GetWebsiteIDGoalsTextCsvOK{}, goals)
+	assert.Equal("id,name,conversions,converters,conversion_rate\n"+
+		"goal_pricing,\"Pricing, \"\"annual\"\"\",1,1,0.3333\n",
+		read(goals.(*api.GetWebsiteIDGoalsTextCsvOK).Response))
+
+	// Text that would be evaluated as a formula is escaped in CSV exports only.
+	formulas := []string{"=HYPERLINK(\"https://example.org\")", "+1", "-1", "@SUM(A1)", "\t=1", "\r=1"}
+	formulaViews := make([]model.ImportedPageView, 0, len(formulas))
+
+	for i, pathname := range formulas {
+		formulaViews = append(formulaViews, model.ImportedPageView{
+			PageViewHit: model.PageViewHit{
+				BID:          "bid_formula_" + string(rune('a'+i)),
+				Hostname:     "example.com",
+				Pathname:     pathname,
+				IsUniqueUser: true,
+				IsUniquePage: true,
+			},
+			DateCreated: date.Add(24 * time.Hour),
+		})
+	}
+
+	require.NoError(duckdbClient.InsertImportedViews(ctx, "import_export_formulas", formulaViews))
+
+	formulaParams := api.GetWebsiteIDPagesParams{
+		Hostname: "example.com",
+		Format:   api.NewOptFormat(api.FormatCsv),
+		Start:    api.NewOptDateTime(date.Add(23 * time.Hour)),
+		End:      api.NewOptDateTime(date.Add(25 * time.Hour)),
+	}
+
+	resp, err = handler.GetWebsiteIDPages(ctx, formulaParams)
+	require.NoError(err)
+	require.IsType(&api.GetWebsiteIDPagesTextCsvOK{}, resp)
+
+	body := read(resp.(*api.GetWebsiteIDPagesTextCsvOK).Response)
+	assert.Contains(body, "\"'=HYPERLINK(\"\"https://example.org\"\")\",1,")
+	assert.Contains(body, "\n'+1,1,")
+	assert.Contains(body, "\n'-1,1,")
+	assert.Contains(body, "\n'@SUM(A1),1,")
+	assert.Contains(body, "\n'\t=1,1,")
+	assert.Contains(body, "\n\"'\r=1\",1,")
+
+	formulaParams.Format = api.NewOptFormat(api.FormatNdjson)
+
+	resp, err = handler.GetWebsiteIDPages(ctx, formulaParams)
+	require.NoError(err)
+	require.IsType(&api.GetWebsiteIDPagesApplicationXNdjsonOK{}, resp)
+	assert.Contains(read(resp.(*api.GetWebsiteIDPagesApplicationXNdjsonOK).Response),
+		`{"path":"=HYPERLINK(\"https://example.org\")","visitors":1,`)
+
+	// Errors are still returned before the export starts.
+	params.Hostname = "missing.example.com"
+
+	resp, err = handler.GetWebsiteIDPages(ctx, params)
+	require.NoError(err)
+	assert.IsType(&api.NotFoundErrorHeaders{}, resp)
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+)
+
+const (
+	funnelStepPathPrefix  = "path:"
+	funnelStepEventPrefix = "event:"
+)
+
+func (h *Handler) GetWebsiteIDFunnel(
+	ctx context.Context,
+	params api.GetWebsiteIDFunnelParams,
+) (api.GetWebsiteIDFunnelRes, error) {
+	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
+
+	// Check if website exists
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
+	if !exists {
+		log.Debug().Msg("website not found")
+		return ErrNotFound(model.ErrWebsiteNotFound), nil
+	}
+
+	steps := make([]model.FunnelStep, 0, len(params.Steps))
+	for _, s := range params.Steps {
+		step, err := parseFunnelStep(s)
+		if err != nil {
+			log.Debug().Str("step", s).Msg("invalid funnel step")
+			return ErrBadRequest(err), nil
+		}
+
+		steps = append(steps, step)
+	}
+
+	// Create filter for database query
+	filters := db.CreateFilters(params, params.Hostname)
+
+	funnel, err := h.analyticsDB.GetWebsiteFunnel(ctx, filters, steps)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to get website funnel")
+		return ErrInternalServerError(model.ErrInternalServerError), nil
+	}
+
+	resp := make(api.StatsFunnel, 0, len(funnel))
+	for _, f := range funnel {
+		resp = append(resp, api.StatsFunnelItem{
+			Step:           f.Step,
+			Name:           params.Steps[f.Step-1],
+			Visits:         f.Visits,
+			ConversionRate: f.ConversionRate,
+			Dropoff:        f.Dropoff,
+			DropoffRate:    f.DropoffRate,
+		})
+	}
+
+	// Export the report if requested.
+	if format, ok := params.Format.Get(); ok {
+		data, err := exportList(ctx, format, resp)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to export website funnel")
+			return ErrInternalServerError(model.ErrInternalServerError), nil
+		}
+
+		resp := exportResponse(params.Hostname, "funnel", format, data)
+		if format == api.FormatCsv {
+			return (*api.GetWebsiteIDFunnelTextCsvOK)(resp), nil
+		}
+
+		return (*api.GetWebsiteIDFunnelApplicationXNdjsonOK)(resp), nil
+	}
+
+	return &api.StatsFunnelHeaders{
+		Response: resp,
+	}, nil
+}
+
+// parseFunnelStep parses a step in the form of path:<pattern> or
+// event:<name>[=<value>].
+func parseFunnelStep(step string) (model.FunnelStep, error) {
+	if pathname, ok := strings.CutPrefix(step, funnelStepPathPrefix); ok {
+		if pathname == "" {
+			return model.FunnelStep{}, model.ErrInvalidFunnelStep
+		}
+
+		return model.FunnelStep{Type: model.GoalTypePathname, Pathname: pathname}, nil
+	}
+
+	if event, ok := strings.CutPrefix(step, funnelStepEventPrefix); ok {
+		name, value, _ := strings.Cut(event, "=")
+		if name == "" {
+			return model.FunnelStep{}, model.ErrInvalidFunnelStep
+		}
+
+		return model.FunnelStep{Type: model.GoalTypeEvent, EventName: name, EventValue: value}, nil
+	}
+
+	return model.FunnelStep{}, model.ErrInvalidFunnelStep
+}
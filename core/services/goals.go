@@ -0,0 +1,355 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+	"go.jetify.com/typeid"
+)
+
+func (h *Handler) GetWebsitesIDGoals(
+	ctx context.Context,
+	params api.GetWebsitesIDGoalsParams,
+) (api.GetWebsitesIDGoalsRes, error) {
+	// Get user ID from context
+	_, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	// Websites the user can not access are reported as missing, so their
+	// hostnames can not be discovered.
+	err := h.authoriseWebsite(ctx, params.Hostname, false)
+	if err != nil {
+		if errors.Is(err, model.ErrWebsiteNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	goals, err := h.db.ListGoals(ctx, params.Hostname)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	resp := make([]api.GoalGet, 0, len(goals))
+	for _, g := range goals {
+		resp = append(resp, goalToAPI(g))
+	}
+
+	return &api.GetWebsitesIDGoalsOKHeaders{
+		Response: resp,
+	}, nil
+}
+
+func (h *Handler) PostWebsitesIDGoals(
+	ctx context.Context,
+	req *api.GoalCreate,
+	params api.PostWebsitesIDGoalsParams,
+) (api.PostWebsitesIDGoalsRes, error) {
+	log := logger.Get()
+	if h.auth.IsDemoMode {
+		log.Debug().Msg("post goal rejected in demo mode")
+		return ErrForbidden(model.ErrDemoMode), nil
+	}
+
+	// Get user ID from context
+	_, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	err := h.authoriseWebsite(ctx, params.Hostname, true)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrWebsiteNotFound):
+			return ErrNotFound(err), nil
+		case errors.Is(err, model.ErrUserForbidden):
+			return ErrForbidden(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	goalID, err := typeid.WithPrefix("goal")
+	if err != nil {
+		return nil, errors.Wrap(err, "typeid goal")
+	}
+
+	dateCreated := time.Now().Unix()
+	goal := model.NewGoal(
+		goalID.String(),
+		params.Hostname,
+		req.Name,
+		model.GoalType(req.Type),
+		req.Pathname.Value,
+		req.EventName.Value,
+		req.EventValue.Value,
+		dateCreated,
+		dateCreated,
+	)
+	clearUnusedGoalFields(goal)
+
+	err = goal.Validate()
+	if err != nil {
+		return ErrBadRequest(err), nil
+	}
+
+	err = h.db.CreateGoal(ctx, goal)
+	if err != nil {
+		if errors.Is(err, model.ErrWebsiteNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	return &api.GoalGetHeaders{
+		Response: goalToAPI(goal),
+	}, nil
+}
+
+func (h *Handler) PatchWebsitesIDGoalsID(
+	ctx context.Context,
+	req *api.GoalPatch,
+	params api.PatchWebsitesIDGoalsIDParams,
+) (api.PatchWebsitesIDGoalsIDRes, error) {
+	log := logger.Get()
+	if h.auth.IsDemoMode {
+		log.Debug().Msg("patch goal rejected in demo mode")
+		return ErrForbidden(model.ErrDemoMode), nil
+	}
+
+	// Get user ID from context
+	_, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	err := h.authoriseWebsite(ctx, params.Hostname, true)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrWebsiteNotFound):
+			return ErrNotFound(err), nil
+		case errors.Is(err, model.ErrUserForbidden):
+			return ErrForbidden(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	goal, err := h.db.GetGoal(ctx, params.GoalId)
+	if err != nil {
+		if errors.Is(err, model.ErrGoalNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	// Goals are only addressable through their own website.
+	if goal.Hostname != params.Hostname {
+		return ErrNotFound(model.ErrGoalNotFound), nil
+	}
+
+	// Update values
+	if req.Name.IsSet() {
+		goal.Name = req.Name.Value
+	}
+
+	if req.Type.IsSet() {
+		goal.Type = model.GoalType(req.Type.Value)
+	}
+
+	if req.Pathname.IsSet() {
+		goal.Pathname = req.Pathname.Value
+	}
+
+	if req.EventName.IsSet() {
+		goal.EventName = req.EventName.Value
+	}
+
+	if req.EventValue.IsSet() {
+		goal.EventValue = req.EventValue.Value
+	}
+
+	clearUnusedGoalFields(goal)
+
+	err = goal.Validate()
+	if err != nil {
+		return ErrBadRequest(err), nil
+	}
+
+	goal.DateUpdated = time.Now().Unix()
+
+	err = h.db.UpdateGoal(ctx, goal)
+	if err != nil {
+		if errors.Is(err, model.ErrGoalNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	return &api.GoalGetHeaders{
+		Response: goalToAPI(goal),
+	}, nil
+}
+
+func (h *Handler) DeleteWebsitesIDGoalsID(
+	ctx context.Context,
+	params api.DeleteWebsitesIDGoalsIDParams,
+) (api.DeleteWebsitesIDGoalsIDRes, error) {
+	log := logger.Get()
+	if h.auth.IsDemoMode {
+		log.Debug().Msg("delete goal rejected in demo mode")
+		return ErrForbidden(model.ErrDemoMode), nil
+	}
+
+	// Get user ID from context
+	_, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	err := h.authoriseWebsite(ctx, params.Hostname, true)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrWebsiteNotFound):
+			return ErrNotFound(err), nil
+		case errors.Is(err, model.ErrUserForbidden):
+			return ErrForbidden(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	goal, err := h.db.GetGoal(ctx, params.GoalId)
+	if err != nil {
+		if errors.Is(err, model.ErrGoalNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if goal.Hostname != params.Hostname {
+		return ErrNotFound(model.ErrGoalNotFound), nil
+	}
+
+	err = h.db.DeleteGoal(ctx, params.GoalId)
+	if err != nil {
+		if errors.Is(err, model.ErrGoalNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	return &api.DeleteWebsitesIDGoalsIDNoContent{}, nil
+}
+
+func (h *Handler) GetWebsiteIDGoals(
+	ctx context.Context,
+	params api.GetWebsiteIDGoalsParams,
+) (api.GetWebsiteIDGoalsRes, error) {
+	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
+
+	// Check if website exists
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
+	if !exists {
+		log.Debug().Msg("website not found")
+		return ErrNotFound(model.ErrWebsiteNotFound), nil
+	}
+
+	goals, err := h.db.ListGoals(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list website goals")
+		return ErrInternalServerError(model.ErrInternalServerError), nil
+	}
+
+	// Create filter for database query
+	filters := db.CreateFilters(params, params.Hostname)
+
+	stats, err := h.analyticsDB.GetWebsiteGoals(ctx, filters, goals)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to get website goals")
+		return ErrInternalServerError(model.ErrInternalServerError), nil
+	}
+
+	resp := make(api.StatsGoals, 0, len(stats))
+	for _, s := range stats {
+		resp = append(resp, api.StatsGoalsItem{
+			ID:             s.ID,
+			Name:           s.Name,
+			Conversions:    s.Conversions,
+			Converters:     s.Converters,
+			ConversionRate: s.ConversionRate,
+		})
+	}
+
+	// Export the report if requested.
+	if format, ok := params.Format.Get(); ok {
+		data, err := exportList(ctx, format, resp)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to export website goals")
+			return ErrInternalServerError(model.ErrInternalServerError), nil
+		}
+
+		resp := exportResponse(params.Hostname, "goals", format, data)
+		if format == api.FormatCsv {
+			return (*api.GetWebsiteIDGoalsTextCsvOK)(resp), nil
+		}
+
+		return (*api.GetWebsiteIDGoalsApplicationXNdjsonOK)(resp), nil
+	}
+
+	return &api.StatsGoalsHeaders{
+		Response: resp,
+	}, nil
+}
+
+// clearUnusedGoalFields resets the match fields that do not apply to the goal
+// type, so switching a goal between types does not leave stale values behind.
+func clearUnusedGoalFields(goal *model.Goal) {
+	switch goal.Type {
+	case model.GoalTypePathname:
+		goal.EventName = ""
+		goal.EventValue = ""
+	case model.GoalTypeEvent:
+		goal.Pathname = ""
+	}
+}
+
+func goalToAPI(goal *model.Goal) api.GoalGet {
+	resp := api.GoalGet{
+		ID:   goal.ID,
+		Name: goal.Name,
+		Type: api.GoalType(goal.Type),
+	}
+
+	if goal.Pathname != "" {
+		resp.Pathname = api.NewOptString(goal.Pathname)
+	}
+
+	if goal.EventName != "" {
+		resp.EventName = api.NewOptString(goal.EventName)
+	}
+
+	if goal.EventValue != "" {
+		resp.EventValue = api.NewOptString(goal.EventValue)
+	}
+
+	return resp
+}
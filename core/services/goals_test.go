@@ -0,0 +1,236 @@
+package services_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/services"
+	"github.com/medama-io/medama/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoals(t *testing.T) {
+	assert, ctx, handler, sqliteClient := metest.NewTestHandler(t)
+	require := require.New(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	ctx = context.WithValue(ctx, model.ContextKeyUserID, user.ID)
+
+	for _, hostname := range []string{"example.com", "other.example.com"} {
+		_, err = handler.PostWebsites(ctx, &api.WebsiteCreate{Hostname: hostname})
+		require.NoError(err)
+	}
+
+	// Goals need the match fields of their type and an existing website.
+	testCases := []struct {
+		hostname string
+		req      *api.GoalCreate
+		expected any
+	}{
+		{"example.com", &api.GoalCreate{Name: "Signup", Type: api.GoalTypePathname}, &api.BadRequestErrorHeaders{}},
+		{"example.com", &api.GoalCreate{Name: "Signup", Type: api.GoalTypeEvent}, &api.BadRequestErrorHeaders{}},
+		{"missing.example.com", &api.GoalCreate{
+			Name:     "Signup",
+			Type:     api.GoalTypePathname,
+			Pathname: api.NewOptString("/signup"),
+		}, &api.NotFoundErrorHeaders{}},
+	}
+
+	for i, tc := range testCases {
+		resp, err := handler.PostWebsitesIDGoals(ctx, tc.req, api.PostWebsitesIDGoalsParams{Hostname: tc.hostname})
+		require.NoError(err)
+		assert.IsType(tc.expected, resp, strconv.Itoa(i))
+	}
+
+	resp, err := handler.PostWebsitesIDGoals(ctx, &api.GoalCreate{
+		Name:       "Thank you",
+		Type:       api.GoalTypePathname,
+		Pathname:   api.NewOptString("/thank-you*"),
+		EventName:  api.NewOptString("ignored"),
+		EventValue: api.NewOptString("ignored"),
+	}, api.PostWebsitesIDGoalsParams{Hostname: "example.com"})
+	require.NoError(err)
+
+	created, ok := resp.(*api.GoalGetHeaders)
+	require.True(ok)
+	assert.Equal("Thank you", created.Response.Name)
+	assert.Equal("/thank-you*", created.Response.Pathname.Value)
+	// Fields of the other goal type are not stored.
+	assert.False(created.Response.EventName.Set)
+	assert.False(created.Response.EventValue.Set)
+
+	goalID := created.Response.ID
+
+	list, err := handler.GetWebsitesIDGoals(ctx, api.GetWebsitesIDGoalsParams{Hostname: "example.com"})
+	require.NoError(err)
+	require.IsType(&api.GetWebsitesIDGoalsOKHeaders{}, list)
+	assert.Equal(
+		[]api.GoalGet{created.Response},
+		list.(*api.GetWebsitesIDGoalsOKHeaders).Response,
+	)
+
+	// Switching the type clears the pathname.
+	patched, err := handler.PatchWebsitesIDGoalsID(ctx, &api.GoalPatch{
+		Type:      api.NewOptGoalType(api.GoalTypeEvent),
+		EventName: api.NewOptString("signup"),
+	}, api.PatchWebsitesIDGoalsIDParams{Hostname: "example.com", GoalId: goalID})
+	require.NoError(err)
+	require.IsType(&api.GoalGetHeaders{}, patched)
+
+	goal := patched.(*api.GoalGetHeaders).Response
+	assert.Equal(api.GoalTypeEvent, goal.Type)
+	assert.Equal("signup", goal.EventName.Value)
+	assert.False(goal.Pathname.Set)
+
+	// Goals are only addressable through their own website.
+	patched, err = handler.PatchWebsitesIDGoalsID(ctx, &api.GoalPatch{
+		Name: api.NewOptString("Renamed"),
+	}, api.PatchWebsitesIDGoalsIDParams{Hostname: "other.example.com", GoalId: goalID})
+	require.NoError(err)
+	assert.IsType(&api.NotFoundErrorHeaders{}, patched)
+
+	deleted, err := handler.DeleteWebsitesIDGoalsID(ctx, api.DeleteWebsitesIDGoalsIDParams{
+		Hostname: "other.example.com",
+		GoalId:   goalID,
+	})
+	require.NoError(err)
+	assert.IsType(&api.NotFoundErrorHeaders{}, deleted)
+
+	deleted, err = handler.DeleteWebsitesIDGoalsID(ctx, api.DeleteWebsitesIDGoalsIDParams{
+		Hostname: "example.com",
+		GoalId:   goalID,
+	})
+	require.NoError(err)
+	assert.IsType(&api.DeleteWebsitesIDGoalsIDNoContent{}, deleted)
+
+	list, err = handler.GetWebsitesIDGoals(ctx, api.GetWebsitesIDGoalsParams{Hostname: "example.com"})
+	require.NoError(err)
+	assert.Empty(list.(*api.GetWebsitesIDGoalsOKHeaders).Response)
+
+	deleted, err = handler.DeleteWebsitesIDGoalsID(ctx, api.DeleteWebsitesIDGoalsIDParams{
+		Hostname: "example.com",
+		GoalId:   goalID,
+	})
+	require.NoError(err)
+	assert.IsType(&api.NotFoundErrorHeaders{}, deleted)
+
+	// Websites the user can not access can not be told apart from missing
+	// ones.
+	viewer, err := handler.PostUsers(ctx, &api.UserAccountCreate{
+		Username: "viewer",
+		Password: "password",
+		Role:     api.UserAccountCreateRoleViewer,
+		Websites: []string{"other.example.com"},
+	}, api.PostUsersParams{})
+	require.NoError(err)
+	require.IsType(&api.UserAccountHeaders{}, viewer)
+
+	viewerCtx := context.WithValue(ctx, model.ContextKeyUserID, viewer.(*api.UserAccountHeaders).Response.ID)
+
+	for _, hostname := range []string{"example.com", "missing.example.com"} {
+		list, err := handler.GetWebsitesIDGoals(viewerCtx, api.GetWebsitesIDGoalsParams{Hostname: hostname})
+		require.NoError(err)
+		assert.IsType(&api.NotFoundErrorHeaders{}, list, hostname)
+
+		post, err := handler.PostWebsitesIDGoals(viewerCtx, &api.GoalCreate{
+			Name:     "Signup",
+			Type:     api.GoalTypePathname,
+			Pathname: api.NewOptString("/signup"),
+		}, api.PostWebsitesIDGoalsParams{Hostname: hostname})
+		require.NoError(err)
+		assert.IsType(&api.NotFoundErrorHeaders{}, post, hostname)
+	}
+
+	// Members that are not admins can view but not manage goals.
+	post, err := handler.PostWebsitesIDGoals(viewerCtx, &api.GoalCreate{
+		Name:     "Signup",
+		Type:     api.GoalTypePathname,
+		Pathname: api.NewOptString("/signup"),
+	}, api.PostWebsitesIDGoalsParams{Hostname: "other.example.com"})
+	require.NoError(err)
+	assert.IsType(&api.ForbiddenErrorHeaders{}, post)
+}
+
+func TestGoalStats(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient := metest.NewTestDatabases(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	require.NoError(sqliteClient.CreateWebsite(ctx, model.NewWebsite(user.ID, "example.com", 1, 1)))
+	require.NoError(sqliteClient.CreateGoal(ctx, model.NewGoal(
+		"goal_thanks", "example.com", "Thank you", model.GoalTypePathname, "/thank-you", "", "", 1, 1,
+	)))
+
+	auth, err := util.NewAuthService(ctx, false)
+	require.NoError(err)
+
+	ingester := duckdb.NewIngester(duckdbClient, duckdb.IngestConfig{})
+	t.Cleanup(func() {
+		ingester.Close(t.Context()) //nolint:errcheck // Test cleanup.
+	})
+
+	handler, err := services.NewService(ctx, auth, sqliteClient, duckdbClient, ingester, "test-commit")
+	require.NoError(err)
+
+	ctx = context.WithValue(ctx, model.ContextKeyUserID, user.ID)
+
+	date := time.Date(2026, 10, 12, 10, 0, 0, 0, time.UTC)
+	view := func(bid string, visitID string, pathname string, uniqueUser bool, uniquePage bool) model.ImportedPageView {
+		return model.ImportedPageView{
+			PageViewHit: model.PageViewHit{
+				BID:          bid,
+				VisitID:      visitID,
+				Hostname:     "example.com",
+				Pathname:     pathname,
+				IsUniqueUser: uniqueUser,
+				IsUniquePage: uniquePage,
+			},
+			DateCreated: date,
+		}
+	}
+
+	// Four visitors, one of which reaches the goal twice in the same visit.
+	require.NoError(duckdbClient.InsertImportedViews(ctx, "import_goals", []model.ImportedPageView{
+		view("bid_1", "visit_1", "/", true, true),
+		view("bid_2", "visit_2", "/", true, true),
+		view("bid_3", "visit_3", "/", true, true),
+		view("bid_4", "visit_4", "/", true, true),
+		view("bid_5", "visit_1", "/thank-you", false, true),
+		view("bid_6", "visit_1", "/thank-you", false, false),
+	}))
+
+	params := api.GetWebsiteIDGoalsParams{
+		Hostname: "example.com",
+		Start:    api.NewOptDateTime(date.Add(-time.Hour)),
+		End:      api.NewOptDateTime(date.Add(time.Hour)),
+	}
+
+	resp, err := handler.GetWebsiteIDGoals(ctx, params)
+	require.NoError(err)
+	require.IsType(&api.StatsGoalsHeaders{}, resp)
+	assert.Equal(api.StatsGoals{{
+		ID:             "goal_thanks",
+		Name:           "Thank you",
+		Conversions:    2,
+		Converters:     1,
+		ConversionRate: 0.25,
+	}}, resp.(*api.StatsGoalsHeaders).Response)
+
+	params.Hostname = "missing.example.com"
+
+	resp, err = handler.GetWebsiteIDGoals(ctx, params)
+	require.NoError(err)
+	assert.IsType(&api.NotFoundErrorHeaders{}, resp)
+}
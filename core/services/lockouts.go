@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/iputils"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util"
+	"github.com/medama-io/medama/util/logger"
+)
+
+func (h *Handler) GetAuthLockouts(
+	ctx context.Context,
+	_params api.GetAuthLockoutsParams,
+) (api.GetAuthLockoutsRes, error) {
+	admin, err := h.isAdmin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if !admin {
+		return ErrForbidden(model.ErrUserForbidden), nil
+	}
+
+	lockouts := h.auth.LoginLimiter().Lockouts()
+
+	resp := make([]api.LoginLockout, 0, len(lockouts))
+	for _, lockout := range lockouts {
+		resp = append(resp, api.LoginLockout{
+			Type:        api.LoginLockoutType(lockout.Kind),
+			Value:       lockout.Value,
+			Lockouts:    lockout.Lockouts,
+			DateExpires: lockout.LockedUntil.Unix(),
+		})
+	}
+
+	return &api.GetAuthLockoutsOKHeaders{
+		Response: resp,
+	}, nil
+}
+
+func (h *Handler) DeleteAuthLockouts(
+	ctx context.Context,
+	params api.DeleteAuthLockoutsParams,
+) (api.DeleteAuthLockoutsRes, error) {
+	log := logger.Get()
+
+	admin, err := h.isAdmin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if !admin {
+		return ErrForbidden(model.ErrUserForbidden), nil
+	}
+
+	if params.Username.Value == "" && params.IP.Value == "" {
+		return ErrBadRequest(model.ErrInvalidLockout), nil
+	}
+
+	var prefix netip.Prefix
+
+	if params.IP.Value != "" {
+		prefix, err = parseLockoutPrefix(params.IP.Value)
+		if err != nil {
+			return ErrBadRequest(model.ErrInvalidLockout), nil
+		}
+	}
+
+	limiter := h.auth.LoginLimiter()
+	cleared := false
+
+	if params.Username.Value != "" && limiter.Clear(util.LockoutUsername, params.Username.Value) {
+		h.audit(ctx, model.AuditLockoutClear, params.Username.Value, nil)
+
+		cleared = true
+	}
+
+	if prefix.IsValid() && limiter.Clear(util.LockoutIP, prefix.String()) {
+		h.audit(ctx, model.AuditLockoutClear, prefix.String(), nil)
+
+		cleared = true
+	}
+
+	if !cleared {
+		return ErrNotFound(model.ErrLockoutNotFound), nil
+	}
+
+	userID, _ := ctx.Value(model.ContextKeyUserID).(string)
+	log.Info().
+		Str("id", userID).
+		Str("username", params.Username.Value).
+		Str("prefix", prefix.String()).
+		Msg("cleared login lockout")
+
+	return &api.DeleteAuthLockoutsNoContent{}, nil
+}
+
+// loginPrefix returns the IP prefix the client of a login is grouped into
+// for lockouts. If the client address is unknown, the returned prefix is
+// invalid and only the username is checked.
+func loginPrefix(ctx context.Context) netip.Prefix {
+	log := logger.Get()
+
+	ip, ok := ctx.Value(model.RequestKeyClientIP).(netip.Addr)
+	if !ok {
+		log.Debug().Msg("login: no client IP in context, skipping ip lockouts")
+		return netip.Prefix{}
+	}
+
+	prefix, err := iputils.GetPrefix(ip, iputils.IPv4DefaultPrefix, iputils.IPv6DefaultPrefix)
+	if err != nil {
+		log.Debug().Err(err).Msg("login: failed to get prefix for client IP")
+		return netip.Prefix{}
+	}
+
+	return prefix
+}
+
+// parseLockoutPrefix parses an IP prefix, or an IP address into the prefix it
+// is grouped into for lockouts.
+func parseLockoutPrefix(value string) (netip.Prefix, error) {
+	if strings.Contains(value, "/") {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+
+		return prefix.Masked(), nil
+	}
+
+	ip, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return iputils.GetPrefix(ip, iputils.IPv4DefaultPrefix, iputils.IPv6DefaultPrefix)
+}
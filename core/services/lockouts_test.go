@@ -0,0 +1,124 @@
+package services_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/middlewares"
+	"github.com/medama-io/medama/services"
+	"github.com/medama-io/medama/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginLockout(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient := metest.NewTestDatabases(t)
+
+	auth, err := util.NewAuthService(ctx, false, util.WithLoginLimits(util.LoginLimits{
+		UserAttempts: 3,
+		IPAttempts:   5,
+		Lockout:      90 * time.Second,
+		MaxLockout:   time.Hour,
+	}))
+	require.NoError(err)
+
+	ingester := duckdb.NewIngester(duckdbClient, duckdb.IngestConfig{})
+	t.Cleanup(func() {
+		ingester.Close(t.Context()) //nolint:errcheck // Test cleanup.
+	})
+
+	handler, err := services.NewService(ctx, auth, sqliteClient, duckdbClient, ingester, "test-commit")
+	require.NoError(err)
+
+	server, err := api.NewServer(handler,
+		middlewares.NewAuthHandler(auth, sqliteClient),
+		api.WithErrorHandler(middlewares.ErrorHandler),
+		api.WithMiddleware(middlewares.RequestContext()),
+	)
+	require.NoError(err)
+
+	do := func(method string, path string, body string, cookie string, ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("X-Forwarded-For", ip)
+
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		if cookie != "" {
+			req.Header.Set("Cookie", cookie)
+		}
+
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	login := func(password string, ip string) *httptest.ResponseRecorder {
+		return do(http.MethodPost, "/auth/login", `{"username":"admin","password":"`+password+`"}`, "", ip)
+	}
+
+	rec := login("CHANGE_ME_ON_FIRST_LOGIN", "192.168.1.10")
+	require.Equal(http.StatusOK, rec.Code)
+
+	session, _, _ := strings.Cut(rec.Header().Get("Set-Cookie"), ";")
+
+	// The username is locked out after too many failed logins, even with the
+	// correct password.
+	for range 3 {
+		rec = login("wrong", "203.0.113.10")
+		assert.Equal(http.StatusUnauthorized, rec.Code)
+	}
+
+	rec = login("CHANGE_ME_ON_FIRST_LOGIN", "198.51.100.10")
+	assert.Equal(http.StatusTooManyRequests, rec.Code)
+	assert.Equal("90", rec.Header().Get("Retry-After"))
+
+	rec = do(http.MethodGet, "/auth/lockouts", "", session, "192.168.1.10")
+	require.Equal(http.StatusOK, rec.Code)
+	assert.Contains(rec.Body.String(), `"type":"username","value":"admin","lockouts":1`)
+
+	// Admins can clear the lockout.
+	rec = do(http.MethodDelete, "/auth/lockouts?username=admin", "", session, "192.168.1.10")
+	assert.Equal(http.StatusNoContent, rec.Code)
+
+	rec = do(http.MethodDelete, "/auth/lockouts?username=admin", "", session, "192.168.1.10")
+	assert.Equal(http.StatusNotFound, rec.Code)
+
+	rec = do(http.MethodDelete, "/auth/lockouts", "", session, "192.168.1.10")
+	assert.Equal(http.StatusBadRequest, rec.Code)
+
+	rec = login("CHANGE_ME_ON_FIRST_LOGIN", "198.51.100.10")
+	assert.Equal(http.StatusOK, rec.Code)
+
+	// The network is locked out after too many failed logins to any username.
+	for _, username := range []string{"alice", "bob", "carol", "dave", "erin"} {
+		rec = do(http.MethodPost, "/auth/login",
+			`{"username":"`+username+`","password":"wrong"}`, "", "100.64.1.20")
+		assert.Equal(http.StatusUnauthorized, rec.Code)
+	}
+
+	rec = login("CHANGE_ME_ON_FIRST_LOGIN", "100.64.1.30")
+	assert.Equal(http.StatusTooManyRequests, rec.Code)
+
+	rec = login("CHANGE_ME_ON_FIRST_LOGIN", "198.51.100.10")
+	assert.Equal(http.StatusOK, rec.Code)
+
+	rec = do(http.MethodDelete, "/auth/lockouts?ip=100.64.1.99", "", session, "192.168.1.10")
+	assert.Equal(http.StatusNoContent, rec.Code)
+
+	rec = login("CHANGE_ME_ON_FIRST_LOGIN", "100.64.1.30")
+	assert.Equal(http.StatusOK, rec.Code)
+
+	rec = do(http.MethodDelete, "/auth/lockouts?ip=invalid", "", session, "192.168.1.10")
+	assert.Equal(http.StatusBadRequest, rec.Code)
+}
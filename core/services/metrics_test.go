@@ -0,0 +1,87 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/metrics"
+	"github.com/medama-io/medama/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventHitMetrics(t *testing.T) {
+	assert, ctx, handler, sqliteClient := metest.NewTestHandler(t)
+	require := require.New(t)
+
+	metrics.Enable()
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	ctx = context.WithValue(ctx, model.ContextKeyUserID, user.ID)
+
+	_, err = handler.PostWebsites(ctx, &api.WebsiteCreate{Hostname: "metrics.example.com"})
+	require.NoError(err)
+
+	handler.RuntimeConfig.IPFilter.LoadFromCommaSeparated("198.51.100.1")
+
+	hit := func(ip string, userAgent string, event api.EventHit) {
+		req := httptest.NewRequest(http.MethodPost, "/event/hit", nil)
+		req.Header.Set("X-Forwarded-For", ip)
+		req.Header.Set("User-Agent", userAgent)
+
+		_, err := handler.PostEventHit(
+			context.WithValue(ctx, model.RequestKeyBody, req),
+			event,
+			api.PostEventHitParams{},
+		)
+		require.NoError(err)
+	}
+
+	custom := func(group string) api.EventHit {
+		return api.NewEventCustomEventHit(api.EventCustom{
+			G: group,
+			D: api.EventCustomD{"plan": {Type: api.StringEventCustomDItem, String: "pro"}},
+		})
+	}
+
+	load := api.NewEventLoadEventHit(api.EventLoad{
+		B: "bid",
+		U: url.URL{Scheme: "https", Host: "metrics.example.com", Path: "/"},
+	})
+
+	outcomes := []struct {
+		typ     api.EventHitType
+		outcome string
+	}{
+		{api.EventCustomEventHit, metrics.OutcomeAccepted},
+		{api.EventCustomEventHit, metrics.OutcomeUnknownHostname},
+		{api.EventCustomEventHit, metrics.OutcomeBlockedIP},
+		{api.EventLoadEventHit, metrics.OutcomeBot},
+	}
+
+	before := make([]uint64, len(outcomes))
+	for i, o := range outcomes {
+		before[i] = metrics.Hits.Value(string(o.typ), o.outcome)
+	}
+
+	hit("203.0.113.1", "", custom("metrics.example.com"))
+	hit("203.0.113.1", "", custom("unknown.example.com"))
+	hit("198.51.100.1", "", custom("metrics.example.com"))
+	hit("203.0.113.1", "Googlebot/2.1 (+http://www.google.com/bot.html)", load)
+
+	for i, o := range outcomes {
+		assert.Equal(before[i]+1, metrics.Hits.Value(string(o.typ), o.outcome), o.outcome)
+	}
+
+	assert.Contains(metrics.Expose(),
+		`medama_event_hits_total{type="custom",outcome="accepted"}`)
+
+	// Queries are labelled by the client method making them.
+	assert.NotZero(metrics.QueryDuration.Count(metrics.DatabaseSQLite, "GetUserByUsername"))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	tz "github.com/medama-io/go-timezone-country"
 	"github.com/medama-io/go-useragent"
@@ -15,6 +16,7 @@ import (
 	"github.com/medama-io/medama/referrer"
 	"github.com/medama-io/medama/util"
 	"github.com/medama-io/medama/util/logger"
+	"github.com/medama-io/medama/webhooks"
 )
 
 // This is a runtime config that is read from user settings in the database.
@@ -35,6 +37,7 @@ type Handler struct {
 	auth        *util.AuthService
 	db          *sqlite.Client
 	analyticsDB *duckdb.Client
+	ingester    *duckdb.Ingester
 
 	// Parsing libraries
 	useragent          *useragent.Parser
@@ -43,19 +46,53 @@ type Handler struct {
 
 	// Cache store for hostnames
 	hostnames *util.CacheStore
+	// Resolves hostname aliases to their website
+	aliases *util.AliasStore
+	// Groups page views into visits, nil unless visit tracking is enabled
+	visits *util.VisitTracker
+	// Recent page views published to realtime streams
+	realtime *util.RealtimeTracker
+	// Purges the data of deleted websites in the background
+	deletions *DeletionJob
+	// Sends webhooks when their trigger fires
+	webhooks *WebhookDispatcher
 
 	// Runtime config
 	RuntimeConfig *RuntimeConfig
 }
 
+// ServiceOption configures optional features of the Handler.
+type ServiceOption func(*serviceOptions)
+
+type serviceOptions struct {
+	visitTracking bool
+}
+
+// WithVisitTracking groups consecutive page views from the same visitor into
+// visits, which funnels and entry and exit pages are built from. Visitors are
+// told apart by a hash of their IP address and user agent with a key that only
+// lives in memory and changes daily, so this is off unless explicitly enabled.
+func WithVisitTracking() ServiceOption {
+	return func(o *serviceOptions) {
+		o.visitTracking = true
+	}
+}
+
 // NewService returns a new instance of the ogen service handler.
 func NewService(
 	ctx context.Context,
 	auth *util.AuthService,
 	sqlite *sqlite.Client,
 	duckdb *duckdb.Client,
+	ingester *duckdb.Ingester,
 	commit string,
+	opts ...ServiceOption,
 ) (*Handler, error) {
+	var options serviceOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	// Load timezone and country maps
 	tzMap, err := tz.NewTimezoneCountryMap()
 	if err != nil {
@@ -78,19 +115,58 @@ func NewService(
 
 	hostnameCache.AddAll(hostnames)
 
+	// Load hostname aliases
+	aliases := util.NewAliasStore()
+
+	websiteSettings, err := sqlite.ListWebsiteSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list website settings: %w", err)
+	}
+
+	for hostname, settings := range websiteSettings {
+		aliases.Set(hostname, settings.Aliases)
+	}
+
 	runtimeConfig, err := NewRuntimeConfig(ctx, sqlite, commit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create runtime config: %w", err)
 	}
 
+	// Restore the realtime stats of page views recorded before a restart.
+	realtimeHits, err := duckdb.GetRealtimeHits(ctx, int(util.MaxRealtimeWindow/time.Minute))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load realtime hits: %w", err)
+	}
+
+	realtime := util.NewRealtimeTracker()
+	realtime.Seed(realtimeHits)
+
+	// Resume purging the data of websites deleted before a restart.
+	deletions := NewDeletionJob(sqlite, duckdb, ingester)
+	deletions.Start(ctx)
+
+	dispatcher := NewWebhookDispatcher(sqlite, duckdb, webhooks.NewClient(0, 0), DefaultWebhookCheckInterval)
+	dispatcher.Start(ctx)
+
+	var visits *util.VisitTracker
+	if options.visitTracking {
+		visits = util.NewVisitTracker(ctx, util.DefaultVisitTimeout)
+	}
+
 	return &Handler{
 		auth:               auth,
 		db:                 sqlite,
 		analyticsDB:        duckdb,
+		ingester:           ingester,
 		useragent:          useragent.NewParser(),
 		referrer:           referrerParser,
 		timezoneCountryMap: &tzMap,
 		hostnames:          &hostnameCache,
+		aliases:            aliases,
+		visits:             visits,
+		realtime:           realtime,
+		deletions:          deletions,
+		webhooks:           dispatcher,
 		RuntimeConfig:      &runtimeConfig,
 	}, nil
 }
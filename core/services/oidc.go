@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/oidc"
+	"github.com/medama-io/medama/util/logger"
+	"go.jetify.com/typeid"
+)
+
+// oidcRedirectPath is where users are sent after logging in with single
+// sign-on.
+const oidcRedirectPath = "/"
+
+func (h *Handler) GetAuthMethods(_ context.Context) (api.GetAuthMethodsRes, error) {
+	return &api.AuthMethodsHeaders{
+		Response: api.AuthMethods{
+			Password: h.auth.PasswordLoginEnabled(),
+			SSO:      h.auth.OIDC() != nil,
+		},
+	}, nil
+}
+
+func (h *Handler) GetAuthOidcLogin(ctx context.Context) (api.GetAuthOidcLoginRes, error) {
+	authURL, state, err := h.auth.StartOIDCLogin(ctx)
+	if err != nil {
+		if errors.Is(err, model.ErrSSONotConfigured) {
+			return ErrNotFound(err), nil
+		}
+
+		log := logger.Get()
+		log.Error().Err(err).Msg("failed to start single sign-on login")
+
+		return ErrInternalServerError(err), nil
+	}
+
+	return &api.GetAuthOidcLoginFound{
+		Location:  authURL,
+		SetCookie: oidcStateCookie(state).String(),
+	}, nil
+}
+
+func (h *Handler) GetAuthOidcCallback(
+	ctx context.Context,
+	params api.GetAuthOidcCallbackParams,
+) (api.GetAuthOidcCallbackRes, error) {
+	log := logger.Get()
+
+	provider := h.auth.OIDC()
+	if provider == nil {
+		return ErrNotFound(model.ErrSSONotConfigured), nil
+	}
+
+	// The login must be completed in the browser that started it, otherwise
+	// an attacker could log a victim into the attacker's account.
+	if subtle.ConstantTimeCompare([]byte(params.State), []byte(params.MeOidc)) != 1 {
+		return ErrBadRequest(model.ErrInvalidSSOLogin), nil
+	}
+
+	if params.Error.Set || params.Code.Value == "" {
+		log.Warn().Str("error", params.Error.Value).Msg("identity provider rejected single sign-on login")
+		return ErrUnauthorised(model.ErrInvalidSSOLogin), nil
+	}
+
+	identity, err := h.auth.FinishOIDCLogin(ctx, params.State, params.Code.Value)
+	if err != nil {
+		if errors.Is(err, oidc.ErrNoRole) {
+			log.Warn().Err(err).Msg("single sign-on user has no role")
+			return ErrForbidden(model.ErrUserForbidden), nil
+		}
+
+		log.Warn().Err(err).Msg("failed to complete single sign-on login")
+
+		return ErrUnauthorised(model.ErrInvalidSSOLogin), nil
+	}
+
+	user, err := h.oidcUser(ctx, provider, identity)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrUserNotFound):
+			log.Warn().Str("username", identity.Username).Msg("single sign-on user not provisioned")
+			return ErrForbidden(model.ErrUserForbidden), nil
+		case errors.Is(err, model.ErrUserExists):
+			log.Warn().Str("username", identity.Username).Msg("single sign-on username already exists")
+			return ErrConflict(err), nil
+		default:
+			return ErrInternalServerError(err), nil
+		}
+	}
+
+	cookie, err := h.auth.CreateSession(ctx, user.ID, params.UserAgent.Value)
+	if err != nil {
+		return ErrInternalServerError(err), nil
+	}
+
+	log.Info().Str("id", user.ID).Str("username", user.Username).Msg("logged in with single sign-on")
+
+	return &api.GetAuthOidcCallbackFound{
+		Location:  oidcRedirectPath,
+		SetCookie: cookie.String(),
+	}, nil
+}
+
+// oidcUser returns the user linked to the identity, creating them on their
+// first login if auto-provisioning is enabled. The role of the user follows
+// their claims at the identity provider, except for the owner.
+func (h *Handler) oidcUser(
+	ctx context.Context,
+	provider *oidc.Provider,
+	identity *oidc.Identity,
+) (*model.User, error) {
+	user, err := h.db.GetUserByIdentity(ctx, identity.Issuer, identity.Subject)
+	if err == nil {
+		if user.Role != model.UserRoleOwner && user.Role != identity.Role {
+			err = h.db.UpdateUserRole(ctx, user.ID, identity.Role)
+			if err != nil {
+				return nil, errors.Wrap(err, "services")
+			}
+
+			user.Role = identity.Role
+		}
+
+		return user, nil
+	}
+
+	if !errors.Is(err, model.ErrUserNotFound) || !provider.AutoProvision() {
+		return nil, err
+	}
+
+	typeID, err := typeid.WithPrefix("user")
+	if err != nil {
+		return nil, errors.Wrap(err, "typeid user")
+	}
+
+	// Users from the identity provider do not have a password, so store the
+	// hash of a random one nobody knows.
+	pwdHash, err := h.auth.HashPassword(rand.Text())
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	dateNow := h.auth.Now().Unix()
+	user = model.NewUser(
+		typeID.String(),
+		identity.Username,
+		pwdHash,
+		identity.Role,
+		model.NewDefaultUserSettings(),
+		dateNow,
+		dateNow,
+	)
+
+	err = h.db.CreateUserWithIdentity(ctx, user, model.NewUserIdentity(
+		identity.Issuer,
+		identity.Subject,
+		user.ID,
+		dateNow,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	log := logger.Get()
+	log.Info().
+		Str("id", user.ID).
+		Str("username", user.Username).
+		Str("role", string(user.Role)).
+		Msg("provisioned single sign-on user")
+
+	return user, nil
+}
+
+// oidcStateCookie returns the cookie binding a single sign-on login to the
+// browser. It is sent cross-site on the redirect back from the identity
+// provider, so it must use the Lax mode.
+func oidcStateCookie(state string) *http.Cookie {
+	return &http.Cookie{
+		Name:     model.OIDCStateCookieName,
+		Value:    state,
+		Path:     "/",
+		MaxAge:   int(model.OIDCLoginDuration.Seconds()),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
@@ -0,0 +1,194 @@
+package services_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/middlewares"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/oidc"
+	"github.com/medama-io/medama/services"
+	"github.com/medama-io/medama/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOIDCLogin(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient := metest.NewTestDatabases(t)
+
+	idp := metest.NewOIDCProvider(t)
+
+	provider, err := oidc.NewProvider(oidc.Config{
+		Issuer:        idp.URL,
+		ClientID:      metest.OIDCClientID,
+		ClientSecret:  metest.OIDCClientSecret,
+		RedirectURL:   "http://medama.test/api/auth/oidc/callback",
+		AdminRoles:    []string{"medama-admins"},
+		AutoProvision: true,
+	})
+	require.NoError(err)
+
+	auth, err := util.NewAuthService(ctx, false, util.WithOIDC(provider), util.WithPasswordLogin(false))
+	require.NoError(err)
+
+	ingester := duckdb.NewIngester(duckdbClient, duckdb.IngestConfig{})
+	t.Cleanup(func() {
+		ingester.Close(t.Context()) //nolint:errcheck // Test cleanup.
+	})
+
+	handler, err := services.NewService(ctx, auth, sqliteClient, duckdbClient, ingester, "test-commit")
+	require.NoError(err)
+
+	server, err := api.NewServer(handler,
+		middlewares.NewAuthHandler(auth, sqliteClient),
+		api.WithErrorHandler(middlewares.ErrorHandler),
+	)
+	require.NoError(err)
+
+	do := func(method string, path string, body string, cookie string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		if cookie != "" {
+			req.Header.Set("Cookie", cookie)
+		}
+
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	cookieOf := func(rec *httptest.ResponseRecorder) string {
+		cookie, _, _ := strings.Cut(rec.Header().Get("Set-Cookie"), ";")
+		return cookie
+	}
+
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	// authorize starts a login and returns the callback path with the
+	// authorization code, along with the state cookie.
+	authorize := func() (string, string) {
+		rec := do(http.MethodGet, "/auth/oidc/login", "", "")
+		require.Equal(http.StatusFound, rec.Code)
+
+		stateCookie := cookieOf(rec)
+		require.True(strings.HasPrefix(stateCookie, model.OIDCStateCookieName+"="))
+
+		resp, err := client.Get(rec.Header().Get("Location"))
+		require.NoError(err)
+		resp.Body.Close()
+		require.Equal(http.StatusFound, resp.StatusCode)
+
+		callback, err := url.Parse(resp.Header.Get("Location"))
+		require.NoError(err)
+
+		return "/auth/oidc/callback?" + callback.RawQuery, stateCookie
+	}
+
+	rec := do(http.MethodGet, "/auth/methods", "", "")
+	require.Equal(http.StatusOK, rec.Code)
+	assert.JSONEq(`{"password":false,"sso":true}`, rec.Body.String())
+
+	rec = do(http.MethodPost, "/auth/login", `{"username":"admin","password":"CHANGE_ME_ON_FIRST_LOGIN"}`, "")
+	assert.Equal(http.StatusForbidden, rec.Code)
+
+	// Users are provisioned on their first login with the role from their claims.
+	idp.SetClaims(map[string]any{
+		"sub":                "alice-id",
+		"preferred_username": "alice",
+		"groups":             []string{"medama-admins"},
+	})
+
+	callback, stateCookie := authorize()
+
+	rec = do(http.MethodGet, callback, "", stateCookie)
+	require.Equal(http.StatusFound, rec.Code)
+	assert.Equal("/", rec.Header().Get("Location"))
+
+	session := cookieOf(rec)
+
+	rec = do(http.MethodGet, "/user", "", session)
+	require.Equal(http.StatusOK, rec.Code)
+	assert.Contains(rec.Body.String(), `"username":"alice"`)
+	assert.Contains(rec.Body.String(), `"role":"admin"`)
+
+	// Callbacks can only be used once.
+	rec = do(http.MethodGet, callback, "", stateCookie)
+	assert.Equal(http.StatusUnauthorized, rec.Code)
+
+	// Callbacks must come from the browser that started the login.
+	callback, _ = authorize()
+	_, otherCookie := authorize()
+
+	rec = do(http.MethodGet, callback, "", otherCookie)
+	assert.Equal(http.StatusBadRequest, rec.Code)
+
+	// Later logins use the linked user and keep their role in sync.
+	idp.SetClaims(map[string]any{
+		"sub":                "alice-id",
+		"preferred_username": "alice-renamed",
+		"groups":             []string{},
+	})
+
+	callback, stateCookie = authorize()
+
+	rec = do(http.MethodGet, callback, "", stateCookie)
+	require.Equal(http.StatusFound, rec.Code)
+
+	user, err := sqliteClient.GetUserByIdentity(ctx, idp.URL, "alice-id")
+	require.NoError(err)
+	assert.Equal("alice", user.Username)
+	assert.Equal(model.UserRoleViewer, user.Role)
+
+	// Identity provider users are not linked to local users by username.
+	idp.SetClaims(map[string]any{
+		"sub":                "mallory-id",
+		"preferred_username": "admin",
+	})
+
+	callback, stateCookie = authorize()
+
+	rec = do(http.MethodGet, callback, "", stateCookie)
+	assert.Equal(http.StatusConflict, rec.Code)
+
+	users, err := sqliteClient.ListUsers(ctx)
+	require.NoError(err)
+	assert.Len(users, 2)
+
+	// Failed logins at the identity provider are rejected.
+	rec = do(http.MethodGet, "/auth/oidc/callback?state=abc&error=access_denied", "", model.OIDCStateCookieName+"=abc")
+	assert.Equal(http.StatusUnauthorized, rec.Code)
+}
+
+func TestOIDCNotConfigured(t *testing.T) {
+	assert, _, handler, _ := metest.NewTestHandler(t)
+
+	resp, err := handler.GetAuthOidcLogin(t.Context())
+	require.NoError(t, err)
+
+	_, ok := resp.(*api.NotFoundErrorHeaders)
+	assert.True(ok)
+
+	methods, err := handler.GetAuthMethods(t.Context())
+	require.NoError(t, err)
+
+	headers, ok := methods.(*api.AuthMethodsHeaders)
+	require.True(t, ok)
+	assert.True(headers.Response.Password)
+	assert.False(headers.Response.SSO)
+}
@@ -16,7 +16,12 @@ func (h *Handler) GetWebsiteIDProperties(
 	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
 
 	// Check if website exists
-	exists := h.hostnames.Has(params.Hostname)
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
 	if !exists {
 		log.Debug().Msg("website not found")
 		return ErrNotFound(model.ErrWebsiteNotFound), nil
@@ -26,6 +31,33 @@ func (h *Handler) GetWebsiteIDProperties(
 	filters := db.CreateFilters(params, params.Hostname)
 	filters.SortByEventDates = true
 
+	// Export the full report if requested.
+	if format, ok := params.Format.Get(); ok {
+		filters := exportFilters(filters)
+
+		data, err := exportReport(ctx, format, func(emit func(*api.StatsPropertiesItem) error) error {
+			return h.analyticsDB.StreamWebsiteCustomProperties(
+				ctx,
+				filters,
+				func(p *model.StatsCustomProperties) error {
+					item := propertiesItem(p)
+					return emit(&item)
+				},
+			)
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("failed to export website properties")
+			return ErrInternalServerError(model.ErrInternalServerError), nil
+		}
+
+		resp := exportResponse(params.Hostname, "properties", format, data)
+		if format == api.FormatCsv {
+			return (*api.GetWebsiteIDPropertiesTextCsvOK)(resp), nil
+		}
+
+		return (*api.GetWebsiteIDPropertiesApplicationXNdjsonOK)(resp), nil
+	}
+
 	// Get the properties for the website
 	properties, err := h.analyticsDB.GetWebsiteCustomProperties(ctx, filters)
 	if err != nil {
@@ -35,23 +67,27 @@ func (h *Handler) GetWebsiteIDProperties(
 
 	resp := make(api.StatsProperties, 0, len(properties))
 	for _, p := range properties {
-		item := api.StatsPropertiesItem{
-			Events:           p.Events,
-			EventsPercentage: p.EventsPercentage,
-		}
-
-		if p.Name != "" {
-			item.Name = api.NewOptString(p.Name)
-		}
-
-		if p.Value != "" {
-			item.Value = api.NewOptString(p.Value)
-		}
-
-		resp = append(resp, item)
+		resp = append(resp, propertiesItem(p))
 	}
 
 	return &api.StatsPropertiesHeaders{
 		Response: resp,
 	}, nil
 }
+
+func propertiesItem(p *model.StatsCustomProperties) api.StatsPropertiesItem {
+	item := api.StatsPropertiesItem{
+		Events:           p.Events,
+		EventsPercentage: p.EventsPercentage,
+	}
+
+	if p.Name != "" {
+		item.Name = api.NewOptString(p.Name)
+	}
+
+	if p.Value != "" {
+		item.Value = api.NewOptString(p.Value)
+	}
+
+	return item
+}
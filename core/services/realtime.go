@@ -0,0 +1,275 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-faster/jx"
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util"
+	"github.com/medama-io/medama/util/logger"
+)
+
+const (
+	// realtimeThrottle is the minimum interval between two realtime updates
+	// sent to the same stream when hits arrive in bursts.
+	realtimeThrottle = time.Second
+	// realtimeRefresh is how often a realtime update is sent without new hits
+	// so visitors that become inactive are removed from the dashboard. This
+	// also keeps the connection alive through proxies.
+	realtimeRefresh = 15 * time.Second
+)
+
+func (h *Handler) GetWebsiteIDRealtime(
+	ctx context.Context,
+	params api.GetWebsiteIDRealtimeParams,
+) (api.GetWebsiteIDRealtimeRes, error) {
+	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
+
+	// Check if website exists
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
+	if !exists {
+		log.Debug().Msg("website not found")
+		return ErrNotFound(model.ErrWebsiteNotFound), nil
+	}
+
+	minutes := params.Minutes.Or(int(util.DefaultRealtimeWindow / time.Minute))
+
+	// Served from the same tracker as the realtime stream so both agree.
+	realtime := h.realtime.Snapshot(params.Hostname, time.Duration(minutes)*time.Minute, time.Now())
+
+	return &api.StatsRealtimeHeaders{
+		Response: realtimeToAPI(realtime),
+	}, nil
+}
+
+// RealtimeStreamHandler streams the realtime stats of a website as Server-Sent
+// Events. An update is sent whenever a new page view is recorded for the
+// website, at most once per second, and periodically to expire inactive
+// visitors.
+//
+// This is served outside of the generated API server as ogen does not support
+// streaming responses, so authentication is handled here.
+func (h *Handler) RealtimeStreamHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		hostname := r.PathValue("hostname")
+		log := logger.Get().With().Str("hostname", hostname).Logger()
+
+		ctx, err := h.authenticateRequest(r)
+		if err != nil {
+			log.Warn().Msg("realtime: unauthorised")
+			writeStreamError(w, http.StatusUnauthorized, model.ErrUnauthorised)
+
+			return
+		}
+
+		access, err := h.canAccessWebsite(ctx, hostname)
+		if err != nil {
+			log.Error().Err(err).Msg("realtime: failed to check website access")
+			writeStreamError(w, http.StatusInternalServerError, model.ErrInternalServerError)
+
+			return
+		}
+
+		if !access {
+			log.Debug().Msg("realtime: website not found")
+			writeStreamError(w, http.StatusNotFound, model.ErrWebsiteNotFound)
+
+			return
+		}
+
+		window := util.DefaultRealtimeWindow
+
+		if v := r.URL.Query().Get("minutes"); v != "" {
+			minutes, err := strconv.Atoi(v)
+			if err != nil || minutes < 1 || time.Duration(minutes)*time.Minute > util.MaxRealtimeWindow {
+				writeStreamError(w, http.StatusBadRequest, model.ErrInvalidRealtimeWindow)
+				return
+			}
+
+			window = time.Duration(minutes) * time.Minute
+		}
+
+		// The stream outlives the server write timeout.
+		rc := http.NewResponseController(w)
+
+		err = rc.SetWriteDeadline(time.Time{})
+		if err != nil {
+			log.Error().Err(err).Msg("realtime: failed to clear write deadline")
+			writeStreamError(w, http.StatusInternalServerError, model.ErrInternalServerError)
+
+			return
+		}
+
+		sub := h.realtime.Subscribe(hostname)
+		defer sub.Close()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", NoCache)
+		w.Header().Set("Connection", "keep-alive")
+		// Disable response buffering in nginx.
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+
+		send := func() bool {
+			err := writeRealtimeEvent(w, h.realtime.Snapshot(hostname, window, time.Now()))
+			if err == nil {
+				err = rc.Flush()
+			}
+
+			if err != nil {
+				log.Debug().Err(err).Msg("realtime: failed to write event")
+				return false
+			}
+
+			return true
+		}
+
+		if !send() {
+			return
+		}
+
+		throttle := time.NewTicker(realtimeThrottle)
+		defer throttle.Stop()
+
+		refresh := time.NewTicker(realtimeRefresh)
+		defer refresh.Stop()
+
+		pending := false
+
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case _, ok := <-sub.C:
+				// The subscription is closed when the server shuts down.
+				if !ok {
+					return
+				}
+
+				pending = true
+			case <-throttle.C:
+				if !pending {
+					continue
+				}
+
+				pending = false
+
+				if !send() {
+					return
+				}
+
+				refresh.Reset(realtimeRefresh)
+			case <-refresh.C:
+				pending = false
+
+				if !send() {
+					return
+				}
+			}
+		}
+	}
+}
+
+// recordRealtimeHit publishes the page view to the realtime subscribers of the
+// website.
+func (h *Handler) recordRealtimeHit(event *model.PageViewHit) {
+	visitID := event.VisitID
+	if visitID == "" {
+		visitID = event.BID
+	}
+
+	referrer := event.ReferrerGroup
+	if referrer == "" {
+		referrer = event.ReferrerHost
+	}
+
+	h.realtime.Record(event.Hostname, model.RealtimeHit{
+		VisitID:  visitID,
+		Pathname: event.Pathname,
+		Referrer: referrer,
+		Time:     time.Now(),
+	})
+}
+
+// Close stops the background jobs of the handler and ends all realtime streams.
+func (h *Handler) Close() {
+	h.realtime.Close()
+
+	if h.visits != nil {
+		h.visits.Close()
+	}
+
+	h.deletions.Close()
+	h.webhooks.Close()
+}
+
+func realtimeToAPI(realtime *model.StatsRealtime) api.StatsRealtime {
+	resp := api.StatsRealtime{
+		Visitors:  realtime.Visitors,
+		Pages:     make([]api.StatsRealtimePagesItem, 0, len(realtime.Pages)),
+		Referrers: make([]api.StatsRealtimeReferrersItem, 0, len(realtime.Referrers)),
+	}
+
+	for _, page := range realtime.Pages {
+		resp.Pages = append(resp.Pages, api.StatsRealtimePagesItem{
+			Path:     page.Pathname,
+			Visitors: page.Visitors,
+		})
+	}
+
+	for _, referrer := range realtime.Referrers {
+		resp.Referrers = append(resp.Referrers, api.StatsRealtimeReferrersItem{
+			Referrer: referrer.Referrer,
+			Visitors: referrer.Visitors,
+		})
+	}
+
+	return resp
+}
+
+// writeRealtimeEvent writes the realtime stats as a single Server-Sent Event.
+func writeRealtimeEvent(w http.ResponseWriter, realtime *model.StatsRealtime) error {
+	resp := realtimeToAPI(realtime)
+
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+
+	e.RawStr("event: realtime\ndata: ")
+	resp.Encode(e)
+	e.RawStr("\n\n")
+
+	_, err := w.Write(e.Bytes())
+
+	return err
+}
+
+// writeStreamError writes a JSON error response in the same format as the
+// generated API server.
+func writeStreamError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+
+	e.ObjStart()
+	e.FieldStart("error")
+	e.ObjStart()
+	e.FieldStart("code")
+	e.Int(code)
+	e.FieldStart("message")
+	e.StrEscape(err.Error())
+	e.ObjEnd()
+	e.ObjEnd()
+
+	_, _ = w.Write(e.Bytes())
+}
@@ -0,0 +1,89 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/services"
+	"github.com/medama-io/medama/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRealtimeSeed(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient := metest.NewTestDatabases(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	require.NoError(sqliteClient.CreateWebsite(ctx, model.NewWebsite(user.ID, "example.com", 1, 1)))
+
+	// Page views recorded before the server started.
+	now := time.Now()
+	views := make([]model.ImportedPageView, 0, 3)
+
+	for i, view := range []struct {
+		pathname string
+		ago      time.Duration
+	}{
+		{"/", time.Minute},
+		{"/pricing", 2 * time.Minute},
+		{"/about", 10 * time.Minute},
+	} {
+		views = append(views, model.ImportedPageView{
+			PageViewHit: model.PageViewHit{
+				BID:          "bid_" + string(rune('a'+i)),
+				Hostname:     "example.com",
+				Pathname:     view.pathname,
+				IsUniqueUser: true,
+				IsUniquePage: true,
+			},
+			DateCreated: now.Add(-view.ago),
+		})
+	}
+
+	require.NoError(duckdbClient.InsertImportedViews(ctx, "import_realtime", views))
+
+	auth, err := util.NewAuthService(ctx, false)
+	require.NoError(err)
+
+	ingester := duckdb.NewIngester(duckdbClient, duckdb.IngestConfig{})
+	t.Cleanup(func() {
+		ingester.Close(t.Context()) //nolint:errcheck // Test cleanup.
+	})
+
+	handler, err := services.NewService(ctx, auth, sqliteClient, duckdbClient, ingester, "test-commit")
+	require.NoError(err)
+	t.Cleanup(handler.Close)
+
+	ctx = context.WithValue(ctx, model.ContextKeyUserID, user.ID)
+
+	res, err := handler.GetWebsiteIDRealtime(ctx, api.GetWebsiteIDRealtimeParams{Hostname: "example.com"})
+	require.NoError(err)
+	require.IsType(&api.StatsRealtimeHeaders{}, res)
+	assert.Equal(api.StatsRealtime{
+		Visitors: 2,
+		Pages: []api.StatsRealtimePagesItem{
+			{Path: "/", Visitors: 1},
+			{Path: "/pricing", Visitors: 1},
+		},
+		Referrers: []api.StatsRealtimeReferrersItem{
+			{Referrer: "", Visitors: 2},
+		},
+	}, res.(*api.StatsRealtimeHeaders).Response)
+
+	res, err = handler.GetWebsiteIDRealtime(ctx, api.GetWebsiteIDRealtimeParams{
+		Hostname: "example.com",
+		Minutes:  api.NewOptInt(30),
+	})
+	require.NoError(err)
+	require.IsType(&api.StatsRealtimeHeaders{}, res)
+	assert.Equal(3, res.(*api.StatsRealtimeHeaders).Response.Visitors)
+}
@@ -0,0 +1,357 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db"
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/db/sqlite"
+	"github.com/medama-io/medama/mail"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+)
+
+// DefaultReportInterval is how often due email reports are checked for.
+const DefaultReportInterval = time.Hour
+
+// ReportJob periodically sends the email reports of all subscriptions whose
+// last full period has not been reported yet.
+type ReportJob struct {
+	db          *sqlite.Client
+	analyticsDB *duckdb.Client
+	mailer      *mail.Client
+	interval    time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewReportJob returns a new report job checking for due reports on the given
+// interval.
+func NewReportJob(
+	db *sqlite.Client,
+	analyticsDB *duckdb.Client,
+	mailer *mail.Client,
+	interval time.Duration,
+) *ReportJob {
+	if interval <= 0 {
+		interval = DefaultReportInterval
+	}
+
+	return &ReportJob{
+		db:          db,
+		analyticsDB: analyticsDB,
+		mailer:      mailer,
+		interval:    interval,
+	}
+}
+
+// Start runs the job once and then on every interval in the background until
+// the job is closed or the context is cancelled.
+func (r *ReportJob) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+
+	r.wg.Add(1)
+
+	go func() {
+		defer r.wg.Done()
+
+		log := logger.Get()
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			_, err := r.Run(ctx, time.Now())
+			if err != nil && ctx.Err() == nil {
+				log.Error().Err(err).Msg("failed to send email reports")
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Close stops the background job and waits for running sends to finish.
+func (r *ReportJob) Close() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+
+	r.wg.Wait()
+}
+
+// Run sends the reports due at the given time and returns the number of sent
+// reports. A failed report does not stop the remaining reports from being
+// sent and is retried on the next run.
+func (r *ReportJob) Run(ctx context.Context, now time.Time) (int, error) {
+	log := logger.Get()
+
+	subs, err := r.db.ListAllReportSubscriptions(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "reports")
+	}
+
+	var (
+		sent int
+		errs []error
+	)
+
+	for _, sub := range subs {
+		if !sub.IsDue(now) {
+			continue
+		}
+
+		if ctx.Err() != nil {
+			return sent, ctx.Err()
+		}
+
+		// Users may have lost access to the website since subscribing.
+		access, err := r.db.HasWebsiteAccess(ctx, sub.UserID, sub.Hostname)
+		if err != nil {
+			errs = append(errs, errors.Wrap(err, "reports: "+sub.ID))
+			continue
+		}
+
+		if !access {
+			log.Debug().
+				Str("id", sub.ID).
+				Str("hostname", sub.Hostname).
+				Msg("skipped report for website without access")
+
+			continue
+		}
+
+		err = r.send(ctx, sub, now)
+		if err != nil {
+			errs = append(errs, errors.Wrap(err, "reports: "+sub.ID))
+			continue
+		}
+
+		sent++
+	}
+
+	if sent > 0 {
+		log.Info().Int("sent", sent).Msg("sent email reports")
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+// send renders and delivers the report of the last full period of the
+// subscription, then marks the period as sent.
+func (r *ReportJob) send(ctx context.Context, sub *model.ReportSubscription, now time.Time) error {
+	start, end := sub.Frequency.Period(now)
+
+	data, err := r.collect(ctx, sub, start, end)
+	if err != nil {
+		return err
+	}
+
+	msg, err := renderReport(sub.Email, data)
+	if err != nil {
+		return err
+	}
+
+	err = r.mailer.Send(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	return r.db.UpdateReportLastSent(ctx, sub.ID, end.Unix())
+}
+
+// reportData is the content of an email report.
+type reportData struct {
+	Hostname  string
+	Frequency string
+	Period    string
+
+	Summary   *reportSummary
+	Pages     []reportRow
+	Referrers []reportRow
+
+	HasPages     bool
+	HasReferrers bool
+}
+
+type reportSummary struct {
+	Visitors   int
+	Pageviews  int
+	BounceRate string
+	Duration   string
+}
+
+type reportRow struct {
+	Name       string
+	Visitors   int
+	Percentage string
+}
+
+func (r *ReportJob) collect(
+	ctx context.Context,
+	sub *model.ReportSubscription,
+	start time.Time,
+	end time.Time,
+) (*reportData, error) {
+	filters := &db.Filters{
+		Hostname:    sub.Hostname,
+		PeriodStart: start.Format(model.DateFormat),
+		PeriodEnd:   end.Format(model.DateFormat),
+		Limit:       model.ReportTopLimit,
+	}
+
+	data := &reportData{
+		Hostname:     sub.Hostname,
+		Frequency:    string(sub.Frequency),
+		Period:       formatReportPeriod(start, end),
+		HasPages:     sub.HasStat(model.ReportStatPages),
+		HasReferrers: sub.HasStat(model.ReportStatReferrers),
+	}
+
+	if sub.HasStat(model.ReportStatSummary) {
+		summary, err := r.analyticsDB.GetWebsiteSummary(ctx, filters)
+		if err != nil {
+			return nil, err
+		}
+
+		data.Summary = &reportSummary{
+			Visitors:   summary.Visitors,
+			Pageviews:  summary.Pageviews,
+			BounceRate: formatPercentage(summary.BounceRate),
+			Duration:   (time.Duration(summary.Duration) * time.Millisecond).Round(time.Second).String(),
+		}
+	}
+
+	if data.HasPages {
+		pages, err := r.analyticsDB.GetWebsitePagesSummary(ctx, filters)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, page := range pages {
+			data.Pages = append(data.Pages, reportRow{
+				Name:       page.Pathname,
+				Visitors:   page.Visitors,
+				Percentage: formatPercentage(page.VisitorsPercentage),
+			})
+		}
+	}
+
+	if data.HasReferrers {
+		referrers, err := r.analyticsDB.GetWebsiteReferrersSummary(ctx, true, filters)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, referrer := range referrers {
+			name := referrer.Referrer
+			if name == "" {
+				name = "Direct/None"
+			}
+
+			data.Referrers = append(data.Referrers, reportRow{
+				Name:       name,
+				Visitors:   referrer.Visitors,
+				Percentage: formatPercentage(referrer.VisitorsPercentage),
+			})
+		}
+	}
+
+	return data, nil
+}
+
+// formatReportPeriod returns the days covered by the period, where end is the
+// exclusive start of the next period.
+func formatReportPeriod(start time.Time, end time.Time) string {
+	const layout = "Jan 2, 2006"
+
+	last := end.AddDate(0, 0, -1)
+	if last.Equal(start) {
+		return start.Format(layout)
+	}
+
+	return start.Format(layout) + " - " + last.Format(layout)
+}
+
+func formatPercentage(ratio float32) string {
+	return fmt.Sprintf("%.1f%%", ratio*100)
+}
+
+func renderReport(to string, data *reportData) (*mail.Message, error) {
+	var text, html bytes.Buffer
+
+	err := reportTextTemplate.Execute(&text, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "render text report")
+	}
+
+	err = reportHTMLTemplate.Execute(&html, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "render html report")
+	}
+
+	frequency := strings.ToUpper(data.Frequency[:1]) + data.Frequency[1:]
+
+	return &mail.Message{
+		To:      to,
+		Subject: frequency + " report for " + data.Hostname + " (" + data.Period + ")",
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
+
+//nolint:gochecknoglobals // Parsed once as the templates never change.
+var reportTextTemplate = texttemplate.Must(texttemplate.New("report").Parse(
+	`Medama {{.Frequency}} report for {{.Hostname}}
+{{.Period}}
+{{with .Summary}}
+Summary
+Visitors: {{.Visitors}}
+Page views: {{.Pageviews}}
+Bounce rate: {{.BounceRate}}
+Visit duration: {{.Duration}}
+{{end}}{{if .HasPages}}
+Top pages
+{{range .Pages}}{{.Name}}: {{.Visitors}} ({{.Percentage}})
+{{else}}No page views in this period.
+{{end}}{{end}}{{if .HasReferrers}}
+Top referrers
+{{range .Referrers}}{{.Name}}: {{.Visitors}} ({{.Percentage}})
+{{else}}No referrers in this period.
+{{end}}{{end}}`))
+
+//nolint:gochecknoglobals // Parsed once as the templates never change.
+var reportHTMLTemplate = htmltemplate.Must(htmltemplate.New("report").Parse(
+	`<!DOCTYPE html>
+<html>
+<body style="font-family: sans-serif; color: #17181c;">
+<h1 style="font-size: 20px;">{{.Hostname}}</h1>
+<p style="color: #6b6e76;">Medama {{.Frequency}} report, {{.Period}}</p>
+{{with .Summary}}<h2 style="font-size: 16px;">Summary</h2>
+<table cellpadding="4">
+<tr><td>Visitors</td><td><strong>{{.Visitors}}</strong></td></tr>
+<tr><td>Page views</td><td><strong>{{.Pageviews}}</strong></td></tr>
+<tr><td>Bounce rate</td><td><strong>{{.BounceRate}}</strong></td></tr>
+<tr><td>Visit duration</td><td><strong>{{.Duration}}</strong></td></tr>
+</table>
+{{end}}{{if .HasPages}}<h2 style="font-size: 16px;">Top pages</h2>
+{{template "rows" .Pages}}{{end}}{{if .HasReferrers}}<h2 style="font-size: 16px;">Top referrers</h2>
+{{template "rows" .Referrers}}{{end}}</body>
+</html>
+{{define "rows"}}{{if .}}<table cellpadding="4">
+{{range .}}<tr><td>{{.Name}}</td><td>{{.Visitors}}</td><td>{{.Percentage}}</td></tr>
+{{end}}</table>
+{{else}}<p>No data in this period.</p>
+{{end}}{{end}}`))
@@ -0,0 +1,261 @@
+package services
+
+import (
+	"context"
+	"net/mail"
+	"slices"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+	"go.jetify.com/typeid"
+)
+
+func (h *Handler) GetReports(
+	ctx context.Context,
+	_params api.GetReportsParams,
+) (api.GetReportsRes, error) {
+	// Get user ID from context
+	userID, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	subs, err := h.db.ListReportSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	resp := make([]api.ReportGet, 0, len(subs))
+	for _, sub := range subs {
+		resp = append(resp, reportToAPI(sub))
+	}
+
+	return &api.GetReportsOKHeaders{
+		Response: resp,
+	}, nil
+}
+
+func (h *Handler) PostReports(
+	ctx context.Context,
+	req *api.ReportCreate,
+	_params api.PostReportsParams,
+) (api.PostReportsRes, error) {
+	log := logger.Get()
+	if h.auth.IsDemoMode {
+		log.Debug().Msg("post report rejected in demo mode")
+		return ErrForbidden(model.ErrDemoMode), nil
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	if !isValidReportEmail(req.Email) {
+		return ErrBadRequest(model.ErrInvalidReportEmail), nil
+	}
+
+	stats, err := reportStatsFromAPI(req.Stats)
+	if err != nil {
+		return ErrBadRequest(err), nil
+	}
+
+	access, err := h.canAccessWebsite(ctx, req.Hostname)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if !access {
+		return ErrNotFound(model.ErrWebsiteNotFound), nil
+	}
+
+	typeID, err := typeid.WithPrefix(model.ReportPrefix)
+	if err != nil {
+		return nil, errors.Wrap(err, "typeid report")
+	}
+
+	now := time.Now().Unix()
+	sub := model.NewReportSubscription(
+		typeID.String(),
+		userID,
+		req.Hostname,
+		req.Email,
+		model.ReportFrequency(req.Frequency),
+		stats,
+		now,
+		now,
+	)
+
+	err = h.db.CreateReportSubscription(ctx, sub)
+	if err != nil {
+		if errors.Is(err, model.ErrWebsiteNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	log.Info().
+		Str("id", sub.ID).
+		Str("hostname", sub.Hostname).
+		Str("frequency", string(sub.Frequency)).
+		Msg("created report subscription")
+
+	return &api.ReportGetHeaders{
+		Response: reportToAPI(sub),
+	}, nil
+}
+
+func (h *Handler) PatchReportsID(
+	ctx context.Context,
+	req *api.ReportPatch,
+	params api.PatchReportsIDParams,
+) (api.PatchReportsIDRes, error) {
+	log := logger.Get()
+	if h.auth.IsDemoMode {
+		log.Debug().Msg("patch report rejected in demo mode")
+		return ErrForbidden(model.ErrDemoMode), nil
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	sub, err := h.db.GetReportSubscription(ctx, params.ReportId)
+	if err != nil {
+		if errors.Is(err, model.ErrReportNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	// Subscriptions of other users are hidden.
+	if sub.UserID != userID {
+		return ErrNotFound(model.ErrReportNotFound), nil
+	}
+
+	if req.Email.Set {
+		if !isValidReportEmail(req.Email.Value) {
+			return ErrBadRequest(model.ErrInvalidReportEmail), nil
+		}
+
+		sub.Email = req.Email.Value
+	}
+
+	if req.Frequency.Set {
+		sub.Frequency = model.ReportFrequency(req.Frequency.Value)
+	}
+
+	if req.Stats != nil {
+		sub.Stats, err = reportStatsFromAPI(req.Stats)
+		if err != nil {
+			return ErrBadRequest(err), nil
+		}
+	}
+
+	sub.DateUpdated = time.Now().Unix()
+
+	err = h.db.UpdateReportSubscription(ctx, sub)
+	if err != nil {
+		if errors.Is(err, model.ErrReportNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	log.Info().Str("id", sub.ID).Msg("updated report subscription")
+
+	return &api.ReportGetHeaders{
+		Response: reportToAPI(sub),
+	}, nil
+}
+
+func (h *Handler) DeleteReportsID(
+	ctx context.Context,
+	params api.DeleteReportsIDParams,
+) (api.DeleteReportsIDRes, error) {
+	log := logger.Get()
+	if h.auth.IsDemoMode {
+		log.Debug().Msg("delete report rejected in demo mode")
+		return ErrForbidden(model.ErrDemoMode), nil
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	err := h.db.DeleteReportSubscription(ctx, userID, params.ReportId)
+	if err != nil {
+		if errors.Is(err, model.ErrReportNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	log.Info().Str("id", params.ReportId).Msg("deleted report subscription")
+
+	return &api.DeleteReportsIDNoContent{}, nil
+}
+
+// isValidReportEmail reports whether the recipient is a bare email address
+// without a display name.
+func isValidReportEmail(email string) bool {
+	addr, err := mail.ParseAddress(email)
+
+	return err == nil && addr.Address == email
+}
+
+// reportStatsFromAPI returns the stats included in a report without
+// duplicates.
+func reportStatsFromAPI(stats []api.ReportStat) ([]model.ReportStat, error) {
+	resp := make([]model.ReportStat, 0, len(stats))
+
+	for _, stat := range stats {
+		if err := stat.Validate(); err != nil {
+			return nil, model.ErrInvalidReportStats
+		}
+
+		if !slices.Contains(resp, model.ReportStat(stat)) {
+			resp = append(resp, model.ReportStat(stat))
+		}
+	}
+
+	if len(resp) == 0 {
+		return nil, model.ErrInvalidReportStats
+	}
+
+	return resp, nil
+}
+
+func reportToAPI(sub *model.ReportSubscription) api.ReportGet {
+	resp := api.ReportGet{
+		ID:        sub.ID,
+		Hostname:  sub.Hostname,
+		Email:     sub.Email,
+		Frequency: api.ReportFrequency(sub.Frequency),
+		Stats:     make([]api.ReportStat, 0, len(sub.Stats)),
+		CreatedAt: time.Unix(sub.DateCreated, 0).UTC(),
+	}
+
+	for _, stat := range sub.Stats {
+		resp.Stats = append(resp.Stats, api.ReportStat(stat))
+	}
+
+	// The last sent date starts at the creation date until the first report.
+	if sub.DateLastSent != sub.DateCreated {
+		resp.LastSentAt = api.NewOptDateTime(time.Unix(sub.DateLastSent, 0).UTC())
+	}
+
+	return resp
+}
@@ -0,0 +1,273 @@
+package services_test
+
+import (
+	"context"
+	"io"
+	"mime/multipart"
+	netmail "net/mail"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/mail"
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/services"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReports(t *testing.T) {
+	assert, ctx, handler, sqliteClient := metest.NewTestHandler(t)
+	require := require.New(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	ctx = context.WithValue(ctx, model.ContextKeyUserID, user.ID)
+
+	_, err = handler.PostWebsites(ctx, &api.WebsiteCreate{Hostname: "example.com"})
+	require.NoError(err)
+
+	resp, err := handler.PostReports(ctx, &api.ReportCreate{
+		Hostname:  "example.com",
+		Email:     "owner@example.com",
+		Frequency: api.ReportFrequencyWeekly,
+		Stats:     []api.ReportStat{api.ReportStatSummary, api.ReportStatPages, api.ReportStatSummary},
+	}, api.PostReportsParams{})
+	require.NoError(err)
+
+	created, ok := resp.(*api.ReportGetHeaders)
+	require.True(ok)
+	assert.True(strings.HasPrefix(created.Response.ID, model.ReportPrefix+"_"))
+	assert.Equal([]api.ReportStat{api.ReportStatSummary, api.ReportStatPages}, created.Response.Stats)
+	assert.False(created.Response.LastSentAt.Set)
+
+	// Reports can only be sent for accessible websites to a bare address.
+	resp, err = handler.PostReports(ctx, &api.ReportCreate{
+		Hostname:  "missing.example.com",
+		Email:     "owner@example.com",
+		Frequency: api.ReportFrequencyDaily,
+		Stats:     []api.ReportStat{api.ReportStatSummary},
+	}, api.PostReportsParams{})
+	require.NoError(err)
+
+	_, ok = resp.(*api.NotFoundErrorHeaders)
+	assert.True(ok)
+
+	resp, err = handler.PostReports(ctx, &api.ReportCreate{
+		Hostname:  "example.com",
+		Email:     "Owner <owner@example.com>",
+		Frequency: api.ReportFrequencyDaily,
+		Stats:     []api.ReportStat{api.ReportStatSummary},
+	}, api.PostReportsParams{})
+	require.NoError(err)
+
+	_, ok = resp.(*api.BadRequestErrorHeaders)
+	assert.True(ok)
+
+	patch, err := handler.PatchReportsID(ctx, &api.ReportPatch{
+		Frequency: api.NewOptReportFrequency(api.ReportFrequencyMonthly),
+		Stats:     []api.ReportStat{api.ReportStatReferrers},
+	}, api.PatchReportsIDParams{ReportId: created.Response.ID})
+	require.NoError(err)
+
+	updated, ok := patch.(*api.ReportGetHeaders)
+	require.True(ok)
+	assert.Equal("owner@example.com", updated.Response.Email)
+	assert.Equal(api.ReportFrequencyMonthly, updated.Response.Frequency)
+	assert.Equal([]api.ReportStat{api.ReportStatReferrers}, updated.Response.Stats)
+
+	// Reports of other users are hidden.
+	otherCtx := context.WithValue(ctx, model.ContextKeyUserID, "user_other")
+
+	patch, err = handler.PatchReportsID(otherCtx, &api.ReportPatch{
+		Email: api.NewOptString("other@example.com"),
+	}, api.PatchReportsIDParams{ReportId: created.Response.ID})
+	require.NoError(err)
+
+	_, ok = patch.(*api.NotFoundErrorHeaders)
+	assert.True(ok)
+
+	list, err := handler.GetReports(otherCtx, api.GetReportsParams{})
+	require.NoError(err)
+
+	reports, ok := list.(*api.GetReportsOKHeaders)
+	require.True(ok)
+	assert.Empty(reports.Response)
+
+	list, err = handler.GetReports(ctx, api.GetReportsParams{})
+	require.NoError(err)
+
+	reports, ok = list.(*api.GetReportsOKHeaders)
+	require.True(ok)
+	require.Len(reports.Response, 1)
+	assert.Equal(created.Response.ID, reports.Response[0].ID)
+
+	del, err := handler.DeleteReportsID(ctx, api.DeleteReportsIDParams{ReportId: "report_missing"})
+	require.NoError(err)
+
+	_, ok = del.(*api.NotFoundErrorHeaders)
+	assert.True(ok)
+
+	del, err = handler.DeleteReportsID(ctx, api.DeleteReportsIDParams{ReportId: created.Response.ID})
+	require.NoError(err)
+
+	_, ok = del.(*api.DeleteReportsIDNoContent)
+	assert.True(ok)
+}
+
+func TestReportJob(t *testing.T) {
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient := metest.NewTestDatabases(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	require.NoError(sqliteClient.CreateWebsite(ctx, model.NewWebsite(user.ID, "example.com", 1, 1)))
+
+	// Viewers without membership of the website do not receive its reports.
+	viewer := model.NewUser("user_viewer", "viewer", "hash", model.UserRoleViewer, model.NewDefaultUserSettings(), 1, 1)
+	require.NoError(sqliteClient.CreateUser(ctx, viewer))
+
+	// Reports sent on Wednesday cover the previous day and week.
+	now := time.Date(2026, 10, 14, 9, 0, 0, 0, time.UTC)
+	yesterday := time.Date(2026, 10, 13, 12, 0, 0, 0, time.UTC)
+
+	var views []model.ImportedPageView
+	for i, view := range []struct{ pathname, referrer string }{
+		{"/", "news.example.org"},
+		{"/", "news.example.org"},
+		{"/pricing", ""},
+		{"/<script>", ""},
+	} {
+		views = append(views, model.ImportedPageView{
+			PageViewHit: model.PageViewHit{
+				BID:          "report_" + strconv.Itoa(i),
+				Hostname:     "example.com",
+				Pathname:     view.pathname,
+				ReferrerHost: view.referrer,
+				IsUniqueUser: true,
+				IsUniquePage: true,
+			},
+			DurationMs:  2000,
+			DateCreated: yesterday,
+		})
+	}
+
+	require.NoError(duckdbClient.InsertImportedViews(ctx, "import_reports", views))
+
+	created := now.AddDate(0, 0, -30).Unix()
+	for _, sub := range []*model.ReportSubscription{
+		model.NewReportSubscription("report_daily", user.ID, "example.com", "daily@example.com",
+			model.ReportFrequencyDaily,
+			[]model.ReportStat{model.ReportStatSummary, model.ReportStatPages, model.ReportStatReferrers},
+			created, created),
+		model.NewReportSubscription("report_weekly", user.ID, "example.com", "weekly@example.com",
+			model.ReportFrequencyWeekly, []model.ReportStat{model.ReportStatReferrers}, created, created),
+		model.NewReportSubscription("report_viewer", viewer.ID, "example.com", "viewer@example.com",
+			model.ReportFrequencyDaily, []model.ReportStat{model.ReportStatSummary}, created, created),
+		// Subscriptions created during the current period wait for it to end.
+		model.NewReportSubscription("report_new", user.ID, "example.com", "new@example.com",
+			model.ReportFrequencyMonthly, []model.ReportStat{model.ReportStatSummary}, now.Unix(), now.Unix()),
+	} {
+		require.NoError(sqliteClient.CreateReportSubscription(ctx, sub))
+	}
+
+	server := metest.NewSMTPServer(t)
+	mailer := mail.NewClient(mail.Config{
+		Host: server.Host,
+		Port: server.Port,
+		From: "reports@example.com",
+	})
+
+	job := services.NewReportJob(sqliteClient, duckdbClient, mailer, time.Hour)
+
+	sent, err := job.Run(ctx, now)
+	require.NoError(err)
+	require.Equal(2, sent)
+
+	messages := server.Messages()
+	require.Len(messages, 2)
+
+	bodies := map[string]map[string]string{}
+	subjects := map[string]string{}
+
+	for _, message := range messages {
+		require.Len(message.To, 1)
+
+		subject, text, html := readReport(t, message.Data)
+		subjects[message.To[0]] = subject
+		bodies[message.To[0]] = map[string]string{"text": text, "html": html}
+	}
+
+	require.Equal("Daily report for example.com (Oct 13, 2026)", subjects["daily@example.com"])
+	require.Equal("Weekly report for example.com (Oct 5, 2026 - Oct 11, 2026)", subjects["weekly@example.com"])
+
+	daily := bodies["daily@example.com"]
+	require.Contains(daily["text"], "Visitors: 4\r\n")
+	require.Contains(daily["text"], "Page views: 4\r\n")
+	require.Contains(daily["text"], "/: 2 (50.0%)\r\n")
+	require.Contains(daily["text"], "news.example.org: 2 (50.0%)\r\n")
+	require.Contains(daily["text"], "Direct/None: 2 (50.0%)\r\n")
+	require.Contains(daily["html"], "<td>/pricing</td>")
+	require.Contains(daily["html"], "&lt;script&gt;")
+	require.NotContains(daily["html"], "<script>")
+
+	// The views happened after the last full week, so it has no referrers.
+	weekly := bodies["weekly@example.com"]
+	require.Contains(weekly["text"], "No referrers in this period.")
+	require.NotContains(weekly["text"], "Summary")
+
+	// Reports are only sent once per period.
+	sent, err = job.Run(ctx, now.Add(time.Hour))
+	require.NoError(err)
+	require.Zero(sent)
+
+	sub, err := sqliteClient.GetReportSubscription(ctx, "report_daily")
+	require.NoError(err)
+	require.Equal(time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC).Unix(), sub.DateLastSent)
+
+	sent, err = job.Run(ctx, now.AddDate(0, 0, 1))
+	require.NoError(err)
+	require.Equal(1, sent)
+	require.Len(server.Messages(), 3)
+}
+
+// readReport returns the decoded subject, plain text and HTML body of an
+// email report.
+func readReport(t *testing.T, data string) (string, string, string) {
+	t.Helper()
+
+	msg, err := netmail.ReadMessage(strings.NewReader(data))
+	require.NoError(t, err)
+
+	subject := msg.Header.Get("Subject")
+	_, params, found := strings.Cut(msg.Header.Get("Content-Type"), "boundary=")
+	require.True(t, found)
+
+	reader := multipart.NewReader(msg.Body, params)
+
+	var text, html string
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(part)
+		require.NoError(t, err)
+
+		if strings.HasPrefix(part.Header.Get("Content-Type"), "text/html") {
+			html = string(body)
+		} else {
+			text = string(body)
+		}
+	}
+
+	return subject, text, html
+}
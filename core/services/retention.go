@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/db/sqlite"
+	"github.com/medama-io/medama/util/logger"
+)
+
+// DefaultRetentionInterval is how often data older than the retention period
+// is deleted.
+const DefaultRetentionInterval = 24 * time.Hour
+
+// RetentionResult summarises a single run of the retention job.
+type RetentionResult struct {
+	// Views is the number of deleted page views.
+	Views int64
+	// Events is the number of deleted custom event properties.
+	Events int64
+}
+
+// RetentionJob periodically deletes analytics data older than the retention
+// period of each website. The tenant retention period applies unless the
+// website overrides it, where a period of 0 days keeps data forever.
+type RetentionJob struct {
+	db          *sqlite.Client
+	analyticsDB *duckdb.Client
+	interval    time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRetentionJob returns a new retention job running on the given interval.
+func NewRetentionJob(
+	db *sqlite.Client,
+	analyticsDB *duckdb.Client,
+	interval time.Duration,
+) *RetentionJob {
+	if interval <= 0 {
+		interval = DefaultRetentionInterval
+	}
+
+	return &RetentionJob{
+		db:          db,
+		analyticsDB: analyticsDB,
+		interval:    interval,
+	}
+}
+
+// Start runs the job once and then on every interval in the background until
+// the job is closed or the context is cancelled.
+func (r *RetentionJob) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+
+	r.wg.Add(1)
+
+	go func() {
+		defer r.wg.Done()
+
+		log := logger.Get()
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			_, err := r.Run(ctx, time.Now())
+			if err != nil && ctx.Err() == nil {
+				log.Error().Err(err).Msg("failed to delete expired analytics data")
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Close stops the background job and waits for a running purge to finish.
+func (r *RetentionJob) Close() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+
+	r.wg.Wait()
+}
+
+// Run deletes all data of each website older than its retention period
+// relative to now. The analytics database is compacted if any rows were
+// deleted.
+func (r *RetentionJob) Run(ctx context.Context, now time.Time) (RetentionResult, error) {
+	var result RetentionResult
+
+	log := logger.Get()
+
+	settings, err := r.db.GetTenantSettings(ctx)
+	if err != nil {
+		return result, errors.Wrap(err, "retention")
+	}
+
+	tenantDays, err := strconv.Atoi(settings.RetentionDays)
+	if err != nil {
+		return result, errors.Wrap(err, "retention: parse retention days setting")
+	}
+
+	websites, err := r.db.ListWebsiteSettings(ctx)
+	if err != nil {
+		return result, errors.Wrap(err, "retention")
+	}
+
+	for hostname, websiteSettings := range websites {
+		days := websiteSettings.Retention(tenantDays)
+		if days <= 0 {
+			continue
+		}
+
+		cutoff := now.AddDate(0, 0, -days)
+
+		views, events, err := r.analyticsDB.DeleteWebsiteDataBefore(ctx, hostname, cutoff)
+		if err != nil {
+			return result, errors.Wrap(err, "retention: "+hostname)
+		}
+
+		if views > 0 || events > 0 {
+			log.Debug().
+				Str("hostname", hostname).
+				Int("retention_days", days).
+				Int64("views", views).
+				Int64("events", events).
+				Msg("deleted expired analytics data")
+		}
+
+		result.Views += views
+		result.Events += events
+	}
+
+	if result.Views == 0 && result.Events == 0 {
+		return result, nil
+	}
+
+	err = r.analyticsDB.Compact(ctx)
+	if err != nil {
+		return result, errors.Wrap(err, "retention: compact")
+	}
+
+	log.Info().
+		Int64("views", result.Views).
+		Int64("events", result.Events).
+		Msg("deleted expired analytics data")
+
+	return result, nil
+}
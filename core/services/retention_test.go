@@ -0,0 +1,111 @@
+package services_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/db"
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetentionJob(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient := metest.NewTestDatabases(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	hostnames := []string{"tenant.example.com", "override.example.com", "forever.example.com"}
+	for _, hostname := range hostnames {
+		require.NoError(sqliteClient.CreateWebsite(ctx, model.NewWebsite(user.ID, hostname, 1, 1)))
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	var views []model.ImportedPageView
+	for _, hostname := range hostnames {
+		for _, daysAgo := range []int{1, 20, 100} {
+			views = append(views, model.ImportedPageView{
+				PageViewHit: model.PageViewHit{
+					BID:      hostname + "_" + strconv.Itoa(daysAgo),
+					Hostname: hostname,
+					Pathname: "/",
+				},
+				DateCreated: now.AddDate(0, 0, -daysAgo),
+			})
+		}
+	}
+
+	require.NoError(duckdbClient.InsertImportedViews(ctx, "import_retention", views))
+	require.NoError(duckdbClient.InsertImportedEvents(ctx, "import_retention", []model.ImportedEvent{{
+		EventHit: model.EventHit{
+			BatchID: "batch",
+			Group:   "tenant.example.com",
+			Name:    "event",
+			Value:   "signup",
+		},
+		DateCreated: now.AddDate(0, 0, -100),
+	}}))
+
+	job := services.NewRetentionJob(sqliteClient, duckdbClient, time.Hour)
+
+	// Data is kept forever by default.
+	result, err := job.Run(ctx, now)
+	require.NoError(err)
+	assert.Zero(result.Views)
+	assert.Zero(result.Events)
+
+	retentionDays := "30"
+	require.NoError(sqliteClient.UpdateTenantSettings(ctx, &db.UpdateTenantSettings{
+		RetentionDays: &retentionDays,
+	}))
+
+	override, forever := 10, 0
+	require.NoError(sqliteClient.UpdateWebsiteSettings(ctx, "override.example.com", &model.WebsiteSettings{
+		RetentionDays: &override,
+	}))
+	require.NoError(sqliteClient.UpdateWebsiteSettings(ctx, "forever.example.com", &model.WebsiteSettings{
+		RetentionDays: &forever,
+	}))
+
+	result, err = job.Run(ctx, now)
+	require.NoError(err)
+	assert.Equal(int64(3), result.Views)
+	assert.Equal(int64(1), result.Events)
+
+	remaining := make(map[string]int)
+
+	rows, err := duckdbClient.QueryxContext(ctx, `--sql
+		SELECT hostname, COUNT(*) FROM views GROUP BY hostname`)
+	require.NoError(err)
+
+	for rows.Next() {
+		var (
+			hostname string
+			count    int
+		)
+
+		require.NoError(rows.Scan(&hostname, &count))
+		remaining[hostname] = count
+	}
+
+	require.NoError(rows.Err())
+	require.NoError(rows.Close())
+
+	assert.Equal(map[string]int{
+		"tenant.example.com":   2,
+		"override.example.com": 1,
+		"forever.example.com":  3,
+	}, remaining)
+
+	// Running again has nothing left to delete.
+	result, err = job.Run(ctx, now)
+	require.NoError(err)
+	assert.Zero(result.Views)
+}
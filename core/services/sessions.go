@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+)
+
+func (h *Handler) GetUserSessions(
+	ctx context.Context,
+	_params api.GetUserSessionsParams,
+) (api.GetUserSessionsRes, error) {
+	// Get user id from request context and check if user exists
+	userID, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	sessionID, _ := ctx.Value(model.ContextKeySessionID).(string)
+
+	sessions, err := h.auth.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	resp := make([]api.UserSession, 0, len(sessions))
+	for _, session := range sessions {
+		resp = append(resp, api.UserSession{
+			UserAgent:   session.UserAgent,
+			Current:     session.ID == sessionID,
+			DateCreated: session.DateCreated,
+			DateExpires: session.DateExpires,
+		})
+	}
+
+	return &api.GetUserSessionsOKHeaders{
+		Response: resp,
+	}, nil
+}
+
+func (h *Handler) DeleteUserSessions(
+	ctx context.Context,
+	_params api.DeleteUserSessionsParams,
+) (api.DeleteUserSessionsRes, error) {
+	log := logger.Get()
+	if h.auth.IsDemoMode {
+		log.Debug().Msg("delete user sessions rejected in demo mode")
+		return ErrForbidden(model.ErrDemoMode), nil
+	}
+
+	// Get user id from request context and check if user exists
+	userID, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	err := h.auth.RevokeUserSessions(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	log.Info().Str("user_id", userID).Msg("signed out all sessions")
+
+	return &api.DeleteUserSessionsNoContent{
+		SetCookie: expiredSessionCookie().String(),
+	}, nil
+}
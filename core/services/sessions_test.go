@@ -0,0 +1,137 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/db/sqlite"
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/middlewares"
+	"github.com/medama-io/medama/services"
+	"github.com/medama-io/medama/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSessionServer(
+	t *testing.T,
+	ctx context.Context,
+	sqliteClient *sqlite.Client,
+	duckdbClient *duckdb.Client,
+	key []byte,
+) http.Handler {
+	t.Helper()
+
+	auth, err := util.NewAuthService(ctx, false, util.WithSessionStore(sqliteClient, key))
+	require.NoError(t, err)
+
+	ingester := duckdb.NewIngester(duckdbClient, duckdb.IngestConfig{})
+	t.Cleanup(func() {
+		ingester.Close(context.Background()) //nolint:errcheck // Test cleanup.
+	})
+
+	handler, err := services.NewService(ctx, auth, sqliteClient, duckdbClient, ingester, "test-commit")
+	require.NoError(t, err)
+
+	server, err := api.NewServer(handler,
+		middlewares.NewAuthHandler(auth, sqliteClient),
+		api.WithErrorHandler(middlewares.ErrorHandler),
+	)
+	require.NoError(t, err)
+
+	return server
+}
+
+func TestPersistentSessions(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient := metest.NewTestDatabases(t)
+
+	key, err := util.GenerateCipherKey()
+	require.NoError(err)
+
+	server := newSessionServer(t, ctx, sqliteClient, duckdbClient, key)
+
+	do := func(server http.Handler, method string, path string, body string, cookie string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		req.Header.Set("User-Agent", "Firefox")
+
+		if cookie != "" {
+			req.Header.Set("Cookie", cookie)
+		}
+
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	login := func() string {
+		rec := do(server, http.MethodPost, "/auth/login",
+			`{"username":"admin","password":"CHANGE_ME_ON_FIRST_LOGIN"}`, "")
+		require.Equal(http.StatusOK, rec.Code)
+
+		cookie, _, _ := strings.Cut(rec.Header().Get("Set-Cookie"), ";")
+
+		return cookie
+	}
+
+	first := login()
+	second := login()
+
+	rec := do(server, http.MethodGet, "/user/sessions", "", first)
+	require.Equal(http.StatusOK, rec.Code)
+
+	var sessions []api.UserSession
+	require.NoError(json.Unmarshal(rec.Body.Bytes(), &sessions))
+	require.Len(sessions, 2)
+
+	current := 0
+	for _, session := range sessions {
+		assert.Equal("Firefox", session.UserAgent)
+
+		if session.Current {
+			current++
+		}
+	}
+
+	assert.Equal(1, current)
+
+	// Sessions survive a restart with the same key.
+	server = newSessionServer(t, ctx, sqliteClient, duckdbClient, key)
+
+	rec = do(server, http.MethodGet, "/user", "", first)
+	assert.Equal(http.StatusOK, rec.Code)
+
+	// Logging out revokes the session.
+	rec = do(server, http.MethodPost, "/auth/logout", "", first)
+	assert.Equal(http.StatusNoContent, rec.Code)
+
+	rec = do(server, http.MethodGet, "/user", "", first)
+	assert.Equal(http.StatusUnauthorized, rec.Code)
+
+	rec = do(server, http.MethodGet, "/user", "", second)
+	assert.Equal(http.StatusOK, rec.Code)
+
+	// Signing out all sessions revokes every session of the user.
+	third := login()
+
+	rec = do(server, http.MethodDelete, "/user/sessions", "", third)
+	assert.Equal(http.StatusNoContent, rec.Code)
+	assert.Contains(rec.Header().Get("Set-Cookie"), "Expires=")
+
+	for _, cookie := range []string{second, third} {
+		rec = do(server, http.MethodGet, "/user", "", cookie)
+		assert.Equal(http.StatusUnauthorized, rec.Code)
+	}
+}
@@ -41,6 +41,21 @@ func (h *Handler) PatchTenantSettings(
 		return ErrForbidden(model.ErrDemoMode), nil
 	}
 
+	// Tenant settings apply to all websites, so only admins can change them.
+	admin, err := h.isAdmin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if !admin {
+		return ErrForbidden(model.ErrUserForbidden), nil
+	}
+
+	previous, err := h.db.GetTenantSettings(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get tenant settings")
+	}
+
 	// Convert tenant settings from request to model format
 	modifiedSettings := &db.UpdateTenantSettings{}
 
@@ -69,8 +84,13 @@ func (h *Handler) PatchTenantSettings(
 		modifiedSettings.BlockedIPs = &blockedIPs
 	}
 
+	if v, ok := req.RetentionDays.Get(); ok {
+		retentionDays := strconv.Itoa(v)
+		modifiedSettings.RetentionDays = &retentionDays
+	}
+
 	// Update tenant settings in database
-	err := h.db.UpdateTenantSettings(ctx, modifiedSettings)
+	err = h.db.UpdateTenantSettings(ctx, modifiedSettings)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to update tenant settings")
 	}
@@ -87,6 +107,17 @@ func (h *Handler) PatchTenantSettings(
 		return nil, errors.Wrap(err, "update system settings: update runtime config")
 	}
 
+	changes := auditChanges{}
+	changes.add("script_type", previous.ScriptType, settings.ScriptType)
+	changes.add("block_abusive_ips", previous.BlockAbusiveIPs, settings.BlockAbusiveIPs)
+	changes.add("block_tor_exit_nodes", previous.BlockTorExitNodes, settings.BlockTorExitNodes)
+	changes.add("blocked_ips", previous.BlockedIPs, settings.BlockedIPs)
+	changes.add("retention_days", previous.RetentionDays, settings.RetentionDays)
+
+	if len(changes) > 0 {
+		h.audit(ctx, model.AuditSettingsUpdate, model.AuditTargetTenant, changes)
+	}
+
 	// Build tenant settings response
 	response, err := buildTenantSettingsResponse(settings)
 	if err != nil {
@@ -122,12 +153,18 @@ func buildTenantSettingsResponse(
 		return nil, errors.Wrap(err, "failed to parse block Tor exit nodes setting")
 	}
 
+	retentionDays, err := strconv.Atoi(settings.RetentionDays)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse retention days setting")
+	}
+
 	return &api.TenantSettingsHeaders{
 		Response: api.TenantSettings{
 			ScriptType:        scriptFeatures,
 			BlockAbusiveIPs:   api.NewOptBool(blockAbusiveIPs),
 			BlockTorExitNodes: api.NewOptBool(blockTorExitNodes),
 			BlockedIPs:        blockedIPs,
+			RetentionDays:     api.NewOptInt(retentionDays),
 		},
 	}, nil
 }
@@ -1,12 +1,14 @@
 package services_test
 
 import (
+	"context"
 	"net/netip"
 	"testing"
 
 	"github.com/medama-io/medama/api"
 	"github.com/medama-io/medama/db"
 	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/model"
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,10 +28,16 @@ func TestGetTenantSettings(t *testing.T) {
 	assert.Equal(api.NewOptBool(true), settings.Response.BlockAbusiveIPs)
 	assert.Equal(api.NewOptBool(true), settings.Response.BlockTorExitNodes)
 	assert.Empty(settings.Response.BlockedIPs)
+	assert.Equal(api.NewOptInt(0), settings.Response.RetentionDays)
 }
 
 func TestPatchTenantSettings(t *testing.T) {
-	assert, ctx, handler, _ := metest.NewTestHandler(t)
+	assert, ctx, handler, sqliteClient := metest.NewTestHandler(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(t, err)
+
+	ctx = context.WithValue(ctx, model.ContextKeyUserID, user.ID)
 
 	req := &api.TenantSettings{
 		ScriptType: []api.TenantSettingsScriptTypeItem{
@@ -39,6 +47,7 @@ func TestPatchTenantSettings(t *testing.T) {
 		BlockAbusiveIPs:   api.NewOptBool(false),
 		BlockTorExitNodes: api.NewOptBool(false),
 		BlockedIPs:        []netip.Addr{netip.MustParseAddr("10.0.0.1")},
+		RetentionDays:     api.NewOptInt(365),
 	}
 
 	resp, err := handler.PatchTenantSettings(ctx, req, api.PatchTenantSettingsParams{})
@@ -57,16 +66,23 @@ func TestPatchTenantSettings(t *testing.T) {
 	assert.Equal(api.NewOptBool(false), settings.Response.BlockAbusiveIPs)
 	assert.Equal(api.NewOptBool(false), settings.Response.BlockTorExitNodes)
 	assert.Equal([]netip.Addr{netip.MustParseAddr("10.0.0.1")}, settings.Response.BlockedIPs)
+	assert.Equal(api.NewOptInt(365), settings.Response.RetentionDays)
 }
 
 func TestPatchTenantSettingsPartial(t *testing.T) {
 	assert, ctx, handler, sqliteClient := metest.NewTestHandler(t)
 
-	err := sqliteClient.UpdateTenantSettings(ctx, &db.UpdateTenantSettings{
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(t, err)
+
+	ctx = context.WithValue(ctx, model.ContextKeyUserID, user.ID)
+
+	err = sqliteClient.UpdateTenantSettings(ctx, &db.UpdateTenantSettings{
 		ScriptType:        ptr("click-events"),
 		BlockAbusiveIPs:   ptr("false"),
 		BlockTorExitNodes: ptr("false"),
 		BlockedIPs:        ptr("10.0.0.1"),
+		RetentionDays:     ptr("30"),
 	})
 	require.NoError(t, err)
 
@@ -89,6 +105,7 @@ func TestPatchTenantSettingsPartial(t *testing.T) {
 	assert.Equal(api.NewOptBool(false), settings.Response.BlockAbusiveIPs)
 	assert.Equal(api.NewOptBool(false), settings.Response.BlockTorExitNodes)
 	assert.Equal([]netip.Addr{netip.MustParseAddr("10.0.0.1")}, settings.Response.BlockedIPs)
+	assert.Equal(api.NewOptInt(30), settings.Response.RetentionDays)
 }
 
 func ptr(s string) *string {
@@ -17,7 +17,12 @@ func (h *Handler) GetWebsiteIDSummary(
 	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
 
 	// Check if website exists
-	exists := h.hostnames.Has(params.Hostname)
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
 	if !exists {
 		log.Debug().Msg("website not found")
 		return ErrNotFound(model.ErrWebsiteNotFound), nil
@@ -82,15 +87,37 @@ func (h *Handler) GetWebsiteIDSummary(
 			return ErrInternalServerError(err), nil
 		}
 
+		// Flag the intervals that deviate from the same intervals in previous
+		// weeks if requested.
+		var anomalies [][]model.StatsAnomaly
+		if params.Anomalies.Value {
+			anomalies, err = intervalAnomalies(ctx, h.analyticsDB, filters, params.Interval.Value, interval)
+			if err != nil {
+				log.Error().Err(err).Msg("failed to get website interval anomalies")
+
+				if errors.Is(err, model.ErrInvalidParameter) || errors.Is(err, model.ErrInvalidAnomalyInterval) {
+					return ErrBadRequest(err), nil
+				}
+
+				return ErrInternalServerError(err), nil
+			}
+		}
+
 		resp.Interval = make([]api.StatsSummaryIntervalItem, 0, len(interval))
-		for _, i := range interval {
-			resp.Interval = append(resp.Interval, api.StatsSummaryIntervalItem{
+		for idx, i := range interval {
+			item := api.StatsSummaryIntervalItem{
 				Date:             i.Interval,
 				Visitors:         api.NewOptInt(i.Visitors),
 				Pageviews:        api.NewOptInt(i.Pageviews),
 				BouncePercentage: api.NewOptFloat32(i.BounceRate),
 				Duration:         api.NewOptInt(i.Duration),
-			})
+			}
+
+			if anomalies != nil {
+				item.Anomalies = anomaliesToAPI(anomalies[idx])
+			}
+
+			resp.Interval = append(resp.Interval, item)
 		}
 	}
 
@@ -106,7 +133,12 @@ func (h *Handler) GetWebsiteIDPages(
 	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
 
 	// Check if website exists.
-	exists := h.hostnames.Has(params.Hostname)
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
 	if !exists {
 		log.Debug().Msg("website not found")
 		return ErrNotFound(model.ErrWebsiteNotFound), nil
@@ -115,6 +147,33 @@ func (h *Handler) GetWebsiteIDPages(
 	// Create filter for database query.
 	filters := db.CreateFilters(params, params.Hostname)
 
+	// Export the full report if requested.
+	if format, ok := params.Format.Get(); ok {
+		filters := exportFilters(filters)
+
+		data, err := exportReport(ctx, format, func(emit func(*api.StatsPagesItem) error) error {
+			return h.analyticsDB.StreamWebsitePages(
+				ctx,
+				filters,
+				func(page *model.StatsPages) error {
+					item := pagesItem(page)
+					return emit(&item)
+				},
+			)
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("failed to export website pages")
+			return ErrInternalServerError(err), nil
+		}
+
+		resp := exportResponse(params.Hostname, "pages", format, data)
+		if format == api.FormatCsv {
+			return (*api.GetWebsiteIDPagesTextCsvOK)(resp), nil
+		}
+
+		return (*api.GetWebsiteIDPagesApplicationXNdjsonOK)(resp), nil
+	}
+
 	// Check parameter if it is asking for summary.
 	if params.Summary.Value {
 		// Get summary.
@@ -155,15 +214,7 @@ func (h *Handler) GetWebsiteIDPages(
 
 	resp := make(api.StatsPages, 0, len(pages))
 	for _, page := range pages {
-		resp = append(resp, api.StatsPagesItem{
-			Path:                page.Pathname,
-			Visitors:            page.Visitors,
-			VisitorsPercentage:  page.VisitorsPercentage,
-			Pageviews:           api.NewOptInt(page.Pageviews),
-			PageviewsPercentage: api.NewOptFloat32(page.PageviewsPercentage),
-			BouncePercentage:    api.NewOptFloat32(page.BounceRate),
-			Duration:            api.NewOptInt(page.Duration),
-		})
+		resp = append(resp, pagesItem(page))
 	}
 
 	return &api.StatsPagesHeaders{
@@ -171,6 +222,18 @@ func (h *Handler) GetWebsiteIDPages(
 	}, nil
 }
 
+func pagesItem(page *model.StatsPages) api.StatsPagesItem {
+	return api.StatsPagesItem{
+		Path:                page.Pathname,
+		Visitors:            page.Visitors,
+		VisitorsPercentage:  page.VisitorsPercentage,
+		Pageviews:           api.NewOptInt(page.Pageviews),
+		PageviewsPercentage: api.NewOptFloat32(page.PageviewsPercentage),
+		BouncePercentage:    api.NewOptFloat32(page.BounceRate),
+		Duration:            api.NewOptInt(page.Duration),
+	}
+}
+
 func (h *Handler) GetWebsiteIDTime(
 	ctx context.Context,
 	params api.GetWebsiteIDTimeParams,
@@ -178,7 +241,12 @@ func (h *Handler) GetWebsiteIDTime(
 	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
 
 	// Check if website exists
-	exists := h.hostnames.Has(params.Hostname)
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
 	if !exists {
 		log.Debug().Msg("website not found")
 		return ErrNotFound(model.ErrWebsiteNotFound), nil
@@ -187,6 +255,29 @@ func (h *Handler) GetWebsiteIDTime(
 	// Create filter for database query
 	filters := db.CreateFilters(params, params.Hostname)
 
+	// Export the full report if requested.
+	if format, ok := params.Format.Get(); ok {
+		filters := exportFilters(filters)
+
+		data, err := exportReport(ctx, format, func(emit func(*api.StatsTimeItem) error) error {
+			return h.analyticsDB.StreamWebsiteTime(ctx, filters, func(page *model.StatsTime) error {
+				item := timeItem(page)
+				return emit(&item)
+			})
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("failed to export website time")
+			return ErrInternalServerError(err), nil
+		}
+
+		resp := exportResponse(params.Hostname, "time", format, data)
+		if format == api.FormatCsv {
+			return (*api.GetWebsiteIDTimeTextCsvOK)(resp), nil
+		}
+
+		return (*api.GetWebsiteIDTimeApplicationXNdjsonOK)(resp), nil
+	}
+
 	// Check parameter if it is asking for summary
 	if params.Summary.Value {
 		// Get summary
@@ -227,14 +318,7 @@ func (h *Handler) GetWebsiteIDTime(
 
 	resp := make(api.StatsTime, 0, len(times))
 	for _, page := range times {
-		resp = append(resp, api.StatsTimeItem{
-			Path:                  page.Pathname,
-			Duration:              page.Duration,
-			DurationPercentage:    page.DurationPercentage,
-			DurationUpperQuartile: api.NewOptInt(page.DurationUpperQuartile),
-			DurationLowerQuartile: api.NewOptInt(page.DurationLowerQuartile),
-			Visitors:              api.NewOptInt(page.Visitors),
-		})
+		resp = append(resp, timeItem(page))
 	}
 
 	return &api.StatsTimeHeaders{
@@ -242,6 +326,17 @@ func (h *Handler) GetWebsiteIDTime(
 	}, nil
 }
 
+func timeItem(page *model.StatsTime) api.StatsTimeItem {
+	return api.StatsTimeItem{
+		Path:                  page.Pathname,
+		Duration:              page.Duration,
+		DurationPercentage:    page.DurationPercentage,
+		DurationUpperQuartile: api.NewOptInt(page.DurationUpperQuartile),
+		DurationLowerQuartile: api.NewOptInt(page.DurationLowerQuartile),
+		Visitors:              api.NewOptInt(page.Visitors),
+	}
+}
+
 func (h *Handler) GetWebsiteIDReferrers(
 	ctx context.Context,
 	params api.GetWebsiteIDReferrersParams,
@@ -249,7 +344,12 @@ func (h *Handler) GetWebsiteIDReferrers(
 	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
 
 	// Check if website exists
-	exists := h.hostnames.Has(params.Hostname)
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
 	if !exists {
 		log.Debug().Msg("website not found")
 		return ErrNotFound(model.ErrWebsiteNotFound), nil
@@ -258,6 +358,34 @@ func (h *Handler) GetWebsiteIDReferrers(
 	// Create filter for database query
 	filters := db.CreateFilters(params, params.Hostname)
 
+	// Export the full report if requested.
+	if format, ok := params.Format.Get(); ok {
+		filters := exportFilters(filters)
+
+		data, err := exportReport(ctx, format, func(emit func(*api.StatsReferrersItem) error) error {
+			return h.analyticsDB.StreamWebsiteReferrers(
+				ctx,
+				params.Grouped.Value,
+				filters,
+				func(page *model.StatsReferrers) error {
+					item := referrersItem(page)
+					return emit(&item)
+				},
+			)
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("failed to export website referrers")
+			return ErrInternalServerError(err), nil
+		}
+
+		resp := exportResponse(params.Hostname, "referrers", format, data)
+		if format == api.FormatCsv {
+			return (*api.GetWebsiteIDReferrersTextCsvOK)(resp), nil
+		}
+
+		return (*api.GetWebsiteIDReferrersApplicationXNdjsonOK)(resp), nil
+	}
+
 	// Check parameter if it is asking for summary
 	if params.Summary.Value {
 		// Get summary
@@ -302,13 +430,7 @@ func (h *Handler) GetWebsiteIDReferrers(
 
 	resp := make(api.StatsReferrers, 0, len(referrers))
 	for _, page := range referrers {
-		resp = append(resp, api.StatsReferrersItem{
-			Referrer:           page.Referrer,
-			Visitors:           page.Visitors,
-			VisitorsPercentage: page.VisitorsPercentage,
-			BouncePercentage:   api.NewOptFloat32(page.BounceRate),
-			Duration:           api.NewOptInt(page.Duration),
-		})
+		resp = append(resp, referrersItem(page))
 	}
 
 	return &api.StatsReferrersHeaders{
@@ -316,6 +438,16 @@ func (h *Handler) GetWebsiteIDReferrers(
 	}, nil
 }
 
+func referrersItem(page *model.StatsReferrers) api.StatsReferrersItem {
+	return api.StatsReferrersItem{
+		Referrer:           page.Referrer,
+		Visitors:           page.Visitors,
+		VisitorsPercentage: page.VisitorsPercentage,
+		BouncePercentage:   api.NewOptFloat32(page.BounceRate),
+		Duration:           api.NewOptInt(page.Duration),
+	}
+}
+
 func (h *Handler) GetWebsiteIDSources(
 	ctx context.Context,
 	params api.GetWebsiteIDSourcesParams,
@@ -323,7 +455,12 @@ func (h *Handler) GetWebsiteIDSources(
 	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
 
 	// Check if website exists
-	exists := h.hostnames.Has(params.Hostname)
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
 	if !exists {
 		log.Debug().Msg("website not found")
 		return ErrNotFound(model.ErrWebsiteNotFound), nil
@@ -332,6 +469,33 @@ func (h *Handler) GetWebsiteIDSources(
 	// Create filter for database query
 	filters := db.CreateFilters(params, params.Hostname)
 
+	// Export the full report if requested.
+	if format, ok := params.Format.Get(); ok {
+		filters := exportFilters(filters)
+
+		data, err := exportReport(ctx, format, func(emit func(*api.StatsUTMSourcesItem) error) error {
+			return h.analyticsDB.StreamWebsiteUTMSources(
+				ctx,
+				filters,
+				func(page *model.StatsUTMSources) error {
+					item := sourcesItem(page)
+					return emit(&item)
+				},
+			)
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("failed to export website utm sources")
+			return ErrInternalServerError(err), nil
+		}
+
+		resp := exportResponse(params.Hostname, "sources", format, data)
+		if format == api.FormatCsv {
+			return (*api.GetWebsiteIDSourcesTextCsvOK)(resp), nil
+		}
+
+		return (*api.GetWebsiteIDSourcesApplicationXNdjsonOK)(resp), nil
+	}
+
 	if params.Summary.Value {
 		// Get summary
 		sources, err := h.analyticsDB.GetWebsiteUTMSourcesSummary(ctx, filters)
@@ -367,13 +531,7 @@ func (h *Handler) GetWebsiteIDSources(
 
 	resp := make(api.StatsUTMSources, 0, len(sources))
 	for _, page := range sources {
-		resp = append(resp, api.StatsUTMSourcesItem{
-			Source:             page.Source,
-			Visitors:           page.Visitors,
-			VisitorsPercentage: page.VisitorsPercentage,
-			BouncePercentage:   api.NewOptFloat32(page.BounceRate),
-			Duration:           api.NewOptInt(page.Duration),
-		})
+		resp = append(resp, sourcesItem(page))
 	}
 
 	return &api.StatsUTMSourcesHeaders{
@@ -381,6 +539,16 @@ func (h *Handler) GetWebsiteIDSources(
 	}, nil
 }
 
+func sourcesItem(page *model.StatsUTMSources) api.StatsUTMSourcesItem {
+	return api.StatsUTMSourcesItem{
+		Source:             page.Source,
+		Visitors:           page.Visitors,
+		VisitorsPercentage: page.VisitorsPercentage,
+		BouncePercentage:   api.NewOptFloat32(page.BounceRate),
+		Duration:           api.NewOptInt(page.Duration),
+	}
+}
+
 func (h *Handler) GetWebsiteIDMediums(
 	ctx context.Context,
 	params api.GetWebsiteIDMediumsParams,
@@ -388,7 +556,12 @@ func (h *Handler) GetWebsiteIDMediums(
 	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
 
 	// Check if website exists
-	exists := h.hostnames.Has(params.Hostname)
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
 	if !exists {
 		log.Debug().Msg("website not found")
 		return ErrNotFound(model.ErrWebsiteNotFound), nil
@@ -397,6 +570,33 @@ func (h *Handler) GetWebsiteIDMediums(
 	// Create filter for database query
 	filters := db.CreateFilters(params, params.Hostname)
 
+	// Export the full report if requested.
+	if format, ok := params.Format.Get(); ok {
+		filters := exportFilters(filters)
+
+		data, err := exportReport(ctx, format, func(emit func(*api.StatsUTMMediumsItem) error) error {
+			return h.analyticsDB.StreamWebsiteUTMMediums(
+				ctx,
+				filters,
+				func(page *model.StatsUTMMediums) error {
+					item := mediumsItem(page)
+					return emit(&item)
+				},
+			)
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("failed to export website utm mediums")
+			return ErrInternalServerError(err), nil
+		}
+
+		resp := exportResponse(params.Hostname, "mediums", format, data)
+		if format == api.FormatCsv {
+			return (*api.GetWebsiteIDMediumsTextCsvOK)(resp), nil
+		}
+
+		return (*api.GetWebsiteIDMediumsApplicationXNdjsonOK)(resp), nil
+	}
+
 	if params.Summary.Value {
 		// Get summary
 		mediums, err := h.analyticsDB.GetWebsiteUTMMediumsSummary(ctx, filters)
@@ -436,13 +636,7 @@ func (h *Handler) GetWebsiteIDMediums(
 
 	resp := make(api.StatsUTMMediums, 0, len(mediums))
 	for _, page := range mediums {
-		resp = append(resp, api.StatsUTMMediumsItem{
-			Medium:             page.Medium,
-			Visitors:           page.Visitors,
-			VisitorsPercentage: page.VisitorsPercentage,
-			BouncePercentage:   api.NewOptFloat32(page.BounceRate),
-			Duration:           api.NewOptInt(page.Duration),
-		})
+		resp = append(resp, mediumsItem(page))
 	}
 
 	return &api.StatsUTMMediumsHeaders{
@@ -450,6 +644,16 @@ func (h *Handler) GetWebsiteIDMediums(
 	}, nil
 }
 
+func mediumsItem(page *model.StatsUTMMediums) api.StatsUTMMediumsItem {
+	return api.StatsUTMMediumsItem{
+		Medium:             page.Medium,
+		Visitors:           page.Visitors,
+		VisitorsPercentage: page.VisitorsPercentage,
+		BouncePercentage:   api.NewOptFloat32(page.BounceRate),
+		Duration:           api.NewOptInt(page.Duration),
+	}
+}
+
 func (h *Handler) GetWebsiteIDCampaigns(
 	ctx context.Context,
 	params api.GetWebsiteIDCampaignsParams,
@@ -457,7 +661,12 @@ func (h *Handler) GetWebsiteIDCampaigns(
 	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
 
 	// Check if website exists
-	exists := h.hostnames.Has(params.Hostname)
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
 	if !exists {
 		log.Debug().Msg("website not found")
 		return ErrNotFound(model.ErrWebsiteNotFound), nil
@@ -466,6 +675,33 @@ func (h *Handler) GetWebsiteIDCampaigns(
 	// Create filter for database query
 	filters := db.CreateFilters(params, params.Hostname)
 
+	// Export the full report if requested.
+	if format, ok := params.Format.Get(); ok {
+		filters := exportFilters(filters)
+
+		data, err := exportReport(ctx, format, func(emit func(*api.StatsUTMCampaignsItem) error) error {
+			return h.analyticsDB.StreamWebsiteUTMCampaigns(
+				ctx,
+				filters,
+				func(page *model.StatsUTMCampaigns) error {
+					item := campaignsItem(page)
+					return emit(&item)
+				},
+			)
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("failed to export website utm campaigns")
+			return ErrInternalServerError(err), nil
+		}
+
+		resp := exportResponse(params.Hostname, "campaigns", format, data)
+		if format == api.FormatCsv {
+			return (*api.GetWebsiteIDCampaignsTextCsvOK)(resp), nil
+		}
+
+		return (*api.GetWebsiteIDCampaignsApplicationXNdjsonOK)(resp), nil
+	}
+
 	if params.Summary.Value {
 		// Get summary
 		campaigns, err := h.analyticsDB.GetWebsiteUTMCampaignsSummary(ctx, filters)
@@ -505,13 +741,7 @@ func (h *Handler) GetWebsiteIDCampaigns(
 
 	resp := make(api.StatsUTMCampaigns, 0, len(campaigns))
 	for _, page := range campaigns {
-		resp = append(resp, api.StatsUTMCampaignsItem{
-			Campaign:           page.Campaign,
-			Visitors:           page.Visitors,
-			VisitorsPercentage: page.VisitorsPercentage,
-			BouncePercentage:   api.NewOptFloat32(page.BounceRate),
-			Duration:           api.NewOptInt(page.Duration),
-		})
+		resp = append(resp, campaignsItem(page))
 	}
 
 	return &api.StatsUTMCampaignsHeaders{
@@ -519,6 +749,16 @@ func (h *Handler) GetWebsiteIDCampaigns(
 	}, nil
 }
 
+func campaignsItem(page *model.StatsUTMCampaigns) api.StatsUTMCampaignsItem {
+	return api.StatsUTMCampaignsItem{
+		Campaign:           page.Campaign,
+		Visitors:           page.Visitors,
+		VisitorsPercentage: page.VisitorsPercentage,
+		BouncePercentage:   api.NewOptFloat32(page.BounceRate),
+		Duration:           api.NewOptInt(page.Duration),
+	}
+}
+
 func (h *Handler) GetWebsiteIDBrowsers(
 	ctx context.Context,
 	params api.GetWebsiteIDBrowsersParams,
@@ -526,7 +766,12 @@ func (h *Handler) GetWebsiteIDBrowsers(
 	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
 
 	// Check if website exists
-	exists := h.hostnames.Has(params.Hostname)
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
 	if !exists {
 		log.Debug().Msg("website not found")
 		return ErrNotFound(model.ErrWebsiteNotFound), nil
@@ -535,6 +780,33 @@ func (h *Handler) GetWebsiteIDBrowsers(
 	// Create filter for database query
 	filters := db.CreateFilters(params, params.Hostname)
 
+	// Export the full report if requested.
+	if format, ok := params.Format.Get(); ok {
+		filters := exportFilters(filters)
+
+		data, err := exportReport(ctx, format, func(emit func(*api.StatsBrowsersItem) error) error {
+			return h.analyticsDB.StreamWebsiteBrowsers(
+				ctx,
+				filters,
+				func(page *model.StatsBrowsers) error {
+					item := browsersItem(page)
+					return emit(&item)
+				},
+			)
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("failed to export website browsers")
+			return ErrInternalServerError(err), nil
+		}
+
+		resp := exportResponse(params.Hostname, "browsers", format, data)
+		if format == api.FormatCsv {
+			return (*api.GetWebsiteIDBrowsersTextCsvOK)(resp), nil
+		}
+
+		return (*api.GetWebsiteIDBrowsersApplicationXNdjsonOK)(resp), nil
+	}
+
 	if params.Summary.Value {
 		// Get summary
 		browsers, err := h.analyticsDB.GetWebsiteBrowsersSummary(ctx, filters)
@@ -574,13 +846,7 @@ func (h *Handler) GetWebsiteIDBrowsers(
 
 	resp := make(api.StatsBrowsers, 0, len(browsers))
 	for _, page := range browsers {
-		resp = append(resp, api.StatsBrowsersItem{
-			Browser:            page.Browser,
-			Visitors:           page.Visitors,
-			VisitorsPercentage: page.VisitorsPercentage,
-			BouncePercentage:   api.NewOptFloat32(page.BounceRate),
-			Duration:           api.NewOptInt(page.Duration),
-		})
+		resp = append(resp, browsersItem(page))
 	}
 
 	return &api.StatsBrowsersHeaders{
@@ -588,6 +854,16 @@ func (h *Handler) GetWebsiteIDBrowsers(
 	}, nil
 }
 
+func browsersItem(page *model.StatsBrowsers) api.StatsBrowsersItem {
+	return api.StatsBrowsersItem{
+		Browser:            page.Browser,
+		Visitors:           page.Visitors,
+		VisitorsPercentage: page.VisitorsPercentage,
+		BouncePercentage:   api.NewOptFloat32(page.BounceRate),
+		Duration:           api.NewOptInt(page.Duration),
+	}
+}
+
 func (h *Handler) GetWebsiteIDOs(
 	ctx context.Context,
 	params api.GetWebsiteIDOsParams,
@@ -595,7 +871,12 @@ func (h *Handler) GetWebsiteIDOs(
 	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
 
 	// Check if website exists
-	exists := h.hostnames.Has(params.Hostname)
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
 	if !exists {
 		log.Debug().Msg("website not found")
 		return ErrNotFound(model.ErrWebsiteNotFound), nil
@@ -604,6 +885,29 @@ func (h *Handler) GetWebsiteIDOs(
 	// Create filter for database query
 	filters := db.CreateFilters(params, params.Hostname)
 
+	// Export the full report if requested.
+	if format, ok := params.Format.Get(); ok {
+		filters := exportFilters(filters)
+
+		data, err := exportReport(ctx, format, func(emit func(*api.StatsOSItem) error) error {
+			return h.analyticsDB.StreamWebsiteOS(ctx, filters, func(page *model.StatsOS) error {
+				item := osItem(page)
+				return emit(&item)
+			})
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("failed to export website os")
+			return ErrInternalServerError(err), nil
+		}
+
+		resp := exportResponse(params.Hostname, "os", format, data)
+		if format == api.FormatCsv {
+			return (*api.GetWebsiteIDOsTextCsvOK)(resp), nil
+		}
+
+		return (*api.GetWebsiteIDOsApplicationXNdjsonOK)(resp), nil
+	}
+
 	if params.Summary.Value {
 		// Get summary
 		os, err := h.analyticsDB.GetWebsiteOSSummary(ctx, filters)
@@ -643,13 +947,7 @@ func (h *Handler) GetWebsiteIDOs(
 
 	resp := make(api.StatsOS, 0, len(os))
 	for _, page := range os {
-		resp = append(resp, api.StatsOSItem{
-			Os:                 page.OS,
-			Visitors:           page.Visitors,
-			VisitorsPercentage: page.VisitorsPercentage,
-			BouncePercentage:   api.NewOptFloat32(page.BounceRate),
-			Duration:           api.NewOptInt(page.Duration),
-		})
+		resp = append(resp, osItem(page))
 	}
 
 	return &api.StatsOSHeaders{
@@ -657,6 +955,16 @@ func (h *Handler) GetWebsiteIDOs(
 	}, nil
 }
 
+func osItem(page *model.StatsOS) api.StatsOSItem {
+	return api.StatsOSItem{
+		Os:                 page.OS,
+		Visitors:           page.Visitors,
+		VisitorsPercentage: page.VisitorsPercentage,
+		BouncePercentage:   api.NewOptFloat32(page.BounceRate),
+		Duration:           api.NewOptInt(page.Duration),
+	}
+}
+
 func (h *Handler) GetWebsiteIDDevice(
 	ctx context.Context,
 	params api.GetWebsiteIDDeviceParams,
@@ -664,7 +972,12 @@ func (h *Handler) GetWebsiteIDDevice(
 	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
 
 	// Check if website exists
-	exists := h.hostnames.Has(params.Hostname)
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
 	if !exists {
 		log.Debug().Msg("website not found")
 		return ErrNotFound(model.ErrWebsiteNotFound), nil
@@ -673,6 +986,33 @@ func (h *Handler) GetWebsiteIDDevice(
 	// Create filter for database query
 	filters := db.CreateFilters(params, params.Hostname)
 
+	// Export the full report if requested.
+	if format, ok := params.Format.Get(); ok {
+		filters := exportFilters(filters)
+
+		data, err := exportReport(ctx, format, func(emit func(*api.StatsDevicesItem) error) error {
+			return h.analyticsDB.StreamWebsiteDevices(
+				ctx,
+				filters,
+				func(page *model.StatsDevices) error {
+					item := devicesItem(page)
+					return emit(&item)
+				},
+			)
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("failed to export website devices")
+			return ErrInternalServerError(err), nil
+		}
+
+		resp := exportResponse(params.Hostname, "devices", format, data)
+		if format == api.FormatCsv {
+			return (*api.GetWebsiteIDDeviceTextCsvOK)(resp), nil
+		}
+
+		return (*api.GetWebsiteIDDeviceApplicationXNdjsonOK)(resp), nil
+	}
+
 	if params.Summary.Value {
 		// Get summary
 		devices, err := h.analyticsDB.GetWebsiteDevicesSummary(ctx, filters)
@@ -712,13 +1052,7 @@ func (h *Handler) GetWebsiteIDDevice(
 
 	resp := make(api.StatsDevices, 0, len(devices))
 	for _, page := range devices {
-		resp = append(resp, api.StatsDevicesItem{
-			Device:             page.Device,
-			Visitors:           page.Visitors,
-			VisitorsPercentage: page.VisitorsPercentage,
-			BouncePercentage:   api.NewOptFloat32(page.BounceRate),
-			Duration:           api.NewOptInt(page.Duration),
-		})
+		resp = append(resp, devicesItem(page))
 	}
 
 	return &api.StatsDevicesHeaders{
@@ -726,6 +1060,16 @@ func (h *Handler) GetWebsiteIDDevice(
 	}, nil
 }
 
+func devicesItem(page *model.StatsDevices) api.StatsDevicesItem {
+	return api.StatsDevicesItem{
+		Device:             page.Device,
+		Visitors:           page.Visitors,
+		VisitorsPercentage: page.VisitorsPercentage,
+		BouncePercentage:   api.NewOptFloat32(page.BounceRate),
+		Duration:           api.NewOptInt(page.Duration),
+	}
+}
+
 func (h *Handler) GetWebsiteIDLanguage(
 	ctx context.Context,
 	params api.GetWebsiteIDLanguageParams,
@@ -733,7 +1077,12 @@ func (h *Handler) GetWebsiteIDLanguage(
 	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
 
 	// Check if website exists
-	exists := h.hostnames.Has(params.Hostname)
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
 	if !exists {
 		log.Debug().Msg("website not found")
 		return ErrNotFound(model.ErrWebsiteNotFound), nil
@@ -742,6 +1091,34 @@ func (h *Handler) GetWebsiteIDLanguage(
 	// Create filter for database query
 	filters := db.CreateFilters(params, params.Hostname)
 
+	// Export the full report if requested.
+	if format, ok := params.Format.Get(); ok {
+		filters := exportFilters(filters)
+
+		data, err := exportReport(ctx, format, func(emit func(*api.StatsLanguagesItem) error) error {
+			return h.analyticsDB.StreamWebsiteLanguages(
+				ctx,
+				params.Locale.Value,
+				filters,
+				func(page *model.StatsLanguages) error {
+					item := languagesItem(page)
+					return emit(&item)
+				},
+			)
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("failed to export website languages")
+			return ErrInternalServerError(err), nil
+		}
+
+		resp := exportResponse(params.Hostname, "languages", format, data)
+		if format == api.FormatCsv {
+			return (*api.GetWebsiteIDLanguageTextCsvOK)(resp), nil
+		}
+
+		return (*api.GetWebsiteIDLanguageApplicationXNdjsonOK)(resp), nil
+	}
+
 	if params.Summary.Value {
 		// Get summary
 		languages, err := h.analyticsDB.GetWebsiteLanguagesSummary(
@@ -785,13 +1162,7 @@ func (h *Handler) GetWebsiteIDLanguage(
 
 	resp := make(api.StatsLanguages, 0, len(languages))
 	for _, page := range languages {
-		resp = append(resp, api.StatsLanguagesItem{
-			Language:           page.Language,
-			Visitors:           page.Visitors,
-			VisitorsPercentage: page.VisitorsPercentage,
-			BouncePercentage:   api.NewOptFloat32(page.BounceRate),
-			Duration:           api.NewOptInt(page.Duration),
-		})
+		resp = append(resp, languagesItem(page))
 	}
 
 	return &api.StatsLanguagesHeaders{
@@ -799,6 +1170,16 @@ func (h *Handler) GetWebsiteIDLanguage(
 	}, nil
 }
 
+func languagesItem(page *model.StatsLanguages) api.StatsLanguagesItem {
+	return api.StatsLanguagesItem{
+		Language:           page.Language,
+		Visitors:           page.Visitors,
+		VisitorsPercentage: page.VisitorsPercentage,
+		BouncePercentage:   api.NewOptFloat32(page.BounceRate),
+		Duration:           api.NewOptInt(page.Duration),
+	}
+}
+
 func (h *Handler) GetWebsiteIDCountry(
 	ctx context.Context,
 	params api.GetWebsiteIDCountryParams,
@@ -806,7 +1187,12 @@ func (h *Handler) GetWebsiteIDCountry(
 	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
 
 	// Check if website exists
-	exists := h.hostnames.Has(params.Hostname)
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
 	if !exists {
 		log.Debug().Msg("website not found")
 		return ErrNotFound(model.ErrWebsiteNotFound), nil
@@ -815,6 +1201,33 @@ func (h *Handler) GetWebsiteIDCountry(
 	// Create filter for database query
 	filters := db.CreateFilters(params, params.Hostname)
 
+	// Export the full report if requested.
+	if format, ok := params.Format.Get(); ok {
+		filters := exportFilters(filters)
+
+		data, err := exportReport(ctx, format, func(emit func(*api.StatsCountriesItem) error) error {
+			return h.analyticsDB.StreamWebsiteCountries(
+				ctx,
+				filters,
+				func(page *model.StatsCountries) error {
+					item := countriesItem(page)
+					return emit(&item)
+				},
+			)
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("failed to export website countries")
+			return ErrInternalServerError(err), nil
+		}
+
+		resp := exportResponse(params.Hostname, "countries", format, data)
+		if format == api.FormatCsv {
+			return (*api.GetWebsiteIDCountryTextCsvOK)(resp), nil
+		}
+
+		return (*api.GetWebsiteIDCountryApplicationXNdjsonOK)(resp), nil
+	}
+
 	if params.Summary.Value {
 		// Get summary
 		countries, err := h.analyticsDB.GetWebsiteCountriesSummary(ctx, filters)
@@ -854,16 +1267,125 @@ func (h *Handler) GetWebsiteIDCountry(
 
 	resp := make(api.StatsCountries, 0, len(countries))
 	for _, page := range countries {
-		resp = append(resp, api.StatsCountriesItem{
-			Country:            page.Country,
-			Visitors:           page.Visitors,
-			VisitorsPercentage: page.VisitorsPercentage,
-			BouncePercentage:   api.NewOptFloat32(page.BounceRate),
-			Duration:           api.NewOptInt(page.Duration),
-		})
+		resp = append(resp, countriesItem(page))
 	}
 
 	return &api.StatsCountriesHeaders{
 		Response: resp,
 	}, nil
 }
+
+func countriesItem(page *model.StatsCountries) api.StatsCountriesItem {
+	return api.StatsCountriesItem{
+		Country:            page.Country,
+		Visitors:           page.Visitors,
+		VisitorsPercentage: page.VisitorsPercentage,
+		BouncePercentage:   api.NewOptFloat32(page.BounceRate),
+		Duration:           api.NewOptInt(page.Duration),
+	}
+}
+
+func (h *Handler) GetWebsiteIDHostnames(
+	ctx context.Context,
+	params api.GetWebsiteIDHostnamesParams,
+) (api.GetWebsiteIDHostnamesRes, error) {
+	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
+
+	// Check if website exists
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
+	if !exists {
+		log.Debug().Msg("website not found")
+		return ErrNotFound(model.ErrWebsiteNotFound), nil
+	}
+
+	// Create filter for database query
+	filters := db.CreateFilters(params, params.Hostname)
+
+	// Export the full report if requested.
+	if format, ok := params.Format.Get(); ok {
+		filters := exportFilters(filters)
+
+		data, err := exportReport(ctx, format, func(emit func(*api.StatsHostnamesItem) error) error {
+			return h.analyticsDB.StreamWebsiteHostnames(
+				ctx,
+				filters,
+				func(page *model.StatsHostnames) error {
+					item := hostnamesItem(page)
+					return emit(&item)
+				},
+			)
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("failed to export website hostnames")
+			return ErrInternalServerError(err), nil
+		}
+
+		resp := exportResponse(params.Hostname, "hostnames", format, data)
+		if format == api.FormatCsv {
+			return (*api.GetWebsiteIDHostnamesTextCsvOK)(resp), nil
+		}
+
+		return (*api.GetWebsiteIDHostnamesApplicationXNdjsonOK)(resp), nil
+	}
+
+	if params.Summary.Value {
+		// Get summary
+		hostnames, err := h.analyticsDB.GetWebsiteHostnamesSummary(ctx, filters)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Bool("summary", params.Summary.Value).
+				Msg("failed to get website hostnames summary")
+
+			return ErrInternalServerError(err), nil
+		}
+
+		resp := make(api.StatsHostnames, 0, len(hostnames))
+		for _, page := range hostnames {
+			resp = append(resp, api.StatsHostnamesItem{
+				Hostname:           page.Hostname,
+				Visitors:           page.Visitors,
+				VisitorsPercentage: page.VisitorsPercentage,
+			})
+		}
+
+		return &api.StatsHostnamesHeaders{
+			Response: resp,
+		}, nil
+	}
+
+	// Get hostnames
+	hostnames, err := h.analyticsDB.GetWebsiteHostnames(ctx, filters)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Bool("summary", params.Summary.Value).
+			Msg("failed to get website hostnames")
+
+		return ErrInternalServerError(err), nil
+	}
+
+	resp := make(api.StatsHostnames, 0, len(hostnames))
+	for _, page := range hostnames {
+		resp = append(resp, hostnamesItem(page))
+	}
+
+	return &api.StatsHostnamesHeaders{
+		Response: resp,
+	}, nil
+}
+
+func hostnamesItem(page *model.StatsHostnames) api.StatsHostnamesItem {
+	return api.StatsHostnamesItem{
+		Hostname:           page.Hostname,
+		Visitors:           page.Visitors,
+		VisitorsPercentage: page.VisitorsPercentage,
+		BouncePercentage:   api.NewOptFloat32(page.BounceRate),
+		Duration:           api.NewOptInt(page.Duration),
+	}
+}
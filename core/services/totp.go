@@ -0,0 +1,309 @@
+package services
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/metrics"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util"
+	"github.com/medama-io/medama/util/logger"
+)
+
+func (h *Handler) PostUserTotp(
+	ctx context.Context,
+	_params api.PostUserTotpParams,
+) (api.PostUserTotpRes, error) {
+	log := logger.Get()
+	if h.auth.IsDemoMode {
+		log.Debug().Msg("post user totp rejected in demo mode")
+		return ErrForbidden(model.ErrDemoMode), nil
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	user, err := h.db.GetUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrUserNotFound) {
+			return ErrUnauthorised(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	secret, err := util.GenerateTOTPSecret()
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	encrypted, err := h.auth.EncryptTOTPSecret(secret)
+	if err != nil {
+		if errors.Is(err, model.ErrTOTPNotConfigured) {
+			return ErrForbidden(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	// Starting a new enrolment replaces any unconfirmed secret.
+	dateNow := h.auth.Now().Unix()
+
+	err = h.db.SetUserTOTP(ctx, model.NewUserTOTP(user.ID, encrypted, dateNow, dateNow))
+	if err != nil {
+		if errors.Is(err, model.ErrTOTPEnabled) {
+			return ErrConflict(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	log.Info().Str("id", user.ID).Msg("started two-factor enrolment")
+
+	return &api.TOTPEnrolmentHeaders{
+		Response: api.TOTPEnrolment{
+			Secret: secret,
+			URI:    util.TOTPURI(user.Username, secret),
+		},
+	}, nil
+}
+
+func (h *Handler) PostUserTotpConfirm(
+	ctx context.Context,
+	req *api.TOTPConfirm,
+	_params api.PostUserTotpConfirmParams,
+) (api.PostUserTotpConfirmRes, error) {
+	log := logger.Get()
+	if h.auth.IsDemoMode {
+		log.Debug().Msg("post user totp confirm rejected in demo mode")
+		return ErrForbidden(model.ErrDemoMode), nil
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	totp, err := h.db.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrTOTPNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if totp.Enabled {
+		return ErrConflict(model.ErrTOTPEnabled), nil
+	}
+
+	secret, err := h.auth.DecryptTOTPSecret(totp.Secret)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	step, valid, err := util.ValidateTOTP(secret, req.Code, h.auth.Now(), totp.LastStep)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if !valid {
+		return ErrBadRequest(model.ErrInvalidTOTPCode), nil
+	}
+
+	codes, hashes, err := util.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	err = h.db.EnableUserTOTP(ctx, userID, step, hashes, h.auth.Now().Unix())
+	if err != nil {
+		if errors.Is(err, model.ErrTOTPEnabled) {
+			return ErrConflict(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	log.Info().Str("id", userID).Msg("enabled two-factor authentication")
+
+	h.audit(ctx, model.AuditTOTPEnable, userID, nil)
+
+	return &api.TOTPRecoveryCodesHeaders{
+		Response: api.TOTPRecoveryCodes{
+			RecoveryCodes: codes,
+		},
+	}, nil
+}
+
+func (h *Handler) PostUserTotpDisable(
+	ctx context.Context,
+	req *api.TOTPDisable,
+	_params api.PostUserTotpDisableParams,
+) (api.PostUserTotpDisableRes, error) {
+	log := logger.Get()
+	if h.auth.IsDemoMode {
+		log.Debug().Msg("post user totp disable rejected in demo mode")
+		return ErrForbidden(model.ErrDemoMode), nil
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	user, err := h.db.GetUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrUserNotFound) {
+			return ErrUnauthorised(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	// Require the password so a stolen session can not remove the second factor.
+	match, err := h.auth.ComparePasswords(req.Password, user.Password)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if !match {
+		return ErrForbidden(model.ErrInvalidPassword), nil
+	}
+
+	err = h.db.DeleteUserTOTP(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, model.ErrTOTPNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	log.Info().Str("id", user.ID).Msg("disabled two-factor authentication")
+
+	h.audit(ctx, model.AuditTOTPDisable, user.ID, nil)
+
+	return &api.PostUserTotpDisableNoContent{}, nil
+}
+
+func (h *Handler) PostAuthLoginTotp(
+	ctx context.Context,
+	req *api.AuthLoginTOTP,
+	params api.PostAuthLoginTotpParams,
+) (api.PostAuthLoginTotpRes, error) {
+	log := logger.Get()
+
+	// Challenges limit the attempts for a single login, so only the network
+	// is locked out to stop repeated logins from guessing codes.
+	limiter := h.auth.LoginLimiter()
+	prefix := loginPrefix(ctx)
+
+	if retryAfter := limiter.Check("", prefix); retryAfter > 0 {
+		metrics.RateLimited.Inc(metrics.LimiterLogin)
+
+		return ErrTooManyRequests(model.ErrLoginLocked, retryAfter), nil
+	}
+
+	userID, err := h.auth.ReadLoginChallenge(ctx, req.Challenge)
+	if err != nil {
+		limiter.Fail("", prefix)
+		return ErrUnauthorised(err), nil
+	}
+
+	valid, err := h.verifySecondFactor(ctx, userID, req.Code)
+	if err != nil {
+		return ErrInternalServerError(err), nil
+	}
+
+	if !valid {
+		log.Debug().Str("id", userID).Msg("invalid two-factor code")
+		limiter.Fail("", prefix)
+
+		return ErrUnauthorised(model.ErrInvalidTOTPCode), nil
+	}
+
+	h.auth.RevokeLoginChallenge(ctx, req.Challenge)
+
+	cookie, err := h.auth.CreateSession(ctx, userID, params.UserAgent.Value)
+	if err != nil {
+		return ErrInternalServerError(err), nil
+	}
+
+	return &api.PostAuthLoginTotpOK{
+		SetCookie: cookie.String(),
+	}, nil
+}
+
+// verifySecondFactor checks a code from the authenticator app, or otherwise
+// uses up a recovery code. Codes from the authenticator app can only be used
+// once to prevent replaying an intercepted code.
+func (h *Handler) verifySecondFactor(ctx context.Context, userID string, code string) (bool, error) {
+	totp, err := h.db.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrTOTPNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	if !totp.Enabled {
+		return false, nil
+	}
+
+	if len(code) == util.TOTPDigits {
+		secret, err := h.auth.DecryptTOTPSecret(totp.Secret)
+		if err != nil {
+			return false, err
+		}
+
+		step, valid, err := util.ValidateTOTP(secret, code, h.auth.Now(), totp.LastStep)
+		if err != nil || !valid {
+			return false, err
+		}
+
+		err = h.db.UpdateUserTOTPLastStep(ctx, userID, step)
+		if err != nil {
+			if errors.Is(err, model.ErrInvalidTOTPCode) {
+				return false, nil
+			}
+
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	err = h.db.UseRecoveryCode(ctx, userID, util.HashRecoveryCode(code), h.auth.Now().Unix())
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidTOTPCode) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// isTOTPEnabled reports whether the user has confirmed two-factor
+// authentication.
+func (h *Handler) isTOTPEnabled(ctx context.Context, userID string) (bool, error) {
+	totp, err := h.db.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrTOTPNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return totp.Enabled, nil
+}
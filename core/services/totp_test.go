@@ -0,0 +1,202 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/middlewares"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/services"
+	"github.com/medama-io/medama/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTwoFactorLogin(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient := metest.NewTestDatabases(t)
+
+	totpKey, err := util.DeriveTOTPKey(strings.Repeat("t", util.MinTOTPSecretLength))
+	require.NoError(err)
+
+	now := time.Now()
+	auth, err := util.NewAuthService(ctx, false,
+		util.WithClock(func() time.Time { return now }),
+		util.WithTOTPKey(totpKey),
+	)
+	require.NoError(err)
+
+	ingester := duckdb.NewIngester(duckdbClient, duckdb.IngestConfig{})
+	t.Cleanup(func() {
+		ingester.Close(t.Context()) //nolint:errcheck // Test cleanup.
+	})
+
+	handler, err := services.NewService(ctx, auth, sqliteClient, duckdbClient, ingester, "test-commit")
+	require.NoError(err)
+
+	server, err := api.NewServer(handler,
+		middlewares.NewAuthHandler(auth, sqliteClient),
+		api.WithErrorHandler(middlewares.ErrorHandler),
+	)
+	require.NoError(err)
+
+	do := func(method string, path string, body string, cookie string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		if cookie != "" {
+			req.Header.Set("Cookie", cookie)
+		}
+
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	sessionCookie := func(rec *httptest.ResponseRecorder) string {
+		cookie, _, _ := strings.Cut(rec.Header().Get("Set-Cookie"), ";")
+		return cookie
+	}
+
+	login := func() *httptest.ResponseRecorder {
+		return do(http.MethodPost, "/auth/login",
+			`{"username":"admin","password":"CHANGE_ME_ON_FIRST_LOGIN"}`, "")
+	}
+
+	loginTOTP := func(challenge string, code string) *httptest.ResponseRecorder {
+		return do(http.MethodPost, "/auth/login/totp",
+			`{"challenge":"`+challenge+`","code":"`+code+`"}`, "")
+	}
+
+	challenge := func() string {
+		rec := login()
+		require.Equal(http.StatusAccepted, rec.Code)
+		assert.Empty(rec.Header().Get("Set-Cookie"))
+
+		var resp api.AuthLoginChallenge
+		require.NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+
+		return resp.Challenge
+	}
+
+	rec := login()
+	require.Equal(http.StatusOK, rec.Code)
+	cookie := sessionCookie(rec)
+
+	// Start enrolment.
+	rec = do(http.MethodPost, "/user/totp", "", cookie)
+	require.Equal(http.StatusCreated, rec.Code)
+
+	var enrolment api.TOTPEnrolment
+	require.NoError(json.Unmarshal(rec.Body.Bytes(), &enrolment))
+	assert.Contains(enrolment.URI, "secret="+enrolment.Secret)
+
+	// Enrolment is only enabled once confirmed with a valid code.
+	rec = do(http.MethodPost, "/user/totp/confirm", `{"code":"000000"}`, cookie)
+	assert.Equal(http.StatusBadRequest, rec.Code)
+
+	code, err := util.TOTPCode(enrolment.Secret, util.TOTPStep(now))
+	require.NoError(err)
+
+	rec = do(http.MethodPost, "/user/totp/confirm", `{"code":"`+code+`"}`, cookie)
+	require.Equal(http.StatusOK, rec.Code)
+
+	var recovery api.TOTPRecoveryCodes
+	require.NoError(json.Unmarshal(rec.Body.Bytes(), &recovery))
+	require.Len(recovery.RecoveryCodes, util.RecoveryCodeCount)
+
+	rec = do(http.MethodGet, "/user", "", cookie)
+	require.Equal(http.StatusOK, rec.Code)
+	assert.Contains(rec.Body.String(), `"totpEnabled":true`)
+
+	rec = do(http.MethodPost, "/user/totp", "", cookie)
+	assert.Equal(http.StatusConflict, rec.Code)
+
+	// The password alone no longer logs in, and the code used to confirm
+	// enrolment can not be replayed.
+	token := challenge()
+
+	rec = loginTOTP(token, code)
+	assert.Equal(http.StatusUnauthorized, rec.Code)
+
+	now = now.Add(util.TOTPPeriod)
+	code, err = util.TOTPCode(enrolment.Secret, util.TOTPStep(now))
+	require.NoError(err)
+
+	rec = loginTOTP("invalid", code)
+	assert.Equal(http.StatusUnauthorized, rec.Code)
+
+	rec = loginTOTP(token, code)
+	require.Equal(http.StatusOK, rec.Code)
+
+	rec = do(http.MethodGet, "/user", "", sessionCookie(rec))
+	assert.Equal(http.StatusOK, rec.Code)
+
+	// Challenges can only be used once.
+	rec = loginTOTP(token, code)
+	assert.Equal(http.StatusUnauthorized, rec.Code)
+
+	// Recovery codes can only be used once.
+	rec = loginTOTP(challenge(), strings.ToUpper(recovery.RecoveryCodes[0]))
+	require.Equal(http.StatusOK, rec.Code)
+
+	rec = loginTOTP(challenge(), recovery.RecoveryCodes[0])
+	assert.Equal(http.StatusUnauthorized, rec.Code)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	count, err := sqliteClient.CountRecoveryCodes(ctx, user.ID)
+	require.NoError(err)
+	assert.Equal(util.RecoveryCodeCount-1, count)
+
+	// Challenges expire.
+	token = challenge()
+	now = now.Add(model.LoginChallengeDuration + util.TOTPPeriod)
+
+	code, err = util.TOTPCode(enrolment.Secret, util.TOTPStep(now))
+	require.NoError(err)
+
+	rec = loginTOTP(token, code)
+	assert.Equal(http.StatusUnauthorized, rec.Code)
+
+	// Disabling requires the password.
+	rec = do(http.MethodPost, "/user/totp/disable", `{"password":"wrong"}`, cookie)
+	assert.Equal(http.StatusForbidden, rec.Code)
+
+	rec = do(http.MethodPost, "/user/totp/disable", `{"password":"CHANGE_ME_ON_FIRST_LOGIN"}`, cookie)
+	assert.Equal(http.StatusNoContent, rec.Code)
+
+	rec = login()
+	assert.Equal(http.StatusOK, rec.Code)
+}
+
+func TestTwoFactorNotConfigured(t *testing.T) {
+	assert, ctx, handler, sqliteClient := metest.NewTestHandler(t)
+	require := require.New(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	// Two-factor authentication can not be enabled without a TOTP secret.
+	ctx = context.WithValue(ctx, model.ContextKeyUserID, user.ID)
+
+	resp, err := handler.PostUserTotp(ctx, api.PostUserTotpParams{})
+	require.NoError(err)
+	assert.IsType(&api.ForbiddenErrorHeaders{}, resp)
+
+	_, err = sqliteClient.GetUserTOTP(ctx, user.ID)
+	require.ErrorIs(err, model.ErrTOTPNotFound)
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"math"
+	"strconv"
 
 	"github.com/go-faster/errors"
 	"github.com/medama-io/medama/api"
@@ -34,9 +35,16 @@ func (h *Handler) GetUser(ctx context.Context, _params api.GetUserParams) (api.G
 		return nil, errors.Wrap(err, "services")
 	}
 
+	totpEnabled, err := h.isTOTPEnabled(ctx, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
 	return &api.UserGetHeaders{
 		Response: api.UserGet{
-			Username: user.Username,
+			Username:    user.Username,
+			Role:        api.UserRole(user.Role),
+			TotpEnabled: totpEnabled,
 			Settings: api.UserSettings{
 				Language: api.NewOptUserSettingsLanguage(
 					api.UserSettingsLanguage(user.Settings.Language),
@@ -87,6 +95,30 @@ func (h *Handler) GetUserUsage(
 		return nil, err
 	}
 
+	// Data retention.
+	settings, err := h.db.GetTenantSettings(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	retentionDays, err := strconv.Atoi(settings.RetentionDays)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse retention days setting")
+	}
+
+	retention := api.UserUsageGetRetention{
+		Days: retentionDays,
+	}
+
+	oldest, err := h.analyticsDB.GetOldestPageView(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if !oldest.IsZero() {
+		retention.Oldest = api.NewOptDateTime(oldest)
+	}
+
 	return &api.UserUsageGetHeaders{
 		Response: api.UserUsageGet{
 			CPU: api.UserUsageGetCPU{
@@ -102,6 +134,7 @@ func (h *Handler) GetUserUsage(
 				Used:  safeConvertUint64ToInt64(diskStat.Used),
 				Total: safeConvertUint64ToInt64(diskStat.Total),
 			},
+			Retention: retention,
 		},
 	}, nil
 }
@@ -145,9 +178,12 @@ func (h *Handler) PatchUser(
 		return nil, errors.Wrap(err, "services")
 	}
 
+	changes := auditChanges{}
+
 	// Update values
 	if req.Username.IsSet() {
 		username := req.Username.Value
+		changes.add("username", user.Username, username)
 		user.Username = username
 
 		err = h.db.UpdateUserUsername(ctx, user.ID, username)
@@ -184,6 +220,8 @@ func (h *Handler) PatchUser(
 			log.Error().Err(err).Msg("failed to update user password")
 			return nil, errors.Wrap(err, "services")
 		}
+
+		changes.redact("password")
 	}
 
 	// Settings
@@ -192,6 +230,7 @@ func (h *Handler) PatchUser(
 	if req.Settings.IsSet() {
 		settings := user.Settings
 		if v, ok := req.Settings.Value.Language.Get(); ok {
+			changes.add("language", settings.Language, string(v))
 			settings.Language = string(v)
 		}
 
@@ -202,6 +241,10 @@ func (h *Handler) PatchUser(
 		}
 	}
 
+	if len(changes) > 0 {
+		h.audit(ctx, model.AuditUserUpdate, user.ID, changes)
+	}
+
 	// Returning tenant settings as part of user modal, to preserve backward compatibility
 	settings, err := h.db.GetTenantSettings(ctx)
 	if err != nil {
@@ -218,9 +261,16 @@ func (h *Handler) PatchUser(
 		}
 	}
 
+	totpEnabled, err := h.isTOTPEnabled(ctx, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
 	return &api.UserGetHeaders{
 		Response: api.UserGet{
-			Username: user.Username,
+			Username:    user.Username,
+			Role:        api.UserRole(user.Role),
+			TotpEnabled: totpEnabled,
 			Settings: api.UserSettings{
 				Language: api.NewOptUserSettingsLanguage(
 					api.UserSettingsLanguage(user.Settings.Language),
@@ -262,6 +312,12 @@ func (h *Handler) DeleteUser(
 		return nil, errors.Wrap(err, "services")
 	}
 
+	// The owner can not delete themselves, as there must always be one.
+	if user.Role == model.UserRoleOwner {
+		log.Debug().Msg("delete owner rejected")
+		return ErrForbidden(model.ErrUserOwner), nil
+	}
+
 	err = h.db.DeleteUser(ctx, user.ID)
 	if err != nil {
 		log.Error().
@@ -274,5 +330,16 @@ func (h *Handler) DeleteUser(
 		return nil, errors.Wrap(err, "services")
 	}
 
+	err = h.auth.RevokeUserSessions(ctx, user.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to revoke user sessions")
+		return nil, errors.Wrap(err, "services")
+	}
+
+	changes := auditChanges{}
+	changes.add("username", user.Username, "")
+	changes.add("role", string(user.Role), "")
+	h.audit(ctx, model.AuditUserDelete, user.ID, changes)
+
 	return &api.DeleteUserNoContent{}, nil
 }
@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"io"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+)
+
+func (h *Handler) GetWebsiteIDPagesEntry(
+	ctx context.Context,
+	params api.GetWebsiteIDPagesEntryParams,
+) (api.GetWebsiteIDPagesEntryRes, error) {
+	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
+
+	// Check if website exists.
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
+	if !exists {
+		log.Debug().Msg("website not found")
+		return ErrNotFound(model.ErrWebsiteNotFound), nil
+	}
+
+	// Create filter for database query.
+	filters := db.CreateFilters(params, params.Hostname)
+
+	// Export the full report if requested.
+	if format, ok := params.Format.Get(); ok {
+		data, err := h.exportVisitPages(ctx, false, format, filters)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to export website entry pages")
+			return ErrInternalServerError(err), nil
+		}
+
+		resp := &api.StatsVisitPagesApplicationXNdjsonHeaders{
+			ContentDisposition: api.NewOptString(exportDisposition(params.Hostname, "pages-entry", format)),
+			Response:           api.StatsVisitPagesApplicationXNdjson{Data: data},
+		}
+		if format == api.FormatCsv {
+			return (*api.GetWebsiteIDPagesEntryTextCsvOK)(resp), nil
+		}
+
+		return (*api.GetWebsiteIDPagesEntryApplicationXNdjsonOK)(resp), nil
+	}
+
+	resp, err := h.getVisitPages(ctx, false, params.Summary.Value, filters)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Bool("summary", params.Summary.Value).
+			Msg("failed to get website entry pages")
+
+		return ErrInternalServerError(err), nil
+	}
+
+	return &api.StatsVisitPagesHeaders{
+		Response: resp,
+	}, nil
+}
+
+func (h *Handler) GetWebsiteIDPagesExit(
+	ctx context.Context,
+	params api.GetWebsiteIDPagesExitParams,
+) (api.GetWebsiteIDPagesExitRes, error) {
+	log := logger.Get().With().Str("hostname", params.Hostname).Logger()
+
+	// Check if website exists.
+	exists, err := h.canAccessWebsite(ctx, params.Hostname)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check website access")
+		return ErrInternalServerError(err), nil
+	}
+
+	if !exists {
+		log.Debug().Msg("website not found")
+		return ErrNotFound(model.ErrWebsiteNotFound), nil
+	}
+
+	// Create filter for database query.
+	filters := db.CreateFilters(params, params.Hostname)
+
+	// Export the full report if requested.
+	if format, ok := params.Format.Get(); ok {
+		data, err := h.exportVisitPages(ctx, true, format, filters)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to export website exit pages")
+			return ErrInternalServerError(err), nil
+		}
+
+		resp := &api.StatsVisitPagesApplicationXNdjsonHeaders{
+			ContentDisposition: api.NewOptString(exportDisposition(params.Hostname, "pages-exit", format)),
+			Response:           api.StatsVisitPagesApplicationXNdjson{Data: data},
+		}
+		if format == api.FormatCsv {
+			return (*api.GetWebsiteIDPagesExitTextCsvOK)(resp), nil
+		}
+
+		return (*api.GetWebsiteIDPagesExitApplicationXNdjsonOK)(resp), nil
+	}
+
+	resp, err := h.getVisitPages(ctx, true, params.Summary.Value, filters)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Bool("summary", params.Summary.Value).
+			Msg("failed to get website exit pages")
+
+		return ErrInternalServerError(err), nil
+	}
+
+	return &api.StatsVisitPagesHeaders{
+		Response: resp,
+	}, nil
+}
+
+// getVisitPages returns the entry or exit pages, only including the bounce
+// rate and duration when a summary is not requested.
+func (h *Handler) getVisitPages(
+	ctx context.Context,
+	isExit bool,
+	summary bool,
+	filters *db.Filters,
+) (api.StatsVisitPages, error) {
+	if summary {
+		pages, err := h.analyticsDB.GetWebsiteVisitPagesSummary(ctx, isExit, filters)
+		if err != nil {
+			return nil, err
+		}
+
+		resp := make(api.StatsVisitPages, 0, len(pages))
+		for _, page := range pages {
+			resp = append(resp, api.StatsVisitPagesItem{
+				Path:               page.Pathname,
+				Visitors:           page.Visitors,
+				VisitorsPercentage: page.VisitorsPercentage,
+			})
+		}
+
+		return resp, nil
+	}
+
+	pages, err := h.analyticsDB.GetWebsiteVisitPages(ctx, isExit, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make(api.StatsVisitPages, 0, len(pages))
+	for _, page := range pages {
+		resp = append(resp, visitPagesItem(page))
+	}
+
+	return resp, nil
+}
+
+// exportVisitPages streams the entry or exit pages in the export format.
+func (h *Handler) exportVisitPages(
+	ctx context.Context,
+	isExit bool,
+	format api.Format,
+	filters *db.Filters,
+) (io.ReadCloser, error) {
+	filters = exportFilters(filters)
+
+	return exportReport(ctx, format, func(emit func(*api.StatsVisitPagesItem) error) error {
+		return h.analyticsDB.StreamWebsiteVisitPages(
+			ctx,
+			isExit,
+			filters,
+			func(page *model.StatsVisitPages) error {
+				item := visitPagesItem(page)
+				return emit(&item)
+			},
+		)
+	})
+}
+
+func visitPagesItem(page *model.StatsVisitPages) api.StatsVisitPagesItem {
+	return api.StatsVisitPagesItem{
+		Path:               page.Pathname,
+		Visitors:           page.Visitors,
+		VisitorsPercentage: page.VisitorsPercentage,
+		BouncePercentage:   api.NewOptFloat32(page.BounceRate),
+		Duration:           api.NewOptInt(page.Duration),
+	}
+}
@@ -0,0 +1,81 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/db/duckdb"
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/services"
+	"github.com/medama-io/medama/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVisitTracking(t *testing.T) {
+	testCases := []struct {
+		name     string
+		opts     []services.ServiceOption
+		visitIDs int
+		visits   int
+	}{
+		// Page views are not linked unless visit tracking is enabled.
+		{"disabled", nil, 0, 0},
+		{"enabled", []services.ServiceOption{services.WithVisitTracking()}, 2, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx, sqliteClient, duckdbClient := metest.NewTestDatabases(t)
+
+			user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+			require.NoError(err)
+
+			require.NoError(sqliteClient.CreateWebsite(ctx, model.NewWebsite(user.ID, "example.com", 1, 1)))
+
+			auth, err := util.NewAuthService(ctx, false)
+			require.NoError(err)
+
+			ingester := duckdb.NewIngester(duckdbClient, duckdb.IngestConfig{})
+			t.Cleanup(func() {
+				ingester.Close(t.Context()) //nolint:errcheck // Test cleanup.
+			})
+
+			handler, err := services.NewService(ctx, auth, sqliteClient, duckdbClient, ingester, "test-commit", tc.opts...)
+			require.NoError(err)
+			t.Cleanup(handler.Close)
+
+			for _, page := range []struct{ bid, pathname string }{{"bid_1", "/"}, {"bid_2", "/pricing"}} {
+				req := httptest.NewRequest(http.MethodPost, "/event/hit", nil)
+				req.Header.Set("X-Forwarded-For", "203.0.113.1")
+				req.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64; rv:131.0) Gecko/20100101 Firefox/131.0")
+
+				res, err := handler.PostEventHit(
+					context.WithValue(ctx, model.RequestKeyBody, req),
+					api.NewEventLoadEventHit(api.EventLoad{
+						B: page.bid,
+						U: url.URL{Scheme: "https", Host: "example.com", Path: page.pathname},
+					}),
+					api.PostEventHitParams{},
+				)
+				require.NoError(err)
+				require.IsType(&api.PostEventHitNoContent{}, res)
+			}
+
+			require.NoError(ingester.Flush(ctx))
+
+			var visitIDs, visits int
+
+			err = duckdbClient.QueryRowContext(ctx, "SELECT COUNT(visit_id), COUNT(DISTINCT visit_id) FROM views").
+				Scan(&visitIDs, &visits)
+			require.NoError(err)
+			require.Equal(tc.visitIDs, visitIDs)
+			require.Equal(tc.visits, visits)
+		})
+	}
+}
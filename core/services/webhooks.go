@@ -0,0 +1,328 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util/logger"
+	"go.jetify.com/typeid"
+)
+
+const (
+	// webhookSecretBytes is the number of random bytes of a webhook secret.
+	webhookSecretBytes = 32
+	// webhookMaxURLLength limits the length of webhook URLs.
+	webhookMaxURLLength = 2048
+	// webhookDeliveriesDefaultLimit is the number of delivery log entries
+	// listed when no limit is given.
+	webhookDeliveriesDefaultLimit = 50
+)
+
+func (h *Handler) GetWebsitesIDWebhooks(
+	ctx context.Context,
+	params api.GetWebsitesIDWebhooksParams,
+) (api.GetWebsitesIDWebhooksRes, error) {
+	// Get user ID from context
+	_, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	// Webhooks send website data to external services, so they are limited
+	// to admins.
+	err := h.authoriseWebsite(ctx, params.Hostname, true)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrWebsiteNotFound):
+			return ErrNotFound(err), nil
+		case errors.Is(err, model.ErrUserForbidden):
+			return ErrForbidden(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	webhooks, err := h.db.ListWebhooks(ctx, params.Hostname)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	resp := make([]api.WebhookGet, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		resp = append(resp, webhookToAPI(webhook))
+	}
+
+	return &api.GetWebsitesIDWebhooksOKHeaders{
+		Response: resp,
+	}, nil
+}
+
+func (h *Handler) PostWebsitesIDWebhooks(
+	ctx context.Context,
+	req *api.WebhookCreate,
+	params api.PostWebsitesIDWebhooksParams,
+) (api.PostWebsitesIDWebhooksRes, error) {
+	log := logger.Get()
+	if h.auth.IsDemoMode {
+		log.Debug().Msg("post webhook rejected in demo mode")
+		return ErrForbidden(model.ErrDemoMode), nil
+	}
+
+	// Get user ID from context
+	userID, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	err := h.authoriseWebsite(ctx, params.Hostname, true)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrWebsiteNotFound):
+			return ErrNotFound(err), nil
+		case errors.Is(err, model.ErrUserForbidden):
+			return ErrForbidden(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if !isValidWebhookURL(&req.URL) {
+		return ErrBadRequest(model.ErrInvalidWebhookURL), nil
+	}
+
+	typeID, err := typeid.WithPrefix(model.WebhookPrefix)
+	if err != nil {
+		return nil, errors.Wrap(err, "typeid webhook")
+	}
+
+	secret := make([]byte, webhookSecretBytes)
+
+	_, err = rand.Read(secret)
+	if err != nil {
+		return nil, errors.Wrap(err, "webhook secret")
+	}
+
+	now := time.Now().Unix()
+	webhook := model.NewWebhook(
+		typeID.String(),
+		userID,
+		params.Hostname,
+		req.URL.String(),
+		hex.EncodeToString(secret),
+		model.WebhookTrigger(req.Trigger),
+		req.EventName.Value,
+		req.Threshold.Value,
+		now,
+		now,
+	)
+	clearUnusedWebhookFields(webhook)
+
+	err = webhook.Validate()
+	if err != nil {
+		return ErrBadRequest(err), nil
+	}
+
+	err = h.db.CreateWebhook(ctx, webhook)
+	if err != nil {
+		if errors.Is(err, model.ErrWebsiteNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	h.webhooks.Reload(ctx)
+
+	log.Info().
+		Str("id", webhook.ID).
+		Str("hostname", webhook.Hostname).
+		Str("trigger", string(webhook.Trigger)).
+		Msg("created webhook")
+
+	changes := auditChanges{}
+	changes.add("hostname", "", webhook.Hostname)
+	changes.add("url", "", webhook.URL)
+	changes.add("trigger", "", string(webhook.Trigger))
+	h.audit(ctx, model.AuditWebhookCreate, webhook.ID, changes)
+
+	return &api.WebhookCreatedHeaders{
+		Response: api.WebhookCreated{
+			Secret:  webhook.Secret,
+			Webhook: webhookToAPI(webhook),
+		},
+	}, nil
+}
+
+func (h *Handler) DeleteWebsitesIDWebhooksID(
+	ctx context.Context,
+	params api.DeleteWebsitesIDWebhooksIDParams,
+) (api.DeleteWebsitesIDWebhooksIDRes, error) {
+	log := logger.Get()
+	if h.auth.IsDemoMode {
+		log.Debug().Msg("delete webhook rejected in demo mode")
+		return ErrForbidden(model.ErrDemoMode), nil
+	}
+
+	// Get user ID from context
+	_, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	err := h.authoriseWebsite(ctx, params.Hostname, true)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrWebsiteNotFound):
+			return ErrNotFound(err), nil
+		case errors.Is(err, model.ErrUserForbidden):
+			return ErrForbidden(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	err = h.db.DeleteWebhook(ctx, params.Hostname, params.WebhookId)
+	if err != nil {
+		if errors.Is(err, model.ErrWebhookNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	h.webhooks.Reload(ctx)
+
+	log.Info().Str("id", params.WebhookId).Msg("deleted webhook")
+
+	h.audit(ctx, model.AuditWebhookDelete, params.WebhookId, nil)
+
+	return &api.DeleteWebsitesIDWebhooksIDNoContent{}, nil
+}
+
+func (h *Handler) GetWebsitesIDWebhooksIDDeliveries(
+	ctx context.Context,
+	params api.GetWebsitesIDWebhooksIDDeliveriesParams,
+) (api.GetWebsitesIDWebhooksIDDeliveriesRes, error) {
+	// Get user ID from context
+	_, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	err := h.authoriseWebsite(ctx, params.Hostname, true)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrWebsiteNotFound):
+			return ErrNotFound(err), nil
+		case errors.Is(err, model.ErrUserForbidden):
+			return ErrForbidden(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	// Check the webhook belongs to the website.
+	_, err = h.db.GetWebhook(ctx, params.Hostname, params.WebhookId)
+	if err != nil {
+		if errors.Is(err, model.ErrWebhookNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	deliveries, err := h.db.ListWebhookDeliveries(
+		ctx,
+		params.WebhookId,
+		params.Limit.Or(webhookDeliveriesDefaultLimit),
+		params.Offset.Or(0),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	resp := make([]api.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		resp = append(resp, webhookDeliveryToAPI(delivery))
+	}
+
+	return &api.GetWebsitesIDWebhooksIDDeliveriesOKHeaders{
+		Response: resp,
+	}, nil
+}
+
+// isValidWebhookURL reports whether webhooks can be sent to the URL.
+func isValidWebhookURL(u *url.URL) bool {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return false
+	}
+
+	return u.Host != "" && u.User == nil && len(u.String()) <= webhookMaxURLLength
+}
+
+// clearUnusedWebhookFields resets the fields not used by the trigger of the
+// webhook.
+func clearUnusedWebhookFields(webhook *model.Webhook) {
+	if webhook.Trigger != model.WebhookTriggerCustomEvent {
+		webhook.EventName = ""
+	}
+
+	if webhook.Trigger != model.WebhookTriggerTrafficSpike {
+		webhook.Threshold = 0
+	}
+}
+
+func webhookToAPI(webhook *model.Webhook) api.WebhookGet {
+	resp := api.WebhookGet{
+		ID:        webhook.ID,
+		Hostname:  webhook.Hostname,
+		Trigger:   api.WebhookTrigger(webhook.Trigger),
+		CreatedAt: time.Unix(webhook.DateCreated, 0).UTC(),
+	}
+
+	u, err := url.Parse(webhook.URL)
+	if err == nil {
+		resp.URL = *u
+	}
+
+	if webhook.EventName != "" {
+		resp.EventName = api.NewOptString(webhook.EventName)
+	}
+
+	if webhook.Threshold > 0 {
+		resp.Threshold = api.NewOptInt(webhook.Threshold)
+	}
+
+	if webhook.DateLastTriggered > 0 {
+		resp.LastTriggeredAt = api.NewOptDateTime(time.Unix(webhook.DateLastTriggered, 0).UTC())
+	}
+
+	return resp
+}
+
+func webhookDeliveryToAPI(delivery *model.WebhookDelivery) api.WebhookDelivery {
+	resp := api.WebhookDelivery{
+		ID:          delivery.ID,
+		Trigger:     api.WebhookTrigger(delivery.Trigger),
+		Payload:     delivery.Payload,
+		Attempts:    delivery.Attempts,
+		CreatedAt:   time.Unix(delivery.DateCreated, 0).UTC(),
+		CompletedAt: time.Unix(delivery.DateCompleted, 0).UTC(),
+	}
+
+	if delivery.StatusCode > 0 {
+		resp.StatusCode = api.NewOptInt(delivery.StatusCode)
+	}
+
+	if delivery.Error != "" {
+		resp.Error = api.NewOptString(delivery.Error)
+	}
+
+	return resp
+}
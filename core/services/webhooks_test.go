@@ -0,0 +1,469 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/services"
+	"github.com/medama-io/medama/webhooks"
+	"github.com/stretchr/testify/require"
+)
+
+// webhookReceiver records the webhooks posted to it.
+type webhookReceiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []*webhookRequest
+}
+
+type webhookRequest struct {
+	header  http.Header
+	body    []byte
+	payload map[string]any
+}
+
+func newWebhookReceiver(t *testing.T, handler http.HandlerFunc) *webhookReceiver {
+	t.Helper()
+
+	receiver := &webhookReceiver{}
+	receiver.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		var payload map[string]any
+		require.NoError(t, json.Unmarshal(body, &payload))
+
+		receiver.mu.Lock()
+		receiver.requests = append(receiver.requests, &webhookRequest{header: r.Header, body: body, payload: payload})
+		receiver.mu.Unlock()
+
+		if handler != nil {
+			handler(w, r)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(receiver.Close)
+
+	return receiver
+}
+
+func (r *webhookReceiver) Requests() []*webhookRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*webhookRequest(nil), r.requests...)
+}
+
+func TestWebhooks(t *testing.T) {
+	assert, ctx, handler, sqliteClient := metest.NewTestHandler(t)
+	require := require.New(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	ctx = context.WithValue(ctx, model.ContextKeyUserID, user.ID)
+
+	_, err = handler.PostWebsites(ctx, &api.WebsiteCreate{Hostname: "example.com"})
+	require.NoError(err)
+
+	receiver := newWebhookReceiver(t, nil)
+	receiverURL, err := url.Parse(receiver.URL)
+	require.NoError(err)
+
+	// Webhooks need a http(s) URL, an accessible website and the fields of
+	// their trigger.
+	testCases := []struct {
+		hostname string
+		req      *api.WebhookCreate
+		expected any
+	}{
+		{"example.com", &api.WebhookCreate{
+			URL:       url.URL{Scheme: "ftp", Host: "example.org"},
+			Trigger:   api.WebhookTriggerCustomEvent,
+			EventName: api.NewOptString("signup"),
+		}, &api.BadRequestErrorHeaders{}},
+		{"example.com", &api.WebhookCreate{
+			URL:     *receiverURL,
+			Trigger: api.WebhookTriggerCustomEvent,
+		}, &api.BadRequestErrorHeaders{}},
+		{"example.com", &api.WebhookCreate{
+			URL:     *receiverURL,
+			Trigger: api.WebhookTriggerTrafficSpike,
+		}, &api.BadRequestErrorHeaders{}},
+		{"missing.example.com", &api.WebhookCreate{
+			URL:     *receiverURL,
+			Trigger: api.WebhookTriggerNewReferrer,
+		}, &api.NotFoundErrorHeaders{}},
+	}
+
+	for i, tc := range testCases {
+		resp, err := handler.PostWebsitesIDWebhooks(ctx, tc.req, api.PostWebsitesIDWebhooksParams{Hostname: tc.hostname})
+		require.NoError(err)
+		assert.IsType(tc.expected, resp, strconv.Itoa(i))
+	}
+
+	resp, err := handler.PostWebsitesIDWebhooks(ctx, &api.WebhookCreate{
+		URL:       *receiverURL,
+		Trigger:   api.WebhookTriggerCustomEvent,
+		EventName: api.NewOptString("signup"),
+		Threshold: api.NewOptInt(10),
+	}, api.PostWebsitesIDWebhooksParams{Hostname: "example.com"})
+	require.NoError(err)
+
+	created, ok := resp.(*api.WebhookCreatedHeaders)
+	require.True(ok)
+	assert.Len(created.Response.Secret, 64)
+	assert.Equal("signup", created.Response.Webhook.EventName.Value)
+	assert.False(created.Response.Webhook.Threshold.Set)
+
+	webhookID := created.Response.Webhook.ID
+
+	hit := func(name string) {
+		req := httptest.NewRequest(http.MethodPost, "/event/hit", nil)
+		req.Header.Set("X-Forwarded-For", "203.0.113.1")
+
+		res, err := handler.PostEventHit(
+			context.WithValue(ctx, model.RequestKeyBody, req),
+			api.NewEventCustomEventHit(api.EventCustom{
+				G: "example.com",
+				D: api.EventCustomD{
+					name:   {Type: api.StringEventCustomDItem, String: "pro"},
+					"seat": {Type: api.IntEventCustomDItem, Int: 3},
+				},
+			}),
+			api.PostEventHitParams{},
+		)
+		require.NoError(err)
+		require.IsType(&api.PostEventHitNoContent{}, res)
+	}
+
+	// Only events with the property of the webhook are sent.
+	hit("newsletter")
+	hit("signup")
+
+	deliveries := func() []api.WebhookDelivery {
+		resp, err := handler.GetWebsitesIDWebhooksIDDeliveries(ctx, api.GetWebsitesIDWebhooksIDDeliveriesParams{
+			Hostname:  "example.com",
+			WebhookId: webhookID,
+		})
+		require.NoError(err)
+
+		list, ok := resp.(*api.GetWebsitesIDWebhooksIDDeliveriesOKHeaders)
+		require.True(ok)
+
+		return list.Response
+	}
+
+	require.Eventually(func() bool { return len(deliveries()) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	requests := receiver.Requests()
+	require.Len(requests, 1)
+
+	received := requests[0]
+	require.NoError(webhooks.Verify(
+		created.Response.Secret, received.header.Get(webhooks.SignatureHeader), received.body, time.Minute, time.Now(),
+	))
+	assert.Equal("custom_event", received.header.Get(webhooks.EventHeader))
+	assert.Equal("custom_event", received.payload["event"])
+	assert.Equal("example.com", received.payload["hostname"])
+	assert.Equal(`Custom event "signup" received on example.com.`, received.payload["text"])
+	assert.Equal(received.payload["text"], received.payload["content"])
+	assert.Equal(map[string]any{
+		"name":       "signup",
+		"properties": map[string]any{"signup": "pro", "seat": "3"},
+		"count":      1.0,
+	}, received.payload["data"])
+
+	delivery := deliveries()[0]
+	assert.Equal(received.header.Get(webhooks.DeliveryHeader), delivery.ID)
+	assert.Equal(received.payload["id"], delivery.ID)
+	assert.Equal(string(received.body), delivery.Payload)
+	assert.Equal(http.StatusNoContent, delivery.StatusCode.Value)
+	assert.Equal(1, delivery.Attempts)
+	assert.False(delivery.Error.Set)
+
+	list, err := handler.GetWebsitesIDWebhooks(ctx, api.GetWebsitesIDWebhooksParams{Hostname: "example.com"})
+	require.NoError(err)
+
+	webhookList, ok := list.(*api.GetWebsitesIDWebhooksOKHeaders)
+	require.True(ok)
+	require.Len(webhookList.Response, 1)
+	assert.Equal(receiver.URL, webhookList.Response[0].URL.String())
+	assert.True(webhookList.Response[0].LastTriggeredAt.Set)
+
+	// Webhooks are only addressable through their own website.
+	_, err = handler.PostWebsites(ctx, &api.WebsiteCreate{Hostname: "other.com"})
+	require.NoError(err)
+
+	del, err := handler.DeleteWebsitesIDWebhooksID(ctx, api.DeleteWebsitesIDWebhooksIDParams{
+		Hostname:  "other.com",
+		WebhookId: webhookID,
+	})
+	require.NoError(err)
+	assert.IsType(&api.NotFoundErrorHeaders{}, del)
+
+	del, err = handler.DeleteWebsitesIDWebhooksID(ctx, api.DeleteWebsitesIDWebhooksIDParams{
+		Hostname:  "example.com",
+		WebhookId: webhookID,
+	})
+	require.NoError(err)
+	assert.IsType(&api.DeleteWebsitesIDWebhooksIDNoContent{}, del)
+
+	_, err = sqliteClient.GetWebhook(ctx, "example.com", webhookID)
+	require.ErrorIs(err, model.ErrWebhookNotFound)
+
+	entries, err := sqliteClient.ListAuditEntries(ctx, model.AuditFilter{Target: webhookID, Limit: 10})
+	require.NoError(err)
+	assert.Len(entries, 2)
+}
+
+func TestWebhookDispatcher(t *testing.T) {
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient := metest.NewTestDatabases(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	require.NoError(sqliteClient.CreateWebsite(ctx, model.NewWebsite(user.ID, "example.com", 1, 1)))
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	var views []model.ImportedPageView
+	for i, referrer := range []string{"news.example.org", "news.example.org", "", "search.example.net"} {
+		views = append(views, model.ImportedPageView{
+			PageViewHit: model.PageViewHit{
+				BID:          "webhook_" + strconv.Itoa(i),
+				Hostname:     "example.com",
+				Pathname:     "/",
+				ReferrerHost: referrer,
+				IsUniqueUser: true,
+				IsUniquePage: true,
+			},
+			DateCreated: now.Add(-10 * time.Minute),
+		})
+	}
+
+	// Referrers seen before the webhook was created are not new.
+	views = append(views, model.ImportedPageView{
+		PageViewHit: model.PageViewHit{
+			BID:          "webhook_old",
+			Hostname:     "example.com",
+			Pathname:     "/",
+			ReferrerHost: "search.example.net",
+		},
+		DateCreated: now.Add(-3 * time.Hour),
+	})
+
+	require.NoError(duckdbClient.InsertImportedViews(ctx, "import_webhooks", views))
+
+	// The first attempt of every delivery fails to exercise retries.
+	var calls atomic.Int32
+
+	receiver := newWebhookReceiver(t, func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1)%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	created := now.Add(-2 * time.Hour).Unix()
+	for _, webhook := range []*model.Webhook{
+		model.NewWebhook("webhook_spike", user.ID, "example.com", receiver.URL, "secret",
+			model.WebhookTriggerTrafficSpike, "", 4, created, created),
+		model.NewWebhook("webhook_quiet", user.ID, "example.com", receiver.URL, "secret",
+			model.WebhookTriggerTrafficSpike, "", 100, created, created),
+		model.NewWebhook("webhook_referrer", user.ID, "example.com", receiver.URL, "secret",
+			model.WebhookTriggerNewReferrer, "", 0, created, created),
+	} {
+		require.NoError(sqliteClient.CreateWebhook(ctx, webhook))
+	}
+
+	dispatcher := services.NewWebhookDispatcher(sqliteClient, duckdbClient, webhooks.NewClient(3, time.Millisecond), time.Hour)
+
+	sent, err := dispatcher.Check(ctx, now)
+	require.NoError(err)
+	require.Equal(2, sent)
+
+	payloads := map[string]map[string]any{}
+	for _, req := range receiver.Requests() {
+		require.NoError(webhooks.Verify("secret", req.header.Get(webhooks.SignatureHeader), req.body, time.Minute, time.Now()))
+		payloads[req.payload["event"].(string)] = req.payload
+	}
+
+	require.Len(payloads, 2)
+	require.Equal(map[string]any{"visitors": 4.0, "threshold": 4.0}, payloads["traffic_spike"]["data"])
+	require.Equal("example.com had 4 visitors in the last hour, reaching the threshold of 4.",
+		payloads["traffic_spike"]["text"])
+	require.Equal(map[string]any{"referrers": []any{"news.example.org"}}, payloads["new_referrer"]["data"])
+
+	for _, id := range []string{"webhook_spike", "webhook_referrer"} {
+		deliveries, err := sqliteClient.ListWebhookDeliveries(ctx, id, 10, 0)
+		require.NoError(err)
+		require.Len(deliveries, 1)
+		require.Equal(2, deliveries[0].Attempts)
+		require.Equal(http.StatusOK, deliveries[0].StatusCode)
+		require.Empty(deliveries[0].Error)
+	}
+
+	// Traffic spikes are sent at most once per hour and referrers are only
+	// new once.
+	sent, err = dispatcher.Check(ctx, now.Add(5*time.Minute))
+	require.NoError(err)
+	require.Zero(sent)
+
+	webhook, err := sqliteClient.GetWebhook(ctx, "example.com", "webhook_referrer")
+	require.NoError(err)
+	require.Equal(now.Add(4*time.Minute).Unix(), webhook.DateLastChecked)
+
+	// Failed deliveries are recorded with their error.
+	receiver.Close()
+
+	require.NoError(sqliteClient.CreateWebhook(ctx, model.NewWebhook("webhook_down", user.ID, "example.com",
+		receiver.URL, "secret", model.WebhookTriggerTrafficSpike, "", 1, created, created)))
+
+	sent, err = dispatcher.Check(ctx, now)
+	require.NoError(err)
+	require.Equal(1, sent)
+
+	deliveries, err := sqliteClient.ListWebhookDeliveries(ctx, "webhook_down", 10, 0)
+	require.NoError(err)
+	require.Len(deliveries, 1)
+	require.Equal(3, deliveries[0].Attempts)
+	require.Zero(deliveries[0].StatusCode)
+	require.NotEmpty(deliveries[0].Error)
+}
+
+func TestWebhookEventAggregation(t *testing.T) {
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient := metest.NewTestDatabases(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	require.NoError(sqliteClient.CreateWebsite(ctx, model.NewWebsite(user.ID, "example.com", 1, 1)))
+
+	receiver := newWebhookReceiver(t, nil)
+
+	created := time.Now().Unix()
+	require.NoError(sqliteClient.CreateWebhook(ctx, model.NewWebhook("webhook_signup", user.ID, "example.com",
+		receiver.URL, "secret", model.WebhookTriggerCustomEvent, "signup", 0, created, created)))
+
+	dispatcher := services.NewWebhookDispatcher(sqliteClient, duckdbClient, webhooks.NewClient(1, time.Millisecond), time.Hour)
+	dispatcher.Start(ctx)
+	t.Cleanup(dispatcher.Close)
+
+	hit := func(plan string) {
+		dispatcher.EventHit("example.com", []model.EventHit{{Group: "example.com", Name: "signup", Value: plan}})
+	}
+
+	// The first event is sent immediately and later events of the window are
+	// counted.
+	for _, plan := range []string{"free", "pro", "pro", "team"} {
+		hit(plan)
+	}
+
+	require.Eventually(func() bool { return len(receiver.Requests()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.Zero(dispatcher.FlushEvents(time.Now()))
+	require.Len(receiver.Requests(), 1)
+
+	require.Equal(1, dispatcher.FlushEvents(time.Now().Add(time.Minute)))
+	require.Eventually(func() bool { return len(receiver.Requests()) == 2 }, 5*time.Second, 10*time.Millisecond)
+
+	requests := receiver.Requests()
+	require.Equal(map[string]any{
+		"name":       "signup",
+		"properties": map[string]any{"signup": "free"},
+		"count":      1.0,
+	}, requests[0].payload["data"])
+	require.Equal(map[string]any{
+		"name":       "signup",
+		"properties": map[string]any{"signup": "team"},
+		"count":      3.0,
+	}, requests[1].payload["data"])
+	require.Equal(`Custom event "signup" received 3 times on example.com.`, requests[1].payload["text"])
+
+	// Windows without events are closed, so the next event is sent
+	// immediately again.
+	require.Zero(dispatcher.FlushEvents(time.Now().Add(3 * time.Minute)))
+
+	hit("pro")
+	require.Eventually(func() bool { return len(receiver.Requests()) == 3 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestWebhookQueuesSeparate(t *testing.T) {
+	require := require.New(t)
+	ctx, sqliteClient, duckdbClient := metest.NewTestDatabases(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	require.NoError(sqliteClient.CreateWebsite(ctx, model.NewWebsite(user.ID, "example.com", 1, 1)))
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	require.NoError(duckdbClient.InsertImportedViews(ctx, "import_webhooks", []model.ImportedPageView{{
+		PageViewHit: model.PageViewHit{
+			BID:          "webhook_spike",
+			Hostname:     "example.com",
+			Pathname:     "/",
+			IsUniqueUser: true,
+			IsUniquePage: true,
+		},
+		DateCreated: now.Add(-10 * time.Minute),
+	}}))
+
+	// Custom event webhooks hang until the end of the test, keeping every
+	// worker busy.
+	release := make(chan struct{})
+	events := newWebhookReceiver(t, func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusNoContent)
+	})
+	scheduled := newWebhookReceiver(t, nil)
+
+	created := now.Add(-2 * time.Hour).Unix()
+	names := make([]model.EventHit, 0, 8)
+
+	for i := range 8 {
+		name := "event_" + strconv.Itoa(i)
+		names = append(names, model.EventHit{Group: "example.com", Name: name, Value: "1"})
+
+		require.NoError(sqliteClient.CreateWebhook(ctx, model.NewWebhook("webhook_"+name, user.ID, "example.com",
+			events.URL, "secret", model.WebhookTriggerCustomEvent, name, 0, created, created)))
+	}
+
+	require.NoError(sqliteClient.CreateWebhook(ctx, model.NewWebhook("webhook_spike", user.ID, "example.com",
+		scheduled.URL, "secret", model.WebhookTriggerTrafficSpike, "", 1, created, created)))
+
+	dispatcher := services.NewWebhookDispatcher(sqliteClient, duckdbClient, webhooks.NewClient(1, time.Millisecond), time.Hour)
+	dispatcher.Start(ctx)
+	t.Cleanup(dispatcher.Close)
+	t.Cleanup(func() { close(release) })
+
+	dispatcher.EventHit("example.com", names)
+	require.Eventually(func() bool { return len(events.Requests()) > 0 }, 5*time.Second, 10*time.Millisecond)
+
+	// Scheduled webhooks are still sent while custom event webhooks are
+	// waiting for a worker.
+	sent, err := dispatcher.Check(ctx, now)
+	require.NoError(err)
+	require.Equal(1, sent)
+	require.Len(scheduled.Requests(), 1)
+}
@@ -2,11 +2,14 @@ package services
 
 import (
 	"context"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/medama-io/medama/api"
 	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util"
 	"github.com/medama-io/medama/util/logger"
 )
 
@@ -44,12 +47,41 @@ func (h *Handler) DeleteWebsitesID(
 		}
 	}
 
-	if website == nil {
+	if website == nil || !keyCanAccessWebsite(ctx, website.Hostname) {
 		return ErrNotFound(model.ErrWebsiteNotFound), nil
 	}
 
-	// Delete all views associated with website
-	err = h.analyticsDB.DeleteWebsite(ctx, params.Hostname)
+	admin, err := h.isAdmin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if !admin {
+		return ErrForbidden(model.ErrUserForbidden), nil
+	}
+
+	views, events, err := h.analyticsDB.CountWebsiteData(ctx, website.Hostname)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	now := time.Now().Unix()
+	deletion := &model.WebsiteDeletion{
+		Hostname:    website.Hostname,
+		UserID:      website.UserID,
+		Views:       views,
+		Events:      events,
+		DateCreated: now,
+		DateUpdated: now,
+	}
+
+	if params.DryRun.Or(false) {
+		resp := api.DeleteWebsitesIDOK(websiteDeletionToAPI(deletion))
+		return &resp, nil
+	}
+
+	// Delete website and queue the deletion of its data.
+	err = h.db.DeleteWebsite(ctx, deletion)
 	if err != nil {
 		if errors.Is(err, model.ErrWebsiteNotFound) {
 			return ErrNotFound(err), nil
@@ -58,20 +90,55 @@ func (h *Handler) DeleteWebsitesID(
 		return nil, errors.Wrap(err, "services")
 	}
 
-	// Delete website
-	err = h.db.DeleteWebsite(ctx, params.Hostname)
+	// Remove website from hostname cache to stop ingesting new hits.
+	h.hostnames.Remove(params.Hostname)
+	h.aliases.Remove(params.Hostname)
+
+	h.audit(ctx, model.AuditWebsiteDelete, website.Hostname, nil)
+
+	// Purge the page views and events in the background.
+	h.deletions.Notify()
+
+	// The webhooks of the website were deleted with it.
+	h.webhooks.Reload(ctx)
+
+	resp := api.DeleteWebsitesIDAccepted(websiteDeletionToAPI(deletion))
+
+	return &resp, nil
+}
+
+func (h *Handler) GetWebsitesIDDeletion(
+	ctx context.Context,
+	params api.GetWebsitesIDDeletionParams,
+) (api.GetWebsitesIDDeletionRes, error) {
+	// Get user ID from context
+	_, ok := ctx.Value(model.ContextKeyUserID).(string)
+	if !ok {
+		return ErrUnauthorised(model.ErrSessionNotFound), nil
+	}
+
+	deletion, err := h.db.GetWebsiteDeletion(ctx, params.Hostname)
 	if err != nil {
-		if errors.Is(err, model.ErrWebsiteNotFound) {
+		if errors.Is(err, model.ErrWebsiteDeletionNotFound) {
 			return ErrNotFound(err), nil
 		}
 
 		return nil, errors.Wrap(err, "services")
 	}
 
-	// Remove website from hostname cache
-	h.hostnames.Remove(params.Hostname)
+	// Only users who can delete websites can see their deletion.
+	admin, err := h.isAdmin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if !admin || !keyCanAccessWebsite(ctx, deletion.Hostname) {
+		return ErrNotFound(model.ErrWebsiteDeletionNotFound), nil
+	}
 
-	return &api.DeleteWebsitesIDNoContent{}, nil
+	resp := websiteDeletionToAPI(deletion)
+
+	return &resp, nil
 }
 
 func (h *Handler) GetWebsites(
@@ -93,6 +160,11 @@ func (h *Handler) GetWebsites(
 		return nil, errors.Wrap(err, "services")
 	}
 
+	// API keys scoped to a website only list that website.
+	websites = slices.DeleteFunc(websites, func(w *model.Website) bool {
+		return !keyCanAccessWebsite(ctx, w.Hostname)
+	})
+
 	// Map to API response
 	websitesGet := make([]api.WebsiteGet, 0, len(websites))
 
@@ -134,7 +206,7 @@ func (h *Handler) GetWebsitesID(
 	params api.GetWebsitesIDParams,
 ) (api.GetWebsitesIDRes, error) {
 	// Get user ID from context
-	userID, ok := ctx.Value(model.ContextKeyUserID).(string)
+	_, ok := ctx.Value(model.ContextKeyUserID).(string)
 	if !ok {
 		return ErrUnauthorised(model.ErrSessionNotFound), nil
 	}
@@ -148,14 +220,26 @@ func (h *Handler) GetWebsitesID(
 		return nil, errors.Wrap(err, "services")
 	}
 
-	if website.UserID != userID {
-		return ErrUnauthorised(model.ErrWebsiteNotFound), nil
+	err = h.authoriseWebsite(ctx, website.Hostname, false)
+	if err != nil {
+		if errors.Is(err, model.ErrWebsiteNotFound) {
+			return ErrUnauthorised(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
+	}
+
+	settings, err := h.db.GetWebsiteSettings(ctx, website.Hostname)
+	if err != nil {
+		if errors.Is(err, model.ErrWebsiteNotFound) {
+			return ErrNotFound(err), nil
+		}
+
+		return nil, errors.Wrap(err, "services")
 	}
 
 	return &api.WebsiteGetHeaders{
-		Response: api.WebsiteGet{
-			Hostname: website.Hostname,
-		},
+		Response: buildWebsiteResponse(website, settings),
 	}, nil
 }
 
@@ -171,7 +255,7 @@ func (h *Handler) PatchWebsitesID(
 	}
 
 	// Get user ID from context
-	userID, ok := ctx.Value(model.ContextKeyUserID).(string)
+	_, ok := ctx.Value(model.ContextKeyUserID).(string)
 	if !ok {
 		return ErrUnauthorised(model.ErrSessionNotFound), nil
 	}
@@ -185,19 +269,60 @@ func (h *Handler) PatchWebsitesID(
 		return nil, errors.Wrap(err, "services")
 	}
 
-	if website.UserID != userID {
-		return ErrUnauthorised(model.ErrWebsiteNotFound), nil
-	}
+	err = h.authoriseWebsite(ctx, website.Hostname, true)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrWebsiteNotFound):
+			return ErrUnauthorised(err), nil
+		case errors.Is(err, model.ErrUserForbidden):
+			return ErrForbidden(err), nil
+		}
 
-	// Update values
-	if req.Hostname.Value != "" {
-		website.Hostname = req.Hostname.Value
+		return nil, errors.Wrap(err, "services")
 	}
 
-	website.DateUpdated = time.Now().Unix()
+	changes := auditChanges{}
+	hostname := website.Hostname
+
+	// Rename the website or merge it into another website
+	if req.Hostname.Value != "" && req.Hostname.Value != website.Hostname {
+		// Scoped API keys can not merge into websites they can not access.
+		if req.Merge.Or(false) && !keyCanAccessWebsite(ctx, req.Hostname.Value) {
+			return ErrForbidden(model.ErrAPIKeyForbidden), nil
+		}
+
+		access, err := h.renameWebsite(
+			ctx, website, req.Hostname.Value, req.Merge.Or(false), req.MergeAccess.Or(false),
+		)
+		if err != nil {
+			switch {
+			case errors.Is(err, model.ErrWebsiteNotFound):
+				return ErrNotFound(err), nil
+			case errors.Is(err, model.ErrWebsiteExists),
+				errors.Is(err, model.ErrWebsiteDeletionPending):
+				return ErrConflict(err), nil
+			}
+
+			return nil, errors.Wrap(err, "services")
+		}
+
+		if access != nil {
+			changes.add("merged_into", "", website.Hostname)
+
+			// Record who gained or lost access with the merge.
+			if req.MergeAccess.Or(false) {
+				changes.addList("moved_members", nil, access.Members)
+				changes.addList("moved_api_keys", nil, access.APIKeys)
+			} else {
+				changes.addList("removed_members", access.Members, nil)
+				changes.addList("removed_api_keys", access.APIKeys, nil)
+			}
+		} else {
+			changes.add("hostname", hostname, website.Hostname)
+		}
+	}
 
-	// Update website
-	err = h.db.UpdateWebsite(ctx, website)
+	settings, err := h.db.GetWebsiteSettings(ctx, website.Hostname)
 	if err != nil {
 		if errors.Is(err, model.ErrWebsiteNotFound) {
 			return ErrNotFound(err), nil
@@ -206,20 +331,188 @@ func (h *Handler) PatchWebsitesID(
 		return nil, errors.Wrap(err, "services")
 	}
 
-	// If hostname was updated, remove old hostname from cache
-	// and add new hostname to cache
-	if req.Hostname.Value != "" {
-		h.hostnames.Remove(params.Hostname)
-		h.hostnames.Add(req.Hostname.Value)
+	retentionDays := retentionDaysString(settings.RetentionDays)
+	aliases := settings.Aliases
+
+	if req.RetentionDays.Set {
+		if v, ok := req.RetentionDays.Get(); ok {
+			settings.RetentionDays = &v
+		} else {
+			// Null removes the override.
+			settings.RetentionDays = nil
+		}
+	}
+
+	// An empty list removes all aliases.
+	if req.Aliases != nil {
+		settings.Aliases, err = h.parseAliases(website.Hostname, req.Aliases)
+		if err != nil {
+			switch {
+			case errors.Is(err, model.ErrInvalidAlias):
+				return ErrBadRequest(err), nil
+			case errors.Is(err, model.ErrAliasExists):
+				return ErrConflict(err), nil
+			}
+
+			return nil, errors.Wrap(err, "services")
+		}
+	}
+
+	if req.RetentionDays.Set || req.Aliases != nil {
+		err = h.db.UpdateWebsiteSettings(ctx, website.Hostname, settings)
+		if err != nil {
+			if errors.Is(err, model.ErrWebsiteNotFound) {
+				return ErrNotFound(err), nil
+			}
+
+			return nil, errors.Wrap(err, "services")
+		}
+
+		h.aliases.Set(website.Hostname, settings.Aliases)
+
+		changes.add("retention_days", retentionDays, retentionDaysString(settings.RetentionDays))
+		changes.addList("aliases", aliases, settings.Aliases)
+	}
+
+	if len(changes) > 0 {
+		h.audit(ctx, model.AuditWebsiteUpdate, hostname, changes)
 	}
 
 	return &api.WebsiteGetHeaders{
-		Response: api.WebsiteGet{
-			Hostname: website.Hostname,
-		},
+		Response: buildWebsiteResponse(website, settings),
 	}, nil
 }
 
+// renameWebsite moves the website and all of its page views and events to
+// the new hostname. If a website with the new hostname exists, the website is
+// merged into it when merge is set. The access of a merged website is only
+// moved when mergeAccess is set and is returned to be recorded.
+//
+// The website is updated within the transaction that moves the analytics
+// data, so a failure to update the website leaves the data in place. Aliases
+// move with the website unless it is merged, in which case they are removed.
+func (h *Handler) renameWebsite(
+	ctx context.Context,
+	website *model.Website,
+	newHostname string,
+	merge bool,
+	mergeAccess bool,
+) (*model.WebsiteAccess, error) {
+	log := logger.Get()
+	hostname := website.Hostname
+
+	target, err := h.db.GetWebsite(ctx, newHostname)
+	switch {
+	case err == nil:
+		// Only websites of the same user can be merged.
+		if !merge || target.UserID != website.UserID {
+			return nil, model.ErrWebsiteExists
+		}
+	case errors.Is(err, model.ErrWebsiteNotFound):
+		merge = false
+	default:
+		return nil, err
+	}
+
+	_, err = h.db.GetWebsiteDeletion(ctx, newHostname)
+	if err == nil {
+		return nil, model.ErrWebsiteDeletionPending
+	} else if !errors.Is(err, model.ErrWebsiteDeletionNotFound) {
+		return nil, err
+	}
+
+	settings, err := h.db.GetWebsiteSettings(ctx, hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stop accepting hits for the old hostname and write any queued hits, so
+	// no rows are left behind.
+	h.hostnames.Remove(hostname)
+	h.aliases.Remove(hostname)
+
+	restore := func() {
+		h.hostnames.Add(hostname)
+		h.aliases.Set(hostname, settings.Aliases)
+	}
+
+	err = h.ingester.Flush(ctx)
+	if err != nil {
+		restore()
+		return nil, errors.Wrap(err, "flush ingester")
+	}
+
+	var (
+		access  *model.WebsiteAccess
+		updated bool
+	)
+
+	views, events, err := h.analyticsDB.RenameWebsite(ctx, hostname, newHostname, func() error {
+		var err error
+		if merge {
+			access, err = h.db.MergeWebsite(ctx, hostname, newHostname, mergeAccess)
+		} else {
+			err = h.db.UpdateWebsite(ctx, hostname, &model.Website{
+				Hostname:    newHostname,
+				DateUpdated: time.Now().Unix(),
+			})
+		}
+
+		updated = err == nil
+
+		return err
+	})
+	if err != nil && !updated {
+		restore()
+		return nil, err
+	}
+
+	if err != nil {
+		// The website was updated but moving the data could not be committed,
+		// so the data is moved again on its own as the website can not be
+		// restored after a merge.
+		log.Warn().
+			Str("hostname", hostname).
+			Str("new_hostname", newHostname).
+			Err(err).
+			Msg("website updated but analytics data not moved, retrying")
+
+		views, events, err = h.analyticsDB.RenameWebsite(ctx, hostname, newHostname, nil)
+		if err != nil {
+			log.Error().
+				Str("hostname", hostname).
+				Str("new_hostname", newHostname).
+				Err(err).
+				Msg("website updated but analytics data not moved")
+		}
+	}
+
+	h.hostnames.Add(newHostname)
+
+	// The webhooks of the website moved to the new hostname.
+	h.webhooks.Reload(ctx)
+
+	if !merge {
+		h.aliases.Set(newHostname, settings.Aliases)
+	}
+
+	website.Hostname = newHostname
+
+	if err != nil {
+		return access, err
+	}
+
+	log.Info().
+		Str("hostname", hostname).
+		Str("new_hostname", newHostname).
+		Bool("merge", merge).
+		Int64("views", views).
+		Int64("events", events).
+		Msg("renamed website")
+
+	return access, nil
+}
+
 func (h *Handler) PostWebsites(
 	ctx context.Context,
 	req *api.WebsiteCreate,
@@ -236,6 +529,19 @@ func (h *Handler) PostWebsites(
 		return ErrUnauthorised(model.ErrSessionNotFound), nil
 	}
 
+	if isScopedAPIKey(ctx) {
+		return ErrForbidden(model.ErrAPIKeyForbidden), nil
+	}
+
+	admin, err := h.isAdmin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "services")
+	}
+
+	if !admin {
+		return ErrForbidden(model.ErrUserForbidden), nil
+	}
+
 	// Create website
 	dateCreated := time.Now().Unix()
 	websiteCreate := model.NewWebsite(
@@ -245,9 +551,10 @@ func (h *Handler) PostWebsites(
 		dateCreated,
 	)
 
-	err := h.db.CreateWebsite(ctx, websiteCreate)
+	err = h.db.CreateWebsite(ctx, websiteCreate)
 	if err != nil {
-		if errors.Is(err, model.ErrWebsiteExists) {
+		if errors.Is(err, model.ErrWebsiteExists) ||
+			errors.Is(err, model.ErrWebsiteDeletionPending) {
 			return ErrConflict(err), nil
 		}
 
@@ -257,9 +564,87 @@ func (h *Handler) PostWebsites(
 	// Add hostname to cache
 	h.hostnames.Add(req.Hostname)
 
+	h.audit(ctx, model.AuditWebsiteCreate, req.Hostname, nil)
+
 	return &api.WebsiteGetHeaders{
 		Response: api.WebsiteGet{
 			Hostname: req.Hostname,
 		},
 	}, nil
 }
+
+// retentionDaysString formats the retention override of a website for the
+// audit log, which is empty if the tenant retention period is used.
+func retentionDaysString(days *int) string {
+	if days == nil {
+		return ""
+	}
+
+	return strconv.Itoa(*days)
+}
+
+func buildWebsiteResponse(website *model.Website, settings *model.WebsiteSettings) api.WebsiteGet {
+	resp := api.WebsiteGet{
+		Hostname: website.Hostname,
+	}
+
+	if settings.RetentionDays != nil {
+		resp.RetentionDays = api.NewOptInt(*settings.RetentionDays)
+	}
+
+	if len(settings.Aliases) > 0 {
+		resp.Aliases = settings.Aliases
+	}
+
+	return resp
+}
+
+// parseAliases validates the aliases of the website and returns them
+// normalised without duplicates. An alias can not be the hostname of a
+// website or an alias of another website.
+func (h *Handler) parseAliases(hostname string, aliases []string) ([]string, error) {
+	if len(aliases) > util.MaxWebsiteAliases {
+		return nil, model.ErrInvalidAlias
+	}
+
+	parsed := make([]string, 0, len(aliases))
+
+	for _, alias := range aliases {
+		alias, err := util.ParseAlias(alias)
+		if err != nil {
+			return nil, err
+		}
+
+		if alias == hostname {
+			return nil, model.ErrInvalidAlias
+		}
+
+		if slices.Contains(parsed, alias) {
+			continue
+		}
+
+		if h.hostnames.Has(alias) {
+			return nil, model.ErrAliasExists
+		}
+
+		if owner, ok := h.aliases.Owner(alias); ok && owner != hostname {
+			return nil, model.ErrAliasExists
+		}
+
+		parsed = append(parsed, alias)
+	}
+
+	return parsed, nil
+}
+
+func websiteDeletionToAPI(deletion *model.WebsiteDeletion) api.WebsiteDeletionHeaders {
+	return api.WebsiteDeletionHeaders{
+		Response: api.WebsiteDeletion{
+			Hostname:      deletion.Hostname,
+			Views:         deletion.Views,
+			Events:        deletion.Events,
+			DeletedViews:  deletion.DeletedViews,
+			DeletedEvents: deletion.DeletedEvents,
+		},
+	}
+}
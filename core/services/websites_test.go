@@ -0,0 +1,183 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/medama-io/medama/api"
+	"github.com/medama-io/medama/metest"
+	"github.com/medama-io/medama/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchWebsitesIDRename(t *testing.T) {
+	assert, ctx, handler, sqliteClient := metest.NewTestHandler(t)
+	require := require.New(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	ctx = context.WithValue(ctx, model.ContextKeyUserID, user.ID)
+
+	for _, hostname := range []string{"www.example.com", "example.com", "other.example.com"} {
+		_, err = handler.PostWebsites(ctx, &api.WebsiteCreate{Hostname: hostname})
+		require.NoError(err)
+	}
+
+	require.NoError(sqliteClient.CreateGoal(ctx, model.NewGoal(
+		"goal1", "www.example.com", "Signup", model.GoalTypeEvent, "", "signup", "", 1, 1,
+	)))
+
+	// Renaming onto an existing website requires merge to be set.
+	resp, err := handler.PatchWebsitesID(ctx, &api.WebsitePatch{
+		Hostname: api.NewOptString("example.com"),
+	}, api.PatchWebsitesIDParams{Hostname: "www.example.com"})
+	require.NoError(err)
+
+	_, ok := resp.(*api.ConflictErrorHeaders)
+	assert.True(ok)
+
+	resp, err = handler.PatchWebsitesID(ctx, &api.WebsitePatch{
+		Hostname: api.NewOptString("new.example.com"),
+	}, api.PatchWebsitesIDParams{Hostname: "www.example.com"})
+	require.NoError(err)
+
+	website, ok := resp.(*api.WebsiteGetHeaders)
+	require.True(ok)
+	assert.Equal("new.example.com", website.Response.Hostname)
+
+	_, err = sqliteClient.GetWebsite(ctx, "www.example.com")
+	require.ErrorIs(err, model.ErrWebsiteNotFound)
+
+	goal, err := sqliteClient.GetGoal(ctx, "goal1")
+	require.NoError(err)
+	assert.Equal("new.example.com", goal.Hostname)
+
+	require.NoError(sqliteClient.CreateAPIKey(ctx, model.NewAPIKey(
+		"key1", user.ID, "CI", "hash", model.APIKeyRoleRead, "new.example.com", 0, 1, 1,
+	)))
+
+	// Merging keeps the goals and removes the merged website.
+	resp, err = handler.PatchWebsitesID(ctx, &api.WebsitePatch{
+		Hostname: api.NewOptString("example.com"),
+		Merge:    api.NewOptBool(true),
+	}, api.PatchWebsitesIDParams{Hostname: "new.example.com"})
+	require.NoError(err)
+
+	website, ok = resp.(*api.WebsiteGetHeaders)
+	require.True(ok)
+	assert.Equal("example.com", website.Response.Hostname)
+
+	_, err = sqliteClient.GetWebsite(ctx, "new.example.com")
+	require.ErrorIs(err, model.ErrWebsiteNotFound)
+
+	goal, err = sqliteClient.GetGoal(ctx, "goal1")
+	require.NoError(err)
+	assert.Equal("example.com", goal.Hostname)
+
+	// Scoped API keys of the merged website are removed and recorded.
+	_, err = sqliteClient.GetAPIKey(ctx, "key1")
+	require.ErrorIs(err, model.ErrAPIKeyNotFound)
+
+	entries, err := sqliteClient.ListAuditEntries(ctx, model.AuditFilter{Target: "new.example.com", Limit: 10})
+	require.NoError(err)
+	require.NotEmpty(entries)
+	assert.Contains(entries[0].Changes, model.AuditChange{Field: "removed_api_keys", Before: "key1"})
+
+	websites, err := sqliteClient.ListWebsites(ctx, user.ID)
+	require.NoError(err)
+	assert.Len(websites, 2)
+}
+
+func TestPatchWebsitesIDAliases(t *testing.T) {
+	assert, ctx, handler, sqliteClient := metest.NewTestHandler(t)
+	require := require.New(t)
+
+	user, err := sqliteClient.GetUserByUsername(ctx, "admin")
+	require.NoError(err)
+
+	ctx = context.WithValue(ctx, model.ContextKeyUserID, user.ID)
+
+	for _, hostname := range []string{"example.com", "other.com"} {
+		_, err = handler.PostWebsites(ctx, &api.WebsiteCreate{Hostname: hostname})
+		require.NoError(err)
+	}
+
+	resp, err := handler.PatchWebsitesID(ctx, &api.WebsitePatch{
+		Aliases: []string{"WWW.example.com", "*.vercel.app", "www.example.com"},
+	}, api.PatchWebsitesIDParams{Hostname: "example.com"})
+	require.NoError(err)
+
+	website, ok := resp.(*api.WebsiteGetHeaders)
+	require.True(ok)
+	assert.Equal([]string{"www.example.com", "*.vercel.app"}, website.Response.Aliases)
+
+	settings, err := sqliteClient.GetWebsiteSettings(ctx, "example.com")
+	require.NoError(err)
+	assert.Equal([]string{"www.example.com", "*.vercel.app"}, settings.Aliases)
+
+	// Aliases can not be invalid, another website or an alias of another website.
+	testCases := []struct {
+		hostname string
+		alias    string
+		expected any
+	}{
+		{"other.com", "*.com", &api.BadRequestErrorHeaders{}},
+		{"example.com", "example.com", &api.BadRequestErrorHeaders{}},
+		{"example.com", "other.com", &api.ConflictErrorHeaders{}},
+		{"other.com", "*.vercel.app", &api.ConflictErrorHeaders{}},
+		{"other.com", "www.example.com", &api.ConflictErrorHeaders{}},
+	}
+
+	for _, tc := range testCases {
+		resp, err = handler.PatchWebsitesID(ctx, &api.WebsitePatch{
+			Aliases: []string{tc.alias},
+		}, api.PatchWebsitesIDParams{Hostname: tc.hostname})
+		require.NoError(err)
+		assert.IsType(tc.expected, resp, tc.alias)
+	}
+
+	// Hits received on an alias are recorded under the website.
+	hit := func(group string) api.PostEventHitRes {
+		req := httptest.NewRequest(http.MethodPost, "/event/hit", nil)
+		req.Header.Set("X-Forwarded-For", "203.0.113.1")
+
+		res, err := handler.PostEventHit(
+			context.WithValue(ctx, model.RequestKeyBody, req),
+			api.NewEventCustomEventHit(api.EventCustom{
+				G: group,
+				D: api.EventCustomD{"plan": {Type: api.StringEventCustomDItem, String: "pro"}},
+			}),
+			api.PostEventHitParams{},
+		)
+		require.NoError(err)
+
+		return res
+	}
+
+	assert.IsType(&api.PostEventHitNoContent{}, hit("www.example.com"))
+	assert.IsType(&api.PostEventHitNoContent{}, hit("my-app.vercel.app"))
+	assert.IsType(&api.NotFoundErrorHeaders{}, hit("example.org"))
+
+	// Renaming the website moves its aliases.
+	_, err = handler.PatchWebsitesID(ctx, &api.WebsitePatch{
+		Hostname: api.NewOptString("example.org"),
+	}, api.PatchWebsitesIDParams{Hostname: "example.com"})
+	require.NoError(err)
+
+	assert.IsType(&api.PostEventHitNoContent{}, hit("www.example.com"))
+
+	// An empty list removes all aliases.
+	resp, err = handler.PatchWebsitesID(ctx, &api.WebsitePatch{
+		Aliases: []string{},
+	}, api.PatchWebsitesIDParams{Hostname: "example.org"})
+	require.NoError(err)
+
+	website, ok = resp.(*api.WebsiteGetHeaders)
+	require.True(ok)
+	assert.Empty(website.Response.Aliases)
+
+	assert.IsType(&api.NotFoundErrorHeaders{}, hit("www.example.com"))
+}
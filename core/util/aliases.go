@@ -0,0 +1,171 @@
+package util
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/medama-io/medama/model"
+)
+
+const (
+	// MaxWebsiteAliases is the maximum number of aliases a website can have.
+	MaxWebsiteAliases = 50
+
+	// wildcardPrefix is the prefix of an alias matching any subdomain.
+	wildcardPrefix = "*."
+	// maxHostnameLength is the maximum length of a fully qualified domain name.
+	maxHostnameLength = 253
+)
+
+// wildcardAlias matches any hostname ending with the suffix.
+type wildcardAlias struct {
+	// suffix includes the leading dot, e.g. ".vercel.app".
+	suffix   string
+	hostname string
+}
+
+// AliasStore resolves the hostname of an incoming hit to the website it
+// belongs to. A website can have exact aliases such as www.example.com and
+// wildcard aliases such as *.example.com, which match any subdomain at any
+// depth but not example.com itself.
+//
+// When several wildcards match, the most specific one wins.
+type AliasStore struct {
+	mu sync.RWMutex
+	// exact maps an exact alias to the hostname of its website.
+	exact map[string]string
+	// wildcards is sorted by the longest suffix first.
+	wildcards []wildcardAlias
+}
+
+// NewAliasStore returns a new empty instance of AliasStore.
+func NewAliasStore() *AliasStore {
+	return &AliasStore{
+		exact: make(map[string]string),
+	}
+}
+
+// ParseAlias validates an alias and returns it in its normalised lowercase
+// form. Wildcards must be the leftmost label and cover at least two labels,
+// so *.example.com is allowed but *.com is not.
+func ParseAlias(alias string) (string, error) {
+	alias = strings.ToLower(strings.TrimSpace(alias))
+	hostname := strings.TrimPrefix(alias, wildcardPrefix)
+
+	if hostname == "" || len(alias) > maxHostnameLength {
+		return "", model.ErrInvalidAlias
+	}
+
+	labels := strings.Split(hostname, ".")
+	if hostname != alias && len(labels) < 2 {
+		return "", model.ErrInvalidAlias
+	}
+
+	for _, label := range labels {
+		if !isHostnameLabel(label) {
+			return "", model.ErrInvalidAlias
+		}
+	}
+
+	return alias, nil
+}
+
+// isHostnameLabel reports whether the label is a valid hostname label.
+func isHostnameLabel(label string) bool {
+	if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+
+	for _, r := range label {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Set replaces all aliases of the website.
+func (s *AliasStore) Set(hostname string, aliases []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.remove(hostname)
+
+	for _, alias := range aliases {
+		if suffix, ok := strings.CutPrefix(alias, wildcardPrefix); ok {
+			s.wildcards = append(s.wildcards, wildcardAlias{
+				suffix:   "." + suffix,
+				hostname: hostname,
+			})
+		} else {
+			s.exact[alias] = hostname
+		}
+	}
+
+	slices.SortStableFunc(s.wildcards, func(a, b wildcardAlias) int {
+		return cmp.Compare(len(b.suffix), len(a.suffix))
+	})
+}
+
+// Remove removes all aliases of the website.
+func (s *AliasStore) Remove(hostname string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.remove(hostname)
+}
+
+func (s *AliasStore) remove(hostname string) {
+	for alias, h := range s.exact {
+		if h == hostname {
+			delete(s.exact, alias)
+		}
+	}
+
+	s.wildcards = slices.DeleteFunc(s.wildcards, func(w wildcardAlias) bool {
+		return w.hostname == hostname
+	})
+}
+
+// Owner returns the hostname of the website that has the alias, comparing
+// wildcards as written rather than matching them.
+func (s *AliasStore) Owner(alias string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if suffix, ok := strings.CutPrefix(alias, wildcardPrefix); ok {
+		for _, w := range s.wildcards {
+			if w.suffix == "."+suffix {
+				return w.hostname, true
+			}
+		}
+
+		return "", false
+	}
+
+	hostname, ok := s.exact[alias]
+
+	return hostname, ok
+}
+
+// Resolve returns the hostname of the website the given hostname is an alias
+// of. Exact aliases take precedence over wildcards.
+func (s *AliasStore) Resolve(hostname string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if website, ok := s.exact[hostname]; ok {
+		return website, true
+	}
+
+	for _, w := range s.wildcards {
+		if strings.HasSuffix(hostname, w.suffix) {
+			return w.hostname, true
+		}
+	}
+
+	return "", false
+}
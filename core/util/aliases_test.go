@@ -0,0 +1,78 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util"
+)
+
+func TestParseAlias(t *testing.T) {
+	assert, require, _ := SetupCacheTest(t)
+
+	valid := map[string]string{
+		"www.example.com":   "www.example.com",
+		" Docs.Example.COM": "docs.example.com",
+		"*.vercel.app":      "*.vercel.app",
+		"localhost":         "localhost",
+	}
+
+	for alias, expected := range valid {
+		parsed, err := util.ParseAlias(alias)
+		require.NoError(err, alias)
+		assert.Equal(expected, parsed)
+	}
+
+	for _, alias := range []string{"", "*.", "*.com", "*", "a.*.example.com", "-a.example.com", "example..com", "exa_mple.com"} {
+		_, err := util.ParseAlias(alias)
+		require.ErrorIs(err, model.ErrInvalidAlias, alias)
+	}
+}
+
+func TestAliasStore(t *testing.T) {
+	assert, _, _ := SetupCacheTest(t)
+
+	aliases := util.NewAliasStore()
+	aliases.Set("example.com", []string{"www.example.com", "*.example.com"})
+	aliases.Set("preview.example.com", []string{"*.preview.example.com", "*.vercel.app"})
+
+	for hostname, expected := range map[string]string{
+		"www.example.com":          "example.com",
+		"docs.example.com":         "example.com",
+		"a.b.example.com":          "example.com",
+		"pr-1.preview.example.com": "preview.example.com",
+		"my-app.vercel.app":        "preview.example.com",
+	} {
+		website, ok := aliases.Resolve(hostname)
+		assert.True(ok, hostname)
+		assert.Equal(expected, website, hostname)
+	}
+
+	// Wildcards do not match the domain itself.
+	for _, hostname := range []string{"example.com", "vercel.app", "example.org", "notexample.com"} {
+		_, ok := aliases.Resolve(hostname)
+		assert.False(ok, hostname)
+	}
+
+	owner, ok := aliases.Owner("*.vercel.app")
+	assert.True(ok)
+	assert.Equal("preview.example.com", owner)
+
+	_, ok = aliases.Owner("*.app")
+	assert.False(ok)
+
+	// Setting the aliases replaces the previous ones.
+	aliases.Set("example.com", []string{"www.example.com"})
+
+	_, ok = aliases.Resolve("docs.example.com")
+	assert.False(ok)
+
+	aliases.Remove("example.com")
+
+	_, ok = aliases.Resolve("www.example.com")
+	assert.False(ok)
+
+	website, ok := aliases.Resolve("my-app.vercel.app")
+	assert.True(ok)
+	assert.Equal("preview.example.com", website)
+}
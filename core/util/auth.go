@@ -5,7 +5,9 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"net/http"
@@ -15,41 +17,142 @@ import (
 	"github.com/alexedwards/argon2id"
 	"github.com/go-faster/errors"
 	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/oidc"
+	"github.com/medama-io/medama/util/logger"
 	"go.jetify.com/typeid"
 )
 
 const (
 	// DefaultCipherKeySize is the default size of the cipher key.
 	DefaultCipherKeySize = 32
+	// APIKeySecretSize is the number of random bytes in an API key secret.
+	APIKeySecretSize = 32
+	// apiKeySeparator separates the ID and secret of an API key token.
+	apiKeySeparator = "."
+	// maxUserAgentLength is the maximum length of a user agent stored with a
+	// session.
+	maxUserAgentLength = 512
 )
 
+// APIKeyStore retrieves API keys and records their usage.
+type APIKeyStore interface {
+	GetAPIKey(ctx context.Context, id string) (*model.APIKey, error)
+	UpdateAPIKeyLastUsed(ctx context.Context, id string, dateLastUsed int64) error
+}
+
 type AuthService struct {
-	// Cache used to store session tokens.
+	// Cache used to store session tokens when sessions are not persisted.
 	Cache *Cache
+	// Store used to create, read and revoke sessions.
+	sessions SessionStore
+	// Cache of recently verified API key secrets, as hashing with argon2id
+	// on every request is too expensive.
+	apiKeys *Cache
 	// Key used to encrypt session tokens.
 	aes32Key []byte
+	// Key used to encrypt TOTP secrets. Two-factor authentication can not be
+	// enabled without it.
+	totpKey []byte
+	// Pending logins waiting for a two-factor authentication code or a
+	// callback from the identity provider.
+	challenges *Cache
+	// Identity provider used for single sign-on, if configured.
+	oidc *oidc.Provider
+	// Whether users can log in with their password.
+	passwordLogin bool
+	// Limits of failed logins before usernames and IP prefixes are locked
+	// out, enforced by limiter.
+	loginLimits LoginLimits
+	limiter     *LoginLimiter
+	// Clock used to validate TOTP codes and login challenges.
+	now func() time.Time
 	// Demo mode flag.
 	IsDemoMode bool
 }
 
-// NewAuthService returns a new instance of AuthService.
-func NewAuthService(ctx context.Context, isDemoMode bool) (*AuthService, error) {
-	// Generate a new random key for encrypting session tokens.
-	// Since we store sessions in an in-memory cache, it doesn't
-	// matter if the key doesn't persist as sessions will be
-	// invalidated when the server restarts.
-	key := make([]byte, DefaultCipherKeySize)
+// AuthOption configures an AuthService.
+type AuthOption func(*AuthService)
+
+// WithSessionStore stores sessions in the given store instead of the
+// in-memory cache, encrypting session tokens with key. For sessions to
+// survive restarts, the key must be persisted or derived from a configured
+// secret as well.
+func WithSessionStore(store SessionStore, key []byte) AuthOption {
+	return func(a *AuthService) {
+		a.sessions = store
+		a.aes32Key = key
+	}
+}
 
-	_, err := rand.Read(key)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to generate cipher key")
+// WithTOTPKey encrypts TOTP secrets with key. The key must be derived from a
+// configured secret with DeriveTOTPKey, as two-factor authentication can not
+// be verified without it.
+func WithTOTPKey(key []byte) AuthOption {
+	return func(a *AuthService) {
+		a.totpKey = key
 	}
+}
+
+// WithClock replaces the clock used to validate TOTP codes and login
+// challenges.
+func WithClock(now func() time.Time) AuthOption {
+	return func(a *AuthService) {
+		a.now = now
+	}
+}
 
-	return &AuthService{
-		aes32Key:   key,
+// WithLoginLimits replaces the default limits of failed logins before
+// usernames and IP prefixes are locked out.
+func WithLoginLimits(limits LoginLimits) AuthOption {
+	return func(a *AuthService) {
+		a.loginLimits = limits
+	}
+}
+
+// NewAuthService returns a new instance of AuthService.
+func NewAuthService(ctx context.Context, isDemoMode bool, opts ...AuthOption) (*AuthService, error) {
+	auth := &AuthService{
 		Cache:      NewCache(ctx, model.SessionDuration),
+		apiKeys:    NewCache(ctx, model.APIKeyVerifiedDuration),
+		challenges: NewCache(ctx, model.LoginChallengeDuration),
+		now:        time.Now,
 		IsDemoMode: isDemoMode,
-	}, nil
+
+		passwordLogin: true,
+		loginLimits:   DefaultLoginLimits(),
+	}
+
+	// By default, sessions are stored in the in-memory cache. Since they are
+	// invalidated when the server restarts, it doesn't matter if the random
+	// key doesn't persist either.
+	auth.sessions = &memorySessionStore{cache: auth.Cache}
+
+	for _, opt := range opts {
+		opt(auth)
+	}
+
+	if auth.aes32Key == nil {
+		key, err := GenerateCipherKey()
+		if err != nil {
+			return nil, err
+		}
+
+		auth.aes32Key = key
+	}
+
+	if len(auth.aes32Key) != DefaultCipherKeySize ||
+		(auth.totpKey != nil && len(auth.totpKey) != DefaultCipherKeySize) {
+		return nil, errors.Errorf("cipher key must be %d bytes", DefaultCipherKeySize)
+	}
+
+	auth.limiter = NewLoginLimiter(auth.loginLimits, auth.now)
+
+	return auth, nil
+}
+
+// LoginLimiter returns the limiter locking out failed logins.
+func (a *AuthService) LoginLimiter() *LoginLimiter {
+	return a.limiter
 }
 
 // HashPassword hashes a password using argon.
@@ -151,11 +254,15 @@ func (a *AuthService) DecryptSession(_ context.Context, session string) (string,
 	return value, nil
 }
 
-// CreateSession creates a new session token and stores it in the cache.
-// This returns an encrypted session token as a cookie.
-func (a *AuthService) CreateSession(ctx context.Context, userID string) (*http.Cookie, error) {
+// CreateSession creates a new session token and stores it in the session
+// store. This returns an encrypted session token as a cookie.
+func (a *AuthService) CreateSession(
+	ctx context.Context,
+	userID string,
+	userAgent string,
+) (*http.Cookie, error) {
 	// Generate session token.
-	sessionIDType, err := typeid.WithPrefix("sess")
+	sessionIDType, err := typeid.WithPrefix(model.SessionPrefix)
 	if err != nil {
 		return nil, errors.Wrap(err, "auth: session")
 	}
@@ -173,42 +280,189 @@ func (a *AuthService) CreateSession(ctx context.Context, userID string) (*http.C
 
 	// Encrypt session token.
 	encryptedSession, err := a.EncryptSession(ctx, sessionID, model.SessionDuration)
+	if err != nil {
+		return nil, err
+	}
 
 	// Update cookie value with encrypted base64 enoded session token.
 	encodedSession := base64.URLEncoding.EncodeToString([]byte(encryptedSession))
 	cookie.Value = encodedSession
 
-	// Set session token in cache.
-	a.Cache.Set(sessionID, userID, model.SessionDuration)
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+
+	// Store session.
+	now := time.Now()
+
+	err = a.sessions.CreateSession(ctx, model.NewSession(
+		sessionID,
+		userID,
+		userAgent,
+		now.Add(model.SessionDuration).Unix(),
+		now.Unix(),
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, "auth: session")
+	}
 
-	return cookie, err
+	return cookie, nil
 }
 
-// ReadSession decrypts and reads a session token from the cache.
+// ReadSession decrypts and reads a session token from the session store.
 // This returns the user id associated with the encrypted session value.
 func (a *AuthService) ReadSession(ctx context.Context, session string) (string, error) {
+	s, err := a.LoadSession(ctx, session)
+	if err != nil {
+		return "", err
+	}
+
+	return s.UserID, nil
+}
+
+// LoadSession decrypts a session token and returns the session it belongs to.
+func (a *AuthService) LoadSession(ctx context.Context, session string) (*model.Session, error) {
 	// Decode base64 encoded session token.
 	encryptedSession, err := base64.URLEncoding.DecodeString(session)
 	if err != nil {
-		return "", model.ErrInvalidSession
+		return nil, model.ErrInvalidSession
 	}
 
 	// Decrypt session token.
 	sessionID, err := a.DecryptSession(ctx, string(encryptedSession))
 	if err != nil {
-		return "", errors.Wrap(err, "session")
+		return nil, errors.Wrap(err, "session")
 	}
 
 	// Check if session exists.
-	userID, err := a.Cache.Get(ctx, sessionID)
+	s, err := a.sessions.GetSession(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, model.ErrSessionNotFound) {
+			return nil, model.ErrSessionNotFound
+		}
+
+		return nil, errors.Wrap(err, "session")
+	}
+
+	if s.IsExpired(time.Now()) {
+		return nil, model.ErrSessionNotFound
+	}
+
+	return s, nil
+}
+
+// ListSessions returns the active sessions of a user.
+func (a *AuthService) ListSessions(ctx context.Context, userID string) ([]*model.Session, error) {
+	sessions, err := a.sessions.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "session")
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession deletes a session token from the session store.
+func (a *AuthService) RevokeSession(ctx context.Context, sessionID string) error {
+	err := a.sessions.DeleteSession(ctx, sessionID)
 	if err != nil {
-		return "", model.ErrSessionNotFound
+		return errors.Wrap(err, "session")
 	}
 
-	return userID.(string), nil
+	return nil
 }
 
-// RevokeSession deletes a session token from the cache.
-func (a *AuthService) RevokeSession(_ctx context.Context, sessionID string) {
-	a.Cache.Delete(sessionID)
+// RevokeUserSessions deletes all session tokens of a user from the session
+// store.
+func (a *AuthService) RevokeUserSessions(ctx context.Context, userID string) error {
+	err := a.sessions.DeleteUserSessions(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "session")
+	}
+
+	return nil
+}
+
+// CreateAPIKey generates a new API key. This returns the ID of the key, the
+// token given to the user and the hash of its secret to store.
+func (a *AuthService) CreateAPIKey() (string, string, string, error) {
+	idType, err := typeid.WithPrefix(model.APIKeyPrefix)
+	if err != nil {
+		return "", "", "", errors.Wrap(err, "auth: api key")
+	}
+
+	id := idType.String()
+
+	secretBytes := make([]byte, APIKeySecretSize)
+
+	_, err = rand.Read(secretBytes)
+	if err != nil {
+		return "", "", "", errors.Wrap(err, "auth: api key")
+	}
+
+	secret := base64.RawURLEncoding.EncodeToString(secretBytes)
+
+	hash, err := a.HashPassword(secret)
+	if err != nil {
+		return "", "", "", errors.Wrap(err, "auth: api key")
+	}
+
+	return id, id + apiKeySeparator + secret, hash, nil
+}
+
+// ReadAPIKey verifies an API key token and returns the key it belongs to.
+// The key is looked up on every call so revoked keys are rejected
+// immediately, while the secret is only hashed again once the verification
+// cache expires. The last used date is recorded at most once per
+// model.APIKeyLastUsedInterval.
+func (a *AuthService) ReadAPIKey(ctx context.Context, store APIKeyStore, token string) (*model.APIKey, error) {
+	id, secret, ok := strings.Cut(token, apiKeySeparator)
+	if !ok || id == "" || secret == "" {
+		return nil, model.ErrInvalidAPIKey
+	}
+
+	key, err := store.GetAPIKey(ctx, id)
+	if err != nil {
+		if errors.Is(err, model.ErrAPIKeyNotFound) {
+			return nil, model.ErrInvalidAPIKey
+		}
+
+		return nil, errors.Wrap(err, "auth: api key")
+	}
+
+	now := time.Now()
+	if key.IsExpired(now) {
+		return nil, model.ErrInvalidAPIKey
+	}
+
+	// The cached hash must match the stored hash, so a key that was deleted
+	// and recreated with the same ID is not accepted with the old secret.
+	sum := sha256.Sum256([]byte(token))
+	cacheKey := hex.EncodeToString(sum[:])
+
+	cached, err := a.apiKeys.Get(ctx, cacheKey)
+	if err != nil || cached.(string) != key.Hash {
+		match, err := a.ComparePasswords(secret, key.Hash)
+		if err != nil {
+			return nil, errors.Wrap(err, "auth: api key")
+		}
+
+		if !match {
+			return nil, model.ErrInvalidAPIKey
+		}
+
+		a.apiKeys.Set(cacheKey, key.Hash, model.APIKeyVerifiedDuration)
+	}
+
+	if now.Sub(time.Unix(key.DateLastUsed, 0)) >= model.APIKeyLastUsedInterval {
+		key.DateLastUsed = now.Unix()
+
+		// Failing to record usage should not reject a valid key.
+		err = store.UpdateAPIKeyLastUsed(ctx, key.ID, key.DateLastUsed)
+		if err != nil {
+			log := logger.Get()
+			log.Error().Str("id", key.ID).Err(err).Msg("failed to record api key usage")
+		}
+	}
+
+	return key, nil
 }
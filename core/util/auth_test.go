@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/base64"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/medama-io/medama/model"
 	"github.com/medama-io/medama/util"
@@ -20,7 +22,10 @@ func SetupAuthTest(
 	require := require.New(t)
 	ctx := t.Context()
 
-	auth, err := util.NewAuthService(ctx, false)
+	totpKey, err := util.GenerateCipherKey()
+	require.NoError(err)
+
+	auth, err := util.NewAuthService(ctx, false, util.WithTOTPKey(totpKey))
 	require.NoError(err)
 	assert.NotNil(auth)
 
@@ -31,7 +36,7 @@ func TestAuthCreateAndRead(t *testing.T) {
 	assert, require, ctx, auth := SetupAuthTest(t)
 
 	// We don't want the cookie to expire
-	cookie, err := auth.CreateSession(ctx, "test_user_id")
+	cookie, err := auth.CreateSession(ctx, "test_user_id", "")
 	require.NoError(err)
 	assert.NotNil(cookie)
 
@@ -51,7 +56,7 @@ func TestAuthWithInvalidSession(t *testing.T) {
 	assert, require, ctx, auth := SetupAuthTest(t)
 
 	// We don't want the cookie to expire
-	cookie, err := auth.CreateSession(ctx, "test_user")
+	cookie, err := auth.CreateSession(ctx, "test_user", "")
 	require.NoError(err)
 	assert.NotNil(cookie)
 
@@ -64,7 +69,7 @@ func TestAuthWithInvalidSession(t *testing.T) {
 func TestAuthWithExpiredSession(t *testing.T) {
 	assert, require, ctx, auth := SetupAuthTest(t)
 
-	cookie, err := auth.CreateSession(ctx, "test_user_id")
+	cookie, err := auth.CreateSession(ctx, "test_user_id", "")
 	require.NoError(err)
 	assert.NotNil(cookie)
 
@@ -81,3 +86,194 @@ func TestAuthWithExpiredSession(t *testing.T) {
 	require.ErrorIs(err, model.ErrSessionNotFound)
 	assert.Empty(userID)
 }
+
+type apiKeyStore map[string]*model.APIKey
+
+func (s apiKeyStore) GetAPIKey(_ context.Context, id string) (*model.APIKey, error) {
+	key, ok := s[id]
+	if !ok {
+		return nil, model.ErrAPIKeyNotFound
+	}
+
+	return key, nil
+}
+
+func (s apiKeyStore) UpdateAPIKeyLastUsed(_ context.Context, id string, dateLastUsed int64) error {
+	s[id].DateLastUsed = dateLastUsed
+	return nil
+}
+
+func TestAuthAPIKey(t *testing.T) {
+	assert, require, ctx, auth := SetupAuthTest(t)
+
+	id, token, hash, err := auth.CreateAPIKey()
+	require.NoError(err)
+	assert.True(strings.HasPrefix(id, model.APIKeyPrefix+"_"))
+
+	// Secrets are stored hashed like passwords.
+	secret := strings.TrimPrefix(token, id+".")
+	assert.True(strings.HasPrefix(hash, "$argon2id$"))
+
+	match, err := auth.ComparePasswords(secret, hash)
+	require.NoError(err)
+	assert.True(match)
+
+	store := apiKeyStore{
+		id: model.NewAPIKey(id, "test_user_id", "CI", hash, model.APIKeyRoleRead, "", 0, 1, 1),
+	}
+
+	key, err := auth.ReadAPIKey(ctx, store, token)
+	require.NoError(err)
+	assert.Equal("test_user_id", key.UserID)
+	assert.NotZero(store[id].DateLastUsed)
+
+	// Cached verifications are still checked against the stored hash.
+	_, _, otherHash, err := auth.CreateAPIKey()
+	require.NoError(err)
+	store[id].Hash = otherHash
+
+	_, err = auth.ReadAPIKey(ctx, store, token)
+	require.ErrorIs(err, model.ErrInvalidAPIKey)
+
+	for _, invalid := range []string{"", id, id + ".", "." + token, "key_missing.secret"} {
+		_, err = auth.ReadAPIKey(ctx, store, invalid)
+		require.ErrorIs(err, model.ErrInvalidAPIKey, invalid)
+	}
+}
+
+type sessionStore map[string]*model.Session
+
+func (s sessionStore) CreateSession(_ context.Context, session *model.Session) error {
+	s[session.ID] = session
+	return nil
+}
+
+func (s sessionStore) GetSession(_ context.Context, id string) (*model.Session, error) {
+	session, ok := s[id]
+	if !ok {
+		return nil, model.ErrSessionNotFound
+	}
+
+	return session, nil
+}
+
+func (s sessionStore) ListSessions(_ context.Context, userID string) ([]*model.Session, error) {
+	sessions := []*model.Session{}
+
+	for _, session := range s {
+		if session.UserID == userID {
+			sessions = append(sessions, session)
+		}
+	}
+
+	return sessions, nil
+}
+
+func (s sessionStore) DeleteSession(_ context.Context, id string) error {
+	delete(s, id)
+	return nil
+}
+
+func (s sessionStore) DeleteUserSessions(_ context.Context, userID string) error {
+	for id, session := range s {
+		if session.UserID == userID {
+			delete(s, id)
+		}
+	}
+
+	return nil
+}
+
+func TestAuthPersistentSessions(t *testing.T) {
+	assert, require, ctx, _ := SetupAuthTest(t)
+
+	key, err := util.DeriveCipherKey(strings.Repeat("s", util.MinSessionSecretLength))
+	require.NoError(err)
+
+	store := sessionStore{}
+
+	auth, err := util.NewAuthService(ctx, false, util.WithSessionStore(store, key))
+	require.NoError(err)
+
+	cookie, err := auth.CreateSession(ctx, "test_user_id", "Mozilla/5.0")
+	require.NoError(err)
+	require.Len(store, 1)
+
+	// Sessions survive a restart when the key is derived from the same secret.
+	key, err = util.DeriveCipherKey(strings.Repeat("s", util.MinSessionSecretLength))
+	require.NoError(err)
+
+	restarted, err := util.NewAuthService(ctx, false, util.WithSessionStore(store, key))
+	require.NoError(err)
+
+	session, err := restarted.LoadSession(ctx, cookie.Value)
+	require.NoError(err)
+	assert.Equal("test_user_id", session.UserID)
+	assert.Equal("Mozilla/5.0", session.UserAgent)
+	assert.Equal(session.DateCreated+int64(model.SessionDuration.Seconds()), session.DateExpires)
+
+	// A different key can not read the session.
+	otherKey, err := util.DeriveCipherKey(strings.Repeat("o", util.MinSessionSecretLength))
+	require.NoError(err)
+
+	other, err := util.NewAuthService(ctx, false, util.WithSessionStore(store, otherKey))
+	require.NoError(err)
+
+	_, err = other.ReadSession(ctx, cookie.Value)
+	require.ErrorIs(err, model.ErrInvalidSession)
+
+	// Expired sessions are rejected even if the store still returns them.
+	session.DateExpires = time.Now().Add(-time.Second).Unix()
+
+	_, err = restarted.ReadSession(ctx, cookie.Value)
+	require.ErrorIs(err, model.ErrSessionNotFound)
+
+	session.DateExpires = time.Now().Add(time.Hour).Unix()
+
+	// Revoked sessions are rejected.
+	require.NoError(restarted.RevokeSession(ctx, session.ID))
+
+	_, err = restarted.ReadSession(ctx, cookie.Value)
+	require.ErrorIs(err, model.ErrSessionNotFound)
+
+	_, err = util.DeriveCipherKey("short")
+	require.Error(err)
+
+	_, err = util.NewAuthService(ctx, false, util.WithSessionStore(store, []byte("short")))
+	require.Error(err)
+}
+
+func TestAuthRevokeUserSessions(t *testing.T) {
+	assert, require, ctx, auth := SetupAuthTest(t)
+
+	first, err := auth.CreateSession(ctx, "test_user_id", "Firefox")
+	require.NoError(err)
+
+	_, err = auth.CreateSession(ctx, "test_user_id", strings.Repeat("a", 1000))
+	require.NoError(err)
+
+	other, err := auth.CreateSession(ctx, "other_user_id", "Chrome")
+	require.NoError(err)
+
+	sessions, err := auth.ListSessions(ctx, "test_user_id")
+	require.NoError(err)
+	require.Len(sessions, 2)
+
+	// Long user agents are truncated.
+	userAgents := []int{len(sessions[0].UserAgent), len(sessions[1].UserAgent)}
+	assert.ElementsMatch([]int{len("Firefox"), 512}, userAgents)
+
+	require.NoError(auth.RevokeUserSessions(ctx, "test_user_id"))
+
+	_, err = auth.ReadSession(ctx, first.Value)
+	require.ErrorIs(err, model.ErrSessionNotFound)
+
+	sessions, err = auth.ListSessions(ctx, "test_user_id")
+	require.NoError(err)
+	assert.Empty(sessions)
+
+	// Sessions of other users are kept.
+	userID, err := auth.ReadSession(ctx, other.Value)
+	require.NoError(err)
+	assert.Equal("other_user_id", userID)
+}
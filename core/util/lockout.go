@@ -0,0 +1,253 @@
+package util
+
+import (
+	"net/netip"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/medama-io/medama/util/logger"
+)
+
+// LockoutKind is what a login lockout is keyed on.
+type LockoutKind string
+
+const (
+	// LockoutUsername locks out logins to a username from everywhere.
+	LockoutUsername LockoutKind = "username"
+	// LockoutIP locks out logins to any username from an IP prefix.
+	LockoutIP LockoutKind = "ip"
+)
+
+const (
+	// DefaultLoginUserAttempts is the default number of failed logins to a
+	// username before it is locked out.
+	DefaultLoginUserAttempts = 5
+	// DefaultLoginIPAttempts is the default number of failed logins from an
+	// IP prefix before it is locked out. It is higher than the username limit
+	// as many users may share a network.
+	DefaultLoginIPAttempts = 20
+	// DefaultLoginLockout is the default duration of the first lockout.
+	DefaultLoginLockout = 1 * time.Minute
+	// DefaultLoginMaxLockout is the default maximum duration of a lockout.
+	DefaultLoginMaxLockout = 1 * time.Hour
+
+	// lockoutCacheSize is the number of usernames and IP prefixes with failed
+	// logins to track.
+	lockoutCacheSize = 65536
+	// lockoutMemory is how long failed logins are remembered without any
+	// further failures, after which lockouts start from the shortest again.
+	lockoutMemory = 24 * time.Hour
+)
+
+// LoginLimits configures when failed logins lock out further logins.
+type LoginLimits struct {
+	// UserAttempts is the number of failed logins to a username before it is
+	// locked out. Zero disables username lockouts.
+	UserAttempts int
+	// IPAttempts is the number of failed logins from an IP prefix before it
+	// is locked out. Zero disables IP lockouts.
+	IPAttempts int
+	// Lockout is the duration of the first lockout. Each further lockout
+	// doubles the duration, up to MaxLockout.
+	Lockout    time.Duration
+	MaxLockout time.Duration
+}
+
+// DefaultLoginLimits returns the default login limits.
+func DefaultLoginLimits() LoginLimits {
+	return LoginLimits{
+		UserAttempts: DefaultLoginUserAttempts,
+		IPAttempts:   DefaultLoginIPAttempts,
+		Lockout:      DefaultLoginLockout,
+		MaxLockout:   DefaultLoginMaxLockout,
+	}
+}
+
+// Lockout is a username or IP prefix that is locked out of logging in.
+type Lockout struct {
+	Kind  LockoutKind
+	Value string
+	// Lockouts is the number of consecutive lockouts, which determines the
+	// duration of the next one.
+	Lockouts    int
+	LockedUntil time.Time
+}
+
+type lockoutKey struct {
+	kind  LockoutKind
+	value string
+}
+
+type loginFailures struct {
+	failures    int
+	lockouts    int
+	lockedUntil time.Time
+}
+
+// LoginLimiter protects logins from brute-force attacks by locking out
+// usernames and IP prefixes after too many failed logins. Lockouts are
+// progressive, so repeated lockouts last longer.
+type LoginLimiter struct {
+	limits LoginLimits
+	now    func() time.Time
+
+	mu      sync.Mutex
+	entries *expirable.LRU[lockoutKey, *loginFailures]
+}
+
+// NewLoginLimiter returns a new login limiter using now as its clock.
+func NewLoginLimiter(limits LoginLimits, now func() time.Time) *LoginLimiter {
+	if limits.Lockout <= 0 {
+		limits.Lockout = DefaultLoginLockout
+	}
+
+	if limits.MaxLockout < limits.Lockout {
+		limits.MaxLockout = limits.Lockout
+	}
+
+	return &LoginLimiter{
+		limits:  limits,
+		now:     now,
+		entries: expirable.NewLRU[lockoutKey, *loginFailures](lockoutCacheSize, nil, lockoutMemory),
+	}
+}
+
+// Check returns how long logins to the username or from the IP prefix are
+// locked out for, or zero if they are allowed. An empty username or invalid
+// prefix is not checked.
+func (l *LoginLimiter) Check(username string, prefix netip.Prefix) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	var retryAfter time.Duration
+
+	for _, key := range lockoutKeys(username, prefix) {
+		entry, ok := l.entries.Peek(key)
+		if ok {
+			retryAfter = max(retryAfter, entry.lockedUntil.Sub(now))
+		}
+	}
+
+	return retryAfter
+}
+
+// Fail records a failed login to the username from the IP prefix, locking
+// them out once they reach their limit.
+func (l *LoginLimiter) Fail(username string, prefix netip.Prefix) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	for _, key := range lockoutKeys(username, prefix) {
+		attempts := l.limits.UserAttempts
+		if key.kind == LockoutIP {
+			attempts = l.limits.IPAttempts
+		}
+
+		if attempts <= 0 {
+			continue
+		}
+
+		entry, ok := l.entries.Get(key)
+		if !ok {
+			entry = &loginFailures{}
+		}
+
+		entry.failures++
+		if entry.failures >= attempts {
+			entry.failures = 0
+			entry.lockouts++
+			duration := l.lockoutDuration(entry.lockouts)
+			entry.lockedUntil = now.Add(duration)
+
+			log := logger.Get()
+			log.Warn().
+				Str("type", string(key.kind)).
+				Str("value", key.value).
+				Int("lockouts", entry.lockouts).
+				Dur("duration", duration).
+				Msg("too many failed logins, locking out")
+		}
+
+		// Adding the entry again keeps it from expiring while failures
+		// continue.
+		l.entries.Add(key, entry)
+	}
+}
+
+// Succeed forgets the failed logins to the username after a successful
+// login. Failures from the IP prefix are kept, as a user logging in does not
+// mean the rest of the network can be trusted.
+func (l *LoginLimiter) Succeed(username string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries.Remove(lockoutKey{kind: LockoutUsername, value: username})
+}
+
+// Clear removes the lockout and failed logins of a username or IP prefix,
+// reporting whether there were any.
+func (l *LoginLimiter) Clear(kind LockoutKind, value string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.entries.Remove(lockoutKey{kind: kind, value: value})
+}
+
+// Lockouts returns the active lockouts, ending last first.
+func (l *LoginLimiter) Lockouts() []Lockout {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	lockouts := []Lockout{}
+
+	for _, key := range l.entries.Keys() {
+		entry, ok := l.entries.Peek(key)
+		if !ok || !entry.lockedUntil.After(now) {
+			continue
+		}
+
+		lockouts = append(lockouts, Lockout{
+			Kind:        key.kind,
+			Value:       key.value,
+			Lockouts:    entry.lockouts,
+			LockedUntil: entry.lockedUntil,
+		})
+	}
+
+	slices.SortFunc(lockouts, func(a, b Lockout) int {
+		return b.LockedUntil.Compare(a.LockedUntil)
+	})
+
+	return lockouts
+}
+
+// lockoutDuration returns the duration of the nth consecutive lockout.
+func (l *LoginLimiter) lockoutDuration(lockouts int) time.Duration {
+	duration := l.limits.Lockout
+	for i := 1; i < lockouts && duration < l.limits.MaxLockout; i++ {
+		duration *= 2
+	}
+
+	return min(duration, l.limits.MaxLockout)
+}
+
+func lockoutKeys(username string, prefix netip.Prefix) []lockoutKey {
+	keys := make([]lockoutKey, 0, 2)
+
+	if username != "" {
+		keys = append(keys, lockoutKey{kind: LockoutUsername, value: username})
+	}
+
+	if prefix.IsValid() {
+		keys = append(keys, lockoutKey{kind: LockoutIP, value: prefix.String()})
+	}
+
+	return keys
+}
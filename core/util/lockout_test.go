@@ -0,0 +1,97 @@
+package util_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginLimiter(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Unix(1700000000, 0)
+	limiter := util.NewLoginLimiter(util.LoginLimits{
+		UserAttempts: 3,
+		IPAttempts:   5,
+		Lockout:      time.Minute,
+		MaxLockout:   3 * time.Minute,
+	}, func() time.Time { return now })
+
+	prefix := netip.MustParsePrefix("192.168.1.0/24")
+	otherPrefix := netip.MustParsePrefix("10.0.0.0/24")
+
+	// The username is locked out after its attempts from any network.
+	limiter.Fail("alice", prefix)
+	limiter.Fail("alice", otherPrefix)
+	assert.Zero(limiter.Check("alice", prefix))
+
+	limiter.Fail("alice", otherPrefix)
+	assert.Equal(time.Minute, limiter.Check("alice", netip.Prefix{}))
+	assert.Zero(limiter.Check("bob", prefix))
+
+	// Further lockouts double in duration, up to the maximum.
+	now = now.Add(time.Minute)
+	assert.Zero(limiter.Check("alice", netip.Prefix{}))
+
+	for range 3 {
+		limiter.Fail("alice", netip.Prefix{})
+	}
+
+	assert.Equal(2*time.Minute, limiter.Check("alice", netip.Prefix{}))
+
+	now = now.Add(2 * time.Minute)
+	for range 3 {
+		limiter.Fail("alice", netip.Prefix{})
+	}
+
+	assert.Equal(3*time.Minute, limiter.Check("alice", netip.Prefix{}))
+
+	// The network is locked out after its attempts for any username.
+	for _, username := range []string{"bob", "carol", "dave", "erin"} {
+		limiter.Fail(username, prefix)
+	}
+
+	assert.Equal(time.Minute, limiter.Check("frank", prefix))
+	assert.Zero(limiter.Check("frank", otherPrefix))
+
+	lockouts := limiter.Lockouts()
+	if assert.Len(lockouts, 2) {
+		assert.Equal(util.Lockout{
+			Kind:        util.LockoutUsername,
+			Value:       "alice",
+			Lockouts:    3,
+			LockedUntil: now.Add(3 * time.Minute),
+		}, lockouts[0])
+		assert.Equal(util.LockoutIP, lockouts[1].Kind)
+		assert.Equal("192.168.1.0/24", lockouts[1].Value)
+	}
+
+	// Lockouts can be cleared by an admin.
+	assert.True(limiter.Clear(util.LockoutUsername, "alice"))
+	assert.False(limiter.Clear(util.LockoutUsername, "alice"))
+	assert.Zero(limiter.Check("alice", netip.Prefix{}))
+
+	// Successful logins forget the failures of the username only.
+	limiter.Fail("bob", otherPrefix)
+	limiter.Fail("bob", otherPrefix)
+	limiter.Succeed("bob")
+	limiter.Fail("bob", otherPrefix)
+	limiter.Fail("bob", otherPrefix)
+	assert.Zero(limiter.Check("bob", netip.Prefix{}))
+	assert.Equal(time.Minute, limiter.Check("", otherPrefix))
+}
+
+func TestLoginLimiterDisabled(t *testing.T) {
+	limiter := util.NewLoginLimiter(util.LoginLimits{}, time.Now)
+
+	prefix := netip.MustParsePrefix("192.168.1.0/24")
+	for range 100 {
+		limiter.Fail("alice", prefix)
+	}
+
+	assert.Zero(t, limiter.Check("alice", prefix))
+	assert.Empty(t, limiter.Lockouts())
+}
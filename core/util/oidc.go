@@ -0,0 +1,100 @@
+package util
+
+import (
+	"context"
+	"time"
+
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/oidc"
+)
+
+// oidcLogin is a single sign-on login waiting for the callback from the
+// identity provider.
+type oidcLogin struct {
+	nonce    string
+	verifier string
+	expires  time.Time
+}
+
+// WithOIDC enables single sign-on with the identity provider.
+func WithOIDC(provider *oidc.Provider) AuthOption {
+	return func(a *AuthService) {
+		a.oidc = provider
+	}
+}
+
+// WithPasswordLogin enables or disables logging in with a password, e.g. to
+// only allow single sign-on.
+func WithPasswordLogin(enabled bool) AuthOption {
+	return func(a *AuthService) {
+		a.passwordLogin = enabled
+	}
+}
+
+// PasswordLoginEnabled reports whether users can log in with their password.
+func (a *AuthService) PasswordLoginEnabled() bool {
+	return a.passwordLogin
+}
+
+// OIDC returns the identity provider used for single sign-on, or nil if
+// single sign-on is not configured.
+func (a *AuthService) OIDC() *oidc.Provider {
+	return a.oidc
+}
+
+// StartOIDCLogin starts a single sign-on login, returning the URL of the
+// identity provider to redirect the user to and the state identifying the
+// login.
+func (a *AuthService) StartOIDCLogin(ctx context.Context) (string, string, error) {
+	if a.oidc == nil {
+		return "", "", model.ErrSSONotConfigured
+	}
+
+	var tokens [3]string
+
+	for i := range tokens {
+		token, err := oidc.GenerateToken()
+		if err != nil {
+			return "", "", err
+		}
+
+		tokens[i] = token
+	}
+
+	state, nonce, verifier := tokens[0], tokens[1], tokens[2]
+
+	authURL, err := a.oidc.AuthCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		return "", "", err
+	}
+
+	a.challenges.Set(state, &oidcLogin{
+		nonce:    nonce,
+		verifier: verifier,
+		expires:  a.now().Add(model.OIDCLoginDuration),
+	}, model.OIDCLoginDuration)
+
+	return authURL, state, nil
+}
+
+// FinishOIDCLogin completes a single sign-on login with the authorization code
+// from the callback. Each login can only be completed once.
+func (a *AuthService) FinishOIDCLogin(ctx context.Context, state string, code string) (*oidc.Identity, error) {
+	if a.oidc == nil {
+		return nil, model.ErrSSONotConfigured
+	}
+
+	value, err := a.challenges.Get(ctx, state)
+	if err != nil {
+		return nil, model.ErrInvalidSSOLogin
+	}
+
+	a.challenges.Delete(state)
+
+	login, ok := value.(*oidcLogin)
+	if !ok || a.now().After(login.expires) {
+		return nil, model.ErrInvalidSSOLogin
+	}
+
+	return a.oidc.Exchange(ctx, code, login.nonce, login.verifier)
+}
@@ -0,0 +1,123 @@
+package util
+
+import "sync"
+
+// DefaultSubscriptionBuffer is the number of messages buffered for each
+// subscriber before new messages are dropped.
+const DefaultSubscriptionBuffer = 64
+
+// PubSub is a simple in-memory publish/subscribe broker that fans out
+// messages published to a topic to all of its subscribers.
+//
+// Publishing never blocks. If a subscriber is too slow to keep up, messages
+// are dropped for that subscriber instead of slowing down the publisher.
+type PubSub[T any] struct {
+	mu     sync.RWMutex
+	topics map[string]map[*Subscription[T]]struct{}
+	buffer int
+	closed bool
+}
+
+// Subscription receives messages published to a topic until it is closed.
+type Subscription[T any] struct {
+	C <-chan T
+
+	ch     chan T
+	topic  string
+	pubsub *PubSub[T]
+	once   sync.Once
+}
+
+// NewPubSub returns a new instance of PubSub with the given subscriber buffer.
+func NewPubSub[T any](buffer int) *PubSub[T] {
+	return &PubSub[T]{
+		topics: make(map[string]map[*Subscription[T]]struct{}),
+		buffer: buffer,
+	}
+}
+
+// Subscribe returns a new subscription to the given topic. If the broker is
+// closed, the subscription channel is closed immediately.
+func (p *PubSub[T]) Subscribe(topic string) *Subscription[T] {
+	ch := make(chan T, p.buffer)
+	sub := &Subscription[T]{
+		C:      ch,
+		ch:     ch,
+		topic:  topic,
+		pubsub: p,
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		sub.once.Do(func() { close(ch) })
+		return sub
+	}
+
+	subs, ok := p.topics[topic]
+	if !ok {
+		subs = make(map[*Subscription[T]]struct{})
+		p.topics[topic] = subs
+	}
+
+	subs[sub] = struct{}{}
+
+	return sub
+}
+
+// Publish sends the message to all subscribers of the given topic.
+func (p *PubSub[T]) Publish(topic string, msg T) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	for sub := range p.topics[topic] {
+		select {
+		case sub.ch <- msg:
+		default:
+			// Drop the message if the subscriber is not keeping up.
+		}
+	}
+}
+
+// Subscribers returns the number of subscribers of the given topic.
+func (p *PubSub[T]) Subscribers(topic string) int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return len(p.topics[topic])
+}
+
+// Close closes all subscriptions so subscribers can stop listening.
+func (p *PubSub[T]) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, subs := range p.topics {
+		for sub := range subs {
+			sub.once.Do(func() { close(sub.ch) })
+		}
+	}
+
+	p.topics = make(map[string]map[*Subscription[T]]struct{})
+	p.closed = true
+}
+
+// Close unsubscribes from the topic and closes the subscription channel.
+func (s *Subscription[T]) Close() {
+	s.once.Do(func() {
+		p := s.pubsub
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		subs := p.topics[s.topic]
+		delete(subs, s)
+
+		if len(subs) == 0 {
+			delete(p.topics, s.topic)
+		}
+
+		close(s.ch)
+	})
+}
@@ -0,0 +1,56 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/medama-io/medama/util"
+)
+
+func TestPubSub(t *testing.T) {
+	assert, _, _ := SetupCacheTest(t)
+
+	pubsub := util.NewPubSub[int](1)
+
+	first := pubsub.Subscribe("example.com")
+	second := pubsub.Subscribe("example.com")
+	other := pubsub.Subscribe("example.org")
+
+	assert.Equal(2, pubsub.Subscribers("example.com"))
+
+	pubsub.Publish("example.com", 1)
+	assert.Equal(1, <-first.C)
+	assert.Equal(1, <-second.C)
+	assert.Empty(other.C)
+
+	// Messages are dropped instead of blocking once the buffer is full.
+	pubsub.Publish("example.com", 2)
+	pubsub.Publish("example.com", 3)
+	assert.Equal(2, <-first.C)
+	assert.Empty(first.C)
+
+	// Closed subscriptions no longer receive messages.
+	second.Close()
+	second.Close()
+	assert.Equal(1, pubsub.Subscribers("example.com"))
+
+	// Buffered messages can still be drained after closing.
+	assert.Equal(2, <-second.C)
+
+	_, ok := <-second.C
+	assert.False(ok)
+
+	// Closing the broker ends all subscriptions.
+	pubsub.Close()
+
+	_, ok = <-first.C
+	assert.False(ok)
+
+	_, ok = <-other.C
+	assert.False(ok)
+
+	other.Close()
+
+	late := pubsub.Subscribe("example.com")
+	_, ok = <-late.C
+	assert.False(ok)
+}
@@ -0,0 +1,208 @@
+package util
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/medama-io/medama/model"
+)
+
+const (
+	// MaxRealtimeWindow is the longest period realtime stats can be requested for.
+	MaxRealtimeWindow = 30 * time.Minute
+	// DefaultRealtimeWindow is the default period a visitor is considered active.
+	DefaultRealtimeWindow = 5 * time.Minute
+
+	// realtimeLimit is the maximum number of pages and referrers in a snapshot.
+	realtimeLimit = 10
+	// maxRealtimeHits is the maximum number of page views kept in memory per
+	// website. Once exceeded, the oldest page views are dropped.
+	maxRealtimeHits = 50000
+	// realtimeCleanInterval is how often page views older than the maximum
+	// window are removed.
+	realtimeCleanInterval = time.Minute
+)
+
+// RealtimeTracker keeps the page views of the last MaxRealtimeWindow in memory
+// and publishes every new page view to the subscribers of the website. This
+// lets dashboards follow live traffic without polling the analytics database.
+type RealtimeTracker struct {
+	mu   sync.RWMutex
+	hits map[string][]model.RealtimeHit
+
+	pubsub *PubSub[model.RealtimeHit]
+	close  chan struct{}
+}
+
+// NewRealtimeTracker returns a new instance of RealtimeTracker that
+// asynchronously removes expired page views.
+func NewRealtimeTracker() *RealtimeTracker {
+	r := &RealtimeTracker{
+		hits:   make(map[string][]model.RealtimeHit),
+		pubsub: NewPubSub[model.RealtimeHit](DefaultSubscriptionBuffer),
+		close:  make(chan struct{}),
+	}
+
+	go func() {
+		ticker := time.NewTicker(realtimeCleanInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				r.clean(time.Now())
+			case <-r.close:
+				return
+			}
+		}
+	}()
+
+	return r
+}
+
+// Record stores the page view for the website and publishes it to all
+// subscribers of the website.
+func (r *RealtimeTracker) Record(hostname string, hit model.RealtimeHit) {
+	r.mu.Lock()
+
+	hits := append(r.hits[hostname], hit)
+	if len(hits) > maxRealtimeHits {
+		hits = slices.Delete(hits, 0, len(hits)-maxRealtimeHits)
+	}
+
+	r.hits[hostname] = hits
+	r.mu.Unlock()
+
+	r.pubsub.Publish(hostname, hit)
+}
+
+// Seed restores the page views of each website recorded before the tracker
+// was created, such as those loaded from the analytics database on startup.
+// The page views must be ordered by time and are not published.
+func (r *RealtimeTracker) Seed(hits map[string][]model.RealtimeHit) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for hostname, seeded := range hits {
+		merged := append(slices.Clone(seeded), r.hits[hostname]...)
+		if len(merged) > maxRealtimeHits {
+			merged = slices.Delete(merged, 0, len(merged)-maxRealtimeHits)
+		}
+
+		r.hits[hostname] = merged
+	}
+}
+
+// Subscribe returns a subscription to the page views of the website.
+func (r *RealtimeTracker) Subscribe(hostname string) *Subscription[model.RealtimeHit] {
+	return r.pubsub.Subscribe(hostname)
+}
+
+// Snapshot returns the visitors active within the window before now and the
+// pages and referrers they viewed.
+func (r *RealtimeTracker) Snapshot(hostname string, window time.Duration, now time.Time) *model.StatsRealtime {
+	since := now.Add(-window)
+
+	visits := make(map[string]struct{})
+	pages := make(map[string]map[string]struct{})
+	referrers := make(map[string]map[string]struct{})
+
+	r.mu.RLock()
+	for _, hit := range r.hits[hostname] {
+		if hit.Time.Before(since) || hit.Time.After(now) {
+			continue
+		}
+
+		visits[hit.VisitID] = struct{}{}
+		addVisit(pages, hit.Pathname, hit.VisitID)
+		addVisit(referrers, hit.Referrer, hit.VisitID)
+	}
+	r.mu.RUnlock()
+
+	realtime := &model.StatsRealtime{
+		Visitors:  len(visits),
+		Pages:     make([]model.StatsRealtimePage, 0, min(len(pages), realtimeLimit)),
+		Referrers: make([]model.StatsRealtimeReferrer, 0, min(len(referrers), realtimeLimit)),
+	}
+
+	for _, key := range topVisits(pages) {
+		realtime.Pages = append(realtime.Pages, model.StatsRealtimePage{
+			Pathname: key,
+			Visitors: len(pages[key]),
+		})
+	}
+
+	for _, key := range topVisits(referrers) {
+		realtime.Referrers = append(realtime.Referrers, model.StatsRealtimeReferrer{
+			Referrer: key,
+			Visitors: len(referrers[key]),
+		})
+	}
+
+	return realtime
+}
+
+// Close stops the cleaning goroutine and closes all subscriptions.
+func (r *RealtimeTracker) Close() {
+	close(r.close)
+	r.pubsub.Close()
+}
+
+// clean removes the page views older than MaxRealtimeWindow.
+func (r *RealtimeTracker) clean(now time.Time) {
+	since := now.Add(-MaxRealtimeWindow)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for hostname, hits := range r.hits {
+		// Page views are recorded in order, so we can drop everything before
+		// the first page view within the window.
+		idx, _ := slices.BinarySearchFunc(hits, since, func(hit model.RealtimeHit, t time.Time) int {
+			return hit.Time.Compare(t)
+		})
+
+		if idx == len(hits) {
+			delete(r.hits, hostname)
+			continue
+		}
+
+		r.hits[hostname] = slices.Delete(hits, 0, idx)
+	}
+}
+
+// addVisit adds the visit to the set of visits for the given key.
+func addVisit(m map[string]map[string]struct{}, key string, visitID string) {
+	visits, ok := m[key]
+	if !ok {
+		visits = make(map[string]struct{})
+		m[key] = visits
+	}
+
+	visits[visitID] = struct{}{}
+}
+
+// topVisits returns the keys with the most visits, ordered by the number of
+// visits and then alphabetically.
+func topVisits(m map[string]map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	slices.SortFunc(keys, func(a, b string) int {
+		if c := cmp.Compare(len(m[b]), len(m[a])); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a, b)
+	})
+
+	if len(keys) > realtimeLimit {
+		keys = keys[:realtimeLimit]
+	}
+
+	return keys
+}
@@ -0,0 +1,100 @@
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util"
+)
+
+func TestRealtimeTracker(t *testing.T) {
+	assert, _, _ := SetupCacheTest(t)
+
+	realtime := util.NewRealtimeTracker()
+	defer realtime.Close()
+
+	sub := realtime.Subscribe("example.com")
+	defer sub.Close()
+
+	now := time.Now()
+	hits := []model.RealtimeHit{
+		{VisitID: "visit_3", Pathname: "/about", Referrer: "Bing", Time: now.Add(-10 * time.Minute)},
+		{VisitID: "visit_1", Pathname: "/", Referrer: "Google", Time: now.Add(-2 * time.Minute)},
+		{VisitID: "visit_2", Pathname: "/pricing", Referrer: "", Time: now.Add(-time.Minute)},
+		{VisitID: "visit_1", Pathname: "/pricing", Referrer: "", Time: now},
+	}
+
+	for _, hit := range hits {
+		realtime.Record("example.com", hit)
+		assert.Equal(hit, <-sub.C)
+	}
+
+	realtime.Record("example.org", model.RealtimeHit{VisitID: "visit_4", Pathname: "/", Time: now})
+	assert.Empty(sub.C)
+
+	assert.Equal(&model.StatsRealtime{
+		Visitors: 2,
+		Pages: []model.StatsRealtimePage{
+			{Pathname: "/pricing", Visitors: 2},
+			{Pathname: "/", Visitors: 1},
+		},
+		Referrers: []model.StatsRealtimeReferrer{
+			{Referrer: "", Visitors: 2},
+			{Referrer: "Google", Visitors: 1},
+		},
+	}, realtime.Snapshot("example.com", util.DefaultRealtimeWindow, now))
+
+	snapshot := realtime.Snapshot("example.com", util.MaxRealtimeWindow, now)
+	assert.Equal(3, snapshot.Visitors)
+	assert.Len(snapshot.Pages, 3)
+
+	// Visitors become inactive once the window passes.
+	snapshot = realtime.Snapshot("example.com", util.DefaultRealtimeWindow, now.Add(10*time.Minute))
+	assert.Equal(0, snapshot.Visitors)
+	assert.Empty(snapshot.Pages)
+	assert.Empty(snapshot.Referrers)
+}
+
+func TestRealtimeTrackerSeed(t *testing.T) {
+	assert, _, _ := SetupCacheTest(t)
+
+	realtime := util.NewRealtimeTracker()
+	defer realtime.Close()
+
+	sub := realtime.Subscribe("example.com")
+	defer sub.Close()
+
+	now := time.Now()
+	realtime.Record("example.com", model.RealtimeHit{VisitID: "visit_2", Pathname: "/", Time: now})
+	<-sub.C
+
+	realtime.Seed(map[string][]model.RealtimeHit{
+		"example.com": {
+			{VisitID: "visit_1", Pathname: "/about", Referrer: "Google", Time: now.Add(-40 * time.Minute)},
+			{VisitID: "visit_1", Pathname: "/", Referrer: "Google", Time: now.Add(-3 * time.Minute)},
+		},
+		"example.org": {
+			{VisitID: "visit_3", Pathname: "/", Time: now.Add(-time.Minute)},
+		},
+	})
+
+	// Seeded page views are not published.
+	assert.Empty(sub.C)
+
+	assert.Equal(&model.StatsRealtime{
+		Visitors: 2,
+		Pages: []model.StatsRealtimePage{
+			{Pathname: "/", Visitors: 2},
+		},
+		Referrers: []model.StatsRealtimeReferrer{
+			{Referrer: "", Visitors: 1},
+			{Referrer: "Google", Visitors: 1},
+		},
+	}, realtime.Snapshot("example.com", util.DefaultRealtimeWindow, now))
+	assert.Equal(1, realtime.Snapshot("example.org", util.DefaultRealtimeWindow, now).Visitors)
+
+	snapshot := realtime.Snapshot("example.com", util.MaxRealtimeWindow, now)
+	assert.Equal(2, snapshot.Visitors)
+	assert.Len(snapshot.Pages, 1)
+}
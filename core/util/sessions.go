@@ -0,0 +1,140 @@
+package util
+
+import (
+	"cmp"
+	"context"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"slices"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/model"
+)
+
+const (
+	// MinSessionSecretLength is the minimum length of a configured session
+	// secret.
+	MinSessionSecretLength = 32
+	// MinTOTPSecretLength is the minimum length of a configured TOTP secret.
+	MinTOTPSecretLength = 32
+	// sessionKeyInfo and totpKeyInfo bind keys derived from the configured
+	// secrets to their use.
+	sessionKeyInfo = "medama session cookie"
+	totpKeyInfo    = "medama totp secret"
+)
+
+// SessionStore stores login sessions.
+type SessionStore interface {
+	CreateSession(ctx context.Context, session *model.Session) error
+	GetSession(ctx context.Context, id string) (*model.Session, error)
+	ListSessions(ctx context.Context, userID string) ([]*model.Session, error)
+	DeleteSession(ctx context.Context, id string) error
+	DeleteUserSessions(ctx context.Context, userID string) error
+}
+
+// memorySessionStore keeps sessions in the in-memory cache, so they are lost
+// when the server restarts.
+type memorySessionStore struct {
+	cache *Cache
+}
+
+func (s *memorySessionStore) CreateSession(_ context.Context, session *model.Session) error {
+	s.cache.Set(session.ID, session, time.Until(time.Unix(session.DateExpires, 0)))
+	return nil
+}
+
+func (s *memorySessionStore) GetSession(ctx context.Context, id string) (*model.Session, error) {
+	value, err := s.cache.Get(ctx, id)
+	if err != nil {
+		return nil, model.ErrSessionNotFound
+	}
+
+	session, ok := value.(*model.Session)
+	if !ok {
+		return nil, model.ErrSessionNotFound
+	}
+
+	return session, nil
+}
+
+func (s *memorySessionStore) ListSessions(ctx context.Context, userID string) ([]*model.Session, error) {
+	sessions := []*model.Session{}
+
+	s.cache.Range(ctx, func(_, value any) bool {
+		session, ok := value.(*model.Session)
+		if ok && session.UserID == userID {
+			sessions = append(sessions, session)
+		}
+
+		return true
+	})
+
+	slices.SortFunc(sessions, func(a, b *model.Session) int {
+		return cmp.Or(cmp.Compare(a.DateCreated, b.DateCreated), cmp.Compare(a.ID, b.ID))
+	})
+
+	return sessions, nil
+}
+
+func (s *memorySessionStore) DeleteSession(_ context.Context, id string) error {
+	s.cache.Delete(id)
+	return nil
+}
+
+func (s *memorySessionStore) DeleteUserSessions(ctx context.Context, userID string) error {
+	sessions, err := s.ListSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		s.cache.Delete(session.ID)
+	}
+
+	return nil
+}
+
+// GenerateCipherKey returns a new random key for encrypting session cookies.
+func GenerateCipherKey() ([]byte, error) {
+	key := make([]byte, DefaultCipherKeySize)
+
+	_, err := rand.Read(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate cipher key")
+	}
+
+	return key, nil
+}
+
+// DeriveCipherKey derives the key for encrypting session cookies from a
+// configured secret, so every instance sharing the secret can read the same
+// session cookies.
+func DeriveCipherKey(secret string) ([]byte, error) {
+	if len(secret) < MinSessionSecretLength {
+		return nil, errors.Errorf("session secret must be at least %d characters", MinSessionSecretLength)
+	}
+
+	return deriveKey(secret, sessionKeyInfo)
+}
+
+// DeriveTOTPKey derives the key for encrypting TOTP secrets from a configured
+// secret. The secret is kept out of the app database, so a copy of the
+// database alone can not be used to decrypt the TOTP secrets.
+func DeriveTOTPKey(secret string) ([]byte, error) {
+	if len(secret) < MinTOTPSecretLength {
+		return nil, errors.Errorf("totp secret must be at least %d characters", MinTOTPSecretLength)
+	}
+
+	return deriveKey(secret, totpKeyInfo)
+}
+
+func deriveKey(secret string, info string) ([]byte, error) {
+	key, err := hkdf.Key(sha256.New, []byte(secret), nil, info, DefaultCipherKeySize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive cipher key")
+	}
+
+	return key, nil
+}
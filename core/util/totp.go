@@ -0,0 +1,280 @@
+package util
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // RFC 6238 uses HMAC-SHA1, which authenticator apps expect.
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/medama-io/medama/model"
+)
+
+const (
+	// TOTPPeriod is the time step of TOTP codes.
+	TOTPPeriod = 30 * time.Second
+	// TOTPDigits is the number of digits of TOTP codes.
+	TOTPDigits = 6
+	// TOTPSkew is the number of time steps before and after the current one
+	// that are accepted to allow for clock drift.
+	TOTPSkew = 1
+	// TOTPSecretSize is the number of random bytes in a TOTP secret.
+	TOTPSecretSize = 20
+	// TOTPIssuer is the issuer shown in authenticator apps.
+	TOTPIssuer = "Medama"
+
+	// RecoveryCodeCount is the number of recovery codes generated when
+	// two-factor authentication is enabled.
+	RecoveryCodeCount = 10
+	// recoveryCodeSize is the number of random bytes in a recovery code.
+	recoveryCodeSize = 10
+)
+
+//nolint:gochecknoglobals // Stateless encoding.
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret returns a new random base32 encoded TOTP secret.
+func GenerateTOTPSecret() (string, error) {
+	secret := make([]byte, TOTPSecretSize)
+
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", errors.Wrap(err, "totp: secret")
+	}
+
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+// TOTPStep returns the time step of the given time.
+func TOTPStep(t time.Time) int64 {
+	return t.Unix() / int64(TOTPPeriod/time.Second)
+}
+
+// TOTPCode returns the code of the secret for the given time step as defined
+// by RFC 6238.
+func TOTPCode(secret string, step int64) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", errors.Wrap(err, "totp: decode secret")
+	}
+
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(step)) //nolint:gosec // Steps are never negative.
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation as defined by RFC 4226.
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", TOTPDigits, value%1_000_000), nil
+}
+
+// ValidateTOTP checks a code against the secret at the given time, allowing
+// for TOTPSkew steps of clock drift. Codes of steps up to lastStep have been
+// used before and are rejected to prevent replays. This returns the step of
+// the matching code.
+func ValidateTOTP(secret string, code string, now time.Time, lastStep int64) (int64, bool, error) {
+	if len(code) != TOTPDigits {
+		return 0, false, nil
+	}
+
+	current := TOTPStep(now)
+
+	for step := current - TOTPSkew; step <= current+TOTPSkew; step++ {
+		if step <= lastStep {
+			continue
+		}
+
+		expected, err := TOTPCode(secret, step)
+		if err != nil {
+			return 0, false, err
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true, nil
+		}
+	}
+
+	return 0, false, nil
+}
+
+// TOTPURI returns the otpauth URI of a secret for the account, which
+// authenticator apps can import from a QR code.
+func TOTPURI(account string, secret string) string {
+	label := url.PathEscape(TOTPIssuer + ":" + account)
+
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", TOTPIssuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", strconv.Itoa(TOTPDigits))
+	query.Set("period", strconv.Itoa(int(TOTPPeriod/time.Second)))
+
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// GenerateRecoveryCodes returns new one-time recovery codes along with the
+// hashes to store.
+func GenerateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, RecoveryCodeCount)
+	hashes := make([]string, 0, RecoveryCodeCount)
+
+	for range RecoveryCodeCount {
+		b := make([]byte, recoveryCodeSize)
+
+		_, err := rand.Read(b)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "totp: recovery code")
+		}
+
+		encoded := strings.ToLower(totpEncoding.EncodeToString(b))
+		code := encoded[:len(encoded)/2] + "-" + encoded[len(encoded)/2:]
+
+		codes = append(codes, code)
+		hashes = append(hashes, HashRecoveryCode(code))
+	}
+
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode returns the hash of a recovery code. Recovery codes have
+// enough entropy that a fast hash is sufficient. Codes are normalised so they
+// can be entered without the separator or in upper case.
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(code))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// loginChallenge is a login waiting for a two-factor authentication code.
+type loginChallenge struct {
+	userID   string
+	expires  time.Time
+	attempts atomic.Int32
+}
+
+// Now returns the current time of the clock used by the auth service.
+func (a *AuthService) Now() time.Time {
+	return a.now()
+}
+
+// EncryptTOTPSecret encrypts a TOTP secret to store. It returns
+// model.ErrTOTPNotConfigured if no TOTP key is configured.
+func (a *AuthService) EncryptTOTPSecret(secret string) ([]byte, error) {
+	if a.totpKey == nil {
+		return nil, model.ErrTOTPNotConfigured
+	}
+
+	aesgcm, err := newGCM(a.totpKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "totp: encrypt")
+	}
+
+	nonce := make([]byte, aesgcm.NonceSize())
+
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, errors.Wrap(err, "totp: encrypt")
+	}
+
+	return aesgcm.Seal(nonce, nonce, []byte(secret), nil), nil
+}
+
+// DecryptTOTPSecret decrypts a stored TOTP secret.
+func (a *AuthService) DecryptTOTPSecret(encrypted []byte) (string, error) {
+	if a.totpKey == nil {
+		return "", model.ErrTOTPNotConfigured
+	}
+
+	aesgcm, err := newGCM(a.totpKey)
+	if err != nil {
+		return "", errors.Wrap(err, "totp: decrypt")
+	}
+
+	nonceSize := aesgcm.NonceSize()
+	if len(encrypted) < nonceSize {
+		return "", errors.New("totp: decrypt: invalid ciphertext")
+	}
+
+	plaintext, err := aesgcm.Open(nil, encrypted[:nonceSize], encrypted[nonceSize:], nil)
+	if err != nil {
+		return "", errors.Wrap(err, "totp: decrypt")
+	}
+
+	return string(plaintext), nil
+}
+
+// CreateLoginChallenge returns a token for a user who entered their password
+// to complete the login with a two-factor authentication code.
+func (a *AuthService) CreateLoginChallenge(_ context.Context, userID string) (string, error) {
+	b := make([]byte, DefaultCipherKeySize)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", errors.Wrap(err, "auth: login challenge")
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	a.challenges.Set(token, &loginChallenge{
+		userID:  userID,
+		expires: a.now().Add(model.LoginChallengeDuration),
+	}, model.LoginChallengeDuration)
+
+	return token, nil
+}
+
+// ReadLoginChallenge returns the user of a login challenge. Each read counts
+// as an attempt, and challenges are revoked after
+// model.LoginChallengeAttempts attempts.
+func (a *AuthService) ReadLoginChallenge(ctx context.Context, token string) (string, error) {
+	value, err := a.challenges.Get(ctx, token)
+	if err != nil {
+		return "", model.ErrInvalidLoginChallenge
+	}
+
+	challenge, ok := value.(*loginChallenge)
+	if !ok || a.now().After(challenge.expires) {
+		a.challenges.Delete(token)
+		return "", model.ErrInvalidLoginChallenge
+	}
+
+	if challenge.attempts.Add(1) > model.LoginChallengeAttempts {
+		a.challenges.Delete(token)
+		return "", model.ErrInvalidLoginChallenge
+	}
+
+	return challenge.userID, nil
+}
+
+// RevokeLoginChallenge deletes a login challenge once the login is complete.
+func (a *AuthService) RevokeLoginChallenge(_ context.Context, token string) {
+	a.challenges.Delete(token)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
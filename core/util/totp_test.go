@@ -0,0 +1,185 @@
+package util_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/model"
+	"github.com/medama-io/medama/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Base32 encoding of the RFC 6238 SHA1 test secret "12345678901234567890".
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCode(t *testing.T) {
+	assert := assert.New(t)
+
+	// RFC 6238 test vectors truncated to six digits.
+	tests := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+
+	for unix, expected := range tests {
+		code, err := util.TOTPCode(rfcSecret, util.TOTPStep(time.Unix(unix, 0)))
+		require.NoError(t, err)
+		assert.Equal(expected, code, unix)
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	now := time.Unix(1234567890, 0)
+	current := util.TOTPStep(now)
+
+	code, err := util.TOTPCode(rfcSecret, current)
+	require.NoError(err)
+
+	step, ok, err := util.ValidateTOTP(rfcSecret, code, now, 0)
+	require.NoError(err)
+	assert.True(ok)
+	assert.Equal(current, step)
+
+	// Codes of adjacent steps are accepted to allow for clock drift.
+	previous, err := util.TOTPCode(rfcSecret, current-1)
+	require.NoError(err)
+
+	step, ok, err = util.ValidateTOTP(rfcSecret, previous, now, 0)
+	require.NoError(err)
+	assert.True(ok)
+	assert.Equal(current-1, step)
+
+	stale, err := util.TOTPCode(rfcSecret, current-2)
+	require.NoError(err)
+
+	_, ok, err = util.ValidateTOTP(rfcSecret, stale, now, 0)
+	require.NoError(err)
+	assert.False(ok)
+
+	// Codes that have been used before are rejected.
+	_, ok, err = util.ValidateTOTP(rfcSecret, code, now, current)
+	require.NoError(err)
+	assert.False(ok)
+
+	_, ok, err = util.ValidateTOTP(rfcSecret, "12345", now, 0)
+	require.NoError(err)
+	assert.False(ok)
+}
+
+func TestTOTPURI(t *testing.T) {
+	uri := util.TOTPURI("admin", rfcSecret)
+
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/Medama:admin?"))
+	assert.Contains(t, uri, "secret="+rfcSecret)
+	assert.Contains(t, uri, "issuer=Medama")
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	assert := assert.New(t)
+
+	codes, hashes, err := util.GenerateRecoveryCodes()
+	require.NoError(t, err)
+	assert.Len(codes, util.RecoveryCodeCount)
+	assert.Len(hashes, util.RecoveryCodeCount)
+
+	for i, code := range codes {
+		assert.Equal(hashes[i], util.HashRecoveryCode(code))
+		assert.NotContains(hashes[i], code)
+	}
+
+	// Codes can be typed without the separator and in any case.
+	assert.Equal(
+		util.HashRecoveryCode(codes[0]),
+		util.HashRecoveryCode(" "+strings.ToUpper(strings.ReplaceAll(codes[0], "-", ""))+" "),
+	)
+}
+
+func TestTOTPSecretEncryption(t *testing.T) {
+	assert, require, _, auth := SetupAuthTest(t)
+
+	secret, err := util.GenerateTOTPSecret()
+	require.NoError(err)
+
+	encrypted, err := auth.EncryptTOTPSecret(secret)
+	require.NoError(err)
+	assert.NotContains(string(encrypted), secret)
+
+	decrypted, err := auth.DecryptTOTPSecret(encrypted)
+	require.NoError(err)
+	assert.Equal(secret, decrypted)
+
+	// Secrets can not be decrypted with another key.
+	_, _, ctx, other := SetupAuthTest(t)
+
+	_, err = other.DecryptTOTPSecret(encrypted)
+	require.Error(err)
+
+	_, err = util.NewAuthService(ctx, false, util.WithTOTPKey([]byte("short")))
+	require.Error(err)
+
+	// Keys are derived from a configured secret.
+	key, err := util.DeriveTOTPKey(strings.Repeat("t", util.MinTOTPSecretLength))
+	require.NoError(err)
+
+	sessionKey, err := util.DeriveCipherKey(strings.Repeat("t", util.MinSessionSecretLength))
+	require.NoError(err)
+	assert.NotEqual(sessionKey, key)
+
+	_, err = util.DeriveTOTPKey("short")
+	require.Error(err)
+
+	// Secrets can not be encrypted without a key.
+	unconfigured, err := util.NewAuthService(ctx, false)
+	require.NoError(err)
+
+	_, err = unconfigured.EncryptTOTPSecret(secret)
+	require.ErrorIs(err, model.ErrTOTPNotConfigured)
+
+	_, err = unconfigured.DecryptTOTPSecret(encrypted)
+	require.ErrorIs(err, model.ErrTOTPNotConfigured)
+}
+
+func TestLoginChallenge(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := t.Context()
+
+	now := time.Now()
+	auth, err := util.NewAuthService(ctx, false, util.WithClock(func() time.Time { return now }))
+	require.NoError(err)
+
+	challenge, err := auth.CreateLoginChallenge(ctx, "user1")
+	require.NoError(err)
+
+	userID, err := auth.ReadLoginChallenge(ctx, challenge)
+	require.NoError(err)
+	assert.Equal("user1", userID)
+
+	_, err = auth.ReadLoginChallenge(ctx, "invalid")
+	require.ErrorIs(err, model.ErrInvalidLoginChallenge)
+
+	// Challenges are limited in attempts.
+	for range model.LoginChallengeAttempts - 1 {
+		_, err = auth.ReadLoginChallenge(ctx, challenge)
+		require.NoError(err)
+	}
+
+	_, err = auth.ReadLoginChallenge(ctx, challenge)
+	require.ErrorIs(err, model.ErrInvalidLoginChallenge)
+
+	// Challenges expire.
+	challenge, err = auth.CreateLoginChallenge(ctx, "user1")
+	require.NoError(err)
+
+	now = now.Add(model.LoginChallengeDuration + time.Second)
+
+	_, err = auth.ReadLoginChallenge(ctx, challenge)
+	require.ErrorIs(err, model.ErrInvalidLoginChallenge)
+}
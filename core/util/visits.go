@@ -0,0 +1,112 @@
+package util
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"go.jetify.com/typeid"
+)
+
+const (
+	// DefaultVisitTimeout is the period of inactivity after which the next
+	// page view from the same visitor starts a new visit.
+	DefaultVisitTimeout = 30 * time.Minute
+
+	visitSaltSize = 32
+)
+
+// VisitTracker groups consecutive page views from the same visitor into a
+// visit without storing any identifying information.
+//
+// Visitors are identified by a keyed hash of their IP address, user agent and
+// hostname which only lives in memory. The key is regenerated every day, so a
+// visitor can not be linked across days and visits are split at midnight UTC.
+type VisitTracker struct {
+	// Cache of visitor hashes to their current visit ID.
+	cache   *Cache
+	timeout time.Duration
+
+	mu      sync.Mutex
+	salt    []byte
+	saltDay time.Time
+}
+
+// NewVisitTracker returns a new instance of VisitTracker.
+func NewVisitTracker(ctx context.Context, timeout time.Duration) *VisitTracker {
+	return &VisitTracker{
+		cache:   NewCache(ctx, timeout),
+		timeout: timeout,
+	}
+}
+
+// VisitID returns the ID of the visit the page view belongs to, starting a new
+// visit if the visitor has been inactive for longer than the timeout.
+func (v *VisitTracker) VisitID(ctx context.Context, ip string, userAgent string, hostname string) (string, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	key, err := v.visitorKey(ip, userAgent, hostname)
+	if err != nil {
+		return "", err
+	}
+
+	visitID, err := v.cache.Get(ctx, key)
+	if err == nil {
+		id, ok := visitID.(string)
+		if !ok {
+			return "", ErrInvalidCast
+		}
+
+		// Extend the visit with every page view.
+		v.cache.Set(key, id, v.timeout)
+
+		return id, nil
+	}
+
+	visitTypeID, err := typeid.WithPrefix("visit")
+	if err != nil {
+		return "", errors.Wrap(err, "typeid visit")
+	}
+
+	id := visitTypeID.String()
+	v.cache.Set(key, id, v.timeout)
+
+	return id, nil
+}
+
+// Close closes the tracker and frees up resources.
+func (v *VisitTracker) Close() {
+	v.cache.Close()
+}
+
+// visitorKey returns the daily salted hash identifying a visitor. This must be
+// called with the lock held.
+func (v *VisitTracker) visitorKey(ip string, userAgent string, hostname string) (string, error) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	if v.salt == nil || !v.saltDay.Equal(today) {
+		salt := make([]byte, visitSaltSize)
+
+		_, err := rand.Read(salt)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to generate visit salt")
+		}
+
+		v.salt = salt
+		v.saltDay = today
+	}
+
+	mac := hmac.New(sha256.New, v.salt)
+	mac.Write([]byte(ip))
+	mac.Write([]byte{0})
+	mac.Write([]byte(userAgent))
+	mac.Write([]byte{0})
+	mac.Write([]byte(hostname))
+
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
@@ -0,0 +1,53 @@
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/util"
+)
+
+func TestVisitTracker(t *testing.T) {
+	assert, require, ctx := SetupCacheTest(t)
+
+	visits := util.NewVisitTracker(ctx, util.DefaultVisitTimeout)
+	defer visits.Close()
+
+	first, err := visits.VisitID(ctx, "127.0.0.1", "Firefox", "example.com")
+	require.NoError(err)
+	assert.NotEmpty(first)
+
+	// Same visitor continues the same visit.
+	second, err := visits.VisitID(ctx, "127.0.0.1", "Firefox", "example.com")
+	require.NoError(err)
+	assert.Equal(first, second)
+
+	// Different IP, user agent or hostname starts a different visit.
+	other, err := visits.VisitID(ctx, "127.0.0.2", "Firefox", "example.com")
+	require.NoError(err)
+	assert.NotEqual(first, other)
+
+	other, err = visits.VisitID(ctx, "127.0.0.1", "Chrome", "example.com")
+	require.NoError(err)
+	assert.NotEqual(first, other)
+
+	other, err = visits.VisitID(ctx, "127.0.0.1", "Firefox", "example.org")
+	require.NoError(err)
+	assert.NotEqual(first, other)
+}
+
+func TestVisitTrackerTimeout(t *testing.T) {
+	assert, require, ctx := SetupCacheTest(t)
+
+	visits := util.NewVisitTracker(ctx, 50*time.Millisecond)
+	defer visits.Close()
+
+	first, err := visits.VisitID(ctx, "127.0.0.1", "Firefox", "example.com")
+	require.NoError(err)
+
+	time.Sleep(100 * time.Millisecond)
+
+	second, err := visits.VisitID(ctx, "127.0.0.1", "Firefox", "example.com")
+	require.NoError(err)
+	assert.NotEqual(first, second)
+}
@@ -0,0 +1,219 @@
+// Package webhooks delivers JSON payloads signed with HMAC-SHA256 to webhook
+// endpoints, retrying failed deliveries with exponential backoff.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+const (
+	// SignatureHeader holds the timestamp and signature of a delivery in the
+	// format "t=<unix timestamp>,v1=<hex signature>".
+	SignatureHeader = "X-Medama-Signature"
+	// EventHeader holds the trigger of a delivery.
+	EventHeader = "X-Medama-Event"
+	// DeliveryHeader holds the unique ID of a delivery, which stays the same
+	// across retries.
+	DeliveryHeader = "X-Medama-Delivery"
+
+	// DefaultMaxAttempts is the number of attempts before a delivery fails.
+	DefaultMaxAttempts = 5
+	// DefaultBackoff is the delay before the first retry, which doubles with
+	// every following retry.
+	DefaultBackoff = 2 * time.Second
+	// DefaultTimeout limits how long a single attempt may take.
+	DefaultTimeout = 10 * time.Second
+
+	// maxBackoff caps the delay between retries.
+	maxBackoff = time.Minute
+)
+
+var (
+	// ErrInvalidSignature is returned when a signature does not match the
+	// payload.
+	ErrInvalidSignature = errors.New("webhooks: invalid signature")
+	// ErrExpiredSignature is returned when the timestamp of a signature is
+	// outside of the tolerance.
+	ErrExpiredSignature = errors.New("webhooks: signature timestamp outside of tolerance")
+)
+
+// Request is a single webhook delivery.
+type Request struct {
+	URL        string
+	Secret     string
+	Event      string
+	DeliveryID string
+	Body       []byte
+}
+
+// Result is the outcome of a delivery after all attempts.
+type Result struct {
+	// StatusCode is the response status of the last attempt, or zero if no
+	// response was received.
+	StatusCode int
+	Attempts   int
+	// Err is set if the delivery failed.
+	Err error
+}
+
+// Client delivers webhooks over HTTP. Deliveries are retried on network
+// errors, 429 Too Many Requests and 5xx responses, while other responses are
+// final. Redirects are not followed.
+type Client struct {
+	httpClient  *http.Client
+	maxAttempts int
+	backoff     time.Duration
+}
+
+// NewClient returns a new client making up to maxAttempts attempts per
+// delivery, waiting backoff before the first retry. Zero values use the
+// defaults.
+func NewClient(maxAttempts int, backoff time.Duration) *Client {
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+
+	if backoff <= 0 {
+		backoff = DefaultBackoff
+	}
+
+	return &Client{
+		httpClient: &http.Client{
+			Timeout: DefaultTimeout,
+			CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+	}
+}
+
+// Deliver posts the request body to its URL until it succeeds, fails
+// permanently or runs out of attempts.
+func (c *Client) Deliver(ctx context.Context, req *Request) Result {
+	var result Result
+
+	backoff := c.backoff
+
+	for result.Attempts < c.maxAttempts {
+		if result.Attempts > 0 {
+			select {
+			case <-ctx.Done():
+				result.Err = ctx.Err()
+				return result
+			case <-time.After(backoff):
+			}
+
+			backoff = min(backoff*2, maxBackoff)
+		}
+
+		result.Attempts++
+
+		retry, err := c.attempt(ctx, req, &result)
+		if err == nil {
+			result.Err = nil
+			return result
+		}
+
+		result.Err = err
+
+		if !retry {
+			return result
+		}
+	}
+
+	return result
+}
+
+// attempt sends the request once and reports whether a failure can be
+// retried.
+func (c *Client) attempt(ctx context.Context, req *Request, result *Result) (bool, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return false, errors.Wrap(err, "webhooks: request")
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "Medama-Webhooks")
+	httpReq.Header.Set(EventHeader, req.Event)
+	httpReq.Header.Set(DeliveryHeader, req.DeliveryID)
+	httpReq.Header.Set(SignatureHeader, Sign(req.Secret, time.Now(), req.Body))
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		result.StatusCode = 0
+		return ctx.Err() == nil, errors.Wrap(err, "webhooks: send")
+	}
+	defer resp.Body.Close()
+
+	result.StatusCode = resp.StatusCode
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+
+	err = errors.New("webhooks: unexpected status " + resp.Status)
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500, err
+}
+
+// Sign returns the signature header value for the body at the given time.
+// The signature is the hex encoded HMAC-SHA256 of "<unix timestamp>.<body>"
+// keyed with the secret of the webhook.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac(secret, ts, body))
+}
+
+// Verify checks the signature header value of a received body, rejecting
+// signatures older or newer than the tolerance relative to now.
+func Verify(secret string, header string, body []byte, tolerance time.Duration, now time.Time) error {
+	var ts, signature string
+
+	for field := range strings.SplitSeq(header, ",") {
+		key, value, _ := strings.Cut(field, "=")
+
+		switch key {
+		case "t":
+			ts = value
+		case "v1":
+			signature = value
+		}
+	}
+
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	expected, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, mac(secret, ts, body)) {
+		return ErrInvalidSignature
+	}
+
+	if now.Sub(time.Unix(unix, 0)).Abs() > tolerance {
+		return ErrExpiredSignature
+	}
+
+	return nil
+}
+
+func mac(secret string, timestamp string, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(timestamp + "."))
+	h.Write(body)
+
+	return h.Sum(nil)
+}
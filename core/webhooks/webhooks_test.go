@@ -0,0 +1,106 @@
+package webhooks_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/medama-io/medama/webhooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliver(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	var calls atomic.Int32
+
+	body := []byte(`{"event":"custom_event"}`)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, err := io.ReadAll(r.Body)
+		assert.NoError(err)
+		assert.Equal(body, received)
+		assert.Equal("application/json", r.Header.Get("Content-Type"))
+		assert.Equal("custom_event", r.Header.Get(webhooks.EventHeader))
+		assert.Equal("delivery_1", r.Header.Get(webhooks.DeliveryHeader))
+		assert.NoError(webhooks.Verify("secret", r.Header.Get(webhooks.SignatureHeader), received, time.Minute, time.Now()))
+
+		// Fail the first attempts to exercise retries.
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	client := webhooks.NewClient(5, time.Millisecond)
+	result := client.Deliver(t.Context(), &webhooks.Request{
+		URL:        server.URL,
+		Secret:     "secret",
+		Event:      "custom_event",
+		DeliveryID: "delivery_1",
+		Body:       body,
+	})
+	require.NoError(result.Err)
+	assert.Equal(3, result.Attempts)
+	assert.Equal(http.StatusNoContent, result.StatusCode)
+}
+
+func TestDeliverFailure(t *testing.T) {
+	assert := assert.New(t)
+
+	var calls, status atomic.Int32
+
+	status.Store(http.StatusBadRequest)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(int(status.Load()))
+	}))
+	t.Cleanup(server.Close)
+
+	client := webhooks.NewClient(3, time.Millisecond)
+	req := &webhooks.Request{URL: server.URL, Secret: "secret", Event: "new_referrer", Body: []byte("{}")}
+
+	// Client errors are not retried.
+	result := client.Deliver(t.Context(), req)
+	assert.Error(result.Err)
+	assert.Equal(1, result.Attempts)
+	assert.Equal(http.StatusBadRequest, result.StatusCode)
+
+	// Server errors are retried until the attempts run out.
+	calls.Store(0)
+
+	status.Store(http.StatusInternalServerError)
+	result = client.Deliver(t.Context(), req)
+	assert.Error(result.Err)
+	assert.Equal(3, result.Attempts)
+	assert.Equal(int32(3), calls.Load())
+
+	// Unreachable endpoints are retried without a status.
+	server.Close()
+
+	result = client.Deliver(t.Context(), req)
+	assert.Error(result.Err)
+	assert.Equal(3, result.Attempts)
+	assert.Zero(result.StatusCode)
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"hostname":"example.com"}`)
+	now := time.Unix(1700000000, 0)
+	header := webhooks.Sign("secret", now, body)
+
+	require.NoError(t, webhooks.Verify("secret", header, body, time.Minute, now.Add(30*time.Second)))
+	require.ErrorIs(t, webhooks.Verify("other", header, body, time.Minute, now), webhooks.ErrInvalidSignature)
+	require.ErrorIs(t, webhooks.Verify("secret", header, []byte("{}"), time.Minute, now), webhooks.ErrInvalidSignature)
+	require.ErrorIs(t, webhooks.Verify("secret", "v1=abc", body, time.Minute, now), webhooks.ErrInvalidSignature)
+	require.ErrorIs(t, webhooks.Verify("secret", header, body, time.Minute, now.Add(time.Hour)), webhooks.ErrExpiredSignature)
+}